@@ -2,13 +2,26 @@
 package main
 
 import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 
+	"github.com/domino14/word-golib/tilemapping"
 	"github.com/namsral/flag"
 	"github.com/rs/zerolog/log"
 
 	"github.com/domino14/word_db_server/dbmaker"
+	"github.com/domino14/word_db_server/dbmaker/difficulty"
+	"github.com/domino14/word_db_server/internal/cardbox"
+	"github.com/domino14/word_db_server/internal/common"
+	"github.com/domino14/word_db_server/internal/wordstore"
 )
 
 func stringInSlice(a string, list []string) bool {
@@ -21,13 +34,42 @@ func stringInSlice(a string, list []string) bool {
 }
 
 type Config struct {
-	MigrateDB    string
-	DBs          string
-	ForceCreate  bool
-	FixDefsOn    string
-	FixSymbolsOn string
-	OutputDir    string
-	DataPath     string
+	MigrateDB     string
+	DBs           string
+	ForceCreate   bool
+	FixDefsOn     string
+	FixSymbolsOn  string
+	PlayabilityOn string
+	SourcesOn     string
+	TagsOn        string
+	UpdateDB      string
+	OutputDir     string
+	DataPath      string
+	Workers       int
+	TieOrder      string
+	LegacyDB      string
+	WordList      string
+	Name          string
+	LetterDist    string
+	Family        string
+	Parent        string
+	DSN           string
+	DryRun        bool
+	MinShift      int
+	Storage       dbmaker.StorageOptions
+}
+
+// storageFlags adds the flags for the SQLite settings of the DBs that are
+// made or optimized.
+func storageFlags(fs *flag.FlagSet, o *dbmaker.StorageOptions) {
+	fs.StringVar(&o.JournalMode, "journalmode", "",
+		"Optional: the journal mode to leave the DBs in, e.g. wal")
+	fs.IntVar(&o.PageSize, "pagesize", 0, "Optional: the page size of the DBs, in bytes")
+	fs.StringVar(&o.Synchronous, "synchronous", "",
+		"Optional: the synchronous setting to build with, e.g. off to build faster")
+	fs.BoolVar(&o.Analyze, "analyze", false,
+		"Run ANALYZE on the DBs once they're made, for the searcher's query planning")
+	fs.BoolVar(&o.Vacuum, "vacuum", false, "Run VACUUM on the DBs once they're made")
 }
 
 // Load loads the configs from the given arguments
@@ -46,21 +88,545 @@ func (c *Config) Load(args []string) error {
 		"Pass in lexicon name to fix definitions on. DB <lexiconname>.db must exist in this dir.")
 	fs.StringVar(&c.FixSymbolsOn, "fixsymbols", "",
 		"Pass in lexicon name to fix lexicon symbols on. DB <lexiconname>.db must exist in this dir.")
+	fs.StringVar(&c.PlayabilityOn, "loadplayability", "",
+		"Pass in lexicon name to load playability data on. DB <lexiconname>.db must exist in this dir.")
+	fs.StringVar(&c.SourcesOn, "loadsources", "",
+		"Pass in lexicon name to load word source flags on. DB <lexiconname>.db must exist in this dir.")
+	fs.StringVar(&c.TagsOn, "loadtags", "",
+		"Pass in lexicon name to load word tags on. DB <lexiconname>.db must exist in this dir.")
+	fs.StringVar(&c.UpdateDB, "updatedb", "",
+		"Pass in lexicon name to update to the current word list, instead of rebuilding it. DB <lexiconname>.db must exist in this dir.")
 	fs.StringVar(&c.OutputDir, "outputdir", ".", "The output directory")
 	fs.StringVar(&c.DataPath, "datapath", os.Getenv("WDB_DATA_PATH"), "The data path")
+	fs.IntVar(&c.Workers, "workers", 0,
+		"Number of goroutines to build each DB with (default is the number of CPUs)")
+	fs.StringVar(&c.TieOrder, "tieorder", "alphagram",
+		"How to order alphagrams with equal probability: alphagram, legacy to keep the order of an existing DB, or tiles for the letter distribution's order")
+	fs.StringVar(&c.LegacyDB, "legacydb", "",
+		"The DB to copy the order from with -tieorder legacy (default is the DB being replaced)")
+	fs.StringVar(&c.WordList, "wordlist", "",
+		"Build a DB from this word list (a word per line, optionally followed by its definition) instead of a known lexicon")
+	fs.StringVar(&c.Name, "name", "", "The lexicon name for -wordlist")
+	fs.StringVar(&c.LetterDist, "letterdist", "", "The letter distribution for -wordlist, e.g. english")
+	fs.StringVar(&c.Family, "family", "",
+		"Optional: the lexicon family (e.g. CSW or TWL) whose newest lexicon -wordlist words get their lexicon symbols from")
+	fs.StringVar(&c.Parent, "parent", "",
+		"Optional: the lexicon -wordlist words get their lexicon symbols from, instead of -family")
+	fs.StringVar(&c.DSN, "dsn", "",
+		"Optional: a postgres:// DSN to also load the DBs into once they're made, for the searcher's -word-db-dsn")
+	fs.BoolVar(&c.DryRun, "dry-run", false,
+		"Build the DBs in a temporary dir and print a JSON report of how they differ from the existing ones, instead of replacing them. Not for -updatedb")
+	fs.IntVar(&c.MinShift, "min-probability-shift", 1,
+		"With -dry-run, only report alphagrams whose probability moved by at least this many places")
+	storageFlags(fs, &c.Storage)
 	return fs.Parse(args)
 
 }
 
+// dryRunOutput is where the -dry-run reports go, or nil without it.
+func (c *Config) dryRunOutput() io.Writer {
+	if !c.DryRun {
+		return nil
+	}
+	return os.Stdout
+}
+
+// loadDifficultyCmd runs `dbmaker load-difficulty`, which replaces the
+// difficulty ratings in an existing DB with ones from another source.
+func loadDifficultyCmd(args []string) error {
+	fs := flag.NewFlagSet("load-difficulty", flag.ContinueOnError)
+	lexicon := fs.String("lexicon", "",
+		"The lexicon to load difficulty data on. DB <lexiconname>.db must exist in this dir.")
+	source := fs.String("source", "",
+		"Where to read difficulty scores from: an http(s) URL, a .json or .csv file, or a directory with a <length>.csv per word length")
+	normalize := fs.String("normalize", "none",
+		"How to turn scores into 1-100 ratings: none (they already are), or quantile (by rank among alphagrams of each length)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *lexicon == "" || *source == "" {
+		return errors.New("load-difficulty needs -lexicon and -source")
+	}
+	norm, err := difficulty.ParseNormalization(*normalize)
+	if err != nil {
+		return err
+	}
+	src, err := difficulty.Open(*source)
+	if err != nil {
+		return err
+	}
+	dbmaker.LoadDifficulty(*lexicon, src, norm)
+	return nil
+}
+
+// computeDifficultyCmd runs `dbmaker compute-difficulty`, which rates an
+// existing DB's alphagrams with a model fit to the ratings in a DB that has
+// some.
+func computeDifficultyCmd(args []string) error {
+	fs := flag.NewFlagSet("compute-difficulty", flag.ContinueOnError)
+	lexicon := fs.String("lexicon", "",
+		"The lexicon to compute difficulty for. DB <lexiconname>.db must exist in this dir.")
+	trainLexicon := fs.String("train-lexicon", "",
+		"The lexicon whose difficulty ratings to fit the model to, if not -lexicon itself. DB <lexiconname>.db must exist in this dir.")
+	normalize := fs.String("normalize", "quantile",
+		"How to turn predictions into 1-100 ratings: quantile (by rank among alphagrams of each length), or none (clamped as predicted)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *lexicon == "" {
+		return errors.New("compute-difficulty needs -lexicon")
+	}
+	if *trainLexicon == "" {
+		*trainLexicon = *lexicon
+	}
+	norm, err := difficulty.ParseNormalization(*normalize)
+	if err != nil {
+		return err
+	}
+	dbmaker.ComputeDifficulty(*lexicon, *trainLexicon, norm)
+	return nil
+}
+
+// recalcProbabilitiesCmd runs `dbmaker recalc-probabilities`, which
+// renumbers the probabilities in an existing DB from its combinations.
+func recalcProbabilitiesCmd(args []string) error {
+	fs := flag.NewFlagSet("recalc-probabilities", flag.ContinueOnError)
+	lexicon := fs.String("lexicon", "",
+		"The lexicon to recalculate probabilities for. DB <lexiconname>.db must exist in this dir.")
+	tieOrderName := fs.String("tieorder", "alphagram",
+		"How to order alphagrams with equal probability: alphagram, legacy to keep their current order, or tiles for the letter distribution's order")
+	dataPath := fs.String("datapath", os.Getenv("WDB_DATA_PATH"), "The data path")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *lexicon == "" {
+		return errors.New("recalc-probabilities needs -lexicon")
+	}
+	tieOrder, err := dbmaker.ParseTieOrder(*tieOrderName)
+	if err != nil {
+		return err
+	}
+	var dist *tilemapping.LetterDistribution
+	if tieOrder == dbmaker.TieOrderTiles {
+		dist, err = common.LetterDistribution(map[string]any{"data-path": *dataPath}, *lexicon)
+		if err != nil {
+			return err
+		}
+	}
+	dbmaker.RecalcProbabilities(*lexicon, dist, tieOrder)
+	return nil
+}
+
+// loadPostgresCmd runs `dbmaker load-postgres`, which loads an existing DB
+// into a Postgres database for the searcher to serve from.
+func loadPostgresCmd(args []string) error {
+	fs := flag.NewFlagSet("load-postgres", flag.ContinueOnError)
+	lexicon := fs.String("lexicon", "",
+		"The lexicon to load. DB <lexiconname>.db must exist in this dir.")
+	dsn := fs.String("dsn", "", "The postgres:// DSN of the database to load it into")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *lexicon == "" || *dsn == "" {
+		return errors.New("load-postgres needs -lexicon and -dsn")
+	}
+	return loadPostgres(*dsn, []string{*lexicon}, ".")
+}
+
+// loadPostgres loads the lexica's DBs in dir into the Postgres database.
+func loadPostgres(dsn string, lexica []string, dir string) error {
+	store, err := wordstore.OpenPostgres(dsn)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+	for _, lex := range lexica {
+		path := filepath.Join(dir, lex+".db")
+		if _, err := os.Stat(path); err != nil {
+			return err
+		}
+		if err := wordstore.LoadSQLite(context.Background(), store, lex, path); err != nil {
+			return fmt.Errorf("loading %v: %w", lex, err)
+		}
+		log.Info().Msgf("Loaded %v into Postgres", lex)
+	}
+	return nil
+}
+
+// exportTrainingCmd runs `dbmaker export-difficulty-training`, which
+// writes the alphagrams of an existing DB with how well users of the quiz
+// scheduler have done on them, for fitting difficulty ratings to.
+func exportTrainingCmd(args []string) error {
+	fs := flag.NewFlagSet("export-difficulty-training", flag.ContinueOnError)
+	lexicon := fs.String("lexicon", "",
+		"The lexicon to export. DB <lexiconname>.db must exist in this dir.")
+	store := fs.String("cardbox-store", "",
+		"The searcher's cardbox store: a postgres:// DSN, or the directory of per-user databases")
+	minUsers := fs.Int("min-users", 5,
+		"Leave out alphagrams that fewer than this many users have answered")
+	out := fs.String("out", "", "The CSV file to write; standard output if not given")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *lexicon == "" || *store == "" {
+		return errors.New("export-difficulty-training needs -lexicon and -cardbox-store")
+	}
+	if _, err := os.Stat(*lexicon + ".db"); err != nil {
+		return err
+	}
+	ctx := context.Background()
+	cards, err := cardbox.Open(*store)
+	if err != nil {
+		return err
+	}
+	defer cards.Close()
+	totals, err := cards.AnswerTotals(ctx, *lexicon)
+	if err != nil {
+		return err
+	}
+	db, err := sql.Open("sqlite3", *lexicon+".db")
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	w := os.Stdout
+	if *out != "" {
+		if w, err = os.Create(*out); err != nil {
+			return err
+		}
+	}
+	n, err := dbmaker.ExportDifficultyTraining(ctx, db, totals, *minUsers, w)
+	if *out != "" {
+		// Some filesystems only report a failed write on close.
+		if cerr := w.Close(); err == nil {
+			err = cerr
+		}
+	}
+	if err != nil {
+		return err
+	}
+	log.Info().Int("alphagrams", n).Int("answered", len(totals)).Msg("exported training data")
+	return nil
+}
+
+// verifyCmd runs `dbmaker verify`, which checks the invariants of
+// existing DBs and writes a JSON summary of what it found. It fails if any
+// check does.
+func verifyCmd(args []string) error {
+	fs := flag.NewFlagSet("verify", flag.ContinueOnError)
+	lexica := fs.String("lexicon", "",
+		"The lexica to verify, comma-separated. DB <lexiconname>.db must exist in this dir for each.")
+	out := fs.String("out", "", "The file to write the summary to; standard output if not given")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *lexica == "" {
+		return errors.New("verify needs -lexicon")
+	}
+	summary := struct {
+		OK     bool                    `json:"ok"`
+		Lexica []*dbmaker.VerifyReport `json:"lexica"`
+	}{OK: true}
+	failed := 0
+	for _, lexicon := range strings.Split(*lexica, ",") {
+		if _, err := os.Stat(lexicon + ".db"); err != nil {
+			return err
+		}
+		db, err := sql.Open("sqlite3", "file:"+lexicon+".db?mode=ro")
+		if err != nil {
+			return err
+		}
+		report, err := dbmaker.Verify(context.Background(), db, lexicon)
+		db.Close()
+		if err != nil {
+			return fmt.Errorf("%v: %w", lexicon, err)
+		}
+		if !report.OK {
+			failed++
+		}
+		summary.OK = summary.OK && report.OK
+		summary.Lexica = append(summary.Lexica, report)
+	}
+	w := os.Stdout
+	if *out != "" {
+		var err error
+		if w, err = os.Create(*out); err != nil {
+			return err
+		}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	err := enc.Encode(summary)
+	if *out != "" {
+		if cerr := w.Close(); err == nil {
+			err = cerr
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d databases failed verification", failed, len(summary.Lexica))
+	}
+	return nil
+}
+
+// buildAllCmd runs `dbmaker build-all`, which builds every known lexicon
+// at once, and fails if any of them failed.
+func buildAllCmd(args []string) error {
+	fs := flag.NewFlagSet("build-all", flag.ContinueOnError)
+	outputDir := fs.String("outputdir", ".", "The output directory")
+	dataPath := fs.String("datapath", os.Getenv("WDB_DATA_PATH"), "The data path")
+	parallel := fs.Int("parallel", dbmaker.DefaultBuildParallelism, "How many lexica to build at once")
+	workers := fs.Int("workers", 0,
+		"Number of goroutines to build each DB with (default is the number of CPUs over -parallel)")
+	force := fs.Bool("force", false, "Rebuild DBs that already exist (they're skipped otherwise)")
+	tieOrderName := fs.String("tieorder", "alphagram",
+		"How to order alphagrams with equal probability: alphagram, legacy to keep the order of each existing DB, or tiles for the letter distribution's order")
+	var storage dbmaker.StorageOptions
+	storageFlags(fs, &storage)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := storage.Validate(); err != nil {
+		return err
+	}
+	tieOrder, err := dbmaker.ParseTieOrder(*tieOrderName)
+	if err != nil {
+		return err
+	}
+	os.MkdirAll(*outputDir, os.ModePerm)
+	results := dbmaker.BuildAll(dbmaker.LexiconMappings(*dataPath), *outputDir, dbmaker.BuildAllOptions{
+		CreateOptions: dbmaker.CreateOptions{Workers: *workers, TieOrder: tieOrder, Storage: storage},
+		Parallel:      *parallel,
+		Force:         *force,
+	})
+	var errs []error
+	built := 0
+	for _, r := range results {
+		switch {
+		case r.Skipped != "":
+			log.Info().Str("lexicon", r.Lexicon).Str("reason", r.Skipped).Msg("skipped")
+		case r.Err != nil:
+			errs = append(errs, fmt.Errorf("%v: %w", r.Lexicon, r.Err))
+		default:
+			built++
+		}
+	}
+	log.Info().Int("built", built).Int("failed", len(errs)).
+		Int("skipped", len(results)-built-len(errs)).Msg("build-all finished")
+	return errors.Join(errs...)
+}
+
+// optimizeCmd runs `dbmaker optimize`, which applies the SQLite settings
+// that DBs can be built with to existing DBs.
+func optimizeCmd(args []string) error {
+	fs := flag.NewFlagSet("optimize", flag.ContinueOnError)
+	lexica := fs.String("lexicon", "",
+		"The lexica to optimize, comma-separated. DB <lexiconname>.db must exist in -outputdir for each.")
+	outputDir := fs.String("outputdir", ".", "The directory of the DBs")
+	var storage dbmaker.StorageOptions
+	storageFlags(fs, &storage)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *lexica == "" {
+		return errors.New("optimize needs -lexicon")
+	}
+	if storage == (dbmaker.StorageOptions{}) {
+		return errors.New("nothing to do; pass -analyze, -vacuum, -journalmode or -pagesize")
+	}
+	for _, lexicon := range strings.Split(*lexica, ",") {
+		if err := dbmaker.OptimizeDatabase(filepath.Join(*outputDir, lexicon+".db"), storage); err != nil {
+			return fmt.Errorf("%v: %w", lexicon, err)
+		}
+		log.Info().Str("lexicon", lexicon).Msg("optimized")
+	}
+	return nil
+}
+
+// exportHooksCmd runs `dbmaker export-hooks`, which writes the hooks
+// found when an existing DB was built as a graph, from each word to the
+// words its front and back hooks make.
+func exportHooksCmd(args []string) error {
+	fs := flag.NewFlagSet("export-hooks", flag.ContinueOnError)
+	lexicon := fs.String("lexicon", "",
+		"The lexicon to export. DB <lexiconname>.db must exist in this dir.")
+	dataPath := fs.String("datapath", os.Getenv("WDB_DATA_PATH"), "The data path")
+	minLength := fs.Int("minlength", 2, "The length of the shortest words to export")
+	maxLength := fs.Int("maxlength", 15, "The length of the longest words to export")
+	format := fs.String("format", "json", "The format to write: json or graphml")
+	out := fs.String("out", "", "The file to write; standard output if not given")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *lexicon == "" {
+		return errors.New("export-hooks needs -lexicon")
+	}
+	if *format != "json" && *format != "graphml" {
+		return fmt.Errorf("unknown format %v; it must be json or graphml", *format)
+	}
+	if _, err := os.Stat(*lexicon + ".db"); err != nil {
+		return err
+	}
+	dist, err := common.LetterDistribution(map[string]any{"data-path": *dataPath}, *lexicon)
+	if err != nil {
+		return err
+	}
+	db, err := sql.Open("sqlite3", *lexicon+".db")
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	g, err := dbmaker.ReadHookGraph(context.Background(), db, *lexicon, dist, *minLength, *maxLength)
+	if err != nil {
+		return err
+	}
+	w := os.Stdout
+	if *out != "" {
+		if w, err = os.Create(*out); err != nil {
+			return err
+		}
+	}
+	if *format == "graphml" {
+		err = g.WriteGraphML(w)
+	} else {
+		err = g.WriteJSON(w)
+	}
+	if *out != "" {
+		if cerr := w.Close(); err == nil {
+			err = cerr
+		}
+	}
+	if err != nil {
+		return err
+	}
+	log.Info().Int("words", len(g.Nodes)).Int("hooks", len(g.Edges)).Msg("exported hooks")
+	return nil
+}
+
+// exportValidityCmd runs `dbmaker export-validity`, which writes the
+// words of existing DBs as minimized DAWGs, for clients to check words
+// offline and for the searcher to serve at /validity/.
+func exportValidityCmd(args []string) error {
+	fs := flag.NewFlagSet("export-validity", flag.ContinueOnError)
+	lexica := fs.String("lexicon", "",
+		"The lexica to export, comma-separated. DB <lexiconname>.db must exist in this dir for each.")
+	dataPath := fs.String("datapath", os.Getenv("WDB_DATA_PATH"), "The data path")
+	outputDir := fs.String("outputdir", ".",
+		"The directory to write <lexiconname>.kwg to; the searcher serves them from lexica/validity in its data path")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *lexica == "" {
+		return errors.New("export-validity needs -lexicon")
+	}
+	os.MkdirAll(*outputDir, os.ModePerm)
+	for _, lexicon := range strings.Split(*lexica, ",") {
+		if err := exportValidity(lexicon, *dataPath, *outputDir); err != nil {
+			return fmt.Errorf("%v: %w", lexicon, err)
+		}
+	}
+	return nil
+}
+
+// exportValidity writes the lexicon's validity export. It's written to a
+// temporary file and renamed into place, so a searcher serving the old
+// one never sends half of the new one.
+func exportValidity(lexicon, dataPath, outputDir string) error {
+	if _, err := os.Stat(lexicon + ".db"); err != nil {
+		return err
+	}
+	dist, err := common.LetterDistribution(map[string]any{"data-path": dataPath}, lexicon)
+	if err != nil {
+		return err
+	}
+	db, err := sql.Open("sqlite3", "file:"+lexicon+".db?mode=ro")
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	f, err := os.CreateTemp(outputDir, lexicon+".kwg.*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	n, err := dbmaker.ExportValidity(context.Background(), db, dist, f)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	if err := os.Chmod(f.Name(), 0644); err != nil {
+		return err
+	}
+	path := filepath.Join(outputDir, lexicon+".kwg")
+	if err := os.Rename(f.Name(), path); err != nil {
+		return err
+	}
+	log.Info().Str("lexicon", lexicon).Int("words", n).Str("path", path).Msg("exported validity")
+	return nil
+}
+
+// subcommands are what dbmaker runs by the first argument, instead of
+// building lexica from its flags.
+var subcommands = map[string]func(args []string) error{
+	"load-difficulty":            loadDifficultyCmd,
+	"compute-difficulty":         computeDifficultyCmd,
+	"recalc-probabilities":       recalcProbabilitiesCmd,
+	"export-difficulty-training": exportTrainingCmd,
+	"verify":                     verifyCmd,
+	"build-all":                  buildAllCmd,
+	"optimize":                   optimizeCmd,
+	"export-hooks":               exportHooksCmd,
+	"export-validity":            exportValidityCmd,
+	"load-postgres":              loadPostgresCmd,
+}
+
+// usage says an unknown subcommand isn't one, and lists those there are.
+func usage(unknown string) {
+	names := make([]string, 0, len(subcommands))
+	for name := range subcommands {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	fmt.Fprintf(os.Stderr, "unknown subcommand %q\nusage: dbmaker [flags]\n       dbmaker <subcommand> [flags]\nsubcommands:\n", unknown)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %s\n", name)
+	}
+}
+
 func main() {
+	// Anything but a flag first is a subcommand.
+	if len(os.Args) > 1 && !strings.HasPrefix(os.Args[1], "-") {
+		cmd, ok := subcommands[os.Args[1]]
+		if !ok {
+			usage(os.Args[1])
+			os.Exit(2)
+		}
+		if err := cmd(os.Args[2:]); err != nil {
+			log.Fatal().Err(err).Msg("")
+		}
+		return
+	}
 
 	cfg := &Config{}
 	cfg.Load(os.Args[1:])
+	// Updating in place has nothing to compare against.
+	if cfg.UpdateDB != "" && cfg.DryRun {
+		log.Fatal().Msg("-updatedb can't be used with -dry-run; rebuild the DB with -dbs instead")
+	}
 	log.Info().Interface("config", cfg).Msg("dbmaker-started")
 
 	// MkdirAll will make any intermediate dirs but fail gracefully if they exist.
 	os.MkdirAll(cfg.OutputDir, os.ModePerm)
 	lexiconMap := dbmaker.LexiconMappings(cfg.DataPath)
+	// Old names of lexica stand for the lexica they're aliases of.
+	for _, name := range []*string{&cfg.MigrateDB, &cfg.FixDefsOn, &cfg.FixSymbolsOn,
+		&cfg.PlayabilityOn, &cfg.SourcesOn, &cfg.TagsOn, &cfg.UpdateDB} {
+		*name = lexiconMap.Canonical(*name)
+	}
 
 	if cfg.MigrateDB != "" {
 		info, err := lexiconMap.GetLexiconInfo(cfg.MigrateDB)
@@ -68,13 +634,76 @@ func main() {
 			log.Err(err).Msg("That lexicon is not supported")
 			return
 		}
-		dbmaker.MigrateLexiconDatabase(cfg.MigrateDB, info)
+		dbmaker.MigrateLexiconDatabase(cfg.MigrateDB, info, lexiconMap)
 	} else if cfg.FixDefsOn != "" {
 		fixDefinitions(cfg.FixDefsOn, lexiconMap)
 	} else if cfg.FixSymbolsOn != "" {
 		fixSymbols(cfg.FixSymbolsOn, lexiconMap)
+	} else if cfg.PlayabilityOn != "" {
+		dbmaker.LoadPlayability(cfg.PlayabilityOn, lexiconMap)
+	} else if cfg.SourcesOn != "" {
+		dbmaker.LoadSources(cfg.SourcesOn, lexiconMap)
+	} else if cfg.TagsOn != "" {
+		dbmaker.LoadTags(cfg.TagsOn, lexiconMap)
+	} else if cfg.UpdateDB != "" {
+		tieOrder, err := dbmaker.ParseTieOrder(cfg.TieOrder)
+		if err != nil {
+			log.Fatal().Err(err).Msg("")
+		}
+		dbmaker.UpdateLexiconDatabase(cfg.UpdateDB, lexiconMap, tieOrder)
+	} else if cfg.WordList != "" {
+		if cfg.Name == "" || cfg.LetterDist == "" {
+			log.Fatal().Msg("-wordlist needs -name and -letterdist")
+		}
+		// Relative to here, not to the data path's lexica.
+		wordList, err := filepath.Abs(cfg.WordList)
+		if err != nil {
+			log.Fatal().Err(err).Msg("")
+		}
+		err = lexiconMap.AddWordList(cfg.DataPath, &common.CustomLexicon{
+			Name:               cfg.Name,
+			File:               wordList,
+			LetterDistribution: cfg.LetterDist,
+			Parent:             cfg.Parent,
+		}, dbmaker.FamilyName(cfg.Family))
+		if err != nil {
+			log.Fatal().Err(err).Msg("")
+		}
+		tieOrder, err := dbmaker.ParseTieOrder(cfg.TieOrder)
+		if err != nil {
+			log.Fatal().Err(err).Msg("")
+		}
+		made := makeDbs(cfg.Name, lexiconMap, cfg.OutputDir, cfg.ForceCreate, dbmaker.CreateOptions{
+			Workers:             cfg.Workers,
+			TieOrder:            tieOrder,
+			LegacyDB:            cfg.LegacyDB,
+			Storage:             cfg.Storage,
+			DryRun:              cfg.dryRunOutput(),
+			MinProbabilityShift: cfg.MinShift,
+		})
+		if cfg.DSN != "" && !cfg.DryRun {
+			if err := loadPostgres(cfg.DSN, made, cfg.OutputDir); err != nil {
+				log.Fatal().Err(err).Msg("")
+			}
+		}
 	} else {
-		makeDbs(cfg.DBs, lexiconMap, cfg.OutputDir, cfg.ForceCreate)
+		tieOrder, err := dbmaker.ParseTieOrder(cfg.TieOrder)
+		if err != nil {
+			log.Fatal().Err(err).Msg("")
+		}
+		made := makeDbs(cfg.DBs, lexiconMap, cfg.OutputDir, cfg.ForceCreate, dbmaker.CreateOptions{
+			Workers:             cfg.Workers,
+			TieOrder:            tieOrder,
+			LegacyDB:            cfg.LegacyDB,
+			Storage:             cfg.Storage,
+			DryRun:              cfg.dryRunOutput(),
+			MinProbabilityShift: cfg.MinShift,
+		})
+		if cfg.DSN != "" && !cfg.DryRun {
+			if err := loadPostgres(cfg.DSN, made, cfg.OutputDir); err != nil {
+				log.Fatal().Err(err).Msg("")
+			}
+		}
 	}
 }
 
@@ -91,8 +720,9 @@ func fixSymbols(dbToFixSymbols string, lexiconMap dbmaker.LexiconMap) {
 	dbmaker.FixLexiconSymbols(dbToFixSymbols, lexiconMap)
 }
 
+// makeDbs makes the DBs, and returns the lexica it didn't skip.
 func makeDbs(dbsToMake string, lexiconMap dbmaker.LexiconMap,
-	outputDir string, forceCreation bool) {
+	outputDir string, forceCreation bool, opts dbmaker.CreateOptions) []string {
 
 	dbs := []string{}
 	if dbsToMake != "" {
@@ -100,20 +730,31 @@ func makeDbs(dbsToMake string, lexiconMap dbmaker.LexiconMap,
 	} else {
 		panic("must provide a list of dbs to make")
 	}
+	if opts.LegacyDB != "" && len(dbs) > 1 {
+		log.Fatal().Msg("-legacydb can only be used when making a single DB")
+	}
 
+	made := []string{}
 	for _, db := range dbs {
 		info, err := lexiconMap.GetLexiconInfo(db)
 		if err != nil {
 			log.Err(err).Msgf("%v was not in list of dbs, skipping...", db)
 			continue
 		}
-		if info.KWG == nil || info.KWG.GetAlphabet() == nil {
-			log.Info().Msgf("%v was not supplied, skipping...", db)
+		if err := info.Buildable(); err != nil {
+			log.Err(err).Msgf("%v can't be built, skipping...", db)
 			continue
 		}
 		info.Initialize()
-		dbmaker.CreateLexiconDatabase(db, info, lexiconMap,
-			outputDir, !forceCreation)
+		db = info.LexiconName
+		err = dbmaker.CreateLexiconDatabase(db, info, lexiconMap,
+			outputDir, !forceCreation, opts)
+		if err != nil {
+			log.Err(err).Msgf("%v was not made", db)
+			continue
+		}
+		made = append(made, db)
 	}
+	return made
 
 }
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/namsral/flag"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/word_db_server/internal/harness"
+)
+
+// harness runs a suite of RPCs over the wire and checks the responses
+// against golden files. By default it builds the suite's fixture lexica,
+// serves them on a local port, and runs the suite against that; with -url
+// it runs against a server that's already up, such as a searchserver
+// started on fixtures written with -fixtures.
+func main() {
+	var suitePath, goldenDir, url, fixtures string
+	var update, useJSON bool
+	fs := flag.NewFlagSet("harness", flag.ExitOnError)
+	fs.StringVar(&suitePath, "suite", "internal/harness/testdata/suite.json", "suite file to run")
+	fs.StringVar(&goldenDir, "golden", "", "directory of golden files (default: golden next to the suite)")
+	fs.BoolVar(&update, "update", false, "rewrite the golden files from the responses")
+	fs.StringVar(&url, "url", "", "server to run the suite against, instead of starting one")
+	fs.StringVar(&fixtures, "fixtures", "", "write the suite's fixture data path to this directory, and exit")
+	fs.BoolVar(&useJSON, "json", false, "call the server with JSON rather than protobuf")
+	fs.Parse(os.Args[1:])
+
+	zerolog.SetGlobalLevel(zerolog.WarnLevel)
+
+	suite, err := harness.LoadSuite(suitePath)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not load suite")
+	}
+	if goldenDir == "" {
+		goldenDir = filepath.Join(filepath.Dir(suitePath), "golden")
+	}
+	if fixtures != "" {
+		if err := harness.WriteFixtures(fixtures, suite.Lexica); err != nil {
+			log.Fatal().Err(err).Msg("could not write fixtures")
+		}
+		return
+	}
+	if url == "" {
+		dataPath, err := os.MkdirTemp("", "harness")
+		if err != nil {
+			log.Fatal().Err(err).Msg("")
+		}
+		defer os.RemoveAll(dataPath)
+		if err := harness.WriteFixtures(dataPath, suite.Lexica); err != nil {
+			log.Fatal().Err(err).Msg("could not write fixtures")
+		}
+		srv, err := harness.StartServer(dataPath)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not start server")
+		}
+		defer srv.Close()
+		url = srv.URL
+	}
+
+	failed := 0
+	for _, r := range suite.Run(context.Background(), harness.NewClients(url, useJSON), goldenDir, update) {
+		if r.Err != nil {
+			failed++
+			fmt.Printf("FAIL %s: %v\n", r.Call, r.Err)
+			continue
+		}
+		fmt.Printf("ok   %s\n", r.Call)
+	}
+	fmt.Printf("%d calls, %d failed\n", len(suite.Calls), failed)
+	if failed > 0 {
+		// Not os.Exit, so that the fixtures are cleaned up.
+		defer os.Exit(1)
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/namsral/flag"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/word_db_server/config"
+	"github.com/domino14/word_db_server/internal/searchserver"
+)
+
+// indexadvisor reads a recording made with the searchserver's
+// -search-record-path option, asks SQLite how it would run each search
+// against the local lexicon databases, and reports the indexes that the
+// searches are missing and the ones they don't use.
+func main() {
+	var dataPath, recording string
+	fs := flag.NewFlagSet("indexadvisor", flag.ExitOnError)
+	fs.StringVar(&dataPath, "wdb-data-path", "", "data path")
+	fs.StringVar(&recording, "recording", "", "search recording file to analyze")
+	fs.Parse(os.Args[1:])
+
+	zerolog.SetGlobalLevel(zerolog.WarnLevel)
+
+	f, err := os.Open(recording)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not open recording")
+	}
+	reqs, elapsed, err := searchserver.ReadTimedRecording(f)
+	f.Close()
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not read recording")
+	}
+
+	reports, err := searchserver.AdviseIndexes(context.Background(),
+		&config.Config{DataPath: dataPath}, reqs, elapsed)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not analyze recording")
+	}
+	for _, r := range reports {
+		fmt.Printf("%s: %d searches, %d not planned\n", r.Lexicon, r.Searches, r.Skipped)
+		if len(r.Missing) > 0 {
+			fmt.Println("  missing indexes, by recorded time of the searches that need them:")
+			fmt.Printf("    %-32s %8s %10s %10s %12s\n", "column", "searches", "time", "rows", "rows/value")
+		}
+		for _, m := range r.Missing {
+			column := m.Table + "." + m.Column
+			if m.Automatic {
+				column += " (automatic)"
+			}
+			fmt.Printf("    %-32s %8d %10s %10d %12.1f\n", column, m.Searches, m.Elapsed,
+				m.Rows, m.RowsPerValue)
+			fmt.Printf("      %s\n", m.CreateStatement())
+		}
+		if len(r.Unused) > 0 {
+			fmt.Println("  indexes no search used:")
+		}
+		for _, name := range r.Unused {
+			fmt.Printf("    %s\n", name)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/namsral/flag"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/word_db_server/config"
+	"github.com/domino14/word_db_server/internal/searchserver"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// searchbench replays a recording made with the searchserver's
+// -search-record-path option against the local lexicon databases, and
+// prints timing statistics.
+func main() {
+	var dataPath, recording string
+	var iterations int
+	fs := flag.NewFlagSet("searchbench", flag.ExitOnError)
+	fs.StringVar(&dataPath, "wdb-data-path", "", "data path")
+	fs.StringVar(&recording, "recording", "", "search recording file to replay")
+	fs.IntVar(&iterations, "iterations", 1, "how many times to replay the recording")
+	fs.Parse(os.Args[1:])
+
+	zerolog.SetGlobalLevel(zerolog.WarnLevel)
+
+	f, err := os.Open(recording)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not open recording")
+	}
+	reqs, err := searchserver.ReadRecording(f)
+	f.Close()
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not read recording")
+	}
+
+	s := &searchserver.Server{Config: &config.Config{DataPath: dataPath}}
+	ctx := context.Background()
+	// Group timings by "query shape": the list of conditions used, and
+	// whether the request was expanded.
+	timings := map[string][]time.Duration{}
+	errs := 0
+	for i := 0; i < iterations; i++ {
+		for _, req := range reqs {
+			start := time.Now()
+			_, err := s.Search(ctx, req)
+			elapsed := time.Since(start)
+			if err != nil {
+				errs++
+				log.Warn().Err(err).Msg("search-error")
+				continue
+			}
+			shape := queryShape(req)
+			timings[shape] = append(timings[shape], elapsed)
+		}
+	}
+
+	shapes := make([]string, 0, len(timings))
+	for k := range timings {
+		shapes = append(shapes, k)
+	}
+	sort.Strings(shapes)
+	fmt.Printf("%-60s %6s %10s %10s %10s\n", "shape", "n", "p50", "p95", "max")
+	for _, shape := range shapes {
+		ts := timings[shape]
+		sort.Slice(ts, func(i, j int) bool { return ts[i] < ts[j] })
+		fmt.Printf("%-60s %6d %10s %10s %10s\n", shape, len(ts),
+			percentile(ts, 50), percentile(ts, 95), ts[len(ts)-1])
+	}
+	fmt.Printf("%d requests, %d errors\n", len(reqs)*iterations, errs)
+}
+
+func queryShape(req *pb.SearchRequest) string {
+	conds := make([]string, len(req.Searchparams))
+	for i, p := range req.Searchparams {
+		conds[i] = p.Condition.String()
+	}
+	shape := strings.Join(conds, ",")
+	if req.Expand {
+		shape += " (expand)"
+	}
+	return shape
+}
+
+// percentile assumes ts is sorted.
+func percentile(ts []time.Duration, p int) time.Duration {
+	idx := (len(ts) - 1) * p / 100
+	return ts[idx]
+}
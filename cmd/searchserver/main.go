@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -11,15 +12,24 @@ import (
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/grpc"
 
 	"github.com/domino14/word_db_server/config"
+	"github.com/domino14/word_db_server/internal/aliases"
 	"github.com/domino14/word_db_server/internal/anagramserver"
+	"github.com/domino14/word_db_server/internal/apikeys"
+	"github.com/domino14/word_db_server/internal/cardbox"
+	"github.com/domino14/word_db_server/internal/common"
+	"github.com/domino14/word_db_server/internal/compression"
+	"github.com/domino14/word_db_server/internal/localize"
+	"github.com/domino14/word_db_server/internal/ratelimit"
+	"github.com/domino14/word_db_server/internal/reqlog"
 	"github.com/domino14/word_db_server/internal/searchserver"
+	"github.com/domino14/word_db_server/internal/tenants"
+	"github.com/domino14/word_db_server/internal/tracing"
 	"github.com/domino14/word_db_server/rpc/wordsearcher"
-)
-
-const (
-	GracefulShutdownTimeout = 10 * time.Second
+	wordsearcherv2 "github.com/domino14/word_db_server/rpc/wordsearcher/v2"
 )
 
 func main() {
@@ -34,28 +44,219 @@ func main() {
 		zerolog.SetGlobalLevel(zerolog.DebugLevel)
 	}
 
+	shutdownTracing, err := tracing.Setup(context.Background())
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not set up tracing")
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			log.Err(err).Msg("could not flush spans")
+		}
+	}()
+
+	lexiconMap, err := common.LoadLexiconMap(cfg.DataPath)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not load the lexicon map")
+	}
+	lexiconAliases := aliases.New(lexiconMap)
+
+	snapshots := searchserver.NewSnapshots(cfg)
+	defer snapshots.Close()
+	dbs := searchserver.NewDBCache(cfg)
+	defer dbs.Close()
+	checkpointer, err := searchserver.NewCheckpointer(cfg)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not start checkpoints")
+	}
+	defer checkpointer.Close()
+	expandCache := searchserver.NewExpandCache(cfg)
+	alphagramIndex := searchserver.NewAlphagramIndex(cfg)
+	if !cfg.ExpandOnly {
+		go alphagramIndex.Warm()
+	}
 	searchServer := &searchserver.Server{
-		Config: cfg,
+		Config:         cfg,
+		Snapshots:      snapshots,
+		DBs:            dbs,
+		ExpandCache:    expandCache,
+		AlphagramIndex: alphagramIndex,
+	}
+	var questionSearcher wordsearcher.QuestionSearcher = searchServer
+	if cfg.ExpandOnly {
+		if cfg.DemoMode {
+			log.Fatal().Msg("expand-only mode can't be combined with demo mode")
+		}
+		questionSearcher = &searchserver.ExpandOnlyServer{Server: searchServer}
+	}
+	if cfg.SearchRecordPath != "" {
+		recorder, err := searchserver.NewRecorder(cfg.SearchRecordPath)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not open search recording")
+		}
+		defer recorder.Close()
+		searchServer.Recorder = recorder
 	}
 	anagramServer := &anagramserver.Server{
-		Config: map[string]any{"data-path": cfg.DataPath},
+		Config: map[string]any{"data-path": cfg.DataPath,
+			"no-definition-lexica": cfg.NoDefinitionLexica},
 	}
 	wordSearchServer := &searchserver.WordSearchServer{
 		Config: cfg,
 	}
 
-	searchHandler := wordsearcher.NewQuestionSearcherServer(searchServer, nil)
-	anagramHandler := wordsearcher.NewAnagrammerServer(anagramServer, nil)
-	wordSearchHandler := wordsearcher.NewWordSearcherServer(wordSearchServer, nil)
+	requestLogger, err := reqlog.New(cfg.RequestLogLevel, cfg.RequestLogSample)
+	if err != nil {
+		log.Fatal().Err(err).Msg("bad request log level")
+	}
+	// This goes last, to log the responses as the other interceptors leave
+	// them.
+	requestLog := requestLogger.ServerOption()
+	// This goes first, so an RPC's span covers the other interceptors.
+	traced := tracing.ServerOption()
+	// This goes before the tenant check, which should see the lexica that
+	// aliases stand for.
+	aliased := twirp.WithServerInterceptors(lexiconAliases.Interceptor())
+	// This does nothing unless the request is from a tenant.
+	tenantCheck := twirp.WithServerInterceptors(tenants.LexiconInterceptor())
+	searchHandler := wordsearcher.NewQuestionSearcherServer(questionSearcher, traced, aliased, tenantCheck,
+		twirp.WithServerInterceptors(searchserver.PreencodeInterceptor(expandCache)), requestLog)
+	// Version 2 of the searcher has its own path prefix.
+	v2SearchHandler := wordsearcherv2.NewQuestionSearcherServer(
+		&searchserver.V2Server{Config: cfg, Searcher: questionSearcher}, traced, aliased, tenantCheck, requestLog,
+		twirp.WithServerPathPrefix(searchserver.V2PathPrefix))
+	anagramHandler := wordsearcher.NewAnagrammerServer(anagramServer, traced, aliased, tenantCheck, requestLog)
+	wordSearchHandler := wordsearcher.NewWordSearcherServer(wordSearchServer, traced, aliased, tenantCheck, requestLog)
+	lexiconInfoServer := &searchserver.LexiconInfoServer{Config: cfg}
+	if cfg.TranslationsFile != "" {
+		if lexiconInfoServer.Translations, err = localize.Load(cfg.TranslationsFile); err != nil {
+			log.Fatal().Err(err).Msg("could not load translations")
+		}
+	}
+	lexiconInfoHandler := wordsearcher.NewLexiconInfoServer(lexiconInfoServer, traced, aliased, tenantCheck, requestLog)
+	keys, err := loadAPIKeys(cfg)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not load API keys")
+	}
 	mux := http.NewServeMux()
-	mux.Handle(searchHandler.PathPrefix(), searchHandler)
-	mux.Handle(anagramHandler.PathPrefix(), anagramHandler)
-	mux.Handle(wordSearchHandler.PathPrefix(), wordSearchHandler)
-	mux.Handle("/plainsearch", plainTextHandler(wordSearchServer, anagramServer))
+	var handler http.Handler = mux
+	// For Kubernetes' probes; these are served in every mode.
+	mux.Handle("/healthz", tenants.NotForTenants(searchserver.HealthzHandler(cfg)))
+	drain := &searchserver.Drain{}
+	mux.Handle("/readyz", tenants.NotForTenants(searchserver.ReadyzHandler(cfg, drain)))
+	if cfg.DemoMode {
+		if keys != nil {
+			log.Fatal().Msg("demo mode can't be combined with API keys")
+		}
+		// Only expose the restricted question searcher in demo mode; the
+		// other services make it too easy to scrape the lexica.
+		demoHandler := wordsearcher.NewQuestionSearcherServer(
+			&searchserver.DemoServer{Server: searchServer}, traced, aliased, requestLog)
+		proxies, err := ratelimit.ParseProxies(cfg.TrustedProxies)
+		if err != nil {
+			log.Fatal().Err(err).Msg("bad trusted proxies")
+		}
+		limiter := ratelimit.New(cfg.DemoRequestsPerMinute, cfg.DemoRequestsPerMinute)
+		mux.Handle(demoHandler.PathPrefix(), limiter.Middleware(proxies.RemoteIP, demoHandler))
+	} else {
+		// Tenants only get the Twirp services, which check their lexica.
+		tenantMux := http.NewServeMux()
+		services := []wordsearcher.TwirpServer{searchHandler, v2SearchHandler,
+			anagramHandler, wordSearchHandler, lexiconInfoHandler}
+		if cfg.ExpandOnly {
+			services = services[:2]
+		}
+		for _, h := range services {
+			var served http.Handler = h
+			if h == searchHandler {
+				// Lets the searcher's protobuf responses be pre-encoded.
+				served = searchserver.ProtobufResponses(h)
+			}
+			served = requireKey(keys, served)
+			mux.Handle(h.PathPrefix(), served)
+			tenantMux.Handle(h.PathPrefix(), served)
+		}
+		if cfg.AdminToken != "" {
+			adminHandler := wordsearcher.NewAdminServer(
+				&searchserver.AdminServer{Config: cfg}, traced, aliased, requestLog)
+			mux.Handle(adminHandler.PathPrefix(), tenants.NotForTenants(
+				searchserver.RequireAdminToken(cfg.AdminToken, adminHandler)))
+		}
+		if !cfg.ExpandOnly {
+			mountHTTPHandlers(mux, keys, cfg, searchServer, wordSearchServer, anagramServer)
+		}
+		if cfg.CardboxStore != "" && !cfg.ExpandOnly {
+			store, err := cardbox.Open(cfg.CardboxStore)
+			if err != nil {
+				log.Fatal().Err(err).Msg("could not open cardbox store")
+			}
+			defer store.Close()
+			// Cardboxes are per user rather than per tenant.
+			scheduler := &searchserver.QuizSchedulerServer{Searcher: searchServer, Store: store,
+				Retention: cfg.CardboxRetention}
+			purgeCtx, stopPurging := context.WithCancel(context.Background())
+			defer stopPurging()
+			go scheduler.PurgeDeletedCardboxes(purgeCtx, time.Hour)
+			schedulerHandler := wordsearcher.NewQuizSchedulerServer(scheduler, traced, aliased, requestLog)
+			mux.Handle(schedulerHandler.PathPrefix(), tenants.NotForTenants(
+				requireKey(keys, schedulerHandler)))
+			// Saved searches are per user too, and per API key with keys.
+			savedSearches := wordsearcher.NewSavedSearchesServer(
+				&searchserver.SavedSearchServer{Searcher: searchServer, Store: store},
+				traced, aliased, requestLog)
+			mux.Handle(wordsearcher.SavedSearchesPathPrefix, tenants.NotForTenants(
+				requireKey(keys, savedSearches)))
+		}
+		mux.Handle("/debug/dbcache", tenants.NotForTenants(requireKey(keys, dbs.StatsHandler())))
+		mux.Handle("/debug/expandcache", tenants.NotForTenants(requireKey(keys, expandCache.StatsHandler())))
+		mux.Handle("/debug/alphagramindex", tenants.NotForTenants(
+			requireKey(keys, alphagramIndex.StatsHandler())))
+
+		if cfg.TenantsFile != "" {
+			ts, err := tenants.Load(cfg.TenantsFile)
+			if err != nil {
+				log.Fatal().Err(err).Msg("could not load tenants")
+			}
+			for _, t := range ts.WithPrefixes() {
+				mux.Handle(t.Prefix+"/", ts.Serve(t, tenantMux))
+			}
+			handler = ts.Identify(mux)
+		}
+	}
+
+	var grpcSrv *grpc.Server
+	if cfg.GRPCAddr != "" {
+		if cfg.DemoMode {
+			// The demo rate limiter is HTTP middleware.
+			log.Fatal().Msg("gRPC can't be served in demo mode")
+		}
+		if keys != nil {
+			// Nor does it check API keys.
+			log.Fatal().Msg("gRPC can't be served with API keys")
+		}
+		lis, err := net.Listen("tcp", cfg.GRPCAddr)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not listen for gRPC")
+		}
+		grpcSrv = searchserver.NewGRPCServer(questionSearcher, expandCache,
+			lexiconAliases.GRPCInterceptor)
+		go func() {
+			if err := grpcSrv.Serve(lis); err != nil {
+				log.Fatal().Err(err).Msg("gRPC server failed")
+			}
+		}()
+		log.Info().Str("addr", cfg.GRPCAddr).Msg("serving gRPC")
+	}
 
+	// Requests are cancelled if they're still running when the drain
+	// timeout is up, rather than finding their databases closed.
+	requestsCtx, cancelRequests := context.WithCancel(context.Background())
 	srv := &http.Server{
-		Addr:    ":8180",
-		Handler: mux,
+		Addr: ":8180",
+		Handler: drain.Middleware(tracing.Middleware(
+			compression.Middleware(cfg.CompressionMinSize, handler))),
+		BaseContext: func(net.Listener) context.Context { return requestsCtx },
 	}
 	idleConnsClosed := make(chan struct{})
 
@@ -64,14 +265,33 @@ func main() {
 		signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
 		<-sig
 		// We received an interrupt signal, shut down.
-		log.Info().Msg("got quit signal...")
-		ctx, cancel := context.WithTimeout(context.Background(), GracefulShutdownTimeout)
+		drain.Start()
+		log.Info().Dur("delay", cfg.DrainDelay).Msg("got quit signal, draining...")
+		time.Sleep(cfg.DrainDelay)
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.DrainTimeout)
+		defer cancel()
 
+		// New connections are refused from here on.
+		log.Info().Int64("in-flight", drain.InFlight()).Msg("waiting for requests in flight")
+		grpcStopped := make(chan struct{})
+		go func() {
+			if grpcSrv != nil {
+				grpcSrv.GracefulStop()
+			}
+			close(grpcStopped)
+		}()
 		if err := srv.Shutdown(ctx); err != nil {
 			// Error from closing listeners, or context timeout:
-			log.Error().Msgf("HTTP server Shutdown: %v", err)
+			log.Error().Int64("in-flight", drain.InFlight()).Msgf("HTTP server Shutdown: %v", err)
+			cancelRequests()
+		}
+		select {
+		case <-grpcStopped:
+		case <-ctx.Done():
+			if grpcSrv != nil {
+				grpcSrv.Stop()
+			}
 		}
-		cancel()
 		close(idleConnsClosed)
 	}()
 
@@ -81,3 +301,47 @@ func main() {
 	<-idleConnsClosed
 	log.Info().Msg("server gracefully shutting down")
 }
+
+// mountHTTPHandlers mounts the plain HTTP endpoints, which tenants can't
+// use. With API keys, they need a key like the Twirp services.
+func mountHTTPHandlers(mux *http.ServeMux, keys *apikeys.Keys, cfg *config.Config,
+	searchServer *searchserver.Server, wordSearchServer *searchserver.WordSearchServer,
+	anagramServer *anagramserver.Server) {
+
+	handle := func(pattern string, h http.Handler) {
+		mux.Handle(pattern, tenants.NotForTenants(requireKey(keys, h)))
+	}
+	handle("/plainsearch", plainTextHandler(wordSearchServer, anagramServer))
+	handle("/quizcards", searchserver.QuizCardsHandler(searchServer))
+	handle("/export", searchserver.ExportHandler(searchServer))
+	handle(searchserver.ValidityPrefix, searchserver.ValidityHandler(cfg))
+	handle(searchserver.RESTPrefix, searchserver.RESTHandler(searchServer, wordSearchServer))
+}
+
+// requireKey makes h require an API key, if there are any keys.
+func requireKey(keys *apikeys.Keys, h http.Handler) http.Handler {
+	if keys == nil {
+		return h
+	}
+	return keys.Middleware(h)
+}
+
+// loadAPIKeys returns the API keys that the server requires, from
+// the config and the keys file, or nil if there are none.
+func loadAPIKeys(cfg *config.Config) (*apikeys.Keys, error) {
+	if cfg.APIKeys == "" && cfg.APIKeysFile == "" {
+		return nil, nil
+	}
+	list, err := apikeys.Parse(cfg.APIKeys)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.APIKeysFile != "" {
+		fromFile, err := apikeys.ReadFile(cfg.APIKeysFile)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, fromFile...)
+	}
+	return apikeys.New(list, cfg.APIKeyRequestsPerMinute)
+}
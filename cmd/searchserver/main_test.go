@@ -0,0 +1,39 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/domino14/word_db_server/config"
+	"github.com/domino14/word_db_server/internal/anagramserver"
+	"github.com/domino14/word_db_server/internal/apikeys"
+	"github.com/domino14/word_db_server/internal/searchserver"
+)
+
+func TestHTTPHandlersRequireKey(t *testing.T) {
+	keys, err := apikeys.New([]*apikeys.Key{{Name: "app", Key: "s3cret"}}, -1)
+	assert.Nil(t, err)
+	cfg := &config.Config{DataPath: t.TempDir()}
+	mux := http.NewServeMux()
+	mountHTTPHandlers(mux, keys, cfg, &searchserver.Server{Config: cfg},
+		&searchserver.WordSearchServer{Config: cfg}, &anagramserver.Server{})
+
+	for _, path := range []string{"/export", "/plainsearch", "/quizcards",
+		searchserver.ValidityPrefix, searchserver.RESTPrefix} {
+
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+		assert.Equal(t, http.StatusUnauthorized, w.Code, path)
+	}
+
+	// With the key, the request gets through to the handler, which
+	// rejects it for having no search.
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/export", nil)
+	req.Header.Set("Authorization", "Bearer s3cret")
+	mux.ServeHTTP(w, req)
+	assert.NotEqual(t, http.StatusUnauthorized, w.Code)
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/namsral/flag"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/domino14/word_db_server/internal/zyzzyva"
+)
+
+// zyzzyvaimport converts Zyzzyva's files for this server. Given a saved
+// word list, it prints the JSON of a search for its alphagrams, in order,
+// to send to QuestionSearcher/Search; given a lexicon text file, it writes
+// a word list for dbmaker.
+func main() {
+	var list, lexicon, lexiconFile, out string
+	var expand bool
+	fs := flag.NewFlagSet("zyzzyvaimport", flag.ExitOnError)
+	fs.StringVar(&list, "list", "", "Zyzzyva word list to turn into a search")
+	fs.StringVar(&lexicon, "lexicon", "", "lexicon to search the list in")
+	fs.BoolVar(&expand, "expand", true, "whether the search expands the alphagrams")
+	fs.StringVar(&lexiconFile, "lexicon-file", "", "Zyzzyva lexicon text file to convert")
+	fs.StringVar(&out, "out", "", "where to write the dbmaker word list")
+	fs.Parse(os.Args[1:])
+
+	switch {
+	case list != "":
+		if lexicon == "" {
+			log.Fatal().Msg("-lexicon is required with -list")
+		}
+		f, err := os.Open(list)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not open list")
+		}
+		entries, err := zyzzyva.ReadList(f)
+		f.Close()
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not read list")
+		}
+		bts, err := protojson.Marshal(zyzzyva.ListSearch(lexicon, entries, expand))
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not encode search")
+		}
+		fmt.Println(string(bts))
+
+	case lexiconFile != "":
+		if out == "" {
+			log.Fatal().Msg("-out is required with -lexicon-file")
+		}
+		f, err := os.Open(lexiconFile)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not open lexicon file")
+		}
+		entries, err := zyzzyva.ReadLexicon(f)
+		f.Close()
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not read lexicon file")
+		}
+		w, err := os.Create(out)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not create word list")
+		}
+		if err := zyzzyva.WriteWordList(w, entries); err != nil {
+			log.Fatal().Err(err).Msg("could not write word list")
+		}
+		if err := w.Close(); err != nil {
+			log.Fatal().Err(err).Msg("could not write word list")
+		}
+		fmt.Printf("wrote %d words to %s\n", len(entries), out)
+
+	default:
+		log.Fatal().Msg("give -list or -lexicon-file")
+	}
+}
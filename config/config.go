@@ -1,12 +1,118 @@
 package config
 
 import (
+	"strings"
+	"time"
+
 	"github.com/namsral/flag"
 )
 
 type Config struct {
 	DataPath string
 	LogLevel string
+	// DemoMode serves a restricted, rate-limited QuestionSearcher only.
+	DemoMode              bool
+	DemoRequestsPerMinute int
+	// TrustedProxies are the comma-separated addresses and CIDR ranges of
+	// the proxies in front of the server, whose X-Forwarded-For headers
+	// the demo rate limit believes.
+	TrustedProxies string
+	// SearchRecordPath, if set, is a file that search requests get
+	// appended to, for replaying with searchbench.
+	SearchRecordPath string
+	// AdminToken is the bearer token for the Admin service. The service
+	// is not served at all if this is empty. It's left out of the config
+	// that gets logged.
+	AdminToken string `json:"-"`
+	// SnapshotTTL is how long a pinned snapshot lives without being used.
+	SnapshotTTL time.Duration
+	// GRPCAddr, if set, is the address to also serve the QuestionSearcher
+	// over gRPC on.
+	GRPCAddr string
+	// DBCacheMaxHandles is how many lexicon databases the searcher keeps
+	// open between requests; 0 opens one per request.
+	DBCacheMaxHandles int
+	// DBCacheIdleTimeout is how long a cached database stays open unused.
+	DBCacheIdleTimeout time.Duration
+	// TenantsFile, if set, is a JSON file listing the tenants and the
+	// lexica each can use. See the tenants package.
+	TenantsFile string
+	// WALAutoCheckpoint is the wal_autocheckpoint, in pages, for
+	// connections that write to lexicon databases in WAL mode. 0 leaves
+	// SQLite's default, and a negative value turns automatic checkpoints
+	// off.
+	WALAutoCheckpoint int
+	// WALCheckpointInterval, if set, is how often the server checkpoints
+	// the lexicon databases that have a WAL, with WALCheckpointMode.
+	WALCheckpointInterval time.Duration
+	WALCheckpointMode     string
+	// ExpandOnly serves only the QuestionSearcher's Expand, so that
+	// expansion can be scaled separately from search.
+	ExpandOnly bool
+	// ExpandCacheSize is how many expanded alphagrams to keep between
+	// requests; 0 keeps none, except in expand-only mode, which has a
+	// large default.
+	ExpandCacheSize int
+	// AlphagramIndexLexica are the comma-separated hot lexica whose
+	// alphagrams are kept in memory, for searching them by length,
+	// probability or alphagram without the database.
+	AlphagramIndexLexica string
+	// AlphagramIndexMemoryMB is roughly how much memory the hot lexica may
+	// take; the least recently searched is dropped to stay under it.
+	AlphagramIndexMemoryMB int
+	// CardboxStore, if set, is where the QuizScheduler keeps cardboxes:
+	// a postgres:// DSN, or a directory for a SQLite database per user.
+	// The service isn't served otherwise. It's left out of the config that
+	// gets logged, as a DSN may have a password.
+	CardboxStore string `json:"-"`
+	// CardboxRetention is how long a deleted cardbox can be restored for,
+	// before it's purged.
+	CardboxRetention time.Duration
+	// WordDBDSN, if set, is a postgres:// DSN of a database that the
+	// lexica have been loaded into, to search instead of the SQLite files
+	// in the data path. See the wordstore package. It's left out of the
+	// config that gets logged.
+	WordDBDSN string `json:"-"`
+	// CompressionMinSize is the size from which HTTP responses are
+	// compressed, for clients that accept zstd or gzip. A negative size
+	// turns compression off.
+	CompressionMinSize int
+	// TranslationsFile, if set, is a JSON file of translations of the
+	// lexicon symbols' labels and the other text the API returns, by
+	// locale. See the localize package.
+	TranslationsFile string
+	// NoDefinitionLexica is a comma-separated list of lexica whose
+	// definitions mustn't be given out, as their licenses don't allow it.
+	// Their words are served without definitions, and they can't be
+	// searched by definition.
+	NoDefinitionLexica string
+	// SearchTimeout is the longest a search or expansion may take, on top
+	// of the client's own deadline; 0 leaves only the client's.
+	SearchTimeout time.Duration
+	// DrainDelay is how long the server keeps serving after it's told to
+	// quit, with /readyz failing, so that load balancers can stop sending
+	// it requests. DrainTimeout is how long it then waits for the requests
+	// in flight to finish before cutting them off.
+	DrainDelay   time.Duration
+	DrainTimeout time.Duration
+	// MaxSearchResults is the most alphagrams a search returns; the rest
+	// are cut off and the response is marked truncated. 0 is no limit.
+	MaxSearchResults int
+	// RequestLogLevel is the level that RPCs are logged at, or disabled
+	// for none. RequestLogSample is n to log only 1 in n successful RPCs;
+	// failed ones are always logged. See the reqlog package.
+	RequestLogLevel  string
+	RequestLogSample int
+	// APIKeys and APIKeysFile, if either is set, are the API keys that
+	// requests to the Twirp services must have: a comma-separated list of
+	// name:key pairs, and a JSON file of keys. See the apikeys package.
+	// APIKeys is left out of the config that gets logged.
+	APIKeys     string `json:"-"`
+	APIKeysFile string
+	// APIKeyRequestsPerMinute is how many requests a minute each API key
+	// may make, unless the keys file gives it its own limit. 0 is no
+	// limit.
+	APIKeyRequestsPerMinute int
 }
 
 // Load loads the configs from the given arguments
@@ -14,6 +120,80 @@ func (c *Config) Load(args []string) error {
 	fs := flag.NewFlagSet("wdb-server", flag.ContinueOnError)
 	fs.StringVar(&c.DataPath, "wdb-data-path", "", "data path")
 	fs.StringVar(&c.LogLevel, "log-level", "debug", "log level")
+	fs.BoolVar(&c.DemoMode, "demo-mode", false, "run as a rate-limited public demo")
+	fs.IntVar(&c.DemoRequestsPerMinute, "demo-requests-per-minute", 30,
+		"requests per minute allowed per client IP in demo mode")
+	fs.StringVar(&c.TrustedProxies, "trusted-proxies", "",
+		"comma-separated addresses and CIDR ranges of proxies whose X-Forwarded-For is trusted")
+	fs.StringVar(&c.SearchRecordPath, "search-record-path", "",
+		"if set, append all search requests to this file")
+	fs.StringVar(&c.AdminToken, "admin-token", "",
+		"bearer token for the admin service; the service is disabled if empty")
+	fs.DurationVar(&c.SnapshotTTL, "snapshot-ttl", 10*time.Minute,
+		"how long a pinned search snapshot lives without being used")
+	fs.StringVar(&c.GRPCAddr, "grpc-addr", "",
+		"if set, also serve the question searcher over gRPC on this address (e.g. :8181)")
+	fs.IntVar(&c.DBCacheMaxHandles, "db-cache-max-handles", 16,
+		"how many lexicon databases to keep open between searches (0 to disable)")
+	fs.DurationVar(&c.DBCacheIdleTimeout, "db-cache-idle-timeout", 5*time.Minute,
+		"how long an unused lexicon database is kept open")
+	fs.StringVar(&c.TenantsFile, "tenants-file", "",
+		"JSON file of tenants, with their URL prefixes, API keys and lexica")
+	fs.IntVar(&c.WALAutoCheckpoint, "wal-autocheckpoint", 0,
+		"WAL size in pages that triggers a checkpoint after a write (0 for SQLite's default, negative for never)")
+	fs.DurationVar(&c.WALCheckpointInterval, "wal-checkpoint-interval", 0,
+		"if set, how often to checkpoint lexicon databases that have a WAL")
+	fs.StringVar(&c.WALCheckpointMode, "wal-checkpoint-mode", "passive",
+		"the mode of the periodic checkpoints: passive, full, restart or truncate")
+	fs.BoolVar(&c.ExpandOnly, "expand-only", false,
+		"only serve Expand, with a large expansion cache")
+	fs.IntVar(&c.ExpandCacheSize, "expand-cache-size", 0,
+		"how many expanded alphagrams to cache (0 for none, or a large default in expand-only mode)")
+	fs.StringVar(&c.AlphagramIndexLexica, "alphagram-index-lexica", "",
+		"comma-separated lexica to keep in memory for searches by length, probability or alphagram")
+	fs.IntVar(&c.AlphagramIndexMemoryMB, "alphagram-index-memory-mb", 512,
+		"roughly how many MB the in-memory lexica may take")
+	fs.StringVar(&c.CardboxStore, "cardbox-store", "",
+		"postgres:// DSN or directory to keep quiz cardboxes in; the quiz scheduler is disabled if empty")
+	fs.DurationVar(&c.CardboxRetention, "cardbox-retention", 30*24*time.Hour,
+		"how long a deleted cardbox can be restored for, before it's purged")
+	fs.StringVar(&c.WordDBDSN, "word-db-dsn", "",
+		"if set, a postgres:// DSN to search the lexica in, instead of the SQLite files in the data path")
+	fs.IntVar(&c.CompressionMinSize, "compression-min-size", 1024,
+		"compress responses of at least this many bytes with zstd or gzip, if the client accepts them (negative for never)")
+	fs.StringVar(&c.TranslationsFile, "translations-file", "",
+		"JSON file of translations, by locale, of the lexicon symbols and other text in responses")
+	fs.StringVar(&c.NoDefinitionLexica, "no-definition-lexica", "",
+		"comma-separated lexica whose definitions must not be served")
+	fs.DurationVar(&c.SearchTimeout, "search-timeout", 30*time.Second,
+		"the longest a search or expansion may run (0 for no limit)")
+	fs.DurationVar(&c.DrainDelay, "drain-delay", 0,
+		"how long to keep serving, with /readyz failing, after SIGTERM")
+	fs.DurationVar(&c.DrainTimeout, "drain-timeout", 10*time.Second,
+		"how long to wait for requests in flight to finish when shutting down")
+	fs.IntVar(&c.MaxSearchResults, "max-search-results", 100000,
+		"the most alphagrams a search returns before it's truncated (0 for no limit)")
+	fs.StringVar(&c.RequestLogLevel, "request-log-level", "info",
+		"the level to log each RPC at (e.g. info or debug), or disabled")
+	fs.IntVar(&c.RequestLogSample, "request-log-sample", 1,
+		"log 1 in this many successful RPCs; failed ones are always logged")
+	fs.StringVar(&c.APIKeys, "api-keys", "",
+		"comma-separated name:key API keys that the server requires")
+	fs.StringVar(&c.APIKeysFile, "api-keys-file", "",
+		"JSON file of API keys that the server requires, with their rate limits")
+	fs.IntVar(&c.APIKeyRequestsPerMinute, "api-key-requests-per-minute", 300,
+		"requests per minute allowed per API key, unless the keys file says otherwise (0 for no limit)")
 	err := fs.Parse(args)
 	return err
 }
+
+// DefinitionsAllowed returns whether the lexicon's definitions may be
+// served, that is whether it's not one of the NoDefinitionLexica.
+func (c *Config) DefinitionsAllowed(lexicon string) bool {
+	for _, l := range strings.Split(c.NoDefinitionLexica, ",") {
+		if l = strings.TrimSpace(l); l != "" && strings.EqualFold(l, lexicon) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,84 @@
+package dbmaker
+
+import (
+	"database/sql"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// displayTiles splits a display alphagram, like A[CH]O[RR], into its
+// tiles. Tiles of more than one letter are the bracketed ones, so LL is
+// two L tiles and [LL] is one LL tile.
+func displayTiles(display string) []string {
+	tiles := []string{}
+	runes := []rune(display)
+	for i := 0; i < len(runes); i++ {
+		if runes[i] == '[' {
+			end := i + 1
+			for end < len(runes) && runes[end] != ']' {
+				end++
+			}
+			tiles = append(tiles, string(runes[i+1:end]))
+			i = end
+			continue
+		}
+		tiles = append(tiles, string(runes[i]))
+	}
+	return tiles
+}
+
+// blankAnagramCounts returns, for every alphagram that's one tile short of
+// one of the given alphagrams, the number of words it makes with a blank.
+// The alphagrams are given in display form, with their number of words;
+// the ones returned are as in the alphagram column, without brackets.
+func blankAnagramCounts(numAnagrams map[string]int) map[string]int {
+	counts := map[string]int{}
+	for display, n := range numAnagrams {
+		tiles := displayTiles(display)
+		for i, t := range tiles {
+			if i > 0 && tiles[i-1] == t {
+				// Taking out either of two of the same tile leaves the
+				// same alphagram.
+				continue
+			}
+			// The tiles that are left are still in order.
+			counts[strings.Join(tiles[:i], "")+strings.Join(tiles[i+1:], "")] += n
+		}
+	}
+	return counts
+}
+
+// loadBlankAnagrams sets num_blank_anagrams for every alphagram: how many
+// of the words one tile longer can be made from it and a blank. It's
+// worked out from the alphagrams already in the database, so it has to
+// run after they're all there.
+func loadBlankAnagrams(db *sql.DB) {
+	rows, err := db.Query(`SELECT display_alphagram, num_anagrams FROM alphagrams`)
+	exitIfError(err)
+	numAnagrams := map[string]int{}
+	for rows.Next() {
+		var display string
+		var n int
+		exitIfError(rows.Scan(&display, &n))
+		numAnagrams[display] = n
+	}
+	exitIfError(rows.Err())
+	rows.Close()
+	counts := blankAnagramCounts(numAnagrams)
+
+	tx, err := db.Begin()
+	exitIfError(err)
+	_, err = tx.Exec(`UPDATE alphagrams SET num_blank_anagrams = 0`)
+	exitIfError(err)
+	stmt, err := tx.Prepare(`UPDATE alphagrams SET num_blank_anagrams = ? WHERE alphagram = ?`)
+	exitIfError(err)
+	for alph, n := range counts {
+		_, err := stmt.Exec(n, alph)
+		exitIfError(err)
+	}
+	stmt.Close()
+	exitIfError(tx.Commit())
+	setCapability(db, CapabilityBlankAnagrams, true)
+	log.Info().Int("alphagrams", len(counts)).Msg("counted blank anagrams")
+}
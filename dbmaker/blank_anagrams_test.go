@@ -0,0 +1,53 @@
+package dbmaker
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisplayTiles(t *testing.T) {
+	assert.Equal(t, []string{"A", "CH", "O", "RR"}, displayTiles("A[CH]O[RR]"))
+	assert.Equal(t, []string{"A", "L", "L", "LL"}, displayTiles("ALL[LL]"))
+	assert.Equal(t, []string{}, displayTiles(""))
+}
+
+func TestLoadBlankAnagrams(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.Nil(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+	_, err = db.Exec(`
+	CREATE TABLE alphagrams (alphagram varchar(20), display_alphagram varchar(40),
+		num_anagrams int, num_blank_anagrams int);
+	INSERT INTO alphagrams VALUES
+		('AET', 'AET', 3, NULL),
+		('AEST', 'AEST', 4, NULL),
+		('AETT', 'AETT', 1, NULL),
+		('ACHO', 'A[CH]O', 1, NULL),
+		('ACHOS', 'A[CH]OS', 1, NULL),
+		('ST', 'ST', 1, NULL);
+	`)
+	assert.Nil(t, err)
+
+	loadBlankAnagrams(db)
+	got := map[string]int{}
+	rows, err := db.Query(`SELECT alphagram, num_blank_anagrams FROM alphagrams`)
+	assert.Nil(t, err)
+	for rows.Next() {
+		var a string
+		var n int
+		assert.Nil(t, rows.Scan(&a, &n))
+		got[a] = n
+	}
+	rows.Close()
+	// AET+? makes the four words of AEST and the one of AETT, once.
+	assert.Equal(t, map[string]int{
+		"AET": 5, "AEST": 0, "AETT": 0, "ACHO": 1, "ACHOS": 0, "ST": 0,
+	}, got)
+	var enabled bool
+	assert.Nil(t, db.QueryRow(`SELECT enabled FROM capabilities WHERE name = ?`,
+		CapabilityBlankAnagrams).Scan(&enabled))
+	assert.True(t, enabled)
+}
@@ -0,0 +1,261 @@
+package dbmaker
+
+import (
+	"database/sql"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/domino14/word-golib/kwg"
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/word_db_server/internal/common"
+)
+
+const (
+	// buildChunkSize is how many alphagrams a worker builds at a time.
+	buildChunkSize = 1000
+	// insertBatchSize is how many rows go into a single INSERT statement.
+	// It must keep rows * columns under SQLite's bound parameter limit.
+	insertBatchSize = 200
+)
+
+// builtWord is a words table row.
+type builtWord struct {
+	word, lexSymbols, definition, frontHooks, backHooks, sources string
+	frontInnerHook, backInnerHook                                int
+}
+
+// builtAlphagram has everything about an alphagram that doesn't depend on
+// the alphagrams before it. Probabilities are assigned in order when the
+// rows are written.
+type builtAlphagram struct {
+	alph           *Alphagram
+	numVowels      int
+	pointValue     int
+	uniqToLexSplit uint8
+	updateToLex    uint8
+	difficulty     sql.NullInt32
+	playability    sql.NullInt32
+	display        string
+	words          []builtWord
+}
+
+// alphagramBuilder builds the rows for alphagrams. Everything it reads is
+// read-only once it is set up, so it is safe to use from several
+// goroutines.
+type alphagramBuilder struct {
+	lexiconInfo *LexiconInfo
+	definitions map[string]string
+	symbols     *symbolFinder
+	// parent is the lexicon a custom lexicon's words get their symbols
+	// from. Words that aren't in it get none.
+	parent *LexiconInfo
+	// words are the lexicon's words, for finding hooks without a KWG.
+	words map[string]bool
+}
+
+// useParent sets up the builder to give a custom lexicon's words the
+// lexicon symbols they have in its parent lexicon.
+func (b *alphagramBuilder) useParent(lexMap LexiconMap) {
+	b.symbols = nil
+	if b.lexiconInfo.Parent == "" {
+		return
+	}
+	parent, err := lexMap.GetLexiconInfo(b.lexiconInfo.Parent)
+	if err != nil || parent.KWG == nil {
+		log.Warn().Str("parent", b.lexiconInfo.Parent).
+			Msg("parent lexicon not found; words will have no lexicon symbols")
+		return
+	}
+	b.parent = parent
+	family, err := lexMap.familyName(parent.LexiconName)
+	exitIfError(err)
+	priorLex, _ := lexMap.priorLexicon(family, parent.LexiconName)
+	b.symbols = newSymbolFinder(lexMap, parent, priorLex)
+}
+
+func (b *alphagramBuilder) lexSymbols(word string) string {
+	if b.lexiconInfo.Custom && (b.parent == nil || !kwg.FindWord(b.parent.KWG, word)) {
+		return ""
+	}
+	return b.symbols.find(word)
+}
+
+func (b *alphagramBuilder) hooks(wordML tilemapping.MachineWord) (
+	frontHooks, backHooks string, frontInnerHook, backInnerHook int) {
+
+	if b.words == nil {
+		return wordHooks(b.lexiconInfo, wordML)
+	}
+	return wordSetHooks(b.words, b.lexiconInfo.LetterDistribution.TileMapping(), wordML)
+}
+
+func (b *alphagramBuilder) build(alph *Alphagram) builtAlphagram {
+	dist := b.lexiconInfo.LetterDistribution
+	built := builtAlphagram{alph: alph, words: make([]builtWord, 0, len(alph.words))}
+	lexSymbolsList := make([]string, 0, len(alph.words))
+	for _, word := range alph.words {
+		wordML, err := tilemapping.ToMachineLetters(word, dist.TileMapping())
+		exitIfError(err)
+		bw := builtWord{
+			word:       word,
+			definition: b.definitions[word],
+			lexSymbols: b.lexSymbols(word),
+			sources:    b.lexiconInfo.Sources[word],
+		}
+		bw.frontHooks, bw.backHooks, bw.frontInnerHook, bw.backInnerHook =
+			b.hooks(wordML)
+		built.words = append(built.words, bw)
+		lexSymbolsList = append(lexSymbolsList, bw.lexSymbols)
+	}
+	built.numVowels = alph.numVowels(dist)
+	built.pointValue = alph.pointValue(dist)
+	built.uniqToLexSplit, built.updateToLex = b.symbols.flags(lexSymbolsList)
+	built.difficulty = alphagramDifficulty(alph.alphagram, b.lexiconInfo.Difficulties,
+		built.updateToLex == uint8(1))
+	built.playability = alphagramPlayability(alph.words, b.lexiconInfo.Playabilities)
+	built.display = common.DisplayMachineWord(alph.mls, dist)
+	return built
+}
+
+// buildInOrder builds the alphagrams on a pool of workers, and calls write
+// with each chunk of results in the same order as alphs. write is only
+// ever called from the calling goroutine.
+func (b *alphagramBuilder) buildInOrder(alphs []Alphagram, workers int,
+	write func([]builtAlphagram)) {
+
+	if workers < 1 {
+		workers = runtime.NumCPU()
+	}
+	numChunks := (len(alphs) + buildChunkSize - 1) / buildChunkSize
+	results := make([]chan []builtAlphagram, numChunks)
+	for i := range results {
+		results[i] = make(chan []builtAlphagram, 1)
+	}
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range jobs {
+				end := min((c+1)*buildChunkSize, len(alphs))
+				chunk := make([]builtAlphagram, 0, end-c*buildChunkSize)
+				for i := c * buildChunkSize; i < end; i++ {
+					chunk = append(chunk, b.build(&alphs[i]))
+				}
+				results[c] <- chunk
+			}
+		}()
+	}
+	go func() {
+		for c := 0; c < numChunks; c++ {
+			jobs <- c
+		}
+		close(jobs)
+	}()
+	for c := 0; c < numChunks; c++ {
+		write(<-results[c])
+	}
+	wg.Wait()
+}
+
+// batchInserter collects rows and inserts them insertBatchSize at a time
+// with multi-row INSERT statements.
+type batchInserter struct {
+	tx      *sql.Tx
+	prefix  string
+	numCols int
+	args    []any
+	full    *sql.Stmt
+}
+
+func newBatchInserter(tx *sql.Tx, table string, columns []string) *batchInserter {
+	return &batchInserter{
+		tx:      tx,
+		prefix:  fmt.Sprintf("INSERT INTO %s (%s) VALUES ", table, strings.Join(columns, ", ")),
+		numCols: len(columns),
+	}
+}
+
+func (bi *batchInserter) query(rows int) string {
+	placeholders := "(" + strings.TrimSuffix(strings.Repeat("?, ", bi.numCols), ", ") + ")"
+	return bi.prefix + strings.TrimSuffix(strings.Repeat(placeholders+", ", rows), ", ")
+}
+
+// Add adds a row. It returns an error if the row had to be flushed and
+// the insert failed.
+func (bi *batchInserter) Add(row ...any) error {
+	if len(row) != bi.numCols {
+		return fmt.Errorf("expected %d columns, got %d", bi.numCols, len(row))
+	}
+	bi.args = append(bi.args, row...)
+	if len(bi.args) < insertBatchSize*bi.numCols {
+		return nil
+	}
+	if bi.full == nil {
+		stmt, err := bi.tx.Prepare(bi.query(insertBatchSize))
+		if err != nil {
+			return err
+		}
+		bi.full = stmt
+	}
+	_, err := bi.full.Exec(bi.args...)
+	bi.args = bi.args[:0]
+	return err
+}
+
+// Flush inserts any remaining rows and closes the prepared statement.
+func (bi *batchInserter) Flush() error {
+	defer func() {
+		if bi.full != nil {
+			bi.full.Close()
+			bi.full = nil
+		}
+	}()
+	if len(bi.args) == 0 {
+		return nil
+	}
+	_, err := bi.tx.Exec(bi.query(len(bi.args)/bi.numCols), bi.args...)
+	bi.args = bi.args[:0]
+	return err
+}
+
+// wordSet returns all the words of the alphagrams.
+func wordSet(alphs []Alphagram) map[string]bool {
+	words := map[string]bool{}
+	for _, a := range alphs {
+		for _, w := range a.words {
+			words[w] = true
+		}
+	}
+	return words
+}
+
+// wordSetHooks is like wordHooks, but looks the hooks up in a set of words
+// instead of a KWG.
+func wordSetHooks(words map[string]bool, tm *tilemapping.TileMapping, wordML tilemapping.MachineWord) (
+	frontHooks, backHooks string, frontInnerHook, backInnerHook int) {
+
+	word := wordML.UserVisible(tm)
+	var front, back strings.Builder
+	for ml := tilemapping.MachineLetter(1); ml < tilemapping.MachineLetter(tm.NumLetters()); ml++ {
+		letter := tm.Letter(ml)
+		if words[letter+word] {
+			front.WriteString(letter)
+		}
+		if words[word+letter] {
+			back.WriteString(letter)
+		}
+	}
+	if words[wordML[1:].UserVisible(tm)] {
+		frontInnerHook = 1
+	}
+	if words[wordML[:len(wordML)-1].UserVisible(tm)] {
+		backInnerHook = 1
+	}
+	return front.String(), back.String(), frontInnerHook, backInnerHook
+}
@@ -0,0 +1,246 @@
+package dbmaker
+
+import (
+	"database/sql"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/domino14/word_db_server/internal/common"
+)
+
+func TestBuildInOrder(t *testing.T) {
+	ld, err := tilemapping.ScanLetterDistribution(strings.NewReader(`?,2,0,0
+A,9,1,1
+B,2,3,0
+C,2,3,0
+`))
+	assert.Nil(t, err)
+	// No words, so the builder doesn't need a KWG.
+	alphs := []Alphagram{}
+	for i := 0; i < buildChunkSize*3+17; i++ {
+		alphs = append(alphs, Alphagram{
+			alphagram: fmt.Sprintf("%d", i),
+			mls:       tilemapping.MachineWord{1, 2, 3}})
+	}
+	b := &alphagramBuilder{lexiconInfo: &LexiconInfo{LetterDistribution: ld}}
+	seen := 0
+	b.buildInOrder(alphs, 4, func(chunk []builtAlphagram) {
+		for _, built := range chunk {
+			assert.Equal(t, fmt.Sprintf("%d", seen), built.alph.alphagram)
+			assert.Equal(t, 7, built.pointValue)
+			assert.Equal(t, 1, built.numVowels)
+			assert.Equal(t, "ABC", built.display)
+			seen++
+		}
+	})
+	assert.Equal(t, len(alphs), seen)
+}
+
+func TestBatchInserter(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.Nil(t, err)
+	defer db.Close()
+	_, err = db.Exec(`CREATE TABLE foo (a int, b varchar(10))`)
+	assert.Nil(t, err)
+
+	tx, err := db.Begin()
+	assert.Nil(t, err)
+	bi := newBatchInserter(tx, "foo", []string{"a", "b"})
+	assert.NotNil(t, bi.Add(1))
+	n := insertBatchSize*2 + 3
+	for i := 0; i < n; i++ {
+		assert.Nil(t, bi.Add(i, fmt.Sprintf("row%d", i)))
+	}
+	assert.Nil(t, bi.Flush())
+	assert.Nil(t, tx.Commit())
+
+	var count, sum int
+	assert.Nil(t, db.QueryRow(`SELECT COUNT(*), SUM(a) FROM foo`).Scan(&count, &sum))
+	assert.Equal(t, n, count)
+	assert.Equal(t, n*(n-1)/2, sum)
+	var b string
+	assert.Nil(t, db.QueryRow(`SELECT b FROM foo WHERE a = ?`, n-1).Scan(&b))
+	assert.Equal(t, fmt.Sprintf("row%d", n-1), b)
+}
+
+func TestCustomLexiconBuild(t *testing.T) {
+	dataPath := t.TempDir()
+	assert.Nil(t, os.MkdirAll(filepath.Join(dataPath, "lexica"), 0755))
+	assert.Nil(t, os.MkdirAll(filepath.Join(dataPath, "letterdistributions"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(dataPath, "letterdistributions", "tiny"),
+		[]byte("?,2,0,0\nA,9,1,1\nE,12,1,1\nS,4,1,0\nT,6,1,0\n"), 0644))
+	assert.Nil(t, os.WriteFile(filepath.Join(dataPath, "lexica", "vocab.txt"),
+		[]byte("at\nta a word\nate\neat\ntea\neats\nseat\nsat\n"), 0644))
+	assert.Nil(t, os.WriteFile(filepath.Join(dataPath, "lexica", common.CustomLexicaFile),
+		[]byte(`[{"name": "VOCAB", "file": "vocab.txt", "letter_distribution": "tiny",
+			"parent": "NOPE"}]`), 0644))
+
+	family := customLexica(dataPath)
+	assert.Equal(t, 1, len(family))
+	info := family[0]
+	assert.True(t, info.Custom)
+	assert.Equal(t, "VOCAB", info.DescriptiveName)
+	assert.Nil(t, info.KWG)
+	info.Initialize()
+
+	lexMap := LexiconMap{FamilyCustom: family}
+	_, err := lexMap.priorLexicon(FamilyCustom, "VOCAB")
+	assert.NotNil(t, err)
+
+	defs, alphagrams, err := populateAlphsDefs(info.LexiconFilename, info.MachineWordCombinations,
+		info.LetterDistribution)
+	assert.Nil(t, err)
+	alphs := alphaMapValues(alphagrams)
+	b := &alphagramBuilder{lexiconInfo: info, definitions: defs, words: wordSet(alphs)}
+	// The parent isn't there, so the words get no symbols.
+	b.useParent(lexMap)
+	assert.Nil(t, b.parent)
+
+	built := map[string]builtWord{}
+	for i := range alphs {
+		for _, w := range b.build(&alphs[i]).words {
+			built[w.word] = w
+		}
+	}
+	assert.Equal(t, builtWord{word: "AT", frontHooks: "ES", backHooks: "E"}, built["AT"])
+	assert.Equal(t, builtWord{word: "TA", definition: "a word"}, built["TA"])
+	assert.Equal(t, builtWord{word: "EAT", frontHooks: "S", backHooks: "S", frontInnerHook: 1}, built["EAT"])
+	assert.Equal(t, builtWord{word: "SEAT", frontInnerHook: 1}, built["SEAT"])
+	assert.Equal(t, builtWord{word: "EATS", backInnerHook: 1}, built["EATS"])
+}
+
+func TestAddWordList(t *testing.T) {
+	dataPath := t.TempDir()
+	assert.Nil(t, os.MkdirAll(filepath.Join(dataPath, "letterdistributions"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(dataPath, "letterdistributions", "tiny"),
+		[]byte("?,2,0,0\nA,9,1,1\nE,12,1,1\nS,4,1,0\nT,6,1,0\n"), 0644))
+	lexMap := LexiconMap{FamilyCSW: {{LexiconName: "CSW19"}, {LexiconName: "CSW21"}}}
+
+	list := &common.CustomLexicon{Name: "MINE", File: "/tmp/mine.txt", LetterDistribution: "tiny"}
+	assert.Nil(t, lexMap.AddWordList(dataPath, list, FamilyCSW))
+	info, err := lexMap.GetLexiconInfo("MINE")
+	assert.Nil(t, err)
+	assert.True(t, info.Custom)
+	assert.Equal(t, "CSW21", info.Parent)
+	assert.Equal(t, "/tmp/mine.txt", info.LexiconFilename)
+
+	assert.NotNil(t, lexMap.AddWordList(dataPath, list, ""))
+	assert.NotNil(t, lexMap.AddWordList(dataPath, &common.CustomLexicon{
+		Name: "OTHER", File: "other.txt", LetterDistribution: "tiny"}, "NOPE"))
+	assert.NotNil(t, lexMap.AddWordList(dataPath, &common.CustomLexicon{
+		Name: "OTHER", File: "other.txt", LetterDistribution: "huge"}, ""))
+}
+
+// benchmarkBuilder returns the alphagrams of 20,000 made-up words and a
+// builder for them that finds hooks in the word list, as custom lexica do.
+func benchmarkBuilder(b *testing.B) (*alphagramBuilder, []Alphagram) {
+	dist := "?,2,0,0\n"
+	for l := 'A'; l <= 'Z'; l++ {
+		dist += fmt.Sprintf("%c,4,1,%d\n", l, map[bool]int{true: 1}[strings.ContainsRune("AEIOU", l)])
+	}
+	ld, err := tilemapping.ScanLetterDistribution(strings.NewReader(dist))
+	assert.Nil(b, err)
+	info := &LexiconInfo{LexiconName: "BENCH", LetterDistribution: ld}
+	info.Initialize()
+
+	rng := rand.New(rand.NewSource(1))
+	var words strings.Builder
+	for i := 0; i < 20000; i++ {
+		for j := 2 + rng.Intn(7); j > 0; j-- {
+			words.WriteByte(byte('A' + rng.Intn(26)))
+		}
+		words.WriteString(" a made-up word\n")
+	}
+	lexFile := filepath.Join(b.TempDir(), "bench.txt")
+	assert.Nil(b, os.WriteFile(lexFile, []byte(words.String()), 0644))
+	defs, alphagrams, err := populateAlphsDefs(lexFile, info.MachineWordCombinations, ld)
+	assert.Nil(b, err)
+	alphs := alphaMapValues(alphagrams)
+	return &alphagramBuilder{lexiconInfo: info, definitions: defs, words: wordSet(alphs)}, alphs
+}
+
+// BenchmarkBuildAlphagrams compares building the rows one alphagram after
+// another, as the build used to, with building them on a pool of workers.
+func BenchmarkBuildAlphagrams(b *testing.B) {
+	builder, alphs := benchmarkBuilder(b)
+	for _, workers := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				builder.buildInOrder(alphs, workers, func([]builtAlphagram) {})
+			}
+		})
+	}
+}
+
+// BenchmarkInsertWords compares inserting the words table a row at a time
+// with a prepared statement, as the build used to, with batched inserts.
+func BenchmarkInsertWords(b *testing.B) {
+	builder, alphs := benchmarkBuilder(b)
+	rows := [][]any{}
+	builder.buildInOrder(alphs, 0, func(chunk []builtAlphagram) {
+		for _, built := range chunk {
+			for _, w := range built.words {
+				rows = append(rows, []any{w.word, built.alph.alphagram, w.lexSymbols,
+					w.definition, w.frontHooks, w.backHooks, w.frontInnerHook,
+					w.backInnerHook, w.sources})
+			}
+		}
+	})
+	columns := []string{"word", "alphagram", "lexicon_symbols", "definition", "front_hooks",
+		"back_hooks", "inner_front_hook", "inner_back_hook", "sources"}
+
+	insert := func(b *testing.B, add func(tx *sql.Tx) error) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			db, err := sql.Open("sqlite3", filepath.Join(b.TempDir(), "bench.db"))
+			assert.Nil(b, err)
+			_, err = db.Exec(`CREATE TABLE words (word varchar(20), alphagram varchar(20),
+				lexicon_symbols varchar(5), definition varchar(512), front_hooks varchar(26),
+				back_hooks varchar(26), inner_front_hook int, inner_back_hook int,
+				sources varchar(32))`)
+			assert.Nil(b, err)
+			b.StartTimer()
+			tx, err := db.Begin()
+			assert.Nil(b, err)
+			assert.Nil(b, add(tx))
+			assert.Nil(b, tx.Commit())
+			b.StopTimer()
+			db.Close()
+			b.StartTimer()
+		}
+	}
+	b.Run("prepared", func(b *testing.B) {
+		insert(b, func(tx *sql.Tx) error {
+			bi := newBatchInserter(tx, "words", columns)
+			stmt, err := tx.Prepare(bi.query(1))
+			if err != nil {
+				return err
+			}
+			defer stmt.Close()
+			for _, row := range rows {
+				if _, err := stmt.Exec(row...); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+	b.Run("batched", func(b *testing.B) {
+		insert(b, func(tx *sql.Tx) error {
+			bi := newBatchInserter(tx, "words", columns)
+			for _, row := range rows {
+				if err := bi.Add(row...); err != nil {
+					return err
+				}
+			}
+			return bi.Flush()
+		})
+	})
+}
@@ -0,0 +1,152 @@
+package dbmaker
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// DefaultBuildParallelism is how many lexica BuildAll builds at once if
+// it isn't told.
+const DefaultBuildParallelism = 2
+
+// BuildAllOptions are the options for BuildAll.
+type BuildAllOptions struct {
+	CreateOptions
+	// Parallel is how many lexica are built at once. 0 means
+	// DefaultBuildParallelism.
+	Parallel int
+	// Force rebuilds the databases that already exist, instead of
+	// skipping them.
+	Force bool
+}
+
+// BuildResult is what happened to one lexicon in BuildAll.
+type BuildResult struct {
+	Lexicon string
+	// Skipped is why the lexicon wasn't built, if it wasn't.
+	Skipped  string
+	Err      error
+	Duration time.Duration
+}
+
+// buildFailure is what exitIfError panics with while BuildAll runs, to be
+// recovered as the error of the lexicon being built.
+type buildFailure struct {
+	err error
+}
+
+// Buildable returns why the lexicon's database can't be built, or nil if
+// it can: a custom lexicon needs its word list, and any other its KWG.
+func (l *LexiconInfo) Buildable() error {
+	if l.Custom {
+		if _, err := os.Stat(l.LexiconFilename); err != nil {
+			return fmt.Errorf("word list was not supplied: %w", err)
+		}
+		return nil
+	}
+	if l.KWG == nil || l.KWG.GetAlphabet() == nil {
+		return errors.New("KWG was not supplied")
+	}
+	return nil
+}
+
+// BuildAll builds the database of every lexicon in the map into outputDir,
+// opts.Parallel at a time, and returns what happened to each, ordered by
+// family name and then as in the family. A lexicon failing to build
+// doesn't stop the others.
+//
+// Each database is built in a temporary file that is renamed over the old
+// one only once it is complete, so a failed build leaves the old database
+// as it was. The builds share the map's KWGs and lexicon infos, which they
+// only read, so each KWG is loaded once for all the lexica of its family
+// that use it. No other dbmaker work may run alongside BuildAll.
+func BuildAll(lexMap LexiconMap, outputDir string, opts BuildAllOptions) []BuildResult {
+	if opts.Parallel < 1 {
+		opts.Parallel = DefaultBuildParallelism
+	}
+	if opts.Workers < 1 {
+		opts.Workers = max(1, runtime.NumCPU()/opts.Parallel)
+	}
+
+	families := make([]string, 0, len(lexMap))
+	for f := range lexMap {
+		families = append(families, string(f))
+	}
+	sort.Strings(families)
+	results := []BuildResult{}
+	infos := []*LexiconInfo{}
+	for _, f := range families {
+		for _, info := range lexMap[FamilyName(f)] {
+			results = append(results, BuildResult{Lexicon: info.LexiconName})
+			infos = append(infos, info)
+		}
+	}
+
+	prevFatal := fatal
+	fatal = func(err error) { panic(buildFailure{err}) }
+	defer func() { fatal = prevFatal }()
+
+	sem := make(chan struct{}, opts.Parallel)
+	var wg sync.WaitGroup
+	for i, info := range infos {
+		res := &results[i]
+		if err := info.Buildable(); err != nil {
+			res.Skipped = err.Error()
+			continue
+		}
+		dbPath := filepath.Join(outputDir, info.LexiconName+".db")
+		if _, err := os.Stat(dbPath); err == nil && !opts.Force {
+			res.Skipped = "database exists; use -force to overwrite it"
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			start := time.Now()
+			res.Err = buildOne(info, lexMap, outputDir, opts.CreateOptions)
+			res.Duration = time.Since(start)
+			if res.Err != nil {
+				log.Err(res.Err).Str("lexicon", res.Lexicon).Msg("build failed")
+			} else {
+				log.Info().Str("lexicon", res.Lexicon).Dur("took", res.Duration).Msg("built")
+			}
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// buildOne builds a lexicon's database in a temporary file, then moves it
+// into place.
+func buildOne(info *LexiconInfo, lexMap LexiconMap, outputDir string,
+	opts CreateOptions) (err error) {
+
+	opts.buildPath = filepath.Join(outputDir, "."+info.LexiconName+".db.building")
+	defer func() {
+		if r := recover(); r != nil {
+			if f, ok := r.(buildFailure); ok {
+				err = f.err
+			} else {
+				err = fmt.Errorf("%v", r)
+			}
+		}
+		if err != nil {
+			removeSqliteFiles(opts.buildPath)
+		}
+	}()
+	info.Initialize()
+	if err := CreateLexiconDatabase(info.LexiconName, info, lexMap, outputDir, false, opts); err != nil {
+		return err
+	}
+	return os.Rename(opts.buildPath, filepath.Join(outputDir, info.LexiconName+".db"))
+}
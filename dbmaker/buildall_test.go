@@ -0,0 +1,83 @@
+//go:build sqlite_fts5
+
+package dbmaker
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/domino14/word_db_server/internal/common"
+)
+
+// TestBuildAll builds custom lexica, since they don't need KWGs. Building
+// needs FTS5, so it only runs with -tags sqlite_fts5.
+func TestBuildAll(t *testing.T) {
+	dataPath := t.TempDir()
+	outputDir := t.TempDir()
+	assert.Nil(t, os.MkdirAll(filepath.Join(dataPath, "lexica"), 0755))
+	assert.Nil(t, os.MkdirAll(filepath.Join(dataPath, "letterdistributions"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(dataPath, "letterdistributions", "tiny"),
+		[]byte("?,2,0,0\nA,9,1,1\nE,12,1,1\nS,4,1,0\nT,6,1,0\n"), 0644))
+	for name, words := range map[string]string{
+		"one.txt": "at\nta\neat\ntea\n",
+		"two.txt": "sat\nseat\n",
+		// Q isn't in the distribution.
+		"bad.txt": "at\nqat\n",
+	} {
+		assert.Nil(t, os.WriteFile(filepath.Join(dataPath, "lexica", name), []byte(words), 0644))
+	}
+	assert.Nil(t, os.WriteFile(filepath.Join(dataPath, "lexica", common.CustomLexicaFile),
+		[]byte(`[{"name": "ONE", "file": "one.txt", "letter_distribution": "tiny"},
+			{"name": "TWO", "file": "two.txt", "letter_distribution": "tiny"},
+			{"name": "BAD", "file": "bad.txt", "letter_distribution": "tiny"},
+			{"name": "GONE", "file": "gone.txt", "letter_distribution": "tiny"}]`), 0644))
+	// BAD has a database already, which a failed build must leave alone.
+	assert.Nil(t, os.WriteFile(filepath.Join(outputDir, "BAD.db"), []byte("old"), 0644))
+
+	lexMap := LexiconMap{FamilyCustom: customLexica(dataPath)}
+	storage := StorageOptions{JournalMode: "wal", PageSize: 8192, Synchronous: "off", Analyze: true}
+	results := BuildAll(lexMap, outputDir, BuildAllOptions{Parallel: 2, Force: true,
+		CreateOptions: CreateOptions{Storage: storage}})
+	byLexicon := map[string]BuildResult{}
+	for _, r := range results {
+		byLexicon[r.Lexicon] = r
+	}
+	assert.Equal(t, 4, len(results))
+	assert.Nil(t, byLexicon["ONE"].Err)
+	assert.Nil(t, byLexicon["TWO"].Err)
+	assert.NotNil(t, byLexicon["BAD"].Err)
+	assert.Contains(t, byLexicon["GONE"].Skipped, "word list was not supplied")
+
+	old, err := os.ReadFile(filepath.Join(outputDir, "BAD.db"))
+	assert.Nil(t, err)
+	assert.Equal(t, "old", string(old))
+	leftover, err := filepath.Glob(filepath.Join(outputDir, ".*.building"))
+	assert.Nil(t, err)
+	assert.Empty(t, leftover)
+
+	db, err := sql.Open("sqlite3", filepath.Join(outputDir, "TWO.db"))
+	assert.Nil(t, err)
+	defer db.Close()
+	var n int
+	assert.Nil(t, db.QueryRow(`SELECT COUNT(*) FROM words`).Scan(&n))
+	assert.Equal(t, 2, n)
+	var mode string
+	assert.Nil(t, db.QueryRow(`PRAGMA journal_mode`).Scan(&mode))
+	assert.Equal(t, "wal", mode)
+	assert.Nil(t, db.QueryRow(`PRAGMA page_size`).Scan(&n))
+	assert.Equal(t, 8192, n)
+	assert.Nil(t, db.QueryRow(`SELECT COUNT(*) FROM sqlite_stat1`).Scan(&n))
+	assert.NotZero(t, n)
+
+	// Without -force, the databases that are there are skipped.
+	results = BuildAll(lexMap, outputDir, BuildAllOptions{})
+	for _, r := range results {
+		if r.Lexicon == "ONE" {
+			assert.Contains(t, r.Skipped, "database exists")
+		}
+	}
+}
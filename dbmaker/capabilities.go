@@ -0,0 +1,58 @@
+package dbmaker
+
+import (
+	"database/sql"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Capabilities are the kinds of optional data a lexicon database may have.
+// Columns for missing data are left NULL, and the capabilities table
+// records what is actually there so the server can tell "no data" apart
+// from a real zero.
+const (
+	CapabilityDifficulty  = "difficulty"
+	CapabilityPlayability = "playability"
+	CapabilityDefinitions = "definitions"
+	CapabilitySources     = "sources"
+	CapabilityTags        = "tags"
+	// CapabilityBlankAnagrams is set once num_blank_anagrams has been
+	// counted, which databases made before it was added haven't had.
+	CapabilityBlankAnagrams = "blank_anagrams"
+	// CapabilityHistory is set once the words' valid_since has been
+	// found, which databases made before it was added haven't had.
+	CapabilityHistory = "history"
+)
+
+const createCapabilitiesQuery = `
+	CREATE TABLE IF NOT EXISTS capabilities (name varchar(32) PRIMARY KEY, enabled int);
+`
+
+func setCapability(db *sql.DB, name string, enabled bool) {
+	// Older databases may not have the table yet; this can be called from
+	// their migrations.
+	_, err := db.Exec(createCapabilitiesQuery)
+	exitIfError(err)
+	_, err = db.Exec(`INSERT OR REPLACE INTO capabilities(name, enabled) VALUES(?, ?)`,
+		name, enabled)
+	exitIfError(err)
+	if !enabled {
+		log.Warn().Str("capability", name).Msg("no data for this capability; leaving it off")
+	}
+}
+
+// setCapabilitiesFromData sets every capability based on whether the
+// database has any non-NULL data for it.
+func setCapabilitiesFromData(db *sql.DB) {
+	queries := map[string]string{
+		CapabilityDifficulty:  `SELECT EXISTS(SELECT 1 FROM alphagrams WHERE difficulty IS NOT NULL)`,
+		CapabilityPlayability: `SELECT EXISTS(SELECT 1 FROM alphagrams WHERE playability IS NOT NULL)`,
+		CapabilityDefinitions: `SELECT EXISTS(SELECT 1 FROM words WHERE definition <> '')`,
+	}
+	for name, q := range queries {
+		var enabled bool
+		err := db.QueryRow(q).Scan(&enabled)
+		exitIfError(err)
+		setCapability(db, name, enabled)
+	}
+}
@@ -0,0 +1,133 @@
+package dbmaker
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/word_db_server/dbmaker/difficulty"
+)
+
+// An alphagramFeatures has the features of an alphagram that a difficulty
+// model is fit on, and its difficulty rating if it has one.
+type alphagramFeatures struct {
+	alphagram  string
+	length     int
+	features   []float64
+	difficulty sql.NullInt64
+}
+
+// readDifficultyFeatures reads the features of every alphagram in the
+// database: its length, where its probability falls among the alphagrams of
+// its length (0 is the likeliest, 1 the least likely), its number of
+// anagrams, the share of its tiles that are vowels, and its words' average
+// number of hooks.
+func readDifficultyFeatures(ctx context.Context, db *sql.DB) ([]alphagramFeatures, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT a.alphagram, a.length, a.probability, m.max_probability,
+			a.num_anagrams, a.num_vowels,
+			(SELECT AVG(LENGTH(COALESCE(w.front_hooks, '')) + LENGTH(COALESCE(w.back_hooks, '')))
+				FROM words w WHERE w.alphagram = a.alphagram),
+			a.difficulty
+		FROM alphagrams a JOIN (
+			SELECT length, MAX(probability) AS max_probability FROM alphagrams GROUP BY length
+		) m USING (length)
+		ORDER BY a.length, a.probability`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var fs []alphagramFeatures
+	for rows.Next() {
+		var f alphagramFeatures
+		var probability, maxProbability, numAnagrams, numVowels int
+		var hooks sql.NullFloat64
+		if err := rows.Scan(&f.alphagram, &f.length, &probability, &maxProbability,
+			&numAnagrams, &numVowels, &hooks, &f.difficulty); err != nil {
+			return nil, err
+		}
+		percentile := 0.0
+		if maxProbability > 1 {
+			percentile = float64(probability-1) / float64(maxProbability-1)
+		}
+		f.features = []float64{float64(f.length), percentile, float64(numAnagrams),
+			float64(numVowels) / float64(f.length), hooks.Float64}
+		fs = append(fs, f)
+	}
+	return fs, rows.Err()
+}
+
+// FitDifficultyModel fits a difficulty model to the rated alphagrams of the
+// database, returning it and the number of alphagrams it was fit to.
+func FitDifficultyModel(ctx context.Context, db *sql.DB) (*difficulty.Model, int, error) {
+	fs, err := readDifficultyFeatures(ctx, db)
+	if err != nil {
+		return nil, 0, err
+	}
+	var xs [][]float64
+	var ys []float64
+	for _, f := range fs {
+		if f.difficulty.Valid {
+			xs = append(xs, f.features)
+			ys = append(ys, float64(f.difficulty.Int64))
+		}
+	}
+	if len(xs) == 0 {
+		return nil, 0, errors.New("no alphagrams with a difficulty rating to fit to")
+	}
+	m, err := difficulty.Fit(xs, ys)
+	return m, len(xs), err
+}
+
+// PredictDifficulty returns the model's difficulty score for every
+// alphagram of the database.
+func PredictDifficulty(ctx context.Context, db *sql.DB, m *difficulty.Model) (difficulty.Scores, error) {
+	fs, err := readDifficultyFeatures(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	scores := difficulty.Scores{}
+	for _, f := range fs {
+		scores[f.alphagram] = m.Predict(f.features)
+	}
+	return scores, nil
+}
+
+// ComputeDifficulty (re)populates the difficulty column of an existing
+// database from a model fit to the ratings of trainLexicon, which may be
+// the lexicon itself. The predicted scores are normalized to ratings by
+// norm. The DBs <lexiconname>.db and <trainlexicon>.db must exist in this
+// directory.
+func ComputeDifficulty(lexiconName, trainLexicon string, norm difficulty.Normalization) {
+	for _, l := range []string{lexiconName, trainLexicon} {
+		if _, err := os.Stat(l + ".db"); os.IsNotExist(err) {
+			log.Fatal().Msgf("Database %v.db does not exist in this directory.", l)
+		}
+	}
+	ctx := context.Background()
+	trainDB, err := sql.Open("sqlite3", trainLexicon+".db")
+	exitIfError(err)
+	m, n, err := FitDifficultyModel(ctx, trainDB)
+	trainDB.Close()
+	exitIfError(err)
+	log.Info().Str("train-lexicon", trainLexicon).Int("alphagrams", n).
+		Float64("intercept", m.Intercept).Floats64("weights", m.Weights).Msg("fit difficulty model")
+
+	db, err := sql.Open("sqlite3", lexiconName+".db")
+	exitIfError(err)
+	defer db.Close()
+	scores, err := PredictDifficulty(ctx, db, m)
+	exitIfError(err)
+	if norm == difficulty.NormalizeNone {
+		// The model predicts ratings, but not necessarily from 1 to 100.
+		for a, s := range scores {
+			scores[a] = min(max(s, 1), 100)
+		}
+	}
+	difficulties, err := difficulty.Normalize(scores, norm)
+	exitIfError(err)
+	loadDifficulty(db, difficulties)
+}
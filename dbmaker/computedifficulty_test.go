@@ -0,0 +1,44 @@
+package dbmaker
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeDifficulty(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.Nil(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+	_, err = db.Exec(`CREATE TABLE alphagrams (alphagram varchar(20), length integer,
+		probability integer, num_anagrams integer, num_vowels integer, difficulty integer);
+	CREATE TABLE words (word varchar(20), alphagram varchar(20), front_hooks varchar(26),
+		back_hooks varchar(26));
+	INSERT INTO alphagrams VALUES
+		('AE', 2, 1, 1, 2, 10), ('AT', 2, 2, 2, 1, 20), ('OX', 2, 3, 1, 1, 50),
+		('JO', 2, 4, 1, 1, 60), ('QI', 2, 5, 1, 1, 90), ('ZA', 2, 6, 1, 1, NULL),
+		('AET', 3, 1, 3, 2, 15), ('AEX', 3, 2, 1, 2, NULL);
+	INSERT INTO words VALUES ('AE', 'AE', 'MNT', ''), ('AT', 'AT', 'BCEFHKLMOPQSTUV', 'ET'),
+		('TA', 'AT', 'EIPU', 'EJMOPSTUWX'), ('OX', 'OX', 'BFLPV', 'OY'), ('JO', 'JO', '', 'BEGTWY'),
+		('QI', 'QI', '', 'S'), ('ZA', 'ZA', '', 'GPS'), ('EAT', 'AET', 'BFHMNPST', 'HS'),
+		('ETA', 'AET', 'BFKMTZ', 'S'), ('TEA', 'AET', '', 'KLMRS'), ('AXE', 'AEX', '', 'DLS');`)
+	assert.Nil(t, err)
+
+	ctx := context.Background()
+	m, n, err := FitDifficultyModel(ctx, db)
+	assert.Nil(t, err)
+	assert.Equal(t, 6, n)
+	scores, err := PredictDifficulty(ctx, db, m)
+	assert.Nil(t, err)
+	assert.Len(t, scores, 8)
+	// Less likely alphagrams are harder.
+	assert.Greater(t, scores["QI"], scores["AE"])
+
+	_, err = db.Exec(`UPDATE alphagrams SET difficulty = NULL`)
+	assert.Nil(t, err)
+	_, _, err = FitDifficultyModel(ctx, db)
+	assert.NotNil(t, err)
+}
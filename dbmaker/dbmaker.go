@@ -7,7 +7,9 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -17,6 +19,7 @@ import (
 	"github.com/domino14/word-golib/kwg"
 	"github.com/domino14/word-golib/tilemapping"
 	"github.com/domino14/word_db_server/internal/common"
+	"github.com/domino14/word_db_server/internal/dbschema"
 
 	// sqlite3 db driver is needed for the word db maker
 	_ "github.com/mattn/go-sqlite3"
@@ -29,20 +32,34 @@ type Alphagram struct {
 	wordCount      uint8
 	uniqToLexSplit uint8
 	updateToLex    uint8
+	// mls are the machine letters of the alphagram. The alphagram string
+	// can't always be parsed back into tiles unambiguously (for example
+	// two Spanish L tiles sort next to each other and look like the LL
+	// tile), so we keep these around when we have them.
+	mls tilemapping.MachineWord
 }
 
 func (a *Alphagram) String() string {
 	return fmt.Sprintf("Alphagram: %s (%d)", a.alphagram, a.combinations)
 }
 
-func (a *Alphagram) pointValue(dist *tilemapping.LetterDistribution) int {
-	pts := 0
-
+// machineLetters returns the tiles for this alphagram. If they were not
+// stored it falls back to parsing the alphagram string.
+func (a *Alphagram) machineLetters(dist *tilemapping.LetterDistribution) tilemapping.MachineWord {
+	if a.mls != nil {
+		return a.mls
+	}
 	mls, err := tilemapping.ToMachineLetters(a.alphagram, dist.TileMapping())
 	if err != nil {
 		panic(err)
 	}
-	for _, ml := range mls {
+	return mls
+}
+
+func (a *Alphagram) pointValue(dist *tilemapping.LetterDistribution) int {
+	pts := 0
+
+	for _, ml := range a.machineLetters(dist) {
 		pts += dist.Score(ml)
 	}
 	return pts
@@ -55,12 +72,7 @@ func (a *Alphagram) numVowels(dist *tilemapping.LetterDistribution) int {
 		vowelMap[v] = true
 	}
 
-	mls, err := tilemapping.ToMachineLetters(a.alphagram, dist.TileMapping())
-	if err != nil {
-		panic(err)
-	}
-
-	for _, ml := range mls {
+	for _, ml := range a.machineLetters(dist) {
 		if vowelMap[ml] {
 			vowels++
 		}
@@ -83,122 +95,218 @@ func (a AlphByCombos) Less(i, j int) bool {
 	return a[i].combinations > a[j].combinations
 }
 
+// alphByTiles is AlphByCombos for TieOrderTiles.
+type alphByTiles struct{ AlphByCombos }
+
+func (a alphByTiles) Less(i, j int) bool {
+	x, y := a.AlphByCombos[i], a.AlphByCombos[j]
+	if x.combinations == y.combinations {
+		return alphagramLess(x.mls, y.mls, x.alphagram, y.alphagram)
+	}
+	return x.combinations > y.combinations
+}
+
+// alphagramLess breaks ties in probability order. Alphagrams with tiles go
+// in the order of their tiles in the letter distribution, which isn't the
+// order of their strings once letters like the Polish Ą (after A, not Z)
+// come in; the tiles are only given for TieOrderTiles. Alphagrams we don't
+// have the tiles of are compared as strings.
+func alphagramLess(amls, bmls tilemapping.MachineWord, a, b string) bool {
+	if amls != nil && bmls != nil {
+		if c := slices.Compare(amls, bmls); c != 0 {
+			return c < 0
+		}
+	}
+	return a < b
+}
+
 type LexiconSymbolDefinition struct {
 	In     string // The word is in this lexicon
 	NotIn  string // The word is not in this lexicon
 	Symbol string // The corresponding lexicon symbol
 }
 
-const CurrentVersion = 6
-
 func exitIfError(err error) {
 	if err != nil {
-		log.Fatal().Err(err).Msg("")
+		fatal(err)
 	}
 }
 
-// create a sqlite db for this lexicon name.
-func createSqliteDb(outputDir string, lexiconName string, quitIfExists bool) (
-	string, error) {
-	dbName := outputDir + "/" + lexiconName + ".db"
-
-	if quitIfExists {
-		_, err := os.Stat(dbName)
-		if err == nil {
-			return "", fmt.Errorf("db %v existed, and not overwriting it; "+
-				"use -force if you would like to overwrite", dbName)
-		}
-	}
+// fatal is what exitIfError does with an error. BuildAll replaces it while
+// it runs, so that one lexicon failing doesn't stop the others.
+var fatal = func(err error) {
+	log.Fatal().Err(err).Msg("")
+}
 
-	os.Remove(dbName)
-	sqlStmt := `
+// createSchemaQuery creates the tables and indexes of a new database.
+const createSchemaQuery = `
 	CREATE TABLE alphagrams (probability int, alphagram varchar(20),
 	    length int, combinations int, num_anagrams int,
 		point_value int, num_vowels int, contains_word_uniq_to_lex_split int,
-		contains_update_to_lex int, difficulty int);
+		contains_update_to_lex int, difficulty int, display_alphagram varchar(40),
+		playability int, vowel_probability int, num_blank_anagrams int);
 
 	CREATE TABLE words (word varchar(20), alphagram varchar(20),
 	    lexicon_symbols varchar(5), definition varchar(512),
 	    front_hooks varchar(26), back_hooks varchar(26),
-	    inner_front_hook int, inner_back_hook int);
+	    inner_front_hook int, inner_back_hook int,
+	    sources varchar(32) NOT NULL DEFAULT '', valid_since varchar(20));
 
-	CREATE TABLE deletedwords (word varchar(20), length int);
+	CREATE TABLE deletedwords (word varchar(20), length int,
+		definition varchar(512));
 
 	CREATE INDEX alpha_index on alphagrams(alphagram);
 	CREATE INDEX prob_index on alphagrams(probability, length);
 	CREATE INDEX word_index on words(word);
 	CREATE INDEX alphagram_index on words(alphagram);
+	CREATE INDEX valid_since_index on words(valid_since);
 	CREATE INDEX length_index on alphagrams(length);
 	CREATE INDEX difficulty_index on alphagrams(difficulty);
+	CREATE INDEX playability_index on alphagrams(playability);
+	CREATE INDEX vowel_prob_index on alphagrams(length, num_vowels, vowel_probability);
 
 	CREATE INDEX num_anagrams_index on alphagrams(num_anagrams);
 	CREATE INDEX point_value_index on alphagrams(point_value);
 	CREATE INDEX num_vowels_index on alphagrams(num_vowels);
+	CREATE INDEX num_blank_anagrams_index on alphagrams(num_blank_anagrams);
 	CREATE INDEX uniq_word_index on alphagrams(contains_word_uniq_to_lex_split);
 	CREATE INDEX update_word_index on alphagrams(contains_update_to_lex);
 
 	CREATE TABLE db_version (version integer);
-	`
+	` + createCapabilitiesQuery + createLexiconMetadataQuery + dbschema.CreateDefinitionAuditQuery +
+	createSchemaMigrationsQuery + createWordTagsQuery + createLexiconStatsQuery +
+	createWordRelationsQuery + createLexiconHistoryQuery
+
+// create a sqlite db at dbName.
+func createSqliteDb(dbName string, quitIfExists bool, storage StorageOptions) (string, error) {
+	if quitIfExists {
+		_, err := os.Stat(dbName)
+		if err == nil {
+			return "", fmt.Errorf("db %v existed, and not overwriting it; "+
+				"use -force if you would like to overwrite", dbName)
+		}
+	}
+
+	removeSqliteFiles(dbName)
 	db, err := sql.Open("sqlite3", dbName)
 	exitIfError(err)
 	log.Info().Msgf("Opened database file at %v for writing", dbName)
 	defer db.Close()
 
-	_, err = db.Exec(sqlStmt)
+	if storage.PageSize != 0 {
+		// This has to come before the first table.
+		_, err = db.Exec(fmt.Sprintf(`PRAGMA page_size = %d;`, storage.PageSize) + createSchemaQuery)
+		exitIfError(err)
+		return dbName, nil
+	}
+	_, err = db.Exec(createSchemaQuery)
 	exitIfError(err)
 	return dbName, nil
 }
 
+// CreateOptions are the less common options for CreateLexiconDatabase.
+type CreateOptions struct {
+	// Workers is how many goroutines build the rows. 0 means one per CPU.
+	Workers int
+	// TieOrder is how alphagrams with equal probability are ordered.
+	TieOrder TieOrder
+	// LegacyDB is the database to copy the order from for TieOrderLegacy.
+	// It defaults to the database that is being replaced.
+	LegacyDB string
+	// Enrichers find out more about the words; see WordEnricher. nil means
+	// DefaultEnrichers.
+	Enrichers []WordEnricher
+	// Storage are the database's SQLite settings.
+	Storage StorageOptions
+	// DryRun, if not nil, makes CreateLexiconDatabase build the database
+	// in a temporary file and write a JSON DiffReport of it against the
+	// existing one to DryRun, instead of replacing it.
+	DryRun io.Writer
+	// MinProbabilityShift is how many places an alphagram's probability
+	// has to move by to be in a DiffReport.
+	MinProbabilityShift int
+	// buildPath, if set, is the file to build the database in instead of
+	// <outputDir>/<lexiconName>.db. See BuildAll.
+	buildPath string
+}
+
+// CreateLexiconDatabase builds the database of a lexicon. It returns an
+// error if the database can't be started; errors while building it are
+// fatal.
 func CreateLexiconDatabase(lexiconName string, lexiconInfo *LexiconInfo, lexMap LexiconMap,
-	outputDir string, quitIfExists bool) {
+	outputDir string, quitIfExists bool, opts CreateOptions) error {
 
 	log.Info().Msgf("Creating lexicon database for %v", lexiconName)
+	if err := opts.Storage.Validate(); err != nil {
+		return err
+	}
+	if opts.DryRun != nil {
+		return dryRun(lexiconName, lexiconInfo, lexMap, outputDir, opts)
+	}
 
-	dbName, err := createSqliteDb(outputDir, lexiconName, quitIfExists)
+	var legacyProbs map[string]int
+	if opts.TieOrder == TieOrderLegacy {
+		// This has to be read before the old database gets removed.
+		legacyDB := opts.LegacyDB
+		if legacyDB == "" {
+			legacyDB = outputDir + "/" + lexiconName + ".db"
+		}
+		var err error
+		legacyProbs, err = legacyProbabilities(legacyDB)
+		if err != nil {
+			return err
+		}
+		log.Info().Int("alphagrams", len(legacyProbs)).Str("db", legacyDB).
+			Msg("using legacy tie order")
+	}
+
+	dbName := outputDir + "/" + lexiconName + ".db"
+	if opts.buildPath != "" {
+		dbName = opts.buildPath
+	}
+	dbName, err := createSqliteDb(dbName, quitIfExists, opts.Storage)
 	if err != nil {
-		log.Error().Err(err).Msg("")
-		return
+		return err
 	}
 
-	definitions, alphagrams := populateAlphsDefs(lexiconInfo.LexiconFilename,
-		lexiconInfo.Combinations, lexiconInfo.LetterDistribution)
+	definitions, alphagrams, err := populateAlphsDefs(lexiconInfo.LexiconFilename,
+		lexiconInfo.MachineWordCombinations, lexiconInfo.LetterDistribution)
+	if err != nil {
+		return err
+	}
 	log.Debug().Msg("Sorting by probability")
 	alphs := alphaMapValues(alphagrams)
-	sort.Sort(AlphByCombos(alphs))
+	if opts.TieOrder == TieOrderTiles {
+		sort.Sort(alphByTiles{alphs})
+	} else {
+		sort.Sort(AlphByCombos(alphs))
+	}
+	if legacyProbs != nil {
+		alphs = applyLegacyOrder(alphs, legacyProbs)
+	}
 
 	var probs [16]uint32
+	// vowelProbs is keyed by [length, num vowels].
+	vowelProbs := map[[2]int]uint32{}
 
-	alphInsertQuery := `
-	INSERT INTO alphagrams(probability, alphagram, length, combinations,
-		num_anagrams, point_value, num_vowels, contains_word_uniq_to_lex_split,
-		contains_update_to_lex, difficulty)
-	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
-	wordInsertQuery := `
-	INSERT INTO words (word, alphagram, lexicon_symbols, definition,
-		front_hooks, back_hooks, inner_front_hook, inner_back_hook)
-	VALUES(?, ?, ?, ?, ?, ?, ?, ?)`
-
-	db, err := sql.Open("sqlite3", dbName)
+	db, err := sql.Open("sqlite3", opts.Storage.buildDSN(dbName))
 	exitIfError(err)
+	defer db.Close()
 	tx, err := db.Begin()
 	exitIfError(err)
 
-	alphStmt, err := tx.Prepare(alphInsertQuery)
-	exitIfError(err)
-	wordStmt, err := tx.Prepare(wordInsertQuery)
-	exitIfError(err)
-	defer alphStmt.Close()
-	defer wordStmt.Close()
-	// lexKWG := lexiconInfo.KWG
+	alphInserter := newBatchInserter(tx, "alphagrams", []string{
+		"probability", "alphagram", "length", "combinations", "num_anagrams",
+		"point_value", "num_vowels", "contains_word_uniq_to_lex_split",
+		"contains_update_to_lex", "difficulty", "display_alphagram", "playability",
+		"vowel_probability"})
+	wordInserter := newBatchInserter(tx, "words", []string{
+		"word", "alphagram", "lexicon_symbols", "definition", "front_hooks",
+		"back_hooks", "inner_front_hook", "inner_back_hook", "sources"})
 
 	lexFamily, err := lexMap.familyName(lexiconName)
 	exitIfError(err)
 
-	latestCSW := lexMap.newestInFamily(FamilyCSW)
-	latestTWL := lexMap.newestInFamily(FamilyTWL)
-	latestCSW.Initialize()
-	latestTWL.Initialize()
-
 	priorLex, err := lexMap.priorLexicon(lexFamily, lexiconName)
 	if err != nil {
 		// ignore this
@@ -206,72 +314,87 @@ func CreateLexiconDatabase(lexiconName string, lexiconInfo *LexiconInfo, lexMap
 	}
 	log.Info().Interface("priorLex", priorLex).Msg("finding prior lexicon")
 
-	for idx, alph := range alphs {
-		if idx%10000 == 0 {
-			log.Debug().Msgf("%d...", idx)
-		}
-		alphML, err := tilemapping.ToMachineLetters(alph.alphagram, lexiconInfo.LetterDistribution.TileMapping())
-		exitIfError(err)
-
-		wl := len(alphML)
-		if wl <= 15 && wl >= 2 {
+	builder := &alphagramBuilder{
+		lexiconInfo: lexiconInfo,
+		definitions: definitions,
+		symbols:     newSymbolFinder(lexMap, lexiconInfo, priorLex),
+	}
+	if lexiconInfo.Custom {
+		builder.useParent(lexMap)
+	}
+	if lexiconInfo.KWG == nil {
+		builder.words = wordSet(alphs)
+	}
+	alphs = slices.DeleteFunc(alphs, func(a Alphagram) bool {
+		return len(a.mls) < 2 || len(a.mls) > 15
+	})
+	written := 0
+	// Hooks and lexicon symbols are found on a pool of workers; the rows
+	// come back in probability order so they can be numbered here.
+	builder.buildInOrder(alphs, opts.Workers, func(chunk []builtAlphagram) {
+		for _, built := range chunk {
+			alph := built.alph
+			wl := len(alph.mls)
 			probs[wl]++
-		} else {
-			continue
-		}
-		lexSymbolsList := []string{}
-		for _, word := range alph.words {
-			wordML, err := tilemapping.ToMachineLetters(word, lexiconInfo.LetterDistribution.TileMapping())
-			exitIfError(err)
-
-			backHooks := tilemapping.MachineWord(kwg.FindHooks(lexiconInfo.KWG, wordML, kwg.BackHooks)).UserVisible(lexiconInfo.LetterDistribution.TileMapping())
-			frontHooks := tilemapping.MachineWord(kwg.FindHooks(lexiconInfo.KWG, wordML, kwg.FrontHooks)).UserVisible(lexiconInfo.LetterDistribution.TileMapping())
-			frontInnerHook := 0
-			backInnerHook := 0
-			if kwg.FindInnerHook(lexiconInfo.KWG, wordML, kwg.BackInnerHook) {
-				backInnerHook = 1
+			for _, w := range built.words {
+				exitIfError(wordInserter.Add(w.word, alph.alphagram, w.lexSymbols,
+					w.definition, w.frontHooks, w.backHooks, w.frontInnerHook,
+					w.backInnerHook, w.sources))
 			}
-			if kwg.FindInnerHook(lexiconInfo.KWG, wordML, kwg.FrontInnerHook) {
-				frontInnerHook = 1
-			}
-
-			def := definitions[word]
-			alphagram := alph.alphagram
-			theseLexSymbols := findLexSymbols(word, latestCSW, latestTWL, lexFamily, priorLex)
-			wordStmt.Exec(word, alphagram, theseLexSymbols, def,
-				frontHooks, backHooks, frontInnerHook, backInnerHook)
-			lexSymbolsList = append(lexSymbolsList, theseLexSymbols)
+			vowelProbs[[2]int{wl, built.numVowels}]++
+			exitIfError(alphInserter.Add(probs[wl], alph.alphagram, wl,
+				alph.combinations, len(alph.words), built.pointValue,
+				built.numVowels, built.uniqToLexSplit, built.updateToLex,
+				built.difficulty, built.display, built.playability,
+				vowelProbs[[2]int{wl, built.numVowels}]))
 		}
+		written += len(chunk)
+		log.Debug().Msgf("%d...", written)
+	})
+	exitIfError(wordInserter.Flush())
+	exitIfError(alphInserter.Flush())
+	tx.Commit()
 
-		_, err = alphStmt.Exec(probs[wl], alph.alphagram, wl, alph.combinations,
-			len(alph.words), alph.pointValue(lexiconInfo.LetterDistribution),
-			alph.numVowels(lexiconInfo.LetterDistribution),
-			containsWordUniqueToLexSplit(lexSymbolsList),
-			containsUpdateToLex(lexSymbolsList),
-			alphagramDifficulty(alph.alphagram, lexiconInfo.Difficulties, containsUpdateToLex(lexSymbolsList) == uint8(1)))
-		exitIfError(err)
-
+	loadBlankAnagrams(db)
+	createDefinitionsFTS(db)
+	setCapabilitiesFromData(db)
+	setCapability(db, CapabilitySources, lexiconInfo.Sources != nil)
+	loadTags(db, lexiconInfo.Tags)
+	enrichers := opts.Enrichers
+	if enrichers == nil {
+		enrichers = DefaultEnrichers()
 	}
-	tx.Commit()
+	enrichWords(db, enrichers)
+	writeValidSince(db, lexMap.lexiconHistory(lexiconInfo))
+	writeLexiconMetadata(db, lexiconInfo, lexMap)
+	writeLexiconStats(db, statsSymbolRules(lexiconInfo, lexMap))
 
 	deletedWords := []string{}
+	deletedWordLengths := map[string]int{}
+	// Keep the last definitions of deleted words around, so they can still
+	// be shown.
+	var priorDefinitions map[string]string
 	// Check for deletions.
 	if priorLex != nil {
 		priorLex.Initialize()
-		definitions, _ := populateAlphsDefs(priorLex.LexiconFilename,
-			priorLex.Combinations, priorLex.LetterDistribution)
-		for word := range definitions {
+		priorDefinitions, _, err = populateAlphsDefs(priorLex.LexiconFilename,
+			priorLex.MachineWordCombinations, priorLex.LetterDistribution)
+		if err != nil {
+			return err
+		}
+		for word := range priorDefinitions {
 			mls, err := tilemapping.ToMachineLetters(word, priorLex.LetterDistribution.TileMapping())
 			exitIfError(err)
 			if !kwg.FindMachineWord(lexiconInfo.KWG, mls) {
 				deletedWords = append(deletedWords, word)
+				deletedWordLengths[word] = len(mls)
 			}
 		}
 	}
 
 	deletedWordInsertQuery := `
-	INSERT INTO deletedwords (word, length)
-	VALUES(?, ?)`
+	INSERT INTO deletedwords (word, length, definition)
+	VALUES(?, ?, ?)`
 
 	if len(deletedWords) > 0 {
 		sort.Strings(deletedWords)
@@ -282,18 +405,21 @@ func CreateLexiconDatabase(lexiconName string, lexiconInfo *LexiconInfo, lexMap
 		exitIfError(err)
 
 		for _, word := range deletedWords {
-			_, err = wordStmt.Exec(word, len(word))
+			_, err = wordStmt.Exec(word, deletedWordLengths[word], priorDefinitions[word])
 			exitIfError(err)
 		}
 		tx.Commit()
 		wordStmt.Close()
 	}
 
-	_, err = db.Exec("INSERT INTO db_version(version) VALUES(?)", CurrentVersion)
+	_, err = db.Exec("INSERT INTO db_version(version) VALUES(?)", dbschema.CurrentVersion)
 	exitIfError(err)
+	recordMigration(db, dbschema.CurrentVersion, MigrationKindCreated)
+	exitIfError(finishStorage(db, opts.Storage))
 	// log the word length dict to screen. This is needed for the lexica.yaml
 	// fixture in webolith.
 	logWordLengths(probs)
+	return nil
 }
 
 func logWordLengths(lengths [16]uint32) {
@@ -323,8 +449,9 @@ func FixDefinitions(lexiconName string, lexMap LexiconMap) {
 	exitIfError(err)
 	lexiconInfo.Initialize()
 
-	definitions, _ := populateAlphsDefs(lexiconInfo.LexiconFilename,
-		lexiconInfo.Combinations, lexiconInfo.LetterDistribution)
+	definitions, _, err := populateAlphsDefs(lexiconInfo.LexiconFilename,
+		lexiconInfo.MachineWordCombinations, lexiconInfo.LetterDistribution)
+	exitIfError(err)
 
 	definitionEditQuery := `
 	UPDATE words SET definition = ? WHERE word = ?
@@ -344,6 +471,8 @@ func FixDefinitions(lexiconName string, lexMap LexiconMap) {
 	tx.Commit()
 
 	defStmt.Close()
+	rebuildDefinitionsFTS(db)
+	enrichWords(db, DefaultEnrichers())
 	db.Close()
 
 }
@@ -362,10 +491,14 @@ func FixLexiconSymbols(lexiconName string, lexMap LexiconMap) {
 
 	lexiconInfo, err := lexMap.GetLexiconInfo(lexiconName)
 	exitIfError(err)
+	if lexiconInfo.Custom {
+		log.Fatal().Msg("custom lexica get their symbols when they're built; rebuild them instead")
+	}
 	lexiconInfo.Initialize()
 
-	_, alphagrams := populateAlphsDefs(lexiconInfo.LexiconFilename,
-		lexiconInfo.Combinations, lexiconInfo.LetterDistribution)
+	_, alphagrams, err := populateAlphsDefs(lexiconInfo.LexiconFilename,
+		lexiconInfo.MachineWordCombinations, lexiconInfo.LetterDistribution)
+	exitIfError(err)
 
 	lexSymbolEditQuery := `
 	UPDATE words SET lexicon_symbols = ? WHERE word = ?
@@ -388,31 +521,31 @@ func FixLexiconSymbols(lexiconName string, lexMap LexiconMap) {
 	lexFamily, err := lexMap.familyName(lexiconName)
 	exitIfError(err)
 
-	latestCSW := lexMap.newestInFamily(FamilyCSW)
-	latestTWL := lexMap.newestInFamily(FamilyTWL)
-
 	priorLex, err := lexMap.priorLexicon(lexFamily, lexiconName)
 	if err != nil {
 		// ignore this
 		log.Err(err).Msg("no prior lexicon, ignoring...")
 	}
+	symbols := newSymbolFinder(lexMap, lexiconInfo, priorLex)
 	for _, alphagramObj := range alphagrams {
 		lexSymbolsList := []string{}
 		for _, word := range alphagramObj.words {
-			theseLexSymbols := findLexSymbols(word, latestCSW, latestTWL, lexFamily, priorLex)
+			theseLexSymbols := symbols.find(word)
 			_, err := wordStmt.Exec(theseLexSymbols, word)
 			if err != nil {
 				log.Fatal().Err(err).Msg("")
 			}
 			lexSymbolsList = append(lexSymbolsList, theseLexSymbols)
 		}
-		_, err := alphStmt.Exec(containsWordUniqueToLexSplit(lexSymbolsList),
-			containsUpdateToLex(lexSymbolsList), alphagramObj.alphagram)
+		uniqToLexSplit, updateToLex := symbols.flags(lexSymbolsList)
+		_, err := alphStmt.Exec(uniqToLexSplit, updateToLex, alphagramObj.alphagram)
 		if err != nil {
 			log.Fatal().Err(err).Msg("")
 		}
 	}
 	tx.Commit()
+	// The symbols' labels and descriptions may have changed too.
+	writeLexiconMetadata(db, lexiconInfo, lexMap)
 
 }
 
@@ -435,7 +568,7 @@ func FixLexiconSymbols(lexiconName string, lexMap LexiconMap) {
 // CREATE INDEX alphagram_index on words(alphagram);
 // `
 // This function assumes the above schema.
-func MigrateLexiconDatabase(lexiconName string, lexiconInfo *LexiconInfo) {
+func MigrateLexiconDatabase(lexiconName string, lexiconInfo *LexiconInfo, lexMap LexiconMap) {
 	dbName := "./" + lexiconName + ".db"
 
 	db, err := sql.Open("sqlite3", dbName)
@@ -461,7 +594,7 @@ func MigrateLexiconDatabase(lexiconName string, lexiconInfo *LexiconInfo) {
 			log.Fatal().Err(err).Msg("")
 		}
 	default:
-		if version == CurrentVersion {
+		if version == dbschema.CurrentVersion {
 			log.Info().Msgf("DB Version is up to date (version %d)", version)
 		} else {
 			log.Info().Msgf("Version of this table is %d, moving to %d", version,
@@ -491,8 +624,79 @@ func MigrateLexiconDatabase(lexiconName string, lexiconInfo *LexiconInfo) {
 	if version == 5 {
 		log.Info().Msg("Migrating to version 6...")
 		migrateToV6(db)
+		log.Info().Msg("Run again to migrate to version 7")
+	}
+	if version == 6 {
+		log.Info().Msg("Migrating to version 7...")
+		migrateToV7(db, lexiconInfo.LetterDistribution)
+		log.Info().Msg("Run again to migrate to version 8")
+	}
+	if version == 7 {
+		log.Info().Msg("Migrating to version 8...")
+		migrateToV8(db, lexiconInfo)
+		log.Info().Msg("Run again to migrate to version 9")
+	}
+	if version == 8 {
+		log.Info().Msg("Migrating to version 9...")
+		migrateToV9(db)
+		log.Info().Msg("Run again to migrate to version 10")
+	}
+	if version == 9 {
+		log.Info().Msg("Migrating to version 10...")
+		migrateToV10(db)
+		log.Info().Msg("Run again to migrate to version 11")
+	}
+	if version == 10 {
+		log.Info().Msg("Migrating to version 11...")
+		migrateToV11(db)
+		log.Info().Msg("Run again to migrate to version 12")
+	}
+	if version == 11 {
+		log.Info().Msg("Migrating to version 12...")
+		migrateToV12(db, lexiconInfo, lexMap)
+		log.Info().Msg("Run again to migrate to version 13")
+	}
+	if version == 12 {
+		log.Info().Msg("Migrating to version 13...")
+		migrateToV13(db, lexiconName, lexMap)
+		log.Info().Msg("Run again to migrate to version 14")
+	}
+	if version == 13 {
+		log.Info().Msg("Migrating to version 14...")
+		migrateToV14(db, lexiconInfo)
+		log.Info().Msg("Run again to migrate to version 15")
+	}
+	if version == 14 {
+		log.Info().Msg("Migrating to version 15...")
+		migrateToV15(db)
+		log.Info().Msg("Run again to migrate to version 16")
+	}
+	if version == 15 {
+		log.Info().Msg("Migrating to version 16...")
+		migrateToV16(db, lexiconInfo)
+		log.Info().Msg("Run again to migrate to version 17")
+	}
+	if version == 16 {
+		log.Info().Msg("Migrating to version 17...")
+		migrateToV17(db, lexiconInfo, lexMap)
+		log.Info().Msg("Run again to migrate to version 18")
+	}
+	if version == 17 {
+		log.Info().Msg("Migrating to version 18...")
+		migrateToV18(db)
+		log.Info().Msg("Run again to migrate to version 19")
+	}
+	if version == 18 {
+		log.Info().Msg("Migrating to version 19...")
+		migrateToV19(db, lexiconInfo, lexMap)
 	}
 
+	var newVersion int
+	err = db.QueryRow("SELECT version FROM db_version").Scan(&newVersion)
+	exitIfError(err)
+	if newVersion != version {
+		recordMigration(db, newVersion, MigrationKindMigrated)
+	}
 }
 
 func migrateToV2(db *sql.DB, dist *tilemapping.LetterDistribution) {
@@ -600,6 +804,8 @@ func migrateToV4(db *sql.DB) {
 	alphagrams := []Alphagram{}
 	lastAlph := ""
 	lastLexSymbolsList := []string{}
+	// Databases this old have the English symbols.
+	rules := append(defaultSymbolRules(FamilyCSW), defaultSymbolRules(FamilyTWL)...)
 
 	for rows.Next() {
 		var (
@@ -614,8 +820,7 @@ func migrateToV4(db *sql.DB) {
 
 		if alph != lastAlph && lastAlph != "" {
 			// We have a new alphagram.
-			uniqToLexSplit := containsWordUniqueToLexSplit(lastLexSymbolsList)
-			updateToLex := containsUpdateToLex(lastLexSymbolsList)
+			uniqToLexSplit, updateToLex := alphagramFlags(rules, lastLexSymbolsList)
 			alphagrams = append(alphagrams, Alphagram{alphagram: lastAlph,
 				uniqToLexSplit: uniqToLexSplit, updateToLex: updateToLex})
 
@@ -627,9 +832,9 @@ func migrateToV4(db *sql.DB) {
 	}
 
 	// Update the very last one too.
+	uniqToLexSplit, updateToLex := alphagramFlags(rules, lastLexSymbolsList)
 	alphagrams = append(alphagrams, Alphagram{alphagram: lastAlph,
-		uniqToLexSplit: containsWordUniqueToLexSplit(lastLexSymbolsList),
-		updateToLex:    containsUpdateToLex(lastLexSymbolsList)})
+		uniqToLexSplit: uniqToLexSplit, updateToLex: updateToLex})
 
 	i := 0
 	updateStmt, err := tx.Prepare(updateQuery)
@@ -663,7 +868,7 @@ func migrateToV5(db *sql.DB, lexiconInfo *LexiconInfo) {
 	exitIfError(err)
 	log.Info().Msg("Created new columns and indices")
 
-	loadDifficulty(db, lexiconInfo)
+	loadDifficulty(db, lexiconInfo.Difficulties)
 
 	_, err = db.Exec("UPDATE db_version SET version = ?", 5)
 	exitIfError(err)
@@ -682,55 +887,212 @@ func migrateToV6(db *sql.DB) {
 	exitIfError(err)
 }
 
-func findLexSymbols(word string, latestCSW, latestTWL *LexiconInfo, lexFamily FamilyName,
-	priorLex *LexiconInfo) string {
+func migrateToV7(db *sql.DB, dist *tilemapping.LetterDistribution) {
+	_, err := db.Exec(`
+		ALTER TABLE alphagrams ADD COLUMN display_alphagram varchar(40);
+	`)
+	exitIfError(err)
+	log.Info().Msg("Created new display_alphagram column")
 
-	symbols := ""
+	rows, err := db.Query(`SELECT alphagram FROM alphagrams`)
+	exitIfError(err)
+	alphagrams := []string{}
+	for rows.Next() {
+		var alph string
+		if err := rows.Scan(&alph); err != nil {
+			log.Fatal().Err(err).Msg("")
+		}
+		alphagrams = append(alphagrams, alph)
+	}
+	rows.Close()
 
-	if priorLex != nil && priorLex.KWG != nil {
-		if !kwg.FindWord(priorLex.KWG, word) && !strings.Contains(symbols, LexiconUpdateSymbol) {
-			symbols += LexiconUpdateSymbol
+	tx, err := db.Begin()
+	exitIfError(err)
+	updateStmt, err := tx.Prepare(`
+		UPDATE alphagrams SET display_alphagram = ? WHERE alphagram = ?
+	`)
+	exitIfError(err)
+	for i, alph := range alphagrams {
+		_, err := updateStmt.Exec(common.DisplayForm(alph, dist), alph)
+		exitIfError(err)
+		if i%10000 == 0 {
+			log.Debug().Msgf("%d...", i)
 		}
 	}
-	if lexFamily == FamilyCSW && !kwg.FindWord(latestTWL.KWG, word) &&
-		!strings.Contains(symbols, CSWOnlySymbol) {
-		symbols += CSWOnlySymbol
+	updateStmt.Close()
+	tx.Commit()
+
+	_, err = db.Exec("UPDATE db_version SET version = ?", 7)
+	exitIfError(err)
+}
+
+func migrateToV8(db *sql.DB, lexiconInfo *LexiconInfo) {
+	// An earlier version of this migration also made the vowel probability
+	// index, which failed after the column had been added and left the
+	// database at version 7; run again, it shouldn't add the column twice.
+	if !hasColumn(db, "alphagrams", "playability") {
+		_, err := db.Exec(`ALTER TABLE alphagrams ADD COLUMN playability int`)
+		exitIfError(err)
+	}
+	_, err := db.Exec(`CREATE INDEX IF NOT EXISTS playability_index on alphagrams(playability)`)
+	exitIfError(err)
+	log.Info().Msg("Created new playability column and index")
+
+	loadPlayability(db, lexiconInfo)
+
+	_, err = db.Exec("UPDATE db_version SET version = ?", 8)
+	exitIfError(err)
+}
+
+func migrateToV9(db *sql.DB) {
+	createDefinitionsFTS(db)
+
+	_, err := db.Exec("UPDATE db_version SET version = ?", 9)
+	exitIfError(err)
+}
+
+func migrateToV10(db *sql.DB) {
+	if !hasColumn(db, "alphagrams", "vowel_probability") {
+		_, err := db.Exec(`ALTER TABLE alphagrams ADD COLUMN vowel_probability int`)
+		exitIfError(err)
+	}
+	// Make the index afresh, in case a failed run left one behind.
+	_, err := db.Exec(`
+	DROP INDEX IF EXISTS vowel_prob_index;
+	CREATE INDEX vowel_prob_index on alphagrams(length, num_vowels, vowel_probability);
+	`)
+	exitIfError(err)
+	log.Info().Msg("Created new vowel_probability column and index")
+
+	// Within a length, probability order is the order we want, so the
+	// rank within each (length, num_vowels) bucket is just a running count.
+	rows, err := db.Query(`
+	SELECT alphagram, length, num_vowels FROM alphagrams
+	ORDER BY length, probability`)
+	exitIfError(err)
+	type alphVowelProb struct {
+		alphagram string
+		vowelProb uint32
+	}
+	alphs := []alphVowelProb{}
+	vowelProbs := map[[2]int]uint32{}
+	for rows.Next() {
+		var alph string
+		var length, numVowels int
+		err := rows.Scan(&alph, &length, &numVowels)
+		exitIfError(err)
+		vowelProbs[[2]int{length, numVowels}]++
+		alphs = append(alphs, alphVowelProb{alph, vowelProbs[[2]int{length, numVowels}]})
 	}
-	if lexFamily == FamilyTWL && !kwg.FindWord(latestCSW.KWG, word) &&
-		!strings.Contains(symbols, TWLOnlySymbol) {
-		symbols += TWLOnlySymbol
+	rows.Close()
+
+	tx, err := db.Begin()
+	exitIfError(err)
+	updateStmt, err := tx.Prepare(`UPDATE alphagrams SET vowel_probability = ? WHERE alphagram = ?`)
+	exitIfError(err)
+	for _, a := range alphs {
+		_, err := updateStmt.Exec(a.vowelProb, a.alphagram)
+		exitIfError(err)
 	}
+	updateStmt.Close()
+	tx.Commit()
+
+	_, err = db.Exec("UPDATE db_version SET version = ?", 10)
+	exitIfError(err)
+}
 
-	return symbols
+// hasColumn returns whether the table has the column.
+func hasColumn(db *sql.DB, table, column string) bool {
+	var n int
+	err := db.QueryRow(`SELECT COUNT(*) FROM pragma_table_info(?) WHERE name = ?`,
+		table, column).Scan(&n)
+	exitIfError(err)
+	return n > 0
 }
 
-// This is a bit of a special function, used only for the annoying lexical
-// split in English-language Scrabble. If the lexiconName is "America",
-// or "NWL18", this will return a 1 if any of the strings in the lexSymbols
-// array contains a $ sign. If the lexiconName is "CSW19", the string to
-// look for is #.
-// All other cases return a 0.
-// Note that this will need to be updated when new versions of America/ CSW
-// are added.
-func containsWordUniqueToLexSplit(lexSymbolsList []string) uint8 {
+func migrateToV11(db *sql.DB) {
+	// Older versions wrote 0 when there was no difficulty or playability
+	// data. A difficulty of 0 was never real data. A playability of 0 can
+	// be, unless there's no playability data at all.
+	_, err := db.Exec(`
+	UPDATE alphagrams SET difficulty = NULL WHERE difficulty = 0;
+	UPDATE alphagrams SET playability = NULL
+		WHERE NOT EXISTS(SELECT 1 FROM alphagrams WHERE playability > 0);
+	`)
+	exitIfError(err)
+	setCapabilitiesFromData(db)
+
+	_, err = db.Exec("UPDATE db_version SET version = ?", 11)
+	exitIfError(err)
+}
 
-	for _, symbols := range lexSymbolsList {
-		if strings.Contains(symbols, "$") || strings.Contains(symbols, "#") {
-			return 1
+func migrateToV12(db *sql.DB, lexiconInfo *LexiconInfo, lexMap LexiconMap) {
+	writeLexiconMetadata(db, lexiconInfo, lexMap)
+
+	_, err := db.Exec("UPDATE db_version SET version = ?", 12)
+	exitIfError(err)
+}
+
+// migrateToV13 adds the last known definitions of deleted words. These
+// come from the prior lexicon in the family, if there is one.
+func migrateToV13(db *sql.DB, lexiconName string, lexMap LexiconMap) {
+	_, err := db.Exec(`ALTER TABLE deletedwords ADD COLUMN definition varchar(512);`)
+	exitIfError(err)
+
+	lexFamily, err := lexMap.familyName(lexiconName)
+	exitIfError(err)
+	priorLex, err := lexMap.priorLexicon(lexFamily, lexiconName)
+	if err != nil {
+		log.Err(err).Msg("no prior lexicon; deleted words will have no definitions")
+	} else {
+		priorLex.Initialize()
+		definitions, _, err := populateAlphsDefs(priorLex.LexiconFilename,
+			priorLex.MachineWordCombinations, priorLex.LetterDistribution)
+		exitIfError(err)
+		deleted := []string{}
+		rows, err := db.Query(`SELECT word FROM deletedwords`)
+		exitIfError(err)
+		for rows.Next() {
+			var word string
+			exitIfError(rows.Scan(&word))
+			deleted = append(deleted, word)
+		}
+		rows.Close()
+
+		tx, err := db.Begin()
+		exitIfError(err)
+		stmt, err := tx.Prepare(`UPDATE deletedwords SET definition = ? WHERE word = ?`)
+		exitIfError(err)
+		for _, word := range deleted {
+			if def, ok := definitions[word]; ok {
+				_, err = stmt.Exec(def, word)
+				exitIfError(err)
+			}
 		}
+		stmt.Close()
+		exitIfError(tx.Commit())
+		log.Info().Msg("Added definitions of deleted words")
 	}
-	return 0
+
+	_, err = db.Exec("UPDATE db_version SET version = ?", 13)
+	exitIfError(err)
 }
 
-// All updates to a lexicon will be indicated with a + symbol basically.
-func containsUpdateToLex(lexSymbolsList []string) uint8 {
+// wordHooks returns the front and back hooks of the word, and whether it
+// has front and back inner hooks (1 or 0, as stored in the db).
+func wordHooks(lexiconInfo *LexiconInfo, wordML tilemapping.MachineWord) (
+	frontHooks, backHooks string, frontInnerHook, backInnerHook int) {
 
-	for _, symbols := range lexSymbolsList {
-		if strings.Contains(symbols, "+") {
-			return 1
-		}
+	tm := lexiconInfo.LetterDistribution.TileMapping()
+	backHooks = tilemapping.MachineWord(kwg.FindHooks(lexiconInfo.KWG, wordML, kwg.BackHooks)).UserVisible(tm)
+	frontHooks = tilemapping.MachineWord(kwg.FindHooks(lexiconInfo.KWG, wordML, kwg.FrontHooks)).UserVisible(tm)
+	if kwg.FindInnerHook(lexiconInfo.KWG, wordML, kwg.BackInnerHook) {
+		backInnerHook = 1
+	}
+	if kwg.FindInnerHook(lexiconInfo.KWG, wordML, kwg.FrontInnerHook) {
+		frontInnerHook = 1
 	}
-	return 0
+	return
 }
 
 // The values of the map.
@@ -744,15 +1106,20 @@ func alphaMapValues(theMap map[string]Alphagram) []Alphagram {
 	return x
 }
 
-func populateAlphsDefs(filename string, combinations func(string, bool) uint64,
-	dist *tilemapping.LetterDistribution) (map[string]string, map[string]Alphagram) {
+// populateAlphsDefs reads the lexicon file, returning the definitions by
+// word and the alphagrams by their string. It returns an error if two
+// different sets of tiles are spelled the same, since the database keys
+// alphagrams by their string.
+func populateAlphsDefs(filename string, combinations func(tilemapping.MachineWord, bool) uint64,
+	dist *tilemapping.LetterDistribution) (map[string]string, map[string]Alphagram, error) {
 
 	definitions := make(map[string]*FullDefinition)
 	alphagrams := make(map[string]Alphagram)
 	file, err := os.Open(filename)
 	if err != nil {
-		panic(err)
+		return nil, nil, err
 	}
+	defer file.Close()
 	scanner := bufio.NewScanner(file)
 	for scanner.Scan() {
 		fields := strings.Fields(scanner.Text())
@@ -763,22 +1130,88 @@ func populateAlphsDefs(filename string, combinations func(string, bool) uint64,
 				definition = strings.Join(fields[1:], " ")
 			}
 			addToDefinitions(word.Word(), definition, definitions)
-			alphagram := word.MakeAlphagram()
+			alphML := word.MakeAlphagramML()
+			alphagram := alphML.UserVisible(dist.TileMapping())
 			alph, ok := alphagrams[alphagram]
 			if !ok {
 				alphagrams[alphagram] = Alphagram{
 					[]string{word.Word()},
-					combinations(alphagram, true),
-					alphagram, 0, 0, 0}
+					combinations(alphML, true),
+					alphagram, 0, 0, 0, alphML}
 			} else {
+				if !slices.Equal(alph.mls, alphML) {
+					return nil, nil, fmt.Errorf("alphagram %v of %v is ambiguous: %v has different tiles with the same spelling",
+						alphagram, word.Word(), alph.words[0])
+				}
 				alph.words = append(alph.words, word.Word())
 				alphagrams[alphagram] = alph
 			}
 		}
 	}
-	file.Close()
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
 
 	definitionMap := expandDefinitions(definitions)
 
-	return definitionMap, alphagrams
+	return definitionMap, alphagrams, nil
+}
+
+// migrateToV14 adds the source flags of words.
+func migrateToV14(db *sql.DB, lexiconInfo *LexiconInfo) {
+	_, err := db.Exec(`ALTER TABLE words ADD COLUMN sources varchar(32) NOT NULL DEFAULT '';`)
+	exitIfError(err)
+	loadSources(db, lexiconInfo.Sources)
+
+	_, err = db.Exec("UPDATE db_version SET version = ?", 14)
+	exitIfError(err)
+}
+
+func migrateToV15(db *sql.DB) {
+	_, err := db.Exec(`
+	ALTER TABLE alphagrams ADD COLUMN num_blank_anagrams int;
+	CREATE INDEX num_blank_anagrams_index on alphagrams(num_blank_anagrams);
+	`)
+	exitIfError(err)
+	loadBlankAnagrams(db)
+
+	_, err = db.Exec("UPDATE db_version SET version = ?", 15)
+	exitIfError(err)
+}
+
+// migrateToV16 adds the word_tags table.
+func migrateToV16(db *sql.DB, lexiconInfo *LexiconInfo) {
+	_, err := db.Exec(createWordTagsQuery)
+	exitIfError(err)
+	loadTags(db, lexiconInfo.Tags)
+
+	_, err = db.Exec("UPDATE db_version SET version = ?", 16)
+	exitIfError(err)
+}
+
+// migrateToV17 adds the lexicon_stats table.
+func migrateToV17(db *sql.DB, lexiconInfo *LexiconInfo, lexMap LexiconMap) {
+	writeLexiconStats(db, statsSymbolRules(lexiconInfo, lexMap))
+
+	_, err := db.Exec("UPDATE db_version SET version = ?", 17)
+	exitIfError(err)
+}
+
+// migrateToV18 adds the word_relations table.
+func migrateToV18(db *sql.DB) {
+	enrichWords(db, DefaultEnrichers())
+
+	_, err := db.Exec("UPDATE db_version SET version = ?", 18)
+	exitIfError(err)
+}
+
+// migrateToV19 adds the valid_since column and the lexicon_history table.
+func migrateToV19(db *sql.DB, lexiconInfo *LexiconInfo, lexMap LexiconMap) {
+	_, err := db.Exec(`ALTER TABLE words ADD COLUMN valid_since varchar(20);
+		CREATE INDEX valid_since_index on words(valid_since);`)
+	exitIfError(err)
+	writeValidSince(db, lexMap.lexiconHistory(lexiconInfo))
+
+	_, err = db.Exec("UPDATE db_version SET version = ?", 19)
+	exitIfError(err)
 }
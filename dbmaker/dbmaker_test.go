@@ -1,10 +1,14 @@
 package dbmaker
 
 import (
+	"database/sql"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/domino14/word-golib/tilemapping"
+	"github.com/stretchr/testify/assert"
 )
 
 var DefaultConfig = map[string]any{
@@ -26,9 +30,10 @@ func TestPopulate(t *testing.T) {
 		LetterDistribution: ld,
 	}
 	lexInfo.Initialize()
-	defs, alphs := populateAlphsDefs("test_files/mini_america.txt",
-		lexInfo.Combinations,
+	defs, alphs, err := populateAlphsDefs("test_files/mini_america.txt",
+		lexInfo.MachineWordCombinations,
 		lexInfo.LetterDistribution)
+	assert.Nil(t, err)
 	if len(alphs["AEINRST"].words) != 2 {
 		t.Error("AEINRST should have 2 words, got",
 			len(alphs["AEINRST"].words))
@@ -43,6 +48,29 @@ type alphaTestCase struct {
 	expected  int
 }
 
+func TestPopulateAmbiguousAlphagram(t *testing.T) {
+	ld, err := tilemapping.ScanLetterDistribution(strings.NewReader(`?,2,0,0
+A,9,1,1
+E,9,1,1
+L,4,1,0
+LL,1,8,0
+`))
+	assert.Nil(t, err)
+	info := &LexiconInfo{LexiconName: "FOO", LetterDistribution: ld}
+	info.Initialize()
+	lexFile := filepath.Join(t.TempDir(), "foo.txt")
+
+	assert.Nil(t, os.WriteFile(lexFile, []byte("LELA\nLALE\n"), 0644))
+	_, alphs, err := populateAlphsDefs(lexFile, info.MachineWordCombinations, ld)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(alphs["AELL"].words))
+
+	// The L, L and the LL tile are both spelled AELL.
+	assert.Nil(t, os.WriteFile(lexFile, []byte("LELA\nLLEA\n"), 0644))
+	_, _, err = populateAlphsDefs(lexFile, info.MachineWordCombinations, ld)
+	assert.ErrorContains(t, err, "ambiguous")
+}
+
 func TestPointValue(t *testing.T) {
 	ld, err := tilemapping.GetDistribution(DefaultConfig, "english")
 	if err != nil {
@@ -63,7 +91,7 @@ func TestPointValue(t *testing.T) {
 		{"DOG", 5},
 	}
 	for _, tc := range ptTestCases {
-		a := &Alphagram{nil, 0, tc.alphagram, 0, 0, 0}
+		a := &Alphagram{nil, 0, tc.alphagram, 0, 0, 0, nil}
 		pts := a.pointValue(ld)
 		if pts != tc.expected {
 			t.Errorf("Expected %d, actual %d, alphagram %s", tc.expected,
@@ -86,7 +114,7 @@ func TestNumVowels(t *testing.T) {
 		{"EUOUAE", 6},
 	}
 	for _, tc := range vowelTestCases {
-		a := &Alphagram{nil, 0, tc.alphagram, 0, 0, 0}
+		a := &Alphagram{nil, 0, tc.alphagram, 0, 0, 0, nil}
 		pts := a.numVowels(ld)
 		if pts != tc.expected {
 			t.Errorf("Expected %d, actual %d, alphagram %s", tc.expected,
@@ -94,3 +122,33 @@ func TestNumVowels(t *testing.T) {
 		}
 	}
 }
+
+func TestMigrateToV10Rerun(t *testing.T) {
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "FOO.db"))
+	assert.Nil(t, err)
+	defer db.Close()
+	// As a failed run could have left it: the column is there, and so is
+	// the index.
+	_, err = db.Exec(`
+	CREATE TABLE db_version (version integer);
+	INSERT INTO db_version VALUES (9);
+	CREATE TABLE alphagrams (alphagram varchar(20), length int, num_vowels int,
+		probability int, vowel_probability int);
+	CREATE INDEX vowel_prob_index on alphagrams(length, num_vowels, vowel_probability);
+	INSERT INTO alphagrams VALUES ('AB', 2, 1, 1, NULL), ('EO', 2, 2, 2, NULL),
+		('AD', 2, 1, 3, NULL);
+	`)
+	assert.Nil(t, err)
+	assert.True(t, hasColumn(db, "alphagrams", "vowel_probability"))
+	assert.False(t, hasColumn(db, "alphagrams", "playability"))
+
+	migrateToV10(db)
+
+	var version int
+	assert.Nil(t, db.QueryRow(`SELECT version FROM db_version`).Scan(&version))
+	assert.Equal(t, 10, version)
+	var vowelProb int
+	assert.Nil(t, db.QueryRow(`SELECT vowel_probability FROM alphagrams
+		WHERE alphagram = 'AD'`).Scan(&vowelProb))
+	assert.Equal(t, 2, vowelProb)
+}
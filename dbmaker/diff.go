@@ -0,0 +1,232 @@
+package dbmaker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/rs/zerolog/log"
+)
+
+// A DiffReport is what rebuilding a lexicon's database would change: the
+// words added and removed, the changed fields of the words in both, and
+// the alphagrams whose probability moved.
+type DiffReport struct {
+	Lexicon           string             `json:"lexicon"`
+	Summary           DiffSummary        `json:"summary"`
+	Added             []string           `json:"added"`
+	Removed           []string           `json:"removed"`
+	Changed           []WordChange       `json:"changed"`
+	ProbabilityShifts []ProbabilityShift `json:"probability_shifts"`
+}
+
+// DiffSummary counts the entries of each list of a DiffReport.
+type DiffSummary struct {
+	Added             int `json:"added"`
+	Removed           int `json:"removed"`
+	Changed           int `json:"changed"`
+	ProbabilityShifts int `json:"probability_shifts"`
+}
+
+// A WordChange is a field of a word that differs between the databases.
+type WordChange struct {
+	Word  string `json:"word"`
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// A ProbabilityShift is an alphagram whose probability differs between
+// the databases.
+type ProbabilityShift struct {
+	Alphagram string `json:"alphagram"`
+	Length    int    `json:"length"`
+	Old       int    `json:"old"`
+	New       int    `json:"new"`
+}
+
+// diffedWordFields are the words columns a DiffReport compares, in the
+// order the changes to a word are listed.
+var diffedWordFields = []string{"definition", "lexicon_symbols", "front_hooks",
+	"back_hooks", "inner_front_hook", "inner_back_hook"}
+
+// readDiffWords returns the words of a database, each with its alphagram
+// and then its diffedWordFields.
+func readDiffWords(ctx context.Context, db *sql.DB) (map[string][]string, error) {
+	rows, err := db.QueryContext(ctx, `SELECT word, alphagram, definition, lexicon_symbols,
+		front_hooks, back_hooks, inner_front_hook, inner_back_hook FROM words`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	words := map[string][]string{}
+	for rows.Next() {
+		var word string
+		fields := make([]sql.NullString, len(diffedWordFields)+1)
+		dest := []any{&word}
+		for i := range fields {
+			dest = append(dest, &fields[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		values := make([]string, len(fields))
+		for i, f := range fields {
+			values[i] = f.String
+		}
+		words[word] = values
+	}
+	return words, rows.Err()
+}
+
+// readDiffProbabilities returns the probability and length of each of a
+// database's alphagrams.
+func readDiffProbabilities(ctx context.Context, db *sql.DB) (map[string][2]int, error) {
+	rows, err := db.QueryContext(ctx, `SELECT alphagram, probability, length FROM alphagrams`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	probs := map[string][2]int{}
+	for rows.Next() {
+		var alphagram string
+		var prob, length int
+		if err := rows.Scan(&alphagram, &prob, &length); err != nil {
+			return nil, err
+		}
+		probs[alphagram] = [2]int{prob, length}
+	}
+	return probs, rows.Err()
+}
+
+// DiffDatabases compares an existing database of a lexicon with a new one.
+// Probability shifts of less than minShift places are left out of the
+// report.
+func DiffDatabases(ctx context.Context, oldDB, newDB *sql.DB, lexicon string, minShift int) (
+	*DiffReport, error) {
+
+	oldWords, err := readDiffWords(ctx, oldDB)
+	if err != nil {
+		return nil, fmt.Errorf("old words: %w", err)
+	}
+	newWords, err := readDiffWords(ctx, newDB)
+	if err != nil {
+		return nil, fmt.Errorf("new words: %w", err)
+	}
+	alphagrams := func(words map[string][]string) map[string]string {
+		m := make(map[string]string, len(words))
+		for w, fields := range words {
+			m[w] = fields[0]
+		}
+		return m
+	}
+	report := &DiffReport{Lexicon: lexicon, Changed: []WordChange{},
+		ProbabilityShifts: []ProbabilityShift{}}
+	report.Added, report.Removed = diffWordLists(alphagrams(oldWords), alphagrams(newWords))
+	if report.Added == nil {
+		report.Added = []string{}
+	}
+	if report.Removed == nil {
+		report.Removed = []string{}
+	}
+
+	kept := []string{}
+	for w := range newWords {
+		if _, ok := oldWords[w]; ok {
+			kept = append(kept, w)
+		}
+	}
+	sort.Strings(kept)
+	for _, w := range kept {
+		for i, field := range diffedWordFields {
+			if o, n := oldWords[w][i+1], newWords[w][i+1]; o != n {
+				report.Changed = append(report.Changed, WordChange{Word: w, Field: field, Old: o, New: n})
+			}
+		}
+	}
+
+	oldProbs, err := readDiffProbabilities(ctx, oldDB)
+	if err != nil {
+		return nil, fmt.Errorf("old alphagrams: %w", err)
+	}
+	newProbs, err := readDiffProbabilities(ctx, newDB)
+	if err != nil {
+		return nil, fmt.Errorf("new alphagrams: %w", err)
+	}
+	for alphagram, n := range newProbs {
+		o, ok := oldProbs[alphagram]
+		if !ok || o[0] == n[0] || abs(o[0]-n[0]) < minShift {
+			continue
+		}
+		report.ProbabilityShifts = append(report.ProbabilityShifts,
+			ProbabilityShift{Alphagram: alphagram, Length: n[1], Old: o[0], New: n[0]})
+	}
+	sort.Slice(report.ProbabilityShifts, func(i, j int) bool {
+		a, b := report.ProbabilityShifts[i], report.ProbabilityShifts[j]
+		if a.Length != b.Length {
+			return a.Length < b.Length
+		}
+		return a.New < b.New
+	})
+
+	report.Summary = DiffSummary{Added: len(report.Added), Removed: len(report.Removed),
+		Changed: len(report.Changed), ProbabilityShifts: len(report.ProbabilityShifts)}
+	return report, nil
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// dryRun builds a lexicon's database in a temporary directory and writes
+// the DiffReport of it against the existing one to opts.DryRun. Nothing
+// in the output directory is written.
+func dryRun(lexiconName string, lexiconInfo *LexiconInfo, lexMap LexiconMap,
+	outputDir string, opts CreateOptions) error {
+
+	existing := outputDir + "/" + lexiconName + ".db"
+	if _, err := os.Stat(existing); err != nil {
+		return fmt.Errorf("dry run needs a database to compare with: %w", err)
+	}
+	tmpDir, err := os.MkdirTemp("", "dbmaker-dry-run")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	out := opts.DryRun
+	opts.DryRun = nil
+	opts.buildPath = filepath.Join(tmpDir, lexiconName+".db")
+	if err := CreateLexiconDatabase(lexiconName, lexiconInfo, lexMap, outputDir,
+		false, opts); err != nil {
+		return err
+	}
+
+	oldDB, err := sql.Open("sqlite3", "file:"+existing+"?mode=ro")
+	if err != nil {
+		return err
+	}
+	defer oldDB.Close()
+	newDB, err := sql.Open("sqlite3", "file:"+opts.buildPath+"?mode=ro")
+	if err != nil {
+		return err
+	}
+	defer newDB.Close()
+	report, err := DiffDatabases(context.Background(), oldDB, newDB, lexiconName,
+		opts.MinProbabilityShift)
+	if err != nil {
+		return err
+	}
+	log.Info().Interface("summary", report.Summary).Str("lexicon", lexiconName).
+		Msg("dry run done; nothing was written")
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
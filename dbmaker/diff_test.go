@@ -0,0 +1,66 @@
+package dbmaker
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func diffTestDB(t *testing.T, name string, words [][]any, alphagrams [][]any) *sql.DB {
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), name))
+	assert.Nil(t, err)
+	t.Cleanup(func() { db.Close() })
+	_, err = db.Exec(`CREATE TABLE words (word, alphagram, definition, lexicon_symbols,
+		front_hooks, back_hooks, inner_front_hook, inner_back_hook);
+		CREATE TABLE alphagrams (alphagram, probability, length)`)
+	assert.Nil(t, err)
+	for _, w := range words {
+		_, err = db.Exec(`INSERT INTO words VALUES (?, ?, ?, ?, ?, ?, ?, ?)`, w...)
+		assert.Nil(t, err)
+	}
+	for _, a := range alphagrams {
+		_, err = db.Exec(`INSERT INTO alphagrams VALUES (?, ?, ?)`, a...)
+		assert.Nil(t, err)
+	}
+	return db
+}
+
+func TestDiffDatabases(t *testing.T) {
+	oldDB := diffTestDB(t, "old.db", [][]any{
+		{"QI", "IQ", "a vital force", "", "", "S", 0, 0},
+		{"ZA", "AZ", "pizza", "", "", "S", 0, 0},
+		{"EVO", "EOV", "evening", "", "D", "", 0, 0},
+	}, [][]any{{"IQ", 1, 2}, {"AZ", 2, 2}, {"EOV", 1, 3}})
+	newDB := diffTestDB(t, "new.db", [][]any{
+		{"QI", "IQ", "a vital force", "", "", "S", 0, 0},
+		{"ZA", "AZ", "a pizza", "", "", "", 0, 0},
+		{"EVO", "EOV", "evening", "+", "D", "", 0, 0},
+		{"AA", "AA", "lava", "+", "", "HLS", 0, 0},
+	}, [][]any{{"AA", 1, 2}, {"IQ", 2, 2}, {"AZ", 3, 2}, {"EOV", 1, 3}})
+
+	report, err := DiffDatabases(context.Background(), oldDB, newDB, "FOO", 1)
+	assert.Nil(t, err)
+	assert.Equal(t, "FOO", report.Lexicon)
+	assert.Equal(t, []string{"AA"}, report.Added)
+	assert.Equal(t, []string{}, report.Removed)
+	assert.Equal(t, []WordChange{
+		{Word: "EVO", Field: "lexicon_symbols", Old: "", New: "+"},
+		{Word: "ZA", Field: "definition", Old: "pizza", New: "a pizza"},
+		{Word: "ZA", Field: "back_hooks", Old: "S", New: ""},
+	}, report.Changed)
+	assert.Equal(t, []ProbabilityShift{
+		{Alphagram: "IQ", Length: 2, Old: 1, New: 2},
+		{Alphagram: "AZ", Length: 2, Old: 2, New: 3},
+	}, report.ProbabilityShifts)
+	assert.Equal(t, DiffSummary{Added: 1, Changed: 3, ProbabilityShifts: 2}, report.Summary)
+
+	// The other way round, and leaving out shifts of one place.
+	report, err = DiffDatabases(context.Background(), newDB, oldDB, "FOO", 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{}, report.Added)
+	assert.Equal(t, []string{"AA"}, report.Removed)
+	assert.Equal(t, []ProbabilityShift{}, report.ProbabilityShifts)
+}
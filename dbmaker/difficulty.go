@@ -1,55 +1,33 @@
 package dbmaker
 
 import (
+	"context"
 	"database/sql"
-	"encoding/csv"
 	"os"
 	"path/filepath"
-	"strconv"
 
 	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/word_db_server/dbmaker/difficulty"
 )
 
 var UpdatesHaveZeroDifficulty = os.Getenv("WDB_UPDATES_HAVE_ZERO_DIFFICULTY") == "1"
 
+// createDifficultyMap reads the difficulty ratings in the lexicon's
+// difficulty directory, <lexiconPath>/difficulty/<lexiconName>, which has
+// a CSV file per word length.
 func createDifficultyMap(lexiconPath string, lexiconName string) map[string]int {
 	difficultyPath := filepath.Join(lexiconPath, "difficulty",
 		lexiconName)
-	dm := map[string]int{}
-	// Only difficulty data for 7s and 8s for meow.
-	for length := 7; length <= 8; length++ {
-		filename := filepath.Join(difficultyPath, strconv.Itoa(length)+".csv")
-		f, err := os.Open(filename)
-		if err != nil {
-			log.Info().Msgf("difficulty map creation: no file named %v found", filename)
-			continue
-		}
-		defer f.Close()
-		log.Info().Msgf("using difficulty file: %v", filename)
-		lines, err := csv.NewReader(f).ReadAll()
-		exitIfError(err)
-		header := lines[0]
-		qidx := -1
-		aidx := -1
-		for i, h := range header {
-			if h == "Alphagram" {
-				aidx = i
-			}
-			if h == "quantile" {
-				qidx = i
-			}
-		}
-		if qidx == -1 || aidx == -1 {
-			panic("alphagram or quantile not found in file")
-		}
-		for _, line := range lines[1:] {
-			// Each quantile starts with `q` so remove that from the string
-			// before conversion.
-			rating, err := strconv.Atoi(line[qidx][1:])
-			exitIfError(err)
-			// quantiles are 0-based; it's nicer to have a range from 1 to 100 inclusive:
-			dm[line[aidx]] = rating + 1
-		}
+	scores, err := (&difficulty.DirSource{Dir: difficultyPath}).Scores(context.Background())
+	if err != nil {
+		log.Info().Err(err).Msgf("difficulty map creation: no usable files in %v", difficultyPath)
+		return nil
+	}
+	dm, err := difficulty.Normalize(scores, difficulty.NormalizeNone)
+	if err != nil {
+		log.Warn().Err(err).Msgf("could not use difficulty files in %v; ignoring them", difficultyPath)
+		return nil
 	}
 	if len(dm) == 0 {
 		return nil
@@ -58,28 +36,30 @@ func createDifficultyMap(lexiconPath string, lexiconName string) map[string]int
 	return dm
 }
 
-func alphagramDifficulty(alphagram string, difficulties map[string]int, isUpdate bool) int {
-	// Default to 0 if not specified. This is ok.
+// alphagramDifficulty returns the difficulty of the alphagram, or NULL if
+// we don't know it.
+func alphagramDifficulty(alphagram string, difficulties map[string]int, isUpdate bool) sql.NullInt32 {
 	if difficulties == nil {
-		return 0
+		return sql.NullInt32{}
 	}
 	// If this is a newly made database, don't set a difficulty for new words. We don't know what their
 	// difficulties are yet!
 	if isUpdate && UpdatesHaveZeroDifficulty {
-		return 0
+		return sql.NullInt32{}
 	}
-	var diff int
-	var ok bool
-	if diff, ok = difficulties[alphagram]; !ok {
-		return 0
+	diff, ok := difficulties[alphagram]
+	if !ok {
+		return sql.NullInt32{}
 	}
-	return diff
+	return sql.NullInt32{Int32: int32(diff), Valid: true}
 }
 
-func loadDifficulty(db *sql.DB, lexInfo *LexiconInfo) {
+// loadDifficulty sets the difficulty of every alphagram in the database
+// from difficulties, clearing it for the ones that aren't in there.
+func loadDifficulty(db *sql.DB, difficulties map[string]int) {
 
 	rows, err := db.Query(`
-		SELECT alphagram FROM alphagrams WHERE length BETWEEN 7 AND 8
+		SELECT alphagram FROM alphagrams
 	`)
 	exitIfError(err)
 	defer rows.Close()
@@ -104,7 +84,7 @@ func loadDifficulty(db *sql.DB, lexInfo *LexiconInfo) {
 	updateStmt, err := tx.Prepare(updateQuery)
 	exitIfError(err)
 	for _, alph := range alphagrams {
-		d := alphagramDifficulty(alph.alphagram, lexInfo.Difficulties, false)
+		d := alphagramDifficulty(alph.alphagram, difficulties, false)
 		_, err := updateStmt.Exec(d, alph.alphagram)
 		exitIfError(err)
 		i++
@@ -113,4 +93,28 @@ func loadDifficulty(db *sql.DB, lexInfo *LexiconInfo) {
 		}
 	}
 	tx.Commit()
+	setCapability(db, CapabilityDifficulty, difficulties != nil)
+}
+
+// LoadDifficulty (re)populates the difficulty column of an existing
+// database from the given source, replacing whatever ratings it had. The DB
+// <lexiconname>.db must exist in this directory.
+func LoadDifficulty(lexiconName string, src difficulty.Source, norm difficulty.Normalization) {
+	_, err := os.Stat(lexiconName + ".db")
+	if os.IsNotExist(err) {
+		log.Fatal().Msg("Database does not exist in this directory.")
+	}
+	scores, err := src.Scores(context.Background())
+	exitIfError(err)
+	if len(scores) == 0 {
+		log.Fatal().Msgf("no difficulty data for %v", lexiconName)
+	}
+	difficulties, err := difficulty.Normalize(scores, norm)
+	exitIfError(err)
+	log.Info().Int("map-size", len(difficulties)).Msg("created difficulty map")
+
+	db, err := sql.Open("sqlite3", lexiconName+".db")
+	exitIfError(err)
+	defer db.Close()
+	loadDifficulty(db, difficulties)
 }
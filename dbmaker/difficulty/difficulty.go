@@ -0,0 +1,132 @@
+// Package difficulty reads alphagram difficulty ratings from wherever a
+// difficulty model puts them, and turns them into the 1 to 100 ratings
+// that the lexicon databases store.
+package difficulty
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Scores are raw difficulty scores by alphagram. Higher is harder; the
+// scale is whatever the model that made them uses.
+type Scores map[string]float64
+
+// A Source is somewhere difficulty scores come from.
+type Source interface {
+	Scores(ctx context.Context) (Scores, error)
+}
+
+// Open returns the source that spec names: an http or https URL, a .json
+// or .csv file, or a directory with a CSV file per word length named
+// <length>.csv.
+func Open(spec string) (Source, error) {
+	if u, err := url.Parse(spec); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		return &HTTPSource{URL: spec}, nil
+	}
+	fi, err := os.Stat(spec)
+	if err != nil {
+		return nil, err
+	}
+	if fi.IsDir() {
+		return &DirSource{Dir: spec}, nil
+	}
+	switch strings.ToLower(filepath.Ext(spec)) {
+	case ".json":
+		return &JSONSource{Path: spec}, nil
+	case ".csv":
+		return &CSVSource{Path: spec}, nil
+	}
+	return nil, fmt.Errorf("don't know how to read difficulty scores from %v (expected a URL, a .json or .csv file, or a directory)", spec)
+}
+
+// Normalization is how raw scores become ratings.
+type Normalization int
+
+const (
+	// NormalizeNone uses the scores as ratings, rounded. They must already
+	// be between 1 and 100.
+	NormalizeNone Normalization = iota
+	// NormalizeQuantile rates each alphagram by where its score falls among
+	// the alphagrams of the same length: the easiest percent get 1, the
+	// hardest get 100. Alphagrams with equal scores get equal ratings.
+	NormalizeQuantile
+)
+
+// ParseNormalization parses the name of a normalization, as given on the
+// command line.
+func ParseNormalization(name string) (Normalization, error) {
+	switch name {
+	case "", "none":
+		return NormalizeNone, nil
+	case "quantile":
+		return NormalizeQuantile, nil
+	}
+	return 0, fmt.Errorf("unknown normalization %q (expected none or quantile)", name)
+}
+
+// Normalize turns the scores into ratings from 1 to 100. Without
+// normalization, scores outside that range are skipped with a warning.
+func Normalize(scores Scores, norm Normalization) (map[string]int, error) {
+	switch norm {
+	case NormalizeNone:
+		return asRatings(scores)
+	case NormalizeQuantile:
+		return quantiles(scores), nil
+	}
+	return nil, fmt.Errorf("unknown normalization %d", norm)
+}
+
+func asRatings(scores Scores) (map[string]int, error) {
+	ratings := make(map[string]int, len(scores))
+	for alph, s := range scores {
+		r := int(math.Round(s))
+		if r < 1 || r > 100 {
+			log.Warn().Str("alphagram", alph).Float64("difficulty", s).
+				Msg("skipping-difficulty-out-of-range")
+			continue
+		}
+		ratings[alph] = r
+	}
+	if len(ratings) == 0 && len(scores) > 0 {
+		return nil, errors.New("no difficulty is between 1 and 100; use quantile normalization for scores on another scale")
+	}
+	return ratings, nil
+}
+
+func quantiles(scores Scores) map[string]int {
+	// Multi-letter tiles make this an approximation of the word length for
+	// some lexica, but alphagrams that differ in it still differ in length.
+	byLength := map[int][]string{}
+	for alph := range scores {
+		l := utf8.RuneCountInString(alph)
+		byLength[l] = append(byLength[l], alph)
+	}
+	ratings := make(map[string]int, len(scores))
+	for _, alphs := range byLength {
+		sort.Slice(alphs, func(i, j int) bool {
+			if scores[alphs[i]] != scores[alphs[j]] {
+				return scores[alphs[i]] < scores[alphs[j]]
+			}
+			return alphs[i] < alphs[j]
+		})
+		rank := 0
+		for i, alph := range alphs {
+			if i > 0 && scores[alph] != scores[alphs[i-1]] {
+				rank = i
+			}
+			ratings[alph] = rank*100/len(alphs) + 1
+		}
+	}
+	return ratings
+}
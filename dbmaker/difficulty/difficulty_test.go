@@ -0,0 +1,125 @@
+package difficulty
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDirSource(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "7.csv"),
+		[]byte("Alphagram,quantile\nAEINRST,q0\nACCHNOS,q99\nAEEINRS,x\n"), 0644)
+	assert.Nil(t, err)
+	err = os.WriteFile(filepath.Join(dir, "8.csv"),
+		[]byte("alphagram,quantile\nACCHNOOS,q41\n"), 0644)
+	assert.Nil(t, err)
+	err = os.WriteFile(filepath.Join(dir, "notes.csv"), []byte("nothing,here\n"), 0644)
+	assert.Nil(t, err)
+
+	src, err := Open(dir)
+	assert.Nil(t, err)
+	scores, err := src.Scores(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, Scores{"AEINRST": 1, "ACCHNOS": 100, "ACCHNOOS": 42}, scores)
+
+	ratings, err := Normalize(scores, NormalizeNone)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int{"AEINRST": 1, "ACCHNOS": 100, "ACCHNOOS": 42}, ratings)
+}
+
+func TestJSONSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "model.json")
+	err := os.WriteFile(path, []byte(`{"AEINRST": 0.25, "ACCHNOS": 3.5}`), 0644)
+	assert.Nil(t, err)
+
+	src, err := Open(path)
+	assert.Nil(t, err)
+	scores, err := src.Scores(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, Scores{"AEINRST": 0.25, "ACCHNOS": 3.5}, scores)
+
+	// 0.25 rounds to 0, which is out of range and skipped.
+	ratings, err := Normalize(scores, NormalizeNone)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int{"ACCHNOS": 4}, ratings)
+
+	_, err = Normalize(Scores{"AEINRST": 0.25, "ACCHNOS": 250}, NormalizeNone)
+	assert.NotNil(t, err)
+}
+
+func TestDirSourceSkipsBadFiles(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "7.csv"),
+		[]byte("alphagram,difficulty\nAEINRST,12\n"), 0644)
+	assert.Nil(t, err)
+	// No score column, so the whole file is unusable.
+	err = os.WriteFile(filepath.Join(dir, "8.csv"),
+		[]byte("alphagram,notes\nACCHNOOS,hard\n"), 0644)
+	assert.Nil(t, err)
+
+	src, err := Open(dir)
+	assert.Nil(t, err)
+	scores, err := src.Scores(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, Scores{"AEINRST": 12}, scores)
+}
+
+func TestHTTPSource(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/scores":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"AEINRST": 12}`))
+		case "/scores.csv":
+			w.Write([]byte("alphagram,score,quantile\nAEINRST,12.5,q3\n"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	scores, err := (&HTTPSource{URL: srv.URL + "/scores"}).Scores(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, Scores{"AEINRST": 12}, scores)
+
+	// score is preferred over quantile.
+	scores, err = (&HTTPSource{URL: srv.URL + "/scores.csv"}).Scores(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, Scores{"AEINRST": 12.5}, scores)
+
+	_, err = (&HTTPSource{URL: srv.URL + "/missing"}).Scores(context.Background())
+	assert.NotNil(t, err)
+}
+
+func TestNormalizeQuantile(t *testing.T) {
+	scores := Scores{
+		"AB": -3, "AC": 0, "AD": 0, "AE": 10,
+		"ABC": 1000,
+	}
+	ratings, err := Normalize(scores, NormalizeQuantile)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int{
+		"AB": 1, "AC": 26, "AD": 26, "AE": 76,
+		"ABC": 1,
+	}, ratings)
+}
+
+func TestOpen(t *testing.T) {
+	src, err := Open("https://example.com/difficulty.json")
+	assert.Nil(t, err)
+	assert.Equal(t, &HTTPSource{URL: "https://example.com/difficulty.json"}, src)
+
+	_, err = Open(filepath.Join(t.TempDir(), "missing.csv"))
+	assert.NotNil(t, err)
+
+	path := filepath.Join(t.TempDir(), "model.txt")
+	assert.Nil(t, os.WriteFile(path, nil, 0644))
+	_, err = Open(path)
+	assert.NotNil(t, err)
+}
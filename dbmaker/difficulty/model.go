@@ -0,0 +1,140 @@
+package difficulty
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// A Model predicts difficulty scores from an alphagram's features with a
+// linear regression. It's for lexica that have no difficulty data of their
+// own: fit it to the ratings of one that does, on features that any
+// lexicon database has.
+type Model struct {
+	// Intercept and Weights are the regression's coefficients, on the
+	// features as given.
+	Intercept float64
+	Weights   []float64
+}
+
+// ridge keeps the fit stable when features are collinear, such as the
+// anagram and hook counts of short words, without noticeably changing it
+// otherwise. It applies to the standardized features.
+const ridge = 1e-6
+
+// Fit fits a model by least squares to features xs, all with the same
+// number of features, and their scores ys.
+func Fit(xs [][]float64, ys []float64) (*Model, error) {
+	if len(xs) == 0 || len(xs) != len(ys) {
+		return nil, errors.New("no scores to fit to")
+	}
+	n := len(xs[0])
+	if len(xs) <= n {
+		return nil, fmt.Errorf("need more than %d scores to fit %d features", n, n)
+	}
+	// Standardize the features, so that the ridge treats them alike.
+	mean := make([]float64, n)
+	sd := make([]float64, n)
+	for _, x := range xs {
+		if len(x) != n {
+			return nil, errors.New("features of different lengths")
+		}
+		for j, v := range x {
+			mean[j] += v
+		}
+	}
+	for j := range mean {
+		mean[j] /= float64(len(xs))
+	}
+	for _, x := range xs {
+		for j, v := range x {
+			sd[j] += (v - mean[j]) * (v - mean[j])
+		}
+	}
+	for j := range sd {
+		sd[j] = math.Sqrt(sd[j] / float64(len(xs)))
+		if sd[j] == 0 {
+			// A constant feature tells us nothing.
+			sd[j] = 1
+		}
+	}
+	var yMean float64
+	for _, y := range ys {
+		yMean += y
+	}
+	yMean /= float64(len(ys))
+
+	// The normal equations, (XᵀX + λI)w = Xᵀy, on centered data.
+	a := make([][]float64, n)
+	for j := range a {
+		a[j] = make([]float64, n+1)
+	}
+	z := make([]float64, n)
+	for i, x := range xs {
+		for j, v := range x {
+			z[j] = (v - mean[j]) / sd[j]
+		}
+		for j := range z {
+			for k := range z {
+				a[j][k] += z[j] * z[k]
+			}
+			a[j][n] += z[j] * (ys[i] - yMean)
+		}
+	}
+	for j := range a {
+		a[j][j] += ridge * float64(len(xs))
+	}
+	w, err := solve(a)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Model{Intercept: yMean, Weights: make([]float64, n)}
+	for j := range w {
+		m.Weights[j] = w[j] / sd[j]
+		m.Intercept -= m.Weights[j] * mean[j]
+	}
+	return m, nil
+}
+
+// Predict returns the model's score for the features.
+func (m *Model) Predict(x []float64) float64 {
+	score := m.Intercept
+	for j, w := range m.Weights {
+		score += w * x[j]
+	}
+	return score
+}
+
+// solve solves the linear system whose augmented matrix is a, by Gaussian
+// elimination with partial pivoting.
+func solve(a [][]float64) ([]float64, error) {
+	n := len(a)
+	for col := 0; col < n; col++ {
+		pivot := col
+		for r := col + 1; r < n; r++ {
+			if math.Abs(a[r][col]) > math.Abs(a[pivot][col]) {
+				pivot = r
+			}
+		}
+		if math.Abs(a[pivot][col]) < 1e-12 {
+			return nil, errors.New("features are degenerate; cannot fit")
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+		for r := col + 1; r < n; r++ {
+			f := a[r][col] / a[col][col]
+			for c := col; c <= n; c++ {
+				a[r][c] -= f * a[col][c]
+			}
+		}
+	}
+	x := make([]float64, n)
+	for r := n - 1; r >= 0; r-- {
+		sum := a[r][n]
+		for c := r + 1; c < n; c++ {
+			sum -= a[r][c] * x[c]
+		}
+		x[r] = sum / a[r][r]
+	}
+	return x, nil
+}
@@ -0,0 +1,25 @@
+package difficulty
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFit(t *testing.T) {
+	xs := [][]float64{{1, 0}, {2, 1}, {3, 0}, {4, 1}, {5, 5}, {6, 2}}
+	ys := []float64{}
+	for _, x := range xs {
+		ys = append(ys, 10+3*x[0]-2*x[1])
+	}
+	m, err := Fit(xs, ys)
+	assert.Nil(t, err)
+	assert.InDelta(t, 10, m.Intercept, 1e-3)
+	assert.InDeltaSlice(t, []float64{3, -2}, m.Weights, 1e-3)
+	assert.InDelta(t, 20, m.Predict([]float64{4, 1}), 1e-3)
+
+	_, err = Fit(xs[:2], ys[:2])
+	assert.NotNil(t, err)
+	_, err = Fit(nil, nil)
+	assert.NotNil(t, err)
+}
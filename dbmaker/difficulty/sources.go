@@ -0,0 +1,207 @@
+package difficulty
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// scoreColumns are the CSV columns that can hold the score, in order of
+// preference.
+var scoreColumns = []string{"difficulty", "score", "quantile"}
+
+// CSVSource reads a CSV file with a header. It needs an alphagram column
+// and a difficulty, score or quantile column. Quantiles may be written as
+// 0-based labels like q37, which are read as ratings from 1 to 100.
+type CSVSource struct {
+	Path string
+}
+
+func (s *CSVSource) Scores(ctx context.Context) (Scores, error) {
+	f, err := os.Open(s.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	scores := Scores{}
+	if err := readCSV(f, scores); err != nil {
+		return nil, fmt.Errorf("%v: %w", s.Path, err)
+	}
+	return scores, nil
+}
+
+// DirSource reads a directory with a CSV file per word length, named
+// <length>.csv, in the format CSVSource reads. This is how the difficulty
+// files in the data path are laid out. A file that can't be read is
+// skipped with a warning, so one bad length doesn't lose the others.
+type DirSource struct {
+	Dir string
+}
+
+func (s *DirSource) Scores(ctx context.Context) (Scores, error) {
+	entries, err := os.ReadDir(s.Dir)
+	if err != nil {
+		return nil, err
+	}
+	scores := Scores{}
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || filepath.Ext(name) != ".csv" {
+			continue
+		}
+		if _, err := strconv.Atoi(strings.TrimSuffix(name, ".csv")); err != nil {
+			continue
+		}
+		filename := filepath.Join(s.Dir, name)
+		log.Info().Msgf("using difficulty file: %v", filename)
+		f, err := os.Open(filename)
+		if err != nil {
+			log.Warn().Err(err).Str("file", filename).Msg("skipping-difficulty-file")
+			continue
+		}
+		err = readCSV(f, scores)
+		f.Close()
+		if err != nil {
+			log.Warn().Err(err).Str("file", filename).Msg("skipping-difficulty-file")
+		}
+	}
+	return scores, nil
+}
+
+// JSONSource reads a JSON object mapping alphagrams to scores.
+type JSONSource struct {
+	Path string
+}
+
+func (s *JSONSource) Scores(ctx context.Context) (Scores, error) {
+	f, err := os.Open(s.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	scores, err := readJSON(f)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", s.Path, err)
+	}
+	return scores, nil
+}
+
+// HTTPSource fetches scores from a URL. The response is read as JSON if
+// its content type or the URL's extension says so, and as CSV otherwise.
+type HTTPSource struct {
+	URL string
+	// Client is the client to fetch with; nil means http.DefaultClient.
+	Client *http.Client
+}
+
+func (s *HTTPSource) Scores(ctx context.Context) (Scores, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %v: %v", s.URL, resp.Status)
+	}
+	var scores Scores
+	if isJSON(s.URL, resp.Header.Get("Content-Type")) {
+		scores, err = readJSON(resp.Body)
+	} else {
+		scores = Scores{}
+		err = readCSV(resp.Body, scores)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", s.URL, err)
+	}
+	return scores, nil
+}
+
+func isJSON(rawURL, contentType string) bool {
+	if mt, _, err := mime.ParseMediaType(contentType); err == nil {
+		if mt == "application/json" || strings.HasSuffix(mt, "+json") {
+			return true
+		}
+		if mt == "text/csv" {
+			return false
+		}
+	}
+	u, err := url.Parse(rawURL)
+	return err == nil && strings.ToLower(path.Ext(u.Path)) == ".json"
+}
+
+func readJSON(r io.Reader) (Scores, error) {
+	scores := Scores{}
+	if err := json.NewDecoder(r).Decode(&scores); err != nil {
+		return nil, err
+	}
+	return scores, nil
+}
+
+// readCSV adds the scores in the CSV to scores. Rows with a bad score are
+// logged and skipped.
+func readCSV(r io.Reader, scores Scores) error {
+	lines, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return err
+	}
+	if len(lines) == 0 {
+		return fmt.Errorf("no header")
+	}
+	aidx := -1
+	sidx := -1
+	spref := len(scoreColumns)
+	for i, h := range lines[0] {
+		h = strings.ToLower(strings.TrimSpace(h))
+		if h == "alphagram" {
+			aidx = i
+		}
+		for p, c := range scoreColumns {
+			if h == c && p < spref {
+				sidx, spref = i, p
+			}
+		}
+	}
+	if aidx == -1 || sidx == -1 {
+		return fmt.Errorf("need an alphagram column and one of %v", strings.Join(scoreColumns, ", "))
+	}
+	for _, line := range lines[1:] {
+		score, err := parseScore(line[sidx])
+		if err != nil {
+			log.Warn().Msgf("bad difficulty %q for %v; skipping", line[sidx], line[aidx])
+			continue
+		}
+		scores[strings.ToUpper(strings.TrimSpace(line[aidx]))] = score
+	}
+	return nil
+}
+
+func parseScore(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	if len(s) > 1 && (s[0] == 'q' || s[0] == 'Q') {
+		// quantiles are 0-based; it's nicer to have a range from 1 to 100
+		// inclusive.
+		q, err := strconv.Atoi(s[1:])
+		return float64(q + 1), err
+	}
+	return strconv.ParseFloat(s, 64)
+}
@@ -0,0 +1,59 @@
+//go:build sqlite_fts5
+
+package dbmaker
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/domino14/word_db_server/internal/common"
+)
+
+// TestDryRun builds a custom lexicon, changes its word list, and checks
+// that a dry run reports the changes without touching the database.
+func TestDryRun(t *testing.T) {
+	dataPath := t.TempDir()
+	outputDir := t.TempDir()
+	assert.Nil(t, os.MkdirAll(filepath.Join(dataPath, "lexica"), 0755))
+	assert.Nil(t, os.MkdirAll(filepath.Join(dataPath, "letterdistributions"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(dataPath, "letterdistributions", "tiny"),
+		[]byte("?,2,0,0\nA,9,1,1\nE,12,1,1\nS,4,1,0\nT,6,1,0\n"), 0644))
+	wordList := filepath.Join(dataPath, "lexica", "one.txt")
+	assert.Nil(t, os.WriteFile(wordList, []byte("at\nta\neat\ntea a drink\n"), 0644))
+	assert.Nil(t, os.WriteFile(filepath.Join(dataPath, "lexica", common.CustomLexicaFile),
+		[]byte(`[{"name": "ONE", "file": "one.txt", "letter_distribution": "tiny"}]`), 0644))
+	lexMap := LexiconMap{FamilyCustom: customLexica(dataPath)}
+	info, err := lexMap.GetLexiconInfo("ONE")
+	assert.Nil(t, err)
+	info.Initialize()
+
+	// There's nothing to compare with yet.
+	var out bytes.Buffer
+	err = CreateLexiconDatabase("ONE", info, lexMap, outputDir, true, CreateOptions{DryRun: &out})
+	assert.NotNil(t, err)
+	assert.Nil(t, CreateLexiconDatabase("ONE", info, lexMap, outputDir, true, CreateOptions{}))
+	before, err := os.ReadFile(filepath.Join(outputDir, "ONE.db"))
+	assert.Nil(t, err)
+
+	assert.Nil(t, os.WriteFile(wordList, []byte("at\neat\ntea a hot drink\nsat\n"), 0644))
+	lexMap = LexiconMap{FamilyCustom: customLexica(dataPath)}
+	info, err = lexMap.GetLexiconInfo("ONE")
+	assert.Nil(t, err)
+	info.Initialize()
+	assert.Nil(t, CreateLexiconDatabase("ONE", info, lexMap, outputDir, true, CreateOptions{DryRun: &out}))
+
+	report := &DiffReport{}
+	assert.Nil(t, json.Unmarshal(out.Bytes(), report))
+	assert.Equal(t, []string{"SAT"}, report.Added)
+	assert.Equal(t, []string{"TA"}, report.Removed)
+	assert.Contains(t, report.Changed,
+		WordChange{Word: "TEA", Field: "definition", Old: "a drink", New: "a hot drink"})
+	after, err := os.ReadFile(filepath.Join(outputDir, "ONE.db"))
+	assert.Nil(t, err)
+	assert.Equal(t, before, after)
+}
@@ -0,0 +1,176 @@
+package dbmaker
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// The word_relations table links words to other words in the lexicon, such
+// as an inflected form to its root. The links are made by WordEnrichers,
+// and enricher is the name of the one that made each.
+const createWordRelationsQuery = `
+	CREATE TABLE IF NOT EXISTS word_relations (word varchar(20),
+		relation varchar(32), related_word varchar(20), detail varchar(64),
+		enricher varchar(32), PRIMARY KEY (word, relation, related_word, detail));
+	CREATE INDEX IF NOT EXISTS word_relations_related_index on word_relations(related_word);
+`
+
+// A WordEnricher finds out more about words from their definitions while a
+// database is built, such as their part of speech or the root of an
+// inflection. It can add columns to the words table, and link words to
+// others in the word_relations table.
+type WordEnricher interface {
+	// Name is the enricher's name in the word_relations table.
+	Name() string
+	// Columns are the columns it adds to the words table.
+	Columns() []EnrichedColumn
+	// Enrich returns what it found out about a word.
+	Enrich(word, definition string) Enrichment
+}
+
+// EnrichedColumn is a column a WordEnricher adds to the words table.
+type EnrichedColumn struct {
+	Name string
+	// Type is the column's SQL type, e.g. varchar(16).
+	Type string
+}
+
+// Enrichment is what a WordEnricher found out about a word.
+type Enrichment struct {
+	// Values are the word's values for the enricher's columns, by column
+	// name. Columns without one are left NULL.
+	Values map[string]any
+	// Relations are the word's links to other words. Links to words that
+	// aren't in the lexicon are left out.
+	Relations []WordRelation
+}
+
+// WordRelation is a link from a word to another.
+type WordRelation struct {
+	// Relation is the kind of link, such as RelationInflectionOf.
+	Relation string
+	// Word is the word that is linked to.
+	Word string
+	// Detail says more about the link, such as "past tense".
+	Detail string
+}
+
+// RelationInflectionOf links an inflected form to its root.
+const RelationInflectionOf = "inflection_of"
+
+// DefaultEnrichers are the enrichers that databases are built with unless
+// they're given others.
+func DefaultEnrichers() []WordEnricher {
+	return []WordEnricher{InflectionEnricher{}}
+}
+
+// inflectionRegex matches the "past tense of EAT" style of definitions.
+// The root is in capitals, as the lexica write the words they refer to.
+var inflectionRegex = regexp.MustCompile(`(?i:\b(past tense|past participle|present participle|` +
+	`present tense|plural|comparative|superlative)\s+of\s+)(\p{Lu}{2,})\b`)
+
+// InflectionEnricher links inflected forms to their roots, from
+// definitions such as "past tense of EAT" or "plural of OX".
+type InflectionEnricher struct{}
+
+func (InflectionEnricher) Name() string { return "inflection" }
+
+func (InflectionEnricher) Columns() []EnrichedColumn { return nil }
+
+func (InflectionEnricher) Enrich(word, definition string) Enrichment {
+	var e Enrichment
+	for _, m := range inflectionRegex.FindAllStringSubmatch(definition, -1) {
+		if m[2] == word {
+			continue
+		}
+		e.Relations = append(e.Relations, WordRelation{
+			Relation: RelationInflectionOf,
+			Word:     m[2],
+			Detail:   strings.ToLower(m[1]),
+		})
+	}
+	return e
+}
+
+// enrichWords runs the enrichers over every word in the database, and
+// replaces the word_relations and the enrichers' columns with what they
+// find. It must be called again when definitions change.
+func enrichWords(db *sql.DB, enrichers []WordEnricher) {
+	existing := map[string]bool{}
+	rows, err := db.Query(`SELECT name FROM pragma_table_info('words')`)
+	exitIfError(err)
+	for rows.Next() {
+		var name string
+		exitIfError(rows.Scan(&name))
+		existing[name] = true
+	}
+	exitIfError(rows.Err())
+	rows.Close()
+
+	tx, err := db.Begin()
+	exitIfError(err)
+	defer tx.Rollback()
+	_, err = tx.Exec(createWordRelationsQuery + `DELETE FROM word_relations;`)
+	exitIfError(err)
+	for _, en := range enrichers {
+		for _, c := range en.Columns() {
+			if !existing[c.Name] {
+				_, err = tx.Exec(`ALTER TABLE words ADD COLUMN ` + c.Name + ` ` + c.Type)
+			} else {
+				_, err = tx.Exec(`UPDATE words SET ` + c.Name + ` = NULL`)
+			}
+			exitIfError(err)
+		}
+	}
+
+	type wordDef struct{ word, definition string }
+	words := []wordDef{}
+	rows, err = tx.Query(`SELECT word, definition FROM words`)
+	exitIfError(err)
+	for rows.Next() {
+		var w wordDef
+		var def sql.NullString
+		exitIfError(rows.Scan(&w.word, &def))
+		w.definition = def.String
+		words = append(words, w)
+	}
+	exitIfError(rows.Err())
+	rows.Close()
+
+	relStmt, err := tx.Prepare(`INSERT OR IGNORE INTO word_relations
+		(word, relation, related_word, detail, enricher)
+		SELECT ?, ?, ?, ?, ? WHERE EXISTS (SELECT 1 FROM words WHERE word = ?)`)
+	exitIfError(err)
+	defer relStmt.Close()
+	relations := 0
+	for _, en := range enrichers {
+		columns := map[string]bool{}
+		for _, c := range en.Columns() {
+			columns[c.Name] = true
+		}
+		for _, w := range words {
+			e := en.Enrich(w.word, w.definition)
+			for col, v := range e.Values {
+				if !columns[col] {
+					exitIfError(fmt.Errorf("enricher %v has no column %v", en.Name(), col))
+				}
+				_, err := tx.Exec(`UPDATE words SET `+col+` = ? WHERE word = ?`, v, w.word)
+				exitIfError(err)
+			}
+			for _, r := range e.Relations {
+				related := strings.ToUpper(r.Word)
+				res, err := relStmt.Exec(w.word, r.Relation, related, r.Detail, en.Name(), related)
+				exitIfError(err)
+				n, err := res.RowsAffected()
+				exitIfError(err)
+				relations += int(n)
+			}
+		}
+	}
+	exitIfError(tx.Commit())
+	log.Info().Int("enrichers", len(enrichers)).Int("relations", relations).Msg("enriched words")
+}
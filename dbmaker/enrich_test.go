@@ -0,0 +1,78 @@
+package dbmaker
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// posEnricher tags words with the part of speech at the end of their
+// definition, like [v].
+type posEnricher struct{}
+
+func (posEnricher) Name() string { return "pos" }
+
+func (posEnricher) Columns() []EnrichedColumn {
+	return []EnrichedColumn{{Name: "part_of_speech", Type: "varchar(8)"}}
+}
+
+func (posEnricher) Enrich(word, definition string) Enrichment {
+	i := strings.LastIndex(definition, "[")
+	if i == -1 || !strings.HasSuffix(definition, "]") {
+		return Enrichment{}
+	}
+	return Enrichment{Values: map[string]any{"part_of_speech": definition[i+1 : len(definition)-1]}}
+}
+
+func TestInflectionEnricher(t *testing.T) {
+	e := InflectionEnricher{}.Enrich("ATE", "past tense of EAT [v]")
+	assert.Equal(t, []WordRelation{{Relation: RelationInflectionOf, Word: "EAT", Detail: "past tense"}},
+		e.Relations)
+	e = InflectionEnricher{}.Enrich("SHOD", "a Past Tense of SHOE, also past participle of SHOE [v]")
+	assert.Equal(t, 2, len(e.Relations))
+	assert.Equal(t, "past participle", e.Relations[1].Detail)
+	// The root has to be in capitals.
+	assert.Empty(t, InflectionEnricher{}.Enrich("OXEN", "plural of the ox").Relations)
+}
+
+func TestEnrichWords(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.Nil(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+	_, err = db.Exec(`
+	CREATE TABLE words (word varchar(20), definition varchar(512));
+	INSERT INTO words VALUES ('EAT', 'to consume food [v]'), ('ATE', 'past tense of EAT [v]'),
+		('OXEN', 'plural of OX [n]'), ('GEESE', 'plural of GOOSE [n]'), ('QI', NULL);
+	`)
+	assert.Nil(t, err)
+
+	relations := func() []string {
+		rows, err := db.Query(`SELECT word, related_word, detail, enricher FROM word_relations
+			ORDER BY word`)
+		assert.Nil(t, err)
+		defer rows.Close()
+		rels := []string{}
+		for rows.Next() {
+			var w, r, d, e string
+			assert.Nil(t, rows.Scan(&w, &r, &d, &e))
+			rels = append(rels, w+" "+r+" "+d+" "+e)
+		}
+		return rels
+	}
+	// GOOSE isn't in the lexicon, and OX isn't either.
+	enrichWords(db, DefaultEnrichers())
+	assert.Equal(t, []string{"ATE EAT past tense inflection"}, relations())
+
+	// Running it again replaces what was there.
+	enrichWords(db, []WordEnricher{InflectionEnricher{}, posEnricher{}})
+	enrichWords(db, []WordEnricher{InflectionEnricher{}, posEnricher{}})
+	assert.Equal(t, []string{"ATE EAT past tense inflection"}, relations())
+	var pos sql.NullString
+	assert.Nil(t, db.QueryRow(`SELECT part_of_speech FROM words WHERE word = 'OXEN'`).Scan(&pos))
+	assert.Equal(t, "n", pos.String)
+	assert.Nil(t, db.QueryRow(`SELECT part_of_speech FROM words WHERE word = 'QI'`).Scan(&pos))
+	assert.False(t, pos.Valid)
+}
@@ -0,0 +1,40 @@
+package dbmaker
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// The definitions_fts table is an FTS5 index over the word definitions.
+// It keeps its own copy of the word rather than pointing at words' rowids,
+// since those are not stable across a VACUUM. This requires go-sqlite3
+// to be built with the sqlite_fts5 tag.
+const createDefinitionsFTSQuery = `
+	CREATE VIRTUAL TABLE definitions_fts USING fts5(word UNINDEXED, definition);
+`
+
+// createDefinitionsFTS creates the full-text index for definitions and
+// populates it from the words table.
+func createDefinitionsFTS(db *sql.DB) {
+	_, err := db.Exec(createDefinitionsFTSQuery)
+	if err != nil && strings.Contains(err.Error(), "no such module: fts5") {
+		fatal(fmt.Errorf("sqlite was built without FTS5; build with -tags sqlite_fts5: %w", err))
+	}
+	exitIfError(err)
+	rebuildDefinitionsFTS(db)
+}
+
+// rebuildDefinitionsFTS repopulates the full-text index. It must be called
+// whenever definitions in the words table change.
+func rebuildDefinitionsFTS(db *sql.DB) {
+	_, err := db.Exec(`
+	DELETE FROM definitions_fts;
+	INSERT INTO definitions_fts(word, definition)
+		SELECT word, definition FROM words WHERE definition <> '';
+	`)
+	exitIfError(err)
+	log.Info().Msg("rebuilt definitions full-text index")
+}
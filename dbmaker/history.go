@@ -0,0 +1,97 @@
+package dbmaker
+
+import (
+	"database/sql"
+
+	"github.com/domino14/word-golib/kwg"
+	"github.com/rs/zerolog/log"
+)
+
+// The lexicon_history table lists the versions of the lexicon's family that
+// the words' valid_since column can name, newest first: updates_ago is 0
+// for the lexicon itself, 1 for the version before it, and so on.
+const createLexiconHistoryQuery = `
+	CREATE TABLE IF NOT EXISTS lexicon_history (lexicon varchar(20) PRIMARY KEY,
+		updates_ago int);
+`
+
+// A lexiconVersion is a version of a family, and a way to tell if a word
+// is in it.
+type lexiconVersion struct {
+	name string
+	has  func(word string) bool
+}
+
+// lexiconHistory returns the lexicon and the versions before it in its
+// family, newest first, as far back as their KWGs are loaded. Custom
+// lexica have no earlier versions.
+func (m LexiconMap) lexiconHistory(lexiconInfo *LexiconInfo) []lexiconVersion {
+	history := []lexiconVersion{{name: lexiconInfo.LexiconName}}
+	family, err := m.familyName(lexiconInfo.LexiconName)
+	if err != nil {
+		return history
+	}
+	for l := lexiconInfo; ; {
+		prior, err := m.priorLexicon(family, l.LexiconName)
+		if err != nil || prior.KWG == nil {
+			break
+		}
+		history = append(history, lexiconVersion{name: prior.LexiconName,
+			has: func(word string) bool { return kwg.FindWord(prior.KWG, word) }})
+		l = prior
+	}
+	return history
+}
+
+// validSince returns the oldest version of the history that the word has
+// been in ever since, not counting the first, which is the word's own
+// lexicon. A word that was deleted and then added back is valid since it
+// was added back.
+func validSince(word string, history []lexiconVersion) string {
+	since := history[0].name
+	for _, v := range history[1:] {
+		if !v.has(word) {
+			break
+		}
+		since = v.name
+	}
+	return since
+}
+
+// writeValidSince fills in the words' valid_since column and the
+// lexicon_history table from the given history of the lexicon. It must be
+// called again when words are added.
+func writeValidSince(db *sql.DB, history []lexiconVersion) {
+	tx, err := db.Begin()
+	exitIfError(err)
+	defer tx.Rollback()
+	_, err = tx.Exec(createLexiconHistoryQuery + `DELETE FROM lexicon_history;`)
+	exitIfError(err)
+	for i, v := range history {
+		_, err = tx.Exec(`INSERT INTO lexicon_history (lexicon, updates_ago) VALUES (?, ?)`,
+			v.name, i)
+		exitIfError(err)
+	}
+
+	words := []string{}
+	rows, err := tx.Query(`SELECT word FROM words`)
+	exitIfError(err)
+	for rows.Next() {
+		var word string
+		exitIfError(rows.Scan(&word))
+		words = append(words, word)
+	}
+	exitIfError(rows.Err())
+	rows.Close()
+
+	stmt, err := tx.Prepare(`UPDATE words SET valid_since = ? WHERE word = ?`)
+	exitIfError(err)
+	defer stmt.Close()
+	for _, w := range words {
+		_, err = stmt.Exec(validSince(w, history), w)
+		exitIfError(err)
+	}
+	exitIfError(tx.Commit())
+	setCapability(db, CapabilityHistory, true)
+	log.Info().Int("versions", len(history)).Msg("wrote valid_since")
+}
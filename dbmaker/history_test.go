@@ -0,0 +1,82 @@
+package dbmaker
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func versionWith(name string, words ...string) lexiconVersion {
+	set := map[string]bool{}
+	for _, w := range words {
+		set[w] = true
+	}
+	return lexiconVersion{name: name, has: func(word string) bool { return set[word] }}
+}
+
+func TestValidSince(t *testing.T) {
+	history := []lexiconVersion{
+		{name: "NWL23"},
+		versionWith("NWL20", "QI", "ZA", "OK"),
+		versionWith("NWL18", "QI", "ZA"),
+		versionWith("TWL06", "QI"),
+	}
+	assert.Equal(t, "TWL06", validSince("QI", history))
+	assert.Equal(t, "NWL18", validSince("ZA", history))
+	assert.Equal(t, "NWL20", validSince("OK", history))
+	assert.Equal(t, "NWL23", validSince("EW", history))
+	// A word is valid since it was last added.
+	history[1] = versionWith("NWL20", "QI", "ZA")
+	history[2] = versionWith("NWL18", "QI", "ZA", "OK")
+	assert.Equal(t, "NWL23", validSince("OK", history))
+}
+
+func TestLexiconHistory(t *testing.T) {
+	nwl18 := &LexiconInfo{LexiconName: "NWL18"}
+	nwl20 := &LexiconInfo{LexiconName: "NWL20"}
+	vocab := &LexiconInfo{LexiconName: "VOCAB", Custom: true}
+	lexMap := LexiconMap{"NWL": {nwl18, nwl20}, FamilyCustom: {vocab}}
+	// Without its KWG, NWL18's words can't be looked up.
+	history := lexMap.lexiconHistory(nwl20)
+	assert.Equal(t, 1, len(history))
+	assert.Equal(t, "NWL20", history[0].name)
+	assert.Equal(t, 1, len(lexMap.lexiconHistory(vocab)))
+}
+
+func TestWriteValidSince(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.Nil(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+	_, err = db.Exec(`CREATE TABLE words (word varchar(20), valid_since varchar(20));
+		INSERT INTO words (word) VALUES ('QI'), ('ZA'), ('EW');`)
+	assert.Nil(t, err)
+
+	history := []lexiconVersion{{name: "NWL23"}, versionWith("NWL20", "QI", "ZA"),
+		versionWith("NWL18", "QI")}
+	writeValidSince(db, history)
+	// Running it again replaces what was there.
+	writeValidSince(db, history)
+
+	since := map[string]string{}
+	rows, err := db.Query(`SELECT word, valid_since FROM words`)
+	assert.Nil(t, err)
+	for rows.Next() {
+		var w, s string
+		assert.Nil(t, rows.Scan(&w, &s))
+		since[w] = s
+	}
+	rows.Close()
+	assert.Equal(t, map[string]string{"QI": "NWL18", "ZA": "NWL20", "EW": "NWL23"}, since)
+
+	var versions, updatesAgo int
+	assert.Nil(t, db.QueryRow(`SELECT COUNT(*), MAX(updates_ago) FROM lexicon_history`).
+		Scan(&versions, &updatesAgo))
+	assert.Equal(t, 3, versions)
+	assert.Equal(t, 2, updatesAgo)
+	var enabled bool
+	assert.Nil(t, db.QueryRow(`SELECT enabled FROM capabilities WHERE name = ?`,
+		CapabilityHistory).Scan(&enabled))
+	assert.True(t, enabled)
+}
@@ -0,0 +1,164 @@
+package dbmaker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	"github.com/domino14/word-golib/tilemapping"
+)
+
+// A HookGraph has the words of some lengths of a lexicon, with an edge from
+// each word to each of the words that its hooks make. It's read from the
+// front_hooks and back_hooks that were found when the database was built.
+type HookGraph struct {
+	Lexicon string     `json:"lexicon"`
+	Nodes   []HookNode `json:"nodes"`
+	Edges   []HookEdge `json:"edges"`
+	index   map[string]int
+}
+
+// A HookNode is a word of a HookGraph. The words that the longest words'
+// hooks make are nodes too, though they have no edges of their own.
+type HookNode struct {
+	Word   string `json:"word"`
+	Length int    `json:"length"`
+}
+
+// A HookEdge goes from a word to the word that one of its hooks makes.
+type HookEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Hook string `json:"hook"`
+	// Side is front or back.
+	Side string `json:"side"`
+}
+
+// ReadHookGraph reads the hook graph of the words from minLength to
+// maxLength tiles long.
+func ReadHookGraph(ctx context.Context, db *sql.DB, lexicon string,
+	dist *tilemapping.LetterDistribution, minLength, maxLength int) (*HookGraph, error) {
+
+	rows, err := db.QueryContext(ctx, `SELECT w.word, a.length, w.front_hooks, w.back_hooks
+		FROM words w JOIN alphagrams a USING (alphagram)
+		WHERE a.length BETWEEN ? AND ? ORDER BY a.length, w.word`, minLength, maxLength)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	g := &HookGraph{Lexicon: lexicon, Nodes: []HookNode{}, Edges: []HookEdge{},
+		index: map[string]int{}}
+	for rows.Next() {
+		var word string
+		var length int
+		var front, back sql.NullString
+		if err := rows.Scan(&word, &length, &front, &back); err != nil {
+			return nil, err
+		}
+		g.addNode(word, length)
+		for _, h := range []struct {
+			side, hooks string
+		}{{"front", front.String}, {"back", back.String}} {
+			tiles, err := tilemapping.ToMachineLetters(h.hooks, dist.TileMapping())
+			if err != nil {
+				return nil, fmt.Errorf("hooks of %v: %w", word, err)
+			}
+			for _, t := range tiles {
+				hook := t.UserVisible(dist.TileMapping(), false)
+				to := word + hook
+				if h.side == "front" {
+					to = hook + word
+				}
+				g.Edges = append(g.Edges, HookEdge{From: word, To: to, Hook: hook, Side: h.side})
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	// The words made by hooking the longest words.
+	for _, e := range g.Edges {
+		if _, ok := g.index[e.To]; !ok {
+			g.addNode(e.To, g.Nodes[g.index[e.From]].Length+1)
+		}
+	}
+	return g, nil
+}
+
+func (g *HookGraph) addNode(word string, length int) {
+	g.index[word] = len(g.Nodes)
+	g.Nodes = append(g.Nodes, HookNode{Word: word, Length: length})
+}
+
+// WriteJSON writes the graph as JSON.
+func (g *HookGraph) WriteJSON(w io.Writer) error {
+	return json.NewEncoder(w).Encode(g)
+}
+
+type graphML struct {
+	XMLName xml.Name     `xml:"graphml"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   struct {
+		ID          string        `xml:"id,attr"`
+		EdgeDefault string        `xml:"edgedefault,attr"`
+		Nodes       []graphMLNode `xml:"node"`
+		Edges       []graphMLEdge `xml:"edge"`
+	} `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID       string `xml:"id,attr"`
+	For      string `xml:"for,attr"`
+	AttrName string `xml:"attr.name,attr"`
+	AttrType string `xml:"attr.type,attr"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+// WriteGraphML writes the graph as GraphML, which graph tools like Gephi
+// and yEd read. The nodes have a length, and the edges a hook and side.
+func (g *HookGraph) WriteGraphML(w io.Writer) error {
+	doc := graphML{XMLNS: "http://graphml.graphdrawing.org/xmlns", Keys: []graphMLKey{
+		{ID: "length", For: "node", AttrName: "length", AttrType: "int"},
+		{ID: "hook", For: "edge", AttrName: "hook", AttrType: "string"},
+		{ID: "side", For: "edge", AttrName: "side", AttrType: "string"},
+	}}
+	doc.Graph.ID = g.Lexicon
+	doc.Graph.EdgeDefault = "directed"
+	for _, n := range g.Nodes {
+		doc.Graph.Nodes = append(doc.Graph.Nodes, graphMLNode{ID: n.Word,
+			Data: []graphMLData{{Key: "length", Value: fmt.Sprint(n.Length)}}})
+	}
+	for _, e := range g.Edges {
+		doc.Graph.Edges = append(doc.Graph.Edges, graphMLEdge{Source: e.From, Target: e.To,
+			Data: []graphMLData{{Key: "hook", Value: e.Hook}, {Key: "side", Value: e.Side}}})
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,54 @@
+package dbmaker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadHookGraph(t *testing.T) {
+	ld, err := tilemapping.ScanLetterDistribution(strings.NewReader(`?,2,0,0
+A,9,1,1
+B,2,3,0
+T,6,1,0
+`))
+	assert.Nil(t, err)
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.Nil(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+	_, err = db.Exec(`
+	CREATE TABLE alphagrams (alphagram varchar(20), length int);
+	CREATE TABLE words (word varchar(20), alphagram varchar(20),
+		front_hooks varchar(26), back_hooks varchar(26));
+	INSERT INTO alphagrams VALUES ('AT', 2), ('AB', 2), ('ABT', 3), ('AAT', 3);
+	INSERT INTO words VALUES ('AT', 'AT', 'BT', 'A'), ('AB', 'AB', 'T', NULL),
+		('BAT', 'ABT', '', 'T'), ('TAB', 'ABT', NULL, ''), ('ATA', 'AAT', '', '');
+	`)
+	assert.Nil(t, err)
+
+	g, err := ReadHookGraph(context.Background(), db, "FOO", ld, 2, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []HookNode{{"AB", 2}, {"AT", 2}, {"TAB", 3}, {"BAT", 3}, {"TAT", 3}, {"ATA", 3}},
+		g.Nodes)
+	assert.Equal(t, []HookEdge{
+		{From: "AB", To: "TAB", Hook: "T", Side: "front"},
+		{From: "AT", To: "BAT", Hook: "B", Side: "front"},
+		{From: "AT", To: "TAT", Hook: "T", Side: "front"},
+		{From: "AT", To: "ATA", Hook: "A", Side: "back"},
+	}, g.Edges)
+
+	var sb strings.Builder
+	assert.Nil(t, g.WriteGraphML(&sb))
+	var doc graphML
+	assert.Nil(t, xml.Unmarshal([]byte(sb.String()), &doc))
+	assert.Equal(t, "directed", doc.Graph.EdgeDefault)
+	assert.Len(t, doc.Graph.Nodes, 6)
+	assert.Equal(t, graphMLEdge{Source: "AT", Target: "ATA", Data: []graphMLData{
+		{Key: "hook", Value: "A"}, {Key: "side", Value: "back"}}}, doc.Graph.Edges[3])
+}
@@ -2,9 +2,14 @@ package dbmaker
 
 import (
 	"errors"
+	"slices"
+	"sync"
 
 	"github.com/domino14/word-golib/kwg"
 	"github.com/domino14/word-golib/tilemapping"
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/word_db_server/internal/common"
 )
 
 type LexiconInfo struct {
@@ -16,7 +21,24 @@ type LexiconInfo struct {
 	LetterDistribution *tilemapping.LetterDistribution
 	Difficulties       map[string]int
 	Playabilities      map[string]int
-	subChooseCombos    [][]uint64
+	// Sources are the source flags of words, comma-separated; see
+	// createSourcesMap.
+	Sources map[string]string
+	// Tags are the word_tags of words; see createTagsMap.
+	Tags map[string][]string
+	// Custom is set for lexica registered in the data path's custom lexica
+	// file. Their Parent, if any, is the lexicon their words get their
+	// lexicon symbols from.
+	Custom bool
+	Parent string
+	// Symbols are the rules for the lexicon symbols of the words, the
+	// same for the whole family. Custom lexica use their parent's.
+	Symbols []SymbolRule
+	// Aliases are old names of the lexicon, from the lexicon map file.
+	// They still find it, with a warning.
+	Aliases         []string
+	subChooseCombos [][]uint64
+	initOnce        sync.Once
 }
 
 type LexiconFamily []*LexiconInfo
@@ -30,10 +52,14 @@ const (
 	FamilyOSPS               = "OSPS"
 	FamilyDeutsch            = "Deutsch"
 	FamilyFrench             = "FRA"
+	// FamilyCustom has all the custom lexica. They aren't versions of each
+	// other.
+	FamilyCustom = "Custom"
 )
 
 type LexiconMap map[FamilyName]LexiconFamily
 
+// The symbols of the default symbol rules.
 const (
 	CSWOnlySymbol       = "#"
 	TWLOnlySymbol       = "$"
@@ -50,9 +76,51 @@ func (m LexiconMap) GetLexiconInfo(lexiconName string) (*LexiconInfo, error) {
 			}
 		}
 	}
+	for _, f := range m {
+		for _, i := range f {
+			if slices.Contains(i.Aliases, lexiconName) {
+				log.Warn().Msgf("lexicon %s is deprecated; use %s", lexiconName, i.LexiconName)
+				return i, nil
+			}
+		}
+	}
 	return nil, errors.New("not found")
 }
 
+// Canonical returns the name of the lexicon that lexiconName is, or is an
+// alias of. It returns lexiconName if there is no such lexicon.
+func (m LexiconMap) Canonical(lexiconName string) string {
+	info, err := m.GetLexiconInfo(lexiconName)
+	if err != nil {
+		return lexiconName
+	}
+	return info.LexiconName
+}
+
+// addAliases gives the lexicon map file's aliases to the lexica they
+// stand for. Aliases of lexica that aren't in the map are left out, and
+// so are aliases that are the names of lexica in the map: those can still
+// be built, e.g. to work out their successors' lexicon symbols, though the
+// server serves their successors in their place.
+func (m LexiconMap) addAliases(mapConfig *common.LexiconMapConfig) {
+	for _, a := range mapConfig.Aliases {
+		if _, err := m.familyName(a.Name); err == nil {
+			continue
+		}
+		target, _, err := mapConfig.Resolve(a.Name)
+		if err != nil {
+			continue
+		}
+		info, err := m.GetLexiconInfo(target)
+		if err != nil || info.LexiconName != target {
+			log.Warn().Str("alias", a.Name).Str("target", target).
+				Msg("alias of a lexicon that can't be built; ignoring it")
+			continue
+		}
+		info.Aliases = append(info.Aliases, a.Name)
+	}
+}
+
 func (m LexiconMap) familyName(lexiconName string) (FamilyName, error) {
 	for fn, f := range m {
 		for _, i := range f {
@@ -69,6 +137,9 @@ func (m LexiconMap) newestInFamily(family FamilyName) *LexiconInfo {
 }
 
 func (m LexiconMap) priorLexicon(family FamilyName, lexiconName string) (*LexiconInfo, error) {
+	if family == FamilyCustom {
+		return nil, errors.New("custom lexica have no prior lexicon")
+	}
 	for idx, i := range m[family] {
 		if i.LexiconName == lexiconName {
 			if idx > 0 {
@@ -82,8 +153,14 @@ func (m LexiconMap) priorLexicon(family FamilyName, lexiconName string) (*Lexico
 }
 
 // Initialize the LexiconInfo data structure for a new lexicon,
-// pre-calculating combinations as necessary.
+// pre-calculating combinations as necessary. Only the first call does
+// anything, so lexica that are built at the same time can share a prior
+// lexicon.
 func (l *LexiconInfo) Initialize() {
+	l.initOnce.Do(l.initialize)
+}
+
+func (l *LexiconInfo) initialize() {
 	// Adapted from GPL Zyzzyva's calculation code.
 	maxFrequency := uint8(0)
 	totalLetters := uint8(0)
@@ -115,15 +192,22 @@ func (l *LexiconInfo) Initialize() {
 
 // Calculate the number of combinations for an alphagram.
 func (l *LexiconInfo) Combinations(alphagram string, withBlanks bool) uint64 {
-	// Adapted from GPL Zyzzyva's calculation code.
-	letters := make([]tilemapping.MachineLetter, 0)
-	counts := make([]uint8, 0)
-	combos := make([][]uint64, 0)
-
 	alphML, err := tilemapping.ToMachineLetters(alphagram, l.LetterDistribution.TileMapping())
 	if err != nil {
 		panic(err)
 	}
+	return l.MachineWordCombinations(alphML, withBlanks)
+}
+
+// MachineWordCombinations calculates the number of combinations for an
+// alphagram that has already been converted to tiles. Prefer this for
+// distributions with multi-character tiles, where the alphagram string
+// may not parse back into the same tiles.
+func (l *LexiconInfo) MachineWordCombinations(alphML tilemapping.MachineWord, withBlanks bool) uint64 {
+	// Adapted from GPL Zyzzyva's calculation code.
+	letters := make([]tilemapping.MachineLetter, 0)
+	counts := make([]uint8, 0)
+	combos := make([][]uint64, 0)
 
 	for _, letter := range alphML {
 		foundLetter := false
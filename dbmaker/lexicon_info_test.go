@@ -1,9 +1,12 @@
 package dbmaker
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/domino14/word-golib/tilemapping"
+	"github.com/stretchr/testify/assert"
 )
 
 type combinationstestpair struct {
@@ -87,3 +90,37 @@ func TestSpanishCombos(t *testing.T) {
 
 	}
 }
+
+func TestLexiconMapFile(t *testing.T) {
+	dataPath := t.TempDir()
+	assert.Nil(t, os.MkdirAll(filepath.Join(dataPath, "lexica"), 0755))
+	assert.Nil(t, os.MkdirAll(filepath.Join(dataPath, "letterdistributions"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(dataPath, "letterdistributions", "tiny"),
+		[]byte("?,2,0,0\nA,9,1,1\nE,12,1,1\nS,4,1,0\nT,6,1,0\n"), 0644))
+	assert.Nil(t, os.WriteFile(filepath.Join(dataPath, "lexica", "lexicon_map.json"), []byte(`{
+		"families": [{"name": "TINY", "lexica": [
+			{"name": "TINY1", "letter_distribution": "tiny", "index": 30},
+			{"name": "TINY2", "letter_distribution": "tiny", "file": "tiny-2.txt",
+			 "descriptive_name": "Tiny, second edition"}]}],
+		"aliases": [{"name": "OLDTINY", "target": "TINY1"},
+			{"name": "TINY1", "target": "TINY2"},
+			{"name": "GONE", "target": "NWL20"}]}`), 0644))
+
+	lexMap := LexiconMappings(dataPath)
+	assert.Len(t, lexMap, 1)
+	assert.Len(t, lexMap["TINY"], 2)
+	info, err := lexMap.GetLexiconInfo("TINY2")
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(dataPath, "lexica", "tiny-2.txt"), info.LexiconFilename)
+	assert.Equal(t, "Tiny, second edition", info.DescriptiveName)
+	assert.Equal(t, "tiny", info.LetterDistribution.Name)
+
+	// TINY1 is still built as itself; its old name finds its successor.
+	info, err = lexMap.GetLexiconInfo("TINY1")
+	assert.Nil(t, err)
+	assert.Equal(t, uint8(30), info.LexiconIndex)
+	assert.Equal(t, "TINY1", info.DescriptiveName)
+	assert.Equal(t, "TINY2", lexMap.Canonical("OLDTINY"))
+	assert.Equal(t, "TINY1", lexMap.Canonical("TINY1"))
+	assert.Equal(t, "GONE", lexMap.Canonical("GONE"))
+}
@@ -0,0 +1,75 @@
+package dbmaker
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"github.com/rs/zerolog/log"
+)
+
+// The lexicon_metadata table has information about the lexicon that the
+// search server can't work out from the rest of the database.
+const createLexiconMetadataQuery = `
+	CREATE TABLE IF NOT EXISTS lexicon_metadata (key varchar(32) PRIMARY KEY, value text);
+`
+
+// LexiconSymbol is a lexicon symbol, along with a short label for it and
+// what it means.
+type LexiconSymbol struct {
+	Symbol      string `json:"symbol"`
+	Description string `json:"description"`
+	Label       string `json:"label"`
+}
+
+// lexiconSymbols returns the lexicon symbols that the rules can give
+// words. Without a prior lexicon, no words are new.
+func lexiconSymbols(rules []SymbolRule, hasPriorLex bool) []LexiconSymbol {
+	symbols := []LexiconSymbol{}
+	for _, r := range rules {
+		if r.New && !hasPriorLex {
+			continue
+		}
+		symbols = append(symbols, LexiconSymbol{r.Symbol, r.Description, r.Label})
+	}
+	return symbols
+}
+
+func writeLexiconMetadata(db *sql.DB, lexiconInfo *LexiconInfo, lexMap LexiconMap) {
+	family, err := lexMap.familyName(lexiconInfo.LexiconName)
+	exitIfError(err)
+	// A custom lexicon's words have the symbols they have in its parent.
+	symbolsFrom := lexiconInfo
+	if lexiconInfo.Custom {
+		symbolsFrom = nil
+		if parent, err := lexMap.GetLexiconInfo(lexiconInfo.Parent); err == nil && parent.KWG != nil {
+			symbolsFrom = parent
+		}
+	}
+	symbolList := []LexiconSymbol{}
+	if symbolsFrom != nil {
+		symbolFamily, err := lexMap.familyName(symbolsFrom.LexiconName)
+		exitIfError(err)
+		_, priorErr := lexMap.priorLexicon(symbolFamily, symbolsFrom.LexiconName)
+		symbolList = lexiconSymbols(symbolsFrom.Symbols, priorErr == nil)
+	}
+	symbols, err := json.Marshal(symbolList)
+	exitIfError(err)
+
+	_, err = db.Exec(createLexiconMetadataQuery)
+	exitIfError(err)
+	md := map[string]string{
+		"lexicon_name":     lexiconInfo.LexiconName,
+		"family":           string(family),
+		"descriptive_name": lexiconInfo.DescriptiveName,
+		"lexicon_symbols":  string(symbols),
+	}
+	if lexiconInfo.Custom {
+		md["parent"] = lexiconInfo.Parent
+		md["letter_distribution"] = lexiconInfo.LetterDistribution.Name
+	}
+	for k, v := range md {
+		_, err := db.Exec(`INSERT OR REPLACE INTO lexicon_metadata(key, value) VALUES(?, ?)`, k, v)
+		exitIfError(err)
+	}
+	log.Info().Str("family", string(family)).Msg("wrote lexicon metadata")
+}
@@ -0,0 +1,28 @@
+package dbmaker
+
+import (
+	"database/sql"
+	"time"
+)
+
+// The schema_migrations table records when the database was created and
+// every migration applied to it since, so tooling can check that a fleet
+// of databases went through the same steps. Databases made before this
+// table existed only have the entries from after they got it.
+const createSchemaMigrationsQuery = `
+	CREATE TABLE IF NOT EXISTS schema_migrations (version int,
+		kind varchar(16), applied_at int);
+`
+
+const (
+	MigrationKindCreated  = "created"
+	MigrationKindMigrated = "migrated"
+)
+
+func recordMigration(db *sql.DB, version int, kind string) {
+	_, err := db.Exec(createSchemaMigrationsQuery)
+	exitIfError(err)
+	_, err = db.Exec(`INSERT INTO schema_migrations(version, kind, applied_at) VALUES(?, ?, ?)`,
+		version, kind, time.Now().Unix())
+	exitIfError(err)
+}
@@ -0,0 +1,130 @@
+package dbmaker
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// createPlayabilityMap reads a per-word playability file for the given
+// lexicon. The file lives at <lexiconPath>/playability/<lexiconName>.csv and
+// must have a header with (at least) `word` and `playability` columns. The
+// playability of a word is an integer score, typically derived from how often
+// the word is played in annotated games; higher is more playable.
+func createPlayabilityMap(lexiconPath string, lexiconName string) map[string]int {
+	filename := filepath.Join(lexiconPath, "playability", lexiconName+".csv")
+	f, err := os.Open(filename)
+	if err != nil {
+		log.Info().Msgf("playability map creation: no file named %v found", filename)
+		return nil
+	}
+	defer f.Close()
+	log.Info().Msgf("using playability file: %v", filename)
+	lines, err := csv.NewReader(f).ReadAll()
+	if err != nil || len(lines) == 0 {
+		log.Warn().Err(err).Msgf("could not read playability file %v; ignoring it", filename)
+		return nil
+	}
+	widx := -1
+	pidx := -1
+	for i, h := range lines[0] {
+		switch strings.ToLower(strings.TrimSpace(h)) {
+		case "word":
+			widx = i
+		case "playability":
+			pidx = i
+		}
+	}
+	if widx == -1 || pidx == -1 {
+		log.Warn().Msgf("playability file %v has no word or playability column; ignoring it", filename)
+		return nil
+	}
+	pm := map[string]int{}
+	for _, line := range lines[1:] {
+		score, err := strconv.Atoi(strings.TrimSpace(line[pidx]))
+		if err != nil {
+			log.Warn().Msgf("bad playability %q for %v in %v; skipping", line[pidx], line[widx], filename)
+			continue
+		}
+		pm[strings.ToUpper(strings.TrimSpace(line[widx]))] = score
+	}
+	if len(pm) == 0 {
+		return nil
+	}
+	log.Info().Int("map-size", len(pm)).Msg("created playability map")
+	return pm
+}
+
+// alphagramPlayability is the playability of the most playable word in
+// the alphagram. If there is no playability data for the lexicon, it is
+// NULL; words missing from the playability data count as 0.
+func alphagramPlayability(words []string, playabilities map[string]int) sql.NullInt32 {
+	if playabilities == nil {
+		return sql.NullInt32{}
+	}
+	best := 0
+	for _, w := range words {
+		if p := playabilities[w]; p > best {
+			best = p
+		}
+	}
+	return sql.NullInt32{Int32: int32(best), Valid: true}
+}
+
+func loadPlayability(db *sql.DB, lexInfo *LexiconInfo) {
+	rows, err := db.Query(`SELECT word, alphagram FROM words ORDER BY alphagram`)
+	exitIfError(err)
+	alphWords := map[string][]string{}
+	for rows.Next() {
+		var word, alph string
+		if err := rows.Scan(&word, &alph); err != nil {
+			log.Fatal().Err(err).Msg("")
+		}
+		alphWords[alph] = append(alphWords[alph], word)
+	}
+	rows.Close()
+
+	tx, err := db.Begin()
+	exitIfError(err)
+	updateStmt, err := tx.Prepare(`
+		UPDATE alphagrams SET playability = ? WHERE alphagram = ?
+	`)
+	exitIfError(err)
+	i := 0
+	for alph, words := range alphWords {
+		_, err := updateStmt.Exec(alphagramPlayability(words, lexInfo.Playabilities), alph)
+		exitIfError(err)
+		i++
+		if i%10000 == 0 {
+			log.Debug().Msgf("%d...", i)
+		}
+	}
+	updateStmt.Close()
+	tx.Commit()
+	setCapability(db, CapabilityPlayability, lexInfo.Playabilities != nil)
+}
+
+// LoadPlayability (re)populates the playability column of an existing
+// database, from the lexicon's playability file. The DB <lexiconname>.db
+// must exist in this directory.
+func LoadPlayability(lexiconName string, lexMap LexiconMap) {
+	_, err := os.Stat(lexiconName + ".db")
+	if os.IsNotExist(err) {
+		log.Fatal().Msg("Database does not exist in this directory.")
+	}
+	db, err := sql.Open("sqlite3", lexiconName+".db")
+	exitIfError(err)
+	defer db.Close()
+
+	lexiconInfo, err := lexMap.GetLexiconInfo(lexiconName)
+	exitIfError(err)
+	if lexiconInfo.Playabilities == nil {
+		log.Fatal().Msgf("no playability data for %v", lexiconName)
+	}
+	loadPlayability(db, lexiconInfo)
+}
@@ -0,0 +1,28 @@
+package dbmaker
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatePlayabilityMap(t *testing.T) {
+	lexiconPath := t.TempDir()
+	err := os.Mkdir(filepath.Join(lexiconPath, "playability"), 0755)
+	assert.Nil(t, err)
+	err = os.WriteFile(filepath.Join(lexiconPath, "playability", "NWL20.csv"),
+		[]byte("Word,Playability\nretinas,950\nRETAINS,1200\nSTAINER,40\n"), 0644)
+	assert.Nil(t, err)
+
+	pm := createPlayabilityMap(lexiconPath, "NWL20")
+	assert.Equal(t, map[string]int{"RETINAS": 950, "RETAINS": 1200, "STAINER": 40}, pm)
+	assert.Equal(t, sql.NullInt32{Int32: 1200, Valid: true},
+		alphagramPlayability([]string{"RETINAS", "RETAINS", "NASTIER"}, pm))
+	assert.Equal(t, sql.NullInt32{Int32: 0, Valid: true},
+		alphagramPlayability([]string{"NASTIER"}, pm))
+	assert.Nil(t, createPlayabilityMap(lexiconPath, "CSW21"))
+	assert.False(t, alphagramPlayability([]string{"NASTIER"}, nil).Valid)
+}
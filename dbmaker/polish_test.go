@@ -0,0 +1,104 @@
+package dbmaker
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/stretchr/testify/assert"
+)
+
+func polishDistribution(t *testing.T) *tilemapping.LetterDistribution {
+	dist, err := tilemapping.NamedLetterDistribution(
+		map[string]any{"data-path": filepath.Join("..", "data")}, "polish")
+	assert.Nil(t, err)
+	return dist
+}
+
+func TestPolishLetterDistribution(t *testing.T) {
+	dist := polishDistribution(t)
+	total := 0
+	for _, n := range dist.Distribution() {
+		total += int(n)
+	}
+	assert.Equal(t, 100, total)
+	assert.Equal(t, 33, int(dist.TileMapping().NumLetters()))
+	mls, err := tilemapping.ToMachineLetters("AĄBZŹŻ", dist.TileMapping())
+	assert.Nil(t, err)
+	assert.Equal(t, []tilemapping.MachineLetter{1, 2, 3, 30, 31, 32}, mls)
+}
+
+func TestPolishAlphagramOrder(t *testing.T) {
+	dist := polishDistribution(t)
+	lexFile := filepath.Join(t.TempDir(), "polish.txt")
+	// AĄ, AF and AŻ have the same combinations. By code point Ą would go
+	// between F and Ż.
+	assert.Nil(t, os.WriteFile(lexFile, []byte("ĄA\nAF\nŻA\nŻĄB bite\n"), 0644))
+	info := &LexiconInfo{LexiconName: "OSPS49", LetterDistribution: dist}
+	info.Initialize()
+
+	defs, alphagrams, err := populateAlphsDefs(lexFile, info.MachineWordCombinations, dist)
+	assert.Nil(t, err)
+	assert.Equal(t, "bite", defs["ŻĄB"])
+	assert.Contains(t, alphagrams, "ĄBŻ")
+	assert.Equal(t, "ĄBŻ", alphagrams["ĄBŻ"].mls.UserVisible(dist.TileMapping()))
+
+	twos := func(alphs []Alphagram) []string {
+		names := []string{}
+		for _, a := range alphs {
+			if len(a.mls) == 2 {
+				names = append(names, a.alphagram)
+			}
+		}
+		return names
+	}
+	alphs := alphaMapValues(alphagrams)
+	sort.Sort(alphByTiles{alphs})
+	assert.Equal(t, []string{"AĄ", "AF", "AŻ"}, twos(alphs))
+	// The default order stays by code point, as it was.
+	sort.Sort(AlphByCombos(alphs))
+	assert.Equal(t, []string{"AF", "AĄ", "AŻ"}, twos(alphs))
+
+	// Rows read back from a db get the same order.
+	rows := []probRow{}
+	for _, a := range []string{"AŻ", "AF", "AĄ"} {
+		rows = append(rows, probRow{alphagram: a, combinations: 10,
+			mls: probRowMLs(a, dist)})
+	}
+	names := []string{}
+	for _, r := range recalcOrder(rows, TieOrderTiles) {
+		names = append(names, r.alphagram)
+	}
+	assert.Equal(t, []string{"AĄ", "AF", "AŻ"}, names)
+}
+
+func TestTieOrderKeepsExistingProbabilities(t *testing.T) {
+	dist := polishDistribution(t)
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "OLD.db"))
+	assert.Nil(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+	// Numbered as dbmaker always has, by code point.
+	_, err = db.Exec(`
+	CREATE TABLE alphagrams (alphagram varchar(20), length int, combinations int,
+		num_vowels int, probability int, vowel_probability int);
+	INSERT INTO alphagrams VALUES
+		('AF', 2, 10, 1, 1, 1), ('AĄ', 2, 10, 2, 2, 1), ('AŻ', 2, 10, 1, 3, 2);
+	`)
+	assert.Nil(t, err)
+
+	// Rebuilding the probabilities with the letter distribution at hand
+	// doesn't renumber them unless asked to.
+	assert.Equal(t, 0, recalcProbabilities(db, dist, TieOrderAlphagram))
+	assert.Equal(t, 2, recalcProbabilities(db, dist, TieOrderTiles))
+	var first string
+	assert.Nil(t, db.QueryRow(`SELECT alphagram FROM alphagrams WHERE probability = 1`).Scan(&first))
+	assert.Equal(t, "AĄ", first)
+
+	to, err := ParseTieOrder("tiles")
+	assert.Nil(t, err)
+	assert.Equal(t, TieOrderTiles, to)
+}
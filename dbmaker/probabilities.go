@@ -0,0 +1,149 @@
+package dbmaker
+
+import (
+	"database/sql"
+	"os"
+	"sort"
+
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/rs/zerolog/log"
+)
+
+// recalcProgressEvery is how many rewritten rows go by between progress
+// messages while recalculating a length's probabilities.
+const recalcProgressEvery = 10000
+
+// recalcOrder puts the alphagrams of a single length back in probability
+// order, from their combinations. With TieOrderLegacy, alphagrams with the
+// same combinations keep their current relative order, and the ones that
+// don't have a probability yet go where TieOrderAlphagram would put them.
+func recalcOrder(rows []probRow, tieOrder TieOrder) []probRow {
+	if tieOrder != TieOrderLegacy {
+		ordered := append([]probRow{}, rows...)
+		sort.Slice(ordered, func(i, j int) bool {
+			if ordered[i].combinations == ordered[j].combinations {
+				return alphagramLess(ordered[i].mls, ordered[j].mls,
+					ordered[i].alphagram, ordered[j].alphagram)
+			}
+			return ordered[i].combinations > ordered[j].combinations
+		})
+		return ordered
+	}
+	kept := []probRow{}
+	added := []probRow{}
+	for _, r := range rows {
+		if r.probability > 0 {
+			kept = append(kept, r)
+		} else {
+			added = append(added, r)
+		}
+	}
+	sort.SliceStable(kept, func(i, j int) bool {
+		if kept[i].combinations == kept[j].combinations {
+			return kept[i].probability < kept[j].probability
+		}
+		return kept[i].combinations > kept[j].combinations
+	})
+	// mergeProbabilityOrder goes by probability, which may not agree with
+	// the combinations any more.
+	for i := range kept {
+		kept[i].probability = i + 1
+	}
+	return mergeProbabilityOrder(kept, added)
+}
+
+// RecalcProbabilities renumbers the probability and vowel_probability of
+// every alphagram in an existing database from its combinations, for when
+// words have been added to or removed from it by hand. All lengths are
+// rewritten in a single transaction, so a failure leaves the database as it
+// was. With TieOrderTiles, ties are broken in the order of dist's tiles.
+// The DB <lexiconname>.db must exist in this directory.
+func RecalcProbabilities(lexiconName string, dist *tilemapping.LetterDistribution,
+	tieOrder TieOrder) {
+	_, err := os.Stat(lexiconName + ".db")
+	if os.IsNotExist(err) {
+		log.Fatal().Msg("Database does not exist in this directory.")
+	}
+	db, err := sql.Open("sqlite3", lexiconName+".db")
+	exitIfError(err)
+	defer db.Close()
+	updated := recalcProbabilities(db, dist, tieOrder)
+	log.Info().Int("updated", updated).Msgf("Recalculated probabilities for %v", lexiconName)
+}
+
+// recalcProbabilities renumbers every length's probabilities, and returns
+// how many rows changed. dist may be nil, in which case ties are broken by
+// the alphagrams' strings.
+func recalcProbabilities(db *sql.DB, dist *tilemapping.LetterDistribution, tieOrder TieOrder) int {
+	tx, err := db.Begin()
+	exitIfError(err)
+	defer tx.Rollback()
+	rows, err := tx.Query(`SELECT DISTINCT length FROM alphagrams ORDER BY length`)
+	exitIfError(err)
+	lengths := []int{}
+	for rows.Next() {
+		var l int
+		exitIfError(rows.Scan(&l))
+		lengths = append(lengths, l)
+	}
+	exitIfError(rows.Err())
+	rows.Close()
+
+	total := 0
+	for _, length := range lengths {
+		total += recalcLengthProbabilities(tx, length, dist, tieOrder)
+	}
+	exitIfError(tx.Commit())
+	return total
+}
+
+// recalcLengthProbabilities rewrites the probabilities of the alphagrams of
+// the given length, and returns how many rows changed.
+func recalcLengthProbabilities(tx *sql.Tx, length int, dist *tilemapping.LetterDistribution,
+	tieOrder TieOrder) int {
+	rows, err := tx.Query(`
+	SELECT alphagram, combinations, num_vowels, probability, vowel_probability
+	FROM alphagrams WHERE length = ?`, length)
+	exitIfError(err)
+	current := []probRow{}
+	oldProbs := map[string]int{}
+	oldVowelProbs := map[string]int{}
+	for rows.Next() {
+		var r probRow
+		var p, vp sql.NullInt64
+		exitIfError(rows.Scan(&r.alphagram, &r.combinations, &r.numVowels, &p, &vp))
+		r.probability = int(p.Int64)
+		if tieOrder == TieOrderTiles {
+			r.mls = probRowMLs(r.alphagram, dist)
+		}
+		oldProbs[r.alphagram] = r.probability
+		oldVowelProbs[r.alphagram] = int(vp.Int64)
+		current = append(current, r)
+	}
+	exitIfError(rows.Err())
+	rows.Close()
+
+	stmt, err := tx.Prepare(`
+	UPDATE alphagrams SET probability = ?, vowel_probability = ?
+	WHERE alphagram = ?`)
+	exitIfError(err)
+	defer stmt.Close()
+	vowelProbs := map[int]int{}
+	updated := 0
+	for i, r := range recalcOrder(current, tieOrder) {
+		vowelProbs[r.numVowels]++
+		if oldProbs[r.alphagram] == i+1 && oldVowelProbs[r.alphagram] == vowelProbs[r.numVowels] {
+			continue
+		}
+		_, err := stmt.Exec(i+1, vowelProbs[r.numVowels], r.alphagram)
+		exitIfError(err)
+		updated++
+		if updated%recalcProgressEvery == 0 {
+			log.Info().Int("length", length).Int("updated", updated).
+				Int("alphagrams", len(current)).Msg("recalculating probabilities")
+		}
+	}
+	log.Info().Int("length", length).Int("alphagrams", len(current)).
+		Int("updated", updated).Msg("recalculated probabilities")
+	return updated
+}
@@ -0,0 +1,63 @@
+package dbmaker
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecalcOrder(t *testing.T) {
+	rows := []probRow{
+		{alphagram: "GHI", combinations: 5, probability: 1},
+		{alphagram: "DEF", combinations: 10, probability: 3},
+		{alphagram: "ABC", combinations: 10, probability: 2},
+		{alphagram: "AAA", combinations: 10},
+	}
+	order := func(rows []probRow) []string {
+		alphs := []string{}
+		for _, r := range rows {
+			alphs = append(alphs, r.alphagram)
+		}
+		return alphs
+	}
+	assert.Equal(t, []string{"AAA", "ABC", "DEF", "GHI"},
+		order(recalcOrder(rows, TieOrderAlphagram)))
+	// ABC and DEF were already in that order, so they stay in it, and the
+	// new AAA goes before the first of them.
+	rows[1].probability, rows[2].probability = 2, 3
+	assert.Equal(t, []string{"AAA", "DEF", "ABC", "GHI"},
+		order(recalcOrder(rows, TieOrderLegacy)))
+}
+
+func TestRecalcProbabilities(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.Nil(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+	// ABE was added by hand, and ADE's combinations were corrected.
+	_, err = db.Exec(`
+	CREATE TABLE alphagrams (alphagram varchar(20), length int, combinations int,
+		num_vowels int, probability int, vowel_probability int);
+	INSERT INTO alphagrams VALUES
+		('ADE', 3, 50, 2, 1, 1), ('ACE', 3, 40, 2, 2, 2), ('BCD', 3, 30, 0, 3, 1),
+		('ABE', 3, 45, 2, NULL, NULL), ('AB', 2, 9, 1, 1, 1);
+	`)
+	assert.Nil(t, err)
+
+	assert.Equal(t, 3, recalcProbabilities(db, nil, TieOrderAlphagram))
+	got := map[string][2]int{}
+	rows, err := db.Query(`SELECT alphagram, probability, vowel_probability FROM alphagrams`)
+	assert.Nil(t, err)
+	for rows.Next() {
+		var a string
+		var p, vp int
+		assert.Nil(t, rows.Scan(&a, &p, &vp))
+		got[a] = [2]int{p, vp}
+	}
+	rows.Close()
+	assert.Equal(t, map[string][2]int{
+		"ADE": {1, 1}, "ABE": {2, 2}, "ACE": {3, 3}, "BCD": {4, 1}, "AB": {1, 1},
+	}, got)
+	assert.Equal(t, 0, recalcProbabilities(db, nil, TieOrderAlphagram))
+}
@@ -0,0 +1,103 @@
+package dbmaker
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// createSourcesMap reads the source flags of words for the given lexicon,
+// for lexica that federations extend with addenda of their own. The file
+// lives at <lexiconPath>/sources/<lexiconName>.csv and must have a header
+// with (at least) `word` and `source` columns. A word may be listed more
+// than once to give it several flags. Words that aren't listed have none.
+func createSourcesMap(lexiconPath string, lexiconName string) map[string]string {
+	filename := filepath.Join(lexiconPath, "sources", lexiconName+".csv")
+	f, err := os.Open(filename)
+	if err != nil {
+		log.Debug().Msgf("sources map creation: no file named %v found", filename)
+		return nil
+	}
+	defer f.Close()
+	log.Info().Msgf("using sources file: %v", filename)
+	lines, err := csv.NewReader(f).ReadAll()
+	if err != nil || len(lines) == 0 {
+		log.Warn().Err(err).Msgf("could not read sources file %v; ignoring it", filename)
+		return nil
+	}
+	widx := -1
+	sidx := -1
+	for i, h := range lines[0] {
+		switch strings.ToLower(strings.TrimSpace(h)) {
+		case "word":
+			widx = i
+		case "source":
+			sidx = i
+		}
+	}
+	if widx == -1 || sidx == -1 {
+		log.Warn().Msgf("sources file %v has no word or source column; ignoring it", filename)
+		return nil
+	}
+	flags := map[string][]string{}
+	for _, line := range lines[1:] {
+		word := strings.ToUpper(strings.TrimSpace(line[widx]))
+		source := strings.ToUpper(strings.TrimSpace(line[sidx]))
+		if word == "" || source == "" {
+			continue
+		}
+		flags[word] = append(flags[word], source)
+	}
+	if len(flags) == 0 {
+		return nil
+	}
+	sm := make(map[string]string, len(flags))
+	for word, fl := range flags {
+		sort.Strings(fl)
+		sm[word] = strings.Join(slices.Compact(fl), ",")
+	}
+	log.Info().Int("map-size", len(sm)).Msg("created sources map")
+	return sm
+}
+
+func loadSources(db *sql.DB, sources map[string]string) {
+	tx, err := db.Begin()
+	exitIfError(err)
+	_, err = tx.Exec(`UPDATE words SET sources = ''`)
+	exitIfError(err)
+	updateStmt, err := tx.Prepare(`UPDATE words SET sources = ? WHERE word = ?`)
+	exitIfError(err)
+	for word, s := range sources {
+		_, err := updateStmt.Exec(s, word)
+		exitIfError(err)
+	}
+	updateStmt.Close()
+	exitIfError(tx.Commit())
+	setCapability(db, CapabilitySources, sources != nil)
+}
+
+// LoadSources (re)populates the source flags of the words in an existing
+// database, from the lexicon's sources file. The DB <lexiconname>.db must
+// exist in this directory.
+func LoadSources(lexiconName string, lexMap LexiconMap) {
+	_, err := os.Stat(lexiconName + ".db")
+	if os.IsNotExist(err) {
+		log.Fatal().Msg("Database does not exist in this directory.")
+	}
+	db, err := sql.Open("sqlite3", lexiconName+".db")
+	exitIfError(err)
+	defer db.Close()
+
+	lexiconInfo, err := lexMap.GetLexiconInfo(lexiconName)
+	exitIfError(err)
+	if lexiconInfo.Sources == nil {
+		log.Fatal().Msgf("no sources data for %v", lexiconName)
+	}
+	loadSources(db, lexiconInfo.Sources)
+}
@@ -0,0 +1,52 @@
+package dbmaker
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateSourcesMap(t *testing.T) {
+	lexiconPath := t.TempDir()
+	err := os.Mkdir(filepath.Join(lexiconPath, "sources"), 0755)
+	assert.Nil(t, err)
+	err = os.WriteFile(filepath.Join(lexiconPath, "sources", "CSW21.csv"),
+		[]byte("Word,Source\nkiwi,nz\nKAI,NZ\nKAI,au\nKAI,NZ\nZOL,\n"), 0644)
+	assert.Nil(t, err)
+
+	sm := createSourcesMap(lexiconPath, "CSW21")
+	assert.Equal(t, map[string]string{"KIWI": "NZ", "KAI": "AU,NZ"}, sm)
+	assert.Nil(t, createSourcesMap(lexiconPath, "NWL20"))
+}
+
+func TestLoadSources(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.Nil(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+	_, err = db.Exec(`
+	CREATE TABLE words (word varchar(20), sources varchar(32) NOT NULL DEFAULT '');
+	INSERT INTO words VALUES ('KAI', ''), ('KIWI', 'OLD'), ('CAT', 'OLD');
+	`)
+	assert.Nil(t, err)
+
+	loadSources(db, map[string]string{"KAI": "AU,NZ", "KIWI": "NZ"})
+	got := map[string]string{}
+	rows, err := db.Query(`SELECT word, sources FROM words`)
+	assert.Nil(t, err)
+	for rows.Next() {
+		var w, s string
+		assert.Nil(t, rows.Scan(&w, &s))
+		got[w] = s
+	}
+	rows.Close()
+	assert.Equal(t, map[string]string{"KAI": "AU,NZ", "KIWI": "NZ", "CAT": ""}, got)
+
+	var enabled bool
+	assert.Nil(t, db.QueryRow(`SELECT enabled FROM capabilities WHERE name = ?`,
+		CapabilitySources).Scan(&enabled))
+	assert.True(t, enabled)
+}
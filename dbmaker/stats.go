@@ -0,0 +1,66 @@
+package dbmaker
+
+import (
+	"database/sql"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// The lexicon_stats table counts the alphagrams and words of each length and
+// number of anagrams, so that the Stats call doesn't have to scan the
+// lexicon. Every statistic it serves is a sum over some of these rows.
+// total_point_value is the sum of the point values of the words (not the
+// alphagrams), and num_uniq_to_lex is the number of words that are on this
+// lexicon's side of a lexicon split (see SymbolRule.NotIn).
+const createLexiconStatsQuery = `
+	CREATE TABLE IF NOT EXISTS lexicon_stats (length int, num_anagrams int,
+		num_alphagrams int, num_words int, total_point_value int,
+		num_uniq_to_lex int, PRIMARY KEY (length, num_anagrams));
+`
+
+// statsSymbolRules returns the symbol rules that the lexicon's words got
+// their symbols from: a custom lexicon's are its parent's.
+func statsSymbolRules(lexiconInfo *LexiconInfo, lexMap LexiconMap) []SymbolRule {
+	if !lexiconInfo.Custom {
+		return lexiconInfo.Symbols
+	}
+	if parent, err := lexMap.GetLexiconInfo(lexiconInfo.Parent); err == nil {
+		return parent.Symbols
+	}
+	return nil
+}
+
+// writeLexiconStats replaces the lexicon_stats table with the counts of the
+// database as it is now. It must be called again when words are added or
+// removed.
+func writeLexiconStats(db *sql.DB, rules []SymbolRule) {
+	uniq := "0"
+	args := []interface{}{}
+	conds := []string{}
+	for _, r := range rules {
+		if r.NotIn != "" {
+			conds = append(conds, "instr(w.lexicon_symbols, ?) > 0")
+			args = append(args, r.Symbol)
+		}
+	}
+	if len(conds) > 0 {
+		uniq = "SUM(CASE WHEN " + strings.Join(conds, " OR ") + " THEN 1 ELSE 0 END)"
+	}
+
+	tx, err := db.Begin()
+	exitIfError(err)
+	defer tx.Rollback()
+	_, err = tx.Exec(createLexiconStatsQuery + `DELETE FROM lexicon_stats;`)
+	exitIfError(err)
+	_, err = tx.Exec(`
+	INSERT INTO lexicon_stats (length, num_anagrams, num_alphagrams, num_words,
+		total_point_value, num_uniq_to_lex)
+	SELECT a.length, a.num_anagrams, COUNT(DISTINCT a.alphagram), COUNT(w.word),
+		COALESCE(SUM(a.point_value), 0), `+uniq+`
+	FROM alphagrams a INNER JOIN words w USING (alphagram)
+	GROUP BY a.length, a.num_anagrams`, args...)
+	exitIfError(err)
+	exitIfError(tx.Commit())
+	log.Info().Msg("wrote lexicon stats")
+}
@@ -0,0 +1,42 @@
+package dbmaker
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteLexiconStats(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.Nil(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+	_, err = db.Exec(createSchemaQuery + `
+	INSERT INTO alphagrams (alphagram, length, probability, num_anagrams, point_value) VALUES
+		('AT', 2, 1, 2, 2), ('IQ', 2, 2, 1, 11), ('AET', 3, 1, 3, 3);
+	INSERT INTO words (word, alphagram, lexicon_symbols) VALUES ('AT', 'AT', ''),
+		('TA', 'AT', '$'), ('QI', 'IQ', '+'), ('EAT', 'AET', '$+'), ('ETA', 'AET', ''),
+		('TEA', 'AET', '');`)
+	assert.Nil(t, err)
+
+	rules := []SymbolRule{{Symbol: "+", New: true}, {Symbol: "$", NotIn: FamilyCSW}}
+	// Writing them twice replaces them.
+	writeLexiconStats(db, rules)
+	writeLexiconStats(db, rules)
+	rows, err := db.Query(`SELECT length, num_anagrams, num_alphagrams, num_words,
+		total_point_value, num_uniq_to_lex FROM lexicon_stats ORDER BY length, num_anagrams`)
+	assert.Nil(t, err)
+	defer rows.Close()
+	stats := [][6]int{}
+	for rows.Next() {
+		var s [6]int
+		assert.Nil(t, rows.Scan(&s[0], &s[1], &s[2], &s[3], &s[4], &s[5]))
+		stats = append(stats, s)
+	}
+	assert.Equal(t, [][6]int{
+		{2, 1, 1, 1, 11, 0},
+		{2, 2, 1, 2, 4, 1},
+		{3, 3, 1, 3, 9, 1},
+	}, stats)
+}
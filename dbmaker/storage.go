@@ -0,0 +1,140 @@
+package dbmaker
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// StorageOptions are the SQLite settings that databases are built with,
+// and what is done to them once they're built. The server only reads
+// lexicon databases, so it gains from a WAL and a query planner that has
+// statistics.
+type StorageOptions struct {
+	// JournalMode, if set, is the journal_mode the database is left in,
+	// such as wal. A WAL database needs a writable directory to be read.
+	JournalMode string
+	// PageSize, if set, is the page size in bytes: a power of two from 512
+	// to 65536.
+	PageSize int
+	// Synchronous, if set, is the synchronous setting used while building,
+	// e.g. off to build faster. It isn't stored in the database; a
+	// database built with it off may be corrupt if the machine crashes
+	// while it's built.
+	Synchronous string
+	// Analyze runs ANALYZE once the database is built, so that the server
+	// can plan searches with its statistics.
+	Analyze bool
+	// Vacuum runs VACUUM once the database is built.
+	Vacuum bool
+}
+
+var (
+	journalModes      = []string{"delete", "truncate", "persist", "memory", "wal", "off"}
+	synchronousLevels = []string{"off", "normal", "full", "extra"}
+)
+
+// Validate returns an error if the options aren't valid SQLite settings.
+func (o StorageOptions) Validate() error {
+	if o.JournalMode != "" && !slices.Contains(journalModes, strings.ToLower(o.JournalMode)) {
+		return fmt.Errorf("journal mode must be one of %v", strings.Join(journalModes, ", "))
+	}
+	if o.PageSize != 0 && (o.PageSize < 512 || o.PageSize > 65536 || o.PageSize&(o.PageSize-1) != 0) {
+		return fmt.Errorf("page size %d is not a power of two from 512 to 65536", o.PageSize)
+	}
+	if o.Synchronous != "" && !slices.Contains(synchronousLevels, strings.ToLower(o.Synchronous)) {
+		return fmt.Errorf("synchronous must be one of %v", strings.Join(synchronousLevels, ", "))
+	}
+	return nil
+}
+
+// buildDSN returns the DSN to open a database being built with.
+func (o StorageOptions) buildDSN(dbName string) string {
+	if o.Synchronous == "" {
+		return dbName
+	}
+	return dbName + "?_sync=" + strings.ToUpper(o.Synchronous)
+}
+
+// removeSqliteFiles removes a database and the journal files that may be
+// left next to it, which would otherwise be taken for the journal of the
+// database made in its place.
+func removeSqliteFiles(dbName string) {
+	for _, suffix := range []string{"", "-wal", "-shm", "-journal"} {
+		os.Remove(dbName + suffix)
+	}
+}
+
+// finishStorage analyzes and vacuums a built database and sets its page
+// size and journal mode, as the options say.
+func finishStorage(db *sql.DB, o StorageOptions) error {
+	if o.PageSize != 0 {
+		// A new database already has it; this is for an old one, for which
+		// it only takes effect on a VACUUM, and never in WAL mode.
+		var current int
+		if err := db.QueryRow(`PRAGMA page_size`).Scan(&current); err != nil {
+			return err
+		}
+		if current != o.PageSize {
+			if o.JournalMode == "" {
+				// Put it back afterwards.
+				if err := db.QueryRow(`PRAGMA journal_mode`).Scan(&o.JournalMode); err != nil {
+					return err
+				}
+			}
+			if _, err := db.Exec(`PRAGMA journal_mode = delete`); err != nil {
+				return err
+			}
+			if _, err := db.Exec(fmt.Sprintf(`PRAGMA page_size = %d`, o.PageSize)); err != nil {
+				return err
+			}
+			o.Vacuum = true
+		}
+	}
+	if o.Analyze {
+		log.Info().Msg("analyzing")
+		if _, err := db.Exec(`ANALYZE`); err != nil {
+			return err
+		}
+	}
+	if o.Vacuum {
+		log.Info().Msg("vacuuming")
+		if _, err := db.Exec(`VACUUM`); err != nil {
+			return err
+		}
+	}
+	if o.JournalMode != "" {
+		var mode string
+		err := db.QueryRow(`PRAGMA journal_mode = ` + strings.ToLower(o.JournalMode)).Scan(&mode)
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(mode, o.JournalMode) {
+			return fmt.Errorf("journal mode is %v, not %v", mode, o.JournalMode)
+		}
+	}
+	return nil
+}
+
+// OptimizeDatabase applies the storage options to an existing database.
+// Synchronous is ignored, as nothing is built.
+func OptimizeDatabase(dbName string, o StorageOptions) error {
+	if err := o.Validate(); err != nil {
+		return err
+	}
+	if _, err := os.Stat(dbName); err != nil {
+		return err
+	}
+	db, err := sql.Open("sqlite3", dbName)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	// The pragmas and VACUUM must all run on the same connection.
+	db.SetMaxOpenConns(1)
+	return finishStorage(db, o)
+}
@@ -0,0 +1,62 @@
+package dbmaker
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorageOptionsValidate(t *testing.T) {
+	assert.Nil(t, StorageOptions{}.Validate())
+	assert.Nil(t, StorageOptions{JournalMode: "WAL", PageSize: 65536, Synchronous: "normal"}.Validate())
+	assert.NotNil(t, StorageOptions{JournalMode: "fast"}.Validate())
+	assert.NotNil(t, StorageOptions{PageSize: 3000}.Validate())
+	assert.NotNil(t, StorageOptions{PageSize: 256}.Validate())
+	assert.NotNil(t, StorageOptions{Synchronous: "sometimes"}.Validate())
+	assert.Equal(t, "x.db", StorageOptions{}.buildDSN("x.db"))
+	assert.Equal(t, "x.db?_sync=OFF", StorageOptions{Synchronous: "off"}.buildDSN("x.db"))
+}
+
+func TestOptimizeDatabase(t *testing.T) {
+	dbName := filepath.Join(t.TempDir(), "FOO.db")
+	db, err := sql.Open("sqlite3", dbName)
+	assert.Nil(t, err)
+	_, err = db.Exec(`PRAGMA page_size = 4096; PRAGMA journal_mode = wal;
+		CREATE TABLE words (word varchar(20));
+		CREATE INDEX word_index on words(word);
+		INSERT INTO words VALUES ('QI'), ('ZA');`)
+	assert.Nil(t, err)
+	db.Close()
+
+	// The page size can only change out of WAL mode; the database is put
+	// back in it.
+	assert.Nil(t, OptimizeDatabase(dbName, StorageOptions{PageSize: 8192, Analyze: true}))
+	db, err = sql.Open("sqlite3", dbName)
+	assert.Nil(t, err)
+	defer db.Close()
+	var pageSize, stats int
+	var mode string
+	assert.Nil(t, db.QueryRow(`PRAGMA page_size`).Scan(&pageSize))
+	assert.Equal(t, 8192, pageSize)
+	assert.Nil(t, db.QueryRow(`PRAGMA journal_mode`).Scan(&mode))
+	assert.Equal(t, "wal", mode)
+	assert.Nil(t, db.QueryRow(`SELECT COUNT(*) FROM sqlite_stat1`).Scan(&stats))
+	assert.Equal(t, 1, stats)
+
+	assert.NotNil(t, OptimizeDatabase(dbName, StorageOptions{PageSize: 100}))
+	assert.NotNil(t, OptimizeDatabase(filepath.Join(t.TempDir(), "NOPE.db"), StorageOptions{Vacuum: true}))
+}
+
+func TestRemoveSqliteFiles(t *testing.T) {
+	dbName := filepath.Join(t.TempDir(), "FOO.db")
+	for _, suffix := range []string{"", "-wal", "-shm"} {
+		assert.Nil(t, os.WriteFile(dbName+suffix, []byte("x"), 0644))
+	}
+	removeSqliteFiles(dbName)
+	left, err := filepath.Glob(dbName + "*")
+	assert.Nil(t, err)
+	assert.Empty(t, left)
+}
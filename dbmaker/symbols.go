@@ -0,0 +1,179 @@
+package dbmaker
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/domino14/word-golib/kwg"
+)
+
+// LexiconSymbolsFile is the file in the data path's lexica directory that
+// configures the lexicon symbols of lexicon families. It has a list of
+// SymbolRules for each family it changes, e.g.
+//
+//	{"CSW": [{"symbol": "#", "label": "CSW-only", "not_in": "TWL"}]}
+//
+// The families it doesn't mention get the default rules.
+const LexiconSymbolsFile = "lexicon_symbols.json"
+
+// A SymbolRule is a lexicon symbol, and which words of a family get it.
+// A rule either marks new words or words missing from another family.
+type SymbolRule struct {
+	// Symbol is a single character.
+	Symbol      string `json:"symbol"`
+	Label       string `json:"label"`
+	Description string `json:"description"`
+	// New gives the symbol to words that weren't in the prior version of
+	// the lexicon. Their alphagrams are marked contains_update_to_lex.
+	New bool `json:"new,omitempty"`
+	// NotIn gives the symbol to words that aren't in the newest lexicon of
+	// this family. Their alphagrams are marked
+	// contains_word_uniq_to_lex_split.
+	NotIn FamilyName `json:"not_in,omitempty"`
+}
+
+// defaultSymbolRules are the rules of the families that the lexicon
+// symbols file doesn't mention: every family marks new words, and the
+// English families mark the words on their side of the split.
+func defaultSymbolRules(family FamilyName) []SymbolRule {
+	if family == FamilyCustom {
+		// Custom lexica get their parents' symbols.
+		return nil
+	}
+	rules := []SymbolRule{{Symbol: LexiconUpdateSymbol, Label: "new",
+		Description: "new in this version of the lexicon", New: true}}
+	switch family {
+	case FamilyCSW:
+		rules = append(rules, SymbolRule{Symbol: CSWOnlySymbol, Label: "CSW-only",
+			Description: "not in the latest North American lexicon", NotIn: FamilyTWL})
+	case FamilyTWL:
+		rules = append(rules, SymbolRule{Symbol: TWLOnlySymbol, Label: "NWL-only",
+			Description: "not in the latest Collins lexicon", NotIn: FamilyCSW})
+	}
+	return rules
+}
+
+// symbolRules returns the symbol rules of each of the families, from the
+// data path's lexicon symbols file if there is one.
+func symbolRules(dataPath string, families []FamilyName) (map[FamilyName][]SymbolRule, error) {
+	configured := map[FamilyName][]SymbolRule{}
+	bts, err := os.ReadFile(filepath.Join(dataPath, "lexica", LexiconSymbolsFile))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	if err == nil {
+		if err := json.Unmarshal(bts, &configured); err != nil {
+			return nil, fmt.Errorf("%s: %w", LexiconSymbolsFile, err)
+		}
+	}
+	rules := map[FamilyName][]SymbolRule{}
+	for _, family := range families {
+		rs, ok := configured[family]
+		if !ok {
+			rs = defaultSymbolRules(family)
+		}
+		if err := validateSymbolRules(rs); err != nil {
+			return nil, fmt.Errorf("%s: family %v: %w", LexiconSymbolsFile, family, err)
+		}
+		rules[family] = rs
+	}
+	return rules, nil
+}
+
+func validateSymbolRules(rules []SymbolRule) error {
+	seen := map[string]bool{}
+	for _, r := range rules {
+		if utf8.RuneCountInString(r.Symbol) != 1 {
+			return fmt.Errorf("symbol %q must be a single character", r.Symbol)
+		}
+		if seen[r.Symbol] {
+			return fmt.Errorf("symbol %v is used twice", r.Symbol)
+		}
+		seen[r.Symbol] = true
+		if r.New == (r.NotIn != "") {
+			return fmt.Errorf("symbol %v must be for either new words or words not in a family", r.Symbol)
+		}
+	}
+	return nil
+}
+
+// symbolFinder gives words the lexicon symbols of a lexicon's rules. A nil
+// finder gives none.
+type symbolFinder struct {
+	rules []SymbolRule
+	// against has, for each rule, the lexicon the rule's words are
+	// missing from: the prior lexicon for New, or the newest lexicon of
+	// the NotIn family. A rule without one gives its symbol to no words.
+	against []*LexiconInfo
+}
+
+// newSymbolFinder returns a finder for the rules of symbolsFrom, a version
+// of a family whose prior version is priorLex. symbolsFrom and priorLex may
+// be nil.
+func newSymbolFinder(lexMap LexiconMap, symbolsFrom, priorLex *LexiconInfo) *symbolFinder {
+	f := &symbolFinder{}
+	if symbolsFrom == nil {
+		return f
+	}
+	f.rules = symbolsFrom.Symbols
+	for _, r := range f.rules {
+		var against *LexiconInfo
+		if r.New {
+			against = priorLex
+		} else if len(lexMap[r.NotIn]) > 0 {
+			against = lexMap.newestInFamily(r.NotIn)
+		}
+		if against != nil && against.KWG == nil {
+			against = nil
+		}
+		f.against = append(f.against, against)
+	}
+	return f
+}
+
+// find returns the word's lexicon symbols.
+func (f *symbolFinder) find(word string) string {
+	symbols := ""
+	if f == nil {
+		return symbols
+	}
+	for i, r := range f.rules {
+		if f.against[i] != nil && !kwg.FindWord(f.against[i].KWG, word) &&
+			!strings.Contains(symbols, r.Symbol) {
+			symbols += r.Symbol
+		}
+	}
+	return symbols
+}
+
+// flags returns the alphagramFlags of the finder's rules.
+func (f *symbolFinder) flags(lexSymbolsList []string) (uniqToLexSplit, updateToLex uint8) {
+	if f == nil {
+		return 0, 0
+	}
+	return alphagramFlags(f.rules, lexSymbolsList)
+}
+
+// alphagramFlags returns the contains_word_uniq_to_lex_split and
+// contains_update_to_lex columns of an alphagram, given its words' lexicon
+// symbols: 1 if any word has a NotIn or New symbol, respectively.
+func alphagramFlags(rules []SymbolRule, lexSymbolsList []string) (uniqToLexSplit, updateToLex uint8) {
+	for _, r := range rules {
+		for _, symbols := range lexSymbolsList {
+			if !strings.Contains(symbols, r.Symbol) {
+				continue
+			}
+			if r.New {
+				updateToLex = 1
+			} else {
+				uniqToLexSplit = 1
+			}
+		}
+	}
+	return uniqToLexSplit, updateToLex
+}
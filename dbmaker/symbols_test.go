@@ -0,0 +1,93 @@
+package dbmaker
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSymbolRules(t *testing.T) {
+	dataPath := t.TempDir()
+	families := []FamilyName{FamilyCSW, FamilyTWL, FamilyFISE, FamilyCustom}
+	rules, err := symbolRules(dataPath, families)
+	assert.Nil(t, err)
+	assert.Equal(t, defaultSymbolRules(FamilyCSW), rules[FamilyCSW])
+	assert.Equal(t, []string{"+", "$"}, []string{rules[FamilyTWL][0].Symbol, rules[FamilyTWL][1].Symbol})
+	assert.Equal(t, 1, len(rules[FamilyFISE]))
+	assert.Nil(t, rules[FamilyCustom])
+
+	path := filepath.Join(dataPath, "lexica", LexiconSymbolsFile)
+	assert.Nil(t, os.MkdirAll(filepath.Dir(path), 0755))
+	assert.Nil(t, os.WriteFile(path, []byte(`{
+		"FISE": [{"symbol": "*", "label": "nueva", "new": true},
+			{"symbol": "¡", "label": "no OSPS", "not_in": "OSPS"}],
+		"TWL": []
+	}`), 0644))
+	rules, err = symbolRules(dataPath, families)
+	assert.Nil(t, err)
+	assert.Equal(t, []SymbolRule{
+		{Symbol: "*", Label: "nueva", New: true},
+		{Symbol: "¡", Label: "no OSPS", NotIn: FamilyOSPS},
+	}, rules[FamilyFISE])
+	assert.Empty(t, rules[FamilyTWL])
+	assert.Equal(t, defaultSymbolRules(FamilyCSW), rules[FamilyCSW])
+
+	for _, bad := range []string{
+		`{"CSW": [{"symbol": "##", "new": true}]}`,
+		`{"CSW": [{"symbol": "", "new": true}]}`,
+		`{"CSW": [{"symbol": "#", "new": true}, {"symbol": "#", "not_in": "TWL"}]}`,
+		`{"CSW": [{"symbol": "#"}]}`,
+		`{"CSW": [{"symbol": "#", "new": true, "not_in": "TWL"}]}`,
+		`{"CSW": {}}`,
+	} {
+		assert.Nil(t, os.WriteFile(path, []byte(bad), 0644))
+		_, err = symbolRules(dataPath, families)
+		assert.NotNil(t, err, bad)
+	}
+}
+
+func TestAlphagramFlags(t *testing.T) {
+	rules := []SymbolRule{
+		{Symbol: "*", New: true},
+		{Symbol: "!", NotIn: FamilyOSPS},
+	}
+	for _, tc := range []struct {
+		symbols       []string
+		uniq, updated uint8
+	}{
+		{[]string{"", ""}, 0, 0},
+		{[]string{"", "*"}, 0, 1},
+		{[]string{"!", ""}, 1, 0},
+		{[]string{"*!"}, 1, 1},
+		// Symbols the rules don't know about don't count.
+		{[]string{"#", "+"}, 0, 0},
+	} {
+		uniq, updated := alphagramFlags(rules, tc.symbols)
+		assert.Equal(t, tc.uniq, uniq, tc.symbols)
+		assert.Equal(t, tc.updated, updated, tc.symbols)
+	}
+	uniq, updated := alphagramFlags(defaultSymbolRules(FamilyTWL), []string{"$+"})
+	assert.Equal(t, uint8(1), uniq)
+	assert.Equal(t, uint8(1), updated)
+}
+
+func TestSymbolFinderWithoutLexica(t *testing.T) {
+	lexMap := LexiconMap{FamilyCSW: {{LexiconName: "CSW21", Symbols: defaultSymbolRules(FamilyCSW)}}}
+	info := lexMap[FamilyCSW][0]
+	// There's no prior lexicon and no TWL family to check words against.
+	f := newSymbolFinder(lexMap, info, nil)
+	assert.Equal(t, info.Symbols, f.rules)
+	assert.Equal(t, []*LexiconInfo{nil, nil}, f.against)
+	assert.Equal(t, "", f.find("QI"))
+	assert.Empty(t, newSymbolFinder(lexMap, nil, nil).find("QI"))
+	var none *symbolFinder
+	assert.Empty(t, none.find("QI"))
+	uniq, updated := none.flags([]string{"#+"})
+	assert.Zero(t, uniq+updated)
+
+	assert.Equal(t, []LexiconSymbol{{"#", "not in the latest North American lexicon", "CSW-only"}},
+		lexiconSymbols(info.Symbols, false))
+	assert.Equal(t, 2, len(lexiconSymbols(info.Symbols, true)))
+}
@@ -0,0 +1,115 @@
+package dbmaker
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// The word_tags table tags words with tiers from outside the lexicon, such
+// as how common they are in everyday English, so that quizzes can be made
+// of the common words only. A word can have any number of tags.
+const createWordTagsQuery = `
+	CREATE TABLE IF NOT EXISTS word_tags (word varchar(20), tag varchar(32),
+		PRIMARY KEY (tag, word));
+	CREATE INDEX IF NOT EXISTS word_tags_word_index on word_tags(word);
+`
+
+// createTagsMap reads the tags of words for the given lexicon. The file
+// lives at <lexiconPath>/tags/<lexiconName>.csv and must have a header with
+// (at least) `word` and `tag` columns. A word may be listed more than once
+// to give it several tags. Tags are kept in lower case.
+func createTagsMap(lexiconPath string, lexiconName string) map[string][]string {
+	filename := filepath.Join(lexiconPath, "tags", lexiconName+".csv")
+	f, err := os.Open(filename)
+	if err != nil {
+		log.Debug().Msgf("tags map creation: no file named %v found", filename)
+		return nil
+	}
+	defer f.Close()
+	log.Info().Msgf("using tags file: %v", filename)
+	lines, err := csv.NewReader(f).ReadAll()
+	if err != nil || len(lines) == 0 {
+		log.Warn().Err(err).Msgf("could not read tags file %v; ignoring it", filename)
+		return nil
+	}
+	widx := -1
+	tidx := -1
+	for i, h := range lines[0] {
+		switch strings.ToLower(strings.TrimSpace(h)) {
+		case "word":
+			widx = i
+		case "tag":
+			tidx = i
+		}
+	}
+	if widx == -1 || tidx == -1 {
+		log.Warn().Msgf("tags file %v has no word or tag column; ignoring it", filename)
+		return nil
+	}
+	tags := map[string][]string{}
+	for _, line := range lines[1:] {
+		word := strings.ToUpper(strings.TrimSpace(line[widx]))
+		tag := strings.ToLower(strings.TrimSpace(line[tidx]))
+		if word == "" || tag == "" {
+			continue
+		}
+		tags[word] = append(tags[word], tag)
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	for word, t := range tags {
+		sort.Strings(t)
+		tags[word] = slices.Compact(t)
+	}
+	log.Info().Int("map-size", len(tags)).Msg("created tags map")
+	return tags
+}
+
+// loadTags replaces the word_tags of the database with the given ones.
+// Tags of words that aren't in the lexicon are left out.
+func loadTags(db *sql.DB, tags map[string][]string) {
+	tx, err := db.Begin()
+	exitIfError(err)
+	_, err = tx.Exec(`DELETE FROM word_tags`)
+	exitIfError(err)
+	insertStmt, err := tx.Prepare(`INSERT INTO word_tags (word, tag)
+		SELECT word, ? FROM words WHERE word = ?`)
+	exitIfError(err)
+	for word, ts := range tags {
+		for _, t := range ts {
+			_, err := insertStmt.Exec(t, word)
+			exitIfError(err)
+		}
+	}
+	insertStmt.Close()
+	exitIfError(tx.Commit())
+	setCapability(db, CapabilityTags, tags != nil)
+}
+
+// LoadTags (re)populates the tags of the words in an existing database,
+// from the lexicon's tags file. The DB <lexiconname>.db must exist in this
+// directory.
+func LoadTags(lexiconName string, lexMap LexiconMap) {
+	_, err := os.Stat(lexiconName + ".db")
+	if os.IsNotExist(err) {
+		log.Fatal().Msg("Database does not exist in this directory.")
+	}
+	db, err := sql.Open("sqlite3", lexiconName+".db")
+	exitIfError(err)
+	defer db.Close()
+
+	lexiconInfo, err := lexMap.GetLexiconInfo(lexiconName)
+	exitIfError(err)
+	if lexiconInfo.Tags == nil {
+		log.Fatal().Msgf("no tags data for %v", lexiconName)
+	}
+	loadTags(db, lexiconInfo.Tags)
+}
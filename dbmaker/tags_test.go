@@ -0,0 +1,53 @@
+package dbmaker
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateTagsMap(t *testing.T) {
+	lexiconPath := t.TempDir()
+	err := os.Mkdir(filepath.Join(lexiconPath, "tags"), 0755)
+	assert.Nil(t, err)
+	err = os.WriteFile(filepath.Join(lexiconPath, "tags", "CSW21.csv"),
+		[]byte("Word,Tag\ncat,common\nZOEA,Obscure\nZOEA,csw-only\nCAT,Common\nDOG,\n"), 0644)
+	assert.Nil(t, err)
+
+	tags := createTagsMap(lexiconPath, "CSW21")
+	assert.Equal(t, map[string][]string{"CAT": {"common"}, "ZOEA": {"csw-only", "obscure"}}, tags)
+	assert.Nil(t, createTagsMap(lexiconPath, "NWL20"))
+}
+
+func TestLoadTags(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.Nil(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+	_, err = db.Exec(`CREATE TABLE words (word varchar(20));
+	INSERT INTO words VALUES ('CAT'), ('ZOEA');` + createWordTagsQuery + `
+	INSERT INTO word_tags VALUES ('CAT', 'old');`)
+	assert.Nil(t, err)
+
+	// QAT isn't in the lexicon.
+	loadTags(db, map[string][]string{"CAT": {"common"}, "ZOEA": {"csw-only", "obscure"},
+		"QAT": {"common"}})
+	got := map[string][]string{}
+	rows, err := db.Query(`SELECT word, tag FROM word_tags ORDER BY word, tag`)
+	assert.Nil(t, err)
+	for rows.Next() {
+		var w, tag string
+		assert.Nil(t, rows.Scan(&w, &tag))
+		got[w] = append(got[w], tag)
+	}
+	rows.Close()
+	assert.Equal(t, map[string][]string{"CAT": {"common"}, "ZOEA": {"csw-only", "obscure"}}, got)
+
+	var enabled bool
+	assert.Nil(t, db.QueryRow(`SELECT enabled FROM capabilities WHERE name = ?`,
+		CapabilityTags).Scan(&enabled))
+	assert.True(t, enabled)
+}
@@ -0,0 +1,106 @@
+package dbmaker
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+)
+
+// TieOrder is how alphagrams with the same number of combinations (and
+// thus the same probability) are ordered when probabilities are assigned.
+type TieOrder int
+
+const (
+	// TieOrderAlphagram breaks ties alphabetically by alphagram. It is
+	// deterministic, so it's the default for new databases.
+	TieOrderAlphagram TieOrder = iota
+	// TieOrderLegacy keeps the order of an existing database for every
+	// alphagram that is in it. The old Aerolith databases broke ties in
+	// whatever order the generator happened to see the alphagrams in, so
+	// that order can't be recomputed, only copied. Webolith saved lists
+	// refer to alphagrams by probability, so regenerating a database
+	// without this would change what is in them.
+	// Alphagrams that aren't in the existing database go where
+	// TieOrderAlphagram would put them among the others.
+	TieOrderLegacy
+	// TieOrderTiles breaks ties by alphagram, compared tile by tile in the
+	// order of the letter distribution rather than as strings, so that in
+	// Polish AĄ comes before AF. It has to be asked for: lexica whose
+	// letters aren't in code point order, such as German's Ä or Spanish's
+	// digraphs, would be renumbered by it.
+	TieOrderTiles
+)
+
+// ParseTieOrder parses the name of a tie order, as given on the command
+// line.
+func ParseTieOrder(name string) (TieOrder, error) {
+	switch name {
+	case "", "alphagram":
+		return TieOrderAlphagram, nil
+	case "legacy":
+		return TieOrderLegacy, nil
+	case "tiles":
+		return TieOrderTiles, nil
+	}
+	return 0, fmt.Errorf("unknown tie order %q (expected alphagram, legacy or tiles)", name)
+}
+
+// legacyProbabilities returns the probability of every alphagram in the
+// database at dbPath.
+func legacyProbabilities(dbPath string) (map[string]int, error) {
+	// sql.Open would happily create an empty database.
+	if _, err := os.Stat(dbPath); err != nil {
+		return nil, fmt.Errorf("need an existing database for the legacy tie order: %w", err)
+	}
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	rows, err := db.Query(`SELECT alphagram, probability FROM alphagrams`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	probs := map[string]int{}
+	for rows.Next() {
+		var alph string
+		var prob int
+		if err := rows.Scan(&alph, &prob); err != nil {
+			return nil, err
+		}
+		probs[alph] = prob
+	}
+	return probs, rows.Err()
+}
+
+// applyLegacyOrder reorders alphagrams that are sorted by AlphByCombos so
+// that, within each length, the ones in legacy keep their legacy order.
+func applyLegacyOrder(alphs []Alphagram, legacy map[string]int) []Alphagram {
+	byAlphagram := map[string]Alphagram{}
+	kept := map[int][]probRow{}
+	added := map[int][]probRow{}
+	lengths := []int{}
+	for _, a := range alphs {
+		byAlphagram[a.alphagram] = a
+		wl := len(a.mls)
+		if _, ok := kept[wl]; !ok {
+			kept[wl] = []probRow{}
+			lengths = append(lengths, wl)
+		}
+		row := probRow{alphagram: a.alphagram, combinations: a.combinations}
+		if p, ok := legacy[a.alphagram]; ok && p > 0 {
+			row.probability = p
+			kept[wl] = append(kept[wl], row)
+		} else {
+			added[wl] = append(added[wl], row)
+		}
+	}
+	ordered := make([]Alphagram, 0, len(alphs))
+	for _, wl := range lengths {
+		for _, row := range mergeProbabilityOrder(kept[wl], added[wl]) {
+			ordered = append(ordered, byAlphagram[row.alphagram])
+		}
+	}
+	return ordered
+}
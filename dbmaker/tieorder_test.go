@@ -0,0 +1,57 @@
+package dbmaker
+
+import (
+	"database/sql"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyLegacyOrder(t *testing.T) {
+	three := tilemapping.MachineWord{1, 2, 3}
+	two := tilemapping.MachineWord{1, 2}
+	alphs := []Alphagram{
+		{alphagram: "ABC", combinations: 10, mls: three},
+		{alphagram: "ABD", combinations: 10, mls: three},
+		{alphagram: "ABE", combinations: 10, mls: three},
+		{alphagram: "XYZ", combinations: 2, mls: three},
+		{alphagram: "AB", combinations: 5, mls: two},
+		{alphagram: "AC", combinations: 5, mls: two},
+	}
+	sort.Sort(AlphByCombos(alphs))
+
+	// The old database had ABE before ABC, and no ABD.
+	legacy := map[string]int{"ABE": 1, "ABC": 2, "XYZ": 3, "AC": 1, "AB": 2}
+	ordered := applyLegacyOrder(alphs, legacy)
+	names := []string{}
+	for _, a := range ordered {
+		names = append(names, a.alphagram)
+	}
+	assert.Equal(t, []string{"ABD", "ABE", "ABC", "XYZ", "AC", "AB"}, names)
+
+	_, err := ParseTieOrder("random")
+	assert.NotNil(t, err)
+	to, err := ParseTieOrder("legacy")
+	assert.Nil(t, err)
+	assert.Equal(t, TieOrderLegacy, to)
+}
+
+func TestLegacyProbabilities(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "OLD.db")
+	_, err := legacyProbabilities(path)
+	assert.NotNil(t, err)
+
+	db, err := sql.Open("sqlite3", path)
+	assert.Nil(t, err)
+	_, err = db.Exec(`CREATE TABLE alphagrams (alphagram varchar(20), probability int);
+		INSERT INTO alphagrams VALUES('ABE', 1), ('ABC', 2);`)
+	assert.Nil(t, err)
+	db.Close()
+
+	probs, err := legacyProbabilities(path)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int{"ABE": 1, "ABC": 2}, probs)
+}
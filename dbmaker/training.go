@@ -0,0 +1,78 @@
+package dbmaker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/domino14/word_db_server/internal/cardbox"
+)
+
+// trainingColumns are the columns of a difficulty training export. The
+// current rating is current_difficulty, rather than difficulty, so that an
+// export can't be fed back to load-difficulty by mistake.
+var trainingColumns = []string{
+	"alphagram", "length", "probability", "combinations", "vowel_probability",
+	"num_vowels", "point_value", "num_anagrams", "playability", "current_difficulty",
+	"users", "num_correct", "num_incorrect", "accuracy",
+}
+
+// ExportDifficultyTraining writes a CSV with a row for each alphagram of
+// the database that at least minUsers users have answered, giving its
+// features next to the answers' totals and accuracy, for fitting a
+// difficulty model. Alphagrams that fewer users answered are left out, so
+// that no row describes a handful of users. It returns the number of rows.
+func ExportDifficultyTraining(ctx context.Context, db *sql.DB, totals map[string]*cardbox.AnswerTotals,
+	minUsers int, w io.Writer) (int, error) {
+
+	rows, err := db.QueryContext(ctx, `SELECT alphagram, length, probability, combinations,
+		vowel_probability, num_vowels, point_value, num_anagrams, playability, difficulty
+		FROM alphagrams ORDER BY length, probability`)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	cw := csv.NewWriter(w)
+	if err := cw.Write(trainingColumns); err != nil {
+		return 0, err
+	}
+	n := 0
+	for rows.Next() {
+		var alphagram string
+		features := make([]sql.NullInt64, 9)
+		dest := []any{&alphagram}
+		for i := range features {
+			dest = append(dest, &features[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return 0, err
+		}
+		t, ok := totals[alphagram]
+		if !ok || t.Users < minUsers || t.NumCorrect+t.NumIncorrect == 0 {
+			continue
+		}
+		record := []string{alphagram}
+		for _, f := range features {
+			if f.Valid {
+				record = append(record, strconv.FormatInt(f.Int64, 10))
+			} else {
+				record = append(record, "")
+			}
+		}
+		answers := t.NumCorrect + t.NumIncorrect
+		record = append(record, strconv.Itoa(t.Users), strconv.Itoa(t.NumCorrect),
+			strconv.Itoa(t.NumIncorrect),
+			strconv.FormatFloat(float64(t.NumCorrect)/float64(answers), 'f', 4, 64))
+		if err := cw.Write(record); err != nil {
+			return 0, err
+		}
+		n++
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	cw.Flush()
+	return n, cw.Error()
+}
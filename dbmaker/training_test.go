@@ -0,0 +1,46 @@
+package dbmaker
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/domino14/word_db_server/internal/cardbox"
+)
+
+func TestExportDifficultyTraining(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.Nil(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+	_, err = db.Exec(`
+	CREATE TABLE alphagrams (probability int, alphagram varchar(20),
+		length int, combinations int, num_anagrams int,
+		point_value int, num_vowels int, difficulty int,
+		playability int, vowel_probability int);
+	INSERT INTO alphagrams VALUES
+		(1, 'AEINRST', 7, 3000, 9, 7, 3, 12, 4000, 1),
+		(2, 'ACCHNOS', 7, 20, 1, 14, 2, NULL, NULL, 5),
+		(3, 'AEIORST', 7, 2500, 1, 7, 4, 40, 200, 1);
+	`)
+	assert.Nil(t, err)
+
+	totals := map[string]*cardbox.AnswerTotals{
+		"AEINRST": {Alphagram: "AEINRST", Users: 3, NumCorrect: 9, NumIncorrect: 1},
+		"ACCHNOS": {Alphagram: "ACCHNOS", Users: 2, NumCorrect: 1, NumIncorrect: 2},
+		"AEIORST": {Alphagram: "AEIORST", Users: 1, NumCorrect: 1},
+	}
+	var sb strings.Builder
+	n, err := ExportDifficultyTraining(context.Background(), db, totals, 2, &sb)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, n)
+	// AEIORST was only answered by one user.
+	assert.Equal(t, "alphagram,length,probability,combinations,vowel_probability,"+
+		"num_vowels,point_value,num_anagrams,playability,current_difficulty,"+
+		"users,num_correct,num_incorrect,accuracy\n"+
+		"AEINRST,7,1,3000,1,3,7,9,4000,12,3,9,1,0.9000\n"+
+		"ACCHNOS,7,2,20,5,2,14,1,,,2,1,2,0.3333\n", sb.String())
+}
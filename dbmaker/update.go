@@ -0,0 +1,365 @@
+package dbmaker
+
+import (
+	"database/sql"
+	"os"
+	"sort"
+
+	"github.com/domino14/word-golib/kwg"
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/word_db_server/internal/common"
+	"github.com/domino14/word_db_server/internal/dbschema"
+)
+
+// probRow is an alphagram's place in probability order. Rows that are
+// already in the db have a probability; new ones have 0.
+type probRow struct {
+	alphagram    string
+	combinations uint64
+	numVowels    int
+	probability  int
+	// mls are the alphagram's tiles, to break ties with; see alphagramLess.
+	mls tilemapping.MachineWord
+}
+
+// probRowMLs returns the tiles of an alphagram read back from the db, or nil
+// if there is no letter distribution to read them with.
+func probRowMLs(alphagram string, dist *tilemapping.LetterDistribution) tilemapping.MachineWord {
+	if dist == nil {
+		return nil
+	}
+	mls, err := tilemapping.ToMachineLetters(alphagram, dist.TileMapping())
+	if err != nil {
+		return nil
+	}
+	return mls
+}
+
+// diffWordLists returns the words that are in newWords but not oldWords,
+// and vice-versa. Both maps go from word to alphagram.
+func diffWordLists(oldWords, newWords map[string]string) (added, removed []string) {
+	for w := range newWords {
+		if _, ok := oldWords[w]; !ok {
+			added = append(added, w)
+		}
+	}
+	for w := range oldWords {
+		if _, ok := newWords[w]; !ok {
+			removed = append(removed, w)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
+
+// mergeProbabilityOrder merges new alphagrams into the existing probability
+// order of a single length. The existing rows keep their relative order
+// (older dbs have ties in an order we can't recreate), and new rows go in
+// the position AlphByCombos would put them in.
+func mergeProbabilityOrder(kept []probRow, added []probRow) []probRow {
+	sort.Slice(kept, func(i, j int) bool {
+		return kept[i].probability < kept[j].probability
+	})
+	sort.Slice(added, func(i, j int) bool {
+		if added[i].combinations == added[j].combinations {
+			return alphagramLess(added[i].mls, added[j].mls, added[i].alphagram, added[j].alphagram)
+		}
+		return added[i].combinations > added[j].combinations
+	})
+	merged := make([]probRow, 0, len(kept)+len(added))
+	i, j := 0, 0
+	for i < len(kept) || j < len(added) {
+		if j == len(added) {
+			merged = append(merged, kept[i])
+			i++
+			continue
+		}
+		if i == len(kept) {
+			merged = append(merged, added[j])
+			j++
+			continue
+		}
+		k, a := kept[i], added[j]
+		if a.combinations > k.combinations ||
+			(a.combinations == k.combinations && alphagramLess(a.mls, k.mls, a.alphagram, k.alphagram)) {
+			merged = append(merged, a)
+			j++
+		} else {
+			merged = append(merged, k)
+			i++
+		}
+	}
+	return merged
+}
+
+// hookNeighbors returns the words whose hooks may have changed because
+// the given word was added to or removed from the lexicon: the word
+// without its first or last tile, and the word's own hook extensions.
+func hookNeighbors(lexiconInfo *LexiconInfo, wordML tilemapping.MachineWord) []string {
+	tm := lexiconInfo.LetterDistribution.TileMapping()
+	neighbors := []string{}
+	if len(wordML) > 2 {
+		neighbors = append(neighbors,
+			wordML[1:].UserVisible(tm), wordML[:len(wordML)-1].UserVisible(tm))
+	}
+	for _, h := range kwg.FindHooks(lexiconInfo.KWG, wordML, kwg.FrontHooks) {
+		ext := append(tilemapping.MachineWord{h}, wordML...)
+		neighbors = append(neighbors, ext.UserVisible(tm))
+	}
+	for _, h := range kwg.FindHooks(lexiconInfo.KWG, wordML, kwg.BackHooks) {
+		ext := append(append(tilemapping.MachineWord{}, wordML...), h)
+		neighbors = append(neighbors, ext.UserVisible(tm))
+	}
+	return neighbors
+}
+
+// UpdateLexiconDatabase updates an existing database to match the current
+// word list for the lexicon, without rebuilding it from scratch. New words
+// are inserted, removed words are moved to deletedwords, and only the
+// affected alphagrams, hooks and probabilities are recomputed.
+// The DB <lexiconname>.db must exist in this directory, and must be at the
+// current version (migrate it first). New alphagrams go among the ones with
+// the same probability as tieOrder puts them; the others keep their order.
+func UpdateLexiconDatabase(lexiconName string, lexMap LexiconMap, tieOrder TieOrder) {
+	_, err := os.Stat(lexiconName + ".db")
+	if os.IsNotExist(err) {
+		log.Fatal().Msg("Database does not exist in this directory.")
+	}
+	db, err := sql.Open("sqlite3", lexiconName+".db")
+	exitIfError(err)
+	defer db.Close()
+
+	var version int
+	err = db.QueryRow("SELECT version FROM db_version").Scan(&version)
+	exitIfError(err)
+	if version != dbschema.CurrentVersion {
+		log.Fatal().Msgf("database is at version %d; migrate it to version %d first",
+			version, dbschema.CurrentVersion)
+	}
+
+	lexiconInfo, err := lexMap.GetLexiconInfo(lexiconName)
+	exitIfError(err)
+	if lexiconInfo.Custom {
+		log.Fatal().Msg("custom lexica can't be updated in place; rebuild them instead")
+	}
+	lexiconInfo.Initialize()
+	dist := lexiconInfo.LetterDistribution
+	var tieDist *tilemapping.LetterDistribution
+	if tieOrder == TieOrderTiles {
+		tieDist = dist
+	}
+
+	definitions, alphagrams, err := populateAlphsDefs(lexiconInfo.LexiconFilename,
+		lexiconInfo.MachineWordCombinations, dist)
+	exitIfError(err)
+	newWords := map[string]string{}
+	for _, alph := range alphagrams {
+		// CreateLexiconDatabase skips these too.
+		if len(alph.mls) < 2 || len(alph.mls) > 15 {
+			continue
+		}
+		for _, w := range alph.words {
+			newWords[w] = alph.alphagram
+		}
+	}
+
+	oldWords := map[string]string{}
+	rows, err := db.Query(`SELECT word, alphagram FROM words`)
+	exitIfError(err)
+	for rows.Next() {
+		var word, alph string
+		exitIfError(rows.Scan(&word, &alph))
+		oldWords[word] = alph
+	}
+	rows.Close()
+
+	added, removed := diffWordLists(oldWords, newWords)
+	log.Info().Int("added", len(added)).Int("removed", len(removed)).Msg("diffed word lists")
+	if len(added) == 0 && len(removed) == 0 {
+		log.Info().Msg("Database is already up to date")
+		return
+	}
+
+	lexFamily, err := lexMap.familyName(lexiconName)
+	exitIfError(err)
+	priorLex, err := lexMap.priorLexicon(lexFamily, lexiconName)
+	if err != nil {
+		// ignore this
+		log.Err(err).Msg("no prior lexicon, ignoring...")
+	}
+	symbols := newSymbolFinder(lexMap, lexiconInfo, priorLex)
+
+	affectedAlphs := map[string]bool{}
+	neighbors := map[string]bool{}
+	for _, w := range added {
+		affectedAlphs[newWords[w]] = true
+	}
+	for _, w := range removed {
+		affectedAlphs[oldWords[w]] = true
+	}
+
+	tx, err := db.Begin()
+	exitIfError(err)
+
+	// Removed words.
+	for _, w := range removed {
+		mls, err := tilemapping.ToMachineLetters(w, dist.TileMapping())
+		exitIfError(err)
+		for _, n := range hookNeighbors(lexiconInfo, mls) {
+			neighbors[n] = true
+		}
+		_, err = tx.Exec(`DELETE FROM deletedwords WHERE word = ?`, w)
+		exitIfError(err)
+		_, err = tx.Exec(`
+		INSERT INTO deletedwords (word, length, definition)
+		VALUES(?, ?, (SELECT definition FROM words WHERE word = ?))`, w, len(mls), w)
+		exitIfError(err)
+		_, err = tx.Exec(`DELETE FROM words WHERE word = ?`, w)
+		exitIfError(err)
+		_, err = tx.Exec(`DELETE FROM word_tags WHERE word = ?`, w)
+		exitIfError(err)
+	}
+
+	// Added words. Their alphagram rows get rewritten below.
+	for _, w := range added {
+		mls, err := tilemapping.ToMachineLetters(w, dist.TileMapping())
+		exitIfError(err)
+		for _, n := range hookNeighbors(lexiconInfo, mls) {
+			neighbors[n] = true
+		}
+		frontHooks, backHooks, frontInnerHook, backInnerHook := wordHooks(lexiconInfo, mls)
+		_, err = tx.Exec(`DELETE FROM deletedwords WHERE word = ?`, w)
+		exitIfError(err)
+		_, err = tx.Exec(`
+		INSERT INTO words (word, alphagram, lexicon_symbols, definition,
+			front_hooks, back_hooks, inner_front_hook, inner_back_hook, sources)
+		VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			w, newWords[w], symbols.find(w),
+			definitions[w], frontHooks, backHooks, frontInnerHook, backInnerHook,
+			lexiconInfo.Sources[w])
+		exitIfError(err)
+		for _, t := range lexiconInfo.Tags[w] {
+			_, err = tx.Exec(`INSERT INTO word_tags (word, tag) VALUES(?, ?)`, w, t)
+			exitIfError(err)
+		}
+	}
+
+	// Hooks of words next to the added or removed words.
+	for n := range neighbors {
+		if _, ok := newWords[n]; !ok {
+			continue
+		}
+		mls, err := tilemapping.ToMachineLetters(n, dist.TileMapping())
+		exitIfError(err)
+		frontHooks, backHooks, frontInnerHook, backInnerHook := wordHooks(lexiconInfo, mls)
+		_, err = tx.Exec(`
+		UPDATE words SET front_hooks = ?, back_hooks = ?, inner_front_hook = ?,
+			inner_back_hook = ?
+		WHERE word = ?`, frontHooks, backHooks, frontInnerHook, backInnerHook, n)
+		exitIfError(err)
+	}
+	log.Info().Int("neighbors", len(neighbors)).Msg("updated hooks")
+
+	// Rewrite the affected alphagram rows. Their probabilities get fixed
+	// up afterwards.
+	affectedLengths := map[int]bool{}
+	for a := range affectedAlphs {
+		var oldLength int
+		err := tx.QueryRow(`SELECT length FROM alphagrams WHERE alphagram = ?`, a).Scan(&oldLength)
+		if err != nil && err != sql.ErrNoRows {
+			exitIfError(err)
+		}
+		_, err = tx.Exec(`DELETE FROM alphagrams WHERE alphagram = ?`, a)
+		exitIfError(err)
+		if oldLength > 0 {
+			affectedLengths[oldLength] = true
+		}
+		alph, ok := alphagrams[a]
+		if !ok {
+			continue
+		}
+		wl := len(alph.mls)
+		affectedLengths[wl] = true
+		lexSymbolsList := []string{}
+		for _, w := range alph.words {
+			lexSymbolsList = append(lexSymbolsList, symbols.find(w))
+		}
+		uniqToLexSplit, updateToLex := symbols.flags(lexSymbolsList)
+		_, err = tx.Exec(`
+		INSERT INTO alphagrams(probability, alphagram, length, combinations,
+			num_anagrams, point_value, num_vowels, contains_word_uniq_to_lex_split,
+			contains_update_to_lex, difficulty, display_alphagram, playability,
+			vowel_probability)
+		VALUES (0, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, 0)`,
+			alph.alphagram, wl, alph.combinations, len(alph.words),
+			alph.pointValue(dist), alph.numVowels(dist),
+			uniqToLexSplit, updateToLex,
+			alphagramDifficulty(alph.alphagram, lexiconInfo.Difficulties, updateToLex == uint8(1)),
+			common.DisplayMachineWord(alph.mls, dist),
+			alphagramPlayability(alph.words, lexiconInfo.Playabilities))
+		exitIfError(err)
+	}
+
+	for length := range affectedLengths {
+		renumberProbabilities(tx, length, tieDist)
+	}
+	exitIfError(tx.Commit())
+
+	// New and removed words change the counts of the alphagrams a tile
+	// shorter than theirs, which can be anywhere.
+	loadBlankAnagrams(db)
+	rebuildDefinitionsFTS(db)
+	enrichWords(db, DefaultEnrichers())
+	writeValidSince(db, lexMap.lexiconHistory(lexiconInfo))
+	setCapabilitiesFromData(db)
+	writeLexiconStats(db, lexiconInfo.Symbols)
+	log.Info().Msgf("Updated %v", lexiconName)
+}
+
+// renumberProbabilities reassigns probability and vowel_probability for all
+// alphagrams of the given length, and writes the rows that changed. New
+// alphagrams' ties are broken by dist's tiles, or as strings if it's nil.
+func renumberProbabilities(tx *sql.Tx, length int, dist *tilemapping.LetterDistribution) {
+	rows, err := tx.Query(`
+	SELECT alphagram, combinations, num_vowels, probability, vowel_probability
+	FROM alphagrams WHERE length = ?`, length)
+	exitIfError(err)
+	kept := []probRow{}
+	added := []probRow{}
+	oldVowelProbs := map[string]int{}
+	for rows.Next() {
+		var r probRow
+		var vp int
+		exitIfError(rows.Scan(&r.alphagram, &r.combinations, &r.numVowels,
+			&r.probability, &vp))
+		oldVowelProbs[r.alphagram] = vp
+		r.mls = probRowMLs(r.alphagram, dist)
+		if r.probability == 0 {
+			added = append(added, r)
+		} else {
+			kept = append(kept, r)
+		}
+	}
+	rows.Close()
+
+	stmt, err := tx.Prepare(`
+	UPDATE alphagrams SET probability = ?, vowel_probability = ?
+	WHERE alphagram = ?`)
+	exitIfError(err)
+	defer stmt.Close()
+	vowelProbs := map[int]int{}
+	updated := 0
+	for i, r := range mergeProbabilityOrder(kept, added) {
+		vowelProbs[r.numVowels]++
+		if r.probability == i+1 && oldVowelProbs[r.alphagram] == vowelProbs[r.numVowels] {
+			continue
+		}
+		_, err := stmt.Exec(i+1, vowelProbs[r.numVowels], r.alphagram)
+		exitIfError(err)
+		updated++
+	}
+	log.Info().Int("length", length).Int("updated", updated).Msg("renumbered probabilities")
+}
@@ -0,0 +1,36 @@
+package dbmaker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffWordLists(t *testing.T) {
+	oldWords := map[string]string{"RETINAS": "AEINRST", "QI": "IQ", "ZA": "AZ"}
+	newWords := map[string]string{"RETINAS": "AEINRST", "RETAINS": "AEINRST", "ZA": "AZ"}
+	added, removed := diffWordLists(oldWords, newWords)
+	assert.Equal(t, []string{"RETAINS"}, added)
+	assert.Equal(t, []string{"QI"}, removed)
+}
+
+func TestMergeProbabilityOrder(t *testing.T) {
+	// Existing rows keep their order even where it disagrees with the
+	// tie-breaking rule (DEF before ABC).
+	kept := []probRow{
+		{alphagram: "GHI", combinations: 5, probability: 3},
+		{alphagram: "DEF", combinations: 10, probability: 1},
+		{alphagram: "ABC", combinations: 10, probability: 2},
+	}
+	added := []probRow{
+		{alphagram: "XYZ", combinations: 1},
+		{alphagram: "AAA", combinations: 10},
+		{alphagram: "JKL", combinations: 7},
+	}
+	merged := mergeProbabilityOrder(kept, added)
+	order := []string{}
+	for _, r := range merged {
+		order = append(order, r.alphagram)
+	}
+	assert.Equal(t, []string{"AAA", "DEF", "ABC", "JKL", "GHI", "XYZ"}, order)
+}
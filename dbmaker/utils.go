@@ -9,6 +9,8 @@ import (
 	"github.com/domino14/word-golib/kwg"
 	"github.com/domino14/word-golib/tilemapping"
 	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/word_db_server/internal/common"
 )
 
 const DeletionToken = "X"
@@ -21,213 +23,112 @@ func loadKWG(dataPath, lexName string) *kwg.KWG {
 	return k
 }
 
+// defaultFamilies are the lexica built unless the lexicon map file lists
+// its own.
+var defaultFamilies = []common.LexiconFamilyConfig{
+	{Name: "CSW", Lexica: []common.LexiconConfig{
+		{Name: "CSW12", Index: 6, DescriptiveName: "CSW12", LetterDistribution: "english"},
+		{Name: "CSW15", Index: 1, DescriptiveName: "Collins 15", LetterDistribution: "english"},
+		{Name: "CSW19", Index: 12, DescriptiveName: "Collins 2019", LetterDistribution: "english", Difficulty: true},
+		{Name: "CSW21", Index: 18, DescriptiveName: "Collins 2021", LetterDistribution: "english", Difficulty: true},
+	}},
+	{Name: "FISE", Lexica: []common.LexiconConfig{
+		{Name: "FISE09", Index: 8, DescriptiveName: "Federación Internacional de Scrabble en Español", LetterDistribution: "spanish"},
+		{Name: "FISE2", Index: 10, DescriptiveName: "Federación Internacional de Scrabble en Español, 2017 Edition", LetterDistribution: "spanish"},
+	}},
+	{Name: "TWL", Lexica: []common.LexiconConfig{
+		{Name: "OWL2", Index: 4, DescriptiveName: "OWL2", LetterDistribution: "english"},
+		{Name: "America", Index: 7, DescriptiveName: "America", LetterDistribution: "english"},
+		{Name: "NWL18", Index: 9, DescriptiveName: "NASPA Word List, 2020 Edition", LetterDistribution: "english", Difficulty: true},
+		{Name: "NWL20", Index: 15, DescriptiveName: "NASPA Word List, 2020 Edition", LetterDistribution: "english", Difficulty: true},
+		{Name: "NWL23", Index: 24, DescriptiveName: "NASPA Word List, 2023 Edition", LetterDistribution: "english", Difficulty: true},
+	}},
+	{Name: "OSPS", Lexica: []common.LexiconConfig{
+		{Name: "OSPS42", Index: 14, DescriptiveName: "Polska Federacja Scrabble - Update 42", LetterDistribution: "polish"},
+		{Name: "OSPS44", Index: 16, DescriptiveName: "Polska Federacja Scrabble - Update 44", LetterDistribution: "polish"},
+		{Name: "OSPS46", Index: 20, DescriptiveName: "Polska Federacja Scrabble - Update 46", LetterDistribution: "polish"},
+		{Name: "OSPS48", Index: 21, DescriptiveName: "Polska Federacja Scrabble - Update 48", LetterDistribution: "polish"},
+		{Name: "OSPS49", Index: 22, DescriptiveName: "Polska Federacja Scrabble - Update 49", LetterDistribution: "polish"},
+	}},
+	{Name: "Deutsch", Lexica: []common.LexiconConfig{
+		{Name: "Deutsch", KWG: "RD28", Index: 17, DescriptiveName: "Scrabble®-Turnierliste - based on Duden 28th edition", LetterDistribution: "german"},
+	}},
+	{Name: "FRA", Lexica: []common.LexiconConfig{
+		{Name: "FRA20", DescriptiveName: "French 2020 lexicon", LetterDistribution: "french"},
+		{Name: "FRA24", Index: 23, DescriptiveName: "French 2024 lexicon", LetterDistribution: "french"},
+	}},
+}
+
+// LexiconMappings returns the lexica that can be built: the families of
+// the data path's lexicon map file, or defaultFamilies, and the custom
+// lexica. The map file's aliases are given to the lexica they stand for.
 func LexiconMappings(dataPath string) LexiconMap {
 	cfg := map[string]any{"data-path": dataPath}
-
-	englishLD, err := tilemapping.EnglishLetterDistribution(cfg)
-	if err != nil {
-		panic(err)
-	}
-	spanishLD, err := tilemapping.NamedLetterDistribution(cfg, "spanish")
-	if err != nil {
-		panic(err)
-	}
-	polishLD, err := tilemapping.NamedLetterDistribution(cfg, "polish")
-	if err != nil {
-		panic(err)
-	}
-	germanLD, err := tilemapping.NamedLetterDistribution(cfg, "german")
-	if err != nil {
-		panic(err)
-	}
-	frenchLD, err := tilemapping.NamedLetterDistribution(cfg, "french")
-	if err != nil {
-		panic(err)
+	mapConfig, err := common.LoadLexiconMap(dataPath)
+	exitIfError(err)
+	families := defaultFamilies
+	if mapConfig != nil && len(mapConfig.Families) > 0 {
+		families = mapConfig.Families
 	}
 
 	lexiconPath := filepath.Join(dataPath, "lexica")
-
-	cswFamily := []*LexiconInfo{
-		{
-			LexiconName:        "CSW12",
-			LexiconFilename:    filepath.Join(lexiconPath, "CSW12.txt"),
-			LexiconIndex:       6,
-			KWG:                loadKWG(dataPath, "CSW12"),
-			DescriptiveName:    "CSW12",
-			LetterDistribution: englishLD,
-		},
-		{
-			LexiconName:        "CSW15",
-			LexiconFilename:    filepath.Join(lexiconPath, "CSW15.txt"),
-			KWG:                loadKWG(dataPath, "CSW15"),
-			LexiconIndex:       1,
-			DescriptiveName:    "Collins 15",
-			LetterDistribution: englishLD,
-		},
-		{
-			LexiconName:        "CSW19",
-			LexiconFilename:    filepath.Join(lexiconPath, "CSW19.txt"),
-			KWG:                loadKWG(dataPath, "CSW19"),
-			LexiconIndex:       12,
-			DescriptiveName:    "Collins 2019",
-			LetterDistribution: englishLD,
-			Difficulties:       createDifficultyMap(lexiconPath, "CSW19"),
-		},
-		{
-			LexiconName:        "CSW21",
-			LexiconFilename:    filepath.Join(lexiconPath, "CSW21.txt"),
-			KWG:                loadKWG(dataPath, "CSW21"),
-			LexiconIndex:       18,
-			DescriptiveName:    "Collins 2021",
-			LetterDistribution: englishLD,
-			Difficulties:       createDifficultyMap(lexiconPath, "CSW21"),
-		},
-	}
-
-	fiseFamily := []*LexiconInfo{
-		{
-			LexiconName:        "FISE09",
-			LexiconFilename:    filepath.Join(lexiconPath, "FISE09.txt"),
-			KWG:                loadKWG(dataPath, "FISE09"),
-			LexiconIndex:       8,
-			DescriptiveName:    "Federación Internacional de Scrabble en Español",
-			LetterDistribution: spanishLD,
-		},
-		{
-			LexiconName:        "FISE2",
-			LexiconFilename:    filepath.Join(lexiconPath, "FISE2.txt"),
-			KWG:                loadKWG(dataPath, "FISE2"),
-			LexiconIndex:       10,
-			DescriptiveName:    "Federación Internacional de Scrabble en Español, 2017 Edition",
-			LetterDistribution: spanishLD,
-		},
-	}
-
-	twlFamily := []*LexiconInfo{
-		{
-			LexiconName:        "OWL2",
-			LexiconFilename:    filepath.Join(lexiconPath, "OWL2.txt"),
-			KWG:                loadKWG(dataPath, "OWL2"),
-			LexiconIndex:       4,
-			DescriptiveName:    "OWL2",
-			LetterDistribution: englishLD,
-		},
-		{
-			LexiconName:        "America",
-			LexiconFilename:    filepath.Join(lexiconPath, "America.txt"),
-			KWG:                loadKWG(dataPath, "America"),
-			LexiconIndex:       7,
-			DescriptiveName:    "America",
-			LetterDistribution: englishLD,
-		},
-		{
-			LexiconName:        "NWL18",
-			LexiconFilename:    filepath.Join(lexiconPath, "NWL18.txt"),
-			KWG:                loadKWG(dataPath, "NWL18"),
-			LexiconIndex:       9,
-			DescriptiveName:    "NASPA Word List, 2020 Edition",
-			LetterDistribution: englishLD,
-			Difficulties:       createDifficultyMap(lexiconPath, "NWL18"),
-		},
-		{
-			LexiconName:        "NWL20",
-			LexiconFilename:    filepath.Join(lexiconPath, "NWL20.txt"),
-			KWG:                loadKWG(dataPath, "NWL20"),
-			LexiconIndex:       15,
-			DescriptiveName:    "NASPA Word List, 2020 Edition",
-			LetterDistribution: englishLD,
-			Difficulties:       createDifficultyMap(lexiconPath, "NWL20"),
-		},
-		{
-			LexiconName:        "NWL23",
-			LexiconFilename:    filepath.Join(lexiconPath, "NWL23.txt"),
-			KWG:                loadKWG(dataPath, "NWL23"),
-			LexiconIndex:       24,
-			DescriptiveName:    "NASPA Word List, 2023 Edition",
-			LetterDistribution: englishLD,
-			Difficulties:       createDifficultyMap(lexiconPath, "NWL23"),
-		},
-	}
-
-	ospsFamily := []*LexiconInfo{
-		{
-			LexiconName:        "OSPS42",
-			LexiconFilename:    filepath.Join(lexiconPath, "OSPS42.txt"),
-			KWG:                loadKWG(dataPath, "OSPS42"),
-			LexiconIndex:       14,
-			DescriptiveName:    "Polska Federacja Scrabble - Update 42",
-			LetterDistribution: polishLD,
-		},
-		{
-			LexiconName:        "OSPS44",
-			LexiconFilename:    filepath.Join(lexiconPath, "OSPS44.txt"),
-			KWG:                loadKWG(dataPath, "OSPS44"),
-			LexiconIndex:       16,
-			DescriptiveName:    "Polska Federacja Scrabble - Update 44",
-			LetterDistribution: polishLD,
-		},
-		{
-			LexiconName:        "OSPS46",
-			LexiconFilename:    filepath.Join(lexiconPath, "OSPS46.txt"),
-			KWG:                loadKWG(dataPath, "OSPS46"),
-			LexiconIndex:       20,
-			DescriptiveName:    "Polska Federacja Scrabble - Update 46",
-			LetterDistribution: polishLD,
-		},
-		{
-			LexiconName:        "OSPS48",
-			LexiconFilename:    filepath.Join(lexiconPath, "OSPS48.txt"),
-			KWG:                loadKWG(dataPath, "OSPS48"),
-			LexiconIndex:       21,
-			DescriptiveName:    "Polska Federacja Scrabble - Update 48",
-			LetterDistribution: polishLD,
-		},
-		{
-			LexiconName:        "OSPS49",
-			LexiconFilename:    filepath.Join(lexiconPath, "OSPS49.txt"),
-			KWG:                loadKWG(dataPath, "OSPS49"),
-			LexiconIndex:       22,
-			DescriptiveName:    "Polska Federacja Scrabble - Update 49",
-			LetterDistribution: polishLD,
-		},
-	}
-
-	deutschFamily := []*LexiconInfo{
-		{
-			LexiconName:        "Deutsch",
-			LexiconFilename:    filepath.Join(lexiconPath, "Deutsch.txt"),
-			KWG:                loadKWG(dataPath, "RD28"),
-			LexiconIndex:       17,
-			DescriptiveName:    "Scrabble®-Turnierliste - based on Duden 28th edition",
-			LetterDistribution: germanLD,
-		},
-	}
-
-	frenchFamily := []*LexiconInfo{
-		{
-			LexiconName:        "FRA20",
-			LexiconFilename:    filepath.Join(lexiconPath, "FRA20.txt"),
-			KWG:                loadKWG(dataPath, "FRA20"),
-			DescriptiveName:    "French 2020 lexicon",
-			LetterDistribution: frenchLD,
-		},
-		{
-			LexiconName:        "FRA24",
-			LexiconFilename:    filepath.Join(lexiconPath, "FRA24.txt"),
-			KWG:                loadKWG(dataPath, "FRA24"),
-			DescriptiveName:    "French 2024 lexicon",
-			LetterDistribution: frenchLD,
-			LexiconIndex:       23,
-		},
-	}
-
-	lexiconMap := LexiconMap{
-		FamilyCSW:     cswFamily,
-		FamilyFISE:    fiseFamily,
-		FamilyTWL:     twlFamily,
-		FamilyOSPS:    ospsFamily,
-		FamilyDeutsch: deutschFamily,
-		FamilyFrench:  frenchFamily,
+	dists := map[string]*tilemapping.LetterDistribution{}
+	lexiconMap := LexiconMap{}
+	for _, f := range families {
+		family := LexiconFamily{}
+		for _, l := range f.Lexica {
+			ld, ok := dists[l.LetterDistribution]
+			if !ok {
+				ld, err = tilemapping.NamedLetterDistribution(cfg, l.LetterDistribution)
+				if err != nil {
+					panic(err)
+				}
+				dists[l.LetterDistribution] = ld
+			}
+			kwgName := l.Name
+			if l.KWG != "" {
+				kwgName = l.KWG
+			}
+			info := &LexiconInfo{
+				LexiconName:        l.Name,
+				LexiconFilename:    filepath.Join(lexiconPath, l.Name+".txt"),
+				KWG:                loadKWG(dataPath, kwgName),
+				LexiconIndex:       l.Index,
+				DescriptiveName:    l.DescriptiveName,
+				LetterDistribution: ld,
+			}
+			if l.File != "" {
+				info.LexiconFilename = filepath.Join(lexiconPath, l.File)
+			}
+			if info.DescriptiveName == "" {
+				info.DescriptiveName = l.Name
+			}
+			if l.Difficulty {
+				info.Difficulties = createDifficultyMap(lexiconPath, l.Name)
+				info.Playabilities = createPlayabilityMap(lexiconPath, l.Name)
+			}
+			family = append(family, info)
+		}
+		lexiconMap[FamilyName(f.Name)] = family
+	}
+	if custom := customLexica(dataPath); len(custom) > 0 {
+		lexiconMap[FamilyCustom] = custom
+	}
+	familyNames := []FamilyName{}
+	for name := range lexiconMap {
+		familyNames = append(familyNames, name)
+	}
+	rules, err := symbolRules(dataPath, familyNames)
+	exitIfError(err)
+	for name, family := range lexiconMap {
+		for _, info := range family {
+			info.Sources = createSourcesMap(lexiconPath, info.LexiconName)
+			info.Tags = createTagsMap(lexiconPath, info.LexiconName)
+			info.Symbols = rules[name]
+		}
+	}
+	if mapConfig != nil {
+		lexiconMap.addAliases(mapConfig)
 	}
 
 	return lexiconMap
@@ -262,3 +163,70 @@ func MoveFile(sourcePath, destPath string) error {
 	}
 	return nil
 }
+
+// customLexica returns the lexica registered in the data path's custom
+// lexica file.
+func customLexica(dataPath string) LexiconFamily {
+	registered, err := common.CustomLexica(dataPath)
+	if err != nil {
+		log.Err(err).Msg("unable to read custom lexica")
+		return nil
+	}
+	family := LexiconFamily{}
+	for _, c := range registered {
+		info, err := customLexiconInfo(dataPath, c)
+		if err != nil {
+			log.Err(err).Str("lexName", c.Name).Msg("unable to load letter distribution")
+			continue
+		}
+		family = append(family, info)
+	}
+	return family
+}
+
+// customLexiconInfo returns the LexiconInfo for a custom lexicon. A custom
+// lexicon doesn't need a KWG; without one, its hooks are found from its
+// own word list.
+func customLexiconInfo(dataPath string, c *common.CustomLexicon) (*LexiconInfo, error) {
+	ld, err := tilemapping.NamedLetterDistribution(map[string]any{"data-path": dataPath},
+		c.LetterDistribution)
+	if err != nil {
+		return nil, err
+	}
+	info := &LexiconInfo{
+		LexiconName:        c.Name,
+		LexiconFilename:    c.WordListPath(dataPath),
+		DescriptiveName:    c.DescriptiveName,
+		LetterDistribution: ld,
+		Custom:             true,
+		Parent:             c.Parent,
+	}
+	if info.DescriptiveName == "" {
+		info.DescriptiveName = c.Name
+	}
+	if _, err := os.Stat(filepath.Join(dataPath, "lexica", "gaddag", c.Name+".kwg")); err == nil {
+		info.KWG = loadKWG(dataPath, c.Name)
+	}
+	return info, nil
+}
+
+// AddWordList adds a word list that isn't registered anywhere to the map,
+// as a custom lexicon. If the lexicon has no parent, its words get their
+// lexicon symbols from the newest lexicon of the given family, if any.
+func (m LexiconMap) AddWordList(dataPath string, c *common.CustomLexicon, family FamilyName) error {
+	if _, err := m.GetLexiconInfo(c.Name); err == nil {
+		return fmt.Errorf("there is already a lexicon named %v", c.Name)
+	}
+	if c.Parent == "" && family != "" {
+		if len(m[family]) == 0 || family == FamilyCustom {
+			return fmt.Errorf("unknown lexicon family %v", family)
+		}
+		c.Parent = m.newestInFamily(family).LexiconName
+	}
+	info, err := customLexiconInfo(dataPath, c)
+	if err != nil {
+		return err
+	}
+	m[FamilyCustom] = append(m[FamilyCustom], info)
+	return nil
+}
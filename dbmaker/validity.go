@@ -0,0 +1,132 @@
+package dbmaker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/domino14/word-golib/tilemapping"
+)
+
+// The validity export is a KWG with only a DAWG, minimized, so clients
+// can check words offline with any KWG reader (word-golib, wolges) in a
+// fraction of the database's size. Its GADDAG is empty. See
+// https://github.com/andy-k/wolges/blob/main/details.txt for the format.
+
+const (
+	kwgAccepts = 0x800000
+	kwgIsEnd   = 0x400000
+	kwgMaxArc  = 0x3fffff
+)
+
+type dawgNode struct {
+	children map[tilemapping.MachineLetter]*dawgNode
+	accepts  bool
+}
+
+func (n *dawgNode) add(word tilemapping.MachineWord) {
+	for _, ml := range word {
+		if n.children == nil {
+			n.children = map[tilemapping.MachineLetter]*dawgNode{}
+		}
+		child, ok := n.children[ml]
+		if !ok {
+			child = &dawgNode{}
+			n.children[ml] = child
+		}
+		n = child
+	}
+	n.accepts = true
+}
+
+// ExportValidity writes the validity export of the words in the database
+// and returns how many words it has.
+func ExportValidity(ctx context.Context, db *sql.DB, dist *tilemapping.LetterDistribution,
+	w io.Writer) (int, error) {
+
+	rows, err := db.QueryContext(ctx, `SELECT word FROM words`)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	root := &dawgNode{}
+	n := 0
+	for rows.Next() {
+		var word string
+		if err := rows.Scan(&word); err != nil {
+			return 0, err
+		}
+		mw, err := tilemapping.ToMachineLetters(word, dist.TileMapping())
+		if err != nil {
+			return 0, fmt.Errorf("%v: %w", word, err)
+		}
+		root.add(mw)
+		n++
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	nodes, err := dawgNodes(root)
+	if err != nil {
+		return 0, err
+	}
+	return n, binary.Write(w, binary.LittleEndian, nodes)
+}
+
+// dawgNodes lays out the DAWG of the trie as KWG nodes. Identical
+// subtrees are written once, which minimizes it.
+func dawgNodes(root *dawgNode) ([]uint32, error) {
+	// The first two nodes point to the DAWG's and the GADDAG's roots.
+	nodes := []uint32{0, kwgIsEnd}
+	// The arcs of the sibling lists written so far, by their nodes.
+	written := map[string]uint32{}
+	var emit func(n *dawgNode) (uint32, error)
+	emit = func(n *dawgNode) (uint32, error) {
+		if len(n.children) == 0 {
+			return 0, nil
+		}
+		tiles := make([]tilemapping.MachineLetter, 0, len(n.children))
+		for ml := range n.children {
+			tiles = append(tiles, ml)
+		}
+		slices.Sort(tiles)
+		siblings := make([]uint32, len(tiles))
+		for i, ml := range tiles {
+			child := n.children[ml]
+			arc, err := emit(child)
+			if err != nil {
+				return 0, err
+			}
+			siblings[i] = uint32(ml)<<24 | arc
+			if child.accepts {
+				siblings[i] |= kwgAccepts
+			}
+			if i == len(tiles)-1 {
+				siblings[i] |= kwgIsEnd
+			}
+		}
+		key := make([]byte, 0, 4*len(siblings))
+		for _, s := range siblings {
+			key = binary.LittleEndian.AppendUint32(key, s)
+		}
+		if arc, ok := written[string(key)]; ok {
+			return arc, nil
+		}
+		arc := uint32(len(nodes))
+		if int(arc)+len(siblings) > kwgMaxArc {
+			return 0, fmt.Errorf("too many nodes for a KWG of %d", kwgMaxArc)
+		}
+		nodes = append(nodes, siblings...)
+		written[string(key)] = arc
+		return arc, nil
+	}
+	arc, err := emit(root)
+	if err != nil {
+		return nil, err
+	}
+	nodes[0] = kwgIsEnd | arc
+	return nodes, nil
+}
@@ -0,0 +1,49 @@
+package dbmaker
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/domino14/word-golib/kwg"
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportValidity(t *testing.T) {
+	ld, err := tilemapping.ScanLetterDistribution(strings.NewReader(`?,2,0,0
+A,9,1,1
+B,2,3,0
+S,4,1,0
+T,6,1,0
+`))
+	assert.Nil(t, err)
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.Nil(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+	_, err = db.Exec(`
+	CREATE TABLE words (word varchar(20));
+	INSERT INTO words VALUES ('AT'), ('ATS'), ('BAT'), ('BATS'), ('TAB'), ('TABS'), ('TAT'), ('TATS');
+	`)
+	assert.Nil(t, err)
+
+	var buf bytes.Buffer
+	n, err := ExportValidity(context.Background(), db, ld, &buf)
+	assert.Nil(t, err)
+	assert.Equal(t, 8, n)
+	// The words all share one S ending, and AT and BAT share their AT:
+	// 2 root pointers and 9 arcs.
+	assert.Equal(t, 4*11, buf.Len())
+
+	d, err := kwg.ScanKWG(bytes.NewReader(buf.Bytes()), buf.Len())
+	assert.Nil(t, err)
+	for word, valid := range map[string]bool{"AT": true, "ATS": true, "BATS": true,
+		"TAB": true, "TATS": true, "A": false, "TA": false, "BAS": false, "TABSS": false} {
+		mw, err := tilemapping.ToMachineLetters(word, ld.TileMapping())
+		assert.Nil(t, err)
+		assert.Equal(t, valid, kwg.FindMachineWord(d, mw), word)
+	}
+}
@@ -0,0 +1,242 @@
+package dbmaker
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/domino14/word_db_server/internal/dbschema"
+)
+
+// maxVerifyExamples is how many of a check's problems are listed in its
+// report; the rest are only counted.
+const maxVerifyExamples = 10
+
+// A VerifyReport is the result of checking a database's invariants.
+type VerifyReport struct {
+	Lexicon string        `json:"lexicon"`
+	OK      bool          `json:"ok"`
+	Checks  []VerifyCheck `json:"checks"`
+}
+
+// A VerifyCheck is the result of one check of a database. Problems counts
+// what it found wrong, and Examples describes the first few of those.
+type VerifyCheck struct {
+	Name     string   `json:"name"`
+	OK       bool     `json:"ok"`
+	Problems int      `json:"problems"`
+	Examples []string `json:"examples,omitempty"`
+}
+
+func (c *VerifyCheck) problem(format string, args ...any) {
+	c.Problems++
+	if len(c.Examples) < maxVerifyExamples {
+		c.Examples = append(c.Examples, fmt.Sprintf(format, args...))
+	}
+}
+
+// Verify checks the invariants that a freshly built database holds:
+//   - every word's alphagram is in the alphagrams table;
+//   - every alphagram's num_anagrams is its number of words;
+//   - the probabilities of the alphagrams of each length are 1 to their
+//     number, with none repeated;
+//   - its lexicon_stats count the alphagrams and words of each length;
+//   - it has all the indexes that a new database is created with;
+//   - its db_version is dbschema.CurrentVersion.
+func Verify(ctx context.Context, db *sql.DB, lexicon string) (*VerifyReport, error) {
+	report := &VerifyReport{Lexicon: lexicon, OK: true}
+	for _, check := range []struct {
+		name string
+		run  func(context.Context, *sql.DB, *VerifyCheck) error
+	}{
+		{"word_alphagrams", verifyWordAlphagrams},
+		{"num_anagrams", verifyNumAnagrams},
+		{"dense_probabilities", verifyProbabilities},
+		{"lexicon_stats", verifyLexiconStats},
+		{"indexes", verifyIndexes},
+		{"db_version", verifyVersion},
+	} {
+		c := VerifyCheck{Name: check.name}
+		if err := check.run(ctx, db, &c); err != nil {
+			return nil, fmt.Errorf("%v: %w", check.name, err)
+		}
+		c.OK = c.Problems == 0
+		report.OK = report.OK && c.OK
+		report.Checks = append(report.Checks, c)
+	}
+	return report, nil
+}
+
+func verifyWordAlphagrams(ctx context.Context, db *sql.DB, c *VerifyCheck) error {
+	rows, err := db.QueryContext(ctx, `SELECT w.word, w.alphagram FROM words w
+		LEFT JOIN alphagrams a ON a.alphagram = w.alphagram
+		WHERE a.alphagram IS NULL ORDER BY w.word`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var word, alphagram string
+		if err := rows.Scan(&word, &alphagram); err != nil {
+			return err
+		}
+		c.problem("%v: alphagram %v is missing", word, alphagram)
+	}
+	return rows.Err()
+}
+
+func verifyNumAnagrams(ctx context.Context, db *sql.DB, c *VerifyCheck) error {
+	rows, err := db.QueryContext(ctx, `SELECT a.alphagram, a.num_anagrams, COUNT(w.word)
+		FROM alphagrams a LEFT JOIN words w ON w.alphagram = a.alphagram
+		GROUP BY a.alphagram HAVING a.num_anagrams IS NOT COUNT(w.word)
+		ORDER BY a.alphagram`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var alphagram string
+		var numAnagrams sql.NullInt64
+		var words int
+		if err := rows.Scan(&alphagram, &numAnagrams, &words); err != nil {
+			return err
+		}
+		c.problem("%v: num_anagrams is %v, but it has %d words", alphagram,
+			nullString(numAnagrams), words)
+	}
+	return rows.Err()
+}
+
+func verifyProbabilities(ctx context.Context, db *sql.DB, c *VerifyCheck) error {
+	rows, err := db.QueryContext(ctx, `SELECT length, COUNT(*), COUNT(DISTINCT probability),
+		MIN(probability), MAX(probability) FROM alphagrams GROUP BY length ORDER BY length`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var length, n, distinct int
+		var lo, hi sql.NullInt64
+		if err := rows.Scan(&length, &n, &distinct, &lo, &hi); err != nil {
+			return err
+		}
+		if distinct != n || lo.Int64 != 1 || hi.Int64 != int64(n) {
+			c.problem("length %d: %d alphagrams have %d distinct probabilities from %v to %v",
+				length, n, distinct, nullString(lo), nullString(hi))
+		}
+	}
+	return rows.Err()
+}
+
+func verifyLexiconStats(ctx context.Context, db *sql.DB, c *VerifyCheck) error {
+	rows, err := db.QueryContext(ctx, `SELECT c.length, c.alphagrams, c.words,
+		s.alphagrams, s.words
+		FROM (SELECT a.length, COUNT(DISTINCT a.alphagram) AS alphagrams, COUNT(w.word) AS words
+			FROM alphagrams a INNER JOIN words w USING (alphagram) GROUP BY a.length) c
+		LEFT JOIN (SELECT length, SUM(num_alphagrams) AS alphagrams, SUM(num_words) AS words
+			FROM lexicon_stats GROUP BY length) s USING (length)
+		WHERE s.alphagrams IS NOT c.alphagrams OR s.words IS NOT c.words
+		ORDER BY c.length`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var length, alphagrams, words int
+		var statAlphagrams, statWords sql.NullInt64
+		if err := rows.Scan(&length, &alphagrams, &words, &statAlphagrams, &statWords); err != nil {
+			return err
+		}
+		c.problem("length %d: %d alphagrams and %d words, but lexicon_stats has %v and %v",
+			length, alphagrams, words, nullString(statAlphagrams), nullString(statWords))
+	}
+	return rows.Err()
+}
+
+func nullString(n sql.NullInt64) string {
+	if !n.Valid {
+		return "NULL"
+	}
+	return fmt.Sprint(n.Int64)
+}
+
+// schemaIndexes returns the names of the indexes that a new database is
+// created with.
+func schemaIndexes(ctx context.Context) ([]string, error) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+	if _, err := db.ExecContext(ctx, createSchemaQuery); err != nil {
+		return nil, err
+	}
+	return indexNames(ctx, db)
+}
+
+func indexNames(ctx context.Context, db *sql.DB) ([]string, error) {
+	rows, err := db.QueryContext(ctx, `SELECT name FROM sqlite_master
+		WHERE type = 'index' AND name NOT LIKE 'sqlite_autoindex_%' ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, rows.Err()
+}
+
+func verifyIndexes(ctx context.Context, db *sql.DB, c *VerifyCheck) error {
+	want, err := schemaIndexes(ctx)
+	if err != nil {
+		return err
+	}
+	have, err := indexNames(ctx, db)
+	if err != nil {
+		return err
+	}
+	for _, name := range want {
+		if !slices.Contains(have, name) {
+			c.problem("index %v is missing", name)
+		}
+	}
+	return nil
+}
+
+func verifyVersion(ctx context.Context, db *sql.DB, c *VerifyCheck) error {
+	rows, err := db.QueryContext(ctx, `SELECT version FROM db_version`)
+	if err != nil {
+		if strings.Contains(err.Error(), "no such table") {
+			c.problem("there is no db_version table")
+			return nil
+		}
+		return err
+	}
+	defer rows.Close()
+	versions := []int{}
+	for rows.Next() {
+		var v int
+		if err := rows.Scan(&v); err != nil {
+			return err
+		}
+		versions = append(versions, v)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if len(versions) != 1 {
+		c.problem("db_version has %d rows rather than 1", len(versions))
+	} else if versions[0] != dbschema.CurrentVersion {
+		c.problem("db_version is %d, not %d; migrate it", versions[0], dbschema.CurrentVersion)
+	}
+	return nil
+}
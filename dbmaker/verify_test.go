@@ -0,0 +1,59 @@
+package dbmaker
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/domino14/word_db_server/internal/dbschema"
+)
+
+func TestVerify(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.Nil(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+	_, err = db.Exec(createSchemaQuery + `
+	INSERT INTO alphagrams (alphagram, length, probability, num_anagrams) VALUES
+		('AT', 2, 1, 2), ('IQ', 2, 2, 1), ('AET', 3, 1, 3);
+	INSERT INTO words (word, alphagram) VALUES ('AT', 'AT'), ('TA', 'AT'), ('QI', 'IQ'),
+		('EAT', 'AET'), ('ETA', 'AET'), ('TEA', 'AET');`)
+	assert.Nil(t, err)
+	_, err = db.Exec(`INSERT INTO db_version VALUES (?)`, dbschema.CurrentVersion)
+	assert.Nil(t, err)
+	writeLexiconStats(db, nil)
+
+	ctx := context.Background()
+	report, err := Verify(ctx, db, "FOO")
+	assert.Nil(t, err)
+	assert.True(t, report.OK)
+	assert.Len(t, report.Checks, 6)
+
+	_, err = db.Exec(`
+	INSERT INTO words (word, alphagram) VALUES ('ZA', 'AZ');
+	UPDATE alphagrams SET num_anagrams = 1 WHERE alphagram = 'AET';
+	UPDATE alphagrams SET probability = 1 WHERE alphagram = 'IQ';
+	UPDATE lexicon_stats SET num_words = 5 WHERE length = 3;
+	DROP INDEX word_index;
+	UPDATE db_version SET version = 3;`)
+	assert.Nil(t, err)
+	report, err = Verify(ctx, db, "FOO")
+	assert.Nil(t, err)
+	assert.False(t, report.OK)
+	problems := map[string][]string{}
+	for _, c := range report.Checks {
+		assert.Equal(t, c.Problems == 0, c.OK, c.Name)
+		problems[c.Name] = c.Examples
+	}
+	assert.Equal(t, map[string][]string{
+		"word_alphagrams":     {"ZA: alphagram AZ is missing"},
+		"num_anagrams":        {"AET: num_anagrams is 1, but it has 3 words"},
+		"dense_probabilities": {"length 2: 2 alphagrams have 1 distinct probabilities from 1 to 1"},
+		"lexicon_stats":       {"length 3: 1 alphagrams and 3 words, but lexicon_stats has 1 and 5"},
+		"indexes":             {"index word_index is missing"},
+		"db_version":          {fmt.Sprintf("db_version is 3, not %d; migrate it", dbschema.CurrentVersion)},
+	}, problems)
+}
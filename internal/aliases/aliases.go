@@ -0,0 +1,175 @@
+// Package aliases lets requests name lexica by old names, which the lexicon
+// map file makes aliases of their successors (NWL18 for NWL20, say). The
+// requests are served as if they had named the successors, and their
+// responses carry a deprecation warning in the Warning header.
+package aliases
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/domino14/word_db_server/internal/common"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+	pbv2 "github.com/domino14/word_db_server/rpc/wordsearcher/v2"
+)
+
+// WarningHeader is the response header deprecation warnings are sent in.
+const WarningHeader = "Warning"
+
+// Aliases resolves the aliases of a lexicon map.
+type Aliases struct {
+	lexMap *common.LexiconMapConfig
+}
+
+// New returns the aliases of the lexicon map, or nil if it has none.
+func New(lexMap *common.LexiconMapConfig) *Aliases {
+	if lexMap == nil || len(lexMap.Aliases) == 0 {
+		return nil
+	}
+	return &Aliases{lexMap: lexMap}
+}
+
+// rewrite changes the lexicon names of a request that are aliases to the
+// lexica they stand for, and returns the warnings for the aliases used.
+func (a *Aliases) rewrite(req any) []string {
+	warnings := []string{}
+	resolve := func(name *string) {
+		target, alias, err := a.lexMap.Resolve(*name)
+		if err != nil || alias == nil {
+			return
+		}
+		warnings = append(warnings, alias.Warning(target))
+		*name = target
+	}
+	resolveV2 := func(l *pbv2.Lexicon) {
+		if l == nil {
+			return
+		}
+		name := l.Name()
+		resolve(&name)
+		if name != l.Name() {
+			l.Family, l.Version = splitName(name)
+		}
+	}
+
+	switch r := req.(type) {
+	case *pb.SearchRequest:
+		for _, p := range r.Searchparams {
+			switch p.Condition {
+			case pb.SearchRequest_LEXICON:
+				if v := p.GetStringvalue(); v != nil {
+					resolve(&v.Value)
+				}
+			case pb.SearchRequest_LEXICON_DIFF:
+				if d := p.GetLexicondiff(); d != nil {
+					resolve(&d.OtherLexicon)
+				}
+			}
+		}
+	case *pb.SaveSearchRequest:
+		// The search is saved with the lexica it stands for, since running
+		// it later doesn't go through this.
+		if r.Search != nil {
+			warnings = append(warnings, a.rewrite(r.Search)...)
+		}
+	case *pb.SearchResponse:
+		// An Expand request; its alphagrams can be of other lexica.
+		resolve(&r.Lexicon)
+		for _, alph := range r.Alphagrams {
+			if alph.Lexicon != "" {
+				resolve(&alph.Lexicon)
+			}
+		}
+	case *pbv2.SearchRequest:
+		resolveV2(r.Lexicon)
+		for _, c := range r.Conditions {
+			if d := c.GetLexiconDiff(); d != nil {
+				resolveV2(d.OtherLexicon)
+			}
+		}
+	case *pbv2.ExpandRequest:
+		resolveV2(r.Lexicon)
+		for _, alph := range r.Alphagrams {
+			resolveV2(alph.Lexicon)
+		}
+	case proto.Message:
+		// The other requests name their lexicon in a lexicon field.
+		m := r.ProtoReflect()
+		fd := m.Descriptor().Fields().ByName("lexicon")
+		if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+			break
+		}
+		old := m.Get(fd).String()
+		name := old
+		resolve(&name)
+		if name != old {
+			m.Set(fd, protoreflect.ValueOfString(name))
+		}
+	}
+	return warnings
+}
+
+// splitName splits a lexicon name into the family and version of a
+// version 2 Lexicon: NWL20 into NWL and 20.
+func splitName(name string) (string, string) {
+	family := strings.TrimRight(name, "0123456789")
+	return family, name[len(family):]
+}
+
+// warningValue returns the Warning header value of the warnings.
+func warningValue(warnings []string) string {
+	values := make([]string, len(warnings))
+	for i, w := range warnings {
+		values[i] = `299 - "` + strings.ReplaceAll(w, `"`, `'`) + `"`
+	}
+	return strings.Join(values, ", ")
+}
+
+// Interceptor is a Twirp interceptor that resolves the aliases in
+// requests. It goes before the interceptors that check lexica, like the
+// tenants', so they see the lexica that are used.
+func (a *Aliases) Interceptor() twirp.Interceptor {
+	return func(next twirp.Method) twirp.Method {
+		return func(ctx context.Context, req any) (any, error) {
+			if a == nil {
+				return next(ctx, req)
+			}
+			if warnings := a.rewrite(req); len(warnings) > 0 {
+				method, _ := twirp.MethodName(ctx)
+				log.Warn().Str("method", method).Strs("warnings", warnings).
+					Msg("deprecated-lexicon")
+				if err := twirp.SetHTTPResponseHeader(ctx, WarningHeader,
+					warningValue(warnings)); err != nil {
+					log.Err(err).Msg("setting-warning-header")
+				}
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// GRPCInterceptor resolves the aliases in gRPC requests, as Interceptor
+// does for Twirp's. The warnings are sent in the warning header metadata.
+func (a *Aliases) GRPCInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (any, error) {
+
+	if a == nil {
+		return handler(ctx, req)
+	}
+	if warnings := a.rewrite(req); len(warnings) > 0 {
+		log.Warn().Str("method", info.FullMethod).Strs("warnings", warnings).
+			Msg("deprecated-lexicon")
+		if err := grpc.SetHeader(ctx, metadata.Pairs(strings.ToLower(WarningHeader),
+			warningValue(warnings))); err != nil {
+			log.Err(err).Msg("setting-warning-header")
+		}
+	}
+	return handler(ctx, req)
+}
@@ -0,0 +1,105 @@
+package aliases
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/word_db_server/internal/common"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+	pbv2 "github.com/domino14/word_db_server/rpc/wordsearcher/v2"
+)
+
+var testMap = &common.LexiconMapConfig{Aliases: []common.LexiconAlias{
+	{Name: "America", Target: "NWL18"},
+	{Name: "NWL18", Target: "NWL20"},
+	{Name: "CSW19", Target: "CSW21", Message: "CSW19 is retired"},
+}}
+
+func TestRewrite(t *testing.T) {
+	a := New(testMap)
+
+	search := &pb.SearchRequest{Searchparams: []*pb.SearchRequest_SearchParam{
+		{Condition: pb.SearchRequest_LEXICON, Conditionparam: &pb.SearchRequest_SearchParam_Stringvalue{
+			Stringvalue: &pb.SearchRequest_StringValue{Value: "America"}}},
+		{Condition: pb.SearchRequest_LEXICON_DIFF, Conditionparam: &pb.SearchRequest_SearchParam_Lexicondiff{
+			Lexicondiff: &pb.SearchRequest_LexiconDiff{OtherLexicon: "CSW19"}}},
+	}}
+	assert.Equal(t, []string{"lexicon America is deprecated; use NWL20", "CSW19 is retired"},
+		a.rewrite(search))
+	assert.Equal(t, "NWL20", search.Searchparams[0].GetStringvalue().Value)
+	assert.Equal(t, "CSW21", search.Searchparams[1].GetLexicondiff().OtherLexicon)
+
+	expand := &pb.SearchResponse{Lexicon: "NWL23", Alphagrams: []*pb.Alphagram{
+		{Alphagram: "IQ"}, {Alphagram: "AZ", Lexicon: "CSW19"}}}
+	assert.Equal(t, []string{"CSW19 is retired"}, a.rewrite(expand))
+	assert.Equal(t, "NWL23", expand.Lexicon)
+	assert.Equal(t, "", expand.Alphagrams[0].Lexicon)
+	assert.Equal(t, "CSW21", expand.Alphagrams[1].Lexicon)
+
+	v2 := &pbv2.ExpandRequest{Lexicon: &pbv2.Lexicon{Family: "NWL", Version: "18"},
+		Alphagrams: []*pbv2.Alphagram{{Alphagram: "IQ", Lexicon: &pbv2.Lexicon{Family: "America"}}}}
+	assert.Len(t, a.rewrite(v2), 2)
+	assert.Equal(t, "NWL", v2.Lexicon.Family)
+	assert.Equal(t, "20", v2.Lexicon.Version)
+	assert.Equal(t, "NWL20", v2.Alphagrams[0].Lexicon.Name())
+
+	save := &pb.SaveSearchRequest{User: "cesar", Name: "sevens",
+		Search: &pb.SearchRequest{Searchparams: []*pb.SearchRequest_SearchParam{
+			{Condition: pb.SearchRequest_LEXICON, Conditionparam: &pb.SearchRequest_SearchParam_Stringvalue{
+				Stringvalue: &pb.SearchRequest_StringValue{Value: "NWL18"}}}}}}
+	assert.Len(t, a.rewrite(save), 1)
+	assert.Equal(t, "NWL20", save.Search.Searchparams[0].GetStringvalue().Value)
+	assert.Empty(t, a.rewrite(&pb.SaveSearchRequest{Name: "none"}))
+
+	judge := &pb.WordJudgeRequest{Lexicon: "nwl18", Words: []string{"QI"}}
+	assert.Len(t, a.rewrite(judge), 1)
+	assert.Equal(t, "NWL20", judge.Lexicon)
+	assert.Empty(t, a.rewrite(&pb.WordJudgeRequest{Lexicon: "NWL20"}))
+
+	assert.Nil(t, New(&common.LexiconMapConfig{}))
+}
+
+type judge struct {
+	pb.Anagrammer
+	lexicon string
+}
+
+func (j *judge) Judge(ctx context.Context, req *pb.WordJudgeRequest) (*pb.WordJudgeResponse, error) {
+	j.lexicon = req.Lexicon
+	return &pb.WordJudgeResponse{Acceptable: true}, nil
+}
+
+func TestInterceptor(t *testing.T) {
+	j := &judge{}
+	srv := httptest.NewServer(pb.NewAnagrammerServer(j,
+		twirp.WithServerInterceptors(New(testMap).Interceptor())))
+	defer srv.Close()
+	post := func(body string) *http.Response {
+		resp, err := http.Post(srv.URL+"/twirp/wordsearcher.Anagrammer/Judge",
+			"application/json", strings.NewReader(body))
+		assert.Nil(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		return resp
+	}
+
+	resp := post(`{"lexicon": "America", "words": ["QI"]}`)
+	assert.Equal(t, "NWL20", j.lexicon)
+	assert.Equal(t, `299 - "lexicon America is deprecated; use NWL20"`, resp.Header.Get(WarningHeader))
+
+	resp = post(`{"lexicon": "NWL20", "words": ["QI"]}`)
+	assert.Equal(t, "NWL20", j.lexicon)
+	assert.Equal(t, "", resp.Header.Get(WarningHeader))
+
+	// Without aliases, requests are left alone.
+	var none *Aliases
+	srv.Config.Handler = pb.NewAnagrammerServer(j, twirp.WithServerInterceptors(none.Interceptor()))
+	post(`{"lexicon": "America", "words": ["QI"]}`)
+	assert.Equal(t, "America", j.lexicon)
+}
@@ -72,7 +72,7 @@ func GenerateBlanks(ctx context.Context, cfg map[string]any, req *pb.BlankChalle
 	if err != nil {
 		return nil, err
 	}
-	dist, err := tilemapping.ProbableLetterDistribution(cfg, req.Lexicon)
+	dist, err := common.LetterDistribution(cfg, req.Lexicon)
 	if err != nil {
 		return nil, err
 	}
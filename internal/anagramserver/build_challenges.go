@@ -22,7 +22,7 @@ func GenerateBuildChallenge(ctx context.Context, cfg map[string]any, req *pb.Bui
 	if err != nil {
 		return nil, err
 	}
-	dist, err := tilemapping.ProbableLetterDistribution(cfg, req.Lexicon)
+	dist, err := common.LetterDistribution(cfg, req.Lexicon)
 	if err != nil {
 		return nil, err
 	}
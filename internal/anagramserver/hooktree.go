@@ -0,0 +1,119 @@
+package anagramserver
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/domino14/word-golib/kwg"
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/twitchtv/twirp"
+
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// The depth and size of hook trees, if the request doesn't give them, and
+// the most a request can ask for.
+const (
+	DefaultHookTreeDepth = 2
+	MaxHookTreeDepth     = 7
+	DefaultHookTreeNodes = 1000
+	MaxHookTreeNodes     = 10000
+)
+
+// hookFinder returns the hooks of a word, of the given kwg hook type.
+type hookFinder func(word tilemapping.MachineWord, hookType int) []tilemapping.MachineLetter
+
+// hookTree builds the hook tree of a word, a depth at a time, until it is
+// depth deep or has maxNodes words. A word that's already in the tree is
+// added again, marked as repeated, but its hooks aren't. Every hook makes a
+// longer word, so the tree can't loop; this just keeps it from repeating
+// whole subtrees. It returns true if the tree was cut short.
+func hookTree(word tilemapping.MachineWord, depth, maxNodes int, tm *tilemapping.TileMapping,
+	hooks hookFinder) (*pb.HookNode, bool) {
+
+	type pending struct {
+		node  *pb.HookNode
+		word  tilemapping.MachineWord
+		depth int
+	}
+	root := &pb.HookNode{Word: word.UserVisible(tm)}
+	seen := map[string]bool{root.Word: true}
+	queue := []pending{{root, word, 0}}
+	nodes := 1
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+		if p.depth == depth {
+			continue
+		}
+		for _, hookType := range []int{kwg.FrontHooks, kwg.BackHooks} {
+			letters := hooks(p.word, hookType)
+			slices.Sort(letters)
+			for _, h := range letters {
+				if nodes == maxNodes {
+					return root, true
+				}
+				front := hookType == kwg.FrontHooks
+				var ext tilemapping.MachineWord
+				if front {
+					ext = append(tilemapping.MachineWord{h}, p.word...)
+				} else {
+					ext = append(append(tilemapping.MachineWord{}, p.word...), h)
+				}
+				child := &pb.HookNode{
+					Word:  ext.UserVisible(tm),
+					Hook:  tilemapping.MachineWord{h}.UserVisible(tm),
+					Front: front,
+				}
+				p.node.Children = append(p.node.Children, child)
+				nodes++
+				if seen[child.Word] {
+					child.Repeated = true
+					continue
+				}
+				seen[child.Word] = true
+				queue = append(queue, pending{child, ext, p.depth + 1})
+			}
+		}
+	}
+	return root, false
+}
+
+// HookTree returns the hook tree of a word in the lexicon.
+func (s *Server) HookTree(ctx context.Context, req *pb.HookTreeRequest) (*pb.HookTreeResponse, error) {
+	if req.Word == "" {
+		return nil, twirp.RequiredArgumentError("word")
+	}
+	depth := int(req.Depth)
+	if depth == 0 {
+		depth = DefaultHookTreeDepth
+	}
+	if depth < 0 || depth > MaxHookTreeDepth {
+		return nil, twirp.InvalidArgumentError("depth",
+			fmt.Sprintf("must be between 1 and %d", MaxHookTreeDepth))
+	}
+	maxNodes := int(req.MaxNodes)
+	if maxNodes == 0 {
+		maxNodes = DefaultHookTreeNodes
+	}
+	if maxNodes < 0 || maxNodes > MaxHookTreeNodes {
+		return nil, twirp.InvalidArgumentError("max_nodes",
+			fmt.Sprintf("must be between 1 and %d", MaxHookTreeNodes))
+	}
+	dawg, err := kwg.Get(s.Config, req.Lexicon)
+	if err != nil {
+		return nil, err
+	}
+	mw, err := tilemapping.ToMachineWord(strings.ToUpper(strings.TrimSpace(req.Word)),
+		dawg.GetAlphabet())
+	if err != nil || !kwg.FindMachineWord(dawg, mw) {
+		return nil, twirp.NotFoundError(fmt.Sprintf("%v is not a word in %v", req.Word, req.Lexicon))
+	}
+	root, truncated := hookTree(mw, depth, maxNodes, dawg.GetAlphabet(),
+		func(word tilemapping.MachineWord, hookType int) []tilemapping.MachineLetter {
+			return kwg.FindHooks(dawg, word, hookType)
+		})
+	return &pb.HookTreeResponse{Root: root, Truncated: truncated}, nil
+}
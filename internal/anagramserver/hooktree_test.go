@@ -0,0 +1,101 @@
+package anagramserver
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/domino14/word-golib/kwg"
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestHookTree(t *testing.T) {
+	ld, err := tilemapping.ScanLetterDistribution(strings.NewReader(`?,2,0,0
+A,9,1,1
+C,2,3,0
+H,2,4,0
+S,4,1,0
+T,6,1,0
+`))
+	assert.Nil(t, err)
+	tm := ld.TileMapping()
+	lexicon := map[string]bool{"AT": true, "CAT": true, "CATS": true, "SCAT": true,
+		"SCATS": true, "CHAT": true, "HAT": true, "HATS": true, "CHATS": true}
+	mw := func(s string) tilemapping.MachineWord {
+		m, err := tilemapping.ToMachineWord(s, tm)
+		assert.Nil(t, err)
+		return m
+	}
+	// Hooks worked out from the word list, like the KWG would.
+	hooks := func(word tilemapping.MachineWord, hookType int) []tilemapping.MachineLetter {
+		found := []tilemapping.MachineLetter{}
+		for ml := tilemapping.MachineLetter(1); ml < tilemapping.MachineLetter(tm.NumLetters()); ml++ {
+			ext := append(append(tilemapping.MachineWord{}, word...), ml)
+			if hookType == kwg.FrontHooks {
+				ext = append(tilemapping.MachineWord{ml}, word...)
+			}
+			if lexicon[ext.UserVisible(tm)] {
+				found = append(found, ml)
+			}
+		}
+		return found
+	}
+	type flat struct {
+		word, hook string
+		front      bool
+		repeated   bool
+		depth      int
+	}
+	flatten := func(root *pb.HookNode) []flat {
+		out := []flat{}
+		var walk func(n *pb.HookNode, depth int)
+		walk = func(n *pb.HookNode, depth int) {
+			out = append(out, flat{n.Word, n.Hook, n.Front, n.Repeated, depth})
+			for _, c := range n.Children {
+				walk(c, depth+1)
+			}
+		}
+		walk(root, 0)
+		return out
+	}
+
+	root, truncated := hookTree(mw("AT"), 3, 100, tm, hooks)
+	assert.False(t, truncated)
+	assert.Equal(t, []flat{
+		{"AT", "", false, false, 0},
+		{"CAT", "C", true, false, 1},
+		{"SCAT", "S", true, false, 2},
+		{"SCATS", "S", false, false, 3},
+		{"CATS", "S", false, false, 2},
+		{"SCATS", "S", true, true, 3},
+		{"HAT", "H", true, false, 1},
+		{"CHAT", "C", true, false, 2},
+		{"CHATS", "S", false, false, 3},
+		{"HATS", "S", false, false, 2},
+		{"CHATS", "C", true, true, 3},
+	}, flatten(root))
+
+	root, truncated = hookTree(mw("AT"), 1, 100, tm, hooks)
+	assert.False(t, truncated)
+	assert.Equal(t, 3, len(flatten(root)))
+
+	// The first two depths have seven words.
+	root, truncated = hookTree(mw("AT"), 3, 8, tm, hooks)
+	assert.True(t, truncated)
+	assert.Equal(t, 8, len(flatten(root)))
+}
+
+func TestHookTreeArguments(t *testing.T) {
+	s := &Server{Config: DefaultConfig}
+	_, err := s.HookTree(context.Background(), &pb.HookTreeRequest{Lexicon: "NWL20"})
+	assert.NotNil(t, err)
+	_, err = s.HookTree(context.Background(), &pb.HookTreeRequest{
+		Lexicon: "NWL20", Word: "AT", Depth: MaxHookTreeDepth + 1})
+	assert.NotNil(t, err)
+	_, err = s.HookTree(context.Background(), &pb.HookTreeRequest{
+		Lexicon: "NWL20", Word: "AT", MaxNodes: -1})
+	assert.NotNil(t, err)
+}
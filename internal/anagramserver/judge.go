@@ -0,0 +1,57 @@
+package anagramserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/domino14/word-golib/kwg"
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/rs/zerolog/log"
+	"github.com/twitchtv/twirp"
+
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// MaxJudgeWords is the most words that can be judged in one request. A
+// single play can't form anywhere near this many.
+const MaxJudgeWords = 64
+
+// judgeWords judges each word with valid. The play is acceptable only if
+// every word is valid.
+func judgeWords(words []string, valid func(word string) bool) *pb.WordJudgeResponse {
+	resp := &pb.WordJudgeResponse{Acceptable: true}
+	for _, w := range words {
+		w = strings.ToUpper(strings.TrimSpace(w))
+		v := valid(w)
+		resp.Words = append(resp.Words, &pb.WordJudgeResponse_JudgedWord{Word: w, Valid: v})
+		resp.Acceptable = resp.Acceptable && v
+	}
+	return resp
+}
+
+func (s *Server) Judge(ctx context.Context, req *pb.WordJudgeRequest) (*pb.WordJudgeResponse, error) {
+	if len(req.Words) == 0 {
+		return nil, twirp.RequiredArgumentError("words")
+	}
+	if len(req.Words) > MaxJudgeWords {
+		return nil, twirp.InvalidArgumentError("words",
+			fmt.Sprintf("can judge at most %d words at once", MaxJudgeWords))
+	}
+	dawg, err := kwg.Get(s.Config, req.Lexicon)
+	if err != nil {
+		return nil, err
+	}
+	alph := dawg.GetAlphabet()
+	resp := judgeWords(req.Words, func(word string) bool {
+		mw, err := tilemapping.ToMachineWord(word, alph)
+		if err != nil {
+			// Letters that aren't in the alphabet can't form a valid word.
+			return false
+		}
+		return kwg.FindMachineWord(dawg, mw)
+	})
+	log.Info().Str("lexicon", req.Lexicon).Strs("words", req.Words).
+		Bool("acceptable", resp.Acceptable).Msg("judged")
+	return resp, nil
+}
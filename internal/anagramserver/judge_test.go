@@ -0,0 +1,54 @@
+package anagramserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestJudgeWords(t *testing.T) {
+	valid := map[string]bool{"QI": true, "ZA": true}
+	isValid := func(w string) bool { return valid[w] }
+
+	resp := judgeWords([]string{"qi", " ZA "}, isValid)
+	assert.True(t, resp.Acceptable)
+	assert.Equal(t, "QI", resp.Words[0].Word)
+	assert.Equal(t, "ZA", resp.Words[1].Word)
+
+	resp = judgeWords([]string{"QI", "QZ", "ZA"}, isValid)
+	assert.False(t, resp.Acceptable)
+	assert.True(t, resp.Words[0].Valid)
+	assert.False(t, resp.Words[1].Valid)
+	assert.True(t, resp.Words[2].Valid)
+}
+
+func TestJudgeArguments(t *testing.T) {
+	s := &Server{Config: DefaultConfig}
+	_, err := s.Judge(context.Background(), &pb.WordJudgeRequest{Lexicon: "NWL20"})
+	assert.NotNil(t, err)
+
+	words := make([]string, MaxJudgeWords+1)
+	for i := range words {
+		words[i] = "QI"
+	}
+	_, err = s.Judge(context.Background(), &pb.WordJudgeRequest{Lexicon: "NWL20", Words: words})
+	assert.NotNil(t, err)
+}
+
+func TestJudge(t *testing.T) {
+	s := &Server{Config: DefaultConfig}
+	resp, err := s.Judge(context.Background(), &pb.WordJudgeRequest{
+		Lexicon: "NWL20", Words: []string{"QI", "QAT", "QIS"}})
+	assert.Nil(t, err)
+	assert.True(t, resp.Acceptable)
+
+	resp, err = s.Judge(context.Background(), &pb.WordJudgeRequest{
+		Lexicon: "NWL20", Words: []string{"QI", "QAJ", "Q1"}})
+	assert.Nil(t, err)
+	assert.False(t, resp.Acceptable)
+	assert.Equal(t, []bool{true, false, false},
+		[]bool{resp.Words[0].Valid, resp.Words[1].Valid, resp.Words[2].Valid})
+}
@@ -115,6 +115,8 @@ func (s *Server) Anagram(ctx context.Context, req *pb.AnagramRequest) (
 		if !ok {
 			return nil, errors.New("could not find data-path in config")
 		}
+		// Lexica whose definitions the expansion must leave out.
+		cfg.NoDefinitionLexica, _ = s.Config["no-definition-lexica"].(string)
 		expander := &searchserver.Server{
 			Config: cfg,
 		}
@@ -0,0 +1,124 @@
+// Package apikeys makes the server's clients, such as third-party study
+// apps, authenticate with an API key, and rate-limits each key. Keys are
+// sent as bearer tokens in the Authorization header; they are unrelated to
+// tenants' keys, which only pick the tenant.
+package apikeys
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/word_db_server/internal/ratelimit"
+)
+
+// A Key is an API key, and who it was given to.
+type Key struct {
+	Name string `json:"name"`
+	Key  string `json:"key"`
+	// RequestsPerMinute is how many requests the key may make a minute,
+	// in bursts of up to as many. 0 means the default, and a negative
+	// number no limit.
+	RequestsPerMinute int `json:"requests_per_minute"`
+
+	limiter *ratelimit.Limiter
+}
+
+// Keys is the set of keys the server accepts.
+type Keys struct {
+	byKey map[string]*Key
+}
+
+// ReadFile reads keys from a JSON file with a list of keys.
+func ReadFile(path string) ([]*Key, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var list []*Key
+	if err := json.NewDecoder(f).Decode(&list); err != nil {
+		return nil, fmt.Errorf("reading API keys from %v: %w", path, err)
+	}
+	return list, nil
+}
+
+// Parse parses keys given as a comma-separated list of name:key pairs, as
+// they are passed in the environment.
+func Parse(s string) ([]*Key, error) {
+	list := []*Key{}
+	for _, pair := range strings.Split(s, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		name, key, ok := strings.Cut(pair, ":")
+		if !ok {
+			return nil, fmt.Errorf("API key %q is not in the form name:key", pair)
+		}
+		list = append(list, &Key{Name: name, Key: key})
+	}
+	return list, nil
+}
+
+// New checks the keys and indexes them. perMinute is the rate limit of
+// the keys that don't have their own; 0 or less means no limit.
+func New(list []*Key, perMinute int) (*Keys, error) {
+	ks := &Keys{byKey: map[string]*Key{}}
+	names := map[string]bool{}
+	for _, k := range list {
+		if k.Name == "" || names[k.Name] {
+			return nil, fmt.Errorf("API key names must be unique and not empty: %q", k.Name)
+		}
+		names[k.Name] = true
+		if k.Key == "" || ks.byKey[k.Key] != nil {
+			return nil, fmt.Errorf("API key %v: keys must be unique and not empty", k.Name)
+		}
+		ks.byKey[k.Key] = k
+		rate := k.RequestsPerMinute
+		if rate == 0 {
+			rate = perMinute
+		}
+		if rate > 0 {
+			k.limiter = ratelimit.New(rate, rate)
+		}
+	}
+	if len(ks.byKey) == 0 {
+		return nil, fmt.Errorf("no API keys were given")
+	}
+	return ks, nil
+}
+
+type ctxKey struct{}
+
+// FromContext returns the key that the request was made with, or nil.
+func FromContext(ctx context.Context) *Key {
+	k, _ := ctx.Value(ctxKey{}).(*Key)
+	return k
+}
+
+// Middleware rejects requests without a known key as unauthenticated,
+// and those over their key's rate limit as resource_exhausted.
+func (ks *Keys) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		given, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		k, ok := ks.byKey[given]
+		if !ok || given == "" {
+			log.Debug().Str("remote", r.RemoteAddr).Str("path", r.URL.Path).
+				Msg("rejected request without a known API key")
+			twirp.WriteError(w, twirp.NewError(twirp.Unauthenticated, "missing or unknown API key"))
+			return
+		}
+		if k.limiter != nil && !k.limiter.Allow("") {
+			log.Debug().Str("key", k.Name).Str("path", r.URL.Path).Msg("rate-limited")
+			twirp.WriteError(w, twirp.NewError(twirp.ResourceExhausted, "rate limit exceeded"))
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), ctxKey{}, k)))
+	})
+}
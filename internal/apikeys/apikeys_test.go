@@ -0,0 +1,77 @@
+package apikeys
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	list, err := Parse(" app:s3cret, other:k:ey ,")
+	assert.Nil(t, err)
+	assert.Equal(t, []*Key{{Name: "app", Key: "s3cret"}, {Name: "other", Key: "k:ey"}}, list)
+
+	_, err = Parse("nokey")
+	assert.NotNil(t, err)
+}
+
+func TestNew(t *testing.T) {
+	_, err := New([]*Key{{Name: "a", Key: "k"}, {Name: "b", Key: "k"}}, 10)
+	assert.NotNil(t, err)
+	_, err = New([]*Key{{Name: "a", Key: "k"}, {Name: "a", Key: "l"}}, 10)
+	assert.NotNil(t, err)
+	_, err = New([]*Key{{Name: "a"}}, 10)
+	assert.NotNil(t, err)
+	_, err = New(nil, 10)
+	assert.NotNil(t, err)
+}
+
+func TestReadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.json")
+	assert.Nil(t, os.WriteFile(path,
+		[]byte(`[{"name": "app", "key": "s3cret", "requests_per_minute": 5}]`), 0644))
+	list, err := ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, []*Key{{Name: "app", Key: "s3cret", RequestsPerMinute: 5}}, list)
+}
+
+func TestMiddleware(t *testing.T) {
+	ks, err := New([]*Key{
+		{Name: "app", Key: "s3cret"},
+		{Name: "big", Key: "b1g", RequestsPerMinute: 3},
+		{Name: "free", Key: "fr33", RequestsPerMinute: -1},
+	}, 1)
+	assert.Nil(t, err)
+	var seen string
+	h := ks.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = FromContext(r.Context()).Name
+		w.WriteHeader(http.StatusOK)
+	}))
+	serve := func(key string) int {
+		req := httptest.NewRequest("POST", "/twirp/foo", nil)
+		if key != "" {
+			req.Header.Set("Authorization", "Bearer "+key)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, serve(""))
+	assert.Equal(t, http.StatusUnauthorized, serve("wrong"))
+	assert.Equal(t, http.StatusOK, serve("s3cret"))
+	assert.Equal(t, "app", seen)
+	assert.Equal(t, http.StatusTooManyRequests, serve("s3cret"))
+	// Each key has its own limit.
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, http.StatusOK, serve("b1g"))
+	}
+	assert.Equal(t, http.StatusTooManyRequests, serve("b1g"))
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, http.StatusOK, serve("fr33"))
+	}
+}
@@ -0,0 +1,192 @@
+// Package cardbox keeps users' cardboxes of quiz questions, and schedules
+// the questions for review with a Leitner system: a question moves up a
+// box every time it's answered correctly, and waits longer before it's due
+// again the higher its box. A missed question goes back to the first box.
+// The stores also keep users' saved searches.
+package cardbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Intervals are how long a card waits before it's due again, by the box it
+// was just moved to. These are Zyzzyva's default cardbox schedule, so that
+// cardboxes imported from it keep their rhythm.
+var Intervals = []time.Duration{
+	1 * day, 4 * day, 7 * day, 12 * day, 20 * day, 30 * day,
+	60 * day, 90 * day, 150 * day, 270 * day, 480 * day,
+}
+
+const day = 24 * time.Hour
+
+// DefaultRetention is how long a deleted cardbox can be restored for, if
+// not configured otherwise. After that it may be purged.
+const DefaultRetention = 30 * day
+
+var (
+	// ErrNotFound is returned for a cardbox, card or saved search that
+	// doesn't exist, that belongs to another user, or that has been
+	// deleted.
+	ErrNotFound = errors.New("not found")
+	// ErrInvalidSearchName is returned for a saved search name that can't
+	// be stored.
+	ErrInvalidSearchName = errors.New("saved search names must be 1 to 128 characters")
+	// ErrInvalidUser is returned for a user name that can't be stored.
+	ErrInvalidUser = errors.New("user names must be 1 to 64 letters, digits, '_', '-' or '.', and not start with '.'")
+)
+
+var userRegex = regexp.MustCompile(`^[A-Za-z0-9_-][A-Za-z0-9_.-]{0,63}$`)
+
+// ValidateUser checks that a user name can be used with every store.
+func ValidateUser(user string) error {
+	if !userRegex.MatchString(user) {
+		return ErrInvalidUser
+	}
+	return nil
+}
+
+// Cardbox is a named set of cards that a user quizzes on.
+type Cardbox struct {
+	ID      int64
+	User    string
+	Name    string
+	Lexicon string
+	Created time.Time
+	// Deleted is when the cardbox was deleted, if it's one of
+	// DeletedCardboxes.
+	Deleted time.Time
+}
+
+// SavedSearch is a search saved under a name, to be run again later.
+type SavedSearch struct {
+	User string
+	// APIKey is the name of the API key the search was saved with, if
+	// any. Each key's users' searches are kept apart.
+	APIKey string
+	Name   string
+	// Search is the search request, in protobuf JSON form.
+	Search  string
+	Created time.Time
+	Updated time.Time
+}
+
+// ValidateSearchName checks that a saved search name can be stored.
+func ValidateSearchName(name string) error {
+	if name == "" || utf8.RuneCountInString(name) > 128 {
+		return ErrInvalidSearchName
+	}
+	return nil
+}
+
+// Card is a question in a cardbox.
+type Card struct {
+	Alphagram    string
+	Words        []string
+	Box          int
+	Due          time.Time
+	NumCorrect   int
+	NumIncorrect int
+}
+
+// Answer moves the card to the box it goes to after being answered at the
+// given time, and schedules it accordingly.
+func (c *Card) Answer(correct bool, now time.Time) {
+	if correct {
+		c.Box = min(c.Box+1, len(Intervals)-1)
+		c.NumCorrect++
+	} else {
+		c.Box = 0
+		c.NumIncorrect++
+	}
+	c.Due = now.Add(Intervals[c.Box])
+}
+
+// AnswerTotals are the answers given to an alphagram's question, summed
+// over every user's cardboxes of a lexicon. They don't say who gave them.
+type AnswerTotals struct {
+	Alphagram string
+	// Users is the number of users who have answered the question.
+	Users        int
+	NumCorrect   int
+	NumIncorrect int
+}
+
+func (a *AnswerTotals) add(b *AnswerTotals) {
+	a.Users += b.Users
+	a.NumCorrect += b.NumCorrect
+	a.NumIncorrect += b.NumIncorrect
+}
+
+// Store keeps cardboxes and saved searches. Every call is scoped to a
+// user, who can't see other users' cardboxes or searches.
+type Store interface {
+	// CreateCardbox stores a new cardbox with the given cards, and returns
+	// its ID. Cards without a due time are due when the cardbox was
+	// created.
+	CreateCardbox(ctx context.Context, box *Cardbox, cards []*Card) (int64, error)
+	// Cardbox returns one of the user's cardboxes.
+	Cardbox(ctx context.Context, user string, id int64) (*Cardbox, error)
+	// Cards returns all of the cards of one of the user's cardboxes, in
+	// alphagram order.
+	Cards(ctx context.Context, user string, id int64) ([]*Card, error)
+	// RecordAnswer records an answer to a card, and returns the card as
+	// rescheduled.
+	RecordAnswer(ctx context.Context, user string, id int64, alphagram string,
+		correct bool, now time.Time) (*Card, error)
+	// DueCards returns up to limit of the cards that are due at the given
+	// time, the longest overdue first, along with the number of cards that
+	// are due in all. A limit of 0 returns all of them.
+	DueCards(ctx context.Context, user string, id int64, now time.Time, limit int) (
+		[]*Card, int, error)
+	// DeleteCardbox deletes one of the user's cardboxes at the given time.
+	// It's only marked deleted, so that it can be restored until it's
+	// purged; until then the other calls treat it as not found.
+	DeleteCardbox(ctx context.Context, user string, id int64, now time.Time) error
+	// RestoreCardbox undeletes one of the user's cardboxes, if it was
+	// deleted no earlier than deletedSince, and returns it.
+	RestoreCardbox(ctx context.Context, user string, id int64, deletedSince time.Time) (
+		*Cardbox, error)
+	// DeletedCardboxes returns the user's cardboxes that were deleted no
+	// earlier than deletedSince, the most recently deleted first.
+	DeletedCardboxes(ctx context.Context, user string, deletedSince time.Time) ([]*Cardbox, error)
+	// PurgeDeleted removes every user's cardboxes that were deleted before
+	// the given time, with their cards, and returns how many there were.
+	PurgeDeleted(ctx context.Context, before time.Time) (int, error)
+	// AnswerTotals returns the answer totals of every question of the
+	// lexicon that has been answered, by alphagram. Unlike the other
+	// calls, it covers all users, though not deleted cardboxes.
+	AnswerTotals(ctx context.Context, lexicon string) (map[string]*AnswerTotals, error)
+	// SaveSearch saves a search under its name, replacing the search the
+	// user and API key had saved under it, if any, and says whether one
+	// was replaced. A replaced search keeps its creation time.
+	SaveSearch(ctx context.Context, search *SavedSearch) (bool, error)
+	// SavedSearch returns one of the searches the user saved with the API
+	// key.
+	SavedSearch(ctx context.Context, user, apiKey, name string) (*SavedSearch, error)
+	// SavedSearches returns the searches the user saved with the API key,
+	// by name.
+	SavedSearches(ctx context.Context, user, apiKey string) ([]*SavedSearch, error)
+	// DeleteSavedSearch deletes one of the searches the user saved with
+	// the API key.
+	DeleteSavedSearch(ctx context.Context, user, apiKey, name string) error
+	Close() error
+}
+
+// Open opens the store that spec names: a postgres:// or postgresql:// DSN
+// for a shared Postgres database, or otherwise a directory to keep a
+// SQLite database per user in. The directory is created if needed.
+func Open(spec string) (Store, error) {
+	if spec == "" {
+		return nil, fmt.Errorf("no cardbox store given")
+	}
+	if strings.HasPrefix(spec, "postgres://") || strings.HasPrefix(spec, "postgresql://") {
+		return OpenPostgres(spec)
+	}
+	return OpenSQLite(spec)
+}
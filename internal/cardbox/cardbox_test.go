@@ -0,0 +1,218 @@
+package cardbox
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnswer(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	c := &Card{Alphagram: "AEINRST"}
+	c.Answer(true, now)
+	assert.Equal(t, 1, c.Box)
+	assert.Equal(t, now.Add(4*day), c.Due)
+	c.Answer(true, now)
+	assert.Equal(t, 2, c.Box)
+	c.Answer(false, now)
+	assert.Equal(t, 0, c.Box)
+	assert.Equal(t, now.Add(day), c.Due)
+	assert.Equal(t, 2, c.NumCorrect)
+	assert.Equal(t, 1, c.NumIncorrect)
+
+	c.Box = len(Intervals) - 1
+	c.Answer(true, now)
+	assert.Equal(t, len(Intervals)-1, c.Box)
+}
+
+func TestSQLiteStore(t *testing.T) {
+	ctx := context.Background()
+	s, err := Open(t.TempDir())
+	assert.Nil(t, err)
+	defer s.Close()
+
+	created := time.Unix(1700000000, 0)
+	id, err := s.CreateCardbox(ctx, &Cardbox{User: "cesar", Name: "7s", Lexicon: "NWL20",
+		Created: created}, []*Card{
+		{Alphagram: "AEINRST", Words: []string{"NASTIER", "RETAINS"}},
+		{Alphagram: "ACCHNOS", Words: []string{"CANCHOS"}},
+	})
+	assert.Nil(t, err)
+
+	box, err := s.Cardbox(ctx, "cesar", id)
+	assert.Nil(t, err)
+	assert.Equal(t, &Cardbox{ID: id, User: "cesar", Name: "7s", Lexicon: "NWL20",
+		Created: created}, box)
+	_, err = s.Cardbox(ctx, "other", id)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	all, err := s.Cards(ctx, "cesar", id)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(all))
+	assert.Equal(t, "ACCHNOS", all[0].Alphagram)
+	assert.Equal(t, created, all[1].Due)
+
+	cards, numDue, err := s.DueCards(ctx, "cesar", id, created, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, numDue)
+	assert.Equal(t, 1, len(cards))
+	assert.Equal(t, "ACCHNOS", cards[0].Alphagram)
+
+	now := created.Add(time.Hour)
+	c, err := s.RecordAnswer(ctx, "cesar", id, "ACCHNOS", true, now)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, c.Box)
+	assert.Equal(t, now.Add(4*day), c.Due)
+	_, err = s.RecordAnswer(ctx, "cesar", id, "AEEINRS", true, now)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	cards, numDue, err = s.DueCards(ctx, "cesar", id, now, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numDue)
+	assert.Equal(t, []string{"NASTIER", "RETAINS"}, cards[0].Words)
+
+	cards, numDue, err = s.DueCards(ctx, "cesar", id, now.Add(5*day), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, numDue)
+	assert.Equal(t, "AEINRST", cards[0].Alphagram)
+
+	_, _, err = s.DueCards(ctx, "nobody", id, now, 0)
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, _, err = s.DueCards(ctx, "../cesar", id, now, 0)
+	assert.ErrorIs(t, err, ErrInvalidUser)
+}
+
+func TestAnswerTotals(t *testing.T) {
+	ctx := context.Background()
+	s, err := Open(t.TempDir())
+	assert.Nil(t, err)
+	defer s.Close()
+
+	now := time.Unix(1700000000, 0)
+	answer := func(user, lexicon string, answers ...bool) {
+		id, err := s.CreateCardbox(ctx, &Cardbox{User: user, Name: "7s", Lexicon: lexicon,
+			Created: now}, []*Card{{Alphagram: "AEINRST"}, {Alphagram: "ACCHNOS"}})
+		assert.Nil(t, err)
+		for _, correct := range answers {
+			_, err := s.RecordAnswer(ctx, user, id, "AEINRST", correct, now)
+			assert.Nil(t, err)
+		}
+	}
+	answer("cesar", "NWL20", true, false)
+	answer("cesar", "NWL20", true)
+	answer("josh", "NWL20", false)
+	answer("josh", "CSW21", true)
+
+	totals, err := s.AnswerTotals(ctx, "NWL20")
+	assert.Nil(t, err)
+	// Nobody has answered ACCHNOS.
+	assert.Equal(t, map[string]*AnswerTotals{
+		"AEINRST": {Alphagram: "AEINRST", Users: 2, NumCorrect: 2, NumIncorrect: 2},
+	}, totals)
+	totals, err = s.AnswerTotals(ctx, "FRA20")
+	assert.Nil(t, err)
+	assert.Empty(t, totals)
+}
+
+func TestDeleteAndRestore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	s, err := Open(dir)
+	assert.Nil(t, err)
+	defer s.Close()
+
+	created := time.Unix(1700000000, 0)
+	newBox := func(name string) int64 {
+		id, err := s.CreateCardbox(ctx, &Cardbox{User: "cesar", Name: name, Lexicon: "NWL20",
+			Created: created}, []*Card{{Alphagram: "AEINRST", Words: []string{"NASTIER"}}})
+		assert.Nil(t, err)
+		_, err = s.RecordAnswer(ctx, "cesar", id, "AEINRST", true, created)
+		assert.Nil(t, err)
+		return id
+	}
+	sevens, eights := newBox("7s"), newBox("8s")
+
+	deleted := created.Add(day)
+	assert.ErrorIs(t, s.DeleteCardbox(ctx, "josh", sevens, deleted), ErrNotFound)
+	assert.Nil(t, s.DeleteCardbox(ctx, "cesar", sevens, deleted))
+	assert.ErrorIs(t, s.DeleteCardbox(ctx, "cesar", sevens, deleted), ErrNotFound)
+	assert.Nil(t, s.DeleteCardbox(ctx, "cesar", eights, deleted.Add(time.Hour)))
+	_, err = s.Cardbox(ctx, "cesar", sevens)
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = s.Cards(ctx, "cesar", sevens)
+	assert.ErrorIs(t, err, ErrNotFound)
+	totals, err := s.AnswerTotals(ctx, "NWL20")
+	assert.Nil(t, err)
+	assert.Empty(t, totals)
+
+	boxes, err := s.DeletedCardboxes(ctx, "cesar", created)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(boxes))
+	assert.Equal(t, "8s", boxes[0].Name)
+	assert.Equal(t, deleted, boxes[1].Deleted)
+	boxes, err = s.DeletedCardboxes(ctx, "josh", created)
+	assert.Nil(t, err)
+	assert.Empty(t, boxes)
+
+	// Too long ago to restore.
+	_, err = s.RestoreCardbox(ctx, "cesar", sevens, deleted.Add(time.Minute))
+	assert.ErrorIs(t, err, ErrNotFound)
+	box, err := s.RestoreCardbox(ctx, "cesar", sevens, deleted)
+	assert.Nil(t, err)
+	assert.Equal(t, &Cardbox{ID: sevens, User: "cesar", Name: "7s", Lexicon: "NWL20",
+		Created: created}, box)
+	cards, err := s.Cards(ctx, "cesar", sevens)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, cards[0].NumCorrect)
+
+	n, err := s.PurgeDeleted(ctx, deleted.Add(2*time.Hour))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, n)
+	_, err = s.RestoreCardbox(ctx, "cesar", eights, created)
+	assert.ErrorIs(t, err, ErrNotFound)
+	st, err := s.(*SQLiteStore).open(ctx, "cesar", false)
+	assert.Nil(t, err)
+	defer st.Close()
+	var numCards int
+	assert.Nil(t, st.db.QueryRow(`SELECT COUNT(*) FROM cards`).Scan(&numCards))
+	assert.Equal(t, 1, numCards)
+}
+
+func TestAddDeletedColumn(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db, err := sql.Open("sqlite3", filepath.Join(dir, "cesar.db"))
+	assert.Nil(t, err)
+	// The schema from before cardboxes could be deleted.
+	_, err = db.Exec(`CREATE TABLE cardboxes (id integer PRIMARY KEY AUTOINCREMENT,
+		user_name varchar(64) NOT NULL, name varchar(128) NOT NULL,
+		lexicon varchar(32) NOT NULL, created bigint NOT NULL);
+	INSERT INTO cardboxes (user_name, name, lexicon, created) VALUES ('cesar', '7s', 'NWL20', 0)`)
+	assert.Nil(t, err)
+	db.Close()
+
+	s, err := Open(dir)
+	assert.Nil(t, err)
+	assert.Nil(t, s.DeleteCardbox(ctx, "cesar", 1, time.Unix(1700000000, 0)))
+	_, err = s.RestoreCardbox(ctx, "cesar", 1, time.Unix(0, 0))
+	assert.Nil(t, err)
+}
+
+func TestRebind(t *testing.T) {
+	s := &sqlStore{postgres: true}
+	assert.Equal(t, "SELECT a FROM b WHERE c = $1 AND d <= $2",
+		s.rebind("SELECT a FROM b WHERE c = ? AND d <= ?"))
+	s.postgres = false
+	assert.Equal(t, "WHERE c = ?", s.rebind("WHERE c = ?"))
+}
+
+func TestValidateUser(t *testing.T) {
+	assert.Nil(t, ValidateUser("user_1.backup"))
+	assert.NotNil(t, ValidateUser(""))
+	assert.NotNil(t, ValidateUser(".hidden"))
+	assert.NotNil(t, ValidateUser("a/b"))
+}
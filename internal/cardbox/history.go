@@ -0,0 +1,101 @@
+package cardbox
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// historyColumns maps the column names that quiz history exports use to
+// the fields of a card. Zyzzyva keeps its cardboxes in the questions table
+// of its quiz databases, so exporting that table as CSV gives question,
+// correct, incorrect, cardbox and next_scheduled.
+var historyColumns = map[string]string{
+	"question":       "alphagram",
+	"alphagram":      "alphagram",
+	"cardbox":        "box",
+	"box":            "box",
+	"next_scheduled": "due",
+	"due":            "due",
+	"correct":        "correct",
+	"num_correct":    "correct",
+	"incorrect":      "incorrect",
+	"num_incorrect":  "incorrect",
+}
+
+// ParseHistoryCSV reads quiz history as CSV with a header row. Only a
+// question (or alphagram) column is required; see historyColumns for the
+// others. Due times can be Unix times, RFC 3339 times or dates. The cards'
+// alphagrams are as given, and their boxes are capped at the last box.
+func ParseHistoryCSV(r io.Reader) ([]*Card, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("the history is empty")
+	}
+	cols := map[string]int{}
+	for i, h := range records[0] {
+		if field, ok := historyColumns[strings.ToLower(strings.TrimSpace(h))]; ok {
+			if _, dup := cols[field]; !dup {
+				cols[field] = i
+			}
+		}
+	}
+	if _, ok := cols["alphagram"]; !ok {
+		return nil, fmt.Errorf("the history has no question or alphagram column")
+	}
+	cards := []*Card{}
+	for n, rec := range records[1:] {
+		line := n + 2
+		c := &Card{}
+		value := func(field string) string {
+			if i, ok := cols[field]; ok {
+				return strings.TrimSpace(rec[i])
+			}
+			return ""
+		}
+		c.Alphagram = strings.ToUpper(value("alphagram"))
+		if c.Alphagram == "" {
+			continue
+		}
+		for field, dest := range map[string]*int{
+			"box": &c.Box, "correct": &c.NumCorrect, "incorrect": &c.NumIncorrect} {
+			v := value(field)
+			if v == "" {
+				continue
+			}
+			if *dest, err = strconv.Atoi(v); err != nil || *dest < 0 {
+				return nil, fmt.Errorf("line %d: bad %v %q", line, field, v)
+			}
+		}
+		c.Box = min(c.Box, len(Intervals)-1)
+		if v := value("due"); v != "" {
+			if c.Due, err = parseDue(v); err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+		}
+		cards = append(cards, c)
+	}
+	return cards, nil
+}
+
+func parseDue(v string) (time.Time, error) {
+	if secs, err := strconv.ParseInt(v, 10, 64); err == nil {
+		if secs == 0 {
+			// Zyzzyva's questions that were never scheduled.
+			return time.Time{}, nil
+		}
+		return time.Unix(secs, 0), nil
+	}
+	for _, layout := range []string{time.RFC3339, time.DateTime, time.DateOnly} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("bad due time %q", v)
+}
@@ -0,0 +1,33 @@
+package cardbox
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseHistoryCSV(t *testing.T) {
+	cards, err := ParseHistoryCSV(strings.NewReader(`question,correct,incorrect,streak,last_correct,difficulty,cardbox,next_scheduled
+AEINRST,5,1,2,1700000000,0,3,1700500000
+eirstuv,0,2,0,0,0,0,0
+,1,1,0,0,0,0,0
+ACCHNOS,9,0,9,0,0,40,2023-11-20
+`))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(cards))
+	assert.Equal(t, &Card{Alphagram: "AEINRST", Box: 3, Due: time.Unix(1700500000, 0),
+		NumCorrect: 5, NumIncorrect: 1}, cards[0])
+	assert.Equal(t, "EIRSTUV", cards[1].Alphagram)
+	assert.True(t, cards[1].Due.IsZero())
+	assert.Equal(t, len(Intervals)-1, cards[2].Box)
+	assert.Equal(t, time.Date(2023, 11, 20, 0, 0, 0, 0, time.UTC), cards[2].Due)
+
+	_, err = ParseHistoryCSV(strings.NewReader("word,box\nQI,1\n"))
+	assert.NotNil(t, err)
+	_, err = ParseHistoryCSV(strings.NewReader("alphagram,box\nIQ,-1\n"))
+	assert.ErrorContains(t, err, "line 2")
+	_, err = ParseHistoryCSV(strings.NewReader("alphagram,due\nIQ,tomorrow\n"))
+	assert.ErrorContains(t, err, "line 2")
+}
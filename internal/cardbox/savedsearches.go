@@ -0,0 +1,120 @@
+package cardbox
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+func (s *sqlStore) SaveSearch(ctx context.Context, search *SavedSearch) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+	res, err := tx.ExecContext(ctx, s.rebind(`UPDATE saved_searches SET search = ?, updated = ?
+		WHERE user_name = ? AND api_key = ? AND name = ?`),
+		search.Search, search.Updated.Unix(), search.User, search.APIKey, search.Name)
+	if err != nil {
+		return false, err
+	}
+	err = requireUpdated(res)
+	replaced := err == nil
+	if errors.Is(err, ErrNotFound) {
+		_, err = tx.ExecContext(ctx, s.rebind(`INSERT INTO saved_searches
+			(user_name, api_key, name, search, created, updated) VALUES (?, ?, ?, ?, ?, ?)`),
+			search.User, search.APIKey, search.Name, search.Search,
+			search.Created.Unix(), search.Updated.Unix())
+	}
+	if err != nil {
+		return false, err
+	}
+	return replaced, tx.Commit()
+}
+
+func (s *sqlStore) SavedSearch(ctx context.Context, user, apiKey, name string) (*SavedSearch, error) {
+	search := &SavedSearch{User: user, APIKey: apiKey, Name: name}
+	var created, updated int64
+	err := s.db.QueryRowContext(ctx, s.rebind(`SELECT search, created, updated
+		FROM saved_searches WHERE user_name = ? AND api_key = ? AND name = ?`),
+		user, apiKey, name).Scan(&search.Search, &created, &updated)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	search.Created = time.Unix(created, 0)
+	search.Updated = time.Unix(updated, 0)
+	return search, nil
+}
+
+func (s *sqlStore) SavedSearches(ctx context.Context, user, apiKey string) ([]*SavedSearch, error) {
+	rows, err := s.db.QueryContext(ctx, s.rebind(`SELECT name, search, created, updated
+		FROM saved_searches WHERE user_name = ? AND api_key = ? ORDER BY name`), user, apiKey)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	searches := []*SavedSearch{}
+	for rows.Next() {
+		search := &SavedSearch{User: user, APIKey: apiKey}
+		var created, updated int64
+		if err := rows.Scan(&search.Name, &search.Search, &created, &updated); err != nil {
+			return nil, err
+		}
+		search.Created = time.Unix(created, 0)
+		search.Updated = time.Unix(updated, 0)
+		searches = append(searches, search)
+	}
+	return searches, rows.Err()
+}
+
+func (s *sqlStore) DeleteSavedSearch(ctx context.Context, user, apiKey, name string) error {
+	res, err := s.db.ExecContext(ctx, s.rebind(`DELETE FROM saved_searches
+		WHERE user_name = ? AND api_key = ? AND name = ?`), user, apiKey, name)
+	if err != nil {
+		return err
+	}
+	return requireUpdated(res)
+}
+
+func (s *SQLiteStore) SaveSearch(ctx context.Context, search *SavedSearch) (bool, error) {
+	st, err := s.open(ctx, search.User, true)
+	if err != nil {
+		return false, err
+	}
+	defer st.Close()
+	return st.SaveSearch(ctx, search)
+}
+
+func (s *SQLiteStore) SavedSearch(ctx context.Context, user, apiKey, name string) (*SavedSearch, error) {
+	st, err := s.open(ctx, user, false)
+	if err != nil {
+		return nil, err
+	}
+	defer st.Close()
+	return st.SavedSearch(ctx, user, apiKey, name)
+}
+
+func (s *SQLiteStore) SavedSearches(ctx context.Context, user, apiKey string) ([]*SavedSearch, error) {
+	st, err := s.open(ctx, user, false)
+	if errors.Is(err, ErrNotFound) {
+		return []*SavedSearch{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer st.Close()
+	return st.SavedSearches(ctx, user, apiKey)
+}
+
+func (s *SQLiteStore) DeleteSavedSearch(ctx context.Context, user, apiKey, name string) error {
+	st, err := s.open(ctx, user, false)
+	if err != nil {
+		return err
+	}
+	defer st.Close()
+	return st.DeleteSavedSearch(ctx, user, apiKey, name)
+}
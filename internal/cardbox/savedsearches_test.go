@@ -0,0 +1,65 @@
+package cardbox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSavedSearches(t *testing.T) {
+	ctx := context.Background()
+	s, err := Open(t.TempDir())
+	assert.Nil(t, err)
+	defer s.Close()
+
+	searches, err := s.SavedSearches(ctx, "cesar", "")
+	assert.Nil(t, err)
+	assert.Equal(t, []*SavedSearch{}, searches)
+
+	created := time.Unix(1700000000, 0)
+	replaced, err := s.SaveSearch(ctx, &SavedSearch{User: "cesar", Name: "top 7s",
+		Search: `{"a": 1}`, Created: created, Updated: created})
+	assert.Nil(t, err)
+	assert.False(t, replaced)
+	_, err = s.SaveSearch(ctx, &SavedSearch{User: "cesar", Name: "8s",
+		Search: `{"b": 1}`, Created: created, Updated: created})
+	assert.Nil(t, err)
+	// The same name under an API key is another search.
+	_, err = s.SaveSearch(ctx, &SavedSearch{User: "cesar", APIKey: "flashcards", Name: "top 7s",
+		Search: `{"c": 1}`, Created: created, Updated: created})
+	assert.Nil(t, err)
+
+	later := created.Add(time.Hour)
+	replaced, err = s.SaveSearch(ctx, &SavedSearch{User: "cesar", Name: "top 7s",
+		Search: `{"a": 2}`, Created: later, Updated: later})
+	assert.Nil(t, err)
+	assert.True(t, replaced)
+
+	search, err := s.SavedSearch(ctx, "cesar", "", "top 7s")
+	assert.Nil(t, err)
+	assert.Equal(t, &SavedSearch{User: "cesar", Name: "top 7s", Search: `{"a": 2}`,
+		Created: created, Updated: later}, search)
+	search, err = s.SavedSearch(ctx, "cesar", "flashcards", "top 7s")
+	assert.Nil(t, err)
+	assert.Equal(t, `{"c": 1}`, search.Search)
+
+	searches, err = s.SavedSearches(ctx, "cesar", "")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(searches))
+	assert.Equal(t, "8s", searches[0].Name)
+	assert.Equal(t, "top 7s", searches[1].Name)
+
+	assert.Nil(t, s.DeleteSavedSearch(ctx, "cesar", "", "8s"))
+	assert.ErrorIs(t, s.DeleteSavedSearch(ctx, "cesar", "", "8s"), ErrNotFound)
+	_, err = s.SavedSearch(ctx, "cesar", "", "8s")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = s.SavedSearch(ctx, "other", "", "top 7s")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = s.SaveSearch(ctx, &SavedSearch{User: "../x", Name: "a"})
+	assert.ErrorIs(t, err, ErrInvalidUser)
+
+	assert.Nil(t, ValidateSearchName("top 7s"))
+	assert.ErrorIs(t, ValidateSearchName(""), ErrInvalidSearchName)
+}
@@ -0,0 +1,560 @@
+package cardbox
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const schema = `
+CREATE TABLE IF NOT EXISTS cardboxes (
+	id %s,
+	user_name varchar(64) NOT NULL,
+	name varchar(128) NOT NULL,
+	lexicon varchar(32) NOT NULL,
+	created bigint NOT NULL,
+	deleted bigint
+);
+CREATE INDEX IF NOT EXISTS cardboxes_user ON cardboxes (user_name);
+CREATE TABLE IF NOT EXISTS cards (
+	cardbox_id bigint NOT NULL REFERENCES cardboxes (id) ON DELETE CASCADE,
+	alphagram varchar(32) NOT NULL,
+	words text NOT NULL,
+	box integer NOT NULL DEFAULT 0,
+	due bigint NOT NULL,
+	num_correct integer NOT NULL DEFAULT 0,
+	num_incorrect integer NOT NULL DEFAULT 0,
+	PRIMARY KEY (cardbox_id, alphagram)
+);
+CREATE INDEX IF NOT EXISTS cards_due ON cards (cardbox_id, due);
+CREATE TABLE IF NOT EXISTS saved_searches (
+	user_name varchar(64) NOT NULL,
+	api_key varchar(64) NOT NULL DEFAULT '',
+	name varchar(128) NOT NULL,
+	search text NOT NULL,
+	created bigint NOT NULL,
+	updated bigint NOT NULL,
+	PRIMARY KEY (user_name, api_key, name)
+);
+`
+
+// sqlStore keeps cardboxes in a database. The queries are written with ?
+// placeholders, which are rewritten for Postgres.
+type sqlStore struct {
+	db       *sql.DB
+	postgres bool
+}
+
+func (s *sqlStore) createSchema(ctx context.Context) error {
+	id := "integer PRIMARY KEY AUTOINCREMENT"
+	if s.postgres {
+		id = "bigserial PRIMARY KEY"
+	}
+	if _, err := s.db.ExecContext(ctx, fmt.Sprintf(schema, id)); err != nil {
+		return err
+	}
+	return s.addDeletedColumn(ctx)
+}
+
+// addDeletedColumn adds the deleted column to a cardboxes table made before
+// cardboxes could be restored.
+func (s *sqlStore) addDeletedColumn(ctx context.Context) error {
+	if s.postgres {
+		_, err := s.db.ExecContext(ctx,
+			`ALTER TABLE cardboxes ADD COLUMN IF NOT EXISTS deleted bigint`)
+		return err
+	}
+	var n int
+	err := s.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM pragma_table_info('cardboxes')
+		WHERE name = 'deleted'`).Scan(&n)
+	if err != nil || n > 0 {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, `ALTER TABLE cardboxes ADD COLUMN deleted bigint`)
+	return err
+}
+
+// rebind rewrites a query's placeholders for the database.
+func (s *sqlStore) rebind(query string) string {
+	if !s.postgres {
+		return query
+	}
+	var sb strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			sb.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+func (s *sqlStore) CreateCardbox(ctx context.Context, box *Cardbox, cards []*Card) (int64, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	var id int64
+	err = tx.QueryRowContext(ctx, s.rebind(`INSERT INTO cardboxes
+		(user_name, name, lexicon, created) VALUES (?, ?, ?, ?) RETURNING id`),
+		box.User, box.Name, box.Lexicon, box.Created.Unix()).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	stmt, err := tx.PrepareContext(ctx, s.rebind(`INSERT INTO cards
+		(cardbox_id, alphagram, words, box, due, num_correct, num_incorrect)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`))
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+	for _, c := range cards {
+		due := c.Due
+		if due.IsZero() {
+			due = box.Created
+		}
+		_, err := stmt.ExecContext(ctx, id, c.Alphagram, strings.Join(c.Words, ","),
+			c.Box, due.Unix(), c.NumCorrect, c.NumIncorrect)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return id, tx.Commit()
+}
+
+func (s *sqlStore) Cardbox(ctx context.Context, user string, id int64) (*Cardbox, error) {
+	box := &Cardbox{ID: id, User: user}
+	var created int64
+	err := s.db.QueryRowContext(ctx, s.rebind(`SELECT name, lexicon, created
+		FROM cardboxes WHERE id = ? AND user_name = ? AND deleted IS NULL`), id, user).Scan(
+		&box.Name, &box.Lexicon, &created)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	box.Created = time.Unix(created, 0)
+	return box, nil
+}
+
+func (s *sqlStore) DeleteCardbox(ctx context.Context, user string, id int64, now time.Time) error {
+	res, err := s.db.ExecContext(ctx, s.rebind(`UPDATE cardboxes SET deleted = ?
+		WHERE id = ? AND user_name = ? AND deleted IS NULL`), now.Unix(), id, user)
+	if err != nil {
+		return err
+	}
+	return requireUpdated(res)
+}
+
+func (s *sqlStore) RestoreCardbox(ctx context.Context, user string, id int64,
+	deletedSince time.Time) (*Cardbox, error) {
+
+	res, err := s.db.ExecContext(ctx, s.rebind(`UPDATE cardboxes SET deleted = NULL
+		WHERE id = ? AND user_name = ? AND deleted >= ?`), id, user, deletedSince.Unix())
+	if err != nil {
+		return nil, err
+	}
+	if err := requireUpdated(res); err != nil {
+		return nil, err
+	}
+	return s.Cardbox(ctx, user, id)
+}
+
+// requireUpdated returns ErrNotFound if no rows were updated.
+func requireUpdated(res sql.Result) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *sqlStore) DeletedCardboxes(ctx context.Context, user string, deletedSince time.Time) (
+	[]*Cardbox, error) {
+
+	rows, err := s.db.QueryContext(ctx, s.rebind(`SELECT id, name, lexicon, created, deleted
+		FROM cardboxes WHERE user_name = ? AND deleted >= ? ORDER BY deleted DESC, id`),
+		user, deletedSince.Unix())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	boxes := []*Cardbox{}
+	for rows.Next() {
+		box := &Cardbox{User: user}
+		var created, deleted int64
+		if err := rows.Scan(&box.ID, &box.Name, &box.Lexicon, &created, &deleted); err != nil {
+			return nil, err
+		}
+		box.Created = time.Unix(created, 0)
+		box.Deleted = time.Unix(deleted, 0)
+		boxes = append(boxes, box)
+	}
+	return boxes, rows.Err()
+}
+
+func (s *sqlStore) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	res, err := s.db.ExecContext(ctx, s.rebind(`DELETE FROM cardboxes WHERE deleted < ?`),
+		before.Unix())
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	return int(n), err
+}
+
+const cardColumns = `alphagram, words, box, due, num_correct, num_incorrect`
+
+func scanCard(row interface{ Scan(...any) error }) (*Card, error) {
+	c := &Card{}
+	var words string
+	var due int64
+	err := row.Scan(&c.Alphagram, &words, &c.Box, &due, &c.NumCorrect, &c.NumIncorrect)
+	if err != nil {
+		return nil, err
+	}
+	if words != "" {
+		c.Words = strings.Split(words, ",")
+	}
+	c.Due = time.Unix(due, 0)
+	return c, nil
+}
+
+func (s *sqlStore) RecordAnswer(ctx context.Context, user string, id int64, alphagram string,
+	correct bool, now time.Time) (*Card, error) {
+
+	if _, err := s.Cardbox(ctx, user, id); err != nil {
+		return nil, err
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	c, err := scanCard(tx.QueryRowContext(ctx, s.rebind(`SELECT `+cardColumns+`
+		FROM cards WHERE cardbox_id = ? AND alphagram = ?`), id, alphagram))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.Answer(correct, now)
+	_, err = tx.ExecContext(ctx, s.rebind(`UPDATE cards
+		SET box = ?, due = ?, num_correct = ?, num_incorrect = ?
+		WHERE cardbox_id = ? AND alphagram = ?`),
+		c.Box, c.Due.Unix(), c.NumCorrect, c.NumIncorrect, id, alphagram)
+	if err != nil {
+		return nil, err
+	}
+	return c, tx.Commit()
+}
+
+func (s *sqlStore) Cards(ctx context.Context, user string, id int64) ([]*Card, error) {
+	if _, err := s.Cardbox(ctx, user, id); err != nil {
+		return nil, err
+	}
+	rows, err := s.db.QueryContext(ctx, s.rebind(`SELECT `+cardColumns+`
+		FROM cards WHERE cardbox_id = ? ORDER BY alphagram`), id)
+	if err != nil {
+		return nil, err
+	}
+	return scanCards(rows)
+}
+
+func scanCards(rows *sql.Rows) ([]*Card, error) {
+	defer rows.Close()
+	cards := []*Card{}
+	for rows.Next() {
+		c, err := scanCard(rows)
+		if err != nil {
+			return nil, err
+		}
+		cards = append(cards, c)
+	}
+	return cards, rows.Err()
+}
+
+func (s *sqlStore) DueCards(ctx context.Context, user string, id int64, now time.Time,
+	limit int) ([]*Card, int, error) {
+
+	if _, err := s.Cardbox(ctx, user, id); err != nil {
+		return nil, 0, err
+	}
+	var numDue int
+	err := s.db.QueryRowContext(ctx, s.rebind(`SELECT COUNT(*) FROM cards
+		WHERE cardbox_id = ? AND due <= ?`), id, now.Unix()).Scan(&numDue)
+	if err != nil {
+		return nil, 0, err
+	}
+	query := `SELECT ` + cardColumns + ` FROM cards WHERE cardbox_id = ? AND due <= ?
+		ORDER BY due, alphagram`
+	args := []any{id, now.Unix()}
+	if limit > 0 {
+		query += ` LIMIT ?`
+		args = append(args, limit)
+	}
+	rows, err := s.db.QueryContext(ctx, s.rebind(query), args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	cards, err := scanCards(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return cards, numDue, nil
+}
+
+func (s *sqlStore) AnswerTotals(ctx context.Context, lexicon string) (
+	map[string]*AnswerTotals, error) {
+
+	rows, err := s.db.QueryContext(ctx, s.rebind(`SELECT cards.alphagram,
+		COUNT(DISTINCT cardboxes.user_name), SUM(cards.num_correct), SUM(cards.num_incorrect)
+		FROM cards JOIN cardboxes ON cards.cardbox_id = cardboxes.id
+		WHERE cardboxes.lexicon = ? AND cardboxes.deleted IS NULL
+		AND cards.num_correct + cards.num_incorrect > 0
+		GROUP BY cards.alphagram`), lexicon)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	totals := map[string]*AnswerTotals{}
+	for rows.Next() {
+		t := &AnswerTotals{}
+		if err := rows.Scan(&t.Alphagram, &t.Users, &t.NumCorrect, &t.NumIncorrect); err != nil {
+			return nil, err
+		}
+		totals[t.Alphagram] = t
+	}
+	return totals, rows.Err()
+}
+
+func (s *sqlStore) Close() error {
+	return s.db.Close()
+}
+
+// PostgresStore keeps every user's cardboxes in one Postgres database, so
+// that replicas can share them.
+type PostgresStore struct {
+	sqlStore
+}
+
+// OpenPostgres connects to the Postgres database with the given DSN, and
+// creates the cardbox tables if they don't exist.
+func OpenPostgres(dsn string) (*PostgresStore, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, err
+	}
+	s := &PostgresStore{sqlStore{db: db, postgres: true}}
+	if err := s.createSchema(context.Background()); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// SQLiteStore keeps each user's cardboxes in a SQLite database of their
+// own, <dir>/<user>.db, which is only created when they make a cardbox.
+type SQLiteStore struct {
+	dir string
+}
+
+// OpenSQLite returns a store that keeps its databases in dir, creating the
+// directory if needed.
+func OpenSQLite(dir string) (*SQLiteStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &SQLiteStore{dir: dir}, nil
+}
+
+// open opens the user's database. If create is false and the user has
+// none, it returns ErrNotFound.
+func (s *SQLiteStore) open(ctx context.Context, user string, create bool) (*sqlStore, error) {
+	if err := ValidateUser(user); err != nil {
+		return nil, err
+	}
+	path := filepath.Join(s.dir, user+".db")
+	if !create {
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return nil, ErrNotFound
+		}
+	}
+	db, err := sql.Open("sqlite3", path+"?_foreign_keys=1&_busy_timeout=5000")
+	if err != nil {
+		return nil, err
+	}
+	st := &sqlStore{db: db}
+	// Databases made by older versions may need columns added.
+	if err := st.createSchema(ctx); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return st, nil
+}
+
+func (s *SQLiteStore) CreateCardbox(ctx context.Context, box *Cardbox, cards []*Card) (int64, error) {
+	st, err := s.open(ctx, box.User, true)
+	if err != nil {
+		return 0, err
+	}
+	defer st.Close()
+	return st.CreateCardbox(ctx, box, cards)
+}
+
+func (s *SQLiteStore) Cardbox(ctx context.Context, user string, id int64) (*Cardbox, error) {
+	st, err := s.open(ctx, user, false)
+	if err != nil {
+		return nil, err
+	}
+	defer st.Close()
+	return st.Cardbox(ctx, user, id)
+}
+
+func (s *SQLiteStore) Cards(ctx context.Context, user string, id int64) ([]*Card, error) {
+	st, err := s.open(ctx, user, false)
+	if err != nil {
+		return nil, err
+	}
+	defer st.Close()
+	return st.Cards(ctx, user, id)
+}
+
+func (s *SQLiteStore) RecordAnswer(ctx context.Context, user string, id int64, alphagram string,
+	correct bool, now time.Time) (*Card, error) {
+
+	st, err := s.open(ctx, user, false)
+	if err != nil {
+		return nil, err
+	}
+	defer st.Close()
+	return st.RecordAnswer(ctx, user, id, alphagram, correct, now)
+}
+
+func (s *SQLiteStore) DueCards(ctx context.Context, user string, id int64, now time.Time,
+	limit int) ([]*Card, int, error) {
+
+	st, err := s.open(ctx, user, false)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer st.Close()
+	return st.DueCards(ctx, user, id, now, limit)
+}
+
+func (s *SQLiteStore) DeleteCardbox(ctx context.Context, user string, id int64, now time.Time) error {
+	st, err := s.open(ctx, user, false)
+	if err != nil {
+		return err
+	}
+	defer st.Close()
+	return st.DeleteCardbox(ctx, user, id, now)
+}
+
+func (s *SQLiteStore) RestoreCardbox(ctx context.Context, user string, id int64,
+	deletedSince time.Time) (*Cardbox, error) {
+
+	st, err := s.open(ctx, user, false)
+	if err != nil {
+		return nil, err
+	}
+	defer st.Close()
+	return st.RestoreCardbox(ctx, user, id, deletedSince)
+}
+
+func (s *SQLiteStore) DeletedCardboxes(ctx context.Context, user string, deletedSince time.Time) (
+	[]*Cardbox, error) {
+
+	st, err := s.open(ctx, user, false)
+	if errors.Is(err, ErrNotFound) {
+		return []*Cardbox{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer st.Close()
+	return st.DeletedCardboxes(ctx, user, deletedSince)
+}
+
+// PurgeDeleted goes through every user's database in turn.
+func (s *SQLiteStore) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	purged := 0
+	err := s.eachUser(ctx, func(st *sqlStore) error {
+		n, err := st.PurgeDeleted(ctx, before)
+		purged += n
+		return err
+	})
+	return purged, err
+}
+
+// AnswerTotals goes through every user's database in turn.
+func (s *SQLiteStore) AnswerTotals(ctx context.Context, lexicon string) (
+	map[string]*AnswerTotals, error) {
+
+	totals := map[string]*AnswerTotals{}
+	err := s.eachUser(ctx, func(st *sqlStore) error {
+		userTotals, err := st.AnswerTotals(ctx, lexicon)
+		if err != nil {
+			return err
+		}
+		for a, t := range userTotals {
+			if sum, ok := totals[a]; ok {
+				sum.add(t)
+			} else {
+				totals[a] = t
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return totals, nil
+}
+
+// eachUser calls f with each user's database.
+func (s *SQLiteStore) eachUser(ctx context.Context, f func(st *sqlStore) error) error {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		user, ok := strings.CutSuffix(e.Name(), ".db")
+		if e.IsDir() || !ok || ValidateUser(user) != nil {
+			continue
+		}
+		st, err := s.open(ctx, user, false)
+		if err != nil {
+			return err
+		}
+		err = f(st)
+		st.Close()
+		if err != nil {
+			return fmt.Errorf("reading %v: %w", e.Name(), err)
+		}
+	}
+	return nil
+}
+
+// Close does nothing, as the databases are only open during calls.
+func (s *SQLiteStore) Close() error {
+	return nil
+}
@@ -0,0 +1,178 @@
+package common
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/domino14/word-golib/tilemapping"
+)
+
+// CustomLexicaFile is the file in the data path's lexica directory where
+// operators register their own word lists as lexica.
+const CustomLexicaFile = "custom_lexica.json"
+
+// A CustomLexicon is a word list, such as a school's vocabulary list, that
+// is built and served like any other lexicon.
+type CustomLexicon struct {
+	Name string `json:"name"`
+	// File is the word list, relative to the lexica directory unless it's
+	// an absolute path. Each line has a word, optionally followed by its
+	// definition.
+	File string `json:"file"`
+	// LetterDistribution is the name of a letter distribution in the data
+	// path, such as english.
+	LetterDistribution string `json:"letter_distribution"`
+	// Parent is the lexicon that words get their lexicon symbols from. It
+	// is optional.
+	Parent          string `json:"parent,omitempty"`
+	DescriptiveName string `json:"descriptive_name,omitempty"`
+}
+
+var customLexicaCache struct {
+	sync.Mutex
+	path    string
+	modTime time.Time
+	size    int64
+	lexica  []*CustomLexicon
+}
+
+// CustomLexica returns the custom lexica registered in the data path. It
+// returns none if there is no CustomLexicaFile.
+func CustomLexica(dataPath string) ([]*CustomLexicon, error) {
+	path := filepath.Join(dataPath, "lexica", CustomLexicaFile)
+	fi, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	c := &customLexicaCache
+	c.Lock()
+	defer c.Unlock()
+	if c.path == path && c.modTime.Equal(fi.ModTime()) && c.size == fi.Size() {
+		return c.lexica, nil
+	}
+	bts, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	lexica := []*CustomLexicon{}
+	if err := json.Unmarshal(bts, &lexica); err != nil {
+		return nil, fmt.Errorf("%s: %w", CustomLexicaFile, err)
+	}
+	for _, l := range lexica {
+		if l.Name == "" || l.File == "" || l.LetterDistribution == "" {
+			return nil, fmt.Errorf("%s: every lexicon needs a name, file and letter_distribution",
+				CustomLexicaFile)
+		}
+	}
+	c.path, c.modTime, c.size, c.lexica = path, fi.ModTime(), fi.Size(), lexica
+	return lexica, nil
+}
+
+// FindCustomLexicon returns the custom lexicon with the given name, or nil
+// if there isn't one.
+func FindCustomLexicon(dataPath, name string) (*CustomLexicon, error) {
+	lexica, err := CustomLexica(dataPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range lexica {
+		if strings.EqualFold(l.Name, name) {
+			return l, nil
+		}
+	}
+	return nil, nil
+}
+
+// IsCustomLexicon returns true if the lexicon is registered as a custom
+// lexicon, or its database was built from a word list given to dbmaker.
+func IsCustomLexicon(dataPath, name string) bool {
+	custom, _ := FindCustomLexicon(dataPath, name)
+	return custom != nil || storedLetterDistribution(dataPath, name) != ""
+}
+
+// WordListPath returns the path of the lexicon's word list.
+func (l *CustomLexicon) WordListPath(dataPath string) string {
+	if filepath.IsAbs(l.File) {
+		return l.File
+	}
+	return filepath.Join(dataPath, "lexica", l.File)
+}
+
+// LetterDistribution returns the letter distribution of a lexicon. Custom
+// lexica name theirs; the others' are worked out from their names. Failing
+// that, the lexicon database may say which it is; see
+// storedLetterDistribution.
+func LetterDistribution(cfg map[string]any, lexicon string) (*tilemapping.LetterDistribution, error) {
+	dataPath, _ := cfg["data-path"].(string)
+	if dataPath != "" {
+		custom, err := FindCustomLexicon(dataPath, lexicon)
+		if err != nil {
+			return nil, err
+		}
+		if custom != nil {
+			return tilemapping.NamedLetterDistribution(cfg, custom.LetterDistribution)
+		}
+	}
+	dist, err := tilemapping.ProbableLetterDistribution(cfg, lexicon)
+	if err == nil || dataPath == "" {
+		return dist, err
+	}
+	if name := storedLetterDistribution(dataPath, lexicon); name != "" {
+		return tilemapping.NamedLetterDistribution(cfg, name)
+	}
+	return nil, err
+}
+
+var storedDistCache struct {
+	sync.Mutex
+	// entries are keyed by database path.
+	entries map[string]storedDist
+}
+
+type storedDist struct {
+	modTime time.Time
+	name    string
+}
+
+// storedLetterDistribution returns the letter distribution that dbmaker
+// stored in a lexicon database's metadata, or "" if there isn't one. Only
+// databases built from word lists that aren't registered anywhere need it.
+func storedLetterDistribution(dataPath, lexicon string) string {
+	if lexicon == "" || filepath.Base(lexicon) != lexicon {
+		return ""
+	}
+	path := filepath.Join(dataPath, "lexica", "db", lexicon+".db")
+	fi, err := os.Stat(path)
+	if err != nil {
+		return ""
+	}
+	c := &storedDistCache
+	c.Lock()
+	defer c.Unlock()
+	if e, ok := c.entries[path]; ok && e.modTime.Equal(fi.ModTime()) {
+		return e.name
+	}
+	var name string
+	db, err := sql.Open("sqlite3", "file:"+path+"?mode=ro")
+	if err != nil {
+		return ""
+	}
+	defer db.Close()
+	// Older databases have no metadata table, and most have no
+	// letter_distribution in it.
+	db.QueryRow(`SELECT value FROM lexicon_metadata WHERE key = 'letter_distribution'`).Scan(&name)
+	if c.entries == nil {
+		c.entries = map[string]storedDist{}
+	}
+	c.entries[path] = storedDist{fi.ModTime(), name}
+	return name
+}
@@ -0,0 +1,75 @@
+package common
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matryer/is"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestCustomLexica(t *testing.T) {
+	is := is.New(t)
+	dataPath := t.TempDir()
+	lexica, err := CustomLexica(dataPath)
+	is.NoErr(err)
+	is.Equal(len(lexica), 0)
+
+	is.NoErr(os.MkdirAll(filepath.Join(dataPath, "lexica"), 0755))
+	is.NoErr(os.MkdirAll(filepath.Join(dataPath, "letterdistributions"), 0755))
+	is.NoErr(os.WriteFile(filepath.Join(dataPath, "letterdistributions", "spanish"),
+		[]byte(miniSpanishDist), 0644))
+	is.NoErr(os.WriteFile(filepath.Join(dataPath, "lexica", CustomLexicaFile), []byte(`[
+		{"name": "Vocab1", "file": "vocab1.txt", "letter_distribution": "spanish",
+		 "parent": "FISE2"}
+	]`), 0644))
+
+	custom, err := FindCustomLexicon(dataPath, "VOCAB1")
+	is.NoErr(err)
+	is.Equal(custom.File, "vocab1.txt")
+	is.Equal(custom.Parent, "FISE2")
+	custom, err = FindCustomLexicon(dataPath, "Vocab2")
+	is.NoErr(err)
+	is.True(custom == nil)
+
+	cfg := map[string]any{"data-path": dataPath}
+	ld, err := LetterDistribution(cfg, "Vocab1")
+	is.NoErr(err)
+	is.Equal(DisplayForm("CHARRO", ld), "[CH]A[RR]O")
+	// Other lexica are looked up by name as usual.
+	_, err = LetterDistribution(cfg, "Vocab2")
+	is.True(err != nil)
+
+	is.NoErr(os.WriteFile(filepath.Join(dataPath, "lexica", CustomLexicaFile),
+		[]byte(`[{"name": "Vocab1"}]`), 0644))
+	_, err = CustomLexica(dataPath)
+	is.True(err != nil)
+}
+
+func TestStoredLetterDistribution(t *testing.T) {
+	is := is.New(t)
+	dataPath := t.TempDir()
+	dbDir := filepath.Join(dataPath, "lexica", "db")
+	is.NoErr(os.MkdirAll(dbDir, 0755))
+	is.NoErr(os.MkdirAll(filepath.Join(dataPath, "letterdistributions"), 0755))
+	is.NoErr(os.WriteFile(filepath.Join(dataPath, "letterdistributions", "spanish"),
+		[]byte(miniSpanishDist), 0644))
+	db, err := sql.Open("sqlite3", filepath.Join(dbDir, "MYLIST.db"))
+	is.NoErr(err)
+	_, err = db.Exec(`CREATE TABLE lexicon_metadata (key varchar(32) PRIMARY KEY, value text);
+		INSERT INTO lexicon_metadata VALUES ('letter_distribution', 'spanish');`)
+	is.NoErr(err)
+	db.Close()
+
+	cfg := map[string]any{"data-path": dataPath}
+	ld, err := LetterDistribution(cfg, "MYLIST")
+	is.NoErr(err)
+	is.Equal(DisplayForm("CHARRO", ld), "[CH]A[RR]O")
+	_, err = LetterDistribution(cfg, "OTHERLIST")
+	is.True(err != nil)
+	is.Equal(storedLetterDistribution(dataPath, "../db/MYLIST"), "")
+	is.True(IsCustomLexicon(dataPath, "MYLIST"))
+	is.True(!IsCustomLexicon(dataPath, "OTHERLIST"))
+}
@@ -0,0 +1,166 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// LexiconMapFiles are the names the lexicon map can have in the data
+// path's lexica directory, in the order they're looked for. The map says
+// which lexica dbmaker builds, in which families, and which old lexicon
+// names are aliases of newer lexica.
+var LexiconMapFiles = []string{"lexicon_map.yaml", "lexicon_map.yml", "lexicon_map.json"}
+
+// A LexiconMapConfig is the contents of a lexicon map file. Either part
+// can be left out: without families, dbmaker builds its own.
+type LexiconMapConfig struct {
+	// Families are in no particular order, but the lexica of a family go
+	// from oldest to newest.
+	Families []LexiconFamilyConfig `json:"families,omitempty" yaml:"families,omitempty"`
+	Aliases  []LexiconAlias        `json:"aliases,omitempty" yaml:"aliases,omitempty"`
+}
+
+type LexiconFamilyConfig struct {
+	Name   string          `json:"name" yaml:"name"`
+	Lexica []LexiconConfig `json:"lexica" yaml:"lexica"`
+}
+
+// A LexiconConfig is a lexicon that dbmaker can build.
+type LexiconConfig struct {
+	Name string `json:"name" yaml:"name"`
+	// LetterDistribution is the name of a letter distribution in the data
+	// path, such as english.
+	LetterDistribution string `json:"letter_distribution" yaml:"letter_distribution"`
+	DescriptiveName    string `json:"descriptive_name,omitempty" yaml:"descriptive_name,omitempty"`
+	// File is the word list, relative to the lexica directory; Name.txt if
+	// not given.
+	File string `json:"file,omitempty" yaml:"file,omitempty"`
+	// KWG is the name of the lexicon's KWG, if it isn't Name.
+	KWG   string `json:"kwg,omitempty" yaml:"kwg,omitempty"`
+	Index uint8  `json:"index,omitempty" yaml:"index,omitempty"`
+	// Difficulty is set for lexica that have difficulty and playability
+	// data to load.
+	Difficulty bool `json:"difficulty,omitempty" yaml:"difficulty,omitempty"`
+}
+
+// A LexiconAlias is an old name of a lexicon, which is still understood
+// but is deprecated. The lexicon it stands for can itself be an alias.
+type LexiconAlias struct {
+	Name   string `json:"name" yaml:"name"`
+	Target string `json:"target" yaml:"target"`
+	// Message, if given, is the deprecation warning sent to whoever uses
+	// the alias, in place of the default one.
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+}
+
+// Warning returns the deprecation warning for the alias, which stands for
+// the lexicon named target.
+func (a LexiconAlias) Warning(target string) string {
+	if a.Message != "" {
+		return a.Message
+	}
+	return fmt.Sprintf("lexicon %s is deprecated; use %s", a.Name, target)
+}
+
+// LoadLexiconMap reads the lexicon map from the data path. It returns nil
+// if there is no lexicon map file.
+func LoadLexiconMap(dataPath string) (*LexiconMapConfig, error) {
+	for _, name := range LexiconMapFiles {
+		path := filepath.Join(dataPath, "lexica", name)
+		contents, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		m := &LexiconMapConfig{}
+		if filepath.Ext(path) == ".json" {
+			err = json.Unmarshal(contents, m)
+		} else {
+			err = yaml.Unmarshal(contents, m)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		if err := m.validate(); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		return m, nil
+	}
+	return nil, nil
+}
+
+func (m *LexiconMapConfig) validate() error {
+	lexica := map[string]bool{}
+	for _, f := range m.Families {
+		if f.Name == "" || len(f.Lexica) == 0 {
+			return errors.New("every family needs a name and lexica")
+		}
+		if f.Name == "Custom" {
+			// dbmaker's family of the custom lexica.
+			return errors.New("the Custom family is made from the custom lexica file")
+		}
+		for _, l := range f.Lexica {
+			if l.Name == "" || l.LetterDistribution == "" {
+				return errors.New("every lexicon needs a name and letter_distribution")
+			}
+			if lexica[l.Name] {
+				return fmt.Errorf("lexicon %s is listed twice", l.Name)
+			}
+			lexica[l.Name] = true
+		}
+	}
+	aliases := map[string]bool{}
+	for _, a := range m.Aliases {
+		if a.Name == "" || a.Target == "" {
+			return errors.New("every alias needs a name and target")
+		}
+		if aliases[a.Name] {
+			return fmt.Errorf("alias %s is listed twice", a.Name)
+		}
+		aliases[a.Name] = true
+	}
+	for _, a := range m.Aliases {
+		if _, _, err := m.Resolve(a.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Resolve returns the lexicon that name stands for, following aliases,
+// and the alias that was used, if any. Names are compared case
+// insensitively, like lexicon names elsewhere.
+func (m *LexiconMapConfig) Resolve(name string) (string, *LexiconAlias, error) {
+	if m == nil {
+		return name, nil, nil
+	}
+	var used *LexiconAlias
+	seen := map[string]bool{}
+	for {
+		var next *LexiconAlias
+		for i := range m.Aliases {
+			if strings.EqualFold(m.Aliases[i].Name, name) {
+				next = &m.Aliases[i]
+				break
+			}
+		}
+		if next == nil {
+			return name, used, nil
+		}
+		if seen[next.Name] {
+			return "", nil, fmt.Errorf("alias %s is part of a cycle", next.Name)
+		}
+		seen[next.Name] = true
+		if used == nil {
+			used = next
+		}
+		name = next.Target
+	}
+}
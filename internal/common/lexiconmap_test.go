@@ -0,0 +1,73 @@
+package common
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestLoadLexiconMap(t *testing.T) {
+	is := is.New(t)
+	dataPath := t.TempDir()
+	m, err := LoadLexiconMap(dataPath)
+	is.NoErr(err)
+	is.True(m == nil)
+
+	is.NoErr(os.MkdirAll(filepath.Join(dataPath, "lexica"), 0755))
+	is.NoErr(os.WriteFile(filepath.Join(dataPath, "lexica", "lexicon_map.yaml"), []byte(`
+families:
+  - name: TWL
+    lexica:
+      - name: NWL20
+        letter_distribution: english
+        difficulty: true
+      - name: NWL23
+        letter_distribution: english
+        index: 24
+aliases:
+  - name: America
+    target: NWL18
+  - name: NWL18
+    target: NWL20
+    message: NWL18 is retired
+`), 0644))
+	m, err = LoadLexiconMap(dataPath)
+	is.NoErr(err)
+	is.Equal(len(m.Families), 1)
+	is.Equal(m.Families[0].Lexica[1], LexiconConfig{Name: "NWL23",
+		LetterDistribution: "english", Index: 24})
+	is.True(m.Families[0].Lexica[0].Difficulty)
+
+	// Aliases are followed to the end, but the warning is the first's.
+	target, alias, err := m.Resolve("america")
+	is.NoErr(err)
+	is.Equal(target, "NWL20")
+	is.Equal(alias.Warning(target), "lexicon America is deprecated; use NWL20")
+	target, alias, err = m.Resolve("NWL18")
+	is.NoErr(err)
+	is.Equal(alias.Warning(target), "NWL18 is retired")
+	target, alias, err = m.Resolve("NWL23")
+	is.NoErr(err)
+	is.Equal(target, "NWL23")
+	is.True(alias == nil)
+}
+
+func TestLoadLexiconMapErrors(t *testing.T) {
+	is := is.New(t)
+	dataPath := t.TempDir()
+	is.NoErr(os.MkdirAll(filepath.Join(dataPath, "lexica"), 0755))
+	path := filepath.Join(dataPath, "lexica", "lexicon_map.json")
+	for _, contents := range []string{
+		`{"aliases": [{"name": "A", "target": "B"}, {"name": "B", "target": "A"}]}`,
+		`{"aliases": [{"name": "A"}]}`,
+		`{"families": [{"name": "TWL", "lexica": [{"name": "NWL20"}]}]}`,
+		`{"families": [{"name": "Custom", "lexica": [{"name": "X", "letter_distribution": "english"}]}]}`,
+		`{"families": [`,
+	} {
+		is.NoErr(os.WriteFile(path, []byte(contents), 0644))
+		_, err := LoadLexiconMap(dataPath)
+		is.True(err != nil)
+	}
+}
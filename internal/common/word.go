@@ -2,6 +2,7 @@ package common
 
 import (
 	"sort"
+	"strings"
 
 	"github.com/domino14/word-golib/tilemapping"
 )
@@ -12,6 +13,13 @@ type Word struct {
 }
 
 func (w Word) MakeAlphagram() string {
+	return w.MakeAlphagramML().UserVisible(w.dist.TileMapping())
+}
+
+// MakeAlphagramML returns the alphagram as tiles. With multi-character
+// tiles the alphagram string can be ambiguous, so use this when you need
+// the actual tiles (to count them, score them, etc).
+func (w Word) MakeAlphagramML() tilemapping.MachineWord {
 	mls, err := tilemapping.ToMachineLetters(w.word, w.dist.TileMapping())
 	if err != nil {
 		panic(err)
@@ -27,7 +35,39 @@ func (w Word) MakeAlphagram() string {
 		// blank is always greater than i
 		return true
 	})
-	return tilemapping.MachineWord(mls).UserVisible(w.dist.TileMapping())
+	return mls
+}
+
+// DisplayAlphagram returns the alphagram in its display form. See DisplayForm.
+func (w Word) DisplayAlphagram() string {
+	return DisplayMachineWord(w.MakeAlphagramML(), w.dist)
+}
+
+// DisplayForm returns the user-visible form of the given string, with any
+// multi-character tiles (such as the Spanish CH, LL, RR) surrounded by
+// brackets, e.g. [CH]A[RR]O. This way clients can render tiles correctly
+// without knowing anything about the language.
+func DisplayForm(word string, dist *tilemapping.LetterDistribution) string {
+	mls, err := tilemapping.ToMachineLetters(word, dist.TileMapping())
+	if err != nil {
+		panic(err)
+	}
+	return DisplayMachineWord(mls, dist)
+}
+
+// DisplayMachineWord is like DisplayForm, but for tiles that have already
+// been parsed.
+func DisplayMachineWord(mls tilemapping.MachineWord, dist *tilemapping.LetterDistribution) string {
+	var sb strings.Builder
+	for _, ml := range mls {
+		tile := ml.UserVisible(dist.TileMapping(), false)
+		if len([]rune(tile)) > 1 {
+			sb.WriteString("[" + tile + "]")
+		} else {
+			sb.WriteString(tile)
+		}
+	}
+	return sb.String()
 }
 
 func InitializeWord(word string, dist *tilemapping.LetterDistribution) Word {
@@ -2,6 +2,7 @@ package common
 
 import (
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/domino14/word-golib/tilemapping"
@@ -21,3 +22,35 @@ func TestAlphagram(t *testing.T) {
 	w := InitializeWord("?EMONEN", englishLD)
 	is.Equal(w.MakeAlphagram(), "EEMNNO?")
 }
+
+const miniSpanishDist = `?,2,0,0
+A,12,1,1
+CH,1,5,0
+E,12,1,1
+O,9,1,1
+R,5,1,0
+RR,1,8,0
+`
+
+func TestDisplayForm(t *testing.T) {
+	is := is.New(t)
+	ld, err := tilemapping.ScanLetterDistribution(strings.NewReader(miniSpanishDist))
+	is.NoErr(err)
+	is.Equal(DisplayForm("CHARRO", ld), "[CH]A[RR]O")
+	w := InitializeWord("CHARRO", ld)
+	is.Equal(w.MakeAlphagram(), "ACHORR")
+	is.Equal(w.DisplayAlphagram(), "A[CH]O[RR]")
+}
+
+func TestAlphagramSeparateTiles(t *testing.T) {
+	is := is.New(t)
+	ld, err := tilemapping.ScanLetterDistribution(strings.NewReader(miniSpanishDist))
+	is.NoErr(err)
+	// ROER has two separate R tiles, which sort next to each other and
+	// look like the RR tile in the alphagram string.
+	w := InitializeWord("ROER", ld)
+	is.Equal(w.MakeAlphagram(), "EORR")
+	is.Equal(len(w.MakeAlphagramML()), 4)
+	is.Equal(w.DisplayAlphagram(), "EORR")
+	is.Equal(DisplayForm("EORR", ld), "EO[RR]")
+}
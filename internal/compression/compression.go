@@ -0,0 +1,204 @@
+// Package compression compresses HTTP responses with zstd or gzip, for
+// the clients that accept them. Expanded search responses, with their
+// definitions, can be many megabytes of very repetitive text.
+package compression
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/gzip"
+	"github.com/klauspost/compress/zstd"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	Zstd = "zstd"
+	Gzip = "gzip"
+)
+
+// DefaultMinSize is the size below which responses are sent as they are;
+// compressing them saves less than it costs.
+const DefaultMinSize = 1024
+
+// Negotiate returns the encoding to compress a response in, given the
+// request's Accept-Encoding header: the one of zstd and gzip with the
+// highest quality, zstd if they're tied, or "" for neither.
+func Negotiate(acceptEncoding string) string {
+	best, bestQ := "", 0.0
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(part, ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name != Zstd && name != Gzip {
+			continue
+		}
+		q := 1.0
+		for _, param := range strings.Split(params, ";") {
+			k, v, ok := strings.Cut(param, "=")
+			if ok && strings.TrimSpace(k) == "q" {
+				var err error
+				if q, err = strconv.ParseFloat(strings.TrimSpace(v), 64); err != nil {
+					q = 0
+				}
+			}
+		}
+		if q > bestQ || (q == bestQ && q > 0 && name == Zstd) {
+			best, bestQ = name, q
+		}
+	}
+	return best
+}
+
+var (
+	gzipWriters = sync.Pool{New: func() any {
+		return gzip.NewWriter(nil)
+	}}
+	zstdWriters = sync.Pool{New: func() any {
+		// Responses are compressed one per goroutine already.
+		enc, err := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			panic(err)
+		}
+		return enc
+	}}
+)
+
+// Middleware compresses the responses of the handler that are at least
+// minSize bytes, if the client accepts zstd or gzip. A negative minSize
+// turns compression off. Responses that the handler has already encoded
+// are left alone.
+func Middleware(minSize int, next http.Handler) http.Handler {
+	if minSize < 0 {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept-Encoding")
+		encoding := Negotiate(r.Header.Get("Accept-Encoding"))
+		if encoding == "" || r.Method == http.MethodHead {
+			next.ServeHTTP(w, r)
+			return
+		}
+		cw := &responseWriter{ResponseWriter: w, encoding: encoding, minSize: minSize,
+			status: http.StatusOK}
+		defer func() {
+			if err := cw.close(); err != nil {
+				log.Err(err).Str("encoding", encoding).Msg("compressing response")
+			}
+		}()
+		next.ServeHTTP(cw, r)
+	})
+}
+
+// responseWriter holds back the start of a response until it knows whether
+// it's big enough to compress.
+type responseWriter struct {
+	http.ResponseWriter
+	encoding string
+	minSize  int
+
+	status  int
+	buf     []byte
+	started bool
+	enc     io.WriteCloser
+}
+
+func (cw *responseWriter) WriteHeader(status int) {
+	if !cw.started {
+		cw.status = status
+	}
+}
+
+func (cw *responseWriter) Write(p []byte) (int, error) {
+	if !cw.started {
+		cw.buf = append(cw.buf, p...)
+		if len(cw.buf) < cw.minSize && cw.Header().Get("Content-Encoding") == "" {
+			return len(p), nil
+		}
+		if err := cw.start(); err != nil {
+			return 0, err
+		}
+		return len(p), nil
+	}
+	if cw.enc != nil {
+		return cw.enc.Write(p)
+	}
+	return cw.ResponseWriter.Write(p)
+}
+
+// start sends the headers, and what's been held back, compressing the
+// response from here on if it's worth it.
+func (cw *responseWriter) start() error {
+	cw.started = true
+	h := cw.Header()
+	if len(cw.buf) >= cw.minSize && h.Get("Content-Encoding") == "" &&
+		cw.status != http.StatusNoContent && cw.status != http.StatusNotModified {
+
+		h.Del("Content-Length")
+		h.Set("Content-Encoding", cw.encoding)
+		switch cw.encoding {
+		case Zstd:
+			enc := zstdWriters.Get().(*zstd.Encoder)
+			enc.Reset(cw.ResponseWriter)
+			cw.enc = enc
+		case Gzip:
+			enc := gzipWriters.Get().(*gzip.Writer)
+			enc.Reset(cw.ResponseWriter)
+			cw.enc = enc
+		}
+	}
+	cw.ResponseWriter.WriteHeader(cw.status)
+	buf := cw.buf
+	cw.buf = nil
+	if len(buf) == 0 {
+		return nil
+	}
+	var err error
+	if cw.enc != nil {
+		_, err = cw.enc.Write(buf)
+	} else {
+		_, err = cw.ResponseWriter.Write(buf)
+	}
+	return err
+}
+
+// Flush sends what's been written so far, compressed if the response is
+// being compressed.
+func (cw *responseWriter) Flush() {
+	if !cw.started {
+		if err := cw.start(); err != nil {
+			return
+		}
+	}
+	if f, ok := cw.enc.(interface{ Flush() error }); ok {
+		f.Flush()
+	}
+	if f, ok := cw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// close ends the response, sending a small one as it is.
+func (cw *responseWriter) close() error {
+	if !cw.started {
+		// Too small to compress.
+		cw.minSize = len(cw.buf) + 1
+		return cw.start()
+	}
+	if cw.enc == nil {
+		return nil
+	}
+	err := cw.enc.Close()
+	switch enc := cw.enc.(type) {
+	case *zstd.Encoder:
+		enc.Reset(nil)
+		zstdWriters.Put(enc)
+	case *gzip.Writer:
+		enc.Reset(nil)
+		gzipWriters.Put(enc)
+	}
+	cw.enc = nil
+	return err
+}
@@ -0,0 +1,91 @@
+package compression
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestNegotiate(t *testing.T) {
+	for header, want := range map[string]string{
+		"":                       "",
+		"identity":               "",
+		"gzip, deflate, br":      Gzip,
+		"gzip, zstd":             Zstd,
+		"zstd;q=0.5, gzip":       Gzip,
+		"ZSTD":                   Zstd,
+		"gzip;q=0, zstd;q=0":     "",
+		"gzip; q=0.8, zstd;q=.8": Zstd,
+		"zstd;q=nope, gzip;q=.1": Gzip,
+	} {
+		assert.Equal(t, want, Negotiate(header), header)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	big := strings.Repeat("a very repetitive definition; ", 200)
+	inner := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/small" {
+			io.WriteString(w, "{}")
+			return
+		}
+		w.WriteHeader(http.StatusTeapot)
+		// Written in pieces, smaller than the minimum.
+		for i := 0; i < len(big); i += 100 {
+			io.WriteString(w, big[i:min(i+100, len(big))])
+		}
+	})
+	h := Middleware(DefaultMinSize, inner)
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	for _, encoding := range []string{Zstd, Gzip} {
+		req := httptest.NewRequest("GET", "/big", nil)
+		req.Header.Set("Accept-Encoding", encoding)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusTeapot, rec.Code)
+		assert.Equal(t, encoding, rec.Header().Get("Content-Encoding"))
+		assert.Equal(t, "Accept-Encoding", rec.Header().Get("Vary"))
+		assert.Less(t, rec.Body.Len(), len(big)/10)
+	}
+
+	req := httptest.NewRequest("GET", "/small", nil)
+	req.Header.Set("Accept-Encoding", "zstd")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, "", rec.Header().Get("Content-Encoding"))
+	assert.Equal(t, "{}", rec.Body.String())
+
+	// The client decompresses what the middleware compressed.
+	client := wordsearcher.NewCompressionClient(srv.Client())
+	resp, err := client.Get(srv.URL + "/big")
+	assert.Nil(t, err)
+	body, err := io.ReadAll(resp.Body)
+	assert.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, big, string(body))
+	assert.True(t, resp.Uncompressed)
+	assert.Equal(t, "", resp.Header.Get("Content-Encoding"))
+
+	resp, err = client.Get(srv.URL + "/small")
+	assert.Nil(t, err)
+	body, _ = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, "{}", string(body))
+
+	// Compression can be turned off.
+	rec = httptest.NewRecorder()
+	req = httptest.NewRequest("GET", "/big", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	Middleware(-1, inner).ServeHTTP(rec, req)
+	assert.Equal(t, "", rec.Header().Get("Content-Encoding"))
+	assert.Equal(t, big, rec.Body.String())
+}
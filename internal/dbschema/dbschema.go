@@ -0,0 +1,8 @@
+// Package dbschema has the parts of the lexicon database schema that both
+// dbmaker, which builds the databases, and the searcher, which serves and
+// edits them, need, so that the searcher doesn't depend on dbmaker.
+package dbschema
+
+// CurrentVersion is the db_version of a database made or migrated by this
+// version of dbmaker.
+const CurrentVersion = 19
@@ -0,0 +1,99 @@
+package dbschema
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// CreateDefinitionAuditQuery makes the definition_audit table, which has a
+// row for every definition changed by ApplyDefinitionUpdates, so
+// corrections made to a serving database can be reviewed and folded back
+// into the definition files.
+const CreateDefinitionAuditQuery = `
+	CREATE TABLE IF NOT EXISTS definition_audit (word varchar(20),
+		old_definition varchar(512), new_definition varchar(512),
+		author varchar(64), changed_at int);
+`
+
+// ApplyDefinitionUpdates sets the definitions of the given words in a
+// single transaction, keeping the full-text index in sync and recording
+// every change in definition_audit. Unlike dbmaker's FixDefinitions, it is
+// meant to be called on a live database, so it returns errors instead of
+// exiting.
+// It returns the number of words whose definitions changed, and the words
+// that are not in the lexicon (these are left alone).
+func ApplyDefinitionUpdates(db *sql.DB, updates map[string]string, author string) (
+	int, []string, error) {
+
+	if _, err := db.Exec(CreateDefinitionAuditQuery); err != nil {
+		return 0, nil, err
+	}
+	var hasFTS bool
+	err := db.QueryRow(`SELECT EXISTS(SELECT 1 FROM sqlite_master
+		WHERE name = 'definitions_fts')`).Scan(&hasFTS)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	words := make([]string, 0, len(updates))
+	for w := range updates {
+		words = append(words, w)
+	}
+	sort.Strings(words)
+
+	tx, err := db.Begin()
+	if err != nil {
+		return 0, nil, err
+	}
+	defer tx.Rollback()
+
+	now := time.Now().Unix()
+	updated := 0
+	notFound := []string{}
+	for _, word := range words {
+		def := updates[word]
+		var oldDef string
+		err := tx.QueryRow(`SELECT definition FROM words WHERE word = ?`, word).Scan(&oldDef)
+		if err == sql.ErrNoRows {
+			notFound = append(notFound, word)
+			continue
+		} else if err != nil {
+			return 0, nil, err
+		}
+		if oldDef == def {
+			continue
+		}
+		if _, err := tx.Exec(`UPDATE words SET definition = ? WHERE word = ?`, def, word); err != nil {
+			return 0, nil, err
+		}
+		if hasFTS {
+			if _, err := tx.Exec(`DELETE FROM definitions_fts WHERE word = ?`, word); err != nil {
+				return 0, nil, err
+			}
+			if def != "" {
+				_, err := tx.Exec(`INSERT INTO definitions_fts(word, definition) VALUES(?, ?)`,
+					word, def)
+				if err != nil {
+					return 0, nil, err
+				}
+			}
+		}
+		_, err = tx.Exec(`
+		INSERT INTO definition_audit(word, old_definition, new_definition, author, changed_at)
+		VALUES(?, ?, ?, ?, ?)`, word, oldDef, def, author, now)
+		if err != nil {
+			return 0, nil, err
+		}
+		log.Info().Str("word", word).Str("author", author).Str("old", oldDef).
+			Str("new", def).Msg("definition-updated")
+		updated++
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, nil, fmt.Errorf("committing definition updates: %w", err)
+	}
+	return updated, notFound, nil
+}
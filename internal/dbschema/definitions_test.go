@@ -0,0 +1,49 @@
+package dbschema
+
+import (
+	"database/sql"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyDefinitionUpdates(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.Nil(t, err)
+	defer db.Close()
+	_, err = db.Exec(`
+	CREATE TABLE words (word varchar(20), definition varchar(512));
+	INSERT INTO words VALUES ('CAT', 'a feline [n]'), ('DOG', 'a canin [n]'), ('EMU', '');
+	`)
+	assert.Nil(t, err)
+
+	updated, notFound, err := ApplyDefinitionUpdates(db, map[string]string{
+		"DOG": "a canine [n]",
+		"CAT": "a feline [n]",
+		"EMU": "a large bird [n]",
+		"QXZ": "not a word",
+	}, "cesar")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, updated)
+	assert.Equal(t, []string{"QXZ"}, notFound)
+
+	var def string
+	assert.Nil(t, db.QueryRow(`SELECT definition FROM words WHERE word = 'DOG'`).Scan(&def))
+	assert.Equal(t, "a canine [n]", def)
+
+	rows, err := db.Query(`SELECT word, old_definition, new_definition, author
+		FROM definition_audit ORDER BY word`)
+	assert.Nil(t, err)
+	defer rows.Close()
+	audit := [][4]string{}
+	for rows.Next() {
+		var r [4]string
+		assert.Nil(t, rows.Scan(&r[0], &r[1], &r[2], &r[3]))
+		audit = append(audit, r)
+	}
+	assert.Equal(t, [][4]string{
+		{"DOG", "a canin [n]", "a canine [n]", "cesar"},
+		{"EMU", "", "a large bird [n]", "cesar"},
+	}, audit)
+}
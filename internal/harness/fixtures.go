@@ -0,0 +1,147 @@
+package harness
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/domino14/word-golib/tilemapping"
+
+	"github.com/domino14/word_db_server/dbmaker"
+	"github.com/domino14/word_db_server/internal/common"
+)
+
+// englishDistribution is the English letter distribution, which every
+// fixture lexicon uses.
+const englishDistribution = `?,2,0,0
+A,9,1,1
+B,2,3,0
+C,2,3,0
+D,4,2,0
+E,12,1,1
+F,2,4,0
+G,3,2,0
+H,2,4,0
+I,9,1,1
+J,1,8,0
+K,1,5,0
+L,4,1,0
+M,2,3,0
+N,6,1,0
+O,8,1,1
+P,2,3,0
+Q,1,10,0
+R,6,1,0
+S,4,1,0
+T,6,1,0
+U,4,1,1
+V,2,4,0
+W,2,4,0
+X,1,8,0
+Y,2,4,0
+Z,1,10,0
+`
+
+// A Lexicon is a fixture lexicon. Its name has to start with one a KWG's
+// alphabet is known from, such as NWL.
+type Lexicon struct {
+	Name string `json:"name"`
+	// Words are lines of a word list: a word, then its definition, if it
+	// has one.
+	Words []string `json:"words"`
+}
+
+// WriteFixtures writes a data path with the lexica in it: a word list, a
+// KWG and a database each, as dbmaker would build it. The lexica are
+// registered as custom lexica, with the English letter distribution.
+func WriteFixtures(dataPath string, lexica []Lexicon) error {
+	for _, dir := range []string{"letterdistributions", "lexica/gaddag", "lexica/db"} {
+		if err := os.MkdirAll(filepath.Join(dataPath, dir), 0755); err != nil {
+			return err
+		}
+	}
+	err := os.WriteFile(filepath.Join(dataPath, "letterdistributions", "english"),
+		[]byte(englishDistribution), 0644)
+	if err != nil {
+		return err
+	}
+	ld, err := tilemapping.ScanLetterDistribution(strings.NewReader(englishDistribution))
+	if err != nil {
+		return err
+	}
+	custom := []*common.CustomLexicon{}
+	for _, lex := range lexica {
+		custom = append(custom, &common.CustomLexicon{Name: lex.Name, File: lex.Name + ".txt",
+			LetterDistribution: "english"})
+	}
+	registered, err := json.Marshal(custom)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(filepath.Join(dataPath, "lexica", common.CustomLexicaFile), registered, 0644)
+	if err != nil {
+		return err
+	}
+
+	lexMap := dbmaker.LexiconMap{}
+	for _, lex := range lexica {
+		wordList := filepath.Join(dataPath, "lexica", lex.Name+".txt")
+		if err := os.WriteFile(wordList, []byte(strings.Join(lex.Words, "\n")+"\n"), 0644); err != nil {
+			return err
+		}
+		if err := writeFixtureKWG(dataPath, lex, ld); err != nil {
+			return err
+		}
+		// The database's hooks are found from the word list, as for any
+		// custom lexicon without a KWG, rather than from the KWG above.
+		info := &dbmaker.LexiconInfo{
+			LexiconName:        lex.Name,
+			LexiconFilename:    wordList,
+			DescriptiveName:    lex.Name,
+			LetterDistribution: ld,
+			Custom:             true,
+		}
+		info.Initialize()
+		lexMap[dbmaker.FamilyCustom] = append(lexMap[dbmaker.FamilyCustom], info)
+	}
+	for _, info := range lexMap[dbmaker.FamilyCustom] {
+		err := dbmaker.CreateLexiconDatabase(info.LexiconName, info, lexMap,
+			filepath.Join(dataPath, "lexica", "db"), false, dbmaker.CreateOptions{})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeFixtureKWG(dataPath string, lex Lexicon, ld *tilemapping.LetterDistribution) error {
+	var words []tilemapping.MachineWord
+	for _, line := range lex.Words {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		mw, err := tilemapping.ToMachineWord(strings.ToUpper(fields[0]), ld.TileMapping())
+		if err != nil {
+			return fmt.Errorf("%v: %w", lex.Name, err)
+		}
+		words = append(words, mw)
+	}
+	f, err := os.Create(filepath.Join(dataPath, "lexica", "gaddag", lex.Name+".kwg"))
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	if err := writeKWG(w, words); err != nil {
+		f.Close()
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,35 @@
+//go:build sqlite_fts5
+
+package harness
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSuite runs the suite in testdata against a server of its fixtures.
+// Building them needs FTS5, like dbmaker does, so it only runs with
+// -tags sqlite_fts5. After a change to what the server returns on purpose,
+// rewrite the golden files with `go run -tags sqlite_fts5 ./cmd/harness
+// -update`.
+func TestSuite(t *testing.T) {
+	s, err := LoadSuite(filepath.Join("testdata", "suite.json"))
+	assert.Nil(t, err)
+	dataPath := t.TempDir()
+	assert.Nil(t, WriteFixtures(dataPath, s.Lexica))
+	srv, err := StartServer(dataPath)
+	assert.Nil(t, err)
+	defer srv.Close()
+
+	for _, useJSON := range []bool{false, true} {
+		results := s.Run(context.Background(), NewClients(srv.URL, useJSON),
+			filepath.Join("testdata", "golden"), false)
+		assert.Equal(t, len(s.Calls), len(results))
+		for _, r := range results {
+			assert.Nil(t, r.Err, r.Call)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package harness
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/domino14/word-golib/tilemapping"
+)
+
+// The fixture lexica need a KWG for the Anagrammer, which nothing in Go
+// builds; the real ones come from wolges. writeKWG writes one that isn't
+// minimized, so it's only fit for small word lists. See
+// https://github.com/andy-k/wolges/blob/main/details.txt for the format.
+
+const (
+	kwgAccepts  = 0x800000
+	kwgIsEnd    = 0x400000
+	kwgMaxArc   = 0x3fffff
+	gaddagSepML = 0
+)
+
+type trieNode struct {
+	children map[tilemapping.MachineLetter]*trieNode
+	accepts  bool
+}
+
+func (n *trieNode) add(word []tilemapping.MachineLetter) {
+	for _, ml := range word {
+		if n.children == nil {
+			n.children = map[tilemapping.MachineLetter]*trieNode{}
+		}
+		child, ok := n.children[ml]
+		if !ok {
+			child = &trieNode{}
+			n.children[ml] = child
+		}
+		n = child
+	}
+	n.accepts = true
+}
+
+// writeKWG writes a KWG of the words, with both its DAWG and its GADDAG.
+func writeKWG(w io.Writer, words []tilemapping.MachineWord) error {
+	dawg, gaddag := &trieNode{}, &trieNode{}
+	for _, word := range words {
+		dawg.add(word)
+		rev := slices.Clone(word)
+		slices.Reverse(rev)
+		gaddag.add(rev)
+		// Every split of the word, as its reversed prefix, the separator,
+		// and the rest of it.
+		for i := 1; i < len(word); i++ {
+			path := append(slices.Clone(rev[len(word)-i:]), gaddagSepML)
+			gaddag.add(append(path, word[i:]...))
+		}
+	}
+	// The first two nodes point to the DAWG's and the GADDAG's roots.
+	nodes := []uint32{0, 0}
+	var emit func(n *trieNode) (uint32, error)
+	emit = func(n *trieNode) (uint32, error) {
+		if len(n.children) == 0 {
+			return 0, nil
+		}
+		tiles := make([]tilemapping.MachineLetter, 0, len(n.children))
+		for ml := range n.children {
+			tiles = append(tiles, ml)
+		}
+		slices.Sort(tiles)
+		start := len(nodes)
+		if start+len(tiles) > kwgMaxArc {
+			return 0, fmt.Errorf("too many words for a KWG of %d nodes", kwgMaxArc)
+		}
+		nodes = append(nodes, make([]uint32, len(tiles))...)
+		for i, ml := range tiles {
+			child := n.children[ml]
+			arc, err := emit(child)
+			if err != nil {
+				return 0, err
+			}
+			node := uint32(ml)<<24 | arc
+			if child.accepts {
+				node |= kwgAccepts
+			}
+			if i == len(tiles)-1 {
+				node |= kwgIsEnd
+			}
+			nodes[start+i] = node
+		}
+		return uint32(start), nil
+	}
+	for i, root := range []*trieNode{dawg, gaddag} {
+		arc, err := emit(root)
+		if err != nil {
+			return err
+		}
+		nodes[i] = kwgIsEnd | arc
+	}
+	return binary.Write(w, binary.LittleEndian, nodes)
+}
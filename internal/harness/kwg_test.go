@@ -0,0 +1,40 @@
+package harness
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/domino14/word-golib/kwg"
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteKWG(t *testing.T) {
+	ld, err := tilemapping.ScanLetterDistribution(strings.NewReader(englishDistribution))
+	assert.Nil(t, err)
+	var words []tilemapping.MachineWord
+	for _, w := range []string{"AT", "EAT", "EATS", "ETA", "SEAT", "TEA", "TEAS"} {
+		mw, err := tilemapping.ToMachineWord(w, ld.TileMapping())
+		assert.Nil(t, err)
+		words = append(words, mw)
+	}
+	var buf bytes.Buffer
+	assert.Nil(t, writeKWG(&buf, words))
+	k, err := kwg.ScanKWG(&buf, buf.Len())
+	assert.Nil(t, err)
+
+	visible := func(mls []tilemapping.MachineLetter) string {
+		return tilemapping.MachineWord(mls).UserVisible(ld.TileMapping())
+	}
+	for _, w := range words {
+		assert.True(t, kwg.FindMachineWord(k, w), visible(w))
+	}
+	mw, _ := tilemapping.ToMachineWord("TAE", ld.TileMapping())
+	assert.False(t, kwg.FindMachineWord(k, mw))
+
+	assert.Equal(t, "S", visible(kwg.FindHooks(k, words[1], kwg.BackHooks)))
+	assert.Equal(t, "S", visible(kwg.FindHooks(k, words[1], kwg.FrontHooks)))
+	assert.Equal(t, "E", visible(kwg.FindHooks(k, words[0], kwg.FrontHooks)))
+	assert.True(t, kwg.FindInnerHook(k, words[4], kwg.FrontInnerHook))
+}
@@ -0,0 +1,56 @@
+package harness
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/word_db_server/config"
+	"github.com/domino14/word_db_server/internal/anagramserver"
+	"github.com/domino14/word_db_server/internal/compression"
+	"github.com/domino14/word_db_server/internal/searchserver"
+	"github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// A Server serves the services that suites call, on a local port, the way
+// the searchserver does.
+type Server struct {
+	*httptest.Server
+	dbs *searchserver.DBCache
+}
+
+// StartServer starts a server of the lexica in dataPath, with the
+// searchserver's default configuration otherwise.
+func StartServer(dataPath string) (*Server, error) {
+	cfg := &config.Config{}
+	if err := cfg.Load(nil); err != nil {
+		return nil, err
+	}
+	cfg.DataPath = dataPath
+
+	dbs := searchserver.NewDBCache(cfg)
+	expandCache := searchserver.NewExpandCache(cfg)
+	searchServer := &searchserver.Server{Config: cfg, DBs: dbs, ExpandCache: expandCache}
+	anagramServer := &anagramserver.Server{
+		Config: map[string]any{"data-path": cfg.DataPath,
+			"no-definition-lexica": cfg.NoDefinitionLexica},
+	}
+	searchHandler := wordsearcher.NewQuestionSearcherServer(searchServer,
+		twirp.WithServerInterceptors(searchserver.PreencodeInterceptor(expandCache)))
+	anagramHandler := wordsearcher.NewAnagrammerServer(anagramServer)
+
+	mux := http.NewServeMux()
+	mux.Handle(searchHandler.PathPrefix(), searchserver.ProtobufResponses(searchHandler))
+	mux.Handle(anagramHandler.PathPrefix(), anagramHandler)
+	return &Server{
+		Server: httptest.NewServer(compression.Middleware(cfg.CompressionMinSize, mux)),
+		dbs:    dbs,
+	}, nil
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.Server.Close()
+	s.dbs.Close()
+}
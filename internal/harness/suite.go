@@ -0,0 +1,185 @@
+// Package harness runs a scripted suite of RPCs against a server, over the
+// wire, and checks their responses against golden files. A suite brings
+// its own fixture lexica, so its golden outputs don't depend on the real
+// lexica, and a refactoring that changes what the server returns for them
+// shows up as a failed call.
+package harness
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// A Suite is a list of calls to make, and the fixture lexica they're made
+// against.
+type Suite struct {
+	Lexica []Lexicon `json:"lexica"`
+	Calls  []Call    `json:"calls"`
+}
+
+// A Call is an RPC of the suite. Its response is checked against the
+// golden file <name>.json, unless it's expected to fail.
+type Call struct {
+	Name string `json:"name"`
+	// Method is one of search, expand, anagram and judge.
+	Method string `json:"method"`
+	// Request is the request message, in protobuf's JSON form.
+	Request json.RawMessage `json:"request"`
+	// Error, if set, is the Twirp error code the call must fail with,
+	// such as invalid_argument.
+	Error string `json:"error,omitempty"`
+}
+
+// LoadSuite reads a suite from a JSON file.
+func LoadSuite(path string) (*Suite, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s := &Suite{}
+	if err := json.Unmarshal(contents, s); err != nil {
+		return nil, fmt.Errorf("%v: %w", path, err)
+	}
+	seen := map[string]bool{}
+	for _, c := range s.Calls {
+		if _, ok := methods[c.Method]; !ok {
+			return nil, fmt.Errorf("call %v: unknown method %q", c.Name, c.Method)
+		}
+		if c.Name == "" || seen[c.Name] {
+			return nil, fmt.Errorf("calls need unique names; got %q", c.Name)
+		}
+		seen[c.Name] = true
+	}
+	return s, nil
+}
+
+// Clients are the services the suite calls.
+type Clients struct {
+	Searcher   pb.QuestionSearcher
+	Anagrammer pb.Anagrammer
+}
+
+// NewClients returns Twirp clients of the server at url, which use JSON
+// rather than protobuf if useJSON is set.
+func NewClients(url string, useJSON bool) Clients {
+	if useJSON {
+		return Clients{
+			Searcher:   pb.NewQuestionSearcherJSONClient(url, http.DefaultClient),
+			Anagrammer: pb.NewAnagrammerJSONClient(url, http.DefaultClient),
+		}
+	}
+	return Clients{
+		Searcher:   pb.NewQuestionSearcherProtobufClient(url, http.DefaultClient),
+		Anagrammer: pb.NewAnagrammerProtobufClient(url, http.DefaultClient),
+	}
+}
+
+type method struct {
+	request  func() proto.Message
+	response func() proto.Message
+	call     func(ctx context.Context, c Clients, req proto.Message) (proto.Message, error)
+}
+
+var methods = map[string]method{
+	"search": {
+		request:  func() proto.Message { return &pb.SearchRequest{} },
+		response: func() proto.Message { return &pb.SearchResponse{} },
+		call: func(ctx context.Context, c Clients, req proto.Message) (proto.Message, error) {
+			return c.Searcher.Search(ctx, req.(*pb.SearchRequest))
+		},
+	},
+	"expand": {
+		request:  func() proto.Message { return &pb.SearchResponse{} },
+		response: func() proto.Message { return &pb.SearchResponse{} },
+		call: func(ctx context.Context, c Clients, req proto.Message) (proto.Message, error) {
+			return c.Searcher.Expand(ctx, req.(*pb.SearchResponse))
+		},
+	},
+	"anagram": {
+		request:  func() proto.Message { return &pb.AnagramRequest{} },
+		response: func() proto.Message { return &pb.AnagramResponse{} },
+		call: func(ctx context.Context, c Clients, req proto.Message) (proto.Message, error) {
+			return c.Anagrammer.Anagram(ctx, req.(*pb.AnagramRequest))
+		},
+	},
+	"judge": {
+		request:  func() proto.Message { return &pb.WordJudgeRequest{} },
+		response: func() proto.Message { return &pb.WordJudgeResponse{} },
+		call: func(ctx context.Context, c Clients, req proto.Message) (proto.Message, error) {
+			return c.Anagrammer.Judge(ctx, req.(*pb.WordJudgeRequest))
+		},
+	},
+}
+
+// A Result is the outcome of a call. Err is nil if the call passed.
+type Result struct {
+	Call string
+	Err  error
+}
+
+var goldenFormat = protojson.MarshalOptions{Multiline: true, Indent: "  "}
+
+// Run makes the suite's calls in order, checking each against its golden
+// file in goldenDir. With update, the golden files are written from the
+// responses instead.
+func (s *Suite) Run(ctx context.Context, c Clients, goldenDir string, update bool) []Result {
+	results := make([]Result, 0, len(s.Calls))
+	for _, call := range s.Calls {
+		results = append(results, Result{Call: call.Name, Err: call.run(ctx, c, goldenDir, update)})
+	}
+	return results
+}
+
+func (c Call) run(ctx context.Context, clients Clients, goldenDir string, update bool) error {
+	m := methods[c.Method]
+	req := m.request()
+	if err := protojson.Unmarshal(c.Request, req); err != nil {
+		return fmt.Errorf("bad request: %w", err)
+	}
+	resp, err := m.call(ctx, clients, req)
+	if c.Error != "" {
+		var twerr twirp.Error
+		if !errors.As(err, &twerr) {
+			return fmt.Errorf("expected a %v error, got %v", c.Error, err)
+		}
+		if string(twerr.Code()) != c.Error {
+			return fmt.Errorf("expected a %v error, got %v: %v", c.Error, twerr.Code(), twerr.Msg())
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	golden := filepath.Join(goldenDir, c.Name+".json")
+	got, err := goldenFormat.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	if update {
+		return os.WriteFile(golden, append(got, '\n'), 0644)
+	}
+	contents, err := os.ReadFile(golden)
+	if err != nil {
+		return err
+	}
+	want := m.response()
+	if err := protojson.Unmarshal(contents, want); err != nil {
+		return fmt.Errorf("%v: %w", golden, err)
+	}
+	// Compared as messages, since protojson's output isn't stable.
+	if !proto.Equal(want, resp) {
+		return fmt.Errorf("response differs from %v; got:\n%s", golden, got)
+	}
+	return nil
+}
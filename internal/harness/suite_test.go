@@ -0,0 +1,20 @@
+package harness
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadSuite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "suite.json")
+	assert.Nil(t, os.WriteFile(path, []byte(`{"calls": [{"name": "a", "method": "define"}]}`), 0644))
+	_, err := LoadSuite(path)
+	assert.NotNil(t, err)
+	assert.Nil(t, os.WriteFile(path, []byte(`{"calls": [{"name": "a", "method": "judge"},
+		{"name": "a", "method": "search"}]}`), 0644))
+	_, err = LoadSuite(path)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,108 @@
+// Package localize translates the human-readable text that the API
+// returns, like the labels and descriptions of lexicon symbols, so that
+// front-ends in other languages don't have to give the raw symbols their
+// own meanings. The translations come from a JSON file keyed by locale:
+//
+//	{
+//	  "es": {
+//	    "symbols": {"#": {"label": "solo CSW", "description": "..."}},
+//	    "conditions": {"PROBABILITY_RANGE": "..."}
+//	  }
+//	}
+//
+// Anything that isn't translated is left in English.
+package localize
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Symbol is the translation of a lexicon symbol's label and description.
+// Either may be left empty.
+type Symbol struct {
+	Label       string `json:"label"`
+	Description string `json:"description"`
+}
+
+// Table is the translations for one locale.
+type Table struct {
+	// Symbols are keyed by the symbol, like #.
+	Symbols map[string]Symbol `json:"symbols"`
+	// Conditions are the conditions' descriptions, keyed by the name of
+	// the condition in SearchRequest.Condition.
+	Conditions map[string]string `json:"conditions"`
+}
+
+// Translations are the tables of all the locales, keyed by locale.
+type Translations map[string]*Table
+
+// Load reads the translations from a JSON file.
+func Load(path string) (Translations, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var ts Translations
+	if err := json.NewDecoder(f).Decode(&ts); err != nil {
+		return nil, fmt.Errorf("reading translations from %v: %w", path, err)
+	}
+	// Locales are looked up without regard to case.
+	lower := Translations{}
+	for locale, t := range ts {
+		if t != nil {
+			lower[normalize(locale)] = t
+		}
+	}
+	return lower, nil
+}
+
+func normalize(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
+
+// For returns the table for the locale, or else for its language, so that
+// pt-BR falls back to pt. It returns nil if there's neither; a nil table
+// translates nothing.
+func (ts Translations) For(locale string) *Table {
+	locale = normalize(locale)
+	if locale == "" {
+		return nil
+	}
+	if t, ok := ts[locale]; ok {
+		return t
+	}
+	lang, _, _ := strings.Cut(locale, "-")
+	return ts[lang]
+}
+
+// Symbol returns the symbol's label and description, translated where
+// there's a translation.
+func (t *Table) Symbol(symbol, label, description string) (string, string) {
+	if t == nil {
+		return label, description
+	}
+	tr := t.Symbols[symbol]
+	if tr.Label != "" {
+		label = tr.Label
+	}
+	if tr.Description != "" {
+		description = tr.Description
+	}
+	return label, description
+}
+
+// Condition returns the condition's description, translated if there's a
+// translation.
+func (t *Table) Condition(name, description string) string {
+	if t == nil {
+		return description
+	}
+	if tr := t.Conditions[name]; tr != "" {
+		return tr
+	}
+	return description
+}
@@ -0,0 +1,49 @@
+package localize
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTranslations(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "translations.json")
+	assert.Nil(t, os.WriteFile(path, []byte(`{
+		"es": {
+			"symbols": {"#": {"label": "solo CSW", "description": "no está en el léxico norteamericano"},
+				"+": {"label": "nueva"}},
+			"conditions": {"LENGTH": "Longitud"}
+		},
+		"PT-br": {"symbols": {"#": {"label": "só CSW"}}}
+	}`), 0644))
+	ts, err := Load(path)
+	assert.Nil(t, err)
+
+	es := ts.For("es-MX")
+	assert.Equal(t, es, ts.For("ES"))
+	label, desc := es.Symbol("#", "CSW-only", "not in the latest North American lexicon")
+	assert.Equal(t, "solo CSW", label)
+	assert.Equal(t, "no está en el léxico norteamericano", desc)
+	// What isn't translated stays in English.
+	label, desc = es.Symbol("+", "new", "new in this version of the lexicon")
+	assert.Equal(t, "nueva", label)
+	assert.Equal(t, "new in this version of the lexicon", desc)
+	assert.Equal(t, "Longitud", es.Condition("LENGTH", "Word length"))
+	assert.Equal(t, "Probability", es.Condition("PROBABILITY_RANGE", "Probability"))
+
+	label, _ = ts.For("pt_BR").Symbol("#", "CSW-only", "")
+	assert.Equal(t, "só CSW", label)
+	assert.Nil(t, ts.For("pt"))
+	assert.Nil(t, ts.For(""))
+	label, desc = ts.For("fr").Symbol("#", "CSW-only", "not in NWL")
+	assert.Equal(t, "CSW-only", label)
+	assert.Equal(t, "not in NWL", desc)
+	var none Translations
+	assert.Equal(t, "Word length", none.For("es").Condition("LENGTH", "Word length"))
+
+	assert.Nil(t, os.WriteFile(path, []byte(`{"es": []}`), 0644))
+	_, err = Load(path)
+	assert.NotNil(t, err)
+}
@@ -1,6 +1,7 @@
 package querygen
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -25,9 +26,12 @@ func whereClauseRender(table string, column string, condition string) string {
 
 // WhereBetweenClause is a "between" clause in SQL.
 type WhereBetweenClause struct {
-	conditionParams *wordsearcher.SearchRequest_MinMax
-	table           string
-	column          string
+	// min and max are an int32 or int64, as in the search request.
+	min, max any
+	// width is how many values are between them.
+	width  float64
+	table  string
+	column string
 }
 
 // NewWhereBetweenClause creates a WhereBetweenClause with a given table,
@@ -36,9 +40,23 @@ type WhereBetweenClause struct {
 func NewWhereBetweenClause(table string, column string,
 	smm *wordsearcher.SearchRequest_MinMax) *WhereBetweenClause {
 	return &WhereBetweenClause{
-		conditionParams: smm,
-		table:           table,
-		column:          column,
+		min:    smm.GetMin(),
+		max:    smm.GetMax(),
+		width:  float64(smm.GetMax()) - float64(smm.GetMin()) + 1,
+		table:  table,
+		column: column,
+	}
+}
+
+// NewWhereBetween64Clause is NewWhereBetweenClause for a MinMax64.
+func NewWhereBetween64Clause(table string, column string,
+	smm *wordsearcher.SearchRequest_MinMax64) *WhereBetweenClause {
+	return &WhereBetweenClause{
+		min:    smm.GetMin(),
+		max:    smm.GetMax(),
+		width:  float64(smm.GetMax()) - float64(smm.GetMin()) + 1,
+		table:  table,
+		column: column,
 	}
 }
 
@@ -47,8 +65,8 @@ func NewWhereBetweenClause(table string, column string,
 func (w *WhereBetweenClause) Render() (string, []interface{}, error) {
 	var conditionTemplate string
 	bindParams := make([]interface{}, 0)
-	min := w.conditionParams.GetMin()
-	max := w.conditionParams.GetMax()
+	min := w.min
+	max := w.max
 
 	if min == max {
 		conditionTemplate = `= ?`
@@ -127,6 +145,8 @@ func NewWhereInClause(table string, column string,
 		numItems = len(sr.GetNumberarray().GetValues())
 	case *wordsearcher.SearchRequest_SearchParam_Stringarray:
 		numItems = len(sr.GetStringarray().GetValues())
+	case *wordsearcher.SearchRequest_SearchParam_Lengthprobabilities:
+		numItems = len(sr.GetLengthprobabilities().GetValues())
 	}
 
 	return &WhereInClause{
@@ -160,6 +180,18 @@ func (w *WhereInClause) Render() (string, []interface{}, error) {
 			bindParams[i] = v
 		}
 
+	case *wordsearcher.SearchRequest_SearchParam_Lengthprobabilities:
+		// The column is a row of columns here, and each item a row of
+		// values. SQLite only takes rows of values from a subquery.
+		vals := w.conditionParams.GetLengthprobabilities().GetValues()
+		bindParams = make([]interface{}, 0, 2*w.numItems)
+		for _, v := range vals {
+			bindParams = append(bindParams, v.GetLength(), v.GetProbability())
+		}
+		markers := strings.Repeat("(?, ?),", w.numItems)
+		return fmt.Sprintf("(%[1]s.length, %[1]s.probability) IN (VALUES %s)",
+			w.table, markers[:len(markers)-1]), bindParams, nil
+
 	default:
 		return "", nil, fmt.Errorf("error rendering, %t is not a supported type for WhereInClause",
 			t)
@@ -194,7 +226,7 @@ func (w *WhereInClause) conditionSubRange(min int, max int) *wordsearcher.Search
 		}
 		return &wordsearcher.SearchRequest_SearchParam{
 			Conditionparam: &wordsearcher.SearchRequest_SearchParam_Numberarray{
-				&wordsearcher.SearchRequest_NumberArray{
+				Numberarray: &wordsearcher.SearchRequest_NumberArray{
 					Values: vals[min:max]}}}
 
 	case *wordsearcher.SearchRequest_SearchParam_Stringarray:
@@ -208,13 +240,363 @@ func (w *WhereInClause) conditionSubRange(min int, max int) *wordsearcher.Search
 		}
 		return &wordsearcher.SearchRequest_SearchParam{
 			Conditionparam: &wordsearcher.SearchRequest_SearchParam_Stringarray{
-				&wordsearcher.SearchRequest_StringArray{
+				Stringarray: &wordsearcher.SearchRequest_StringArray{
+					Values: vals[min:max]}}}
+
+	case *wordsearcher.SearchRequest_SearchParam_Lengthprobabilities:
+		vals := w.conditionParams.GetLengthprobabilities().GetValues()
+		if max >= len(vals) {
+			max = len(vals)
+		}
+		if min >= len(vals) {
+			min = len(vals)
+		}
+		return &wordsearcher.SearchRequest_SearchParam{
+			Conditionparam: &wordsearcher.SearchRequest_SearchParam_Lengthprobabilities{
+				Lengthprobabilities: &wordsearcher.SearchRequest_LengthProbabilityList{
 					Values: vals[min:max]}}}
 
 	}
 	return nil
 }
 
+// WhereLengthBetweenClause is like a WhereBetweenClause, but it compares
+// the length of the column's value instead of the value itself. This is
+// used for hooks, which are stored as a string of letters. The length is
+// in characters, so it's only the number of hooks when every tile is a
+// single character.
+type WhereLengthBetweenClause struct {
+	conditionParams *wordsearcher.SearchRequest_MinMax
+	table           string
+	column          string
+}
+
+func NewWhereLengthBetweenClause(table string, column string,
+	smm *wordsearcher.SearchRequest_MinMax) *WhereLengthBetweenClause {
+	return &WhereLengthBetweenClause{
+		conditionParams: smm,
+		table:           table,
+		column:          column,
+	}
+}
+
+func (w *WhereLengthBetweenClause) Render() (string, []interface{}, error) {
+	min, max := w.conditionParams.GetMin(), w.conditionParams.GetMax()
+	column := fmt.Sprintf("length(%s.%s)", w.table, w.column)
+	if min == max {
+		return column + " = ?", []interface{}{min}, nil
+	}
+	return column + " BETWEEN ? and ?", []interface{}{min, max}, nil
+}
+
+// WhereRatioBetweenClause matches rows where one column, as a percentage of
+// another, is between the min and max. It compares in integers, without
+// dividing, so that it means the same in every SQL dialect.
+type WhereRatioBetweenClause struct {
+	conditionParams *wordsearcher.SearchRequest_MinMax
+	table           string
+	column          string
+	ofColumn        string
+}
+
+func NewWhereRatioBetweenClause(table, column, ofColumn string,
+	smm *wordsearcher.SearchRequest_MinMax) *WhereRatioBetweenClause {
+	return &WhereRatioBetweenClause{
+		conditionParams: smm,
+		table:           table,
+		column:          column,
+		ofColumn:        ofColumn,
+	}
+}
+
+func (w *WhereRatioBetweenClause) Render() (string, []interface{}, error) {
+	lo, hi := w.conditionParams.GetMin(), w.conditionParams.GetMax()
+	if lo < 0 || hi > 100 || lo > hi {
+		return "", nil, fmt.Errorf("bad percentage range %v-%v", lo, hi)
+	}
+	of := w.table + "." + w.ofColumn
+	return fmt.Sprintf("%s.%s * 100 BETWEEN ? * %s AND ? * %s", w.table, w.column, of, of),
+		[]interface{}{lo, hi}, nil
+}
+
+// WhereContainsLettersClause matches rows whose column contains every one
+// of the given letters, in any order. It looks for each letter as a
+// substring, so the letters must all be single characters; a C would
+// otherwise be found in a CH.
+type WhereContainsLettersClause struct {
+	letters []string
+	table   string
+	column  string
+}
+
+func NewWhereContainsLettersClause(table string, column string,
+	letters []string) *WhereContainsLettersClause {
+	return &WhereContainsLettersClause{
+		letters: letters,
+		table:   table,
+		column:  column,
+	}
+}
+
+func (w *WhereContainsLettersClause) Render() (string, []interface{}, error) {
+	if len(w.letters) == 0 {
+		return "", nil, errors.New("no letters provided")
+	}
+	rendered := []string{}
+	bindParams := []interface{}{}
+	for _, l := range w.letters {
+		rendered = append(rendered, whereClauseRender(w.table, w.column, `LIKE ?`))
+		bindParams = append(bindParams, "%"+l+"%")
+	}
+	return "(" + strings.Join(rendered, " AND ") + ")", bindParams, nil
+}
+
+// WhereAlphagramLettersClause matches alphagrams by their tiles, using the
+// display form of the alphagram, where multi-character tiles are bracketed
+// (see common.DisplayForm). Multi-character tiles are removed before
+// looking for single-character ones, so that an R isn't found in [RR].
+// Both the alphagram and the tiles are in alphagram order, so a tile that's
+// repeated must be repeated in the alphagram for it to contain the tiles.
+type WhereAlphagramLettersClause struct {
+	table  string
+	column string
+	// tiles are in display form and alphagram order.
+	tiles []string
+	// multiTiles are all of the distribution's multi-character tiles, in
+	// display form.
+	multiTiles []string
+	exclude    bool
+}
+
+// NewWhereAlphagramLettersClause matches alphagrams that have all of the
+// tiles, or, if exclude is set, none of them.
+func NewWhereAlphagramLettersClause(table string, column string, tiles []string,
+	multiTiles []string, exclude bool) *WhereAlphagramLettersClause {
+	return &WhereAlphagramLettersClause{
+		table:      table,
+		column:     column,
+		tiles:      tiles,
+		multiTiles: multiTiles,
+		exclude:    exclude,
+	}
+}
+
+func (w *WhereAlphagramLettersClause) Render() (string, []interface{}, error) {
+	if len(w.tiles) == 0 {
+		return "", nil, errors.New("no letters provided")
+	}
+	column := w.table + "." + w.column
+	stripped := column
+	var strippedParams []interface{}
+	for _, t := range w.multiTiles {
+		stripped = "REPLACE(" + stripped + ", ?, '')"
+		strippedParams = append(strippedParams, t)
+	}
+	var single, multi []string
+	for _, t := range w.tiles {
+		if strings.HasPrefix(t, "[") {
+			multi = append(multi, t)
+		} else {
+			single = append(single, t)
+		}
+	}
+	rendered := []string{}
+	bindParams := []interface{}{}
+	if w.exclude {
+		for _, t := range single {
+			rendered = append(rendered, stripped+" NOT LIKE ?")
+			bindParams = append(bindParams, strippedParams...)
+			bindParams = append(bindParams, "%"+t+"%")
+		}
+		for _, t := range multi {
+			rendered = append(rendered, column+" NOT LIKE ?")
+			bindParams = append(bindParams, "%"+t+"%")
+		}
+	} else {
+		if len(single) > 0 {
+			rendered = append(rendered, stripped+" LIKE ?")
+			bindParams = append(bindParams, strippedParams...)
+			bindParams = append(bindParams, "%"+strings.Join(single, "%")+"%")
+		}
+		if len(multi) > 0 {
+			rendered = append(rendered, column+" LIKE ?")
+			bindParams = append(bindParams, "%"+strings.Join(multi, "%")+"%")
+		}
+	}
+	return "(" + strings.Join(rendered, " AND ") + ")", bindParams, nil
+}
+
+// WhereHasFlagClause matches rows whose column, a comma-separated list of
+// flags, has the given flag.
+type WhereHasFlagClause struct {
+	flag   string
+	table  string
+	column string
+}
+
+func NewWhereHasFlagClause(table string, column string, flag string) *WhereHasFlagClause {
+	return &WhereHasFlagClause{flag: flag, table: table, column: column}
+}
+
+func (w *WhereHasFlagClause) Render() (string, []interface{}, error) {
+	if w.flag == "" || strings.Contains(w.flag, ",") {
+		return "", nil, fmt.Errorf("bad flag %q", w.flag)
+	}
+	return fmt.Sprintf("(',' || %s.%s || ',') LIKE ?", w.table, w.column),
+		[]interface{}{"%," + w.flag + ",%"}, nil
+}
+
+// WhereHasTagClause matches rows whose column, a word, has the given tag in
+// the word_tags table.
+type WhereHasTagClause struct {
+	tag    string
+	table  string
+	column string
+}
+
+func NewWhereHasTagClause(table string, column string, tag string) *WhereHasTagClause {
+	return &WhereHasTagClause{tag: tag, table: table, column: column}
+}
+
+func (w *WhereHasTagClause) Render() (string, []interface{}, error) {
+	clause := whereClauseRender(w.table, w.column,
+		"IN (SELECT word_tags.word FROM word_tags WHERE word_tags.tag = ?)")
+	return clause, []interface{}{w.tag}, nil
+}
+
+// FullTextMatchClause matches rows whose column is among the rows of an
+// FTS5 table matching the given terms. Each term is quoted, so FTS query
+// syntax in user input is treated literally; a row must match all terms.
+type FullTextMatchClause struct {
+	table    string
+	column   string
+	ftsTable string
+	terms    []string
+}
+
+func NewFullTextMatchClause(table, column, ftsTable string, terms []string) *FullTextMatchClause {
+	return &FullTextMatchClause{table: table, column: column, ftsTable: ftsTable, terms: terms}
+}
+
+func (f *FullTextMatchClause) Render() (string, []interface{}, error) {
+	if len(f.terms) == 0 {
+		return "", nil, errors.New("no terms to match")
+	}
+	quoted := make([]string, len(f.terms))
+	for i, t := range f.terms {
+		quoted[i] = `"` + strings.ReplaceAll(t, `"`, `""`) + `"`
+	}
+	return whereClauseRender(f.table, f.column, fmt.Sprintf(
+			"IN (SELECT %s FROM %s WHERE %s MATCH ?)", f.column, f.ftsTable, f.ftsTable)),
+		[]interface{}{strings.Join(quoted, " ")}, nil
+}
+
+// WordSubqueryClause matches alphagrams that have at least one word
+// matching the inner clause. The inner clause must apply to the words table.
+type WordSubqueryClause struct {
+	inner Clause
+}
+
+func NewWordSubqueryClause(inner Clause) *WordSubqueryClause {
+	return &WordSubqueryClause{inner: inner}
+}
+
+func (w *WordSubqueryClause) Render() (string, []interface{}, error) {
+	r, bindParams, err := w.inner.Render()
+	if err != nil {
+		return "", nil, err
+	}
+	return whereClauseRender("alphagrams", "alphagram",
+		"IN (SELECT words.alphagram FROM words WHERE "+r+")"), bindParams, nil
+}
+
+// AddedInLastUpdatesClause matches words whose valid_since is one of the
+// last n versions in the lexicon_history table, other than the oldest, as
+// a word that is valid since it may be older still.
+type AddedInLastUpdatesClause struct {
+	n int
+}
+
+func NewAddedInLastUpdatesClause(n int) *AddedInLastUpdatesClause {
+	return &AddedInLastUpdatesClause{n: n}
+}
+
+func (a *AddedInLastUpdatesClause) Render() (string, []interface{}, error) {
+	return whereClauseRender("words", "valid_since", `IN (SELECT lexicon FROM lexicon_history
+		WHERE updates_ago < ? AND updates_ago < (SELECT MAX(updates_ago) FROM lexicon_history))`),
+		[]interface{}{a.n}, nil
+}
+
+// CombinatorClause combines clauses with AND or OR, or negates one with
+// NOT.
+type CombinatorClause struct {
+	op      wordsearcher.SearchRequest_Combinator_Op
+	clauses []Clause
+}
+
+func NewCombinatorClause(op wordsearcher.SearchRequest_Combinator_Op, clauses []Clause) *CombinatorClause {
+	return &CombinatorClause{op: op, clauses: clauses}
+}
+
+func (c *CombinatorClause) Render() (string, []interface{}, error) {
+	if len(c.clauses) == 0 {
+		return "", nil, errors.New("nothing to combine")
+	}
+	if c.op == wordsearcher.SearchRequest_Combinator_NOT && len(c.clauses) != 1 {
+		return "", nil, errors.New("NOT takes exactly one condition")
+	}
+	rendered := make([]string, len(c.clauses))
+	bindParams := []interface{}{}
+	for i, cl := range c.clauses {
+		r, bp, err := cl.Render()
+		if err != nil {
+			return "", nil, err
+		}
+		rendered[i] = r
+		bindParams = append(bindParams, bp...)
+	}
+	switch c.op {
+	case wordsearcher.SearchRequest_Combinator_AND:
+		return "(" + strings.Join(rendered, " AND ") + ")", bindParams, nil
+	case wordsearcher.SearchRequest_Combinator_OR:
+		return "(" + strings.Join(rendered, " OR ") + ")", bindParams, nil
+	case wordsearcher.SearchRequest_Combinator_NOT:
+		return "NOT (" + rendered[0] + ")", bindParams, nil
+	}
+	return "", nil, fmt.Errorf("unhandled combinator op: %v", c.op)
+}
+
+// LexiconDiffClause compares the alphagrams' words with the words of
+// another lexicon database, which must be attached under the given schema
+// name. Only alphagrams of the main lexicon can match, so WORDS_DIFFER is
+// one-sided: an alphagram whose words are all only in the other lexicon is
+// left out, and is found by searching the other lexicon instead.
+type LexiconDiffClause struct {
+	schema string
+	mode   wordsearcher.SearchRequest_LexiconDiff_Mode
+}
+
+func NewLexiconDiffClause(schema string, mode wordsearcher.SearchRequest_LexiconDiff_Mode) *LexiconDiffClause {
+	return &LexiconDiffClause{schema: schema, mode: mode}
+}
+
+func (l *LexiconDiffClause) Render() (string, []interface{}, error) {
+	notInOther := fmt.Sprintf(
+		"IN (SELECT words.alphagram FROM main.words WHERE words.word NOT IN (SELECT word FROM %s.words))",
+		l.schema)
+	switch l.mode {
+	case wordsearcher.SearchRequest_LexiconDiff_NOT_IN_OTHER:
+		return whereClauseRender("alphagrams", "alphagram", notInOther), nil, nil
+	case wordsearcher.SearchRequest_LexiconDiff_WORDS_DIFFER:
+		onlyInOther := fmt.Sprintf(
+			"IN (SELECT o.alphagram FROM %s.words o WHERE o.word NOT IN (SELECT word FROM main.words))",
+			l.schema)
+		return "(" + whereClauseRender("alphagrams", "alphagram", notInOther) + " OR " +
+			whereClauseRender("alphagrams", "alphagram", onlyInOther) + ")", nil, nil
+	}
+	return "", nil, fmt.Errorf("unhandled lexicon diff mode: %v", l.mode)
+}
+
 // LimitOffsetClause represents a limit/offset SQL statement.
 type LimitOffsetClause struct {
 	conditionParams *wordsearcher.SearchRequest_MinMax
@@ -88,3 +88,98 @@ func TestLimitOffsetClause(t *testing.T) {
 	assert.Equal(t, "LIMIT ? OFFSET ?", res)
 	assert.Equal(t, []interface{}{int32(100), int32(200)}, params)
 }
+
+func TestWhereLengthBetweenClause(t *testing.T) {
+	c := NewWhereLengthBetweenClause("words", "front_hooks",
+		&wordsearcher.SearchRequest_MinMax{
+			Min: 0,
+			Max: 0,
+		})
+	res, params, _ := c.Render()
+	assert.Equal(t, "length(words.front_hooks) = ?", res)
+	assert.Equal(t, []interface{}{int32(0)}, params)
+
+	c = NewWhereLengthBetweenClause("words", "back_hooks",
+		&wordsearcher.SearchRequest_MinMax{
+			Min: 2,
+			Max: 5,
+		})
+	res, params, _ = c.Render()
+	assert.Equal(t, "length(words.back_hooks) BETWEEN ? and ?", res)
+	assert.Equal(t, []interface{}{int32(2), int32(5)}, params)
+}
+
+func TestWhereContainsLettersClause(t *testing.T) {
+	c := NewWhereContainsLettersClause("words", "back_hooks", []string{"S", "D"})
+	res, params, _ := c.Render()
+	assert.Equal(t, "(words.back_hooks LIKE ? AND words.back_hooks LIKE ?)", res)
+	assert.Equal(t, []interface{}{"%S%", "%D%"}, params)
+}
+
+func TestWhereAlphagramLettersClause(t *testing.T) {
+	c := NewWhereAlphagramLettersClause("alphagrams", "display_alphagram",
+		[]string{"A", "[CH]", "R", "R"}, []string{"[CH]", "[RR]"}, false)
+	res, params, err := c.Render()
+	assert.Nil(t, err)
+	assert.Equal(t, "(REPLACE(REPLACE(alphagrams.display_alphagram, ?, ''), ?, '') LIKE ? AND "+
+		"alphagrams.display_alphagram LIKE ?)", res)
+	assert.Equal(t, []interface{}{"[CH]", "[RR]", "%A%R%R%", "%[CH]%"}, params)
+
+	c = NewWhereAlphagramLettersClause("alphagrams", "display_alphagram",
+		[]string{"A", "E"}, nil, true)
+	res, params, err = c.Render()
+	assert.Nil(t, err)
+	assert.Equal(t, "(alphagrams.display_alphagram NOT LIKE ? AND "+
+		"alphagrams.display_alphagram NOT LIKE ?)", res)
+	assert.Equal(t, []interface{}{"%A%", "%E%"}, params)
+}
+
+func TestWordSubqueryClause(t *testing.T) {
+	c := NewWordSubqueryClause(NewWhereLengthBetweenClause("words", "back_hooks",
+		&wordsearcher.SearchRequest_MinMax{
+			Min: 3,
+			Max: 26,
+		}))
+	res, params, _ := c.Render()
+	assert.Equal(t, "alphagrams.alphagram IN (SELECT words.alphagram FROM words "+
+		"WHERE length(words.back_hooks) BETWEEN ? and ?)", res)
+	assert.Equal(t, []interface{}{int32(3), int32(26)}, params)
+}
+
+func TestFullTextMatchClause(t *testing.T) {
+	c := NewFullTextMatchClause("words", "word", "definitions_fts",
+		[]string{"bird", `say "hi"`})
+	res, params, err := c.Render()
+	assert.Nil(t, err)
+	assert.Equal(t, "words.word IN (SELECT word FROM definitions_fts "+
+		"WHERE definitions_fts MATCH ?)", res)
+	assert.Equal(t, []interface{}{`"bird" "say ""hi"""`}, params)
+
+	_, _, err = NewFullTextMatchClause("words", "word", "definitions_fts", nil).Render()
+	assert.NotNil(t, err)
+}
+
+func TestWhereHasFlagClause(t *testing.T) {
+	res, params, err := NewWhereHasFlagClause("words", "sources", "NZ").Render()
+	assert.Nil(t, err)
+	assert.Equal(t, "(',' || words.sources || ',') LIKE ?", res)
+	assert.Equal(t, []interface{}{"%,NZ,%"}, params)
+
+	_, _, err = NewWhereHasFlagClause("words", "sources", "NZ,AU").Render()
+	assert.NotNil(t, err)
+}
+
+func TestLexiconDiffClause(t *testing.T) {
+	res, params, err := NewLexiconDiffClause("other",
+		wordsearcher.SearchRequest_LexiconDiff_NOT_IN_OTHER).Render()
+	assert.Nil(t, err)
+	assert.Equal(t, "alphagrams.alphagram IN (SELECT words.alphagram FROM main.words "+
+		"WHERE words.word NOT IN (SELECT word FROM other.words))", res)
+	assert.Nil(t, params)
+
+	res, _, err = NewLexiconDiffClause("other",
+		wordsearcher.SearchRequest_LexiconDiff_WORDS_DIFFER).Render()
+	assert.Nil(t, err)
+	assert.Contains(t, res, " OR alphagrams.alphagram IN (SELECT o.alphagram FROM other.words o "+
+		"WHERE o.word NOT IN (SELECT word FROM main.words)))")
+}
@@ -0,0 +1,156 @@
+package querygen
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// ConditionInfo describes a search condition that the query generator
+// handles: the parameter it takes and the rules for using it. Clients can
+// build their condition pickers from these; see Conditions.
+type ConditionInfo struct {
+	Condition   wordsearcher.SearchRequest_Condition
+	Description string
+	// Param is the SearchParam field that the condition's parameter goes
+	// in, such as "minmax", or "" if it takes none.
+	Param string
+	// Enum, if set, is the enum that a numbervalue parameter is one of.
+	Enum protoreflect.EnumDescriptor
+	// Capability is the optional lexicon data that the condition needs,
+	// as named in the capabilities table made by dbmaker.
+	Capability string
+	// Combinable is whether the condition can be in a combinator.
+	Combinable bool
+	// Last is whether the condition must be the last filter. Only one
+	// such condition can be in a search; see isMutexCondition.
+	Last bool
+}
+
+// conditions are the conditions that Search handles, in the order of the
+// enum. The others are only used by the front end, or internally.
+var conditions = []*ConditionInfo{
+	{Condition: wordsearcher.SearchRequest_LEXICON, Param: "stringvalue",
+		Description: "The lexicon to search. It must be the first condition."},
+	{Condition: wordsearcher.SearchRequest_LENGTH, Param: "minmax", Combinable: true,
+		Description: "Alphagrams with between min and max letters."},
+	{Condition: wordsearcher.SearchRequest_PROBABILITY_RANGE, Param: "minmax", Combinable: true,
+		Description: "Alphagrams with a probability order between min and max, within their length."},
+	{Condition: wordsearcher.SearchRequest_PROBABILITY_LIST, Param: "numberarray",
+		Combinable: true, Last: true,
+		Description: "Alphagrams with one of the given probability orders."},
+	{Condition: wordsearcher.SearchRequest_PROBABILITY_LIMIT, Param: "minmax", Last: true,
+		Description: "The min-th to max-th alphagrams matching the other conditions, in the sort order."},
+	{Condition: wordsearcher.SearchRequest_NUMBER_OF_ANAGRAMS, Param: "minmax", Combinable: true,
+		Description: "Alphagrams with between min and max words."},
+	{Condition: wordsearcher.SearchRequest_NUMBER_OF_VOWELS, Param: "minmax", Combinable: true,
+		Description: "Alphagrams with between min and max vowels."},
+	{Condition: wordsearcher.SearchRequest_POINT_VALUE, Param: "minmax", Combinable: true,
+		Description: "Alphagrams whose tiles are worth between min and max points."},
+	{Condition: wordsearcher.SearchRequest_MATCHING_ANAGRAM, Param: "stringvalue",
+		Combinable: true, Last: true,
+		Description: "Alphagrams of the words that anagram the given rack, which may have blanks (?) and ranges ([AEI])."},
+	{Condition: wordsearcher.SearchRequest_ALPHAGRAM_LIST, Param: "stringarray",
+		Combinable: true, Last: true,
+		Description: "The given alphagrams."},
+	{Condition: wordsearcher.SearchRequest_NOT_IN_LEXICON, Param: "numbervalue",
+		Enum:       wordsearcher.SearchRequest_OTHER_ENGLISH.Descriptor(),
+		Combinable: true,
+		Description: "Alphagrams with a word that isn't in the other English lexicon, or that is new " +
+			"since the previous version of this one."},
+	{Condition: wordsearcher.SearchRequest_DIFFICULTY_RANGE, Param: "minmax", Capability: "difficulty",
+		Combinable:  true,
+		Description: "Alphagrams with a difficulty between min and max, from 1 to 100."},
+	{Condition: wordsearcher.SearchRequest_PLAYABILITY_RANGE, Param: "minmax",
+		Capability: "playability", Combinable: true,
+		Description: "Alphagrams with a playability order between min and max."},
+	{Condition: wordsearcher.SearchRequest_DELETED_WORD,
+		Description: "Words that have been deleted from the lexicon, instead of alphagrams. Only a " +
+			"length condition can go with it."},
+	{Condition: wordsearcher.SearchRequest_NUMBER_OF_FRONT_HOOKS, Param: "minmax", Combinable: true,
+		Description: "Alphagrams with a word that has between min and max front hooks."},
+	{Condition: wordsearcher.SearchRequest_NUMBER_OF_BACK_HOOKS, Param: "minmax", Combinable: true,
+		Description: "Alphagrams with a word that has between min and max back hooks."},
+	{Condition: wordsearcher.SearchRequest_FRONT_HOOKS_INCLUDE, Param: "stringvalue",
+		Combinable:  true,
+		Description: "Alphagrams with a word that takes all of the given letters as front hooks."},
+	{Condition: wordsearcher.SearchRequest_BACK_HOOKS_INCLUDE, Param: "stringvalue",
+		Combinable:  true,
+		Description: "Alphagrams with a word that takes all of the given letters as back hooks."},
+	{Condition: wordsearcher.SearchRequest_DEFINITION_CONTAINS, Param: "stringvalue",
+		Capability: "definitions", Combinable: true,
+		Description: "Alphagrams with a word whose definition contains all of the given terms."},
+	{Condition: wordsearcher.SearchRequest_VOWEL_PROBABILITY_RANGE, Param: "minmax",
+		Combinable:  true,
+		Description: "Alphagrams with a probability order between min and max, within their length and number of vowels."},
+	{Condition: wordsearcher.SearchRequest_RANDOM_SAMPLE, Param: "randomsample",
+		Description: "Count alphagrams picked at random from the ones matching the other conditions. " +
+			"The same seed picks the same ones."},
+	{Condition: wordsearcher.SearchRequest_LEXICON_DIFF, Param: "lexicondiff",
+		Description: "Alphagrams whose words differ in the other lexicon."},
+	{Condition: wordsearcher.SearchRequest_WORD_SOURCE, Param: "stringvalue", Capability: "sources",
+		Combinable:  true,
+		Description: "Alphagrams with a word that has the given source flag."},
+	{Condition: wordsearcher.SearchRequest_COMBINATOR, Param: "combinator", Combinable: true,
+		Description: "Combines conditions with AND, OR or NOT."},
+	{Condition: wordsearcher.SearchRequest_CONTAINS_LETTERS, Param: "stringvalue", Combinable: true,
+		Description: "Alphagrams with all of the given letters, counting repeats."},
+	{Condition: wordsearcher.SearchRequest_EXCLUDES_LETTERS, Param: "stringvalue", Combinable: true,
+		Description: "Alphagrams with none of the given letters."},
+	{Condition: wordsearcher.SearchRequest_VOWEL_RATIO, Param: "minmax", Combinable: true,
+		Description: "Alphagrams whose tiles are between min and max percent vowels."},
+	{Condition: wordsearcher.SearchRequest_NUMBER_OF_BLANK_ANAGRAMS, Param: "minmax",
+		Capability: "blank_anagrams", Combinable: true,
+		Description: "Alphagrams that make between min and max words one tile longer with a blank."},
+	{Condition: wordsearcher.SearchRequest_COMBINATIONS_RANGE, Param: "minmax64", Combinable: true,
+		Description: "Alphagrams with between min and max combinations, the number of ways to draw their tiles."},
+	{Condition: wordsearcher.SearchRequest_HAS_TAG, Param: "stringvalue", Capability: "tags",
+		Combinable:  true,
+		Description: "Alphagrams with a word that has the given tag, such as a frequency tier."},
+	{Condition: wordsearcher.SearchRequest_ORDERED_ALPHAGRAM_LIST, Param: "stringarray", Last: true,
+		Description: "The given alphagrams, or the alphagrams of the given words, in the order given. " +
+			"It can't go with other filters."},
+	{Condition: wordsearcher.SearchRequest_ORDERED_PROBABILITY_LIST, Param: "lengthprobabilities",
+		Last: true,
+		Description: "The alphagrams with the given lengths and probability orders, in the order given. " +
+			"It can't go with other filters."},
+	{Condition: wordsearcher.SearchRequest_BUILD, Param: "build", Combinable: true, Last: true,
+		Description: "Alphagrams of the words that can be built from some of the given letters, " +
+			"which may have blanks (?) and ranges ([AEI]), with between min and max letters."},
+	{Condition: wordsearcher.SearchRequest_HAS_INNER_HOOKS, Combinable: true,
+		Description: "Alphagrams with a word that takes a letter just inside its first or last letter."},
+	{Condition: wordsearcher.SearchRequest_NO_INNER_HOOKS, Combinable: true,
+		Description: "Alphagrams with a word that takes no inner hooks."},
+	{Condition: wordsearcher.SearchRequest_ADDED_IN_LAST_UPDATES, Param: "numbervalue",
+		Capability: "history", Combinable: true,
+		Description: "Alphagrams with a word that was added in one of the lexicon's last n updates."},
+	{Condition: wordsearcher.SearchRequest_STEM_PLUS_ONE, Param: "stringvalue", Combinable: true,
+		Last:        true,
+		Description: "Alphagrams of the given stem plus one more tile, such as the bingos of SATINE + ?."},
+	{Condition: wordsearcher.SearchRequest_PROBABILITY_LIMIT_PER_LENGTH, Param: "minmax", Last: true,
+		Description: "The min-th to max-th alphagrams of each length matching the other conditions, in the sort order."},
+	{Condition: wordsearcher.SearchRequest_MOST_PROBABLE, Param: "minmax", Last: true,
+		Description: "The min-th to max-th most probable alphagrams matching the other conditions, of any length."},
+	{Condition: wordsearcher.SearchRequest_LEAST_PROBABLE, Param: "minmax", Last: true,
+		Description: "The min-th to max-th least probable alphagrams matching the other conditions, of any length."},
+}
+
+var conditionsByEnum = func() map[wordsearcher.SearchRequest_Condition]*ConditionInfo {
+	m := map[wordsearcher.SearchRequest_Condition]*ConditionInfo{}
+	for _, c := range conditions {
+		m[c.Condition] = c
+	}
+	return m
+}()
+
+// Conditions returns the conditions that Search handles. They must not be
+// modified.
+func Conditions() []*ConditionInfo {
+	return conditions
+}
+
+// Condition returns the description of a condition, or nil if Search
+// doesn't handle it.
+func Condition(c wordsearcher.SearchRequest_Condition) *ConditionInfo {
+	return conditionsByEnum[c]
+}
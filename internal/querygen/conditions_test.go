@@ -0,0 +1,28 @@
+package querygen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestConditions(t *testing.T) {
+	oneof := (&wordsearcher.SearchRequest_SearchParam{}).ProtoReflect().Descriptor().
+		Oneofs().ByName("conditionparam")
+	for i, c := range Conditions() {
+		if i > 0 {
+			assert.Less(t, Conditions()[i-1].Condition, c.Condition)
+		}
+		assert.NotEmpty(t, c.Description, c.Condition)
+		if c.Param != "" {
+			assert.NotNil(t, oneof.Fields().ByName(protoreflect.Name(c.Param)), c.Condition)
+		}
+		assert.Equal(t, c, Condition(c.Condition))
+	}
+	assert.Nil(t, Condition(wordsearcher.SearchRequest_HAS_TAGS))
+	assert.True(t, isMutexCondition(wordsearcher.SearchRequest_PROBABILITY_LIMIT))
+	assert.False(t, isMutexCondition(wordsearcher.SearchRequest_LENGTH))
+}
@@ -3,7 +3,10 @@ package querygen
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"sort"
 	"strings"
+	"unicode"
 
 	"github.com/rs/zerolog/log"
 
@@ -13,38 +16,49 @@ import (
 	"github.com/domino14/word_db_server/config"
 	anagrammer "github.com/domino14/word_db_server/internal/anagramserver/legacyanagrammer"
 	"github.com/domino14/word_db_server/internal/common"
+	"github.com/domino14/word_db_server/internal/wordstore"
 	"github.com/domino14/word_db_server/rpc/wordsearcher"
 )
 
 // UnexpandedQuery just selects word and alphagram. We save bandwidth and
-// speed by just selecting what we need.
+// speed by just selecting what we need. The length and probability are
+// what's needed to page through the results (see PageKey).
+// The arguments are the where clause, the limit/offset clause, the order
+// by columns, any more columns the subquery needs for Postgres to sort
+// by (see Render), and the word columns asked for with SetWordFields.
 const UnexpandedQuery = `
-SELECT word, alphagram FROM (
-	SELECT alphagrams.alphagram
+SELECT word, alphagram, length, probability%[5]s FROM (
+	SELECT alphagrams.alphagram, alphagrams.length, alphagrams.probability%[4]s
 	FROM alphagrams
-	WHERE %s
-	ORDER BY alphagrams.probability
-	%s) q
+	WHERE %[1]s
+	ORDER BY %[3]s
+	%[2]s) q
 INNER JOIN words w using (alphagram)
 `
 
-// FullQuery selects all the words and alphagram details
+// FullQuery selects all the words and alphagram details. It takes the
+// same arguments as UnexpandedQuery, less the last two.
 const FullQuery = `
 SELECT word, alphagram, lexicon_symbols, definition, front_hooks, back_hooks,
 inner_front_hook, inner_back_hook, probability,
-combinations, difficulty FROM (
+combinations, difficulty, display_alphagram, playability, length,
+vowel_probability, sources FROM (
 	SELECT alphagrams.probability, alphagrams.combinations,
-		alphagrams.alphagram, alphagrams.difficulty
+		alphagrams.alphagram, alphagrams.difficulty,
+		alphagrams.display_alphagram, alphagrams.playability,
+		alphagrams.length, alphagrams.vowel_probability
 	FROM alphagrams
-	WHERE %s
-	ORDER BY alphagrams.probability
-	%s) q
+	WHERE %[1]s
+	ORDER BY %[3]s
+	%[2]s) q
 INNER JOIN words w using (alphagram)
 `
 
 // AlphagramOnlyQuery is used to select only alphagrams with their info
 const AlphagramOnlyQuery = `
-SELECT alphagram, probability, combinations, difficulty FROM alphagrams
+SELECT alphagram, probability, combinations, difficulty, display_alphagram,
+	playability, length, vowel_probability
+FROM alphagrams
 WHERE %s
 %s
 `
@@ -52,14 +66,14 @@ WHERE %s
 // WordInfoQuery is used to select words with their info
 const WordInfoQuery = `
 SELECT word, alphagram, lexicon_symbols, definition, front_hooks,
-	back_hooks, inner_front_hook, inner_back_hook
+	back_hooks, inner_front_hook, inner_back_hook, sources
 FROM words WHERE %s
 %s
 ORDER BY word
 `
 
 const DeletedWordQuery = `
-SELECT word
+SELECT word, length, definition
 FROM deletedwords WHERE %s
 %s
 ORDER BY word
@@ -75,6 +89,21 @@ const (
 	DeletedWords
 )
 
+const (
+	// OrderByProbability is the default ordering of alphagrams.
+	OrderByProbability = "alphagrams.probability"
+	// OrderByVowelProbability orders by probability within (length, number
+	// of vowels) buckets.
+	OrderByVowelProbability = "alphagrams.vowel_probability, alphagrams.probability"
+	// OrderByMostProbable ranks alphagrams of all lengths by their number
+	// of combinations, for MOST_PROBABLE.
+	OrderByMostProbable = "alphagrams.combinations DESC, alphagrams.length, " +
+		"alphagrams.probability, alphagrams.alphagram"
+	// OrderByLeastProbable is the reverse of OrderByMostProbable.
+	OrderByLeastProbable = "alphagrams.combinations, alphagrams.length DESC, " +
+		"alphagrams.probability DESC, alphagrams.alphagram DESC"
+)
+
 // Query is a struct that encapsulates a set of bind parameters and a template.
 type Query struct {
 	bindParams   []interface{}
@@ -82,6 +111,17 @@ type Query struct {
 	rendered     string
 	expandedForm bool
 	qtype        QueryType
+	orderBy      string
+	dialect      wordstore.Dialect
+	// postFilter, if set, picks the alphagrams to keep from the query's
+	// results. See QueryGen.plan.
+	postFilter alphagramFilter
+	// wordColumns are the words table's columns an unexpanded query
+	// selects besides the word.
+	wordColumns []string
+	// reorder is whether the order has to be given again after the join
+	// with the words, as it always has for Postgres.
+	reorder bool
 }
 
 func (q *Query) String() string {
@@ -113,6 +153,7 @@ func NewQuery(bp []interface{}, qt QueryType) *Query {
 		template:     template,
 		expandedForm: expandedForm,
 		qtype:        qt,
+		orderBy:      OrderByProbability,
 	}
 }
 
@@ -146,6 +187,111 @@ func alphasFromWordList(words []string, dist *tilemapping.LetterDistribution) []
 	return vals
 }
 
+// splitLetters splits a user-entered string into its individual tiles,
+// so that multi-character tiles (such as Spanish digraphs) stay intact.
+func splitLetters(letters string, dist *tilemapping.LetterDistribution) ([]string, error) {
+	mls, err := tilemapping.ToMachineLetters(letters, dist.TileMapping())
+	if err != nil {
+		return nil, err
+	}
+	tiles := make([]string, len(mls))
+	for i, ml := range mls {
+		tiles[i] = ml.UserVisible(dist.TileMapping(), false)
+	}
+	return tiles, nil
+}
+
+// checkHookTiles returns an error if the lexicon has multi-character
+// tiles. Hooks are stored as the letters of the hook tiles run together,
+// so with tiles like CH they can't be counted or told apart: C and H
+// hooks look the same as a CH hook.
+func (qg *QueryGen) checkHookTiles() error {
+	dist, err := common.LetterDistribution(qg.config, qg.lexiconName)
+	if err != nil {
+		return err
+	}
+	for letter := range dist.TileMapping().Vals() {
+		if len([]rune(letter)) > 1 {
+			return fmt.Errorf("hook searches aren't supported in %v, which has multi-character tiles",
+				qg.lexiconName)
+		}
+	}
+	return nil
+}
+
+// alphagramTiles splits user-entered letters into tiles, in alphagram order
+// and in display form (see common.DisplayForm). Letters are split into the
+// longest tiles they can be, so letters that also make up a longer tile
+// can be given separately with spaces or brackets: "R R" or "[R][R]" is two
+// Rs, while "RR" is the Spanish RR. It also returns all of the
+// distribution's multi-character tiles in display form.
+func alphagramTiles(letters string, dist *tilemapping.LetterDistribution) ([]string, []string, error) {
+	mls, err := parseTiles(letters, dist)
+	if err != nil {
+		return nil, nil, err
+	}
+	tiles := make([]string, len(mls))
+	for i, ml := range mls {
+		tiles[i] = common.DisplayMachineWord(tilemapping.MachineWord{ml}, dist)
+	}
+	multiTiles := []string{}
+	for letter := range dist.TileMapping().Vals() {
+		if len([]rune(letter)) > 1 {
+			multiTiles = append(multiTiles, "["+letter+"]")
+		}
+	}
+	// Longer tiles first, in case one contains another.
+	sort.Slice(multiTiles, func(i, j int) bool {
+		if len(multiTiles[i]) != len(multiTiles[j]) {
+			return len(multiTiles[i]) > len(multiTiles[j])
+		}
+		return multiTiles[i] < multiTiles[j]
+	})
+	return tiles, multiTiles, nil
+}
+
+// parseTiles splits user-entered letters into tiles, in alphagram order, as
+// alphagramTiles does.
+func parseTiles(letters string, dist *tilemapping.LetterDistribution) (tilemapping.MachineWord, error) {
+	mls := tilemapping.MachineWord{}
+	for _, field := range strings.FieldsFunc(strings.ToUpper(letters), func(r rune) bool {
+		return unicode.IsSpace(r) || r == '[' || r == ']'
+	}) {
+		fieldMLs, err := tilemapping.ToMachineLetters(field, dist.TileMapping())
+		if err != nil {
+			return nil, err
+		}
+		mls = append(mls, fieldMLs...)
+	}
+	for _, ml := range mls {
+		if ml == 0 {
+			return nil, errors.New("blanks can't be searched for")
+		}
+	}
+	slices.Sort(mls)
+	return mls, nil
+}
+
+// stemPlusOneAlphagrams returns the alphagrams of the stem plus each of the
+// distribution's tiles, as the alphagrams table has them.
+func stemPlusOneAlphagrams(stem string, dist *tilemapping.LetterDistribution) ([]string, error) {
+	mls, err := parseTiles(stem, dist)
+	if err != nil {
+		return nil, err
+	}
+	if len(mls) == 0 {
+		return nil, errors.New("no stem given")
+	}
+	tm := dist.TileMapping()
+	alphas := []string{}
+	for ml := tilemapping.MachineLetter(1); ml < tilemapping.MachineLetter(tm.NumLetters()); ml++ {
+		alpha := append(slices.Clone(mls), ml)
+		slices.Sort(alpha)
+		alphas = append(alphas, alpha.UserVisible(tm))
+	}
+	return alphas, nil
+}
+
 // Render renders a list of whereClauses and a limitOffsetClause into the
 // query template.
 func (q *Query) Render(whereClauses []string, limitOffsetClause string) {
@@ -154,7 +300,33 @@ func (q *Query) Render(whereClauses []string, limitOffsetClause string) {
 		// This should only happen for deleted words.
 		where = "1=1"
 	}
-	q.rendered = fmt.Sprintf(q.template, where, limitOffsetClause)
+	switch q.template {
+	case FullQuery, UnexpandedQuery:
+		if q.template == FullQuery {
+			q.rendered = fmt.Sprintf(q.template, where, limitOffsetClause, q.orderBy)
+		} else {
+			extra := ""
+			if q.dialect == wordstore.Postgres || q.reorder {
+				// For the order to be given again below.
+				extra = ", alphagrams.vowel_probability, alphagrams.combinations"
+			}
+			wordColumns := ""
+			for _, c := range q.wordColumns {
+				wordColumns += ", w." + c
+			}
+			q.rendered = fmt.Sprintf(q.template, where, limitOffsetClause, q.orderBy, extra,
+				wordColumns)
+		}
+		if q.dialect == wordstore.Postgres || q.reorder {
+			// Postgres doesn't keep the order of the subquery through the
+			// join, so it has to be given again. Nor is SQLite sure to for
+			// an order its indexes don't have.
+			q.rendered += "ORDER BY " + strings.ReplaceAll(q.orderBy, "alphagrams.", "q.") +
+				", w.word\n"
+		}
+	default:
+		q.rendered = fmt.Sprintf(q.template, where, limitOffsetClause)
+	}
 }
 
 // QueryGen is a query generator.
@@ -164,6 +336,12 @@ type QueryGen struct {
 	searchParams []*wordsearcher.SearchRequest_SearchParam
 	maxChunkSize int
 	config       map[string]any
+	orderBy      string
+	stats        *TableStats
+	page         *Page
+	dialect      wordstore.Dialect
+	ordered      *OrderedList
+	wordColumns  []string
 }
 
 // NewQueryGen generates a new query generator with the given parameters.
@@ -174,7 +352,52 @@ func NewQueryGen(lexiconName string, queryType QueryType,
 	qgenConfig := map[string]any{
 		"data-path": cfg.DataPath}
 
-	return &QueryGen{lexiconName, queryType, searchParams, maxChunkSize, qgenConfig}
+	dialect := wordstore.SQLite
+	if cfg.WordDBDSN != "" {
+		dialect = wordstore.Postgres
+	}
+	return &QueryGen{lexiconName, queryType, searchParams, maxChunkSize,
+		qgenConfig, OrderByProbability, nil, nil, dialect, nil, nil}
+}
+
+// SetSortOrder sets the order of the returned alphagrams. This also
+// determines which alphagrams a probability limit picks.
+func (qg *QueryGen) SetSortOrder(order wordsearcher.SearchRequest_SortOrder) {
+	switch order {
+	case wordsearcher.SearchRequest_SORT_VOWEL_PROBABILITY:
+		qg.orderBy = OrderByVowelProbability
+	default:
+		qg.orderBy = OrderByProbability
+	}
+}
+
+// wordFieldColumns are the words table's columns for each word field.
+var wordFieldColumns = map[wordsearcher.SearchRequest_WordField][]string{
+	wordsearcher.SearchRequest_DEFINITION:      {"definition"},
+	wordsearcher.SearchRequest_FRONT_HOOKS:     {"front_hooks"},
+	wordsearcher.SearchRequest_BACK_HOOKS:      {"back_hooks"},
+	wordsearcher.SearchRequest_LEXICON_SYMBOLS: {"lexicon_symbols"},
+	wordsearcher.SearchRequest_INNER_HOOKS:     {"inner_front_hook", "inner_back_hook"},
+	wordsearcher.SearchRequest_SOURCES:         {"sources"},
+}
+
+// SetWordFields sets the word fields that an AlphagramsAndWords query
+// selects besides the word, for a partial expansion. Other query types
+// ignore them.
+func (qg *QueryGen) SetWordFields(fields []wordsearcher.SearchRequest_WordField) error {
+	seen := map[wordsearcher.SearchRequest_WordField]bool{}
+	qg.wordColumns = nil
+	for _, f := range fields {
+		cols, ok := wordFieldColumns[f]
+		if !ok {
+			return fmt.Errorf("unknown word field %v", f)
+		}
+		if !seen[f] {
+			seen[f] = true
+			qg.wordColumns = append(qg.wordColumns, cols...)
+		}
+	}
+	return nil
 }
 
 func (qg *QueryGen) generateWhereClause(sp *wordsearcher.SearchRequest_SearchParam) (Clause, error) {
@@ -211,6 +434,20 @@ func (qg *QueryGen) generateWhereClause(sp *wordsearcher.SearchRequest_SearchPar
 		}
 		return NewWhereBetweenClause("alphagrams", "difficulty", minmax), nil
 
+	case wordsearcher.SearchRequest_PLAYABILITY_RANGE:
+		minmax := sp.GetMinmax()
+		if minmax == nil {
+			return nil, errors.New("minmax not provided for playability range request")
+		}
+		return NewWhereBetweenClause("alphagrams", "playability", minmax), nil
+
+	case wordsearcher.SearchRequest_VOWEL_PROBABILITY_RANGE:
+		minmax := sp.GetMinmax()
+		if minmax == nil {
+			return nil, errors.New("minmax not provided for vowel probability range request")
+		}
+		return NewWhereBetweenClause("alphagrams", "vowel_probability", minmax), nil
+
 	case wordsearcher.SearchRequest_NUMBER_OF_VOWELS:
 		minmax := sp.GetMinmax()
 		if minmax == nil {
@@ -225,6 +462,27 @@ func (qg *QueryGen) generateWhereClause(sp *wordsearcher.SearchRequest_SearchPar
 		}
 		return NewWhereBetweenClause("alphagrams", "point_value", minmax), nil
 
+	case wordsearcher.SearchRequest_NUMBER_OF_BLANK_ANAGRAMS:
+		minmax := sp.GetMinmax()
+		if minmax == nil {
+			return nil, errors.New("minmax not provided for num blank anagrams request")
+		}
+		return NewWhereBetweenClause("alphagrams", "num_blank_anagrams", minmax), nil
+
+	case wordsearcher.SearchRequest_COMBINATIONS_RANGE:
+		minmax := sp.GetMinmax64()
+		if minmax == nil {
+			return nil, errors.New("minmax64 not provided for combinations range request")
+		}
+		return NewWhereBetween64Clause("alphagrams", "combinations", minmax), nil
+
+	case wordsearcher.SearchRequest_VOWEL_RATIO:
+		minmax := sp.GetMinmax()
+		if minmax == nil {
+			return nil, errors.New("minmax not provided for vowel ratio request")
+		}
+		return NewWhereRatioBetweenClause("alphagrams", "num_vowels", "length", minmax), nil
+
 	case wordsearcher.SearchRequest_NOT_IN_LEXICON:
 		desc := sp.GetNumbervalue()
 		var column string
@@ -249,7 +507,7 @@ func (qg *QueryGen) generateWhereClause(sp *wordsearcher.SearchRequest_SearchPar
 		if err != nil {
 			return nil, err
 		}
-		dist, err := tilemapping.ProbableLetterDistribution(qg.config, qg.lexiconName)
+		dist, err := common.LetterDistribution(qg.config, qg.lexiconName)
 		if err != nil {
 			return nil, err
 		}
@@ -282,13 +540,43 @@ func (qg *QueryGen) generateWhereClause(sp *wordsearcher.SearchRequest_SearchPar
 
 		return NewWhereInClause("alphagrams", "alphagram", newSp), nil
 
+	case wordsearcher.SearchRequest_BUILD:
+		return qg.buildClause(sp.GetBuild())
+
+	case wordsearcher.SearchRequest_STEM_PLUS_ONE:
+		desc := sp.GetStringvalue()
+		if desc == nil {
+			return nil, errors.New("stringvalue not provided for stem plus one request")
+		}
+		dist, err := common.LetterDistribution(qg.config, qg.lexiconName)
+		if err != nil {
+			return nil, err
+		}
+		alphas, err := stemPlusOneAlphagrams(desc.GetValue(), dist)
+		if err != nil {
+			return nil, err
+		}
+		// One query looks them all up; most aren't in the lexicon.
+		return NewWhereInClause("alphagrams", "alphagram", &wordsearcher.SearchRequest_SearchParam{
+			Conditionparam: &wordsearcher.SearchRequest_SearchParam_Stringarray{
+				Stringarray: &wordsearcher.SearchRequest_StringArray{Values: alphas}}}), nil
+
 	case wordsearcher.SearchRequest_PROBABILITY_LIST:
 		return NewWhereInClause("alphagrams", "probability", sp), nil
 
 	case wordsearcher.SearchRequest_ALPHAGRAM_LIST:
 		return NewWhereInClause("alphagrams", "alphagram", sp), nil
 
-	case wordsearcher.SearchRequest_PROBABILITY_LIMIT:
+	case wordsearcher.SearchRequest_ORDERED_ALPHAGRAM_LIST:
+		return qg.orderedAlphagramClause(sp)
+
+	case wordsearcher.SearchRequest_ORDERED_PROBABILITY_LIST:
+		return qg.orderedProbabilityClause(sp)
+
+	case wordsearcher.SearchRequest_PROBABILITY_LIMIT,
+		wordsearcher.SearchRequest_PROBABILITY_LIMIT_PER_LENGTH,
+		wordsearcher.SearchRequest_MOST_PROBABLE,
+		wordsearcher.SearchRequest_LEAST_PROBABLE:
 		// This is handled by a limit offset clause, which is handled specially.
 		// Don't do anything here.
 		return nil, nil
@@ -304,28 +592,249 @@ func (qg *QueryGen) generateWhereClause(sp *wordsearcher.SearchRequest_SearchPar
 		// handled elsewhere
 		return nil, nil
 
+	case wordsearcher.SearchRequest_LEXICON_DIFF:
+		desc := sp.GetLexicondiff()
+		if desc == nil || desc.GetOtherLexicon() == "" {
+			return nil, errors.New("other lexicon not provided for lexicon diff request")
+		}
+		return NewLexiconDiffClause(OtherLexiconSchema, desc.GetMode()), nil
+
+	case wordsearcher.SearchRequest_RANDOM_SAMPLE:
+		// The sample is taken by the caller, from the results of the
+		// other conditions.
+		return nil, nil
+
+	case wordsearcher.SearchRequest_NUMBER_OF_FRONT_HOOKS,
+		wordsearcher.SearchRequest_NUMBER_OF_BACK_HOOKS:
+		minmax := sp.GetMinmax()
+		if minmax == nil {
+			return nil, errors.New("minmax not provided for number of hooks request")
+		}
+		if err := qg.checkHookTiles(); err != nil {
+			return nil, err
+		}
+		column := "front_hooks"
+		if condition == wordsearcher.SearchRequest_NUMBER_OF_BACK_HOOKS {
+			column = "back_hooks"
+		}
+		return NewWordSubqueryClause(
+			NewWhereLengthBetweenClause("words", column, minmax)), nil
+
+	case wordsearcher.SearchRequest_HAS_INNER_HOOKS:
+		return NewWordSubqueryClause(NewCombinatorClause(wordsearcher.SearchRequest_Combinator_OR,
+			[]Clause{
+				NewWhereEqualsNumberClause("words", "inner_front_hook", 1),
+				NewWhereEqualsNumberClause("words", "inner_back_hook", 1),
+			})), nil
+
+	case wordsearcher.SearchRequest_NO_INNER_HOOKS:
+		return NewWordSubqueryClause(NewCombinatorClause(wordsearcher.SearchRequest_Combinator_AND,
+			[]Clause{
+				NewWhereEqualsNumberClause("words", "inner_front_hook", 0),
+				NewWhereEqualsNumberClause("words", "inner_back_hook", 0),
+			})), nil
+
+	case wordsearcher.SearchRequest_ADDED_IN_LAST_UPDATES:
+		desc := sp.GetNumbervalue()
+		if desc == nil || desc.GetValue() < 1 {
+			return nil, errors.New("a positive numbervalue must be provided for added in last updates request")
+		}
+		return NewWordSubqueryClause(NewAddedInLastUpdatesClause(int(desc.GetValue()))), nil
+
+	case wordsearcher.SearchRequest_FRONT_HOOKS_INCLUDE,
+		wordsearcher.SearchRequest_BACK_HOOKS_INCLUDE:
+		desc := sp.GetStringvalue()
+		if desc == nil || desc.GetValue() == "" {
+			return nil, errors.New("stringvalue not provided for hooks include request")
+		}
+		if err := qg.checkHookTiles(); err != nil {
+			return nil, err
+		}
+		dist, err := common.LetterDistribution(qg.config, qg.lexiconName)
+		if err != nil {
+			return nil, err
+		}
+		letters, err := splitLetters(strings.ToUpper(desc.GetValue()), dist)
+		if err != nil {
+			return nil, err
+		}
+		column := "front_hooks"
+		if condition == wordsearcher.SearchRequest_BACK_HOOKS_INCLUDE {
+			column = "back_hooks"
+		}
+		return NewWordSubqueryClause(
+			NewWhereContainsLettersClause("words", column, letters)), nil
+
+	case wordsearcher.SearchRequest_DEFINITION_CONTAINS:
+		desc := sp.GetStringvalue()
+		if desc == nil || strings.TrimSpace(desc.GetValue()) == "" {
+			return nil, errors.New("stringvalue not provided for definition contains request")
+		}
+		return NewWordSubqueryClause(NewFullTextMatchClause("words", "word",
+			"definitions_fts", strings.Fields(desc.GetValue()))), nil
+
+	case wordsearcher.SearchRequest_WORD_SOURCE:
+		desc := sp.GetStringvalue()
+		if desc == nil || strings.TrimSpace(desc.GetValue()) == "" {
+			return nil, errors.New("stringvalue not provided for word source request")
+		}
+		return NewWordSubqueryClause(NewWhereHasFlagClause("words", "sources",
+			strings.ToUpper(strings.TrimSpace(desc.GetValue())))), nil
+
+	case wordsearcher.SearchRequest_HAS_TAG:
+		desc := sp.GetStringvalue()
+		if desc == nil || strings.TrimSpace(desc.GetValue()) == "" {
+			return nil, errors.New("stringvalue not provided for tag request")
+		}
+		return NewWordSubqueryClause(NewWhereHasTagClause("words", "word",
+			strings.ToLower(strings.TrimSpace(desc.GetValue())))), nil
+
+	case wordsearcher.SearchRequest_CONTAINS_LETTERS,
+		wordsearcher.SearchRequest_EXCLUDES_LETTERS:
+		desc := sp.GetStringvalue()
+		if desc == nil || strings.TrimSpace(desc.GetValue()) == "" {
+			return nil, errors.New("stringvalue not provided for letters request")
+		}
+		dist, err := common.LetterDistribution(qg.config, qg.lexiconName)
+		if err != nil {
+			return nil, err
+		}
+		tiles, multiTiles, err := alphagramTiles(desc.GetValue(), dist)
+		if err != nil {
+			return nil, err
+		}
+		return NewWhereAlphagramLettersClause("alphagrams", "display_alphagram", tiles,
+			multiTiles, condition == wordsearcher.SearchRequest_EXCLUDES_LETTERS), nil
+
+	case wordsearcher.SearchRequest_COMBINATOR:
+		return qg.generateCombinatorClause(sp.GetCombinator())
+
 	default:
 		return nil, fmt.Errorf("unhandled search request condition: %v", condition)
 
 	}
 }
 
+// generateCombinatorClause generates the clause for a combinator and,
+// recursively, the combinators in it.
+func (qg *QueryGen) generateCombinatorClause(comb *wordsearcher.SearchRequest_Combinator) (Clause, error) {
+	if comb == nil || len(comb.GetParams()) == 0 {
+		return nil, errors.New("combinator has no conditions")
+	}
+	if comb.GetOp() == wordsearcher.SearchRequest_Combinator_NOT && len(comb.GetParams()) != 1 {
+		return nil, errors.New("NOT takes exactly one condition")
+	}
+	clauses := make([]Clause, 0, len(comb.GetParams()))
+	for _, param := range comb.GetParams() {
+		if info := Condition(param.Condition); info == nil || !info.Combinable {
+			return nil, fmt.Errorf("%v can't be combined", param.Condition)
+		}
+		clause, err := qg.generateWhereClause(param)
+		if err != nil {
+			return nil, err
+		}
+		// A list in a combinator can't be split over several queries.
+		if lc, ok := clause.(*WhereInClause); ok && lc.numItems > qg.maxChunkSize {
+			return nil, fmt.Errorf("%v has too many items to combine (the most is %d)",
+				param.Condition, qg.maxChunkSize)
+		}
+		clauses = append(clauses, clause)
+	}
+	return NewCombinatorClause(comb.GetOp(), clauses), nil
+}
+
+// MaxBuildBlanks is the most blanks that a build search can have; with
+// more, almost every short word can be built.
+const MaxBuildBlanks = 3
+
+// buildClause finds the words that can be built from the letters by walking
+// the lexicon's DAWG, and makes a clause for their alphagrams.
+func (qg *QueryGen) buildClause(build *wordsearcher.SearchRequest_Build) (Clause, error) {
+	if build == nil {
+		return nil, errors.New("build not provided for build request")
+	}
+	minLen, maxLen := int(build.GetMinLength()), int(build.GetMaxLength())
+	if minLen < 0 || maxLen < 0 || (maxLen > 0 && minLen > maxLen) {
+		return nil, fmt.Errorf("invalid build lengths %d to %d", minLen, maxLen)
+	}
+	letters := strings.ToUpper(build.GetLetters())
+	if strings.Count(letters, "?") > MaxBuildBlanks {
+		return nil, fmt.Errorf("builds can have at most %d blanks", MaxBuildBlanks)
+	}
+	inRange := func(n int) bool {
+		return n >= minLen && (maxLen == 0 || n <= maxLen)
+	}
+
+	dawg, err := kwg.Get(qg.config, qg.lexiconName)
+	if err != nil {
+		return nil, err
+	}
+	dist, err := common.LetterDistribution(qg.config, qg.lexiconName)
+	if err != nil {
+		return nil, err
+	}
+	alph := dawg.GetAlphabet()
+
+	var words []string
+	if strings.Contains(letters, "[") {
+		// defer to the legacy anagrammer. This is a "range" query.
+		for _, word := range anagrammer.Anagram(letters, dawg, anagrammer.ModeBuild) {
+			mls, err := tilemapping.ToMachineLetters(word, alph)
+			if err == nil && inRange(len(mls)) {
+				words = append(words, word)
+			}
+		}
+	} else {
+		da := kwg.DaPool.Get().(*kwg.KWGAnagrammer)
+		defer kwg.DaPool.Put(da)
+		err = da.InitForString(dawg, letters)
+		if err != nil {
+			return nil, err
+		}
+		da.Subanagram(dawg, func(word tilemapping.MachineWord) error {
+			if inRange(len(word)) {
+				words = append(words, word.UserVisible(alph))
+			}
+			return nil
+		})
+	}
+	if len(words) == 0 {
+		return nil, errors.New("no words can be built from these letters")
+	}
+	alphas := alphasFromWordList(words, dist)
+	newSp := &wordsearcher.SearchRequest_SearchParam{
+		Conditionparam: &wordsearcher.SearchRequest_SearchParam_Stringarray{
+			Stringarray: &wordsearcher.SearchRequest_StringArray{
+				Values: alphas}}}
+	return NewWhereInClause("alphagrams", "alphagram", newSp), nil
+}
+
+// maxLimitLengths is the most lengths a PROBABILITY_LIMIT_PER_LENGTH can
+// make a query each for.
+const maxLimitLengths = 15
+
+// lengths returns the lengths of the search's LENGTH condition, in order.
+func (qg *QueryGen) lengths() []int {
+	var lengths []int
+	for _, param := range qg.searchParams {
+		if param.Condition == wordsearcher.SearchRequest_LENGTH {
+			mm := param.GetMinmax()
+			for l := mm.GetMin(); l <= mm.GetMax() && len(lengths) <= maxLimitLengths; l++ {
+				lengths = append(lengths, int(l))
+			}
+		}
+	}
+	return lengths
+}
+
 func isMutexCondition(condition wordsearcher.SearchRequest_Condition) bool {
 	// a "mutex condition" is a condition that requires the query generator
 	// to generate a "where ... in (?, .., ?)" query. We can't have more than
 	// one of these per query otherwise it gets really complicated.
 	// Note that probability_limit is not a mutex condition, but we return
 	// true anyway because we can't combine this condition with any mutex conditions.
-	switch condition {
-	case wordsearcher.SearchRequest_PROBABILITY_LIST,
-		wordsearcher.SearchRequest_ALPHAGRAM_LIST,
-		wordsearcher.SearchRequest_PROBABILITY_LIMIT,
-		wordsearcher.SearchRequest_MATCHING_ANAGRAM:
-
-		return true
-
-	}
-	return false
+	info := Condition(condition)
+	return info != nil && info.Last
 }
 
 // Validate returns an error if the query is invalid.
@@ -334,21 +843,63 @@ func (qg *QueryGen) Validate() error {
 	conditionOrderProblem := false
 	deletedWordCondition := false
 	lengthCondition := false
+	perLengthLimit := false
+	numRandomSamples := 0
+	numLexiconDiffs := 0
+	// A random sample isn't a filter, so it doesn't count towards the
+	// last condition.
+	lastIdx := len(qg.searchParams) - 1
+	for lastIdx >= 0 && qg.searchParams[lastIdx].Condition == wordsearcher.SearchRequest_RANDOM_SAMPLE {
+		lastIdx--
+	}
 	for idx, param := range qg.searchParams {
 		if isMutexCondition(param.Condition) {
-			if idx != len(qg.searchParams)-1 {
+			if idx != lastIdx {
 				conditionOrderProblem = true
 			}
 			numMutexDescriptions++
 		}
+		if param.Condition == wordsearcher.SearchRequest_LEXICON_DIFF {
+			numLexiconDiffs++
+		}
+		if param.Condition == wordsearcher.SearchRequest_RANDOM_SAMPLE {
+			if param.GetRandomsample().GetCount() < 1 {
+				return errors.New("random sample count must be positive")
+			}
+			numRandomSamples++
+		}
 		if param.Condition == wordsearcher.SearchRequest_DELETED_WORD {
 			deletedWordCondition = true
 		}
 		if param.Condition == wordsearcher.SearchRequest_LENGTH {
 			lengthCondition = true
 		}
+		if param.Condition == wordsearcher.SearchRequest_PROBABILITY_LIMIT_PER_LENGTH {
+			perLengthLimit = true
+		}
+		if isOrderedList(param.Condition) && len(qg.searchParams) > 1 {
+			return errors.New("an ordered list can't be combined with other conditions")
+		}
+	}
+	if perLengthLimit {
+		if !lengthCondition {
+			return errors.New("a probability limit per length needs a length condition")
+		}
+		if len(qg.lengths()) > maxLimitLengths {
+			return fmt.Errorf("a probability limit per length can cover at most %d lengths",
+				maxLimitLengths)
+		}
+	}
+	if numRandomSamples > 1 {
+		return errors.New("only one random sample is allowed")
+	}
+	if numLexiconDiffs > 1 {
+		return errors.New("only one lexicon diff is allowed")
 	}
 	if deletedWordCondition {
+		if numRandomSamples > 0 {
+			return errors.New("deleted word condition cannot be combined with a random sample")
+		}
 		// deleted_word, and at most one other condition, and it must be length
 		if len(qg.searchParams) > 2 {
 			return errors.New("deleted word condition cannot be combined with anything other than length")
@@ -359,6 +910,9 @@ func (qg *QueryGen) Validate() error {
 		}
 	}
 
+	if err := qg.validatePage(); err != nil {
+		return err
+	}
 	if numMutexDescriptions > 1 {
 		return errors.New("mutually exclusive search conditions not allowed")
 	}
@@ -393,8 +947,12 @@ func (qg *QueryGen) maybeChunk(clauses []Clause) (bool, []string,
 					return false, nil, nil, nil, err
 				}
 				newRenderedWhereClauses := append(renderedWhereClauses, r)
-				query := NewQuery(append(bindParams, bp...), qg.queryType)
-				query.Render(newRenderedWhereClauses, "")
+				limitClause, limitParams := qg.pageLimit()
+				query := NewQuery(append(append(bindParams, bp...), limitParams...), qg.queryType)
+				query.orderBy = qg.queryOrder()
+				query.dialect = qg.dialect
+				query.wordColumns = qg.wordColumns
+				query.Render(newRenderedWhereClauses, limitClause)
 				queries = append(queries, query)
 				multipleQueriesGenerated = true
 				idx += qg.maxChunkSize
@@ -419,8 +977,17 @@ func (qg *QueryGen) Generate() ([]*Query, error) {
 	clauses := []Clause{}
 
 	var loffClause Clause
+	// perLength are the lengths to make a query each for, with loffClause.
+	var perLength []int
+	// rankOrder, if set, is the order loffClause ranks the alphagrams in,
+	// in place of the search's.
+	var rankOrder string
 	for _, param := range qg.searchParams {
-		clause, err := qg.generateWhereClause(param)
+		clause := qg.rackCondition(param)
+		var err error
+		if clause == nil {
+			clause, err = qg.generateWhereClause(param)
+		}
 		log.Debug().Msgf("For param %v generated clause %v (err %v)", param, clause, err)
 		if err != nil {
 			return nil, err
@@ -432,6 +999,26 @@ func (qg *QueryGen) Generate() ([]*Query, error) {
 		if param.Condition == wordsearcher.SearchRequest_PROBABILITY_LIMIT {
 			loffClause = NewLimitOffsetClause(param.GetMinmax())
 		}
+		if param.Condition == wordsearcher.SearchRequest_PROBABILITY_LIMIT_PER_LENGTH {
+			loffClause = NewLimitOffsetClause(param.GetMinmax())
+			perLength = qg.lengths()
+		}
+		switch param.Condition {
+		case wordsearcher.SearchRequest_MOST_PROBABLE:
+			loffClause = NewLimitOffsetClause(param.GetMinmax())
+			rankOrder = OrderByMostProbable
+		case wordsearcher.SearchRequest_LEAST_PROBABLE:
+			loffClause = NewLimitOffsetClause(param.GetMinmax())
+			rankOrder = OrderByLeastProbable
+		}
+	}
+	clauses, postFilter, err := qg.plan(clauses, loffClause != nil || qg.page != nil)
+	if err != nil {
+		return nil, err
+	}
+	if qg.page != nil && qg.page.After != nil {
+		// Not at the end, where a list clause must stay.
+		clauses = append([]Clause{NewPageAfterClause(*qg.page.After)}, clauses...)
 	}
 	// Now render.
 	log.Debug().Msgf("where clauses: %v", clauses)
@@ -448,6 +1035,27 @@ func (qg *QueryGen) Generate() ([]*Query, error) {
 			return nil, errors.New("incompatible query arguments; please try " +
 				"a simpler query (remove probability limit)")
 		}
+	} else if perLength != nil {
+		renderedLOClause, bp, err := loffClause.Render()
+		if err != nil {
+			return nil, err
+		}
+		for _, length := range perLength {
+			r, lengthParams, err := NewWhereEqualsNumberClause("alphagrams", "length", length).Render()
+			if err != nil {
+				return nil, err
+			}
+			params := append(append(slices.Clone(bindParams), lengthParams...), bp...)
+			query := NewQuery(params, qg.queryType)
+			query.orderBy = qg.queryOrder()
+			query.dialect = qg.dialect
+			query.wordColumns = qg.wordColumns
+			query.Render(append(slices.Clone(rwc), r), renderedLOClause)
+			if postFilter != nil {
+				query.postFilter = postFilter
+			}
+			queries = append(queries, query)
+		}
 	} else {
 		var renderedLOClause string
 		var err error
@@ -459,12 +1067,23 @@ func (qg *QueryGen) Generate() ([]*Query, error) {
 			}
 			bindParams = append(bindParams, bp...)
 		} else {
-			renderedLOClause = ""
+			renderedLOClause, bp = qg.pageLimit()
+			bindParams = append(bindParams, bp...)
 		}
 		log.Debug().Interface("bindParams", bindParams).Interface("rwc", rwc).Interface("renderedLOClause", renderedLOClause).
 			Msg("bd")
 		query := NewQuery(bindParams, qg.queryType)
+		query.orderBy = qg.queryOrder()
+		if rankOrder != "" {
+			query.orderBy = rankOrder
+			query.reorder = true
+		}
+		query.dialect = qg.dialect
+		query.wordColumns = qg.wordColumns
 		query.Render(rwc, renderedLOClause)
+		if postFilter != nil {
+			query.postFilter = postFilter
+		}
 		queries = append(queries, query)
 
 	}
@@ -472,6 +1091,33 @@ func (qg *QueryGen) Generate() ([]*Query, error) {
 	return queries, nil
 }
 
+// OtherLexiconSchema is the name the other lexicon's database must be
+// attached as for a LEXICON_DIFF search.
+const OtherLexiconSchema = "other"
+
+// LexiconDiff returns the lexicon diff condition, or nil if there isn't
+// one. The caller must attach the other lexicon's database as
+// OtherLexiconSchema before running the queries.
+func (qg *QueryGen) LexiconDiff() *wordsearcher.SearchRequest_LexiconDiff {
+	for _, param := range qg.searchParams {
+		if param.Condition == wordsearcher.SearchRequest_LEXICON_DIFF {
+			return param.GetLexicondiff()
+		}
+	}
+	return nil
+}
+
+// RandomSample returns the random sample requested, or nil if there isn't
+// one.
+func (qg *QueryGen) RandomSample() *wordsearcher.SearchRequest_RandomSample {
+	for _, param := range qg.searchParams {
+		if param.Condition == wordsearcher.SearchRequest_RANDOM_SAMPLE {
+			return param.GetRandomsample()
+		}
+	}
+	return nil
+}
+
 func (qg *QueryGen) LexiconName() string {
 	return qg.lexiconName
 }
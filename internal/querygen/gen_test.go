@@ -0,0 +1,471 @@
+package querygen
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/domino14/word-golib/tilemapping"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/domino14/word_db_server/config"
+	"github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestVowelProbabilitySortOrder(t *testing.T) {
+	params := []*wordsearcher.SearchRequest_SearchParam{
+		{
+			Condition: wordsearcher.SearchRequest_LENGTH,
+			Conditionparam: &wordsearcher.SearchRequest_SearchParam_Minmax{
+				Minmax: &wordsearcher.SearchRequest_MinMax{Min: 7, Max: 7}},
+		},
+		{
+			Condition: wordsearcher.SearchRequest_VOWEL_PROBABILITY_RANGE,
+			Conditionparam: &wordsearcher.SearchRequest_SearchParam_Minmax{
+				Minmax: &wordsearcher.SearchRequest_MinMax{Min: 1, Max: 50}},
+		},
+	}
+	qg := NewQueryGen("NWL23", FullExpanded, params, 950, &config.Config{})
+	queries, err := qg.Generate()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(queries))
+	assert.Contains(t, queries[0].Rendered(), "alphagrams.vowel_probability BETWEEN ? and ?")
+	assert.Contains(t, queries[0].Rendered(), "ORDER BY "+OrderByProbability+"\n")
+	assert.Equal(t, []interface{}{int32(7), int32(1), int32(50)},
+		queries[0].BindParams())
+
+	qg.SetSortOrder(wordsearcher.SearchRequest_SORT_VOWEL_PROBABILITY)
+	queries, err = qg.Generate()
+	assert.Nil(t, err)
+	assert.Contains(t, queries[0].Rendered(), "ORDER BY "+OrderByVowelProbability)
+	assert.False(t, strings.Contains(queries[0].Rendered(), "%!"))
+}
+
+func TestPostgresOrder(t *testing.T) {
+	length := &wordsearcher.SearchRequest_SearchParam{
+		Condition: wordsearcher.SearchRequest_LENGTH,
+		Conditionparam: &wordsearcher.SearchRequest_SearchParam_Minmax{
+			Minmax: &wordsearcher.SearchRequest_MinMax{Min: 7, Max: 7}},
+	}
+	qg := NewQueryGen("NWL23", AlphagramsAndWords, []*wordsearcher.SearchRequest_SearchParam{length},
+		950, &config.Config{})
+	queries, err := qg.Generate()
+	assert.Nil(t, err)
+	assert.False(t, strings.Contains(queries[0].Rendered(), "w.word"))
+
+	qg = NewQueryGen("NWL23", AlphagramsAndWords, []*wordsearcher.SearchRequest_SearchParam{length},
+		950, &config.Config{WordDBDSN: "postgres://localhost/wdb"})
+	qg.SetSortOrder(wordsearcher.SearchRequest_SORT_VOWEL_PROBABILITY)
+	queries, err = qg.Generate()
+	assert.Nil(t, err)
+	assert.True(t, strings.HasSuffix(queries[0].Rendered(),
+		"INNER JOIN words w using (alphagram)\nORDER BY q.vowel_probability, q.probability, w.word\n"))
+
+	// A ranking order is given again after the join in SQLite too.
+	mostProbable := &wordsearcher.SearchRequest_SearchParam{
+		Condition: wordsearcher.SearchRequest_MOST_PROBABLE,
+		Conditionparam: &wordsearcher.SearchRequest_SearchParam_Minmax{
+			Minmax: &wordsearcher.SearchRequest_MinMax{Min: 5001, Max: 6000}},
+	}
+	qg = NewQueryGen("NWL23", AlphagramsAndWords,
+		[]*wordsearcher.SearchRequest_SearchParam{length, mostProbable}, 950, &config.Config{})
+	queries, err = qg.Generate()
+	assert.Nil(t, err)
+	assert.True(t, strings.HasSuffix(queries[0].Rendered(), "INNER JOIN words w using (alphagram)\n"+
+		"ORDER BY q.combinations DESC, q.length, q.probability, q.alphagram, w.word\n"))
+	assert.Equal(t, []interface{}{int32(7), int32(1000), int32(5000)}, queries[0].BindParams())
+}
+
+func TestNumberOfAnagrams(t *testing.T) {
+	numAnagrams := func(min, max int32) []*wordsearcher.SearchRequest_SearchParam {
+		return []*wordsearcher.SearchRequest_SearchParam{{
+			Condition: wordsearcher.SearchRequest_NUMBER_OF_ANAGRAMS,
+			Conditionparam: &wordsearcher.SearchRequest_SearchParam_Minmax{
+				Minmax: &wordsearcher.SearchRequest_MinMax{Min: min, Max: max}},
+		}}
+	}
+	// Exactly four words.
+	queries, err := NewQueryGen("NWL23", AlphagramsOnly, numAnagrams(4, 4), 950,
+		&config.Config{}).Generate()
+	assert.Nil(t, err)
+	assert.Contains(t, queries[0].Rendered(), "alphagrams.num_anagrams = ?")
+	assert.Equal(t, []interface{}{int32(4)}, queries[0].BindParams())
+
+	// Four or more.
+	queries, err = NewQueryGen("NWL23", AlphagramsOnly, numAnagrams(4, 100), 950,
+		&config.Config{}).Generate()
+	assert.Nil(t, err)
+	assert.Contains(t, queries[0].Rendered(), "alphagrams.num_anagrams BETWEEN ? and ?")
+	assert.Equal(t, []interface{}{int32(4), int32(100)}, queries[0].BindParams())
+}
+
+func TestVowelRatio(t *testing.T) {
+	params := []*wordsearcher.SearchRequest_SearchParam{{
+		Condition: wordsearcher.SearchRequest_VOWEL_RATIO,
+		Conditionparam: &wordsearcher.SearchRequest_SearchParam_Minmax{
+			Minmax: &wordsearcher.SearchRequest_MinMax{Min: 40, Max: 60}},
+	}}
+	queries, err := NewQueryGen("NWL23", AlphagramsOnly, params, 950, &config.Config{}).Generate()
+	assert.Nil(t, err)
+	assert.Contains(t, queries[0].Rendered(),
+		"alphagrams.num_vowels * 100 BETWEEN ? * alphagrams.length AND ? * alphagrams.length")
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.Nil(t, err)
+	defer db.Close()
+	_, err = db.Exec(`
+	CREATE TABLE alphagrams (alphagram varchar(20), probability int,
+		combinations int, difficulty int, display_alphagram varchar(20),
+		playability int, length int, vowel_probability int, num_vowels int,
+		point_value int, num_anagrams int);
+	INSERT INTO alphagrams VALUES
+		('CHRRSTY', 1, 1, NULL, '', NULL, 7, 1, 0, 16, 1),
+		('AEINRST', 2, 1, NULL, '', NULL, 7, 1, 3, 7, 9),
+		('AEIORST', 3, 1, NULL, '', NULL, 7, 1, 4, 7, 1),
+		('AEIORSTU', 4, 1, NULL, '', NULL, 8, 1, 5, 8, 1),
+		('AEIST', 5, 1, NULL, '', NULL, 5, 1, 3, 5, 2);
+	`)
+	assert.Nil(t, err)
+	rows, err := db.Query(queries[0].Rendered(), queries[0].BindParams()...)
+	assert.Nil(t, err)
+	found := []string{}
+	for rows.Next() {
+		var alph string
+		var ignored any
+		assert.Nil(t, rows.Scan(&alph, &ignored, &ignored, &ignored, &ignored,
+			&ignored, &ignored, &ignored))
+		found = append(found, alph)
+	}
+	rows.Close()
+	// 3/7 is 43%, 4/7 is 57% and 3/5 is 60%; 5/8 is 62.5%.
+	assert.ElementsMatch(t, []string{"AEINRST", "AEIORST", "AEIST"}, found)
+
+	params[0].GetMinmax().Max = 101
+	_, err = NewQueryGen("NWL23", AlphagramsOnly, params, 950, &config.Config{}).Generate()
+	assert.NotNil(t, err)
+}
+
+func TestCombinationsRange(t *testing.T) {
+	params := []*wordsearcher.SearchRequest_SearchParam{{
+		Condition: wordsearcher.SearchRequest_COMBINATIONS_RANGE,
+		Conditionparam: &wordsearcher.SearchRequest_SearchParam_Minmax64{
+			Minmax64: &wordsearcher.SearchRequest_MinMax64{Min: 3000000000, Max: 5000000000}},
+	}}
+	queries, err := NewQueryGen("NWL23", AlphagramsOnly, params, 950, &config.Config{}).Generate()
+	assert.Nil(t, err)
+	assert.Contains(t, queries[0].Rendered(), "alphagrams.combinations BETWEEN ? and ?")
+	assert.Equal(t, []interface{}{int64(3000000000), int64(5000000000)}, queries[0].BindParams())
+
+	// The combinations condition needs a minmax64.
+	params[0].Conditionparam = &wordsearcher.SearchRequest_SearchParam_Minmax{
+		Minmax: &wordsearcher.SearchRequest_MinMax{Min: 1, Max: 2}}
+	_, err = NewQueryGen("NWL23", AlphagramsOnly, params, 950, &config.Config{}).Generate()
+	assert.NotNil(t, err)
+}
+
+func TestHasTag(t *testing.T) {
+	params := []*wordsearcher.SearchRequest_SearchParam{{
+		Condition: wordsearcher.SearchRequest_HAS_TAG,
+		Conditionparam: &wordsearcher.SearchRequest_SearchParam_Stringvalue{
+			Stringvalue: &wordsearcher.SearchRequest_StringValue{Value: " Common "}},
+	}}
+	queries, err := NewQueryGen("NWL23", AlphagramsOnly, params, 950, &config.Config{}).Generate()
+	assert.Nil(t, err)
+	assert.Contains(t, queries[0].Rendered(), "alphagrams.alphagram IN (SELECT words.alphagram "+
+		"FROM words WHERE words.word IN (SELECT word_tags.word FROM word_tags WHERE word_tags.tag = ?))")
+	assert.Equal(t, []interface{}{"common"}, queries[0].BindParams())
+
+	params[0].Conditionparam = &wordsearcher.SearchRequest_SearchParam_Stringvalue{
+		Stringvalue: &wordsearcher.SearchRequest_StringValue{Value: " "}}
+	_, err = NewQueryGen("NWL23", AlphagramsOnly, params, 950, &config.Config{}).Generate()
+	assert.NotNil(t, err)
+}
+
+func TestRandomSampleValidation(t *testing.T) {
+	length := &wordsearcher.SearchRequest_SearchParam{
+		Condition: wordsearcher.SearchRequest_LENGTH,
+		Conditionparam: &wordsearcher.SearchRequest_SearchParam_Minmax{
+			Minmax: &wordsearcher.SearchRequest_MinMax{Min: 7, Max: 7}},
+	}
+	sample := func(count int32) *wordsearcher.SearchRequest_SearchParam {
+		return &wordsearcher.SearchRequest_SearchParam{
+			Condition: wordsearcher.SearchRequest_RANDOM_SAMPLE,
+			Conditionparam: &wordsearcher.SearchRequest_SearchParam_Randomsample{
+				Randomsample: &wordsearcher.SearchRequest_RandomSample{Count: count, Seed: 42}},
+		}
+	}
+	limit := &wordsearcher.SearchRequest_SearchParam{
+		Condition: wordsearcher.SearchRequest_PROBABILITY_LIMIT,
+		Conditionparam: &wordsearcher.SearchRequest_SearchParam_Minmax{
+			Minmax: &wordsearcher.SearchRequest_MinMax{Min: 1, Max: 5000}},
+	}
+
+	// The sample can come after a condition that must be last.
+	qg := NewQueryGen("NWL23", AlphagramsAndWords,
+		[]*wordsearcher.SearchRequest_SearchParam{length, limit, sample(10)}, 950, &config.Config{})
+	assert.Nil(t, qg.Validate())
+	assert.Equal(t, int32(10), qg.RandomSample().Count)
+	queries, err := qg.Generate()
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{int32(7), int32(5000), int32(0)}, queries[0].BindParams())
+
+	qg = NewQueryGen("NWL23", AlphagramsAndWords,
+		[]*wordsearcher.SearchRequest_SearchParam{length, sample(0)}, 950, &config.Config{})
+	assert.NotNil(t, qg.Validate())
+	qg = NewQueryGen("NWL23", AlphagramsAndWords,
+		[]*wordsearcher.SearchRequest_SearchParam{sample(5), length, sample(5)}, 950, &config.Config{})
+	assert.NotNil(t, qg.Validate())
+	qg = NewQueryGen("NWL23", AlphagramsAndWords,
+		[]*wordsearcher.SearchRequest_SearchParam{length}, 950, &config.Config{})
+	assert.Nil(t, qg.RandomSample())
+}
+
+func TestCombinator(t *testing.T) {
+	minmax := func(c wordsearcher.SearchRequest_Condition, min, max int32) *wordsearcher.SearchRequest_SearchParam {
+		return &wordsearcher.SearchRequest_SearchParam{
+			Condition: c,
+			Conditionparam: &wordsearcher.SearchRequest_SearchParam_Minmax{
+				Minmax: &wordsearcher.SearchRequest_MinMax{Min: min, Max: max}},
+		}
+	}
+	combine := func(op wordsearcher.SearchRequest_Combinator_Op,
+		params ...*wordsearcher.SearchRequest_SearchParam) *wordsearcher.SearchRequest_SearchParam {
+		return &wordsearcher.SearchRequest_SearchParam{
+			Condition: wordsearcher.SearchRequest_COMBINATOR,
+			Conditionparam: &wordsearcher.SearchRequest_SearchParam_Combinator{
+				Combinator: &wordsearcher.SearchRequest_Combinator{Op: op, Params: params}},
+		}
+	}
+	// length 7 AND (no vowels OR (point value > 20 AND NOT 1 anagram))
+	params := []*wordsearcher.SearchRequest_SearchParam{
+		minmax(wordsearcher.SearchRequest_LENGTH, 7, 7),
+		combine(wordsearcher.SearchRequest_Combinator_OR,
+			minmax(wordsearcher.SearchRequest_NUMBER_OF_VOWELS, 0, 0),
+			combine(wordsearcher.SearchRequest_Combinator_AND,
+				minmax(wordsearcher.SearchRequest_POINT_VALUE, 21, 100),
+				combine(wordsearcher.SearchRequest_Combinator_NOT,
+					minmax(wordsearcher.SearchRequest_NUMBER_OF_ANAGRAMS, 1, 1)))),
+	}
+	qg := NewQueryGen("NWL23", AlphagramsOnly, params, 950, &config.Config{})
+	queries, err := qg.Generate()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(queries))
+	assert.Contains(t, queries[0].Rendered(), "alphagrams.length = ? AND "+
+		"(alphagrams.num_vowels = ? OR "+
+		"(alphagrams.point_value BETWEEN ? and ? AND NOT (alphagrams.num_anagrams = ?)))")
+	assert.Equal(t, []interface{}{int32(7), int32(0), int32(21), int32(100), int32(1)},
+		queries[0].BindParams())
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.Nil(t, err)
+	defer db.Close()
+	_, err = db.Exec(`
+	CREATE TABLE alphagrams (alphagram varchar(20), probability int,
+		combinations int, difficulty int, display_alphagram varchar(20),
+		playability int, length int, vowel_probability int, num_vowels int,
+		point_value int, num_anagrams int);
+	INSERT INTO alphagrams VALUES
+		('CHRRSTY', 1, 1, NULL, '', NULL, 7, 1, 0, 16, 1),
+		('AEIJKQZ', 2, 1, NULL, '', NULL, 7, 1, 3, 40, 1),
+		('AEJKQZZ', 3, 1, NULL, '', NULL, 7, 2, 2, 50, 2),
+		('AEINRST', 4, 1, NULL, '', NULL, 7, 3, 3, 7, 9),
+		('CHRRSTYY', 5, 1, NULL, '', NULL, 8, 1, 0, 20, 1);
+	`)
+	assert.Nil(t, err)
+	rows, err := db.Query(queries[0].Rendered(), queries[0].BindParams()...)
+	assert.Nil(t, err)
+	found := []string{}
+	for rows.Next() {
+		var alph string
+		var ignored any
+		assert.Nil(t, rows.Scan(&alph, &ignored, &ignored, &ignored, &ignored,
+			&ignored, &ignored, &ignored))
+		found = append(found, alph)
+	}
+	rows.Close()
+	assert.ElementsMatch(t, []string{"CHRRSTY", "AEJKQZZ"}, found)
+
+	for _, bad := range []*wordsearcher.SearchRequest_SearchParam{
+		combine(wordsearcher.SearchRequest_Combinator_NOT,
+			minmax(wordsearcher.SearchRequest_LENGTH, 7, 7),
+			minmax(wordsearcher.SearchRequest_POINT_VALUE, 7, 7)),
+		combine(wordsearcher.SearchRequest_Combinator_OR),
+		combine(wordsearcher.SearchRequest_Combinator_OR,
+			minmax(wordsearcher.SearchRequest_PROBABILITY_LIMIT, 1, 100)),
+		combine(wordsearcher.SearchRequest_Combinator_OR,
+			&wordsearcher.SearchRequest_SearchParam{
+				Condition: wordsearcher.SearchRequest_ALPHAGRAM_LIST,
+				Conditionparam: &wordsearcher.SearchRequest_SearchParam_Stringarray{
+					Stringarray: &wordsearcher.SearchRequest_StringArray{
+						Values: []string{"AB", "AD", "AE"}}}}),
+	} {
+		qg := NewQueryGen("NWL23", AlphagramsOnly, []*wordsearcher.SearchRequest_SearchParam{
+			minmax(wordsearcher.SearchRequest_LENGTH, 7, 7), bad}, 2, &config.Config{})
+		_, err := qg.Generate()
+		assert.NotNil(t, err)
+	}
+}
+
+const miniSpanishDist = `?,2,0,0
+A,12,1,1
+CH,1,5,0
+E,12,1,1
+O,9,1,1
+R,5,1,0
+RR,1,8,0
+`
+
+func TestAlphagramLetters(t *testing.T) {
+	dist, err := tilemapping.ScanLetterDistribution(strings.NewReader(miniSpanishDist))
+	assert.Nil(t, err)
+	tiles, multiTiles, err := alphagramTiles("rach", dist)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"A", "[CH]", "R"}, tiles)
+	assert.Equal(t, []string{"[CH]", "[RR]"}, multiTiles)
+	tiles, _, err = alphagramTiles("RR [R][R]", dist)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"R", "R", "[RR]"}, tiles)
+	_, _, err = alphagramTiles("A?", dist)
+	assert.NotNil(t, err)
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.Nil(t, err)
+	defer db.Close()
+	_, err = db.Exec(`CREATE TABLE alphagrams (display_alphagram varchar(20));
+		INSERT INTO alphagrams VALUES ('A[CH]O[RR]'), ('EORR'), ('A[CH]O'), ('AEOR');`)
+	assert.Nil(t, err)
+	search := func(letters string, exclude bool) []string {
+		tiles, multiTiles, err := alphagramTiles(letters, dist)
+		assert.Nil(t, err)
+		where, params, err := NewWhereAlphagramLettersClause("alphagrams", "display_alphagram",
+			tiles, multiTiles, exclude).Render()
+		assert.Nil(t, err)
+		rows, err := db.Query(`SELECT display_alphagram FROM alphagrams WHERE `+where, params...)
+		assert.Nil(t, err)
+		defer rows.Close()
+		found := []string{}
+		for rows.Next() {
+			var alph string
+			assert.Nil(t, rows.Scan(&alph))
+			found = append(found, alph)
+		}
+		return found
+	}
+	assert.ElementsMatch(t, []string{"EORR", "AEOR"}, search("R", false))
+	assert.ElementsMatch(t, []string{"EORR"}, search("R R", false))
+	assert.ElementsMatch(t, []string{"A[CH]O[RR]"}, search("RR", false))
+	assert.ElementsMatch(t, []string{"A[CH]O[RR]", "A[CH]O"}, search("OCH", false))
+	assert.ElementsMatch(t, []string{"EORR", "AEOR"}, search("CH", true))
+	assert.ElementsMatch(t, []string{"A[CH]O[RR]", "A[CH]O"}, search("R", true))
+	assert.ElementsMatch(t, []string{}, search("EE", false))
+}
+
+func TestStemPlusOneAlphagrams(t *testing.T) {
+	dist, err := tilemapping.ScanLetterDistribution(strings.NewReader(miniSpanishDist))
+	assert.Nil(t, err)
+	alphas, err := stemPlusOneAlphagrams("rch", dist)
+	assert.Nil(t, err)
+	// As the alphagram column has them, without brackets.
+	assert.Equal(t, []string{"ACHR", "CHCHR", "CHER", "CHOR", "CHRR", "CHRRR"}, alphas)
+	_, err = stemPlusOneAlphagrams("", dist)
+	assert.NotNil(t, err)
+	_, err = stemPlusOneAlphagrams("R?", dist)
+	assert.NotNil(t, err)
+}
+
+func TestPage(t *testing.T) {
+	length := minMaxParam(wordsearcher.SearchRequest_LENGTH, 7, 8)
+	qg := NewQueryGen("NWL23", AlphagramsAndWords,
+		[]*wordsearcher.SearchRequest_SearchParam{length}, 950, &config.Config{})
+	qg.SetPage(&Page{Size: 50})
+	assert.Nil(t, qg.Validate())
+	queries, err := qg.Generate()
+	assert.Nil(t, err)
+	assert.Contains(t, queries[0].Rendered(), "ORDER BY "+OrderByPage+"\n\tLIMIT ?")
+	assert.Equal(t, []interface{}{int32(7), int32(8), 51}, queries[0].BindParams())
+
+	qg.SetPage(&Page{Size: 50, After: &PageKey{Length: 7, Probability: 120, Alphagram: "AEINRST"}})
+	queries, err = qg.Generate()
+	assert.Nil(t, err)
+	assert.Contains(t, queries[0].Rendered(), "("+OrderByPage+") > (?, ?, ?) AND")
+	assert.Equal(t, []interface{}{int32(7), int32(120), "AEINRST", int32(7), int32(8), 51},
+		queries[0].BindParams())
+
+	// Every chunk of a long list gets the page's conditions, and the list
+	// isn't planned into a post-filter, which would apply after the limit.
+	qg = NewQueryGen("NWL23", AlphagramsAndWords,
+		[]*wordsearcher.SearchRequest_SearchParam{length, alphagramListParam(20000)}, 5000, &config.Config{})
+	qg.SetStats(testStats)
+	qg.SetPage(&Page{Size: 5, After: &PageKey{Length: 7, Probability: 120, Alphagram: "AEINRST"}})
+	queries, err = qg.Generate()
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(queries))
+	for _, q := range queries {
+		assert.Nil(t, q.postFilter)
+		assert.Contains(t, q.Rendered(), "LIMIT ?")
+		assert.Equal(t, 6, q.BindParams()[len(q.BindParams())-1])
+	}
+
+	qg = NewQueryGen("NWL23", AlphagramsAndWords, []*wordsearcher.SearchRequest_SearchParam{
+		length, minMaxParam(wordsearcher.SearchRequest_PROBABILITY_LIMIT, 1, 100)}, 950, &config.Config{})
+	qg.SetPage(&Page{Size: 50})
+	assert.NotNil(t, qg.Validate())
+	qg = NewQueryGen("NWL23", AlphagramsAndWords,
+		[]*wordsearcher.SearchRequest_SearchParam{length}, 950, &config.Config{})
+	qg.SetSortOrder(wordsearcher.SearchRequest_SORT_VOWEL_PROBABILITY)
+	qg.SetPage(&Page{Size: 50})
+	assert.NotNil(t, qg.Validate())
+	qg.SetSortOrder(wordsearcher.SearchRequest_SORT_PROBABILITY)
+	qg.SetPage(&Page{Size: -1})
+	assert.NotNil(t, qg.Validate())
+}
+
+func TestOrderedProbabilityList(t *testing.T) {
+	pairs := &wordsearcher.SearchRequest_SearchParam{
+		Condition: wordsearcher.SearchRequest_ORDERED_PROBABILITY_LIST,
+		Conditionparam: &wordsearcher.SearchRequest_SearchParam_Lengthprobabilities{
+			Lengthprobabilities: &wordsearcher.SearchRequest_LengthProbabilityList{
+				Values: []*wordsearcher.SearchRequest_LengthProbability{
+					{Length: 7, Probability: 12}, {Length: 8, Probability: 3},
+					{Length: 7, Probability: 12}, {Length: 5, Probability: 1}}}},
+	}
+	qg := NewQueryGen("NWL23", AlphagramsAndWords, []*wordsearcher.SearchRequest_SearchParam{pairs},
+		2, &config.Config{})
+	queries, err := qg.Generate()
+	assert.Nil(t, err)
+	// The repeat is dropped, and the rest are chunked.
+	assert.Equal(t, 2, len(queries))
+	assert.Contains(t, queries[0].Rendered(),
+		"(alphagrams.length, alphagrams.probability) IN (VALUES (?, ?),(?, ?))")
+	assert.Equal(t, []interface{}{int32(7), int32(12), int32(8), int32(3)}, queries[0].BindParams())
+	assert.Equal(t, []interface{}{int32(5), int32(1)}, queries[1].BindParams())
+
+	ordered, missing := qg.OrderedList().Order([]*wordsearcher.Alphagram{
+		{Alphagram: "AEINRST", Length: 7, Probability: 12},
+		{Alphagram: "AEINT", Length: 5, Probability: 1},
+	})
+	assert.Equal(t, "AEINRST", ordered[0].Alphagram)
+	assert.Equal(t, "AEINT", ordered[1].Alphagram)
+	assert.Equal(t, []string{"8:3"}, missing)
+
+	pairs.GetLengthprobabilities().Values[0].Probability = 0
+	_, err = NewQueryGen("NWL23", AlphagramsAndWords, []*wordsearcher.SearchRequest_SearchParam{pairs},
+		2, &config.Config{}).Generate()
+	assert.Equal(t, "length 7, probability 0 is not valid", err.Error())
+}
+
+func TestBuildValidation(t *testing.T) {
+	build := func(b *wordsearcher.SearchRequest_Build) error {
+		_, err := NewQueryGen("NWL23", AlphagramsAndWords, []*wordsearcher.SearchRequest_SearchParam{{
+			Condition:      wordsearcher.SearchRequest_BUILD,
+			Conditionparam: &wordsearcher.SearchRequest_SearchParam_Build{Build: b},
+		}}, 950, &config.Config{}).Generate()
+		return err
+	}
+	assert.Equal(t, "build not provided for build request", build(nil).Error())
+	assert.Equal(t, "invalid build lengths 5 to 3",
+		build(&wordsearcher.SearchRequest_Build{Letters: "RETINAS", MinLength: 5, MaxLength: 3}).Error())
+	assert.Equal(t, "builds can have at most 3 blanks",
+		build(&wordsearcher.SearchRequest_Build{Letters: "AE????"}).Error())
+}
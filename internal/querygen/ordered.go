@@ -0,0 +1,132 @@
+package querygen
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/domino14/word-golib/tilemapping"
+
+	"github.com/domino14/word_db_server/internal/common"
+	"github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// OrderedList is the list that an ORDERED_ALPHAGRAM_LIST or
+// ORDERED_PROBABILITY_LIST search returns its alphagrams in.
+type OrderedList struct {
+	// Entries are the list's alphagrams, or its lengths and probabilities
+	// as "length:probability", without repeats.
+	Entries       []string
+	byProbability bool
+}
+
+// key returns the entry that the alphagram would be in the list.
+func (ol *OrderedList) key(a *wordsearcher.Alphagram) string {
+	if ol.byProbability {
+		return fmt.Sprintf("%d:%d", a.Length, a.Probability)
+	}
+	return a.Alphagram
+}
+
+// Order returns the alphagrams in the list's order, and the entries that
+// none of them are.
+func (ol *OrderedList) Order(alphagrams []*wordsearcher.Alphagram) (
+	[]*wordsearcher.Alphagram, []string) {
+
+	found := make(map[string]*wordsearcher.Alphagram, len(alphagrams))
+	for _, a := range alphagrams {
+		found[ol.key(a)] = a
+	}
+	ordered := make([]*wordsearcher.Alphagram, 0, len(alphagrams))
+	var missing []string
+	for _, e := range ol.Entries {
+		if a, ok := found[e]; ok {
+			ordered = append(ordered, a)
+		} else {
+			missing = append(missing, e)
+		}
+	}
+	return ordered, missing
+}
+
+// OrderedList returns the list that the search's alphagrams must be put in
+// the order of, or nil if they are in the sort order. It is only set once
+// Generate has been called.
+func (qg *QueryGen) OrderedList() *OrderedList {
+	return qg.ordered
+}
+
+// orderedAlphagramClause makes the clause for an ORDERED_ALPHAGRAM_LIST,
+// and remembers the list's order.
+func (qg *QueryGen) orderedAlphagramClause(sp *wordsearcher.SearchRequest_SearchParam) (Clause, error) {
+	arr := sp.GetStringarray()
+	if arr == nil {
+		return nil, errors.New("stringarray not provided for ordered alphagram list request")
+	}
+	dist, err := common.LetterDistribution(qg.config, qg.lexiconName)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	alphas := []string{}
+	for _, entry := range arr.GetValues() {
+		entry = strings.ToUpper(strings.TrimSpace(entry))
+		mls, err := tilemapping.ToMachineLetters(entry, dist.TileMapping())
+		if err != nil || len(mls) == 0 {
+			return nil, fmt.Errorf("%q is not a valid alphagram", entry)
+		}
+		for _, ml := range mls {
+			if ml == 0 {
+				return nil, fmt.Errorf("%q is not a valid alphagram", entry)
+			}
+		}
+		// Words and alphagrams alike.
+		alpha := common.InitializeWord(entry, dist).MakeAlphagram()
+		if !seen[alpha] {
+			seen[alpha] = true
+			alphas = append(alphas, alpha)
+		}
+	}
+	qg.ordered = &OrderedList{Entries: alphas}
+	return NewWhereInClause("alphagrams", "alphagram",
+		&wordsearcher.SearchRequest_SearchParam{
+			Conditionparam: &wordsearcher.SearchRequest_SearchParam_Stringarray{
+				Stringarray: &wordsearcher.SearchRequest_StringArray{Values: alphas}}}), nil
+}
+
+// orderedProbabilityClause makes the clause for an
+// ORDERED_PROBABILITY_LIST, and remembers the list's order.
+func (qg *QueryGen) orderedProbabilityClause(sp *wordsearcher.SearchRequest_SearchParam) (Clause, error) {
+	list := sp.GetLengthprobabilities()
+	if list == nil {
+		return nil, errors.New("lengthprobabilities not provided for ordered probability list request")
+	}
+	seen := map[string]bool{}
+	entries := []string{}
+	vals := []*wordsearcher.SearchRequest_LengthProbability{}
+	for _, v := range list.GetValues() {
+		if v.GetLength() < 1 || v.GetProbability() < 1 {
+			return nil, fmt.Errorf("length %d, probability %d is not valid",
+				v.GetLength(), v.GetProbability())
+		}
+		entry := fmt.Sprintf("%d:%d", v.GetLength(), v.GetProbability())
+		if !seen[entry] {
+			seen[entry] = true
+			entries = append(entries, entry)
+			vals = append(vals, v)
+		}
+	}
+	qg.ordered = &OrderedList{Entries: entries, byProbability: true}
+	return NewWhereInClause("alphagrams", "length, probability",
+		&wordsearcher.SearchRequest_SearchParam{
+			Conditionparam: &wordsearcher.SearchRequest_SearchParam_Lengthprobabilities{
+				Lengthprobabilities: &wordsearcher.SearchRequest_LengthProbabilityList{
+					Values: vals}}}), nil
+}
+
+// isOrderedList returns whether the condition returns its alphagrams in the
+// order of a list.
+func isOrderedList(condition wordsearcher.SearchRequest_Condition) bool {
+	return condition == wordsearcher.SearchRequest_ORDERED_ALPHAGRAM_LIST ||
+		condition == wordsearcher.SearchRequest_ORDERED_PROBABILITY_LIST
+}
@@ -0,0 +1,105 @@
+package querygen
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// OrderByPage is the order of alphagrams in a paged search. Probabilities
+// are only unique within a length, and the alphagram breaks any ties, so
+// every alphagram has its own place in this order.
+const OrderByPage = "alphagrams.length, alphagrams.probability, alphagrams.alphagram"
+
+// PageKey is an alphagram's place in OrderByPage.
+type PageKey struct {
+	Length      int32
+	Probability int32
+	Alphagram   string
+}
+
+// Page is one page of a search's results: the first Size alphagrams after
+// After, or from the start if After is nil.
+type Page struct {
+	After *PageKey
+	Size  int
+}
+
+// Less returns whether a comes before b in OrderByPage.
+func (a PageKey) Less(b PageKey) bool {
+	if a.Length != b.Length {
+		return a.Length < b.Length
+	}
+	if a.Probability != b.Probability {
+		return a.Probability < b.Probability
+	}
+	return a.Alphagram < b.Alphagram
+}
+
+// PageAfterClause selects the alphagrams after a key in OrderByPage.
+type PageAfterClause struct {
+	key PageKey
+}
+
+// NewPageAfterClause creates a new PageAfterClause.
+func NewPageAfterClause(key PageKey) *PageAfterClause {
+	return &PageAfterClause{key: key}
+}
+
+// Render renders the clause as a row value comparison, so that it can use
+// the (length, probability) index.
+func (pc *PageAfterClause) Render() (string, []interface{}, error) {
+	return "(" + OrderByPage + ") > (?, ?, ?)",
+		[]interface{}{pc.key.Length, pc.key.Probability, pc.key.Alphagram}, nil
+}
+
+// SetPage makes the generator return only one page of results, in
+// OrderByPage. Each query returns up to one more alphagram than the page
+// size, so that the caller can tell whether there is another page.
+func (qg *QueryGen) SetPage(page *Page) {
+	qg.page = page
+}
+
+func (qg *QueryGen) queryOrder() string {
+	if qg.page != nil {
+		return OrderByPage
+	}
+	return qg.orderBy
+}
+
+// pageLimit renders the limit clause for a paged search.
+func (qg *QueryGen) pageLimit() (string, []interface{}) {
+	if qg.page == nil {
+		return "", nil
+	}
+	return "LIMIT ?", []interface{}{qg.page.Size + 1}
+}
+
+func (qg *QueryGen) validatePage() error {
+	if qg.page == nil {
+		return nil
+	}
+	if qg.page.Size < 1 {
+		return errors.New("page size must be positive")
+	}
+	if qg.orderBy != OrderByProbability {
+		return errors.New("paged searches must be sorted by probability")
+	}
+	if qg.queryType == DeletedWords {
+		return errors.New("deleted word searches can't be paged")
+	}
+	for _, param := range qg.searchParams {
+		switch param.Condition {
+		case wordsearcher.SearchRequest_PROBABILITY_LIMIT,
+			wordsearcher.SearchRequest_PROBABILITY_LIMIT_PER_LENGTH,
+			wordsearcher.SearchRequest_MOST_PROBABLE,
+			wordsearcher.SearchRequest_LEAST_PROBABLE,
+			wordsearcher.SearchRequest_RANDOM_SAMPLE,
+			wordsearcher.SearchRequest_ORDERED_ALPHAGRAM_LIST,
+			wordsearcher.SearchRequest_ORDERED_PROBABILITY_LIST:
+			return fmt.Errorf("searches with %v can't be paged", param.Condition)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,290 @@
+package querygen
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/word_db_server/internal/common"
+	"github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// PostFilterMaxRows is the most rows the planner will fetch in order to
+// filter them in Go instead of in SQL.
+const PostFilterMaxRows = 20000
+
+// TableStats are the statistics that SQLite's ANALYZE gathered for the
+// alphagrams table. They are only estimates.
+type TableStats struct {
+	Rows int64
+	// RowsPerValue is the average number of rows that have the same value,
+	// for each column that is the first column of an index.
+	RowsPerValue map[string]float64
+}
+
+// SetStats gives the generator statistics about the lexicon database, so
+// that it can plan the queries. Without them, conditions are applied in
+// the order given.
+func (qg *QueryGen) SetStats(stats *TableStats) {
+	qg.stats = stats
+}
+
+// estimate returns the estimated number of alphagrams that match the
+// clause on its own, and false if there's no estimate.
+func (st *TableStats) estimate(c Clause) (float64, bool) {
+	if st == nil || st.Rows == 0 {
+		return 0, false
+	}
+	var table, column string
+	var values float64
+	switch cl := c.(type) {
+	case *WhereBetweenClause:
+		table, column = cl.table, cl.column
+		values = cl.width
+	case *WhereEqualsNumberClause:
+		table, column, values = cl.table, cl.column, 1
+	case *WhereInClause:
+		table, column, values = cl.table, cl.column, float64(cl.numItems)
+	default:
+		return 0, false
+	}
+	perValue, ok := st.RowsPerValue[column]
+	if table != "alphagrams" || !ok || values < 0 {
+		return 0, false
+	}
+	return math.Min(float64(st.Rows), perValue*values), true
+}
+
+// plan orders the clauses so that the ones expected to match the fewest
+// alphagrams come first, leaving a list clause last, as maybeChunk needs.
+// It also decides whether a long alphagram list is better applied to the
+// results in Go: if the other conditions match few enough alphagrams, one
+// query for those is cheaper than splitting the list over many queries.
+// The same goes for an anagram or build, whose DAWG walk can find
+// thousands of words; if the other conditions are selective, the walk is
+// skipped, and the alphagrams they match are checked against the rack
+// instead. It returns the filter to apply in either case. limited is
+// whether the query's rows are limited in SQL, by a probability limit or
+// a page; the limit has to apply to the filtered results, so those
+// queries are never post-filtered.
+func (qg *QueryGen) plan(clauses []Clause, limited bool) ([]Clause, alphagramFilter, error) {
+	usesStats := qg.stats != nil && (qg.queryType == FullExpanded || qg.queryType == AlphagramsAndWords)
+	planned := make([]Clause, 0, len(clauses))
+	var list *WhereInClause
+	var rack *rackClause
+	for _, c := range clauses {
+		if rc, ok := c.(*rackClause); ok {
+			if usesStats && !limited && rack == nil {
+				rack = rc
+				continue
+			}
+			walked, err := rc.walk()
+			if err != nil {
+				return nil, nil, err
+			}
+			c = walked
+		}
+		if lc, ok := c.(*WhereInClause); ok && usesStats && list == nil {
+			list = lc
+			continue
+		}
+		planned = append(planned, c)
+	}
+	if !usesStats {
+		return planned, nil, nil
+	}
+	estimates := make([]float64, len(planned))
+	for i, c := range planned {
+		est, ok := qg.stats.estimate(c)
+		if !ok {
+			est = math.Inf(1)
+		}
+		estimates[i] = est
+	}
+	sort.Stable(byEstimate{planned, estimates})
+
+	// The conditions are assumed to be independent.
+	others := float64(qg.stats.Rows)
+	for _, est := range estimates {
+		if !math.IsInf(est, 1) {
+			others *= est / float64(qg.stats.Rows)
+		}
+	}
+	if rack != nil {
+		log.Debug().Float64("others", others).Msg("query-plan-rack")
+		if list == nil && len(planned) > 0 && others <= PostFilterMaxRows {
+			return planned, rack.matcher, nil
+		}
+		walked, err := rack.walk()
+		if err != nil {
+			return nil, nil, err
+		}
+		if lc, ok := walked.(*WhereInClause); ok && list == nil {
+			list = lc
+		} else {
+			planned = append(planned, walked)
+		}
+	}
+	if list == nil {
+		return planned, nil, nil
+	}
+	log.Debug().Float64("others", others).Int("list", list.numItems).Msg("query-plan")
+	if !limited && list.table == "alphagrams" && list.column == "alphagram" &&
+		list.numItems > qg.maxChunkSize && len(planned) > 0 &&
+		others <= float64(list.numItems) && others <= PostFilterMaxRows {
+
+		return planned, list.alphagramSet(), nil
+	}
+	return append(planned, list), nil, nil
+}
+
+type byEstimate struct {
+	clauses   []Clause
+	estimates []float64
+}
+
+func (b byEstimate) Len() int           { return len(b.clauses) }
+func (b byEstimate) Less(i, j int) bool { return b.estimates[i] < b.estimates[j] }
+func (b byEstimate) Swap(i, j int) {
+	b.clauses[i], b.clauses[j] = b.clauses[j], b.clauses[i]
+	b.estimates[i], b.estimates[j] = b.estimates[j], b.estimates[i]
+}
+
+// An alphagramFilter decides which of a query's alphagrams to keep.
+type alphagramFilter interface {
+	keep(alphagram string) bool
+}
+
+// alphagramSet is a filter that keeps the alphagrams in it.
+type alphagramSet map[string]bool
+
+func (as alphagramSet) keep(alphagram string) bool {
+	return as[alphagram]
+}
+
+// alphagramSet returns the values of a list clause as a set.
+func (w *WhereInClause) alphagramSet() alphagramSet {
+	set := make(alphagramSet, w.numItems)
+	for _, v := range w.conditionParams.GetStringarray().GetValues() {
+		set[v] = true
+	}
+	return set
+}
+
+// A rackClause is an anagram or build condition whose DAWG walk is left
+// for the planner to do, or skip. It can't be rendered itself.
+type rackClause struct {
+	matcher *rackMatcher
+	// walk walks the DAWG and returns the clause for what it found.
+	walk func() (Clause, error)
+}
+
+func (rc *rackClause) Render() (string, []interface{}, error) {
+	return "", nil, errors.New("rack condition was not planned")
+}
+
+// rackMatcher is a filter that keeps the alphagrams that can be made from
+// a rack, as the DAWG walk for an anagram or build would find them. It
+// works on runes, so it's only used for lexica whose tiles are all single
+// letters.
+type rackMatcher struct {
+	letters map[rune]int
+	blanks  int
+	// For an anagram, minLen and maxLen are both the size of the rack. A
+	// maxLen of 0 is no limit.
+	minLen, maxLen int
+}
+
+func (m *rackMatcher) keep(alphagram string) bool {
+	used := make(map[rune]int, len(m.letters))
+	blanks, n := m.blanks, 0
+	for _, r := range alphagram {
+		n++
+		if used[r] < m.letters[r] {
+			used[r]++
+		} else if blanks > 0 {
+			blanks--
+		} else {
+			return false
+		}
+	}
+	return n >= m.minLen && (m.maxLen == 0 || n <= m.maxLen)
+}
+
+// rackCondition returns a rackClause for an anagram or build condition that
+// the planner could check with a rackMatcher, and nil otherwise. The walk
+// is left to generateWhereClause, which also does the validation.
+func (qg *QueryGen) rackCondition(sp *wordsearcher.SearchRequest_SearchParam) Clause {
+	if qg.stats == nil || (qg.queryType != FullExpanded && qg.queryType != AlphagramsAndWords) {
+		return nil
+	}
+	var letters string
+	var minLen, maxLen int
+	switch sp.Condition {
+	case wordsearcher.SearchRequest_MATCHING_ANAGRAM:
+		letters = sp.GetStringvalue().GetValue()
+		minLen = utf8.RuneCountInString(letters)
+		maxLen = minLen
+	case wordsearcher.SearchRequest_BUILD:
+		build := sp.GetBuild()
+		letters = strings.ToUpper(build.GetLetters())
+		minLen, maxLen = int(build.GetMinLength()), int(build.GetMaxLength())
+		if minLen < 0 || maxLen < 0 || (maxLen > 0 && minLen > maxLen) ||
+			strings.Count(letters, "?") > MaxBuildBlanks {
+			return nil
+		}
+	default:
+		return nil
+	}
+	// Ranges are left to the legacy anagrammer.
+	if letters == "" || letters != strings.ToUpper(letters) || strings.Contains(letters, "[") {
+		return nil
+	}
+	dist, err := common.LetterDistribution(qg.config, qg.lexiconName)
+	if err != nil {
+		return nil
+	}
+	tm := dist.TileMapping()
+	for ml := tilemapping.MachineLetter(1); ml < tilemapping.MachineLetter(tm.NumLetters()); ml++ {
+		if utf8.RuneCountInString(tm.Letter(ml)) > 1 {
+			return nil
+		}
+	}
+	m := &rackMatcher{letters: map[rune]int{}, minLen: minLen, maxLen: maxLen}
+	for _, r := range letters {
+		if r == '?' {
+			m.blanks++
+		} else {
+			m.letters[r]++
+		}
+	}
+	return &rackClause{matcher: m, walk: func() (Clause, error) {
+		return qg.generateWhereClause(sp)
+	}}
+}
+
+// Keeps returns whether the query's post-filter keeps the alphagram. It
+// keeps every alphagram if the query has no post-filter.
+func (q *Query) Keeps(alphagram string) bool {
+	return q.postFilter == nil || q.postFilter.keep(alphagram)
+}
+
+// Filter removes the alphagrams that the query's post-filter rejects. It
+// returns the alphagrams unchanged if the query has no post-filter.
+func (q *Query) Filter(alphagrams []*wordsearcher.Alphagram) []*wordsearcher.Alphagram {
+	if q.postFilter == nil {
+		return alphagrams
+	}
+	kept := alphagrams[:0]
+	for _, a := range alphagrams {
+		if q.Keeps(a.Alphagram) {
+			kept = append(kept, a)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,229 @@
+package querygen
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/domino14/word_db_server/config"
+	"github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+var testStats = &TableStats{
+	Rows: 100000,
+	RowsPerValue: map[string]float64{
+		"length":      7000,
+		"probability": 14,
+		"alphagram":   1,
+		"point_value": 2000,
+	},
+}
+
+func minMaxParam(cond wordsearcher.SearchRequest_Condition, min, max int32) *wordsearcher.SearchRequest_SearchParam {
+	return &wordsearcher.SearchRequest_SearchParam{
+		Condition: cond,
+		Conditionparam: &wordsearcher.SearchRequest_SearchParam_Minmax{
+			Minmax: &wordsearcher.SearchRequest_MinMax{Min: min, Max: max}},
+	}
+}
+
+func alphagramListParam(n int) *wordsearcher.SearchRequest_SearchParam {
+	alphs := make([]string, n)
+	for i := range alphs {
+		alphs[i] = fmt.Sprintf("A%05d", i)
+	}
+	return &wordsearcher.SearchRequest_SearchParam{
+		Condition: wordsearcher.SearchRequest_ALPHAGRAM_LIST,
+		Conditionparam: &wordsearcher.SearchRequest_SearchParam_Stringarray{
+			Stringarray: &wordsearcher.SearchRequest_StringArray{Values: alphs}},
+	}
+}
+
+// distConfig returns a config whose data path has an english and a
+// spanish letter distribution, the spanish one with a CH tile.
+func distConfig(t *testing.T) *config.Config {
+	dataPath := t.TempDir()
+	assert.Nil(t, os.MkdirAll(filepath.Join(dataPath, "letterdistributions"), 0755))
+	english := "?,2,0,0\n"
+	for l := 'A'; l <= 'Z'; l++ {
+		english += fmt.Sprintf("%c,2,1,0\n", l)
+	}
+	assert.Nil(t, os.WriteFile(filepath.Join(dataPath, "letterdistributions", "english"),
+		[]byte(english), 0644))
+	assert.Nil(t, os.WriteFile(filepath.Join(dataPath, "letterdistributions", "spanish"),
+		[]byte(english+"CH,1,5,0\n"), 0644))
+	return &config.Config{DataPath: dataPath}
+}
+
+func TestPlanOrdersBySelectivity(t *testing.T) {
+	params := []*wordsearcher.SearchRequest_SearchParam{
+		minMaxParam(wordsearcher.SearchRequest_LENGTH, 7, 8),
+		minMaxParam(wordsearcher.SearchRequest_NUMBER_OF_FRONT_HOOKS, 1, 3),
+		minMaxParam(wordsearcher.SearchRequest_PROBABILITY_RANGE, 1, 100),
+	}
+	qg := NewQueryGen("NWL23", FullExpanded, params, 950, distConfig(t))
+	queries, err := qg.Generate()
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{int32(7), int32(8), int32(1), int32(3), int32(1), int32(100)},
+		queries[0].BindParams())
+
+	qg.SetStats(testStats)
+	queries, err = qg.Generate()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(queries))
+	// Probability first, then length; hooks have no estimate so go last.
+	assert.Equal(t, []interface{}{int32(1), int32(100), int32(7), int32(8), int32(1), int32(3)},
+		queries[0].BindParams())
+	assert.Nil(t, queries[0].postFilter)
+}
+
+func TestPlanPostFiltersLongLists(t *testing.T) {
+	params := []*wordsearcher.SearchRequest_SearchParam{
+		minMaxParam(wordsearcher.SearchRequest_LENGTH, 6, 6),
+		minMaxParam(wordsearcher.SearchRequest_PROBABILITY_RANGE, 1, 200),
+		alphagramListParam(3000),
+	}
+	qg := NewQueryGen("NWL23", AlphagramsAndWords, params, 950, &config.Config{})
+	queries, err := qg.Generate()
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(queries))
+
+	qg.SetStats(testStats)
+	queries, err = qg.Generate()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(queries))
+	assert.NotContains(t, queries[0].Rendered(), "alphagrams.alphagram IN")
+	assert.Equal(t, 3000, len(queries[0].postFilter.(alphagramSet)))
+
+	kept := queries[0].Filter([]*wordsearcher.Alphagram{
+		{Alphagram: "A00001"}, {Alphagram: "EINORST"}, {Alphagram: "A02999"}})
+	assert.Equal(t, 2, len(kept))
+	assert.Equal(t, "A02999", kept[1].Alphagram)
+
+	// Without other selective conditions, the list stays in the SQL.
+	qg = NewQueryGen("NWL23", AlphagramsAndWords, []*wordsearcher.SearchRequest_SearchParam{
+		minMaxParam(wordsearcher.SearchRequest_LENGTH, 6, 9),
+		alphagramListParam(3000),
+	}, 950, &config.Config{})
+	qg.SetStats(testStats)
+	queries, err = qg.Generate()
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(queries))
+	for _, q := range queries {
+		assert.Contains(t, q.Rendered(), "alphagrams.alphagram IN")
+		assert.Contains(t, q.Rendered(), "alphagrams.length BETWEEN")
+		assert.Nil(t, q.postFilter)
+	}
+
+	// Limits in the SQL would apply before the filter, so paged queries
+	// keep the list in the SQL...
+	params = []*wordsearcher.SearchRequest_SearchParam{
+		minMaxParam(wordsearcher.SearchRequest_LENGTH, 6, 6),
+		minMaxParam(wordsearcher.SearchRequest_PROBABILITY_RANGE, 1, 200),
+		alphagramListParam(3000),
+	}
+	qg = NewQueryGen("NWL23", AlphagramsAndWords, params, 950, &config.Config{})
+	qg.SetStats(testStats)
+	qg.SetPage(&Page{Size: 10})
+	queries, err = qg.Generate()
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(queries))
+	for _, q := range queries {
+		assert.Contains(t, q.Rendered(), "alphagrams.alphagram IN")
+		assert.Nil(t, q.postFilter)
+	}
+	// ...and a probability limit can't be combined with the long list, as
+	// without stats.
+	qg = NewQueryGen("NWL23", AlphagramsAndWords, append(params,
+		minMaxParam(wordsearcher.SearchRequest_PROBABILITY_LIMIT, 1, 10)), 950, &config.Config{})
+	qg.SetStats(testStats)
+	_, err = qg.Generate()
+	assert.NotNil(t, err)
+
+	// Short lists are left alone too.
+	qg = NewQueryGen("NWL23", AlphagramsAndWords, []*wordsearcher.SearchRequest_SearchParam{
+		minMaxParam(wordsearcher.SearchRequest_PROBABILITY_RANGE, 1, 10),
+		alphagramListParam(50),
+	}, 950, &config.Config{})
+	qg.SetStats(testStats)
+	queries, err = qg.Generate()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(queries))
+	assert.Contains(t, queries[0].Rendered(), "alphagrams.alphagram IN")
+}
+
+func TestPlanSkipsDAWGWalk(t *testing.T) {
+	params := []*wordsearcher.SearchRequest_SearchParam{
+		minMaxParam(wordsearcher.SearchRequest_LENGTH, 7, 7),
+		minMaxParam(wordsearcher.SearchRequest_PROBABILITY_RANGE, 1, 200),
+		{
+			Condition: wordsearcher.SearchRequest_MATCHING_ANAGRAM,
+			Conditionparam: &wordsearcher.SearchRequest_SearchParam_Stringvalue{
+				Stringvalue: &wordsearcher.SearchRequest_StringValue{Value: "AEINST?"}},
+		},
+	}
+	cfg := distConfig(t)
+
+	// There's no DAWG here, so walking it fails.
+	qg := NewQueryGen("NWL23", AlphagramsAndWords, params, 950, cfg)
+	_, err := qg.Generate()
+	assert.NotNil(t, err)
+
+	// The other conditions match few enough alphagrams to check them all
+	// against the rack instead.
+	qg.SetStats(testStats)
+	queries, err := qg.Generate()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(queries))
+	assert.NotContains(t, queries[0].Rendered(), "alphagrams.alphagram IN")
+	kept := queries[0].Filter([]*wordsearcher.Alphagram{
+		{Alphagram: "AEINRST"}, {Alphagram: "ADEINST"}, {Alphagram: "ADEIMNT"},
+		{Alphagram: "AEINST"}})
+	assert.Equal(t, 2, len(kept))
+	assert.Equal(t, "ADEINST", kept[1].Alphagram)
+
+	// A page's limit has to apply after the filter, so the DAWG is walked.
+	qg.SetPage(&Page{Size: 10})
+	_, err = qg.Generate()
+	assert.NotNil(t, err)
+
+	// Alphagrams with multi-letter tiles can't be checked letter by letter.
+	qg = NewQueryGen("FISE2", AlphagramsAndWords, params, 950, cfg)
+	qg.SetStats(testStats)
+	_, err = qg.Generate()
+	assert.NotNil(t, err)
+}
+
+func TestRackMatcher(t *testing.T) {
+	build := &rackMatcher{letters: map[rune]int{'A': 1, 'B': 1, 'E': 2}, blanks: 1,
+		minLen: 2, maxLen: 4}
+	assert.True(t, build.keep("AB"))
+	assert.True(t, build.keep("ABEE"))
+	assert.True(t, build.keep("AEEZ"))
+	assert.False(t, build.keep("A"))
+	assert.False(t, build.keep("ABEEZ"))
+	assert.False(t, build.keep("AAZZ"))
+}
+
+func TestHookSearchesNeedSingleCharacterTiles(t *testing.T) {
+	cfg := distConfig(t)
+	for _, hooks := range []*wordsearcher.SearchRequest_SearchParam{
+		minMaxParam(wordsearcher.SearchRequest_NUMBER_OF_BACK_HOOKS, 1, 3),
+		{
+			Condition: wordsearcher.SearchRequest_FRONT_HOOKS_INCLUDE,
+			Conditionparam: &wordsearcher.SearchRequest_SearchParam_Stringvalue{
+				Stringvalue: &wordsearcher.SearchRequest_StringValue{Value: "c"}},
+		},
+	} {
+		params := []*wordsearcher.SearchRequest_SearchParam{
+			minMaxParam(wordsearcher.SearchRequest_LENGTH, 4, 4), hooks}
+		_, err := NewQueryGen("NWL23", AlphagramsOnly, params, 950, cfg).Generate()
+		assert.Nil(t, err)
+		// A C hook can't be told from a CH hook in the stored hooks.
+		_, err = NewQueryGen("FISE2", AlphagramsOnly, params, 950, cfg).Generate()
+		assert.ErrorContains(t, err, "multi-character tiles")
+	}
+}
@@ -0,0 +1,163 @@
+// Package ratelimit has a simple token-bucket rate limiter that can be
+// put in front of the Twirp handlers.
+package ratelimit
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/twitchtv/twirp"
+)
+
+// maxBuckets is how many keys we track before pruning buckets that
+// have refilled completely (and thus carry no state worth keeping).
+const maxBuckets = 10000
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// Limiter is a token-bucket rate limiter keyed by an arbitrary string,
+// such as an IP address or an API key.
+type Limiter struct {
+	mu      sync.Mutex
+	rate    float64 // tokens per second
+	burst   float64
+	buckets map[string]*bucket
+	now     func() time.Time
+}
+
+// New creates a Limiter that allows `perMinute` requests per minute per key,
+// with bursts of up to `burst` requests.
+func New(perMinute int, burst int) *Limiter {
+	return &Limiter{
+		rate:    float64(perMinute) / 60.0,
+		burst:   float64(burst),
+		buckets: map[string]*bucket{},
+		now:     time.Now,
+	}
+}
+
+// Allow returns true if the key has a token available, and consumes it.
+func (l *Limiter) Allow(key string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	b, ok := l.buckets[key]
+	if !ok {
+		if len(l.buckets) >= maxBuckets {
+			l.prune(now)
+		}
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+	b.tokens += now.Sub(b.last).Seconds() * l.rate
+	if b.tokens > l.burst {
+		b.tokens = l.burst
+	}
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+func (l *Limiter) prune(now time.Time) {
+	for k, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*l.rate >= l.burst {
+			delete(l.buckets, k)
+		}
+	}
+}
+
+// Middleware rate-limits requests to the given handler. keyFunc picks the
+// key to limit on for each request.
+func (l *Limiter) Middleware(keyFunc func(*http.Request) string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := keyFunc(r)
+		if !l.Allow(key) {
+			log.Debug().Str("key", key).Str("path", r.URL.Path).Msg("rate-limited")
+			twirp.WriteError(w, twirp.NewError(twirp.ResourceExhausted, "rate limit exceeded"))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Proxies are the proxies in front of the server whose X-Forwarded-For
+// headers can be believed.
+type Proxies []netip.Prefix
+
+// ParseProxies parses a comma-separated list of proxy addresses and CIDR
+// ranges.
+func ParseProxies(s string) (Proxies, error) {
+	var ps Proxies
+	for _, f := range strings.Split(s, ",") {
+		f = strings.TrimSpace(f)
+		if f == "" {
+			continue
+		}
+		if !strings.Contains(f, "/") {
+			addr, err := netip.ParseAddr(f)
+			if err != nil {
+				return nil, fmt.Errorf("bad proxy %q: %w", f, err)
+			}
+			ps = append(ps, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(f)
+		if err != nil {
+			return nil, fmt.Errorf("bad proxy %q: %w", f, err)
+		}
+		ps = append(ps, prefix.Masked())
+	}
+	return ps, nil
+}
+
+func (ps Proxies) trusts(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, p := range ps {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoteIP returns the IP address of the client. X-Forwarded-For is only
+// read when the request came from a trusted proxy, and then the client is
+// the right-most address in it that isn't a trusted proxy; the entries to
+// its left could have been made up by the client.
+func (ps Proxies) RemoteIP(r *http.Request) string {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+	if !ps.trusts(ip) {
+		return ip
+	}
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop == "" {
+			continue
+		}
+		ip = hop
+		if !ps.trusts(hop) {
+			break
+		}
+	}
+	return ip
+}
@@ -0,0 +1,92 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllow(t *testing.T) {
+	now := time.Unix(1000, 0)
+	l := New(60, 2)
+	l.now = func() time.Time { return now }
+
+	assert.True(t, l.Allow("a"))
+	assert.True(t, l.Allow("a"))
+	assert.False(t, l.Allow("a"))
+	// Other keys have their own bucket.
+	assert.True(t, l.Allow("b"))
+
+	now = now.Add(time.Second)
+	assert.True(t, l.Allow("a"))
+	assert.False(t, l.Allow("a"))
+
+	// Buckets never fill up past the burst size.
+	now = now.Add(time.Hour)
+	assert.True(t, l.Allow("a"))
+	assert.True(t, l.Allow("a"))
+	assert.False(t, l.Allow("a"))
+}
+
+func TestMiddleware(t *testing.T) {
+	l := New(1, 1)
+	proxies, err := ParseProxies("10.0.0.0/24")
+	assert.Nil(t, err)
+	h := l.Middleware(proxies.RemoteIP, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	req := httptest.NewRequest("POST", "/twirp/foo", nil)
+	req.RemoteAddr = "10.0.0.2:4321"
+	req.Header.Set("X-Forwarded-For", "10.1.2.3, 10.0.0.1")
+	assert.Equal(t, "10.1.2.3", proxies.RemoteIP(req))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+}
+
+func TestRemoteIP(t *testing.T) {
+	proxies, err := ParseProxies(" 10.0.0.0/24, 192.168.1.1,")
+	assert.Nil(t, err)
+	assert.Len(t, proxies, 2)
+	_, err = ParseProxies("10.0.0.0/99")
+	assert.NotNil(t, err)
+	_, err = ParseProxies("proxy")
+	assert.NotNil(t, err)
+
+	for _, tc := range []struct {
+		remote, fwd, want string
+	}{
+		// Clients that aren't trusted proxies can't pick their IP.
+		{"1.2.3.4:5555", "5.6.7.8", "1.2.3.4"},
+		{"1.2.3.4:5555", "", "1.2.3.4"},
+		{"192.168.1.1:80", "", "192.168.1.1"},
+		// A spoofed left-most entry is passed over for the right-most
+		// hop that isn't a trusted proxy.
+		{"192.168.1.1:80", "9.9.9.9, 1.2.3.4", "1.2.3.4"},
+		{"192.168.1.1:80", "9.9.9.9, 1.2.3.4, 10.0.0.7", "1.2.3.4"},
+		// If every hop is a proxy, the left-most one is the client.
+		{"192.168.1.1:80", "10.0.0.3, 10.0.0.7", "10.0.0.3"},
+		{"[::1]:80", "5.6.7.8", "::1"},
+	} {
+		req := httptest.NewRequest("GET", "/", nil)
+		req.RemoteAddr = tc.remote
+		if tc.fwd != "" {
+			req.Header.Set("X-Forwarded-For", tc.fwd)
+		}
+		assert.Equal(t, tc.want, proxies.RemoteIP(req), tc)
+	}
+
+	// Without trusted proxies, the header is ignored.
+	req := httptest.NewRequest("GET", "/", nil)
+	req.RemoteAddr = "10.0.0.2:4321"
+	req.Header.Set("X-Forwarded-For", "1.2.3.4")
+	assert.Equal(t, "10.0.0.2", Proxies(nil).RemoteIP(req))
+}
@@ -0,0 +1,183 @@
+// Package reqlog logs each Twirp RPC with zerolog: its method, lexicon,
+// search conditions, how many rows it returned, and how long it took.
+// Successful requests can be sampled, to keep the logs of a busy server
+// small; failed ones are always logged.
+package reqlog
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/word_db_server/internal/apikeys"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// A Logger logs the requests of the Twirp servers it's an option of.
+type Logger struct {
+	level zerolog.Level
+	// sample is n to log 1 in n successful requests.
+	sample int64
+	count  atomic.Int64
+	logger zerolog.Logger
+}
+
+// New returns a Logger that logs successful requests at the named level
+// (such as info or debug, or disabled for none), 1 in every sample of
+// them. Failed requests are logged at warn, or at the level if it's
+// higher, unless the level is disabled.
+func New(level string, sample int) (*Logger, error) {
+	lvl, err := zerolog.ParseLevel(strings.ToLower(level))
+	if err != nil {
+		return nil, err
+	}
+	if sample < 1 {
+		sample = 1
+	}
+	return &Logger{level: lvl, sample: int64(sample), logger: log.Logger}, nil
+}
+
+type entryKey struct{}
+
+// An entry is what's known of a request so far.
+type entry struct {
+	start   time.Time
+	lexicon string
+	// conditions summarizes a search's conditions.
+	conditions string
+	expand     bool
+	rows       int
+	words      int
+	counted    bool
+	err        twirp.Error
+}
+
+// ServerOption returns the option that makes a Twirp server log its
+// requests. It should come after the server's other interceptors, which
+// may change the response before it's sent.
+func (l *Logger) ServerOption() twirp.ServerOption {
+	return func(opts *twirp.ServerOptions) {
+		twirp.WithServerHooks(l.hooks())(opts)
+		twirp.WithServerInterceptors(l.intercept)(opts)
+	}
+}
+
+func (l *Logger) hooks() *twirp.ServerHooks {
+	return &twirp.ServerHooks{
+		RequestReceived: func(ctx context.Context) (context.Context, error) {
+			return context.WithValue(ctx, entryKey{}, &entry{start: time.Now()}), nil
+		},
+		Error: func(ctx context.Context, err twirp.Error) context.Context {
+			if e, ok := ctx.Value(entryKey{}).(*entry); ok {
+				e.err = err
+			}
+			return ctx
+		},
+		ResponseSent: func(ctx context.Context) {
+			if e, ok := ctx.Value(entryKey{}).(*entry); ok {
+				l.log(ctx, e)
+			}
+		},
+	}
+}
+
+func (l *Logger) intercept(next twirp.Method) twirp.Method {
+	return func(ctx context.Context, req any) (any, error) {
+		e, ok := ctx.Value(entryKey{}).(*entry)
+		if !ok {
+			return next(ctx, req)
+		}
+		e.describeRequest(req)
+		resp, err := next(ctx, req)
+		if err == nil {
+			e.countResponse(resp)
+		}
+		return resp, err
+	}
+}
+
+type lexiconGetter interface {
+	GetLexicon() string
+}
+
+func (e *entry) describeRequest(req any) {
+	switch r := req.(type) {
+	case *pb.SearchRequest:
+		conds := make([]string, 0, len(r.Searchparams))
+		for _, p := range r.Searchparams {
+			if p.Condition == pb.SearchRequest_LEXICON {
+				e.lexicon = p.GetStringvalue().GetValue()
+				continue
+			}
+			conds = append(conds, p.Condition.String())
+		}
+		e.conditions = strings.Join(conds, ",")
+		e.expand = r.Expand
+	case lexiconGetter:
+		e.lexicon = r.GetLexicon()
+	}
+}
+
+func (e *entry) countResponse(resp any) {
+	e.counted = true
+	switch r := resp.(type) {
+	case *pb.SearchResponse:
+		e.rows = len(r.Alphagrams)
+		for _, a := range r.Alphagrams {
+			e.words += len(a.Words)
+		}
+	case *pb.AnagramResponse:
+		e.rows = len(r.Words)
+	case *pb.WordSearchResponse:
+		e.rows = len(r.Words)
+	case *pb.WordJudgeResponse:
+		e.rows = len(r.Words)
+	default:
+		e.counted = false
+	}
+}
+
+func (l *Logger) log(ctx context.Context, e *entry) {
+	if l.level == zerolog.Disabled {
+		return
+	}
+	level := l.level
+	if e.err != nil {
+		level = max(level, zerolog.WarnLevel)
+	} else if l.count.Add(1)%l.sample != 0 {
+		return
+	}
+	ev := l.logger.WithLevel(level)
+	service, _ := twirp.ServiceName(ctx)
+	method, _ := twirp.MethodName(ctx)
+	status, _ := twirp.StatusCode(ctx)
+	ev = ev.Str("service", service).Str("method", method).Str("status", status).
+		Dur("duration", time.Since(e.start))
+	if k := apikeys.FromContext(ctx); k != nil {
+		ev = ev.Str("api_key", k.Name)
+	}
+	if e.lexicon != "" {
+		ev = ev.Str("lexicon", e.lexicon)
+	}
+	if e.conditions != "" {
+		ev = ev.Str("conditions", e.conditions).Bool("expand", e.expand)
+	}
+	if e.counted {
+		ev = ev.Int("rows", e.rows)
+		if e.words > 0 {
+			ev = ev.Int("words", e.words)
+		}
+	}
+	if e.err != nil {
+		ev = ev.Str("code", string(e.err.Code())).Str("error", e.err.Msg())
+	}
+	if e.err == nil && l.sample > 1 {
+		ev = ev.Int64("sampled", l.sample)
+	}
+	ev.Msg("rpc")
+}
@@ -0,0 +1,104 @@
+package reqlog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/twitchtv/twirp"
+
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+type judge struct {
+	pb.Anagrammer
+}
+
+func (judge) Judge(ctx context.Context, req *pb.WordJudgeRequest) (*pb.WordJudgeResponse, error) {
+	if len(req.Words) == 0 {
+		return nil, twirp.RequiredArgumentError("words")
+	}
+	resp := &pb.WordJudgeResponse{}
+	for _, w := range req.Words {
+		resp.Words = append(resp.Words, &pb.WordJudgeResponse_JudgedWord{Word: w})
+	}
+	return resp, nil
+}
+
+func logLines(buf *bytes.Buffer) []map[string]any {
+	lines := []map[string]any{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		m := map[string]any{}
+		json.Unmarshal([]byte(line), &m)
+		lines = append(lines, m)
+	}
+	buf.Reset()
+	return lines
+}
+
+func TestLogger(t *testing.T) {
+	l, err := New("INFO", 2)
+	assert.Nil(t, err)
+	var buf bytes.Buffer
+	l.logger = zerolog.New(&buf)
+	srv := httptest.NewServer(pb.NewAnagrammerServer(judge{}, l.ServerOption()))
+	defer srv.Close()
+	client := pb.NewAnagrammerProtobufClient(srv.URL, http.DefaultClient)
+	ctx := context.Background()
+	req := &pb.WordJudgeRequest{Lexicon: "NWL20", Words: []string{"QI", "ZA"}}
+
+	// 1 in 2 successful requests is logged.
+	_, err = client.Judge(ctx, req)
+	assert.Nil(t, err)
+	assert.Empty(t, logLines(&buf))
+	_, err = client.Judge(ctx, req)
+	assert.Nil(t, err)
+	lines := logLines(&buf)
+	assert.Len(t, lines, 1)
+	assert.Equal(t, "info", lines[0]["level"])
+	assert.Equal(t, "Judge", lines[0]["method"])
+	assert.Equal(t, "Anagrammer", lines[0]["service"])
+	assert.Equal(t, "NWL20", lines[0]["lexicon"])
+	assert.Equal(t, float64(2), lines[0]["rows"])
+	assert.Equal(t, "200", lines[0]["status"])
+	assert.Contains(t, lines[0], "duration")
+
+	// Errors always are.
+	_, err = client.Judge(ctx, &pb.WordJudgeRequest{Lexicon: "NWL20"})
+	assert.NotNil(t, err)
+	lines = logLines(&buf)
+	assert.Len(t, lines, 1)
+	assert.Equal(t, "warn", lines[0]["level"])
+	assert.Equal(t, "invalid_argument", lines[0]["code"])
+	assert.NotContains(t, lines[0], "rows")
+
+	_, err = New("loud", 1)
+	assert.NotNil(t, err)
+}
+
+func TestDescribeSearch(t *testing.T) {
+	e := &entry{}
+	e.describeRequest(&pb.SearchRequest{Expand: true, Searchparams: []*pb.SearchRequest_SearchParam{
+		{Condition: pb.SearchRequest_LEXICON, Conditionparam: &pb.SearchRequest_SearchParam_Stringvalue{
+			Stringvalue: &pb.SearchRequest_StringValue{Value: "CSW21"}}},
+		{Condition: pb.SearchRequest_LENGTH},
+		{Condition: pb.SearchRequest_PROBABILITY_RANGE},
+	}})
+	assert.Equal(t, "CSW21", e.lexicon)
+	assert.Equal(t, "LENGTH,PROBABILITY_RANGE", e.conditions)
+	assert.True(t, e.expand)
+
+	e.countResponse(&pb.SearchResponse{Alphagrams: []*pb.Alphagram{
+		{Words: []*pb.Word{{}, {}}}, {Words: []*pb.Word{{}}}}})
+	assert.Equal(t, 2, e.rows)
+	assert.Equal(t, 3, e.words)
+}
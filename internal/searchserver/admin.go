@@ -0,0 +1,85 @@
+package searchserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/word_db_server/config"
+	"github.com/domino14/word_db_server/internal/dbschema"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// AdminServer implements the Admin service. It must only be mounted behind
+// RequireAdminToken.
+type AdminServer struct {
+	Config *config.Config
+}
+
+// UpdateDefinitions applies definition corrections to a lexicon database.
+func (s *AdminServer) UpdateDefinitions(ctx context.Context, req *pb.DefinitionUpdateRequest) (
+	*pb.DefinitionUpdateResponse, error) {
+
+	if req.Lexicon == "" {
+		return nil, twirp.RequiredArgumentError("lexicon")
+	}
+	if req.Author == "" {
+		return nil, twirp.RequiredArgumentError("author")
+	}
+	if len(req.Updates) == 0 {
+		return nil, twirp.RequiredArgumentError("updates")
+	}
+	updates := map[string]string{}
+	for _, u := range req.Updates {
+		if u.Word == "" {
+			return nil, twirp.InvalidArgumentError("updates", "word must not be empty")
+		}
+		if _, ok := updates[u.Word]; ok {
+			return nil, twirp.InvalidArgumentError("updates", "word "+u.Word+" is repeated")
+		}
+		updates[u.Word] = u.Definition
+	}
+
+	// The full-text index of the definitions isn't loaded into Postgres.
+	if s.Config.WordDBDSN != "" {
+		return nil, errNotOnPostgres("updating definitions")
+	}
+	db, err := getDbConnection(s.Config, req.Lexicon)
+	if err != nil {
+		return nil, twirp.NotFoundError(err.Error())
+	}
+	defer db.Close()
+	if err := setWALAutoCheckpoint(ctx, db, s.Config); err != nil {
+		return nil, err
+	}
+
+	updated, notFound, err := dbschema.ApplyDefinitionUpdates(db, updates, req.Author)
+	if err != nil {
+		return nil, err
+	}
+	log.Info().Str("lexicon", req.Lexicon).Str("author", req.Author).
+		Int("updated", updated).Strs("notFound", notFound).Msg("definitions-updated")
+	return &pb.DefinitionUpdateResponse{
+		NumUpdated: int32(updated),
+		NotFound:   notFound,
+	}, nil
+}
+
+// RequireAdminToken only lets through requests with an
+// `Authorization: Bearer <token>` header matching the given token.
+func RequireAdminToken(token string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		given, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+			log.Warn().Str("remote", r.RemoteAddr).Str("path", r.URL.Path).
+				Msg("rejected admin request")
+			twirp.WriteError(w, twirp.NewError(twirp.Unauthenticated, "bad admin token"))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
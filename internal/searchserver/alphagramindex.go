@@ -0,0 +1,499 @@
+package searchserver
+
+import (
+	"container/list"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/word_db_server/config"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// AlphagramIndexStats counts what the index has done since it was created.
+type AlphagramIndexStats struct {
+	// Lexica are the lexica in memory, and Bytes roughly how much memory
+	// they take.
+	Lexica []string `json:"lexica"`
+	Bytes  int64    `json:"bytes"`
+	Budget int64    `json:"budget"`
+	Hits   int64    `json:"hits"`
+	// Misses counts searches of hot lexica that went to the database,
+	// because the lexicon wasn't loaded yet or the search can't be done
+	// from memory.
+	Misses    int64 `json:"misses"`
+	Loads     int64 `json:"loads"`
+	Evictions int64 `json:"evictions"`
+	// Invalidations counts lexica that were dropped because the database
+	// changed.
+	Invalidations int64 `json:"invalidations"`
+	// TooBig lists hot lexica that don't fit in the budget on their own.
+	TooBig []string `json:"too_big"`
+}
+
+type indexedWord struct {
+	word, lexiconSymbols, definition, frontHooks, backHooks string
+	innerFrontHook, innerBackHook                           bool
+	sources                                                 []string
+}
+
+type indexedAlphagram struct {
+	alphagram, displayAlphagram                                    string
+	probability, difficulty, playability, length, vowelProbability int32
+	combinations                                                   int64
+	words                                                          []indexedWord
+}
+
+// lexiconIndex is a lexicon's alphagrams, as its database had them when
+// its stamp was taken.
+type lexiconIndex struct {
+	lexicon string
+	stamp   string
+	bytes   int64
+	// sorted has the alphagrams in the order of a search by probability.
+	sorted      []*indexedAlphagram
+	byAlphagram map[string]*indexedAlphagram
+}
+
+// AlphagramIndex keeps the alphagrams of the most used lexica in memory, so
+// that the simplest searches, by length and probability or by a list of
+// alphagrams, don't query the database at all. Other searches, and those
+// of lexica that aren't hot, are done in SQL as usual.
+//
+// A hot lexicon is loaded in the background the first time it's searched
+// (or at startup, with Warm), and its searches go to the database until
+// it's loaded. When the loaded lexica take more than the memory budget,
+// the one least recently searched is dropped. A lexicon is dropped and
+// loaded again when its database or its WAL changes.
+type AlphagramIndex struct {
+	mu     sync.Mutex
+	hot    map[string]bool
+	order  []string
+	budget int64
+	bytes  int64
+	loaded map[string]*list.Element
+	// lru has the most recently searched lexicon at the front.
+	lru     *list.List
+	loading map[string]bool
+	// tooBig has the stamps of lexica that didn't fit in the budget, so
+	// they aren't loaded again until they change.
+	tooBig map[string]string
+	stats  AlphagramIndexStats
+	stamp  func(lexicon string) (string, error)
+	open   func(lexicon string) (*sql.DB, error)
+}
+
+// NewAlphagramIndex creates an AlphagramIndex for the config's hot lexica.
+// It returns nil, which is a valid AlphagramIndex that searches nothing in
+// memory, if there are none, or if the lexica are in Postgres.
+func NewAlphagramIndex(cfg *config.Config) *AlphagramIndex {
+	if cfg.WordDBDSN != "" {
+		return nil
+	}
+	hot := map[string]bool{}
+	order := []string{}
+	for _, l := range strings.Split(cfg.AlphagramIndexLexica, ",") {
+		if l = strings.TrimSpace(l); l != "" && !hot[l] {
+			hot[l] = true
+			order = append(order, l)
+		}
+	}
+	if len(hot) == 0 || cfg.AlphagramIndexMemoryMB <= 0 {
+		return nil
+	}
+	return &AlphagramIndex{
+		hot:     hot,
+		order:   order,
+		budget:  int64(cfg.AlphagramIndexMemoryMB) << 20,
+		loaded:  map[string]*list.Element{},
+		lru:     list.New(),
+		loading: map[string]bool{},
+		tooBig:  map[string]string{},
+		stamp: func(lexicon string) (string, error) {
+			store, err := lexiconStore(cfg)
+			if err != nil {
+				return "", err
+			}
+			return store.Stamp(context.Background(), lexicon)
+		},
+		open: func(lexicon string) (*sql.DB, error) {
+			return getDbConnection(cfg, lexicon)
+		},
+	}
+}
+
+// Warm loads all of the hot lexica, in the order of the config, until the
+// budget is full.
+func (x *AlphagramIndex) Warm() {
+	if x == nil {
+		return
+	}
+	for _, l := range x.order {
+		stamp, err := x.stamp(l)
+		if err != nil {
+			log.Err(err).Str("lexicon", l).Msg("could not index lexicon")
+			continue
+		}
+		x.mu.Lock()
+		_, loaded := x.loaded[l]
+		busy := loaded || x.loading[l]
+		if !busy {
+			x.loading[l] = true
+		}
+		x.mu.Unlock()
+		if !busy {
+			x.load(l, stamp)
+		}
+	}
+}
+
+// get returns the lexicon's index, or nil if it isn't loaded, starting to
+// load it if it's hot.
+func (x *AlphagramIndex) get(lexicon string) *lexiconIndex {
+	if x == nil || !x.hot[lexicon] {
+		return nil
+	}
+	stamp, err := x.stamp(lexicon)
+	if err != nil {
+		// The search will fail in the database with a better error.
+		return nil
+	}
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if el, ok := x.loaded[lexicon]; ok {
+		idx := el.Value.(*lexiconIndex)
+		if idx.stamp == stamp {
+			x.lru.MoveToFront(el)
+			return idx
+		}
+		x.stats.Invalidations++
+		x.remove(el)
+	}
+	if old, ok := x.tooBig[lexicon]; x.loading[lexicon] || (ok && old == stamp) {
+		return nil
+	}
+	x.loading[lexicon] = true
+	go x.load(lexicon, stamp)
+	return nil
+}
+
+// load reads the lexicon into memory and adds it to the index. The
+// lexicon must have been marked as loading.
+func (x *AlphagramIndex) load(lexicon, stamp string) {
+	start := time.Now()
+	idx, err := x.read(lexicon, stamp)
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	delete(x.loading, lexicon)
+	if err != nil {
+		log.Err(err).Str("lexicon", lexicon).Msg("could not index lexicon")
+		return
+	}
+	if idx.bytes > x.budget {
+		log.Warn().Str("lexicon", lexicon).Int64("bytes", idx.bytes).
+			Msg("lexicon is too big for the alphagram index")
+		x.tooBig[lexicon] = stamp
+		return
+	}
+	delete(x.tooBig, lexicon)
+	if el, ok := x.loaded[lexicon]; ok {
+		x.remove(el)
+	}
+	x.loaded[lexicon] = x.lru.PushFront(idx)
+	x.bytes += idx.bytes
+	x.stats.Loads++
+	for x.bytes > x.budget {
+		x.stats.Evictions++
+		x.remove(x.lru.Back())
+	}
+	log.Info().Str("lexicon", lexicon).Int("alphagrams", len(idx.sorted)).
+		Int64("bytes", idx.bytes).Dur("took", time.Since(start)).Msg("indexed lexicon")
+}
+
+// remove drops a lexicon. x.mu must be held.
+func (x *AlphagramIndex) remove(el *list.Element) {
+	idx := x.lru.Remove(el).(*lexiconIndex)
+	delete(x.loaded, idx.lexicon)
+	x.bytes -= idx.bytes
+}
+
+// read reads the lexicon's alphagrams and words from its database.
+func (x *AlphagramIndex) read(lexicon, stamp string) (*lexiconIndex, error) {
+	db, err := x.open(lexicon)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	idx := &lexiconIndex{lexicon: lexicon, stamp: stamp,
+		byAlphagram: map[string]*indexedAlphagram{}}
+
+	rows, err := db.Query(`SELECT alphagram, probability, combinations, difficulty,
+		display_alphagram, playability, length, vowel_probability FROM alphagrams`)
+	if err != nil {
+		return nil, err
+	}
+	var alphagram, display sql.NullString
+	var probability, difficulty, playability, length, vowelProbability sql.NullInt32
+	var combinations sql.NullInt64
+	for rows.Next() {
+		err := rows.Scan(&alphagram, &probability, &combinations, &difficulty,
+			&display, &playability, &length, &vowelProbability)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		a := &indexedAlphagram{
+			alphagram:        alphagram.String,
+			displayAlphagram: display.String,
+			probability:      probability.Int32,
+			combinations:     combinations.Int64,
+			difficulty:       difficulty.Int32,
+			playability:      playability.Int32,
+			length:           length.Int32,
+			vowelProbability: vowelProbability.Int32,
+		}
+		idx.sorted = append(idx.sorted, a)
+		idx.byAlphagram[a.alphagram] = a
+		idx.bytes += 96 + int64(len(a.alphagram)+len(a.displayAlphagram))
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// A search joins the words in the order of their rows.
+	rows, err = db.Query(`SELECT word, alphagram, lexicon_symbols, definition, front_hooks,
+		back_hooks, inner_front_hook, inner_back_hook, sources FROM words ORDER BY rowid`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var word, symbols, definition, front, back, sources sql.NullString
+	var innerFront, innerBack sql.NullBool
+	for rows.Next() {
+		err := rows.Scan(&word, &alphagram, &symbols, &definition, &front, &back,
+			&innerFront, &innerBack, &sources)
+		if err != nil {
+			return nil, err
+		}
+		a := idx.byAlphagram[alphagram.String]
+		if a == nil {
+			continue
+		}
+		w := indexedWord{
+			word:           word.String,
+			lexiconSymbols: symbols.String,
+			definition:     definition.String,
+			frontHooks:     front.String,
+			backHooks:      back.String,
+			innerFrontHook: innerFront.Bool,
+			innerBackHook:  innerBack.Bool,
+			sources:        splitSources(sources.String),
+		}
+		a.words = append(a.words, w)
+		idx.bytes += 112 + int64(len(w.word)+len(w.lexiconSymbols)+len(w.definition)+
+			len(w.frontHooks)+len(w.backHooks)+len(sources.String))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	// Alphagrams of different lengths can have the same probability.
+	sort.Slice(idx.sorted, func(i, j int) bool {
+		a, b := idx.sorted[i], idx.sorted[j]
+		if a.probability != b.probability {
+			return a.probability < b.probability
+		}
+		if a.length != b.length {
+			return a.length < b.length
+		}
+		return a.alphagram < b.alphagram
+	})
+	return idx, nil
+}
+
+// indexSearch is a search that can be done from memory.
+type indexSearch struct {
+	lengths, probabilities []*pb.SearchRequest_MinMax
+	limit                  *pb.SearchRequest_MinMax
+	list                   map[string]bool
+}
+
+// newIndexSearch returns the search of the request's conditions, or nil if
+// they can't be searched in memory. The request must have been validated.
+func newIndexSearch(req *pb.SearchRequest) *indexSearch {
+	if req.PageSize != 0 || req.Cursor != "" || req.SnapshotId != "" || req.PinSnapshot ||
+		req.SortOrder != pb.SearchRequest_SORT_PROBABILITY || len(req.PartialExpand) > 0 {
+		return nil
+	}
+	s := &indexSearch{}
+	for _, p := range req.Searchparams[1:] {
+		switch p.Condition {
+		case pb.SearchRequest_LENGTH:
+			s.lengths = append(s.lengths, p.GetMinmax())
+		case pb.SearchRequest_PROBABILITY_RANGE:
+			s.probabilities = append(s.probabilities, p.GetMinmax())
+		case pb.SearchRequest_PROBABILITY_LIMIT:
+			s.limit = p.GetMinmax()
+		case pb.SearchRequest_ALPHAGRAM_LIST:
+			values := p.GetStringarray().GetValues()
+			// A longer list is searched in chunks, each in its own order.
+			if s.list != nil || len(values) > MaxSQLChunkSize {
+				return nil
+			}
+			s.list = make(map[string]bool, len(values))
+			for _, v := range values {
+				s.list[v] = true
+			}
+		default:
+			return nil
+		}
+	}
+	if len(s.lengths) == 0 && s.list == nil {
+		return nil
+	}
+	return s
+}
+
+func (s *indexSearch) matches(a *indexedAlphagram) bool {
+	for _, mm := range s.lengths {
+		if a.length < mm.Min || a.length > mm.Max {
+			return false
+		}
+	}
+	for _, mm := range s.probabilities {
+		if a.probability < mm.Min || a.probability > mm.Max {
+			return false
+		}
+	}
+	return s.list == nil || s.list[a.alphagram]
+}
+
+// run returns the alphagrams that match, as the database would. If max is
+// more than 0, it returns no more than max of them, and whether it cut off
+// any others.
+func (s *indexSearch) run(idx *lexiconIndex, expand bool, max int) ([]*pb.Alphagram, bool) {
+	offset, limit := 0, -1
+	if s.limit != nil {
+		offset, limit = int(s.limit.Min-1), int(s.limit.Max-s.limit.Min+1)
+	}
+	alphagrams := []*pb.Alphagram{}
+	for _, a := range idx.sorted {
+		if limit == 0 {
+			break
+		}
+		if !s.matches(a) {
+			continue
+		}
+		if offset > 0 {
+			offset--
+			continue
+		}
+		limit--
+		if max > 0 && len(alphagrams) == max {
+			return alphagrams, true
+		}
+		alphagrams = append(alphagrams, a.toPB(expand))
+	}
+	return alphagrams, false
+}
+
+// toPB returns the alphagram as a search returns it. It's a new message,
+// which the caller may modify.
+func (a *indexedAlphagram) toPB(expand bool) *pb.Alphagram {
+	alpha := &pb.Alphagram{
+		Alphagram:    a.alphagram,
+		Probability:  a.probability,
+		Length:       a.length,
+		ExpandedRepr: expand,
+		Words:        make([]*pb.Word, len(a.words)),
+	}
+	if expand {
+		alpha.Combinations = a.combinations
+		alpha.Difficulty = a.difficulty
+		alpha.DisplayAlphagram = a.displayAlphagram
+		alpha.Playability = a.playability
+		alpha.VowelProbability = a.vowelProbability
+	}
+	for i, w := range a.words {
+		if !expand {
+			alpha.Words[i] = &pb.Word{Word: w.word}
+			continue
+		}
+		alpha.Words[i] = &pb.Word{
+			Word:           w.word,
+			Alphagram:      a.alphagram,
+			Definition:     w.definition,
+			FrontHooks:     w.frontHooks,
+			BackHooks:      w.backHooks,
+			LexiconSymbols: w.lexiconSymbols,
+			InnerFrontHook: w.innerFrontHook,
+			InnerBackHook:  w.innerBackHook,
+			Sources:        w.sources,
+		}
+	}
+	return alpha
+}
+
+// search does the search from memory, if it can. It returns whether it
+// did.
+func (x *AlphagramIndex) search(req *pb.SearchRequest, lexicon string, max int) (
+	[]*pb.Alphagram, bool, bool) {
+
+	if x == nil || !x.hot[lexicon] {
+		return nil, false, false
+	}
+	s := newIndexSearch(req)
+	var idx *lexiconIndex
+	if s != nil {
+		idx = x.get(lexicon)
+	}
+	x.mu.Lock()
+	if idx == nil {
+		x.stats.Misses++
+	} else {
+		x.stats.Hits++
+	}
+	x.mu.Unlock()
+	if idx == nil {
+		return nil, false, false
+	}
+	alphagrams, truncated := s.run(idx, req.Expand, max)
+	return alphagrams, truncated, true
+}
+
+// Stats returns the index's counters.
+func (x *AlphagramIndex) Stats() AlphagramIndexStats {
+	if x == nil {
+		return AlphagramIndexStats{}
+	}
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	st := x.stats
+	st.Lexica = []string{}
+	for el := x.lru.Front(); el != nil; el = el.Next() {
+		st.Lexica = append(st.Lexica, el.Value.(*lexiconIndex).lexicon)
+	}
+	st.TooBig = []string{}
+	for l := range x.tooBig {
+		st.TooBig = append(st.TooBig, l)
+	}
+	sort.Strings(st.TooBig)
+	st.Bytes = x.bytes
+	st.Budget = x.budget
+	return st
+}
+
+// StatsHandler serves the index's counters as JSON.
+func (x *AlphagramIndex) StatsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(x.Stats())
+	})
+}
@@ -0,0 +1,103 @@
+package searchserver
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/domino14/word_db_server/config"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestAlphagramIndexMatchesSQL(t *testing.T) {
+	dataPath := makeExpandLexicon(t)
+	cfg := &config.Config{DataPath: dataPath, AlphagramIndexLexica: "FOO",
+		AlphagramIndexMemoryMB: 1}
+	index := NewAlphagramIndex(cfg)
+	index.Warm()
+	indexed := &Server{Config: cfg, AlphagramIndex: index}
+	plain := &Server{Config: cfg}
+
+	searches := [][]*pb.SearchRequest_SearchParam{
+		{SearchDescLexicon("FOO"), SearchDescLength(2, 3)},
+		{SearchDescLexicon("FOO"), SearchDescLength(2, 2), SearchDescProbRange(2, 5)},
+		{SearchDescLexicon("FOO"), SearchDescLength(2, 3), SearchDescProbLimit(2, 2)},
+		{SearchDescLexicon("FOO"), SearchDescAlphagramList([]string{"EOV", "IQ", "XYZ"})},
+	}
+	for _, params := range searches {
+		for _, expand := range []bool{false, true} {
+			want, err := plain.Search(context.Background(), WordSearch(params, expand))
+			assert.Nil(t, err)
+			got, err := indexed.Search(context.Background(), WordSearch(params, expand))
+			assert.Nil(t, err)
+			assert.Equal(t, want.String(), got.String())
+		}
+	}
+	st := index.Stats()
+	assert.Equal(t, int64(len(searches)*2), st.Hits)
+	assert.Equal(t, []string{"FOO"}, st.Lexica)
+
+	// Other searches go to the database.
+	resp, err := indexed.Search(context.Background(), WordSearch([]*pb.SearchRequest_SearchParam{
+		SearchDescLexicon("FOO"), SearchDescLength(2, 3), SearchDescDifficultyRange(0, 0)}, false))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(resp.Alphagrams))
+	assert.Equal(t, int64(1), index.Stats().Misses)
+}
+
+func TestAlphagramIndexInvalidation(t *testing.T) {
+	dataPath := makeExpandLexicon(t)
+	cfg := &config.Config{DataPath: dataPath, AlphagramIndexLexica: "FOO",
+		AlphagramIndexMemoryMB: 1}
+	index := NewAlphagramIndex(cfg)
+	index.Warm()
+	assert.NotNil(t, index.get("FOO"))
+
+	db, err := sql.Open("sqlite3", filepath.Join(dataPath, "lexica", "db", "FOO.db"))
+	assert.Nil(t, err)
+	defer db.Close()
+	_, err = db.Exec(`UPDATE words SET definition = 'a pie' WHERE word = 'ZA'`)
+	assert.Nil(t, err)
+	// It's loaded again in the background.
+	assert.Nil(t, index.get("FOO"))
+	assert.Equal(t, int64(1), index.Stats().Invalidations)
+	assert.Eventually(t, func() bool { return index.get("FOO") != nil }, time.Second,
+		10*time.Millisecond)
+	resp, err := (&Server{Config: cfg, AlphagramIndex: index}).Search(context.Background(),
+		WordSearch([]*pb.SearchRequest_SearchParam{SearchDescLexicon("FOO"),
+			SearchDescAlphagramList([]string{"AZ"})}, true))
+	assert.Nil(t, err)
+	assert.Equal(t, "a pie", resp.Alphagrams[0].Words[0].Definition)
+}
+
+func TestAlphagramIndexBudget(t *testing.T) {
+	cfg := &config.Config{DataPath: makeExpandLexicon(t), AlphagramIndexLexica: "FOO",
+		AlphagramIndexMemoryMB: 1}
+	index := NewAlphagramIndex(cfg)
+	// Too small for even this lexicon.
+	index.budget = 100
+	index.Warm()
+	st := index.Stats()
+	assert.Equal(t, []string{}, st.Lexica)
+	assert.Equal(t, []string{"FOO"}, st.TooBig)
+	// It isn't loaded again until it changes.
+	assert.Nil(t, index.get("FOO"))
+	assert.Equal(t, 0, len(index.loading))
+}
+
+func TestNewAlphagramIndex(t *testing.T) {
+	assert.Nil(t, NewAlphagramIndex(&config.Config{AlphagramIndexMemoryMB: 512}))
+	assert.Nil(t, NewAlphagramIndex(&config.Config{AlphagramIndexLexica: "NWL23"}))
+	assert.Nil(t, NewAlphagramIndex(&config.Config{AlphagramIndexLexica: "NWL23",
+		AlphagramIndexMemoryMB: 512, WordDBDSN: "postgres://localhost/words"}))
+	x := NewAlphagramIndex(&config.Config{AlphagramIndexLexica: "NWL23, CSW21,NWL23",
+		AlphagramIndexMemoryMB: 512})
+	assert.Equal(t, []string{"NWL23", "CSW21"}, x.order)
+
+	var none *AlphagramIndex
+	assert.Equal(t, AlphagramIndexStats{}, none.Stats())
+}
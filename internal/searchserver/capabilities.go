@@ -0,0 +1,60 @@
+package searchserver
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/word_db_server/internal/querygen"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// lexiconCapabilities returns which optional data the lexicon database
+// has. It returns nil if the database predates the capabilities table,
+// in which case we don't know and shouldn't block anything.
+func lexiconCapabilities(db *sql.DB) (map[string]bool, error) {
+	rows, err := db.Query(`SELECT name, enabled FROM capabilities`)
+	if err != nil {
+		if strings.Contains(err.Error(), "no such table") {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer rows.Close()
+	caps := map[string]bool{}
+	for rows.Next() {
+		var name string
+		var enabled bool
+		if err := rows.Scan(&name, &enabled); err != nil {
+			return nil, err
+		}
+		caps[name] = enabled
+	}
+	return caps, rows.Err()
+}
+
+// checkCapabilities returns an error if any of the search params needs
+// data that the lexicon doesn't have. Otherwise those searches would
+// quietly return nothing.
+func checkCapabilities(lexName string, params []*pb.SearchRequest_SearchParam,
+	caps map[string]bool) error {
+	if caps == nil {
+		return nil
+	}
+	for _, p := range params {
+		if p.Condition == pb.SearchRequest_COMBINATOR {
+			if err := checkCapabilities(lexName, p.GetCombinator().GetParams(), caps); err != nil {
+				return err
+			}
+			continue
+		}
+		info := querygen.Condition(p.Condition)
+		if info != nil && info.Capability != "" && !caps[info.Capability] {
+			return twirp.NewError(twirp.FailedPrecondition,
+				fmt.Sprintf("lexicon %v has no %v data", lexName, info.Capability))
+		}
+	}
+	return nil
+}
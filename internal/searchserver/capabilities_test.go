@@ -0,0 +1,53 @@
+package searchserver
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestCheckCapabilities(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.Nil(t, err)
+	defer db.Close()
+
+	// A database without a capabilities table doesn't block anything.
+	caps, err := lexiconCapabilities(db)
+	assert.Nil(t, err)
+	assert.Nil(t, caps)
+	params := []*pb.SearchRequest_SearchParam{
+		SearchDescLexicon("FOO"),
+		SearchDescDifficultyRange(1, 50),
+	}
+	assert.Nil(t, checkCapabilities("FOO", params, caps))
+
+	_, err = db.Exec(`CREATE TABLE capabilities (name varchar(32) PRIMARY KEY, enabled int);
+		INSERT INTO capabilities VALUES('difficulty', 0), ('playability', 1);`)
+	assert.Nil(t, err)
+	caps, err = lexiconCapabilities(db)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]bool{"difficulty": false, "playability": true}, caps)
+
+	assert.NotNil(t, checkCapabilities("FOO", params, caps))
+	assert.Nil(t, checkCapabilities("FOO", []*pb.SearchRequest_SearchParam{
+		SearchDescLexicon("FOO"),
+		SearchDescPlayabilityRange(1, 50),
+		SearchDescLength(7, 7),
+	}, caps))
+	// Conditions in combinators are checked too.
+	assert.NotNil(t, checkCapabilities("FOO", []*pb.SearchRequest_SearchParam{
+		SearchDescLexicon("FOO"),
+		SearchDescCombinator(pb.SearchRequest_Combinator_OR,
+			SearchDescLength(7, 7),
+			SearchDescCombinator(pb.SearchRequest_Combinator_NOT,
+				SearchDescDifficultyRange(1, 50))),
+	}, caps))
+	// Databases made before blank anagrams were counted don't list them.
+	assert.NotNil(t, checkCapabilities("FOO", []*pb.SearchRequest_SearchParam{
+		SearchDescLexicon("FOO"),
+		SearchDescNumBlankAnagrams(5, 100),
+	}, caps))
+}
@@ -0,0 +1,179 @@
+package searchserver
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/word_db_server/config"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// checkpoint writes the database's WAL back into the database. It does
+// nothing if the database isn't in WAL mode.
+func checkpoint(ctx context.Context, db *sql.DB, mode pb.CheckpointRequest_Mode) (
+	*pb.CheckpointResponse, error) {
+
+	name, ok := pb.CheckpointRequest_Mode_name[int32(mode)]
+	if !ok {
+		return nil, fmt.Errorf("unknown checkpoint mode %v", mode)
+	}
+	var journalMode string
+	if err := db.QueryRowContext(ctx, `PRAGMA journal_mode`).Scan(&journalMode); err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(journalMode, "wal") {
+		return &pb.CheckpointResponse{}, nil
+	}
+	resp := &pb.CheckpointResponse{Wal: true}
+	err := db.QueryRowContext(ctx, "PRAGMA wal_checkpoint("+name+")").Scan(
+		&resp.Busy, &resp.LogFrames, &resp.CheckpointedFrames)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// setWALAutoCheckpoint limits db to one connection and sets its
+// wal_autocheckpoint, as configured, for a caller that is about to write to
+// it. It does nothing if the config leaves SQLite's default.
+func setWALAutoCheckpoint(ctx context.Context, db *sql.DB, cfg *config.Config) error {
+	if cfg.WALAutoCheckpoint == 0 {
+		return nil
+	}
+	// Pragmas only apply to the connection they're run on.
+	db.SetMaxOpenConns(1)
+	_, err := db.ExecContext(ctx, fmt.Sprintf("PRAGMA wal_autocheckpoint = %d",
+		max(cfg.WALAutoCheckpoint, 0)))
+	return err
+}
+
+// Checkpoint writes a lexicon database's WAL back into the database.
+func (s *AdminServer) Checkpoint(ctx context.Context, req *pb.CheckpointRequest) (
+	*pb.CheckpointResponse, error) {
+
+	if req.Lexicon == "" {
+		return nil, twirp.RequiredArgumentError("lexicon")
+	}
+	if s.Config.WordDBDSN != "" {
+		return nil, errNotOnPostgres("checkpointing")
+	}
+	db, err := getDbConnection(s.Config, req.Lexicon)
+	if err != nil {
+		return nil, twirp.NotFoundError(err.Error())
+	}
+	defer db.Close()
+	resp, err := checkpoint(ctx, db, req.Mode)
+	if err != nil {
+		return nil, err
+	}
+	log.Info().Str("lexicon", req.Lexicon).Str("mode", req.Mode.String()).
+		Bool("wal", resp.Wal).Bool("busy", resp.Busy).Int32("logFrames", resp.LogFrames).
+		Int32("checkpointed", resp.CheckpointedFrames).Msg("checkpointed")
+	return resp, nil
+}
+
+// ParseCheckpointMode parses the name of a checkpoint mode, as given on the
+// command line.
+func ParseCheckpointMode(name string) (pb.CheckpointRequest_Mode, error) {
+	mode, ok := pb.CheckpointRequest_Mode_value[strings.ToUpper(name)]
+	if !ok {
+		return 0, fmt.Errorf("unknown checkpoint mode %q (expected passive, full, restart or truncate)", name)
+	}
+	return pb.CheckpointRequest_Mode(mode), nil
+}
+
+// Checkpointer periodically checkpoints the lexicon databases that have a
+// WAL, so that it doesn't keep growing between maintenance operations and
+// slowing down reads. SQLite's automatic checkpoints can't always finish
+// while there are readers, and they don't happen at all once the writes
+// stop.
+type Checkpointer struct {
+	dir      string
+	mode     pb.CheckpointRequest_Mode
+	interval time.Duration
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewCheckpointer starts checkpointing the lexica in the config's data
+// path. It returns nil, which is a valid Checkpointer that does nothing,
+// if the config doesn't ask for periodic checkpoints or the lexica are in
+// Postgres.
+func NewCheckpointer(cfg *config.Config) (*Checkpointer, error) {
+	if cfg.WALCheckpointInterval <= 0 || cfg.WordDBDSN != "" {
+		return nil, nil
+	}
+	mode, err := ParseCheckpointMode(cfg.WALCheckpointMode)
+	if err != nil {
+		return nil, err
+	}
+	c := &Checkpointer{
+		dir:      filepath.Join(cfg.DataPath, "lexica", "db"),
+		mode:     mode,
+		interval: cfg.WALCheckpointInterval,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	go c.run()
+	return c, nil
+}
+
+func (c *Checkpointer) run() {
+	defer close(c.done)
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C:
+			c.checkpointAll(context.Background())
+		}
+	}
+}
+
+// checkpointAll checkpoints every database in the directory that has a
+// non-empty WAL.
+func (c *Checkpointer) checkpointAll(ctx context.Context) {
+	wals, err := filepath.Glob(filepath.Join(c.dir, "*.db-wal"))
+	if err != nil {
+		log.Error().Err(err).Msg("could not list WAL files")
+		return
+	}
+	for _, wal := range wals {
+		if fi, err := os.Stat(wal); err != nil || fi.Size() == 0 {
+			continue
+		}
+		path := strings.TrimSuffix(wal, "-wal")
+		db, err := sql.Open("sqlite3", path)
+		if err != nil {
+			log.Error().Err(err).Str("db", path).Msg("could not open db to checkpoint")
+			continue
+		}
+		resp, err := checkpoint(ctx, db, c.mode)
+		db.Close()
+		if err != nil {
+			log.Error().Err(err).Str("db", path).Msg("checkpoint failed")
+			continue
+		}
+		log.Debug().Str("db", path).Bool("busy", resp.Busy).Int32("logFrames", resp.LogFrames).
+			Int32("checkpointed", resp.CheckpointedFrames).Msg("checkpointed")
+	}
+}
+
+// Close stops the checkpoints.
+func (c *Checkpointer) Close() {
+	if c == nil {
+		return
+	}
+	close(c.stop)
+	<-c.done
+}
@@ -0,0 +1,100 @@
+package searchserver
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/domino14/word_db_server/config"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func walSize(t *testing.T, path string) int64 {
+	fi, err := os.Stat(path + "-wal")
+	assert.Nil(t, err)
+	return fi.Size()
+}
+
+func TestCheckpoint(t *testing.T) {
+	dataPath := t.TempDir()
+	dbDir := filepath.Join(dataPath, "lexica", "db")
+	assert.Nil(t, os.MkdirAll(dbDir, 0755))
+	path := filepath.Join(dbDir, "FOO.db")
+	// This stays open so that the WAL isn't checkpointed and removed when
+	// the last connection closes.
+	db, err := sql.Open("sqlite3", path)
+	assert.Nil(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+	_, err = db.Exec(`PRAGMA wal_autocheckpoint = 0;
+		PRAGMA journal_mode = WAL;
+		CREATE TABLE words (word varchar(20));
+		INSERT INTO words VALUES ('QI'), ('ZA');`)
+	assert.Nil(t, err)
+	assert.True(t, walSize(t, path) > 0)
+
+	cfg := &config.Config{DataPath: dataPath}
+	s := &AdminServer{Config: cfg}
+	resp, err := s.Checkpoint(context.Background(), &pb.CheckpointRequest{
+		Lexicon: "FOO", Mode: pb.CheckpointRequest_TRUNCATE})
+	assert.Nil(t, err)
+	assert.True(t, resp.Wal)
+	assert.False(t, resp.Busy)
+	assert.Equal(t, int64(0), walSize(t, path))
+
+	_, err = s.Checkpoint(context.Background(), &pb.CheckpointRequest{Lexicon: "BAR"})
+	assert.NotNil(t, err)
+
+	// The periodic checkpoints find the WAL by itself.
+	_, err = db.Exec(`INSERT INTO words VALUES ('XU')`)
+	assert.Nil(t, err)
+	assert.True(t, walSize(t, path) > 0)
+	cfg.WALCheckpointInterval = time.Hour
+	cfg.WALCheckpointMode = "truncate"
+	c, err := NewCheckpointer(cfg)
+	assert.Nil(t, err)
+	defer c.Close()
+	c.checkpointAll(context.Background())
+	assert.Equal(t, int64(0), walSize(t, path))
+}
+
+func TestCheckpointNotWAL(t *testing.T) {
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "FOO.db"))
+	assert.Nil(t, err)
+	defer db.Close()
+	_, err = db.Exec(`CREATE TABLE words (word varchar(20))`)
+	assert.Nil(t, err)
+	resp, err := checkpoint(context.Background(), db, pb.CheckpointRequest_FULL)
+	assert.Nil(t, err)
+	assert.False(t, resp.Wal)
+}
+
+func TestNewCheckpointer(t *testing.T) {
+	c, err := NewCheckpointer(&config.Config{})
+	assert.Nil(t, err)
+	assert.Nil(t, c)
+	c.Close()
+
+	_, err = NewCheckpointer(&config.Config{WALCheckpointInterval: time.Minute,
+		WALCheckpointMode: "eventually"})
+	assert.NotNil(t, err)
+}
+
+func TestSetWALAutoCheckpoint(t *testing.T) {
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "FOO.db"))
+	assert.Nil(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	var pages int
+	assert.Nil(t, setWALAutoCheckpoint(ctx, db, &config.Config{WALAutoCheckpoint: 250}))
+	assert.Nil(t, db.QueryRow(`PRAGMA wal_autocheckpoint`).Scan(&pages))
+	assert.Equal(t, 250, pages)
+	assert.Nil(t, setWALAutoCheckpoint(ctx, db, &config.Config{WALAutoCheckpoint: -1}))
+	assert.Nil(t, db.QueryRow(`PRAGMA wal_autocheckpoint`).Scan(&pages))
+	assert.Equal(t, 0, pages)
+}
@@ -0,0 +1,73 @@
+package searchserver
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/domino14/word_db_server/internal/querygen"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// cursorVersion prefixes every cursor, so that the format can change
+// without misreading old cursors.
+const cursorVersion = "1"
+
+var errBadCursor = errors.New("bad cursor")
+
+// encodeCursor encodes the place of an alphagram in the paged search
+// order. It holds everything needed to find the next page, so the server
+// doesn't keep any state for it.
+func encodeCursor(key querygen.PageKey) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%d:%d:%s",
+		cursorVersion, key.Length, key.Probability, key.Alphagram)))
+}
+
+func decodeCursor(cursor string) (*querygen.PageKey, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errBadCursor
+	}
+	fields := strings.SplitN(string(raw), ":", 4)
+	if len(fields) != 4 || fields[0] != cursorVersion {
+		return nil, errBadCursor
+	}
+	length, err := strconv.ParseInt(fields[1], 10, 32)
+	if err != nil {
+		return nil, errBadCursor
+	}
+	prob, err := strconv.ParseInt(fields[2], 10, 32)
+	if err != nil {
+		return nil, errBadCursor
+	}
+	return &querygen.PageKey{Length: int32(length), Probability: int32(prob),
+		Alphagram: fields[3]}, nil
+}
+
+func pageKey(a *pb.Alphagram) querygen.PageKey {
+	return querygen.PageKey{Length: a.Length, Probability: a.Probability, Alphagram: a.Alphagram}
+}
+
+// cutPage sorts the results of a paged search, which may come from several
+// queries, and cuts them to the page size. It returns the cursor for the
+// next page, if there is one.
+func cutPage(alphagrams []*pb.Alphagram, size int) ([]*pb.Alphagram, string) {
+	slices.SortStableFunc(alphagrams, func(a, b *pb.Alphagram) int {
+		ka, kb := pageKey(a), pageKey(b)
+		switch {
+		case ka.Less(kb):
+			return -1
+		case kb.Less(ka):
+			return 1
+		}
+		return 0
+	})
+	if len(alphagrams) <= size {
+		return alphagrams, ""
+	}
+	alphagrams = alphagrams[:size]
+	return alphagrams, encodeCursor(pageKey(alphagrams[size-1]))
+}
@@ -0,0 +1,58 @@
+package searchserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/domino14/word_db_server/config"
+	"github.com/domino14/word_db_server/internal/querygen"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestCursor(t *testing.T) {
+	key := querygen.PageKey{Length: 7, Probability: 1203, Alphagram: "A[CH]O[RR]"}
+	decoded, err := decodeCursor(encodeCursor(key))
+	assert.Nil(t, err)
+	assert.Equal(t, key, *decoded)
+
+	for _, bad := range []string{"", "!!", "MTo3OjEyMDM", "Mjo3OjEyMDM6QUI"} {
+		_, err := decodeCursor(bad)
+		assert.NotNil(t, err, bad)
+	}
+}
+
+func TestPagedSearch(t *testing.T) {
+	s := &Server{Config: &config.Config{DataPath: makeExpandLexicon(t)}}
+	page := func(size int32, cursor string, expand bool) *pb.SearchResponse {
+		resp, err := s.Search(context.Background(), &pb.SearchRequest{
+			Searchparams: []*pb.SearchRequest_SearchParam{
+				SearchDescLexicon("FOO"), SearchDescLength(2, 3)},
+			PageSize: size,
+			Cursor:   cursor,
+			Expand:   expand,
+		})
+		assert.Nil(t, err)
+		return resp
+	}
+	resp := page(2, "", false)
+	assert.Equal(t, []string{"IQ", "AZ"}, alphagrams(resp))
+	assert.NotEqual(t, "", resp.NextCursor)
+	resp = page(2, resp.NextCursor, false)
+	assert.Equal(t, []string{"EOV"}, alphagrams(resp))
+	assert.Equal(t, "", resp.NextCursor)
+
+	// Cursors don't depend on whether the results are expanded.
+	resp = page(1, "", true)
+	assert.Equal(t, []string{"IQ"}, alphagrams(resp))
+	resp = page(3, resp.NextCursor, false)
+	assert.Equal(t, []string{"AZ", "EOV"}, alphagrams(resp))
+	assert.Equal(t, "", resp.NextCursor)
+
+	_, err := s.Search(context.Background(), &pb.SearchRequest{
+		Searchparams: []*pb.SearchRequest_SearchParam{SearchDescLexicon("FOO")},
+		Cursor:       encodeCursor(querygen.PageKey{Length: 2}),
+	})
+	assert.NotNil(t, err)
+}
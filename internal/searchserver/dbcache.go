@@ -0,0 +1,393 @@
+package searchserver
+
+import (
+	"container/list"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/word_db_server/config"
+	"github.com/domino14/word_db_server/internal/querygen"
+)
+
+const (
+	// maxCachedStatements is how many prepared statements are kept per
+	// lexicon. Queries with long IN lists differ in their number of
+	// parameters, so there can be many distinct ones.
+	maxCachedStatements = 256
+	// maxIdleConnsPerHandle is how many SQLite connections each cached
+	// handle keeps open between requests.
+	maxIdleConnsPerHandle = 4
+)
+
+type cachedHandle struct {
+	lexicon  string
+	db       *sql.DB
+	file     os.FileInfo
+	lastUsed time.Time
+	inUse    int
+	// stale is set when the file has been replaced; the handle is closed
+	// once the requests using it are done.
+	stale bool
+	stmts *stmtCache
+	// tableStats are the alphagrams table's ANALYZE statistics, once
+	// statsRead; they can't change without the file being replaced.
+	tableStats *querygen.TableStats
+	statsRead  bool
+}
+
+// DBCacheStats counts what the cache has done since it was created.
+type DBCacheStats struct {
+	Open      int   `json:"open"`
+	Hits      int64 `json:"hits"`
+	Misses    int64 `json:"misses"`
+	Evictions int64 `json:"evictions"`
+	// Reopens counts handles that were replaced because the lexicon file
+	// changed.
+	Reopens int64 `json:"reopens"`
+	// Overflows counts requests that got an uncached handle because the
+	// cache was full of handles in use.
+	Overflows      int64 `json:"overflows"`
+	StatementHits  int64 `json:"statement_hits"`
+	StatementMiss  int64 `json:"statement_misses"`
+	StatementEvict int64 `json:"statement_evictions"`
+}
+
+// DBCache keeps lexicon database handles open between requests, along with
+// their prepared statements, so that a busy server isn't opening the
+// SQLite file and re-preparing the same queries for every request. At most
+// maxHandles lexica are kept open; the least recently used idle one is
+// closed to make room, and any handle unused for the idle timeout is
+// closed. If the lexicon file is replaced, the next request opens the new
+// file.
+type DBCache struct {
+	mu          sync.Mutex
+	maxHandles  int
+	idleTimeout time.Duration
+	handles     map[string]*cachedHandle
+	byDB        map[*sql.DB]*cachedHandle
+	stats       DBCacheStats
+	path        func(lexicon string) (string, error)
+	now         func() time.Time
+}
+
+// NewDBCache creates a DBCache for the lexica in the config's data path.
+// It returns nil, which is a valid DBCache that caches nothing, if the
+// config disables caching or the lexica are in Postgres, where connections
+// are pooled anyway.
+func NewDBCache(cfg *config.Config) *DBCache {
+	if cfg.DBCacheMaxHandles <= 0 || cfg.WordDBDSN != "" {
+		return nil
+	}
+	return &DBCache{
+		maxHandles:  cfg.DBCacheMaxHandles,
+		idleTimeout: cfg.DBCacheIdleTimeout,
+		handles:     map[string]*cachedHandle{},
+		byDB:        map[*sql.DB]*cachedHandle{},
+		path: func(lexicon string) (string, error) {
+			return lexiconDBPath(cfg, lexicon)
+		},
+		now: time.Now,
+	}
+}
+
+// Get returns a handle for the lexicon. The returned release function must
+// be called when the caller is done with it; the handle must not be closed.
+func (c *DBCache) Get(lexicon string) (*sql.DB, func(), error) {
+	path, err := c.path(lexicon)
+	if err != nil {
+		return nil, nil, err
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reap()
+	if h, ok := c.handles[lexicon]; ok {
+		if os.SameFile(h.file, fi) {
+			c.stats.Hits++
+			h.inUse++
+			h.lastUsed = c.now()
+			return h.db, c.releaser(h), nil
+		}
+		log.Info().Str("lexicon", lexicon).Msg("lexicon file changed; reopening")
+		c.stats.Reopens++
+		c.remove(h)
+	}
+	c.stats.Misses++
+
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(c.handles) >= c.maxHandles && !c.evictOne() {
+		// Every cached handle is in use; don't block, just don't cache.
+		c.stats.Overflows++
+		return db, func() { db.Close() }, nil
+	}
+	db.SetMaxIdleConns(maxIdleConnsPerHandle)
+	db.SetConnMaxIdleTime(c.idleTimeout)
+	h := &cachedHandle{
+		lexicon:  lexicon,
+		db:       db,
+		file:     fi,
+		lastUsed: c.now(),
+		inUse:    1,
+		stmts:    newStmtCache(db, maxCachedStatements, &c.mu, &c.stats),
+	}
+	c.handles[lexicon] = h
+	c.byDB[db] = h
+	log.Debug().Str("lexicon", lexicon).Int("open", len(c.handles)).Msg("opened cached db")
+	return db, c.releaser(h), nil
+}
+
+// queryer returns the statement cache for db if it's a cached handle, and
+// db itself otherwise.
+func (c *DBCache) queryer(db *sql.DB) queryer {
+	if c == nil {
+		return db
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if h, ok := c.byDB[db]; ok && !h.stale {
+		return h.stmts
+	}
+	return db
+}
+
+// tableStats returns the alphagrams table's statistics for db. They're only
+// read once for a cached handle.
+func (c *DBCache) tableStats(db *sql.DB) (*querygen.TableStats, error) {
+	if c == nil {
+		return alphagramStats(db)
+	}
+	c.mu.Lock()
+	h, ok := c.byDB[db]
+	if ok && h.statsRead {
+		defer c.mu.Unlock()
+		return h.tableStats, nil
+	}
+	c.mu.Unlock()
+
+	// Read without the lock, as it may have to wait for a connection.
+	stats, err := alphagramStats(db)
+	if err == nil && ok {
+		c.mu.Lock()
+		h.tableStats, h.statsRead = stats, true
+		c.mu.Unlock()
+	}
+	return stats, err
+}
+
+func (c *DBCache) releaser(h *cachedHandle) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			h.inUse--
+			h.lastUsed = c.now()
+			if h.stale && h.inUse == 0 {
+				c.closeHandle(h)
+			}
+		})
+	}
+}
+
+// remove takes the handle out of the cache, and closes it unless it's in
+// use. c.mu must be held.
+func (c *DBCache) remove(h *cachedHandle) {
+	delete(c.handles, h.lexicon)
+	h.stale = true
+	if h.inUse == 0 {
+		c.closeHandle(h)
+	}
+}
+
+// closeHandle closes the handle and its statements. c.mu must be held.
+func (c *DBCache) closeHandle(h *cachedHandle) {
+	h.stmts.closeAll()
+	h.db.Close()
+	delete(c.byDB, h.db)
+	log.Debug().Str("lexicon", h.lexicon).Msg("closed cached db")
+}
+
+// evictOne closes the least recently used handle that isn't in use. It
+// returns false if they are all in use. c.mu must be held.
+func (c *DBCache) evictOne() bool {
+	var oldest *cachedHandle
+	for _, h := range c.handles {
+		if h.inUse == 0 && (oldest == nil || h.lastUsed.Before(oldest.lastUsed)) {
+			oldest = h
+		}
+	}
+	if oldest == nil {
+		return false
+	}
+	c.stats.Evictions++
+	c.remove(oldest)
+	return true
+}
+
+// reap closes handles that have been idle for the idle timeout. c.mu must
+// be held.
+func (c *DBCache) reap() {
+	if c.idleTimeout <= 0 {
+		return
+	}
+	cutoff := c.now().Add(-c.idleTimeout)
+	for _, h := range c.handles {
+		if h.inUse == 0 && h.lastUsed.Before(cutoff) {
+			c.stats.Evictions++
+			c.remove(h)
+		}
+	}
+}
+
+// Stats returns the cache's counters.
+func (c *DBCache) Stats() DBCacheStats {
+	if c == nil {
+		return DBCacheStats{}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reap()
+	st := c.stats
+	st.Open = len(c.handles)
+	return st
+}
+
+// StatsHandler serves the cache's counters as JSON.
+func (c *DBCache) StatsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(c.Stats())
+	})
+}
+
+// Close closes every cached handle, including ones in use.
+func (c *DBCache) Close() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, h := range c.handles {
+		delete(c.handles, h.lexicon)
+		c.closeHandle(h)
+	}
+}
+
+type cachedStmt struct {
+	query string
+	stmt  *sql.Stmt
+	elem  *list.Element
+	// users counts queries being started with stmt. An evicted statement
+	// is closed once they have all started; their rows keep it usable
+	// until they're closed.
+	users   int
+	evicted bool
+}
+
+// stmtCache is a queryer that prepares each distinct query once and reuses
+// the statement. It shares the DBCache's mutex and stats.
+type stmtCache struct {
+	db    *sql.DB
+	max   int
+	mu    *sync.Mutex
+	stats *DBCacheStats
+	stmts map[string]*cachedStmt
+	// lru has the most recently used query at the front.
+	lru *list.List
+}
+
+func newStmtCache(db *sql.DB, max int, mu *sync.Mutex, stats *DBCacheStats) *stmtCache {
+	return &stmtCache{
+		db:    db,
+		max:   max,
+		mu:    mu,
+		stats: stats,
+		stmts: map[string]*cachedStmt{},
+		lru:   list.New(),
+	}
+}
+
+func (sc *stmtCache) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	cs, err := sc.acquire(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer sc.release(cs)
+	return cs.stmt.QueryContext(ctx, args...)
+}
+
+func (sc *stmtCache) acquire(ctx context.Context, query string) (*cachedStmt, error) {
+	sc.mu.Lock()
+	if cs, ok := sc.stmts[query]; ok {
+		sc.stats.StatementHits++
+		sc.lru.MoveToFront(cs.elem)
+		cs.users++
+		sc.mu.Unlock()
+		return cs, nil
+	}
+	sc.stats.StatementMiss++
+	sc.mu.Unlock()
+
+	// Prepare without the lock, as it may have to wait for a connection.
+	stmt, err := sc.db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	if cs, ok := sc.stmts[query]; ok {
+		// Someone else prepared it in the meantime.
+		stmt.Close()
+		sc.lru.MoveToFront(cs.elem)
+		cs.users++
+		return cs, nil
+	}
+	cs := &cachedStmt{query: query, stmt: stmt, users: 1}
+	cs.elem = sc.lru.PushFront(cs)
+	sc.stmts[query] = cs
+	for sc.lru.Len() > sc.max {
+		sc.stats.StatementEvict++
+		sc.evict(sc.lru.Back().Value.(*cachedStmt))
+	}
+	return cs, nil
+}
+
+func (sc *stmtCache) release(cs *cachedStmt) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	cs.users--
+	if cs.evicted && cs.users == 0 {
+		cs.stmt.Close()
+	}
+}
+
+// evict removes the statement from the cache. sc.mu must be held.
+func (sc *stmtCache) evict(cs *cachedStmt) {
+	sc.lru.Remove(cs.elem)
+	delete(sc.stmts, cs.query)
+	cs.evicted = true
+	if cs.users == 0 {
+		cs.stmt.Close()
+	}
+}
+
+// closeAll closes all the statements. sc.mu must be held.
+func (sc *stmtCache) closeAll() {
+	for _, cs := range sc.stmts {
+		sc.evict(cs)
+	}
+}
@@ -0,0 +1,207 @@
+package searchserver
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testDBCache(t *testing.T, dir string, maxHandles int, now *time.Time) *DBCache {
+	c := &DBCache{
+		maxHandles:  maxHandles,
+		idleTimeout: time.Minute,
+		handles:     map[string]*cachedHandle{},
+		byDB:        map[*sql.DB]*cachedHandle{},
+		path: func(lexicon string) (string, error) {
+			return filepath.Join(dir, lexicon+".db"), nil
+		},
+		now: func() time.Time { return *now },
+	}
+	t.Cleanup(c.Close)
+	return c
+}
+
+func TestDBCacheReusesHandles(t *testing.T) {
+	dir := t.TempDir()
+	makeVersionDB(t, filepath.Join(dir, "FOO.db"), "old")
+	now := time.Unix(1000, 0)
+	c := testDBCache(t, dir, 2, &now)
+
+	db, release, err := c.Get("FOO")
+	assert.Nil(t, err)
+	release()
+	db2, release, err := c.Get("FOO")
+	assert.Nil(t, err)
+	assert.Same(t, db, db2)
+	assert.Equal(t, "old", dbVersion(t, db2))
+	release()
+
+	// Replacing the file gets a new handle.
+	makeVersionDB(t, filepath.Join(dir, "new.db"), "new")
+	assert.Nil(t, os.Rename(filepath.Join(dir, "new.db"), filepath.Join(dir, "FOO.db")))
+	db3, release, err := c.Get("FOO")
+	assert.Nil(t, err)
+	assert.NotSame(t, db, db3)
+	assert.Equal(t, "new", dbVersion(t, db3))
+	release()
+
+	st := c.Stats()
+	assert.Equal(t, int64(1), st.Hits)
+	assert.Equal(t, int64(2), st.Misses)
+	assert.Equal(t, int64(1), st.Reopens)
+	assert.Equal(t, 1, st.Open)
+
+	_, _, err = c.Get("BAR")
+	assert.NotNil(t, err)
+}
+
+func TestDBCacheTableStats(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "FOO.db")
+	db, err := sql.Open("sqlite3", path)
+	assert.Nil(t, err)
+	_, err = db.Exec(`CREATE TABLE alphagrams (alphagram varchar(20), length int);
+		CREATE INDEX length_index on alphagrams(length);
+		INSERT INTO alphagrams VALUES ('AB', 2), ('ABC', 3), ('ABD', 3);
+		ANALYZE;`)
+	assert.Nil(t, err)
+	db.Close()
+	now := time.Unix(1000, 0)
+	c := testDBCache(t, dir, 2, &now)
+
+	db, release, err := c.Get("FOO")
+	assert.Nil(t, err)
+	defer release()
+	stats, err := c.tableStats(db)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), stats.Rows)
+	assert.Equal(t, 2.0, stats.RowsPerValue["length"])
+
+	// The stats are only read once per handle.
+	_, err = db.Exec(`DELETE FROM sqlite_stat1`)
+	assert.Nil(t, err)
+	again, err := c.tableStats(db)
+	assert.Nil(t, err)
+	assert.Same(t, stats, again)
+	fresh, err := alphagramStats(db)
+	assert.Nil(t, err)
+	assert.Nil(t, fresh)
+}
+
+func TestDBCacheEviction(t *testing.T) {
+	dir := t.TempDir()
+	for _, lex := range []string{"A", "B", "C"} {
+		makeVersionDB(t, filepath.Join(dir, lex+".db"), lex)
+	}
+	now := time.Unix(1000, 0)
+	c := testDBCache(t, dir, 2, &now)
+
+	_, releaseA, err := c.Get("A")
+	assert.Nil(t, err)
+	now = now.Add(time.Second)
+	_, releaseB, err := c.Get("B")
+	assert.Nil(t, err)
+
+	// Both handles are in use, so C isn't cached.
+	dbC, releaseC, err := c.Get("C")
+	assert.Nil(t, err)
+	assert.Equal(t, "C", dbVersion(t, dbC))
+	releaseC()
+	assert.Equal(t, int64(1), c.Stats().Overflows)
+	assert.Equal(t, 2, c.Stats().Open)
+
+	// With A and B idle, the least recently used (A) makes room for C.
+	releaseB()
+	now = now.Add(time.Second)
+	releaseA()
+	_, releaseC, err = c.Get("C")
+	assert.Nil(t, err)
+	releaseC()
+	assert.Contains(t, c.handles, "A")
+	assert.NotContains(t, c.handles, "B")
+	assert.Equal(t, int64(1), c.Stats().Evictions)
+
+	// Everything is closed after the idle timeout.
+	now = now.Add(2 * time.Minute)
+	assert.Equal(t, 0, c.Stats().Open)
+	assert.Empty(t, c.byDB)
+}
+
+func TestStmtCache(t *testing.T) {
+	dir := t.TempDir()
+	makeVersionDB(t, filepath.Join(dir, "FOO.db"), "old")
+	now := time.Unix(1000, 0)
+	c := testDBCache(t, dir, 2, &now)
+
+	db, release, err := c.Get("FOO")
+	assert.Nil(t, err)
+	defer release()
+	sc := c.queryer(db).(*stmtCache)
+	sc.max = 2
+
+	query := func(q string) string {
+		rows, err := sc.QueryContext(context.Background(), q)
+		assert.Nil(t, err)
+		defer rows.Close()
+		var v string
+		assert.True(t, rows.Next())
+		assert.Nil(t, rows.Scan(&v))
+		return v
+	}
+	assert.Equal(t, "old", query(`SELECT version FROM v`))
+	assert.Equal(t, "old", query(`SELECT version FROM v`))
+	assert.Equal(t, "old", query(`SELECT version FROM v WHERE 1`))
+
+	// Rows keep an evicted statement usable.
+	rows, err := sc.QueryContext(context.Background(), `SELECT version FROM v WHERE 2`)
+	assert.Nil(t, err)
+	assert.Equal(t, "old", query(`SELECT version FROM v WHERE 3`))
+	assert.Equal(t, "old", query(`SELECT version FROM v WHERE 4`))
+	assert.True(t, rows.Next())
+	rows.Close()
+
+	st := c.Stats()
+	assert.Equal(t, int64(1), st.StatementHits)
+	assert.Equal(t, int64(5), st.StatementMiss)
+	assert.Equal(t, int64(3), st.StatementEvict)
+	assert.Equal(t, 2, sc.lru.Len())
+
+	// An uncached handle is its own queryer.
+	other, err := sql.Open("sqlite3", filepath.Join(dir, "FOO.db"))
+	assert.Nil(t, err)
+	defer other.Close()
+	assert.Equal(t, other, c.queryer(other))
+	var nilCache *DBCache
+	assert.Equal(t, other, nilCache.queryer(other))
+}
+
+func TestDBCacheConcurrent(t *testing.T) {
+	dir := t.TempDir()
+	makeVersionDB(t, filepath.Join(dir, "FOO.db"), "old")
+	now := time.Unix(1000, 0)
+	c := testDBCache(t, dir, 1, &now)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				db, release, err := c.Get("FOO")
+				assert.Nil(t, err)
+				rows, err := c.queryer(db).QueryContext(context.Background(), `SELECT version FROM v`)
+				assert.Nil(t, err)
+				rows.Close()
+				release()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int64(1), c.Stats().Misses)
+}
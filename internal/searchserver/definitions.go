@@ -0,0 +1,59 @@
+package searchserver
+
+import (
+	"database/sql"
+
+	"github.com/domino14/word_db_server/config"
+	"github.com/domino14/word_db_server/internal/querygen"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// Some lexica are licensed on the condition that their definitions aren't
+// given out. The config's NoDefinitionLexica lists them; their words are
+// served with everything but the definition, whatever the client asks for,
+// and the definition searches are turned off as if the lexicon had no
+// definitions.
+
+// stripDefinitions blanks the definitions of the alphagrams' words if the
+// lexicon's definitions mustn't be served. The alphagrams must not be
+// shared, e.g. with the expand cache, unless they're stripped before they
+// are.
+func stripDefinitions(cfg *config.Config, lexName string, alphas []*pb.Alphagram) {
+	if cfg.DefinitionsAllowed(lexName) {
+		return
+	}
+	for _, a := range alphas {
+		stripWordDefinitions(cfg, lexName, a.Words)
+	}
+}
+
+// stripWordDefinitions is stripDefinitions for a list of words.
+func stripWordDefinitions(cfg *config.Config, lexName string, words []*pb.Word) {
+	if cfg.DefinitionsAllowed(lexName) {
+		return
+	}
+	for _, w := range words {
+		w.Definition = ""
+	}
+}
+
+// servedCapabilities returns the lexicon's capabilities as clients see
+// them: without definitions if those mustn't be served.
+func servedCapabilities(cfg *config.Config, lexName string, db *sql.DB) (map[string]bool, error) {
+	caps, err := lexiconCapabilities(db)
+	if err != nil || cfg.DefinitionsAllowed(lexName) {
+		return caps, err
+	}
+	if caps == nil {
+		// The lexicon predates the capabilities table, so nothing else
+		// is blocked.
+		caps = map[string]bool{}
+		for _, info := range querygen.Conditions() {
+			if info.Capability != "" {
+				caps[info.Capability] = true
+			}
+		}
+	}
+	caps["definitions"] = false
+	return caps, nil
+}
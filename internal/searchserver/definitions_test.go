@@ -0,0 +1,57 @@
+package searchserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/domino14/word_db_server/config"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestNoDefinitionLexica(t *testing.T) {
+	cfg := &config.Config{DataPath: makeExpandLexicon(t), NoDefinitionLexica: "BAR, foo",
+		ExpandCacheSize: 10}
+	assert.False(t, cfg.DefinitionsAllowed("FOO"))
+	assert.True(t, cfg.DefinitionsAllowed("FOOD"))
+	assert.True(t, (&config.Config{}).DefinitionsAllowed(""))
+
+	s := &Server{Config: cfg, ExpandCache: NewExpandCache(cfg)}
+	for i := 0; i < 2; i++ {
+		// The second time is from the cache.
+		resp, err := s.Expand(context.Background(), &pb.SearchResponse{
+			Lexicon:    "FOO",
+			Alphagrams: []*pb.Alphagram{{Alphagram: "EOV", Words: []*pb.Word{{Word: "EVO"}}}},
+		})
+		assert.Nil(t, err)
+		w := resp.Alphagrams[0].Words[0]
+		assert.Equal(t, "", w.Definition)
+		assert.Equal(t, "D", w.FrontHooks)
+		assert.Equal(t, int32(3), resp.Alphagrams[0].Probability)
+	}
+
+	words := &WordSearchServer{Config: cfg}
+	resp, err := words.GetWordInformation(context.Background(), &pb.DefineRequest{Lexicon: "FOO", Word: "qi"})
+	assert.Nil(t, err)
+	assert.Equal(t, "", resp.Words[0].Definition)
+	assert.Equal(t, "S", resp.Words[0].BackHooks)
+	_, err = words.WordSearch(context.Background(), &pb.WordSearchRequest{
+		Lexicon: "FOO", Glob: "*pizza*", AppliesTo: "definition"})
+	assert.NotNil(t, err)
+
+	// The lexicon predates the capabilities table, but its definitions
+	// still can't be searched.
+	db, err := getDbConnection(cfg, "FOO")
+	assert.Nil(t, err)
+	defer db.Close()
+	caps, err := servedCapabilities(cfg, "FOO", db)
+	assert.Nil(t, err)
+	assert.False(t, caps["definitions"])
+	assert.True(t, caps["difficulty"])
+	assert.NotNil(t, checkCapabilities("FOO", []*pb.SearchRequest_SearchParam{
+		SearchDescDefinitionContains("pizza")}, caps))
+	caps, err = servedCapabilities(cfg, "BAZ", db)
+	assert.Nil(t, err)
+	assert.Nil(t, caps)
+}
@@ -0,0 +1,131 @@
+package searchserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/twitchtv/twirp"
+
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// DemoMaxAlphagrams is the largest number of alphagrams a single request
+// to the public demo can return.
+const DemoMaxAlphagrams = 100
+
+// demoConditions are the only search conditions allowed in demo mode.
+// Anything that lets the caller enumerate large parts of the lexicon
+// (word lists, patterns, not-in-lexicon, etc) is left out.
+var demoConditions = map[pb.SearchRequest_Condition]bool{
+	pb.SearchRequest_LEXICON:            true,
+	pb.SearchRequest_LENGTH:             true,
+	pb.SearchRequest_PROBABILITY_RANGE:  true,
+	pb.SearchRequest_PROBABILITY_LIMIT:  true,
+	pb.SearchRequest_NUMBER_OF_ANAGRAMS: true,
+	pb.SearchRequest_NUMBER_OF_VOWELS:   true,
+	pb.SearchRequest_POINT_VALUE:        true,
+	pb.SearchRequest_DIFFICULTY_RANGE:   true,
+}
+
+// DemoServer wraps a Server for the public demo. It only allows a small
+// subset of searches, and every search must be bounded by a length and a
+// probability range or limit that together cover at most DemoMaxAlphagrams
+// alphagrams.
+type DemoServer struct {
+	*Server
+}
+
+// Search implements a restricted search for the demo.
+func (s *DemoServer) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResponse, error) {
+	if err := validateDemoRequest(req); err != nil {
+		return nil, err
+	}
+	return s.Server.Search(ctx, req)
+}
+
+// Expand implements a restricted expand for the demo.
+func (s *DemoServer) Expand(ctx context.Context, req *pb.SearchResponse) (*pb.SearchResponse, error) {
+	if err := validateDemoExpand(req); err != nil {
+		return nil, err
+	}
+	return s.Server.Expand(ctx, req)
+}
+
+// SolutionCharts implements restricted solution charts for the demo, with
+// the limits of Expand.
+func (s *DemoServer) SolutionCharts(ctx context.Context, req *pb.SearchResponse) (
+	*pb.SolutionChartsResponse, error) {
+	if err := validateDemoExpand(req); err != nil {
+		return nil, err
+	}
+	return s.Server.SolutionCharts(ctx, req)
+}
+
+// MissedWords implements restricted grading for the demo.
+func (s *DemoServer) MissedWords(ctx context.Context, req *pb.MissedWordsRequest) (
+	*pb.MissedWordsResponse, error) {
+	if len(req.Answers) > DemoMaxAlphagrams {
+		return nil, twirp.InvalidArgumentError("answers",
+			fmt.Sprintf("at most %d alphagrams can be graded in demo mode", DemoMaxAlphagrams))
+	}
+	if req.SnapshotId != "" {
+		return nil, twirp.NewError(twirp.PermissionDenied, "snapshots are not available in demo mode")
+	}
+	return s.Server.MissedWords(ctx, req)
+}
+
+func validateDemoExpand(req *pb.SearchResponse) error {
+	if len(req.Alphagrams) > DemoMaxAlphagrams {
+		return twirp.InvalidArgumentError("alphagrams",
+			fmt.Sprintf("at most %d alphagrams can be expanded in demo mode", DemoMaxAlphagrams))
+	}
+	if req.SnapshotId != "" {
+		return twirp.NewError(twirp.PermissionDenied, "snapshots are not available in demo mode")
+	}
+	return nil
+}
+
+func validateDemoRequest(req *pb.SearchRequest) error {
+	if req.PinSnapshot || req.SnapshotId != "" {
+		return twirp.NewError(twirp.PermissionDenied, "snapshots are not available in demo mode")
+	}
+	// Probability ranks are per length, so a request can return up to
+	// its range's width of alphagrams for every length it covers. The
+	// narrowest range and lengths of each are the ones that count.
+	var probWidth, lengths int64
+	for _, p := range req.Searchparams {
+		if !demoConditions[p.Condition] {
+			return twirp.NewError(twirp.PermissionDenied,
+				fmt.Sprintf("search condition %v is not available in demo mode", p.Condition))
+		}
+		switch p.Condition {
+		case pb.SearchRequest_PROBABILITY_RANGE, pb.SearchRequest_PROBABILITY_LIMIT,
+			pb.SearchRequest_LENGTH:
+			mm := p.GetMinmax()
+			if mm == nil {
+				return twirp.InvalidArgumentError("searchparams", "minmax not provided")
+			}
+			width := max(int64(mm.Max)-int64(mm.Min)+1, 0)
+			if p.Condition == pb.SearchRequest_LENGTH {
+				if lengths == 0 || width < lengths {
+					lengths = width
+				}
+			} else if probWidth == 0 || width < probWidth {
+				probWidth = width
+			}
+		}
+	}
+	if probWidth == 0 {
+		return twirp.InvalidArgumentError("searchparams",
+			"a probability range or limit is required in demo mode")
+	}
+	if lengths == 0 {
+		return twirp.InvalidArgumentError("searchparams", "a length is required in demo mode")
+	}
+	if probWidth*lengths > DemoMaxAlphagrams {
+		return twirp.InvalidArgumentError("searchparams",
+			fmt.Sprintf("searches are limited to %d alphagrams in demo mode, across all lengths",
+				DemoMaxAlphagrams))
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package searchserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestValidateDemoRequest(t *testing.T) {
+	ok := WordSearch([]*pb.SearchRequest_SearchParam{
+		SearchDescLexicon("NWL23"),
+		SearchDescLength(7, 7),
+		SearchDescProbRange(1, 100),
+	}, false)
+	assert.Nil(t, validateDemoRequest(ok))
+
+	tooWide := WordSearch([]*pb.SearchRequest_SearchParam{
+		SearchDescLexicon("NWL23"),
+		SearchDescLength(7, 7),
+		SearchDescProbLimit(1, 500),
+	}, false)
+	assert.NotNil(t, validateDemoRequest(tooWide))
+
+	unbounded := WordSearch([]*pb.SearchRequest_SearchParam{
+		SearchDescLexicon("NWL23"),
+		SearchDescLength(7, 7),
+	}, false)
+	assert.NotNil(t, validateDemoRequest(unbounded))
+
+	// 20 alphagrams for each of 8 lengths is too many in total.
+	manyLengths := WordSearch([]*pb.SearchRequest_SearchParam{
+		SearchDescLexicon("NWL23"),
+		SearchDescLength(2, 9),
+		SearchDescProbRange(1, 20),
+	}, false)
+	assert.NotNil(t, validateDemoRequest(manyLengths))
+
+	fewLengths := WordSearch([]*pb.SearchRequest_SearchParam{
+		SearchDescLexicon("NWL23"),
+		SearchDescLength(7, 8),
+		SearchDescProbRange(1, 50),
+	}, false)
+	assert.Nil(t, validateDemoRequest(fewLengths))
+
+	noLength := WordSearch([]*pb.SearchRequest_SearchParam{
+		SearchDescLexicon("NWL23"),
+		SearchDescProbRange(1, 50),
+	}, false)
+	assert.NotNil(t, validateDemoRequest(noLength))
+
+	notAllowed := WordSearch([]*pb.SearchRequest_SearchParam{
+		SearchDescLexicon("NWL23"),
+		SearchDescProbRange(1, 50),
+		SearchDescWordList([]string{"FOO"}),
+	}, false)
+	assert.NotNil(t, validateDemoRequest(notAllowed))
+}
@@ -0,0 +1,43 @@
+package searchserver
+
+import (
+	"net/http"
+	"sync/atomic"
+)
+
+// A Drain tracks the requests in flight so that the server can shut down
+// without cutting them off. Once it's started, /readyz fails, so load
+// balancers stop sending requests, but those that still come are served
+// until the HTTP server is shut down.
+type Drain struct {
+	draining atomic.Bool
+	inFlight atomic.Int64
+}
+
+// Start starts draining.
+func (d *Drain) Start() {
+	d.draining.Store(true)
+}
+
+// Draining is whether the server is draining. A nil Drain never is.
+func (d *Drain) Draining() bool {
+	return d != nil && d.draining.Load()
+}
+
+// InFlight is how many requests are being served.
+func (d *Drain) InFlight() int64 {
+	return d.inFlight.Load()
+}
+
+// Middleware counts the requests in flight. Once draining, it closes each
+// connection after its response, so clients reconnect to another server.
+func (d *Drain) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		d.inFlight.Add(1)
+		defer d.inFlight.Add(-1)
+		if d.Draining() {
+			w.Header().Set("Connection", "close")
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,51 @@
+package searchserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/domino14/word_db_server/config"
+)
+
+func TestDrain(t *testing.T) {
+	drain := &Drain{}
+	var during int64
+	handler := drain.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		during = drain.InFlight()
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/twirp/x", nil))
+	assert.Equal(t, int64(1), during)
+	assert.Equal(t, int64(0), drain.InFlight())
+	assert.Equal(t, "", rec.Header().Get("Connection"))
+
+	cfg := &config.Config{DataPath: makeExpandLexicon(t)}
+	readyz := ReadyzHandler(cfg, drain)
+	rec = httptest.NewRecorder()
+	readyz.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	var report HealthReport
+	assert.Nil(t, json.NewDecoder(rec.Body).Decode(&report))
+	assert.False(t, report.Draining)
+
+	// Requests are still served while draining, but not kept alive, and
+	// the server isn't ready.
+	drain.Start()
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/twirp/x", nil))
+	assert.Equal(t, int64(1), during)
+	assert.Equal(t, "close", rec.Header().Get("Connection"))
+	rec = httptest.NewRecorder()
+	readyz.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Nil(t, json.NewDecoder(rec.Body).Decode(&report))
+	assert.True(t, report.Draining)
+	assert.False(t, report.Ready)
+
+	var none *Drain
+	assert.False(t, none.Draining())
+}
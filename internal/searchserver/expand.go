@@ -1,46 +1,187 @@
 package searchserver
 
 import (
+	"cmp"
 	"context"
 	"database/sql"
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/rs/zerolog/log"
+	"github.com/twitchtv/twirp"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/domino14/word_db_server/config"
 	"github.com/domino14/word_db_server/internal/querygen"
+	"github.com/domino14/word_db_server/internal/tracing"
 	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
 )
 
 // Expand implements the "expand" rpc command, which takes in a simple
 // list of alphagrams with words and returns all the needed expanded info
-// (such as definitions, hooks, etc).
+// (such as definitions, hooks, etc). Alphagrams tagged with another
+// lexicon are expanded in that one.
 func (s *Server) Expand(ctx context.Context, req *pb.SearchResponse) (*pb.SearchResponse, error) {
 	defer timeTrack(time.Now(), "expand")
-	lexName := req.Lexicon
-	// Get all the alphagrams from the search request.
-	db, err := getDbConnection(s.Config, lexName)
+	selected, err := selectedForExpansion(req)
 	if err != nil {
 		return nil, err
 	}
-	defer db.Close()
-	alphStrToObjs, err := getInputAlphagramInfo(req, s.Config, db)
-	if err != nil {
-		return nil, err
+	if selected == nil {
+		selected = make([]int, len(req.Alphagrams))
+		for i := range selected {
+			selected[i] = i
+		}
+	}
+	// Group the alphagrams by lexicon, the request's first; it's opened
+	// even if none are in it, to check the snapshot.
+	lexica := []string{}
+	byLexicon := map[string][]int{}
+	if req.Lexicon != "" {
+		lexica = append(lexica, req.Lexicon)
+		byLexicon[req.Lexicon] = []int{}
+	}
+	for _, idx := range selected {
+		lex := req.Alphagrams[idx].Lexicon
+		if lex == "" {
+			lex = req.Lexicon
+		}
+		if _, ok := byLexicon[lex]; !ok {
+			lexica = append(lexica, lex)
+		}
+		byLexicon[lex] = append(byLexicon[lex], idx)
 	}
 
-	outputAlphas, err := mergeInputWordInfo(req, s.Config, alphStrToObjs, db)
-	if err != nil {
-		return nil, err
+	ctx, cancel := s.withSearchTimeout(ctx)
+	defer cancel()
+	outputAlphas := slices.Clone(req.Alphagrams)
+	var snapshotID string
+	for _, lex := range lexica {
+		idxs := byLexicon[lex]
+		alphas := make([]*pb.Alphagram, len(idxs))
+		for i, idx := range idxs {
+			alphas[i] = req.Alphagrams[idx]
+		}
+		// A snapshot is of the request's lexicon; the others are read as
+		// they are now.
+		pinned := ""
+		if lex == req.Lexicon {
+			pinned = req.SnapshotId
+		}
+		expanded, id, err := s.expandLexicon(ctx, lex, pinned, alphas)
+		if err != nil {
+			return nil, searchError(ctx, err)
+		}
+		if lex == req.Lexicon {
+			snapshotID = id
+		}
+		for i, idx := range idxs {
+			if tag := req.Alphagrams[idx].Lexicon; tag != "" {
+				// Expansions can be cached, so tag a copy.
+				expanded[i] = proto.Clone(expanded[i]).(*pb.Alphagram)
+				expanded[i].Lexicon = tag
+			}
+			outputAlphas[idx] = expanded[i]
+		}
 	}
 
 	return &pb.SearchResponse{
 		Alphagrams: outputAlphas,
-		Lexicon:    lexName,
+		Lexicon:    req.Lexicon,
+		SnapshotId: snapshotID,
 	}, nil
 }
 
-func getInputAlphagramInfo(req *pb.SearchResponse, cfg *config.Config, db *sql.DB) (map[string]*pb.Alphagram, error) {
+// expandLexicon expands alphagrams of one lexicon, in the given snapshot
+// if there is one. It returns the expansions, indexed like alphs, and the
+// snapshot they came from.
+func (s *Server) expandLexicon(ctx context.Context, lexName, snapshotID string, alphs []*pb.Alphagram) (
+	_ []*pb.Alphagram, _ string, err error) {
+
+	ctx, span := tracing.Start(ctx, "expand", attribute.String("lexicon", lexName),
+		attribute.Int("alphagrams", len(alphs)))
+	defer func() { tracing.End(span, err) }()
+	db, snapshotID, release, err := s.searchDB(lexName, snapshotID, false)
+	if err != nil {
+		return nil, "", err
+	}
+	defer release()
+	// A snapshot may be older than the lexicon file the cache follows.
+	cache := s.ExpandCache
+	if snapshotID != "" {
+		cache = nil
+	}
+	outputAlphas, missing, err := cache.lookup(lexName, alphs)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(missing) > 0 {
+		uncached := &pb.SearchResponse{Lexicon: lexName, Alphagrams: alphs}
+		if len(missing) < len(alphs) {
+			uncached.Alphagrams = nil
+			for _, idx := range missing {
+				uncached.Alphagrams = append(uncached.Alphagrams, alphs[idx])
+			}
+		}
+		q := s.DBs.queryer(db)
+		alphStrToObjs, err := getInputAlphagramInfo(ctx, uncached, s.Config, q)
+		if err != nil {
+			return nil, "", err
+		}
+		expanded, err := mergeInputWordInfo(ctx, uncached, s.Config, alphStrToObjs, q)
+		if err != nil {
+			return nil, "", err
+		}
+		cache.store(lexName, uncached.Alphagrams, expanded)
+		for i, idx := range missing {
+			outputAlphas[idx] = expanded[i]
+		}
+	}
+	return outputAlphas, snapshotID, nil
+}
+
+// selectedForExpansion returns the indexes of the alphagrams to expand, in
+// order, or nil if all of them should be.
+func selectedForExpansion(req *pb.SearchResponse) ([]int, error) {
+	if len(req.ExpandIndexes) == 0 && len(req.ExpandAlphagrams) == 0 {
+		return nil, nil
+	}
+	selected := make([]bool, len(req.Alphagrams))
+	for _, idx := range req.ExpandIndexes {
+		if idx < 0 || int(idx) >= len(req.Alphagrams) {
+			return nil, twirp.InvalidArgumentError("expand_indexes",
+				fmt.Sprintf("index %d is out of range", idx))
+		}
+		selected[idx] = true
+	}
+	if len(req.ExpandAlphagrams) > 0 {
+		positions := map[string][]int{}
+		for i, a := range req.Alphagrams {
+			positions[a.Alphagram] = append(positions[a.Alphagram], i)
+		}
+		for _, a := range req.ExpandAlphagrams {
+			idxs, ok := positions[a]
+			if !ok {
+				return nil, twirp.InvalidArgumentError("expand_alphagrams",
+					fmt.Sprintf("%v is not one of the alphagrams", a))
+			}
+			for _, idx := range idxs {
+				selected[idx] = true
+			}
+		}
+	}
+	indexes := []int{}
+	for i, sel := range selected {
+		if sel {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes, nil
+}
+
+func getInputAlphagramInfo(ctx context.Context, req *pb.SearchResponse, cfg *config.Config, db queryer) (map[string]*pb.Alphagram, error) {
 	inputAlphas := alphasFromSearchResponse(req)
 	alphaQgen := querygen.NewQueryGen(req.Lexicon, querygen.AlphagramsOnly,
 		[]*pb.SearchRequest_SearchParam{SearchDescAlphagramList(inputAlphas)},
@@ -52,40 +193,56 @@ func getInputAlphagramInfo(req *pb.SearchResponse, cfg *config.Config, db *sql.D
 	}
 	log.Debug().Msgf("alphaQgen generated queries %v", queries)
 
-	alphagrams, err := combineAlphaQueryResults(queries, db)
+	alphagrams, err := combineAlphaQueryResults(ctx, queries, db, len(inputAlphas))
 	if err != nil {
 		return nil, err
 	}
 	// Now we have a bunch of alphagrams with their info. Create a map
 	// for fast access.
-	alphStrToObjs := map[string]*pb.Alphagram{}
+	alphStrToObjs := make(map[string]*pb.Alphagram, len(alphagrams))
 	for _, a := range alphagrams {
 		alphStrToObjs[a.Alphagram] = a
 	}
 	return alphStrToObjs, nil
 }
 
-func mergeInputWordInfo(req *pb.SearchResponse, cfg *config.Config,
-	alphStrToObjs map[string]*pb.Alphagram, db *sql.DB) ([]*pb.Alphagram, error) {
-	outputAlphas := []*pb.Alphagram{}
-
-	wordToAlphagramDict := map[string]*pb.Alphagram{}
+func mergeInputWordInfo(ctx context.Context, req *pb.SearchResponse, cfg *config.Config,
+	alphStrToObjs map[string]*pb.Alphagram, db queryer) ([]*pb.Alphagram, error) {
+	outputAlphas := make([]*pb.Alphagram, 0, len(req.Alphagrams))
+	numWords := 0
+	for _, a := range req.Alphagrams {
+		numWords += len(a.Words)
+	}
+	wordToAlphagramDict := make(map[string]*pb.Alphagram, numWords)
+	// The words found are at most the ones asked for, so each alphagram's
+	// share of one slice has room for them.
+	wordSlots := make([]*pb.Word, numWords)
 	for _, a := range req.Alphagrams {
 		var thisa *pb.Alphagram
 		var ok bool
 		if thisa, ok = alphStrToObjs[a.Alphagram]; !ok {
 			// The alphagram might not have been found in the DB if for
-			// example it contained a blank.
+			// example it contained a blank. Trust the caller's length if
+			// given, since with multi-character tiles the number of runes
+			// isn't the number of tiles.
+			length := a.Length
+			if length == 0 {
+				length = int32(len([]rune(a.Alphagram)))
+			}
 			thisa = &pb.Alphagram{
 				Alphagram: a.Alphagram,
-				Length:    int32(len([]rune(a.Alphagram)))}
+				Length:    length}
+		}
+		if thisa.Words == nil {
+			thisa.Words = wordSlots[:0:len(a.Words)]
+			wordSlots = wordSlots[len(a.Words):]
 		}
 		for _, w := range a.Words {
 			wordToAlphagramDict[w.Word] = thisa
 		}
 		outputAlphas = append(outputAlphas, thisa)
 	}
-	listOfWords := []string{}
+	listOfWords := make([]string, 0, len(wordToAlphagramDict))
 	for k := range wordToAlphagramDict {
 		listOfWords = append(listOfWords, k)
 	}
@@ -99,136 +256,220 @@ func mergeInputWordInfo(req *pb.SearchResponse, cfg *config.Config,
 		return nil, err
 	}
 	log.Debug().Msgf("Generated word queries %v", queries)
-	words, err := combineWordQueryResults(queries, db)
+	words, err := combineWordQueryResults(ctx, queries, db, len(listOfWords))
 	if err != nil {
 		return nil, err
 	}
 	// Take all the words and match them with the input alphagrams.
+	found := make(map[string]bool, len(words))
 	for _, word := range words {
 		q := wordToAlphagramDict[word.Word]
 		q.Words = append(q.Words, word)
+		found[word.Word] = true
+	}
+	// Words that weren't found may have been deleted from the lexicon.
+	// Mark them instead of dropping them.
+	missing := []string{}
+	for _, w := range listOfWords {
+		if !found[w] {
+			missing = append(missing, w)
+		}
 	}
+	deleted, err := deletedWordInfo(ctx, db, missing)
+	if err != nil {
+		return nil, err
+	}
+	// Append in a fixed order; the map's order would change from one
+	// search to the next.
+	deletedWords := make([]string, 0, len(deleted))
+	for w := range deleted {
+		deletedWords = append(deletedWords, w)
+	}
+	slices.SortFunc(deletedWords, func(a, b string) int {
+		return cmp.Or(cmp.Compare(wordToAlphagramDict[a].Alphagram,
+			wordToAlphagramDict[b].Alphagram), cmp.Compare(a, b))
+	})
+	for _, w := range deletedWords {
+		info := deleted[w]
+		q := wordToAlphagramDict[w]
+		q.Words = append(q.Words, &pb.Word{Word: w, Alphagram: q.Alphagram,
+			Definition: info.definition, Deleted: true})
+		if q.Probability == 0 {
+			// This alphagram isn't in the db; use the stored length.
+			q.Length = info.length
+		}
+	}
+	for _, a := range outputAlphas {
+		a.Deleted = len(a.Words) > 0
+		for _, w := range a.Words {
+			if !w.Deleted {
+				a.Deleted = false
+				break
+			}
+		}
+	}
+	// Before they can be cached.
+	stripDefinitions(cfg, req.Lexicon, outputAlphas)
 	return outputAlphas, nil
 }
 
+type deletedWord struct {
+	length     int32
+	definition string
+}
+
+// deletedWordInfo returns the words in the list that are in the
+// deletedwords table, along with their lengths and last known definitions.
+func deletedWordInfo(ctx context.Context, db queryer, words []string) (map[string]deletedWord, error) {
+	deleted := map[string]deletedWord{}
+	for start := 0; start < len(words); start += MaxSQLChunkSize {
+		end := min(start+MaxSQLChunkSize, len(words))
+		where, args, err := querygen.NewWhereInClause("deletedwords", "word",
+			SearchDescWordList(words[start:end])).Render()
+		if err != nil {
+			return nil, err
+		}
+		rows, err := db.QueryContext(ctx,
+			fmt.Sprintf(querygen.DeletedWordQuery, where, ""), args...)
+		if err != nil {
+			return nil, err
+		}
+		for rows.Next() {
+			var word string
+			var definition sql.NullString
+			var d deletedWord
+			if err := rows.Scan(&word, &d.length, &definition); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			d.definition = definition.String
+			deleted[word] = d
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return deleted, nil
+}
+
 func alphasFromSearchResponse(req *pb.SearchResponse) []string {
-	astrs := []string{}
+	astrs := make([]string, 0, len(req.Alphagrams))
 	for _, a := range req.Alphagrams {
 		astrs = append(astrs, a.Alphagram)
 	}
 	return astrs
 }
 
-func combineAlphaQueryResults(queries []*querygen.Query, db *sql.DB) ([]*pb.Alphagram, error) {
-	alphagrams := []*pb.Alphagram{}
+// combineAlphaQueryResults runs the queries and returns the alphagrams they
+// find, of which sizeHint are expected.
+func combineAlphaQueryResults(ctx context.Context, queries []*querygen.Query, db queryer,
+	sizeHint int) ([]*pb.Alphagram, error) {
+
+	alphagrams := make([]*pb.Alphagram, 0, sizeHint)
 	// Execute the queries.
 	for _, query := range queries {
-		rows, err := db.Query(query.Rendered(), query.BindParams()...)
+		qctx, span := tracing.StartQuery(ctx, query.Rendered())
+		rows, err := db.QueryContext(qctx, query.Rendered(), query.BindParams()...)
 		if err != nil {
+			tracing.End(span, err)
 			return nil, err
 		}
-		alphagrams = append(alphagrams, processAlphagramRows(rows)...)
+		var got []*pb.Alphagram
+		got, err = processAlphagramRows(rows, sizeHint-len(alphagrams))
+		alphagrams = append(alphagrams, got...)
+		if err == nil {
+			err = rows.Err()
+		}
 		rows.Close()
+		tracing.End(span, err)
+		if err != nil {
+			return nil, err
+		}
 	}
 	return alphagrams, nil
 }
 
-func combineWordQueryResults(queries []*querygen.Query, db *sql.DB) ([]*pb.Word, error) {
-	words := []*pb.Word{}
+// combineWordQueryResults runs the queries and returns the words they
+// find, of which sizeHint are expected.
+func combineWordQueryResults(ctx context.Context, queries []*querygen.Query, db queryer,
+	sizeHint int) ([]*pb.Word, error) {
+
+	words := make([]*pb.Word, 0, sizeHint)
 	for _, query := range queries {
-		rows, err := db.Query(query.Rendered(), query.BindParams()...)
+		qctx, span := tracing.StartQuery(ctx, query.Rendered())
+		rows, err := db.QueryContext(qctx, query.Rendered(), query.BindParams()...)
 		if err != nil {
+			tracing.End(span, err)
 			return nil, err
 		}
-		words = append(words, processWordRows(rows)...)
+		var got []*pb.Word
+		got, err = processWordRows(rows, sizeHint-len(words))
+		words = append(words, got...)
+		if err == nil {
+			err = rows.Err()
+		}
 		rows.Close()
+		tracing.End(span, err)
+		if err != nil {
+			return nil, err
+		}
 	}
 	return words, nil
 }
 
-func processAlphagramRows(rows *sql.Rows) []*pb.Alphagram {
-	alphagrams := []*pb.Alphagram{}
-	rawBuffer := make([]sql.RawBytes, 4)
-	scanCallArgs := make([]interface{}, len(rawBuffer))
-	for i := range rawBuffer {
-		scanCallArgs[i] = &rawBuffer[i]
-	}
+// alphagramTextColumns are the text columns of an alphagram row.
+var alphagramTextColumns = []int{0, 4}
 
-	for rows.Next() {
-		var alphagram string
-		var probability, difficulty int32
-		var combinations int64
-
-		rows.Scan(scanCallArgs...)
-		for i, col := range rawBuffer {
-			switch i {
-			case 0:
-				alphagram = string(col)
-			case 1:
-				probability = toint32(col)
-			case 2:
-				combinations = toint64(col)
-			case 3:
-				difficulty = toint32(col)
-			}
-		}
+// processAlphagramRows returns the alphagrams of the rows. sizeHint is the
+// number of them expected.
+func processAlphagramRows(rows *sql.Rows, sizeHint int) ([]*pb.Alphagram, error) {
+	alphagrams := make([]*pb.Alphagram, 0, sizeHint)
+	alphas := newSlab[pb.Alphagram](sizeHint)
+	rs := getRowScanner(8)
+	defer rs.release()
 
-		alpha := &pb.Alphagram{
-			Alphagram:    alphagram,
-			Probability:  probability,
-			Combinations: combinations,
-			Difficulty:   difficulty,
-			Length:       int32(len([]rune(alphagram))),
+	for rows.Next() {
+		if err := rows.Scan(rs.args...); err != nil {
+			return nil, err
 		}
+		alpha := alphas.next()
+		rs.text(alphagramTextColumns, []*string{&alpha.Alphagram, &alpha.DisplayAlphagram})
+		alpha.Probability = toint32(rs.raw[1])
+		alpha.Combinations = toint64(rs.raw[2])
+		alpha.Difficulty = toint32(rs.raw[3])
+		alpha.Playability = toint32(rs.raw[5])
+		alpha.Length = toint32(rs.raw[6])
+		alpha.VowelProbability = toint32(rs.raw[7])
 		alphagrams = append(alphagrams, alpha)
 	}
-	return alphagrams
+	return alphagrams, nil
 }
 
-func processWordRows(rows *sql.Rows) []*pb.Word {
-	words := []*pb.Word{}
-	rawBuffer := make([]sql.RawBytes, 8)
-	scanCallArgs := make([]interface{}, len(rawBuffer))
-	for i := range rawBuffer {
-		scanCallArgs[i] = &rawBuffer[i]
-	}
+// wordTextColumns are the text columns of a word row.
+var wordTextColumns = []int{0, 1, 2, 3, 4, 5, 8}
 
-	for rows.Next() {
-		var lexSymbols, definition, frontHooks, backHooks, alphagram, word string
-		var innerFrontHook, innerBackHook bool
-		rows.Scan(scanCallArgs...)
-		for i, col := range rawBuffer {
-			switch i {
-			case 0:
-				word = string(col)
-			case 1:
-				alphagram = string(col)
-			case 2:
-				lexSymbols = string(col)
-			case 3:
-				definition = string(col)
-			case 4:
-				frontHooks = string(col)
-			case 5:
-				backHooks = string(col)
-			case 6:
-				innerFrontHook = tobool(col)
-			case 7:
-				innerBackHook = tobool(col)
-			}
-		}
+// processWordRows returns the words of the rows. sizeHint is the number of
+// them expected.
+func processWordRows(rows *sql.Rows, sizeHint int) ([]*pb.Word, error) {
+	words := make([]*pb.Word, 0, sizeHint)
+	pbWords := newSlab[pb.Word](sizeHint)
+	rs := getRowScanner(9)
+	defer rs.release()
 
-		pbWord := &pb.Word{
-			LexiconSymbols: lexSymbols,
-			Definition:     definition,
-			FrontHooks:     frontHooks,
-			BackHooks:      backHooks,
-			InnerFrontHook: innerFrontHook,
-			InnerBackHook:  innerBackHook,
-			Alphagram:      alphagram,
-			Word:           word,
+	for rows.Next() {
+		var sources string
+		if err := rows.Scan(rs.args...); err != nil {
+			return nil, err
 		}
-		words = append(words, pbWord)
+		w := pbWords.next()
+		rs.text(wordTextColumns, []*string{&w.Word, &w.Alphagram, &w.LexiconSymbols,
+			&w.Definition, &w.FrontHooks, &w.BackHooks, &sources})
+		w.InnerFrontHook = tobool(rs.raw[6])
+		w.InnerBackHook = tobool(rs.raw[7])
+		w.Sources = splitSources(sources)
+		words = append(words, w)
 	}
-	return words
+	return words, nil
 }
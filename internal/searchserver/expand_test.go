@@ -2,10 +2,17 @@ package searchserver
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/domino14/word_db_server/config"
+	"github.com/domino14/word_db_server/internal/querygen"
 	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
 )
 
@@ -58,3 +65,268 @@ func TestExpandHuge(t *testing.T) {
 		expandedResp.Alphagrams[3000].Alphagram)
 
 }
+
+func TestMergeInputWordInfoDeleted(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.Nil(t, err)
+	defer db.Close()
+	_, err = db.Exec(`
+	CREATE TABLE words (word varchar(20), alphagram varchar(20),
+		lexicon_symbols varchar(5), definition varchar(512),
+		front_hooks varchar(26), back_hooks varchar(26),
+		inner_front_hook int, inner_back_hook int,
+		sources varchar(32) NOT NULL DEFAULT '');
+	CREATE TABLE deletedwords (word varchar(20), length int,
+		definition varchar(512));
+	INSERT INTO words VALUES ('RETAINS', 'AEINRST', '', 'keeps', '', '', 0, 0, '');
+	INSERT INTO deletedwords VALUES ('RETINAS', 7, NULL), ('EVO', 3, 'evolution [n]'),
+		('STEARIN', 7, NULL), ('RATINES', 7, NULL), ('STAINER', 7, NULL);
+	`)
+	assert.Nil(t, err)
+
+	req := &pb.SearchResponse{
+		Alphagrams: []*pb.Alphagram{
+			{Alphagram: "AEINRST", Words: []*pb.Word{{Word: "STEARIN"}, {Word: "RETAINS"},
+				{Word: "STAINER"}, {Word: "RETINAS"}, {Word: "RATINES"}}},
+			{Alphagram: "EOV", Words: []*pb.Word{{Word: "EVO"}}},
+		},
+		Lexicon: "FOO",
+	}
+	alphs := map[string]*pb.Alphagram{
+		"AEINRST": {Alphagram: "AEINRST", Probability: 1, Length: 7},
+	}
+	out, err := mergeInputWordInfo(context.Background(), req, DefaultConfig, alphs, db)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(out))
+
+	assert.False(t, out[0].Deleted)
+	// Found words come first, then the deleted ones in alphabetical order.
+	words := []string{}
+	for _, w := range out[0].Words {
+		words = append(words, w.Word)
+	}
+	assert.Equal(t, []string{"RETAINS", "RATINES", "RETINAS", "STAINER", "STEARIN"}, words)
+	assert.False(t, out[0].Words[0].Deleted)
+	assert.True(t, out[0].Words[1].Deleted)
+
+	assert.True(t, out[1].Deleted)
+	assert.Equal(t, int32(3), out[1].Length)
+	assert.Equal(t, "EVO", out[1].Words[0].Word)
+	assert.True(t, out[1].Words[0].Deleted)
+	assert.Equal(t, "evolution [n]", out[1].Words[0].Definition)
+	assert.Equal(t, "", out[0].Words[1].Definition)
+}
+
+func TestDeletedWordRows(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.Nil(t, err)
+	defer db.Close()
+	_, err = db.Exec(`
+	CREATE TABLE deletedwords (word varchar(20), length int,
+		definition varchar(512));
+	INSERT INTO deletedwords VALUES ('EVO', 3, 'evolution [n]'), ('QI', 2, NULL);
+	`)
+	assert.Nil(t, err)
+
+	rows, err := db.Query(fmt.Sprintf(querygen.DeletedWordQuery, "1 = 1", ""))
+	assert.Nil(t, err)
+	alphs, _ := processQuestionRows(rows, true, querygen.DeletedWords, 0, nil)
+	rows.Close()
+	assert.Equal(t, 2, len(alphs))
+	assert.Equal(t, "EVO", alphs[0].Alphagram)
+	assert.Equal(t, int32(3), alphs[0].Length)
+	assert.True(t, alphs[0].Deleted)
+	assert.Equal(t, "evolution [n]", alphs[0].Words[0].Definition)
+	assert.True(t, alphs[0].Words[0].Deleted)
+	assert.Equal(t, "", alphs[1].Words[0].Definition)
+}
+
+func TestProcessRowsScanError(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.Nil(t, err)
+	defer db.Close()
+
+	// Rows with the wrong number of columns can't be scanned.
+	rows, err := db.Query("SELECT 'AB', 'AB'")
+	assert.Nil(t, err)
+	_, err = processWordRows(rows, 0)
+	rows.Close()
+	assert.NotNil(t, err)
+
+	rows, err = db.Query("SELECT 'AB', 'AB'")
+	assert.Nil(t, err)
+	_, err = processAlphagramRows(rows, 0)
+	rows.Close()
+	assert.NotNil(t, err)
+}
+
+// makeExpandLexicon makes a small lexicon, FOO, with everything that
+// expansion needs, and returns its data path.
+func makeExpandLexicon(t testing.TB) string {
+	dataPath := t.TempDir()
+	dbDir := filepath.Join(dataPath, "lexica", "db")
+	assert.Nil(t, os.MkdirAll(dbDir, 0755))
+	db, err := sql.Open("sqlite3", filepath.Join(dbDir, "FOO.db"))
+	assert.Nil(t, err)
+	defer db.Close()
+	_, err = db.Exec(`
+	CREATE TABLE alphagrams (alphagram varchar(20), probability int,
+		combinations int, difficulty int, display_alphagram varchar(20),
+		playability int, length int, vowel_probability int);
+	CREATE TABLE words (word varchar(20), alphagram varchar(20),
+		lexicon_symbols varchar(5), definition varchar(512),
+		front_hooks varchar(26), back_hooks varchar(26),
+		inner_front_hook int, inner_back_hook int,
+		sources varchar(32) NOT NULL DEFAULT '');
+	CREATE TABLE deletedwords (word varchar(20), length int,
+		definition varchar(512));
+	INSERT INTO alphagrams VALUES ('IQ', 1, 1, 0, 'IQ', 0, 2, 1),
+		('AZ', 2, 1, 0, 'AZ', 0, 2, 2), ('EOV', 3, 1, 0, 'EOV', 0, 3, 1);
+	INSERT INTO words VALUES ('QI', 'IQ', '', 'a life force', '', 'S', 0, 0, ''),
+		('ZA', 'AZ', '', 'pizza', '', 'S', 0, 0, ''),
+		('EVO', 'EOV', '', 'evolution', 'D', 'S', 0, 0, 'NZ');
+	`)
+	assert.Nil(t, err)
+	return dataPath
+}
+
+// benchmarkExpandRequest adds a thousand alphagrams of three words each to
+// FOO, like a big quiz, and returns the request to expand them.
+func benchmarkExpandRequest(b *testing.B, dataPath string) *pb.SearchResponse {
+	db, err := sql.Open("sqlite3", filepath.Join(dataPath, "lexica", "db", "FOO.db"))
+	assert.Nil(b, err)
+	defer db.Close()
+	tx, err := db.Begin()
+	assert.Nil(b, err)
+	req := &pb.SearchResponse{Lexicon: "FOO"}
+	for i := 0; i < 1000; i++ {
+		alph := fmt.Sprintf("AEINRST%04d", i)
+		_, err = tx.Exec(`INSERT INTO alphagrams VALUES (?, ?, 1000000, 50, ?, 2000, 7, ?)`,
+			alph, i+1, alph, i+1)
+		assert.Nil(b, err)
+		a := &pb.Alphagram{Alphagram: alph}
+		for j := 0; j < 3; j++ {
+			word := fmt.Sprintf("RETAINS%04d%d", i, j)
+			_, err = tx.Exec(`INSERT INTO words VALUES (?, ?, '+', ?, 'S', 'ES', 1, 0, 'NZ,UK')`,
+				word, alph, "to keep possession of, to hold")
+			assert.Nil(b, err)
+			a.Words = append(a.Words, &pb.Word{Word: word})
+		}
+		req.Alphagrams = append(req.Alphagrams, a)
+	}
+	assert.Nil(b, tx.Commit())
+	// Like a built database.
+	_, err = db.Exec(`CREATE INDEX alphagram_index on alphagrams(alphagram);
+		CREATE INDEX word_index on words(word)`)
+	assert.Nil(b, err)
+	return req
+}
+
+func BenchmarkExpand(b *testing.B) {
+	dataPath := makeExpandLexicon(b)
+	req := benchmarkExpandRequest(b, dataPath)
+	s := &Server{Config: &config.Config{DataPath: dataPath}}
+	// Without the debug logs, which cost more than the expansion, or the
+	// timing of each one.
+	defer zerolog.SetGlobalLevel(zerolog.GlobalLevel())
+	zerolog.SetGlobalLevel(zerolog.WarnLevel)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		resp, err := s.Expand(context.Background(), req)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(resp.Alphagrams[999].Words) != 3 {
+			b.Fatal("words missing")
+		}
+	}
+}
+
+func TestPartialExpand(t *testing.T) {
+	s := &Server{Config: &config.Config{DataPath: makeExpandLexicon(t)}}
+	req := func() *pb.SearchResponse {
+		return &pb.SearchResponse{
+			Lexicon: "FOO",
+			Alphagrams: []*pb.Alphagram{
+				{Alphagram: "IQ", Words: []*pb.Word{{Word: "QI"}}},
+				{Alphagram: "AZ", Words: []*pb.Word{{Word: "ZA"}}},
+				{Alphagram: "EOV", Words: []*pb.Word{{Word: "EVO"}}},
+			},
+		}
+	}
+
+	r := req()
+	r.ExpandIndexes = []int32{2}
+	r.ExpandAlphagrams = []string{"IQ"}
+	resp, err := s.Expand(context.Background(), r)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(resp.Alphagrams))
+	assert.Equal(t, "a life force", resp.Alphagrams[0].Words[0].Definition)
+	assert.Equal(t, int32(1), resp.Alphagrams[0].Probability)
+	assert.Equal(t, "", resp.Alphagrams[1].Words[0].Definition)
+	assert.Equal(t, int32(0), resp.Alphagrams[1].Probability)
+	assert.Equal(t, "evolution", resp.Alphagrams[2].Words[0].Definition)
+	assert.Equal(t, "D", resp.Alphagrams[2].Words[0].FrontHooks)
+	assert.Equal(t, []string{"NZ"}, resp.Alphagrams[2].Words[0].Sources)
+	assert.Nil(t, resp.Alphagrams[0].Words[0].Sources)
+
+	resp, err = s.Expand(context.Background(), req())
+	assert.Nil(t, err)
+	assert.Equal(t, "pizza", resp.Alphagrams[1].Words[0].Definition)
+
+	r = req()
+	r.ExpandIndexes = []int32{3}
+	_, err = s.Expand(context.Background(), r)
+	assert.NotNil(t, err)
+
+	r = req()
+	r.ExpandAlphagrams = []string{"AEINRST"}
+	_, err = s.Expand(context.Background(), r)
+	assert.NotNil(t, err)
+}
+
+func TestMultiLexiconExpand(t *testing.T) {
+	dataPath := makeExpandLexicon(t)
+	dbDir := filepath.Join(dataPath, "lexica", "db")
+	foo, err := os.ReadFile(filepath.Join(dbDir, "FOO.db"))
+	assert.Nil(t, err)
+	assert.Nil(t, os.WriteFile(filepath.Join(dbDir, "BAR.db"), foo, 0644))
+	db, err := sql.Open("sqlite3", filepath.Join(dbDir, "BAR.db"))
+	assert.Nil(t, err)
+	_, err = db.Exec(`UPDATE words SET definition = 'a cheese pie' WHERE word = 'ZA'`)
+	assert.Nil(t, err)
+	db.Close()
+
+	s := &Server{Config: &config.Config{DataPath: dataPath, ExpandCacheSize: 10}}
+	s.ExpandCache = NewExpandCache(s.Config)
+	for i := 0; i < 2; i++ {
+		resp, err := s.Expand(context.Background(), &pb.SearchResponse{
+			Lexicon: "FOO",
+			Alphagrams: []*pb.Alphagram{
+				{Alphagram: "AZ", Words: []*pb.Word{{Word: "ZA"}}, Lexicon: "BAR"},
+				{Alphagram: "AZ", Words: []*pb.Word{{Word: "ZA"}}},
+				{Alphagram: "IQ", Words: []*pb.Word{{Word: "QI"}}, Lexicon: "FOO"},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, "FOO", resp.Lexicon)
+		assert.Equal(t, "a cheese pie", resp.Alphagrams[0].Words[0].Definition)
+		assert.Equal(t, "BAR", resp.Alphagrams[0].Lexicon)
+		assert.Equal(t, "pizza", resp.Alphagrams[1].Words[0].Definition)
+		assert.Equal(t, "", resp.Alphagrams[1].Lexicon)
+		assert.Equal(t, "a life force", resp.Alphagrams[2].Words[0].Definition)
+		assert.Equal(t, "FOO", resp.Alphagrams[2].Lexicon)
+	}
+	// The second time around, the expansions came from the cache, which
+	// doesn't keep the tags.
+	assert.Equal(t, int64(3), s.ExpandCache.Stats().Hits)
+
+	_, err = s.Expand(context.Background(), &pb.SearchResponse{
+		Lexicon: "FOO",
+		Alphagrams: []*pb.Alphagram{
+			{Alphagram: "AZ", Words: []*pb.Word{{Word: "ZA"}}, Lexicon: "NOPE"},
+		},
+	})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,244 @@
+package searchserver
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/domino14/word_db_server/config"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// DefaultExpandOnlyCacheSize is the size of the expansion cache in
+// expand-only mode, if the config doesn't give one.
+const DefaultExpandOnlyCacheSize = 200000
+
+// ExpandCacheStats counts what the cache has done since it was created.
+type ExpandCacheStats struct {
+	Entries   int   `json:"entries"`
+	Hits      int64 `json:"hits"`
+	Misses    int64 `json:"misses"`
+	Evictions int64 `json:"evictions"`
+	// Invalidations counts lexica whose entries were all dropped because
+	// the database changed.
+	Invalidations int64 `json:"invalidations"`
+}
+
+type expandCacheEntry struct {
+	key       string
+	lexicon   string
+	alphagram *pb.Alphagram
+	// encoded is the alphagram marshaled, once it's been sent in a
+	// protobuf response. See PreencodeInterceptor.
+	encoded []byte
+}
+
+// ExpandCache keeps expanded alphagrams, so that expanding the same
+// questions again (as every quiz over a popular list does) doesn't query
+// the database. An entry is for an alphagram with a given set of words,
+// since Expand only returns the words it's asked for. A lexicon's entries
+// are dropped when its database or its WAL changes, which covers both a
+// new file and definition fixes made in place.
+//
+// Cached alphagrams are shared between responses, and must not be
+// modified.
+type ExpandCache struct {
+	mu      sync.Mutex
+	max     int
+	entries map[string]*list.Element
+	// byAlphagram finds the entries of the alphagrams in responses.
+	byAlphagram map[*pb.Alphagram]*list.Element
+	// lru has the most recently used entry at the front.
+	lru *list.List
+	// stamps are the state of each lexicon's files when its entries were
+	// cached.
+	stamps map[string]string
+	stats  ExpandCacheStats
+	stamp  func(lexicon string) (string, error)
+}
+
+// NewExpandCache creates an ExpandCache for the lexica in the config's data
+// path. It returns nil, which is a valid ExpandCache that caches nothing,
+// if the config disables it.
+func NewExpandCache(cfg *config.Config) *ExpandCache {
+	size := cfg.ExpandCacheSize
+	if size == 0 && cfg.ExpandOnly {
+		size = DefaultExpandOnlyCacheSize
+	}
+	if size <= 0 {
+		return nil
+	}
+	return &ExpandCache{
+		max:         size,
+		entries:     map[string]*list.Element{},
+		byAlphagram: map[*pb.Alphagram]*list.Element{},
+		lru:         list.New(),
+		stamps:      map[string]string{},
+		stamp: func(lexicon string) (string, error) {
+			store, err := lexiconStore(cfg)
+			if err != nil {
+				return "", err
+			}
+			return store.Stamp(context.Background(), lexicon)
+		},
+	}
+}
+
+// expandCacheKey is the key of an input alphagram. The order of its words
+// doesn't matter, as Expand returns them in the database's order.
+func expandCacheKey(lexicon string, a *pb.Alphagram) string {
+	words := make([]string, len(a.Words))
+	for i, w := range a.Words {
+		words[i] = w.Word
+	}
+	sort.Strings(words)
+	return lexicon + "\x00" + a.Alphagram + "\x00" + strings.Join(words, "\x00")
+}
+
+// lookup returns the cached expansions of the input alphagrams, indexed
+// like alphs, and the indexes of the ones that aren't cached.
+func (c *ExpandCache) lookup(lexicon string, alphs []*pb.Alphagram) ([]*pb.Alphagram, []int, error) {
+	found := make([]*pb.Alphagram, len(alphs))
+	if c == nil {
+		missing := make([]int, len(alphs))
+		for i := range alphs {
+			missing[i] = i
+		}
+		return found, missing, nil
+	}
+	stamp, err := c.stamp(lexicon)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if old, ok := c.stamps[lexicon]; ok && old != stamp {
+		c.invalidate(lexicon)
+	}
+	c.stamps[lexicon] = stamp
+	missing := []int{}
+	for i, a := range alphs {
+		if el, ok := c.entries[expandCacheKey(lexicon, a)]; ok {
+			c.lru.MoveToFront(el)
+			found[i] = el.Value.(*expandCacheEntry).alphagram
+			c.stats.Hits++
+			continue
+		}
+		c.stats.Misses++
+		missing = append(missing, i)
+	}
+	return found, missing, nil
+}
+
+// store caches the expansions of the input alphagrams. expanded is indexed
+// like alphs.
+func (c *ExpandCache) store(lexicon string, alphs, expanded []*pb.Alphagram) {
+	if c == nil {
+		return
+	}
+	// An alphagram that's given more than once is expanded once, with the
+	// words of all of its inputs, so it isn't the expansion of any of them.
+	seen := make(map[string]int, len(alphs))
+	for _, a := range alphs {
+		seen[a.Alphagram]++
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, a := range alphs {
+		if seen[a.Alphagram] > 1 {
+			continue
+		}
+		key := expandCacheKey(lexicon, a)
+		if el, ok := c.entries[key]; ok {
+			c.lru.MoveToFront(el)
+			continue
+		}
+		el := c.lru.PushFront(&expandCacheEntry{
+			key: key, lexicon: lexicon, alphagram: expanded[i]})
+		c.entries[key] = el
+		c.byAlphagram[expanded[i]] = el
+		for c.lru.Len() > c.max {
+			c.stats.Evictions++
+			c.remove(c.lru.Back())
+		}
+	}
+}
+
+// invalidate drops all of the lexicon's entries. c.mu must be held.
+func (c *ExpandCache) invalidate(lexicon string) {
+	c.stats.Invalidations++
+	for el := c.lru.Front(); el != nil; {
+		next := el.Next()
+		if el.Value.(*expandCacheEntry).lexicon == lexicon {
+			c.remove(el)
+		}
+		el = next
+	}
+}
+
+// remove drops an entry. c.mu must be held.
+func (c *ExpandCache) remove(el *list.Element) {
+	c.lru.Remove(el)
+	e := el.Value.(*expandCacheEntry)
+	delete(c.entries, e.key)
+	if c.byAlphagram[e.alphagram] == el {
+		delete(c.byAlphagram, e.alphagram)
+	}
+}
+
+// encoding returns the marshaled alphagram, if it's a cached one, marshaling
+// it the first time. It returns nil for alphagrams that aren't cached.
+func (c *ExpandCache) encoding(a *pb.Alphagram) ([]byte, error) {
+	if c == nil {
+		return nil, nil
+	}
+	c.mu.Lock()
+	el, ok := c.byAlphagram[a]
+	if !ok {
+		c.mu.Unlock()
+		return nil, nil
+	}
+	e := el.Value.(*expandCacheEntry)
+	encoded := e.encoded
+	c.mu.Unlock()
+	if encoded != nil {
+		return encoded, nil
+	}
+	// Cached alphagrams don't change, so it doesn't matter if two
+	// responses marshal one at once.
+	encoded, err := proto.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	e.encoded = encoded
+	c.mu.Unlock()
+	return encoded, nil
+}
+
+// Stats returns the cache's counters.
+func (c *ExpandCache) Stats() ExpandCacheStats {
+	if c == nil {
+		return ExpandCacheStats{}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	st := c.stats
+	st.Entries = c.lru.Len()
+	return st
+}
+
+// StatsHandler serves the cache's counters as JSON.
+func (c *ExpandCache) StatsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(c.Stats())
+	})
+}
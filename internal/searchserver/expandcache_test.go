@@ -0,0 +1,86 @@
+package searchserver
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/word_db_server/config"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestExpandCache(t *testing.T) {
+	dataPath := makeExpandLexicon(t)
+	db, err := sql.Open("sqlite3", filepath.Join(dataPath, "lexica", "db", "FOO.db"))
+	assert.Nil(t, err)
+	defer db.Close()
+
+	cfg := &config.Config{DataPath: dataPath, ExpandCacheSize: 2}
+	cache := NewExpandCache(cfg)
+	s := &Server{Config: cfg, ExpandCache: cache}
+	req := func(alphs ...string) *pb.SearchResponse {
+		words := map[string]string{"IQ": "QI", "AZ": "ZA", "EOV": "EVO"}
+		r := &pb.SearchResponse{Lexicon: "FOO"}
+		for _, a := range alphs {
+			r.Alphagrams = append(r.Alphagrams, &pb.Alphagram{
+				Alphagram: a, Words: []*pb.Word{{Word: words[a]}}})
+		}
+		return r
+	}
+
+	resp, err := s.Expand(context.Background(), req("IQ", "AZ"))
+	assert.Nil(t, err)
+	assert.Equal(t, "a life force", resp.Alphagrams[0].Words[0].Definition)
+	assert.Equal(t, ExpandCacheStats{Entries: 2, Misses: 2}, cache.Stats())
+
+	// Only EOV is looked up; it pushes out IQ, the least recently used.
+	resp, err = s.Expand(context.Background(), req("EOV", "AZ"))
+	assert.Nil(t, err)
+	assert.Equal(t, "evolution", resp.Alphagrams[0].Words[0].Definition)
+	assert.Equal(t, "pizza", resp.Alphagrams[1].Words[0].Definition)
+	assert.Equal(t, ExpandCacheStats{Entries: 2, Hits: 1, Misses: 3, Evictions: 1}, cache.Stats())
+
+	// Changing the database drops its entries.
+	_, err = db.Exec(`UPDATE words SET definition = 'a pie' WHERE word = 'ZA'`)
+	assert.Nil(t, err)
+	resp, err = s.Expand(context.Background(), req("AZ"))
+	assert.Nil(t, err)
+	assert.Equal(t, "a pie", resp.Alphagrams[0].Words[0].Definition)
+	st := cache.Stats()
+	assert.Equal(t, int64(1), st.Invalidations)
+	assert.Equal(t, 1, st.Entries)
+}
+
+func TestExpandCacheKey(t *testing.T) {
+	key := func(words ...string) string {
+		a := &pb.Alphagram{Alphagram: "AEINRST"}
+		for _, w := range words {
+			a.Words = append(a.Words, &pb.Word{Word: w})
+		}
+		return expandCacheKey("NWL23", a)
+	}
+	assert.Equal(t, key("RETAINS", "NASTIER"), key("NASTIER", "RETAINS"))
+	assert.NotEqual(t, key("RETAINS"), key("RETAINS", "NASTIER"))
+}
+
+func TestNewExpandCache(t *testing.T) {
+	assert.Nil(t, NewExpandCache(&config.Config{}))
+	c := NewExpandCache(&config.Config{ExpandOnly: true})
+	assert.Equal(t, DefaultExpandOnlyCacheSize, c.max)
+	assert.Nil(t, NewExpandCache(&config.Config{ExpandOnly: true, ExpandCacheSize: -1}))
+
+	var none *ExpandCache
+	assert.Equal(t, ExpandCacheStats{}, none.Stats())
+}
+
+func TestExpandOnlyServer(t *testing.T) {
+	s := &ExpandOnlyServer{Server: &Server{Config: &config.Config{}}}
+	_, err := s.Search(context.Background(), &pb.SearchRequest{})
+	twerr, ok := err.(twirp.Error)
+	assert.True(t, ok)
+	assert.Equal(t, twirp.Unimplemented, twerr.Code())
+}
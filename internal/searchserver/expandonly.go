@@ -0,0 +1,22 @@
+package searchserver
+
+import (
+	"context"
+
+	"github.com/twitchtv/twirp"
+
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// ExpandOnlyServer wraps a Server for deployments that run expansion
+// (definitions and hooks) separately from search (the indexes), so that
+// each can be scaled on its own. It only serves Expand, and MissedWords,
+// which like it only needs the words.
+type ExpandOnlyServer struct {
+	*Server
+}
+
+// Search is not served in expand-only mode.
+func (s *ExpandOnlyServer) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResponse, error) {
+	return nil, twirp.NewError(twirp.Unimplemented, "this server only expands; search elsewhere")
+}
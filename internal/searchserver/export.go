@@ -0,0 +1,151 @@
+package searchserver
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// exportPageSize is how many alphagrams an export searches for at a time.
+// Each page is written out before the next is searched for.
+const exportPageSize = 1000
+
+var (
+	exportWordHeader = []string{"alphagram", "length", "probability", "word",
+		"front_hooks", "back_hooks", "lexicon_symbols", "definition"}
+	exportAlphagramHeader = []string{"alphagram", "length", "probability", "words",
+		"front_hooks", "back_hooks", "definitions"}
+)
+
+// exportRows turns expanded alphagrams into rows, with a row per word, or
+// per alphagram with its words' fields joined.
+func exportRows(alphs []*pb.Alphagram, perAlphagram bool) [][]string {
+	rows := [][]string{}
+	for _, a := range alphs {
+		length := strconv.Itoa(int(a.Length))
+		prob := strconv.Itoa(int(a.Probability))
+		if !perAlphagram {
+			for _, w := range a.Words {
+				rows = append(rows, []string{a.Alphagram, length, prob, w.Word,
+					w.FrontHooks, w.BackHooks, w.LexiconSymbols, w.Definition})
+			}
+			continue
+		}
+		var words, fronts, backs, defs []string
+		for _, w := range a.Words {
+			words = append(words, w.Word+w.LexiconSymbols)
+			fronts = append(fronts, w.FrontHooks)
+			backs = append(backs, w.BackHooks)
+			defs = append(defs, w.Word+": "+w.Definition)
+		}
+		rows = append(rows, []string{a.Alphagram, length, prob, strings.Join(words, " "),
+			strings.Join(fronts, " / "), strings.Join(backs, " / "), strings.Join(defs, " / ")})
+	}
+	return rows
+}
+
+// ExportHandler serves searches as CSV or TSV, for importing into Anki or
+// a spreadsheet. It takes a POSTed SearchRequest in protobuf JSON form,
+// like QuizCardsHandler, and always expands the results. The format query
+// parameter is csv (the default) or tsv, and rows is words (the default)
+// or alphagrams.
+//
+// Searches that can be paged are searched for a page at a time, and each
+// page is flushed to the client as it's written, so big lists start
+// arriving at once. An error after the first page can only end the
+// response early.
+func ExportHandler(s *Server) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "POST a search request", http.StatusMethodNotAllowed)
+			return
+		}
+		comma, contentType := ',', "text/csv; charset=utf-8"
+		switch r.URL.Query().Get("format") {
+		case "", "csv":
+		case "tsv":
+			comma, contentType = '\t', "text/tab-separated-values; charset=utf-8"
+		default:
+			http.Error(w, "format must be csv or tsv", http.StatusBadRequest)
+			return
+		}
+		perAlphagram := false
+		switch r.URL.Query().Get("rows") {
+		case "", "words":
+		case "alphagrams":
+			perAlphagram = true
+		default:
+			http.Error(w, "rows must be words or alphagrams", http.StatusBadRequest)
+			return
+		}
+		body, err := io.ReadAll(io.LimitReader(r.Body, 1<<20))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		req := &pb.SearchRequest{}
+		if err := protojson.Unmarshal(body, req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		req.Expand = true
+		if req.PageSize == 0 && req.Cursor == "" {
+			paged := proto.Clone(req).(*pb.SearchRequest)
+			paged.PageSize = exportPageSize
+			// Searches that can't be paged are done all at once.
+			if _, err := createQueryGen(paged, s.Config, MaxSQLChunkSize); err == nil {
+				req = paged
+			}
+		}
+
+		var out *csv.Writer
+		for page := 0; ; page++ {
+			resp, err := s.Search(r.Context(), req)
+			if err != nil {
+				if out == nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+				} else {
+					log.Err(err).Int("page", page).Msg("export-failed")
+				}
+				return
+			}
+			if out == nil {
+				ext := "csv"
+				if comma == '\t' {
+					ext = "tsv"
+				}
+				w.Header().Set("Content-Type", contentType)
+				w.Header().Set("Content-Disposition",
+					fmt.Sprintf("attachment; filename=%q", resp.Lexicon+"."+ext))
+				out = csv.NewWriter(w)
+				out.Comma = comma
+				header := exportWordHeader
+				if perAlphagram {
+					header = exportAlphagramHeader
+				}
+				out.Write(header)
+			}
+			out.WriteAll(exportRows(resp.Alphagrams, perAlphagram))
+			if err := out.Error(); err != nil {
+				log.Err(err).Msg("writing-export")
+				return
+			}
+			if f, ok := w.(http.Flusher); ok {
+				f.Flush()
+			}
+			if resp.NextCursor == "" || req.PageSize == 0 {
+				return
+			}
+			req.Cursor = resp.NextCursor
+		}
+	})
+}
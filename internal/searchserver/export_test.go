@@ -0,0 +1,58 @@
+package searchserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/domino14/word_db_server/config"
+)
+
+func TestExportHandler(t *testing.T) {
+	h := ExportHandler(&Server{Config: &config.Config{DataPath: makeExpandLexicon(t)}})
+	export := func(query, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/export"+query,
+			strings.NewReader(body)))
+		return rec
+	}
+	search := `{"searchparams": [{"condition": "LEXICON", "stringvalue": {"value": "FOO"}},
+		{"condition": "LENGTH", "minmax": {"min": 2, "max": 3}}]`
+
+	rec := export("", search+`}`)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="FOO.csv"`, rec.Header().Get("Content-Disposition"))
+	assert.Equal(t, "alphagram,length,probability,word,front_hooks,back_hooks,lexicon_symbols,definition\n"+
+		"IQ,2,1,QI,,S,,a life force\n"+
+		"AZ,2,2,ZA,,S,,pizza\n"+
+		"EOV,3,3,EVO,D,S,,evolution\n", rec.Body.String())
+
+	// Pages of one alphagram are all written out.
+	paged := export("?format=tsv&rows=alphagrams", search+`, "page_size": 1}`)
+	assert.Equal(t, http.StatusOK, paged.Code)
+	assert.Equal(t, "text/tab-separated-values; charset=utf-8", paged.Header().Get("Content-Type"))
+	assert.Equal(t, "alphagram\tlength\tprobability\twords\tfront_hooks\tback_hooks\tdefinitions\n"+
+		"IQ\t2\t1\tQI\t\tS\tQI: a life force\n"+
+		"AZ\t2\t2\tZA\t\tS\tZA: pizza\n"+
+		"EOV\t3\t3\tEVO\tD\tS\tEVO: evolution\n", paged.Body.String())
+
+	// Searches that can't be paged are exported all at once.
+	rec = export("", `{"searchparams": [{"condition": "LEXICON", "stringvalue": {"value": "FOO"}},
+		{"condition": "LENGTH", "minmax": {"min": 2, "max": 2}},
+		{"condition": "PROBABILITY_LIMIT", "minmax": {"min": 2, "max": 2}}]}`)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, 2, strings.Count(rec.Body.String(), "\n"))
+
+	assert.Equal(t, http.StatusBadRequest, export("?format=xls", search+`}`).Code)
+	assert.Equal(t, http.StatusBadRequest, export("?rows=tiles", search+`}`).Code)
+	rec = export("", `{"searchparams": [{"condition": "LEXICON", "stringvalue": {"value": "NWL18"}}]}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "NWL18")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/export", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
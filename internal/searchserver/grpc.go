@@ -0,0 +1,74 @@
+package searchserver
+
+import (
+	"context"
+	"errors"
+
+	"github.com/twitchtv/twirp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	// Lets gRPC clients ask for gzipped responses.
+	_ "google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/status"
+
+	"github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// NewGRPCServer returns a gRPC server that serves the QuestionSearcher
+// service with the given searcher, for clients that don't speak Twirp. The
+// searcher is the same one the Twirp handler uses, and the cache, which may
+// be nil, is its expand cache. The interceptors, if any, run after the
+// error interceptor, in the order given.
+func NewGRPCServer(searcher wordsearcher.QuestionSearcherServer, cache *ExpandCache,
+	interceptors ...grpc.UnaryServerInterceptor) *grpc.Server {
+
+	chain := append([]grpc.UnaryServerInterceptor{twirpErrorInterceptor}, interceptors...)
+	chain = append(chain, PreencodeGRPCInterceptor(cache))
+	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(chain...))
+	wordsearcher.RegisterQuestionSearcherServer(srv, searcher)
+	return srv
+}
+
+// grpcCodes maps Twirp error codes to their gRPC equivalents. Twirp's codes
+// were modelled on gRPC's, so only the Twirp-specific ones differ in name.
+var grpcCodes = map[twirp.ErrorCode]codes.Code{
+	twirp.Canceled:           codes.Canceled,
+	twirp.Unknown:            codes.Unknown,
+	twirp.InvalidArgument:    codes.InvalidArgument,
+	twirp.Malformed:          codes.InvalidArgument,
+	twirp.DeadlineExceeded:   codes.DeadlineExceeded,
+	twirp.NotFound:           codes.NotFound,
+	twirp.BadRoute:           codes.Unimplemented,
+	twirp.AlreadyExists:      codes.AlreadyExists,
+	twirp.PermissionDenied:   codes.PermissionDenied,
+	twirp.Unauthenticated:    codes.Unauthenticated,
+	twirp.ResourceExhausted:  codes.ResourceExhausted,
+	twirp.FailedPrecondition: codes.FailedPrecondition,
+	twirp.Aborted:            codes.Aborted,
+	twirp.OutOfRange:         codes.OutOfRange,
+	twirp.Unimplemented:      codes.Unimplemented,
+	twirp.Internal:           codes.Internal,
+	twirp.Unavailable:        codes.Unavailable,
+	twirp.DataLoss:           codes.DataLoss,
+}
+
+// twirpErrorInterceptor turns the errors the searcher returns into gRPC
+// status errors, with the codes a Twirp client would have seen. Like
+// Twirp, errors that aren't Twirp errors are internal errors.
+func twirpErrorInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (any, error) {
+
+	resp, err := handler(ctx, req)
+	if err == nil {
+		return resp, nil
+	}
+	var terr twirp.Error
+	if !errors.As(err, &terr) {
+		return resp, status.Error(codes.Internal, err.Error())
+	}
+	code, ok := grpcCodes[terr.Code()]
+	if !ok {
+		code = codes.Unknown
+	}
+	return resp, status.Error(code, terr.Msg())
+}
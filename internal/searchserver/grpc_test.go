@@ -0,0 +1,80 @@
+package searchserver
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/domino14/word_db_server/config"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func grpcTestClient(t *testing.T, searcher pb.QuestionSearcherServer) pb.QuestionSearcherClient {
+	lis := bufconn.Listen(1 << 20)
+	srv := NewGRPCServer(searcher, nil)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.Nil(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewQuestionSearcherClient(conn)
+}
+
+func TestGRPCSearchErrors(t *testing.T) {
+	client := grpcTestClient(t, &Server{Config: &config.Config{DataPath: t.TempDir()}})
+
+	_, err := client.Search(context.Background(), WordSearch([]*pb.SearchRequest_SearchParam{
+		SearchDescLexicon("NWL18"),
+		SearchDescLength(7, 7),
+	}, false))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "NWL18")
+}
+
+type notFoundSearcher struct{}
+
+func (notFoundSearcher) Search(context.Context, *pb.SearchRequest) (*pb.SearchResponse, error) {
+	return nil, twirp.NotFoundError("no such list")
+}
+
+func (notFoundSearcher) Expand(ctx context.Context, req *pb.SearchResponse) (*pb.SearchResponse, error) {
+	return req, nil
+}
+
+func (notFoundSearcher) MissedWords(context.Context, *pb.MissedWordsRequest) (*pb.MissedWordsResponse, error) {
+	return nil, twirp.NotFoundError("no such list")
+}
+
+func (notFoundSearcher) SolutionCharts(context.Context, *pb.SearchResponse) (*pb.SolutionChartsResponse, error) {
+	return nil, twirp.NotFoundError("no such list")
+}
+
+func TestGRPCErrorCodes(t *testing.T) {
+	client := grpcTestClient(t, notFoundSearcher{})
+
+	_, err := client.Search(context.Background(), &pb.SearchRequest{})
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.NotFound, st.Code())
+	assert.Equal(t, "no such list", st.Message())
+
+	resp, err := client.Expand(context.Background(), &pb.SearchResponse{SnapshotId: "abc",
+		Alphagrams: []*pb.Alphagram{{Alphagram: "IQ", Words: []*pb.Word{{Word: "QI"}}}}})
+	assert.Nil(t, err)
+	assert.Equal(t, "abc", resp.SnapshotId)
+	// The alphagrams were pre-encoded, and come back the same.
+	assert.Equal(t, "QI", resp.Alphagrams[0].Words[0].Word)
+	assert.Empty(t, resp.ProtoReflect().GetUnknown())
+}
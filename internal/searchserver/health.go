@@ -0,0 +1,116 @@
+package searchserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/domino14/word-golib/kwg"
+
+	"github.com/domino14/word_db_server/config"
+	"github.com/domino14/word_db_server/internal/common"
+)
+
+// LexiconHealth is the status of one lexicon's files.
+type LexiconHealth struct {
+	Lexicon   string `json:"lexicon"`
+	DBOpen    bool   `json:"db_open"`
+	DBVersion int    `json:"db_version,omitempty"`
+	DBError   string `json:"db_error,omitempty"`
+	// The DAWG (a KWG file) is needed for anagramming and for
+	// MATCHING_ANAGRAM searches. Custom lexica can do without one.
+	DAWGLoaded bool   `json:"dawg_loaded"`
+	DAWGError  string `json:"dawg_error,omitempty"`
+}
+
+// HealthReport is the status of every lexicon being served.
+type HealthReport struct {
+	// Ready is true if there is at least one lexicon, and every lexicon's
+	// database and DAWG (unless it's a custom lexicon) could be loaded.
+	Ready bool `json:"ready"`
+	// Draining is true if the server is shutting down; it's never ready
+	// then.
+	Draining bool             `json:"draining,omitempty"`
+	Lexica   []*LexiconHealth `json:"lexica"`
+}
+
+// servedLexica returns the names of the lexica that have a database, in
+// order.
+func servedLexica(ctx context.Context, cfg *config.Config) ([]string, error) {
+	store, err := lexiconStore(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return store.Lexica(ctx)
+}
+
+// CheckHealth opens every lexicon's database and loads its DAWG. DAWGs are
+// cached once loaded, so the first check also warms them up.
+func CheckHealth(ctx context.Context, cfg *config.Config) *HealthReport {
+	report := &HealthReport{Lexica: []*LexiconHealth{}}
+	lexica, err := servedLexica(ctx, cfg)
+	if err != nil {
+		return report
+	}
+	report.Ready = len(lexica) > 0
+	kwgCfg := map[string]any{"data-path": cfg.DataPath}
+	for _, lex := range lexica {
+		h := &LexiconHealth{Lexicon: lex}
+		if err := checkLexiconDB(ctx, cfg, h); err != nil {
+			h.DBError = err.Error()
+		} else {
+			h.DBOpen = true
+		}
+		if _, err := kwg.Get(kwgCfg, lex); err != nil {
+			h.DAWGError = err.Error()
+		} else {
+			h.DAWGLoaded = true
+		}
+		custom := common.IsCustomLexicon(cfg.DataPath, lex)
+		report.Ready = report.Ready && h.DBOpen && (h.DAWGLoaded || custom)
+		report.Lexica = append(report.Lexica, h)
+	}
+	return report
+}
+
+func checkLexiconDB(ctx context.Context, cfg *config.Config, h *LexiconHealth) error {
+	db, err := getDbConnection(cfg, h.Lexicon)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	return db.QueryRowContext(ctx, `SELECT version FROM db_version`).Scan(&h.DBVersion)
+}
+
+// HealthzHandler reports the health of the lexica. It always responds with
+// 200 while the server is up, since restarting it won't fix a bad lexicon
+// file; see ReadyzHandler for gating traffic.
+func HealthzHandler(cfg *config.Config) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeHealth(w, CheckHealth(r.Context(), cfg), http.StatusOK)
+	})
+}
+
+// ReadyzHandler is like HealthzHandler, but responds with 503 unless the
+// server is ready to serve every lexicon and isn't draining. drain may be
+// nil.
+func ReadyzHandler(cfg *config.Config, drain *Drain) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := CheckHealth(r.Context(), cfg)
+		if drain.Draining() {
+			report.Ready = false
+			report.Draining = true
+		}
+		status := http.StatusOK
+		if !report.Ready {
+			status = http.StatusServiceUnavailable
+		}
+		writeHealth(w, report, status)
+	})
+}
+
+func writeHealth(w http.ResponseWriter, report *HealthReport, status int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(report)
+}
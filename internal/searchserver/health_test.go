@@ -0,0 +1,57 @@
+package searchserver
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/domino14/word_db_server/config"
+)
+
+func TestCheckHealth(t *testing.T) {
+	dataPath := t.TempDir()
+	cfg := &config.Config{DataPath: dataPath}
+	// No lexica at all isn't ready.
+	assert.False(t, CheckHealth(context.Background(), cfg).Ready)
+
+	dbDir := filepath.Join(dataPath, "lexica", "db")
+	assert.Nil(t, os.MkdirAll(dbDir, 0755))
+	db, err := sql.Open("sqlite3", filepath.Join(dbDir, "FOO.db"))
+	assert.Nil(t, err)
+	_, err = db.Exec(`CREATE TABLE db_version (version integer);
+		INSERT INTO db_version VALUES (14);`)
+	assert.Nil(t, err)
+	db.Close()
+	assert.Nil(t, os.WriteFile(filepath.Join(dbDir, "BAR.db"), []byte("not a database"), 0644))
+
+	report := CheckHealth(context.Background(), cfg)
+	assert.False(t, report.Ready)
+	assert.Equal(t, 2, len(report.Lexica))
+	bar, foo := report.Lexica[0], report.Lexica[1]
+	assert.Equal(t, "BAR", bar.Lexicon)
+	assert.False(t, bar.DBOpen)
+	assert.NotEqual(t, "", bar.DBError)
+	assert.Equal(t, "FOO", foo.Lexicon)
+	assert.True(t, foo.DBOpen)
+	assert.Equal(t, 14, foo.DBVersion)
+	// There's no KWG for it.
+	assert.False(t, foo.DAWGLoaded)
+	assert.NotEqual(t, "", foo.DAWGError)
+
+	rec := httptest.NewRecorder()
+	ReadyzHandler(cfg, nil).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	rec = httptest.NewRecorder()
+	HealthzHandler(cfg).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var decoded HealthReport
+	assert.Nil(t, json.NewDecoder(rec.Body).Decode(&decoded))
+	assert.Equal(t, 14, decoded.Lexica[1].DBVersion)
+}
@@ -36,6 +36,20 @@ func SearchDescDifficultyRange(min int, max int) *pb.SearchRequest_SearchParam {
 	}
 }
 
+func SearchDescPlayabilityRange(min int, max int) *pb.SearchRequest_SearchParam {
+	return &pb.SearchRequest_SearchParam{
+		Condition:      pb.SearchRequest_PLAYABILITY_RANGE,
+		Conditionparam: minMaxParam(min, max),
+	}
+}
+
+func SearchDescVowelProbRange(min int, max int) *pb.SearchRequest_SearchParam {
+	return &pb.SearchRequest_SearchParam{
+		Condition:      pb.SearchRequest_VOWEL_PROBABILITY_RANGE,
+		Conditionparam: minMaxParam(min, max),
+	}
+}
+
 func SearchDescProbLimit(min int, max int) *pb.SearchRequest_SearchParam {
 	return &pb.SearchRequest_SearchParam{
 		Condition:      pb.SearchRequest_PROBABILITY_LIMIT,
@@ -43,6 +57,27 @@ func SearchDescProbLimit(min int, max int) *pb.SearchRequest_SearchParam {
 	}
 }
 
+func SearchDescProbLimitPerLength(min int, max int) *pb.SearchRequest_SearchParam {
+	return &pb.SearchRequest_SearchParam{
+		Condition:      pb.SearchRequest_PROBABILITY_LIMIT_PER_LENGTH,
+		Conditionparam: minMaxParam(min, max),
+	}
+}
+
+func SearchDescMostProbable(min int, max int) *pb.SearchRequest_SearchParam {
+	return &pb.SearchRequest_SearchParam{
+		Condition:      pb.SearchRequest_MOST_PROBABLE,
+		Conditionparam: minMaxParam(min, max),
+	}
+}
+
+func SearchDescLeastProbable(min int, max int) *pb.SearchRequest_SearchParam {
+	return &pb.SearchRequest_SearchParam{
+		Condition:      pb.SearchRequest_LEAST_PROBABLE,
+		Conditionparam: minMaxParam(min, max),
+	}
+}
+
 func SearchDescPointValue(min int, max int) *pb.SearchRequest_SearchParam {
 	return &pb.SearchRequest_SearchParam{
 		Condition:      pb.SearchRequest_POINT_VALUE,
@@ -57,6 +92,32 @@ func SearchDescNumAnagrams(min int, max int) *pb.SearchRequest_SearchParam {
 	}
 }
 
+func SearchDescNumBlankAnagrams(min int, max int) *pb.SearchRequest_SearchParam {
+	return &pb.SearchRequest_SearchParam{
+		Condition:      pb.SearchRequest_NUMBER_OF_BLANK_ANAGRAMS,
+		Conditionparam: minMaxParam(min, max),
+	}
+}
+
+func SearchDescCombinations(min int64, max int64) *pb.SearchRequest_SearchParam {
+	return &pb.SearchRequest_SearchParam{
+		Condition: pb.SearchRequest_COMBINATIONS_RANGE,
+		Conditionparam: &pb.SearchRequest_SearchParam_Minmax64{
+			Minmax64: &pb.SearchRequest_MinMax64{Min: min, Max: max},
+		},
+	}
+}
+
+func SearchDescCombinator(op pb.SearchRequest_Combinator_Op,
+	params ...*pb.SearchRequest_SearchParam) *pb.SearchRequest_SearchParam {
+	return &pb.SearchRequest_SearchParam{
+		Condition: pb.SearchRequest_COMBINATOR,
+		Conditionparam: &pb.SearchRequest_SearchParam_Combinator{
+			Combinator: &pb.SearchRequest_Combinator{Op: op, Params: params},
+		},
+	}
+}
+
 func SearchDescAlphagramList(alphas []string) *pb.SearchRequest_SearchParam {
 	return &pb.SearchRequest_SearchParam{
 		Condition:      pb.SearchRequest_ALPHAGRAM_LIST,
@@ -78,6 +139,37 @@ func SearchDescProbabilityList(probs []int32) *pb.SearchRequest_SearchParam {
 	}
 }
 
+func SearchDescBuild(letters string, minLength, maxLength int32) *pb.SearchRequest_SearchParam {
+	return &pb.SearchRequest_SearchParam{
+		Condition: pb.SearchRequest_BUILD,
+		Conditionparam: &pb.SearchRequest_SearchParam_Build{
+			Build: &pb.SearchRequest_Build{Letters: letters, MinLength: minLength, MaxLength: maxLength},
+		},
+	}
+}
+
+func SearchDescOrderedAlphagramList(alphas []string) *pb.SearchRequest_SearchParam {
+	return &pb.SearchRequest_SearchParam{
+		Condition:      pb.SearchRequest_ORDERED_ALPHAGRAM_LIST,
+		Conditionparam: stringArrayParam(alphas),
+	}
+}
+
+// SearchDescOrderedProbabilityList takes the lengths and probabilities as
+// pairs: length, probability, length, probability...
+func SearchDescOrderedProbabilityList(pairs ...int32) *pb.SearchRequest_SearchParam {
+	vals := make([]*pb.SearchRequest_LengthProbability, 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		vals = append(vals, &pb.SearchRequest_LengthProbability{Length: pairs[i], Probability: pairs[i+1]})
+	}
+	return &pb.SearchRequest_SearchParam{
+		Condition: pb.SearchRequest_ORDERED_PROBABILITY_LIST,
+		Conditionparam: &pb.SearchRequest_SearchParam_Lengthprobabilities{
+			Lengthprobabilities: &pb.SearchRequest_LengthProbabilityList{Values: vals},
+		},
+	}
+}
+
 func SearchDescNotInLexicon(n pb.SearchRequest_NotInLexCondition) *pb.SearchRequest_SearchParam {
 	return &pb.SearchRequest_SearchParam{
 		Condition:      pb.SearchRequest_NOT_IN_LEXICON,
@@ -85,6 +177,84 @@ func SearchDescNotInLexicon(n pb.SearchRequest_NotInLexCondition) *pb.SearchRequ
 	}
 }
 
+func SearchDescNumFrontHooks(min int, max int) *pb.SearchRequest_SearchParam {
+	return &pb.SearchRequest_SearchParam{
+		Condition:      pb.SearchRequest_NUMBER_OF_FRONT_HOOKS,
+		Conditionparam: minMaxParam(min, max),
+	}
+}
+
+func SearchDescNumBackHooks(min int, max int) *pb.SearchRequest_SearchParam {
+	return &pb.SearchRequest_SearchParam{
+		Condition:      pb.SearchRequest_NUMBER_OF_BACK_HOOKS,
+		Conditionparam: minMaxParam(min, max),
+	}
+}
+
+func SearchDescHasInnerHooks() *pb.SearchRequest_SearchParam {
+	return &pb.SearchRequest_SearchParam{Condition: pb.SearchRequest_HAS_INNER_HOOKS}
+}
+
+func SearchDescNoInnerHooks() *pb.SearchRequest_SearchParam {
+	return &pb.SearchRequest_SearchParam{Condition: pb.SearchRequest_NO_INNER_HOOKS}
+}
+
+func SearchDescAddedInLastUpdates(n int) *pb.SearchRequest_SearchParam {
+	return &pb.SearchRequest_SearchParam{
+		Condition:      pb.SearchRequest_ADDED_IN_LAST_UPDATES,
+		Conditionparam: numberParam(n),
+	}
+}
+
+func SearchDescStemPlusOne(stem string) *pb.SearchRequest_SearchParam {
+	return &pb.SearchRequest_SearchParam{
+		Condition:      pb.SearchRequest_STEM_PLUS_ONE,
+		Conditionparam: stringParam(stem),
+	}
+}
+
+func SearchDescFrontHooksInclude(letters string) *pb.SearchRequest_SearchParam {
+	return &pb.SearchRequest_SearchParam{
+		Condition:      pb.SearchRequest_FRONT_HOOKS_INCLUDE,
+		Conditionparam: stringParam(letters),
+	}
+}
+
+func SearchDescBackHooksInclude(letters string) *pb.SearchRequest_SearchParam {
+	return &pb.SearchRequest_SearchParam{
+		Condition:      pb.SearchRequest_BACK_HOOKS_INCLUDE,
+		Conditionparam: stringParam(letters),
+	}
+}
+
+func SearchDescDefinitionContains(terms string) *pb.SearchRequest_SearchParam {
+	return &pb.SearchRequest_SearchParam{
+		Condition:      pb.SearchRequest_DEFINITION_CONTAINS,
+		Conditionparam: stringParam(terms),
+	}
+}
+
+func SearchDescHasTag(tag string) *pb.SearchRequest_SearchParam {
+	return &pb.SearchRequest_SearchParam{
+		Condition:      pb.SearchRequest_HAS_TAG,
+		Conditionparam: stringParam(tag),
+	}
+}
+
+func SearchDescContainsLetters(letters string) *pb.SearchRequest_SearchParam {
+	return &pb.SearchRequest_SearchParam{
+		Condition:      pb.SearchRequest_CONTAINS_LETTERS,
+		Conditionparam: stringParam(letters),
+	}
+}
+
+func SearchDescExcludesLetters(letters string) *pb.SearchRequest_SearchParam {
+	return &pb.SearchRequest_SearchParam{
+		Condition:      pb.SearchRequest_EXCLUDES_LETTERS,
+		Conditionparam: stringParam(letters),
+	}
+}
+
 func stringArrayParam(sa []string) *pb.SearchRequest_SearchParam_Stringarray {
 	return &pb.SearchRequest_SearchParam_Stringarray{
 		Stringarray: &pb.SearchRequest_StringArray{
@@ -109,6 +279,30 @@ func stringParam(str string) *pb.SearchRequest_SearchParam_Stringvalue {
 	}
 }
 
+func SearchDescRandomSample(count int, seed int64) *pb.SearchRequest_SearchParam {
+	return &pb.SearchRequest_SearchParam{
+		Condition: pb.SearchRequest_RANDOM_SAMPLE,
+		Conditionparam: &pb.SearchRequest_SearchParam_Randomsample{
+			Randomsample: &pb.SearchRequest_RandomSample{
+				Count: int32(count),
+				Seed:  seed,
+			},
+		},
+	}
+}
+
+func SearchDescLexiconDiff(other string, mode pb.SearchRequest_LexiconDiff_Mode) *pb.SearchRequest_SearchParam {
+	return &pb.SearchRequest_SearchParam{
+		Condition: pb.SearchRequest_LEXICON_DIFF,
+		Conditionparam: &pb.SearchRequest_SearchParam_Lexicondiff{
+			Lexicondiff: &pb.SearchRequest_LexiconDiff{
+				OtherLexicon: other,
+				Mode:         mode,
+			},
+		},
+	}
+}
+
 func minMaxParam(min int, max int) *pb.SearchRequest_SearchParam_Minmax {
 	return &pb.SearchRequest_SearchParam_Minmax{
 		Minmax: &pb.SearchRequest_MinMax{
@@ -0,0 +1,322 @@
+package searchserver
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/word_db_server/config"
+	"github.com/domino14/word_db_server/internal/querygen"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// As search conditions are added, it's easy to miss one that scans the
+// whole alphagrams table, or to keep an index that nothing uses any more.
+// AdviseIndexes asks SQLite how it would run a recording of real searches
+// (see Recorder), and reports both.
+
+// IndexReport is the index advice for one lexicon database.
+type IndexReport struct {
+	Lexicon string
+	// Searches is how many recorded searches were of the lexicon, and
+	// Skipped how many of those couldn't be planned, e.g. as they were
+	// invalid or compared lexica.
+	Searches int
+	Skipped  int
+	// Missing are the columns that searches scanned a whole table to
+	// filter by, the ones that cost the most time first.
+	Missing []*MissingIndex
+	// Used has how many searches used each of the database's indexes.
+	// Unused are the ones none did.
+	Used   map[string]int
+	Unused []string
+}
+
+// MissingIndex is a column without an index that searches had to scan a
+// whole table for.
+type MissingIndex struct {
+	Table  string
+	Column string
+	// Searches is how many searches would have used an index, and Elapsed
+	// how long they took when they were recorded, which is the most that
+	// one could save.
+	Searches int
+	Elapsed  time.Duration
+	// Rows is how many rows each of those searches scans now, and
+	// RowsPerValue how many an index would find for each of the column's
+	// values.
+	Rows         int64
+	RowsPerValue float64
+	// Automatic is whether SQLite built a temporary index on the column
+	// for each search, which it does for joins.
+	Automatic bool
+}
+
+// CreateStatement returns the SQL that adds the index.
+func (m *MissingIndex) CreateStatement() string {
+	return fmt.Sprintf("CREATE INDEX %s_index ON %s(%s);", m.Column, m.Table, m.Column)
+}
+
+// planDetail matches the lines of EXPLAIN QUERY PLAN that read a table, like
+// "SCAN alphagrams" or "SEARCH w USING AUTOMATIC COVERING INDEX
+// (alphagram=?)".
+var planDetail = regexp.MustCompile(`^(SCAN|SEARCH) (?:TABLE )?(\w+)(?: AS \w+)?` +
+	`(?: USING (AUTOMATIC )?(?:COVERING |PARTIAL )*INDEX(?: (\w+))?(?: \((.*)\))?)?`)
+
+// tableAlias matches a table and its alias in a FROM or JOIN.
+var tableAlias = regexp.MustCompile(`(?i)\b(?:FROM|JOIN)\s+(\w+)\s+(?:AS\s+)?(\w+)`)
+
+// filteredColumn matches a column compared to something in a WHERE clause,
+// as querygen writes them, like alphagrams.length BETWEEN ? and ?.
+var filteredColumn = regexp.MustCompile(`\b(\w+)\.(\w+)\s*(?:=|<|>|(?i:BETWEEN|IN|LIKE)\b)`)
+
+// AdviseIndexes plans the recorded searches, which took the given times,
+// against the lexicon databases in the config's data path. It returns a
+// report for each lexicon that was searched, in the order of their names.
+func AdviseIndexes(ctx context.Context, cfg *config.Config, reqs []*pb.SearchRequest,
+	elapsed []time.Duration) ([]*IndexReport, error) {
+
+	if cfg.WordDBDSN != "" {
+		return nil, errNotOnPostgres("index advice")
+	}
+	advisors := map[string]*indexAdvisor{}
+	defer func() {
+		for _, a := range advisors {
+			if a != nil {
+				a.db.Close()
+			}
+		}
+	}()
+	for i, req := range reqs {
+		if len(req.Searchparams) == 0 {
+			continue
+		}
+		lexName := req.Searchparams[0].GetStringvalue().GetValue()
+		a, ok := advisors[lexName]
+		if !ok {
+			var err error
+			if a, err = newIndexAdvisor(ctx, cfg, lexName); err != nil {
+				// Clients can search for lexica that aren't there.
+				log.Warn().Err(err).Str("lexicon", lexName).Msg("index-advice-skipping-lexicon")
+			}
+			advisors[lexName] = a
+		}
+		if a == nil {
+			continue
+		}
+		var took time.Duration
+		if i < len(elapsed) {
+			took = elapsed[i]
+		}
+		a.report.Searches++
+		if err := a.plan(ctx, cfg, req, took); err != nil {
+			a.report.Skipped++
+		}
+	}
+
+	reports := []*IndexReport{}
+	for _, a := range advisors {
+		if a == nil {
+			continue
+		}
+		report, err := a.finish(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("lexicon %v: %w", a.report.Lexicon, err)
+		}
+		reports = append(reports, report)
+	}
+	sort.Slice(reports, func(i, j int) bool { return reports[i].Lexicon < reports[j].Lexicon })
+	return reports, nil
+}
+
+// indexAdvisor collects the plans of one lexicon's searches.
+type indexAdvisor struct {
+	db     *sql.DB
+	report *IndexReport
+	stats  *querygen.TableStats
+	// leading has the tables and columns that an index starts with.
+	leading map[string]bool
+	missing map[string]*MissingIndex
+}
+
+func newIndexAdvisor(ctx context.Context, cfg *config.Config, lexName string) (*indexAdvisor, error) {
+	db, err := getDbConnection(cfg, lexName)
+	if err != nil {
+		return nil, err
+	}
+	a := &indexAdvisor{
+		db:      db,
+		report:  &IndexReport{Lexicon: lexName, Used: map[string]int{}},
+		leading: map[string]bool{},
+		missing: map[string]*MissingIndex{},
+	}
+	rows, err := db.QueryContext(ctx, `SELECT m.name, m.tbl_name, i.name FROM sqlite_master m
+		JOIN pragma_index_info(m.name) i ON i.seqno = 0
+		WHERE m.type = 'index' AND m.name NOT LIKE 'sqlite_autoindex%'`)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name, table, column string
+		if err := rows.Scan(&name, &table, &column); err != nil {
+			db.Close()
+			return nil, err
+		}
+		a.report.Used[name] = 0
+		a.leading[table+"."+column] = true
+	}
+	if err := rows.Err(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	// As Search does, so that the plans are the same. Without them the
+	// conditions are applied in order.
+	a.stats, _ = alphagramStats(db)
+	return a, nil
+}
+
+// plan explains the queries of a search, and notes the indexes they use and
+// the ones they're missing.
+func (a *indexAdvisor) plan(ctx context.Context, cfg *config.Config, req *pb.SearchRequest,
+	took time.Duration) error {
+
+	qgen, err := createQueryGen(req, cfg, MaxSQLChunkSize)
+	if err != nil {
+		return err
+	}
+	if qgen.LexiconDiff() != nil {
+		return fmt.Errorf("lexicon diffs need another database attached")
+	}
+	qgen.SetStats(a.stats)
+	queries, err := qgen.Generate()
+	if err != nil {
+		return err
+	}
+	used := map[string]bool{}
+	missing := map[string]bool{}
+	for _, q := range queries {
+		rows, err := a.db.QueryContext(ctx, "EXPLAIN QUERY PLAN "+q.Rendered(), q.BindParams()...)
+		if err != nil {
+			return err
+		}
+		details := []string{}
+		for rows.Next() {
+			var id, parent, notused int
+			var detail string
+			if err := rows.Scan(&id, &parent, &notused, &detail); err != nil {
+				rows.Close()
+				return err
+			}
+			details = append(details, detail)
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return err
+		}
+		a.readPlan(q.Rendered(), details, used, missing)
+	}
+	for name := range used {
+		a.report.Used[name]++
+	}
+	for key := range missing {
+		m := a.missing[key]
+		m.Searches++
+		m.Elapsed += took
+	}
+	return nil
+}
+
+// readPlan reads the plan of a query, adding the names of the indexes it
+// uses to used, and the columns it lacks indexes on to missing.
+func (a *indexAdvisor) readPlan(query string, details []string, used, missing map[string]bool) {
+	aliases := map[string]string{}
+	for _, m := range tableAlias.FindAllStringSubmatch(query, -1) {
+		aliases[m[2]] = m[1]
+	}
+	note := func(table, column string, automatic bool) {
+		key := table + "." + column
+		if a.leading[key] {
+			return
+		}
+		if a.missing[key] == nil {
+			a.missing[key] = &MissingIndex{Table: table, Column: column}
+		}
+		a.missing[key].Automatic = a.missing[key].Automatic || automatic
+		missing[key] = true
+	}
+	for _, detail := range details {
+		m := planDetail.FindStringSubmatch(detail)
+		if m == nil {
+			continue
+		}
+		table := m[2]
+		if t, ok := aliases[table]; ok {
+			table = t
+		}
+		switch {
+		case m[3] != "":
+			// SQLite made an index for the search; it's on the columns it
+			// looked up.
+			for _, cond := range strings.Split(m[5], " AND ") {
+				column := strings.TrimRight(cond, "=<>?")
+				if column != "" {
+					note(table, column, true)
+				}
+			}
+		case m[4] != "":
+			used[m[4]] = true
+		case m[1] == "SCAN":
+			for _, f := range filteredColumn.FindAllStringSubmatch(query, -1) {
+				if f[1] == table {
+					note(table, f[2], false)
+				}
+			}
+		}
+	}
+}
+
+// finish completes the report with the size of the tables that are missing
+// indexes.
+func (a *indexAdvisor) finish(ctx context.Context) (*IndexReport, error) {
+	for _, m := range a.missing {
+		if m.Searches == 0 {
+			continue
+		}
+		var distinct int64
+		err := a.db.QueryRowContext(ctx, fmt.Sprintf(`SELECT COUNT(*), COUNT(DISTINCT %s) FROM %s`,
+			m.Column, m.Table)).Scan(&m.Rows, &distinct)
+		if err != nil {
+			return nil, err
+		}
+		if distinct > 0 {
+			m.RowsPerValue = float64(m.Rows) / float64(distinct)
+		}
+		a.report.Missing = append(a.report.Missing, m)
+	}
+	sort.Slice(a.report.Missing, func(i, j int) bool {
+		mi, mj := a.report.Missing[i], a.report.Missing[j]
+		if mi.Elapsed != mj.Elapsed {
+			return mi.Elapsed > mj.Elapsed
+		}
+		if mi.Searches != mj.Searches {
+			return mi.Searches > mj.Searches
+		}
+		return mi.Table+"."+mi.Column < mj.Table+"."+mj.Column
+	})
+	for name, n := range a.report.Used {
+		if n == 0 {
+			a.report.Unused = append(a.report.Unused, name)
+		}
+	}
+	sort.Strings(a.report.Unused)
+	return a.report, nil
+}
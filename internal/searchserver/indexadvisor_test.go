@@ -0,0 +1,67 @@
+package searchserver
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/domino14/word_db_server/config"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestAdviseIndexes(t *testing.T) {
+	cfg := &config.Config{DataPath: makeExpandLexicon(t)}
+	db, err := sql.Open("sqlite3", filepath.Join(cfg.DataPath, "lexica", "db", "FOO.db"))
+	assert.Nil(t, err)
+	_, err = db.Exec(`CREATE INDEX prob_index ON alphagrams(probability, length);
+		CREATE INDEX difficulty_index ON alphagrams(difficulty);`)
+	assert.Nil(t, err)
+	db.Close()
+
+	reqs := []*pb.SearchRequest{
+		WordSearch([]*pb.SearchRequest_SearchParam{
+			SearchDescLexicon("FOO"), SearchDescLength(2, 3)}, true),
+		WordSearch([]*pb.SearchRequest_SearchParam{
+			SearchDescLexicon("FOO"), SearchDescLength(2, 2)}, false),
+		WordSearch([]*pb.SearchRequest_SearchParam{
+			SearchDescLexicon("FOO"), SearchDescProbRange(1, 2)}, true),
+		// Not valid, so skipped.
+		WordSearch([]*pb.SearchRequest_SearchParam{
+			SearchDescLexicon("FOO"), SearchDescLexicon("FOO")}, true),
+		WordSearch([]*pb.SearchRequest_SearchParam{
+			SearchDescLexicon("NOPE"), SearchDescLength(2, 3)}, true),
+	}
+	elapsed := []time.Duration{time.Second, 2 * time.Second, time.Second, time.Second, time.Second}
+	reports, err := AdviseIndexes(context.Background(), cfg, reqs, elapsed)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(reports))
+	r := reports[0]
+	assert.Equal(t, "FOO", r.Lexicon)
+	assert.Equal(t, 4, r.Searches)
+	assert.Equal(t, 1, r.Skipped)
+	assert.Equal(t, map[string]int{"prob_index": 1, "difficulty_index": 0}, r.Used)
+	assert.Equal(t, []string{"difficulty_index"}, r.Unused)
+
+	missing := map[string]*MissingIndex{}
+	for _, m := range r.Missing {
+		missing[m.Table+"."+m.Column] = m
+	}
+	length := missing["alphagrams.length"]
+	assert.NotNil(t, length)
+	assert.Equal(t, 2, length.Searches)
+	assert.Equal(t, 3*time.Second, length.Elapsed)
+	assert.Equal(t, int64(3), length.Rows)
+	assert.Equal(t, 1.5, length.RowsPerValue)
+	assert.False(t, length.Automatic)
+	assert.Equal(t, "CREATE INDEX length_index ON alphagrams(length);", length.CreateStatement())
+	// The words are joined on their alphagram.
+	alphagram := missing["words.alphagram"]
+	assert.NotNil(t, alphagram)
+	assert.Equal(t, 3, alphagram.Searches)
+	assert.True(t, alphagram.Automatic)
+	assert.Equal(t, r.Missing[0], alphagram)
+}
@@ -0,0 +1,75 @@
+package searchserver
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/domino14/word_db_server/config"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func makeDiffLexicon(t *testing.T, dir, name string, words map[string]string) {
+	db, err := sql.Open("sqlite3", filepath.Join(dir, name+".db"))
+	assert.Nil(t, err)
+	defer db.Close()
+	_, err = db.Exec(`CREATE TABLE alphagrams (alphagram varchar(20), probability int, length int);
+		CREATE TABLE words (word varchar(20), alphagram varchar(20));`)
+	assert.Nil(t, err)
+	alphs := map[string]bool{}
+	for w, a := range words {
+		_, err = db.Exec(`INSERT INTO words VALUES(?, ?)`, w, a)
+		assert.Nil(t, err)
+		if !alphs[a] {
+			alphs[a] = true
+			_, err = db.Exec(`INSERT INTO alphagrams VALUES(?, ?, ?)`, a, len(alphs), len(a))
+			assert.Nil(t, err)
+		}
+	}
+}
+
+func TestLexiconDiffSearch(t *testing.T) {
+	dataPath := t.TempDir()
+	dbDir := filepath.Join(dataPath, "lexica", "db")
+	assert.Nil(t, os.MkdirAll(dbDir, 0755))
+	makeDiffLexicon(t, dbDir, "BIG", map[string]string{
+		"QI": "IQ", "ZA": "AZ", "CAT": "ACT", "ACT": "ACT", "EVO": "EOV"})
+	makeDiffLexicon(t, dbDir, "SMALL", map[string]string{
+		"QI": "IQ", "ZA": "AZ", "CAT": "ACT", "TAC": "ACT"})
+
+	s := &Server{Config: &config.Config{DataPath: dataPath}}
+	search := func(lex, other string, mode pb.SearchRequest_LexiconDiff_Mode) []string {
+		resp, err := s.Search(context.Background(), &pb.SearchRequest{
+			Searchparams: []*pb.SearchRequest_SearchParam{
+				SearchDescLexicon(lex),
+				SearchDescLexiconDiff(other, mode),
+			},
+		})
+		assert.Nil(t, err)
+		return alphagrams(resp)
+	}
+	assert.ElementsMatch(t, []string{"ACT", "EOV"},
+		search("BIG", "SMALL", pb.SearchRequest_LexiconDiff_NOT_IN_OTHER))
+	assert.ElementsMatch(t, []string{"ACT"},
+		search("SMALL", "BIG", pb.SearchRequest_LexiconDiff_NOT_IN_OTHER))
+	assert.ElementsMatch(t, []string{"ACT", "EOV"},
+		search("BIG", "SMALL", pb.SearchRequest_LexiconDiff_WORDS_DIFFER))
+
+	// The connection gets the other lexicon detached again. EOV is only in
+	// BIG, so searching SMALL doesn't find it; only its own alphagrams can
+	// match.
+	assert.ElementsMatch(t, []string{"ACT"},
+		search("SMALL", "BIG", pb.SearchRequest_LexiconDiff_WORDS_DIFFER))
+
+	_, err := s.Search(context.Background(), &pb.SearchRequest{
+		Searchparams: []*pb.SearchRequest_SearchParam{
+			SearchDescLexicon("BIG"),
+			SearchDescLexiconDiff("../BIG", pb.SearchRequest_LexiconDiff_NOT_IN_OTHER),
+		},
+	})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,246 @@
+package searchserver
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/word_db_server/config"
+	"github.com/domino14/word_db_server/internal/common"
+	"github.com/domino14/word_db_server/internal/localize"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// LexiconInfoServer implements the LexiconInfo service.
+type LexiconInfoServer struct {
+	Config *config.Config
+	// Translations, if set, translate the symbols' labels and the
+	// descriptions into the locale asked for.
+	Translations localize.Translations
+}
+
+// GetLexiconMetadata returns what we know about the lexicon. Most of it
+// comes from the lexicon database; the letter distribution comes from the
+// data path.
+func (s *LexiconInfoServer) GetLexiconMetadata(ctx context.Context, req *pb.LexiconMetadataRequest) (
+	*pb.LexiconMetadata, error) {
+
+	if req.Lexicon == "" {
+		return nil, twirp.RequiredArgumentError("lexicon")
+	}
+	db, err := getDbConnection(s.Config, req.Lexicon)
+	if err != nil {
+		return nil, twirp.NotFoundError(err.Error())
+	}
+	defer db.Close()
+
+	md := &pb.LexiconMetadata{Lexicon: req.Lexicon}
+	err = db.QueryRowContext(ctx, `SELECT version FROM db_version`).Scan(&md.DbVersion)
+	if err != nil {
+		return nil, err
+	}
+	stored, err := storedLexiconMetadata(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	md.Family = stored["family"]
+	md.DescriptiveName = stored["descriptive_name"]
+
+	if md.LengthCounts, err = lengthCounts(ctx, db); err != nil {
+		return nil, err
+	}
+	if md.LexiconSymbols, err = lexiconSymbols(ctx, db, stored["lexicon_symbols"]); err != nil {
+		return nil, err
+	}
+	tr := s.Translations.For(req.Locale)
+	for _, sym := range md.LexiconSymbols {
+		sym.Label, sym.Description = tr.Symbol(sym.Symbol, sym.Label, sym.Description)
+	}
+	caps, err := servedCapabilities(s.Config, req.Lexicon, db)
+	if err != nil {
+		return nil, err
+	}
+	for c, enabled := range caps {
+		if enabled {
+			md.Capabilities = append(md.Capabilities, c)
+		}
+	}
+	sort.Strings(md.Capabilities)
+
+	dist, err := common.LetterDistribution(
+		map[string]any{"data-path": s.Config.DataPath}, req.Lexicon)
+	if err != nil {
+		return nil, err
+	}
+	md.LetterDistribution = letterDistributionTiles(dist)
+	return md, nil
+}
+
+// storedLexiconMetadata returns the lexicon_metadata table as a map. Older
+// databases don't have this table, so it's empty for them.
+func storedLexiconMetadata(ctx context.Context, db *sql.DB) (map[string]string, error) {
+	md := map[string]string{}
+	rows, err := db.QueryContext(ctx, `SELECT key, value FROM lexicon_metadata`)
+	if err != nil {
+		if strings.Contains(err.Error(), "no such table") {
+			return md, nil
+		}
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var k, v string
+		if err := rows.Scan(&k, &v); err != nil {
+			return nil, err
+		}
+		md[k] = v
+	}
+	return md, rows.Err()
+}
+
+func lengthCounts(ctx context.Context, db *sql.DB) ([]*pb.LexiconMetadata_LengthCount, error) {
+	rows, err := db.QueryContext(ctx, `
+	SELECT alphagrams.length, COUNT(words.word), COUNT(DISTINCT alphagrams.alphagram)
+	FROM alphagrams INNER JOIN words USING (alphagram)
+	GROUP BY alphagrams.length ORDER BY alphagrams.length`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	counts := []*pb.LexiconMetadata_LengthCount{}
+	for rows.Next() {
+		lc := &pb.LexiconMetadata_LengthCount{}
+		if err := rows.Scan(&lc.Length, &lc.NumWords, &lc.NumAlphagrams); err != nil {
+			return nil, err
+		}
+		counts = append(counts, lc)
+	}
+	return counts, rows.Err()
+}
+
+// lexiconSymbols returns the stored lexicon symbols, with their labels and
+// descriptions. If there are none stored, it returns the symbols actually
+// used by words, without either.
+func lexiconSymbols(ctx context.Context, db *sql.DB, stored string) ([]*pb.LexiconMetadata_LexiconSymbol, error) {
+	symbols := []*pb.LexiconMetadata_LexiconSymbol{}
+	if stored != "" {
+		if err := json.Unmarshal([]byte(stored), &symbols); err != nil {
+			return nil, err
+		}
+		return symbols, nil
+	}
+	rows, err := db.QueryContext(ctx, `SELECT DISTINCT lexicon_symbols FROM words`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	seen := map[string]bool{}
+	for rows.Next() {
+		var s string
+		if err := rows.Scan(&s); err != nil {
+			return nil, err
+		}
+		for _, r := range s {
+			seen[string(r)] = true
+		}
+	}
+	for sym := range seen {
+		symbols = append(symbols, &pb.LexiconMetadata_LexiconSymbol{Symbol: sym})
+	}
+	sort.Slice(symbols, func(i, j int) bool { return symbols[i].Symbol < symbols[j].Symbol })
+	return symbols, rows.Err()
+}
+
+func letterDistributionTiles(dist *tilemapping.LetterDistribution) []*pb.LexiconMetadata_Tile {
+	tm := dist.TileMapping()
+	tiles := make([]*pb.LexiconMetadata_Tile, 0, len(dist.Distribution()))
+	for i, ct := range dist.Distribution() {
+		ml := tilemapping.MachineLetter(i)
+		tiles = append(tiles, &pb.LexiconMetadata_Tile{
+			Letter: ml.UserVisible(tm, false),
+			Count:  int32(ct),
+			Score:  int32(dist.Score(ml)),
+			Vowel:  ml.IsVowel(dist),
+		})
+	}
+	return tiles
+}
+
+// GetLetterDistribution returns the lexicon's letter distribution. Like
+// ValidateRack, it only needs the data path, not the lexicon database.
+func (s *LexiconInfoServer) GetLetterDistribution(ctx context.Context, req *pb.LetterDistributionRequest) (
+	*pb.LetterDistribution, error) {
+
+	if req.Lexicon == "" {
+		return nil, twirp.RequiredArgumentError("lexicon")
+	}
+	dist, err := common.LetterDistribution(
+		map[string]any{"data-path": s.Config.DataPath}, req.Lexicon)
+	if err != nil {
+		return nil, twirp.NotFoundError(err.Error())
+	}
+	return &pb.LetterDistribution{
+		Lexicon:    req.Lexicon,
+		Name:       dist.Name,
+		Tiles:      letterDistributionTiles(dist),
+		TotalTiles: int32(dist.NumTotalLetters()),
+	}, nil
+}
+
+// ValidateRack checks whether the rack can be drawn from the lexicon's
+// letter distribution, and if not, which tiles there are too many of.
+func (s *LexiconInfoServer) ValidateRack(ctx context.Context, req *pb.RackValidationRequest) (
+	*pb.RackValidationResponse, error) {
+
+	if req.Lexicon == "" {
+		return nil, twirp.RequiredArgumentError("lexicon")
+	}
+	dist, err := common.LetterDistribution(
+		map[string]any{"data-path": s.Config.DataPath}, req.Lexicon)
+	if err != nil {
+		return nil, err
+	}
+	excess, err := rackExcessTiles(req.Rack, dist)
+	if err != nil {
+		return nil, twirp.InvalidArgumentError("rack", err.Error())
+	}
+	return &pb.RackValidationResponse{
+		Valid:       len(excess) == 0,
+		ExcessTiles: excess,
+	}, nil
+}
+
+func rackExcessTiles(rack string, dist *tilemapping.LetterDistribution) (
+	[]*pb.RackValidationResponse_ExcessTile, error) {
+
+	tm := dist.TileMapping()
+	// ToMachineLetters would turn these into played-through markers.
+	if strings.ContainsAny(rack, " "+string(tilemapping.ASCIIPlayedThrough)) {
+		return nil, fmt.Errorf("rack %v has played-through or empty squares", rack)
+	}
+	mls, err := tilemapping.ToMachineLetters(rack, tm)
+	if err != nil {
+		return nil, err
+	}
+	requested := make([]int32, len(dist.Distribution()))
+	for _, ml := range mls {
+		requested[ml.IntrinsicTileIdx()]++
+	}
+	excess := []*pb.RackValidationResponse_ExcessTile{}
+	for i, ct := range dist.Distribution() {
+		if requested[i] > int32(ct) {
+			excess = append(excess, &pb.RackValidationResponse_ExcessTile{
+				Letter:    tilemapping.MachineLetter(i).UserVisible(tm, false),
+				Requested: requested[i],
+				Available: int32(ct),
+			})
+		}
+	}
+	return excess, nil
+}
@@ -0,0 +1,121 @@
+package searchserver
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/stretchr/testify/assert"
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/word_db_server/config"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestLexiconMetadataFromDB(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.Nil(t, err)
+	defer db.Close()
+	ctx := context.Background()
+
+	_, err = db.Exec(`CREATE TABLE alphagrams (alphagram varchar(20), length integer);
+		CREATE TABLE words (word varchar(20), alphagram varchar(20), lexicon_symbols varchar(5));
+		INSERT INTO alphagrams VALUES('AB', 2), ('ABT', 3), ('ACT', 3);
+		INSERT INTO words VALUES('AB', 'AB', ''), ('BA', 'AB', '+'), ('BAT', 'ABT', '#'),
+			('TAB', 'ABT', ''), ('ACT', 'ACT', '+'), ('CAT', 'ACT', '');`)
+	assert.Nil(t, err)
+
+	counts, err := lengthCounts(ctx, db)
+	assert.Nil(t, err)
+	assert.Equal(t, []*pb.LexiconMetadata_LengthCount{
+		{Length: 2, NumWords: 2, NumAlphagrams: 1},
+		{Length: 3, NumWords: 4, NumAlphagrams: 2},
+	}, counts)
+
+	// Without a lexicon_metadata table, the symbols come from the words.
+	stored, err := storedLexiconMetadata(ctx, db)
+	assert.Nil(t, err)
+	assert.Empty(t, stored)
+	symbols, err := lexiconSymbols(ctx, db, stored["lexicon_symbols"])
+	assert.Nil(t, err)
+	assert.Equal(t, []*pb.LexiconMetadata_LexiconSymbol{
+		{Symbol: "#"}, {Symbol: "+"},
+	}, symbols)
+
+	_, err = db.Exec(`CREATE TABLE lexicon_metadata (key varchar(32) PRIMARY KEY, value text);
+		INSERT INTO lexicon_metadata VALUES('family', 'TWL'),
+			('lexicon_symbols', '[{"symbol":"+","description":"new in this version","label":"new"}]');`)
+	assert.Nil(t, err)
+	stored, err = storedLexiconMetadata(ctx, db)
+	assert.Nil(t, err)
+	assert.Equal(t, "TWL", stored["family"])
+	symbols, err = lexiconSymbols(ctx, db, stored["lexicon_symbols"])
+	assert.Nil(t, err)
+	assert.Equal(t, []*pb.LexiconMetadata_LexiconSymbol{
+		{Symbol: "+", Description: "new in this version", Label: "new"},
+	}, symbols)
+}
+
+const miniSpanishDist = `?,2,0,0
+A,12,1,1
+CH,1,5,0
+E,12,1,1
+O,9,1,1
+R,5,1,0
+RR,1,8,0
+`
+
+func TestGetLetterDistribution(t *testing.T) {
+	dataPath := t.TempDir()
+	assert.Nil(t, os.MkdirAll(filepath.Join(dataPath, "letterdistributions"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(dataPath, "letterdistributions", "spanish"),
+		[]byte(miniSpanishDist), 0644))
+	s := &LexiconInfoServer{Config: &config.Config{DataPath: dataPath}}
+
+	dist, err := s.GetLetterDistribution(context.Background(),
+		&pb.LetterDistributionRequest{Lexicon: "FISE99"})
+	assert.Nil(t, err)
+	assert.Equal(t, "FISE99", dist.Lexicon)
+	assert.Equal(t, "spanish", dist.Name)
+	assert.Equal(t, int32(42), dist.TotalTiles)
+	assert.Equal(t, []*pb.LexiconMetadata_Tile{
+		{Letter: "?", Count: 2},
+		{Letter: "A", Count: 12, Score: 1, Vowel: true},
+		{Letter: "CH", Count: 1, Score: 5},
+		{Letter: "E", Count: 12, Score: 1, Vowel: true},
+		{Letter: "O", Count: 9, Score: 1, Vowel: true},
+		{Letter: "R", Count: 5, Score: 1},
+		{Letter: "RR", Count: 1, Score: 8},
+	}, dist.Tiles)
+
+	_, err = s.GetLetterDistribution(context.Background(), &pb.LetterDistributionRequest{})
+	assert.Equal(t, twirp.InvalidArgument, err.(twirp.Error).Code())
+	_, err = s.GetLetterDistribution(context.Background(),
+		&pb.LetterDistributionRequest{Lexicon: "NOPE"})
+	assert.Equal(t, twirp.NotFound, err.(twirp.Error).Code())
+}
+
+func TestRackExcessTiles(t *testing.T) {
+	ld, err := tilemapping.ScanLetterDistribution(strings.NewReader(miniSpanishDist))
+	assert.Nil(t, err)
+
+	excess, err := rackExcessTiles("CHAORR??", ld)
+	assert.Nil(t, err)
+	assert.Empty(t, excess)
+
+	excess, err = rackExcessTiles("CHCH??aA", ld)
+	assert.Nil(t, err)
+	assert.Equal(t, []*pb.RackValidationResponse_ExcessTile{
+		{Letter: "?", Requested: 3, Available: 2},
+		{Letter: "CH", Requested: 2, Available: 1},
+	}, excess)
+
+	_, err = rackExcessTiles("AQ", ld)
+	assert.NotNil(t, err)
+	_, err = rackExcessTiles("AE.", ld)
+	assert.NotNil(t, err)
+}
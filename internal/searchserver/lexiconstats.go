@@ -0,0 +1,94 @@
+package searchserver
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/twitchtv/twirp"
+
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// Stats returns the lexicon's statistics from the lexicon_stats table that
+// dbmaker writes.
+func (s *LexiconInfoServer) Stats(ctx context.Context, req *pb.LexiconStatsRequest) (
+	*pb.LexiconStats, error) {
+
+	if req.Lexicon == "" {
+		return nil, twirp.RequiredArgumentError("lexicon")
+	}
+	store, err := lexiconStore(s.Config)
+	if err != nil {
+		return nil, err
+	}
+	db, err := store.Open(req.Lexicon)
+	if err != nil {
+		return nil, twirp.NotFoundError(err.Error())
+	}
+	defer db.Close()
+
+	rows, err := db.QueryContext(ctx, `SELECT length, num_anagrams, num_alphagrams,
+		num_words, total_point_value, num_uniq_to_lex
+		FROM lexicon_stats ORDER BY length, num_anagrams`)
+	if err != nil {
+		if strings.Contains(err.Error(), "no such table") ||
+			strings.Contains(err.Error(), "does not exist") {
+			return nil, twirp.NewError(twirp.FailedPrecondition,
+				"this lexicon's database has no stats; migrate it")
+		}
+		return nil, err
+	}
+	defer rows.Close()
+
+	resp := &pb.LexiconStats{Lexicon: req.Lexicon, Totals: &pb.LexiconStats_LengthStats{}}
+	// The point value totals, by length, to average at the end.
+	points := map[int32]int64{}
+	var totalPoints int64
+	anagramCounts := map[int32]*pb.LexiconStats_AnagramCount{}
+	for rows.Next() {
+		var length, numAnagrams, numAlphagrams, numWords, numUniq int32
+		var pointValue int64
+		if err := rows.Scan(&length, &numAnagrams, &numAlphagrams, &numWords,
+			&pointValue, &numUniq); err != nil {
+			return nil, err
+		}
+		if n := len(resp.Lengths); n == 0 || resp.Lengths[n-1].Length != length {
+			resp.Lengths = append(resp.Lengths, &pb.LexiconStats_LengthStats{Length: length})
+		}
+		for _, ls := range []*pb.LexiconStats_LengthStats{resp.Lengths[len(resp.Lengths)-1], resp.Totals} {
+			ls.NumAlphagrams += numAlphagrams
+			ls.NumWords += numWords
+			ls.NumUniqToLex += numUniq
+		}
+		points[length] += pointValue
+		totalPoints += pointValue
+
+		ac := anagramCounts[numAnagrams]
+		if ac == nil {
+			ac = &pb.LexiconStats_AnagramCount{NumAnagrams: numAnagrams}
+			anagramCounts[numAnagrams] = ac
+			resp.AnagramCounts = append(resp.AnagramCounts, ac)
+		}
+		ac.NumAlphagrams += numAlphagrams
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	for _, ls := range resp.Lengths {
+		ls.AvgPointValue = averagePoints(points[ls.Length], ls.NumWords)
+	}
+	resp.Totals.AvgPointValue = averagePoints(totalPoints, resp.Totals.NumWords)
+	slices.SortFunc(resp.AnagramCounts, func(a, b *pb.LexiconStats_AnagramCount) int {
+		return cmp.Compare(a.NumAnagrams, b.NumAnagrams)
+	})
+	return resp, nil
+}
+
+func averagePoints(total int64, words int32) float64 {
+	if words == 0 {
+		return 0
+	}
+	return float64(total) / float64(words)
+}
@@ -0,0 +1,47 @@
+package searchserver
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/word_db_server/config"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestLexiconStats(t *testing.T) {
+	ctx := context.Background()
+	dataPath := makeExpandLexicon(t)
+	s := &LexiconInfoServer{Config: &config.Config{DataPath: dataPath}}
+
+	_, err := s.Stats(ctx, &pb.LexiconStatsRequest{Lexicon: "FOO"})
+	assert.Equal(t, twirp.FailedPrecondition, err.(twirp.Error).Code())
+
+	db, err := sql.Open("sqlite3", filepath.Join(dataPath, "lexica", "db", "FOO.db"))
+	assert.Nil(t, err)
+	defer db.Close()
+	_, err = db.Exec(`CREATE TABLE lexicon_stats (length int, num_anagrams int,
+		num_alphagrams int, num_words int, total_point_value int, num_uniq_to_lex int);
+		INSERT INTO lexicon_stats VALUES (2, 1, 2, 2, 22, 0), (2, 2, 1, 2, 6, 1),
+			(3, 1, 3, 3, 18, 2);`)
+	assert.Nil(t, err)
+
+	resp, err := s.Stats(ctx, &pb.LexiconStatsRequest{Lexicon: "FOO"})
+	assert.Nil(t, err)
+	assert.Equal(t, &pb.LexiconStats_LengthStats{NumAlphagrams: 6, NumWords: 7,
+		AvgPointValue: 46.0 / 7, NumUniqToLex: 3}, resp.Totals)
+	assert.Equal(t, []*pb.LexiconStats_LengthStats{
+		{Length: 2, NumAlphagrams: 3, NumWords: 4, AvgPointValue: 7, NumUniqToLex: 1},
+		{Length: 3, NumAlphagrams: 3, NumWords: 3, AvgPointValue: 6, NumUniqToLex: 2},
+	}, resp.Lengths)
+	assert.Equal(t, []*pb.LexiconStats_AnagramCount{
+		{NumAnagrams: 1, NumAlphagrams: 5}, {NumAnagrams: 2, NumAlphagrams: 1},
+	}, resp.AnagramCounts)
+
+	_, err = s.Stats(ctx, &pb.LexiconStatsRequest{Lexicon: "BAR"})
+	assert.Equal(t, twirp.NotFound, err.(twirp.Error).Code())
+}
@@ -0,0 +1,37 @@
+package searchserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/twitchtv/twirp"
+)
+
+// A search that matches most of a lexicon, like every length and every
+// probability, would otherwise hold a SQLite connection and the memory for
+// all of its alphagrams for as long as it took. Searches and expansions
+// are given the config's SearchTimeout, and searches return at most
+// MaxSearchResults alphagrams, reading no more rows than that.
+
+// withSearchTimeout returns the context to search with, which ends after
+// the config's SearchTimeout if it's set.
+func (s *Server) withSearchTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.Config.SearchTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, s.Config.SearchTimeout)
+}
+
+// searchError returns the error that a search or expansion failed with,
+// as a Twirp error if it ran out of time or was canceled.
+func searchError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return twirp.NewError(twirp.DeadlineExceeded,
+			fmt.Sprintf("the search took too long; narrow it down (%v)", err))
+	case errors.Is(ctx.Err(), context.Canceled):
+		return twirp.NewError(twirp.Canceled, "the search was canceled")
+	}
+	return err
+}
@@ -0,0 +1,127 @@
+package searchserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/word_db_server/config"
+	"github.com/domino14/word_db_server/internal/querygen"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestMaxSearchResults(t *testing.T) {
+	cfg := &config.Config{DataPath: makeExpandLexicon(t)}
+	db, err := getDbConnection(cfg, "FOO")
+	assert.Nil(t, err)
+	defer db.Close()
+	_, err = db.Exec(`INSERT INTO alphagrams VALUES ('AEV', 4, 1, 0, 'AEV', 0, 3, 2);
+		INSERT INTO words VALUES ('AVE', 'AEV', '', '', '', 'S', 0, 0, ''),
+		('VAE', 'AEV', '', '', '', 'S', 0, 0, '')`)
+	assert.Nil(t, err)
+
+	search := func(chunkSize, max int) ([]string, bool) {
+		qgen := querygen.NewQueryGen("FOO", querygen.FullExpanded, []*pb.SearchRequest_SearchParam{
+			SearchDescProbabilityList([]int32{1, 2, 3, 4})}, chunkSize, cfg)
+		queries, err := qgen.Generate()
+		assert.Nil(t, err)
+		alphas, truncated, err := combineQueryResults(context.Background(), queries, db, true,
+			qgen.Type(), max)
+		assert.Nil(t, err)
+		found := []string{}
+		for _, a := range alphas {
+			found = append(found, a.Alphagram)
+			if a.Alphagram == "AEV" {
+				assert.Equal(t, 2, len(a.Words))
+			}
+		}
+		return found, truncated
+	}
+	found, truncated := search(10, 0)
+	assert.Equal(t, []string{"IQ", "AZ", "EOV", "AEV"}, found)
+	assert.False(t, truncated)
+	found, truncated = search(10, 4)
+	assert.Equal(t, 4, len(found))
+	assert.False(t, truncated)
+	found, truncated = search(10, 2)
+	assert.Equal(t, []string{"IQ", "AZ"}, found)
+	assert.True(t, truncated)
+	// Cut off within the second query.
+	found, truncated = search(2, 3)
+	assert.Equal(t, []string{"IQ", "AZ", "EOV"}, found)
+	assert.True(t, truncated)
+	// Cut off exactly at the end of the first query.
+	found, truncated = search(2, 2)
+	assert.Equal(t, []string{"IQ", "AZ"}, found)
+	assert.True(t, truncated)
+}
+
+func TestSearchTimeout(t *testing.T) {
+	s := &Server{Config: &config.Config{SearchTimeout: time.Nanosecond}}
+	ctx, cancel := s.withSearchTimeout(context.Background())
+	defer cancel()
+	<-ctx.Done()
+	err := searchError(ctx, ctx.Err())
+	assert.Equal(t, twirp.DeadlineExceeded, err.(twirp.Error).Code())
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, twirp.Canceled, searchError(ctx, ctx.Err()).(twirp.Error).Code())
+
+	// A search that has run out of time stops with the error.
+	cfg := &config.Config{DataPath: makeExpandLexicon(t)}
+	db, err := getDbConnection(cfg, "FOO")
+	assert.Nil(t, err)
+	defer db.Close()
+	qgen := querygen.NewQueryGen("FOO", querygen.FullExpanded, []*pb.SearchRequest_SearchParam{
+		SearchDescLength(2, 3)}, MaxSQLChunkSize, cfg)
+	queries, err := qgen.Generate()
+	assert.Nil(t, err)
+	_, _, err = combineQueryResults(ctx, queries, db, true, qgen.Type(), 0)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestMaxSearchResultsPostFiltered(t *testing.T) {
+	cfg := &config.Config{DataPath: makeExpandLexicon(t)}
+	db, err := getDbConnection(cfg, "FOO")
+	assert.Nil(t, err)
+	defer db.Close()
+	_, err = db.Exec(`INSERT INTO alphagrams VALUES ('AEV', 4, 1, 0, 'AEV', 0, 3, 2);
+		INSERT INTO words VALUES ('AVE', 'AEV', '', '', '', 'S', 0, 0, '')`)
+	assert.Nil(t, err)
+
+	search := func(max int) ([]string, bool) {
+		// The list is longer than a chunk and the length matches few
+		// alphagrams, so the list is checked after the rows are read.
+		list := []string{"EOV", "AEV"}
+		for i := 0; i < 10; i++ {
+			list = append(list, fmt.Sprintf("NOPE%d", i))
+		}
+		qgen := querygen.NewQueryGen("FOO", querygen.FullExpanded, []*pb.SearchRequest_SearchParam{
+			SearchDescLength(2, 3), SearchDescAlphagramList(list)}, 2, cfg)
+		qgen.SetStats(&querygen.TableStats{Rows: 4, RowsPerValue: map[string]float64{"length": 1}})
+		queries, err := qgen.Generate()
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(queries))
+		assert.NotContains(t, queries[0].Rendered(), "alphagrams.alphagram IN")
+		alphas, truncated, err := combineQueryResults(context.Background(), queries, db, true,
+			qgen.Type(), max)
+		assert.Nil(t, err)
+		found := []string{}
+		for _, a := range alphas {
+			found = append(found, a.Alphagram)
+		}
+		return found, truncated
+	}
+	// IQ and AZ come first but aren't in the list, so they don't count.
+	found, truncated := search(1)
+	assert.Equal(t, []string{"EOV"}, found)
+	assert.True(t, truncated)
+	found, truncated = search(2)
+	assert.Equal(t, []string{"EOV", "AEV"}, found)
+	assert.False(t, truncated)
+}
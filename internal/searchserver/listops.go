@@ -0,0 +1,239 @@
+package searchserver
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/domino14/word_db_server/internal/cardbox"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// maxListOperationDepth limits how deeply list operations can be nested.
+const maxListOperationDepth = 8
+
+// alphagramSet is a set of alphagrams with their words, which remembers the
+// order the alphagrams were added in.
+type alphagramSet struct {
+	order []string
+	words map[string][]string
+}
+
+func newAlphagramSet() *alphagramSet {
+	return &alphagramSet{words: map[string][]string{}}
+}
+
+// add adds an alphagram, or any of its words that the set doesn't have.
+func (s *alphagramSet) add(alphagram string, words []string) {
+	have, ok := s.words[alphagram]
+	if !ok {
+		s.order = append(s.order, alphagram)
+	}
+	for _, w := range words {
+		if !slices.Contains(have, w) {
+			have = append(have, w)
+		}
+	}
+	s.words[alphagram] = have
+}
+
+func (s *alphagramSet) has(alphagram string) bool {
+	_, ok := s.words[alphagram]
+	return ok
+}
+
+// filter returns the alphagrams of s that keep returns true for.
+func (s *alphagramSet) filter(keep func(alphagram string) bool) *alphagramSet {
+	out := newAlphagramSet()
+	for _, a := range s.order {
+		if keep(a) {
+			out.add(a, s.words[a])
+		}
+	}
+	return out
+}
+
+// combineSets applies a list operation to sets that have been evaluated.
+func combineSets(op pb.ListOperation_Op, sets []*alphagramSet) (*alphagramSet, error) {
+	if len(sets) == 0 {
+		return nil, twirp.InvalidArgumentError("operands", "a list operation needs operands")
+	}
+	switch op {
+	case pb.ListOperation_UNION:
+		out := newAlphagramSet()
+		for _, s := range sets {
+			for _, a := range s.order {
+				out.add(a, s.words[a])
+			}
+		}
+		return out, nil
+	case pb.ListOperation_INTERSECTION:
+		return sets[0].filter(func(a string) bool {
+			for _, s := range sets[1:] {
+				if !s.has(a) {
+					return false
+				}
+			}
+			return true
+		}), nil
+	case pb.ListOperation_DIFFERENCE:
+		return sets[0].filter(func(a string) bool {
+			for _, s := range sets[1:] {
+				if s.has(a) {
+					return false
+				}
+			}
+			return true
+		}), nil
+	}
+	return nil, twirp.InvalidArgumentError("op", fmt.Sprintf("unknown list operation %v", op))
+}
+
+func setFromAlphagrams(alphagrams []*pb.Alphagram) *alphagramSet {
+	out := newAlphagramSet()
+	for _, a := range alphagrams {
+		words := make([]string, len(a.Words))
+		for i, w := range a.Words {
+			words[i] = w.Word
+		}
+		out.add(a.Alphagram, words)
+	}
+	return out
+}
+
+// evalListOperation evaluates an operation and, recursively, the operations
+// in it.
+func (s *QuizSchedulerServer) evalListOperation(ctx context.Context, req *pb.CombineListsRequest,
+	op *pb.ListOperation, depth int) (*alphagramSet, error) {
+
+	if op == nil {
+		return nil, twirp.RequiredArgumentError("operation")
+	}
+	if depth > maxListOperationDepth {
+		return nil, twirp.InvalidArgumentError("operation",
+			fmt.Sprintf("list operations can be nested at most %d deep", maxListOperationDepth))
+	}
+	sets := make([]*alphagramSet, len(op.Operands))
+	for i, operand := range op.Operands {
+		set, err := s.evalListOperand(ctx, req, operand, depth)
+		if err != nil {
+			return nil, err
+		}
+		sets[i] = set
+	}
+	return combineSets(op.Op, sets)
+}
+
+func (s *QuizSchedulerServer) evalListOperand(ctx context.Context, req *pb.CombineListsRequest,
+	operand *pb.ListOperand, depth int) (*alphagramSet, error) {
+
+	switch o := operand.GetOperand().(type) {
+	case *pb.ListOperand_Search:
+		search := proto.Clone(o.Search).(*pb.SearchRequest)
+		search.Expand = false
+		resp, err := s.Searcher.Search(ctx, search)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Lexicon != req.Lexicon {
+			return nil, twirp.InvalidArgumentError("operation",
+				fmt.Sprintf("a search is in %v, not %v", resp.Lexicon, req.Lexicon))
+		}
+		return setFromAlphagrams(resp.Alphagrams), nil
+
+	case *pb.ListOperand_CardboxId:
+		if err := cardbox.ValidateUser(req.User); err != nil {
+			return nil, cardboxError(err)
+		}
+		box, err := s.Store.Cardbox(ctx, req.User, o.CardboxId)
+		if err != nil {
+			return nil, cardboxError(err)
+		}
+		if box.Lexicon != req.Lexicon {
+			return nil, twirp.InvalidArgumentError("operation",
+				fmt.Sprintf("cardbox %d is in %v, not %v", box.ID, box.Lexicon, req.Lexicon))
+		}
+		cards, err := s.Store.Cards(ctx, req.User, o.CardboxId)
+		if err != nil {
+			return nil, cardboxError(err)
+		}
+		out := newAlphagramSet()
+		for _, c := range cards {
+			out.add(c.Alphagram, c.Words)
+		}
+		return out, nil
+
+	case *pb.ListOperand_Alphagrams:
+		values := o.Alphagrams.GetValues()
+		if len(values) == 0 {
+			return newAlphagramSet(), nil
+		}
+		// Search for them to get their words, which also drops any that
+		// aren't in the lexicon.
+		resp, err := s.Searcher.Search(ctx, &pb.SearchRequest{
+			Searchparams: []*pb.SearchRequest_SearchParam{
+				SearchDescLexicon(req.Lexicon), SearchDescAlphagramList(values)},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return setFromAlphagrams(resp.Alphagrams), nil
+
+	case *pb.ListOperand_Operation:
+		return s.evalListOperation(ctx, req, o.Operation, depth+1)
+	}
+	return nil, twirp.InvalidArgumentError("operation", "a list operand is empty")
+}
+
+// CombineLists computes a union, intersection or difference of lists.
+func (s *QuizSchedulerServer) CombineLists(ctx context.Context, req *pb.CombineListsRequest) (
+	*pb.CombineListsResponse, error) {
+
+	if req.Lexicon == "" {
+		return nil, twirp.RequiredArgumentError("lexicon")
+	}
+	set, err := s.evalListOperation(ctx, req, req.Operation, 0)
+	if err != nil {
+		return nil, err
+	}
+	result := &pb.SearchResponse{Lexicon: req.Lexicon, Alphagrams: []*pb.Alphagram{}}
+	for _, a := range set.order {
+		alph := &pb.Alphagram{Alphagram: a}
+		for _, w := range set.words[a] {
+			alph.Words = append(alph.Words, &pb.Word{Word: w})
+		}
+		result.Alphagrams = append(result.Alphagrams, alph)
+	}
+
+	resp := &pb.CombineListsResponse{}
+	if req.SaveAs != "" {
+		if err := cardbox.ValidateUser(req.User); err != nil {
+			return nil, cardboxError(err)
+		}
+		cards := make([]*cardbox.Card, len(set.order))
+		for i, a := range set.order {
+			cards[i] = &cardbox.Card{Alphagram: a, Words: set.words[a]}
+		}
+		resp.CardboxId, err = s.Store.CreateCardbox(ctx, &cardbox.Cardbox{
+			User:    req.User,
+			Name:    req.SaveAs,
+			Lexicon: req.Lexicon,
+			Created: time.Now(),
+		}, cards)
+		if err != nil {
+			return nil, cardboxError(err)
+		}
+	}
+	if req.Expand && len(result.Alphagrams) > 0 {
+		result, err = s.Searcher.Expand(ctx, result)
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp.Result = result
+	return resp, nil
+}
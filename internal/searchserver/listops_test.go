@@ -0,0 +1,112 @@
+package searchserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/word_db_server/config"
+	"github.com/domino14/word_db_server/internal/cardbox"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestCombineSets(t *testing.T) {
+	a := newAlphagramSet()
+	a.add("AEINRST", []string{"NASTIER"})
+	a.add("ACCHNOS", []string{"CANCHOS"})
+	b := newAlphagramSet()
+	b.add("EIRSTUV", []string{"VIRTUES"})
+	b.add("AEINRST", []string{"RETAINS", "NASTIER"})
+
+	union, err := combineSets(pb.ListOperation_UNION, []*alphagramSet{a, b})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"AEINRST", "ACCHNOS", "EIRSTUV"}, union.order)
+	assert.Equal(t, []string{"NASTIER", "RETAINS"}, union.words["AEINRST"])
+
+	inter, err := combineSets(pb.ListOperation_INTERSECTION, []*alphagramSet{a, b})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"AEINRST"}, inter.order)
+
+	diff, err := combineSets(pb.ListOperation_DIFFERENCE, []*alphagramSet{a, b})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ACCHNOS"}, diff.order)
+
+	_, err = combineSets(pb.ListOperation_UNION, nil)
+	assert.NotNil(t, err)
+}
+
+func TestCombineLists(t *testing.T) {
+	ctx := context.Background()
+	store, err := cardbox.OpenSQLite(t.TempDir())
+	assert.Nil(t, err)
+	s := &QuizSchedulerServer{
+		Searcher: &Server{Config: &config.Config{DataPath: makeExpandLexicon(t)}},
+		Store:    store,
+	}
+	twos, err := s.CreateCardbox(ctx, &pb.CreateCardboxRequest{User: "cesar",
+		Search: &pb.SearchRequest{Searchparams: []*pb.SearchRequest_SearchParam{
+			SearchDescLexicon("FOO"), SearchDescLength(2, 2)}}})
+	assert.Nil(t, err)
+
+	cardboxOperand := &pb.ListOperand{
+		Operand: &pb.ListOperand_CardboxId{CardboxId: twos.CardboxId}}
+	alphagramsOperand := func(alphs ...string) *pb.ListOperand {
+		return &pb.ListOperand{Operand: &pb.ListOperand_Alphagrams{
+			Alphagrams: &pb.SearchRequest_StringArray{Values: alphs}}}
+	}
+	searchOperand := &pb.ListOperand{Operand: &pb.ListOperand_Search{
+		Search: &pb.SearchRequest{Searchparams: []*pb.SearchRequest_SearchParam{
+			SearchDescLexicon("FOO"), SearchDescLength(3, 3)}}}}
+
+	// (the cardbox minus AZ) plus the threes, saved and expanded.
+	resp, err := s.CombineLists(ctx, &pb.CombineListsRequest{
+		User:    "cesar",
+		Lexicon: "FOO",
+		Operation: &pb.ListOperation{Op: pb.ListOperation_UNION, Operands: []*pb.ListOperand{
+			{Operand: &pb.ListOperand_Operation{Operation: &pb.ListOperation{
+				Op:       pb.ListOperation_DIFFERENCE,
+				Operands: []*pb.ListOperand{cardboxOperand, alphagramsOperand("AZ")}}}},
+			searchOperand,
+		}},
+		Expand: true,
+		SaveAs: "no za",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"IQ", "EOV"}, alphagrams(resp.Result))
+	assert.Equal(t, "evolution", resp.Result.Alphagrams[1].Words[0].Definition)
+	cards, err := store.Cards(ctx, "cesar", resp.CardboxId)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(cards))
+	assert.Equal(t, []string{"EVO"}, cards[0].Words)
+
+	resp, err = s.CombineLists(ctx, &pb.CombineListsRequest{
+		User:    "cesar",
+		Lexicon: "FOO",
+		Operation: &pb.ListOperation{Op: pb.ListOperation_INTERSECTION,
+			Operands: []*pb.ListOperand{cardboxOperand, alphagramsOperand("AZ", "EOV", "XYZ")}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"AZ"}, alphagrams(resp.Result))
+	assert.Equal(t, "ZA", resp.Result.Alphagrams[0].Words[0].Word)
+	assert.Equal(t, int64(0), resp.CardboxId)
+
+	_, err = s.CombineLists(ctx, &pb.CombineListsRequest{
+		User:    "mallory",
+		Lexicon: "FOO",
+		Operation: &pb.ListOperation{Op: pb.ListOperation_UNION,
+			Operands: []*pb.ListOperand{cardboxOperand}},
+	})
+	assert.Equal(t, twirp.NotFound, err.(twirp.Error).Code())
+	_, err = s.CombineLists(ctx, &pb.CombineListsRequest{
+		User:    "cesar",
+		Lexicon: "BAR",
+		Operation: &pb.ListOperation{Op: pb.ListOperation_UNION,
+			Operands: []*pb.ListOperand{cardboxOperand}},
+	})
+	assert.Equal(t, twirp.InvalidArgument, err.(twirp.Error).Code())
+	_, err = s.CombineLists(ctx, &pb.CombineListsRequest{Lexicon: "FOO",
+		Operation: &pb.ListOperation{Operands: []*pb.ListOperand{{}}}})
+	assert.Equal(t, twirp.InvalidArgument, err.(twirp.Error).Code())
+}
@@ -0,0 +1,138 @@
+package searchserver
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/word_db_server/internal/querygen"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// MissedWords grades a quiz's answers against the lexicon. Only the words
+// of the alphagrams are looked up; nothing is expanded.
+func (s *Server) MissedWords(ctx context.Context, req *pb.MissedWordsRequest) (
+	*pb.MissedWordsResponse, error) {
+
+	if req.Lexicon == "" {
+		return nil, twirp.RequiredArgumentError("lexicon")
+	}
+	if limit := s.Config.MaxSearchResults; limit > 0 && len(req.Answers) > limit {
+		return nil, twirp.InvalidArgumentError("answers",
+			fmt.Sprintf("can grade at most %d alphagrams at once", limit))
+	}
+	ctx, cancel := s.withSearchTimeout(ctx)
+	defer cancel()
+	db, _, release, err := s.searchDB(req.Lexicon, req.SnapshotId, false)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	alphagrams := make([]string, 0, len(req.Answers))
+	seen := map[string]bool{}
+	for _, a := range req.Answers {
+		if !seen[a.Alphagram] {
+			seen[a.Alphagram] = true
+			alphagrams = append(alphagrams, a.Alphagram)
+		}
+	}
+	anagrams, err := alphagramWords(ctx, s.DBs.queryer(db), alphagrams)
+	if err != nil {
+		return nil, searchError(ctx, err)
+	}
+	resp := gradeAnswers(req.Answers, anagrams)
+	log.Debug().Str("lexicon", req.Lexicon).Interface("summary", resp.Summary).
+		Msg("graded-answers")
+	return resp, nil
+}
+
+// alphagramWords returns the words of each of the alphagrams, in
+// alphabetical order.
+func alphagramWords(ctx context.Context, db queryer, alphagrams []string) (
+	map[string][]string, error) {
+
+	anagrams := make(map[string][]string, len(alphagrams))
+	for start := 0; start < len(alphagrams); start += MaxSQLChunkSize {
+		end := min(start+MaxSQLChunkSize, len(alphagrams))
+		where, args, err := querygen.NewWhereInClause("words", "alphagram",
+			SearchDescAlphagramList(alphagrams[start:end])).Render()
+		if err != nil {
+			return nil, err
+		}
+		rows, err := db.QueryContext(ctx,
+			fmt.Sprintf(querygen.WordInfoQuery, where, ""), args...)
+		if err != nil {
+			return nil, err
+		}
+		words, err := processWordRows(rows, 0)
+		for _, w := range words {
+			anagrams[w.Alphagram] = append(anagrams[w.Alphagram], w.Word)
+		}
+		if err == nil {
+			err = rows.Err()
+		}
+		rows.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return anagrams, nil
+}
+
+// gradeAnswers grades each answer against the words of its alphagram.
+// Words are compared in upper case, and a word given twice counts once.
+func gradeAnswers(answers []*pb.MissedWordsRequest_Answer, anagrams map[string][]string) *pb.MissedWordsResponse {
+	resp := &pb.MissedWordsResponse{
+		Alphagrams: make([]*pb.MissedWordsResponse_Graded, 0, len(answers)),
+		Summary:    &pb.MissedWordsResponse_Summary{NumAlphagrams: int32(len(answers))},
+	}
+	for _, a := range answers {
+		words := anagrams[a.Alphagram]
+		valid := make(map[string]bool, len(words))
+		for _, w := range words {
+			valid[w] = true
+		}
+		g := &pb.MissedWordsResponse_Graded{
+			Alphagram: a.Alphagram,
+			NumWords:  int32(len(words)),
+			Missed:    []string{},
+			Wrong:     []string{},
+		}
+		given := map[string]bool{}
+		for _, w := range a.Words {
+			w = strings.ToUpper(strings.TrimSpace(w))
+			if w == "" || given[w] {
+				continue
+			}
+			given[w] = true
+			if valid[w] {
+				g.NumCorrect++
+			} else {
+				g.Wrong = append(g.Wrong, w)
+			}
+		}
+		for _, w := range words {
+			if !given[w] {
+				g.Missed = append(g.Missed, w)
+			}
+		}
+		sort.Strings(g.Missed)
+		g.Solved = len(g.Missed) == 0 && len(g.Wrong) == 0
+		resp.Alphagrams = append(resp.Alphagrams, g)
+
+		sum := resp.Summary
+		sum.NumWords += g.NumWords
+		sum.NumCorrect += g.NumCorrect
+		sum.NumMissed += int32(len(g.Missed))
+		sum.NumWrong += int32(len(g.Wrong))
+		if g.Solved {
+			sum.NumSolved++
+		}
+	}
+	return resp
+}
@@ -0,0 +1,63 @@
+package searchserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/word_db_server/config"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestGradeAnswers(t *testing.T) {
+	anagrams := map[string][]string{
+		"AEINRST": {"ANESTRI", "NASTIER", "RATINES", "RETAINS", "RETINAS", "RETSINA", "STAINER", "STEARIN"},
+		"EOV":     {"EVO"},
+	}
+	resp := gradeAnswers([]*pb.MissedWordsRequest_Answer{
+		{Alphagram: "AEINRST", Words: []string{"retains", "RETINAS", "RETINAS", "STAINRE",
+			"NASTIER", "RETSINA", "STAINER", "STEARIN", "RATINES"}},
+		{Alphagram: "EOV", Words: []string{" evo "}},
+		{Alphagram: "IQ"},
+	}, anagrams)
+
+	assert.Equal(t, 3, len(resp.Alphagrams))
+	g := resp.Alphagrams[0]
+	assert.Equal(t, []string{"ANESTRI"}, g.Missed)
+	assert.Equal(t, []string{"STAINRE"}, g.Wrong)
+	assert.Equal(t, int32(8), g.NumWords)
+	assert.Equal(t, int32(7), g.NumCorrect)
+	assert.False(t, g.Solved)
+	assert.True(t, resp.Alphagrams[1].Solved)
+	// An alphagram without words has nothing to miss.
+	assert.Equal(t, []string{}, resp.Alphagrams[2].Missed)
+	assert.True(t, resp.Alphagrams[2].Solved)
+	assert.Equal(t, &pb.MissedWordsResponse_Summary{NumAlphagrams: 3, NumSolved: 2,
+		NumWords: 9, NumCorrect: 8, NumMissed: 1, NumWrong: 1}, resp.Summary)
+}
+
+func TestMissedWords(t *testing.T) {
+	s := &Server{Config: &config.Config{DataPath: makeExpandLexicon(t)}}
+	resp, err := s.MissedWords(context.Background(), &pb.MissedWordsRequest{
+		Lexicon: "FOO",
+		Answers: []*pb.MissedWordsRequest_Answer{
+			{Alphagram: "IQ", Words: []string{"QI"}},
+			{Alphagram: "AZ", Words: []string{"AZ"}},
+			{Alphagram: "EOV"},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{}, resp.Alphagrams[0].Missed)
+	assert.Equal(t, []string{"ZA"}, resp.Alphagrams[1].Missed)
+	assert.Equal(t, []string{"AZ"}, resp.Alphagrams[1].Wrong)
+	assert.Equal(t, []string{"EVO"}, resp.Alphagrams[2].Missed)
+	assert.Equal(t, int32(1), resp.Summary.NumSolved)
+	assert.Equal(t, int32(2), resp.Summary.NumMissed)
+
+	_, err = s.MissedWords(context.Background(), &pb.MissedWordsRequest{})
+	var twerr twirp.Error
+	assert.ErrorAs(t, err, &twerr)
+	assert.Equal(t, twirp.InvalidArgument, twerr.Code())
+}
@@ -0,0 +1,122 @@
+package searchserver
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/word_db_server/internal/common"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// maxNeighborsLength is the longest alphagram whose neighbors can be
+// found; its neighbors are all looked up at once.
+const maxNeighborsLength = 15
+
+// neighborCandidates returns every alphagram one tile away from mw, which
+// is in order, with the tiles added and removed to make each, keyed as
+// the alphagrams table has them.
+func neighborCandidates(mw tilemapping.MachineWord, tm *tilemapping.TileMapping) map[string]*pb.NeighborsResponse_Neighbor {
+	cands := map[string]*pb.NeighborsResponse_Neighbor{}
+	add := func(alpha tilemapping.MachineWord, added, removed tilemapping.MachineLetter) {
+		if len(alpha) == 0 {
+			return
+		}
+		slices.Sort(alpha)
+		key := alpha.UserVisible(tm)
+		if _, ok := cands[key]; ok {
+			return
+		}
+		n := &pb.NeighborsResponse_Neighbor{Alphagram: key}
+		if added != 0 {
+			n.Added = added.UserVisible(tm, false)
+		}
+		if removed != 0 {
+			n.Removed = removed.UserVisible(tm, false)
+		}
+		cands[key] = n
+	}
+	for i, removed := range mw {
+		if i > 0 && mw[i-1] == removed {
+			continue
+		}
+		rest := slices.Delete(slices.Clone(mw), i, i+1)
+		add(slices.Clone(rest), 0, removed)
+		for ml := tilemapping.MachineLetter(1); ml < tilemapping.MachineLetter(tm.NumLetters()); ml++ {
+			if ml != removed {
+				add(append(slices.Clone(rest), ml), ml, removed)
+			}
+		}
+	}
+	for ml := tilemapping.MachineLetter(1); ml < tilemapping.MachineLetter(tm.NumLetters()); ml++ {
+		add(append(slices.Clone(mw), ml), ml, 0)
+	}
+	return cands
+}
+
+// Neighbors returns the alphagrams in the lexicon that are one tile away
+// from the given one. The candidates are made from the letter
+// distribution and looked up in one search.
+func (s *WordSearchServer) Neighbors(ctx context.Context, req *pb.NeighborsRequest) (*pb.NeighborsResponse, error) {
+	if req.Lexicon == "" {
+		return nil, twirp.RequiredArgumentError("lexicon")
+	}
+	dist, err := common.LetterDistribution(map[string]any{"data-path": s.Config.DataPath}, req.Lexicon)
+	if err != nil {
+		return nil, twirp.InvalidArgumentError("lexicon", err.Error())
+	}
+	tm := dist.TileMapping()
+	mls, err := tilemapping.ToMachineLetters(strings.ToUpper(strings.TrimSpace(req.Alphagram)), tm)
+	mw := tilemapping.MachineWord(mls)
+	if err != nil || len(mw) == 0 || slices.Contains(mw, 0) {
+		return nil, twirp.InvalidArgumentError("alphagram", "must be letters of the lexicon")
+	}
+	if len(mw) > maxNeighborsLength {
+		return nil, twirp.InvalidArgumentError("alphagram", "is too long")
+	}
+	slices.Sort(mw)
+	alphagram := mw.UserVisible(tm)
+
+	cands := neighborCandidates(mw, tm)
+	list := []string{alphagram}
+	for key := range cands {
+		list = append(list, key)
+	}
+	found, err := (&Server{Config: s.Config}).Search(ctx, WordSearch([]*pb.SearchRequest_SearchParam{
+		SearchDescLexicon(req.Lexicon), SearchDescAlphagramList(list)}, false))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.NeighborsResponse{Alphagram: alphagram, Neighbors: []*pb.NeighborsResponse_Neighbor{}}
+	lengths := map[string]int{}
+	for _, a := range found.Alphagrams {
+		if a.Alphagram == alphagram {
+			resp.Valid = true
+			resp.NumWords = int32(len(a.Words))
+			continue
+		}
+		n, ok := cands[a.Alphagram]
+		if !ok {
+			continue
+		}
+		n.NumWords = int32(len(a.Words))
+		n.Probability = a.Probability
+		lengths[a.Alphagram] = int(a.Length)
+		resp.Neighbors = append(resp.Neighbors, n)
+	}
+	sort.Slice(resp.Neighbors, func(i, j int) bool {
+		a, b := resp.Neighbors[i], resp.Neighbors[j]
+		if lengths[a.Alphagram] != lengths[b.Alphagram] {
+			return lengths[a.Alphagram] < lengths[b.Alphagram]
+		}
+		if a.Probability != b.Probability {
+			return a.Probability < b.Probability
+		}
+		return a.Alphagram < b.Alphagram
+	})
+	return resp, nil
+}
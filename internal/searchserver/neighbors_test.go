@@ -0,0 +1,82 @@
+package searchserver
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/stretchr/testify/assert"
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/word_db_server/config"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestNeighborCandidates(t *testing.T) {
+	dist, err := tilemapping.ScanLetterDistribution(strings.NewReader("?,2,0,0\nA,9,1,1\nB,2,3,0\nC,2,3,0\n"))
+	assert.Nil(t, err)
+	tm := dist.TileMapping()
+	mw, err := tilemapping.ToMachineLetters("AAB", tm)
+	assert.Nil(t, err)
+	cands := neighborCandidates(mw, tm)
+	keys := []string{}
+	for k := range cands {
+		keys = append(keys, k)
+	}
+	assert.ElementsMatch(t, []string{
+		"AB", "AA", // removals
+		"ABB", "ABC", "AAA", "AAC", // swaps
+		"AAAB", "AABB", "AABC", // additions
+	}, keys)
+	assert.Equal(t, "C", cands["ABC"].Added)
+	assert.Equal(t, "A", cands["ABC"].Removed)
+	assert.Equal(t, "", cands["AB"].Added)
+	assert.Equal(t, "", cands["AABC"].Removed)
+}
+
+func TestNeighbors(t *testing.T) {
+	dataPath := makeExpandLexicon(t)
+	dbDir := filepath.Join(dataPath, "lexica", "db")
+	assert.Nil(t, os.Rename(filepath.Join(dbDir, "FOO.db"), filepath.Join(dbDir, "NWL99.db")))
+	assert.Nil(t, os.MkdirAll(filepath.Join(dataPath, "letterdistributions"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(dataPath, "letterdistributions", "english"),
+		[]byte("?,2,0,0\nA,9,1,1\nE,12,1,1\nI,9,1,1\nO,8,1,1\nQ,1,10,0\nV,2,4,0\nZ,1,10,0\n"), 0644))
+	db, err := sql.Open("sqlite3", filepath.Join(dbDir, "NWL99.db"))
+	assert.Nil(t, err)
+	_, err = db.Exec(`
+	INSERT INTO alphagrams VALUES ('EO', 4, 1, 0, 'EO', 0, 2, 1),
+		('EIV', 1, 1, 0, 'EIV', 0, 3, 1), ('AEOV', 1, 1, 0, 'AEOV', 0, 4, 1);
+	INSERT INTO words VALUES ('OE', 'EO', '', 'a wind', '', '', 0, 0, ''),
+		('VIE', 'EIV', '', 'to strive', '', '', 0, 0, ''),
+		('VOEA', 'AEOV', '', 'made up', '', '', 0, 0, ''),
+		('AVOE', 'AEOV', '', 'made up too', '', '', 0, 0, '')`)
+	assert.Nil(t, err)
+	db.Close()
+
+	s := &WordSearchServer{Config: &config.Config{DataPath: dataPath}}
+	resp, err := s.Neighbors(context.Background(), &pb.NeighborsRequest{Lexicon: "NWL99", Alphagram: "voe"})
+	assert.Nil(t, err)
+	assert.Equal(t, "EOV", resp.Alphagram)
+	assert.True(t, resp.Valid)
+	assert.Equal(t, int32(1), resp.NumWords)
+	found := []string{}
+	for _, n := range resp.Neighbors {
+		found = append(found, n.Alphagram+":"+n.Removed+">"+n.Added)
+	}
+	assert.Equal(t, []string{"EO:V>", "EIV:O>I", "AEOV:>A"}, found)
+	assert.Equal(t, int32(2), resp.Neighbors[2].NumWords)
+
+	resp, err = s.Neighbors(context.Background(), &pb.NeighborsRequest{Lexicon: "NWL99", Alphagram: "EIO"})
+	assert.Nil(t, err)
+	assert.False(t, resp.Valid)
+	assert.Equal(t, 3, len(resp.Neighbors))
+
+	_, err = s.Neighbors(context.Background(), &pb.NeighborsRequest{Lexicon: "NWL99", Alphagram: "E?"})
+	assert.Equal(t, twirp.InvalidArgument, err.(twirp.Error).Code())
+	_, err = s.Neighbors(context.Background(), &pb.NeighborsRequest{Alphagram: "EV"})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,128 @@
+package searchserver
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/twitchtv/twirp"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// Marshaling big expanded responses is much of the server's work when many
+// clients expand the same popular lists at once. A protobuf response can
+// instead be put together from encodings of its alphagrams that are made
+// once and kept in the expand cache: the alphagrams go in the response's
+// unknown fields, which proto.Marshal copies as they are. The bytes are
+// the same as those of the alphagrams field, except for coming after the
+// response's other fields, which protobuf parsers don't mind.
+
+// maxPooledEncodeBuffer is the size above which an encoding buffer isn't
+// kept for another response, so one huge search doesn't hold its memory.
+const maxPooledEncodeBuffer = 16 << 20
+
+var encodeBuffers = sync.Pool{New: func() any {
+	b := make([]byte, 0, 64<<10)
+	return &b
+}}
+
+type preencodeKey struct{}
+
+// preencodeState marks a request whose response will be marshaled as
+// protobuf, and holds its encoding buffer until the response is written.
+type preencodeState struct {
+	buf *[]byte
+}
+
+// ProtobufResponses marks the requests with protobuf bodies, whose
+// responses Twirp marshals as protobuf, so that PreencodeInterceptor can
+// pre-encode them. JSON responses are left alone; their alphagrams would
+// be lost.
+func ProtobufResponses(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ct, _, _ := strings.Cut(r.Header.Get("Content-Type"), ";")
+		if !strings.EqualFold(strings.TrimSpace(ct), "application/protobuf") {
+			next.ServeHTTP(w, r)
+			return
+		}
+		st := &preencodeState{}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), preencodeKey{}, st)))
+		// Twirp has marshaled the response into its own buffer and sent it.
+		if st.buf != nil && cap(*st.buf) <= maxPooledEncodeBuffer {
+			*st.buf = (*st.buf)[:0]
+			encodeBuffers.Put(st.buf)
+		}
+	})
+}
+
+// PreencodeInterceptor pre-encodes the SearchResponses of the requests that
+// ProtobufResponses marked, using the cache's encodings of the alphagrams
+// that it has.
+func PreencodeInterceptor(cache *ExpandCache) twirp.Interceptor {
+	return func(next twirp.Method) twirp.Method {
+		return func(ctx context.Context, req any) (any, error) {
+			resp, err := next(ctx, req)
+			st, _ := ctx.Value(preencodeKey{}).(*preencodeState)
+			sr, ok := resp.(*pb.SearchResponse)
+			if err != nil || st == nil || !ok || st.buf != nil {
+				return resp, err
+			}
+			st.buf = encodeBuffers.Get().(*[]byte)
+			if err := cache.preencode(sr, st.buf); err != nil {
+				return nil, err
+			}
+			return sr, nil
+		}
+	}
+}
+
+// PreencodeGRPCInterceptor pre-encodes the SearchResponses of a gRPC
+// server. gRPC doesn't say when it's done with a response, so these
+// encodings aren't pooled.
+func PreencodeGRPCInterceptor(cache *ExpandCache) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (any, error) {
+
+		resp, err := handler(ctx, req)
+		sr, ok := resp.(*pb.SearchResponse)
+		if err != nil || !ok {
+			return resp, err
+		}
+		if err := cache.preencode(sr, new([]byte)); err != nil {
+			return nil, err
+		}
+		return sr, nil
+	}
+}
+
+// preencode moves the response's alphagrams into its unknown fields,
+// encoded into buf. The response must not be used other than to be
+// marshaled afterwards.
+func (c *ExpandCache) preencode(sr *pb.SearchResponse, buf *[]byte) error {
+	b := append((*buf)[:0], sr.ProtoReflect().GetUnknown()...)
+	opts := proto.MarshalOptions{UseCachedSize: true}
+	for _, a := range sr.Alphagrams {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		encoded, err := c.encoding(a)
+		if err != nil {
+			return err
+		}
+		if encoded != nil {
+			b = protowire.AppendBytes(b, encoded)
+			continue
+		}
+		b = protowire.AppendVarint(b, uint64(proto.Size(a)))
+		if b, err = opts.MarshalAppend(b, a); err != nil {
+			return err
+		}
+	}
+	*buf = b
+	sr.Alphagrams = nil
+	sr.ProtoReflect().SetUnknown(b)
+	return nil
+}
@@ -0,0 +1,120 @@
+package searchserver
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/domino14/word_db_server/config"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestPreencodeTwirp(t *testing.T) {
+	cfg := &config.Config{DataPath: makeExpandLexicon(t), ExpandCacheSize: 10}
+	cache := NewExpandCache(cfg)
+	s := &Server{Config: cfg, ExpandCache: cache}
+	handler := pb.NewQuestionSearcherServer(s,
+		twirp.WithServerInterceptors(PreencodeInterceptor(cache)))
+	srv := httptest.NewServer(ProtobufResponses(handler))
+	defer srv.Close()
+
+	req := &pb.SearchResponse{Lexicon: "FOO", Alphagrams: []*pb.Alphagram{
+		{Alphagram: "IQ", Words: []*pb.Word{{Word: "QI"}}},
+		{Alphagram: "EOV", Words: []*pb.Word{{Word: "EVO"}}},
+	}}
+	want, err := s.Expand(context.Background(), req)
+	assert.Nil(t, err)
+	want = proto.Clone(want).(*pb.SearchResponse)
+
+	for _, client := range []pb.QuestionSearcher{
+		pb.NewQuestionSearcherProtobufClient(srv.URL, srv.Client()),
+		pb.NewQuestionSearcherJSONClient(srv.URL, srv.Client()),
+	} {
+		// The first time the alphagrams are encoded; then the cache's
+		// encodings are used.
+		for i := 0; i < 2; i++ {
+			resp, err := client.Expand(context.Background(), req)
+			assert.Nil(t, err)
+			assert.True(t, proto.Equal(want, resp), resp.String())
+		}
+	}
+	for _, a := range want.Alphagrams {
+		el := cache.entries[expandCacheKey("FOO", &pb.Alphagram{Alphagram: a.Alphagram,
+			Words: []*pb.Word{{Word: a.Words[0].Word}}})]
+		encoded := el.Value.(*expandCacheEntry).encoded
+		assert.NotEmpty(t, encoded)
+		decoded := &pb.Alphagram{}
+		assert.Nil(t, proto.Unmarshal(encoded, decoded))
+		assert.True(t, proto.Equal(a, decoded))
+	}
+}
+
+func TestPreencodeWithoutCache(t *testing.T) {
+	sr := &pb.SearchResponse{Lexicon: "FOO", SnapshotId: "abc", Alphagrams: []*pb.Alphagram{
+		{Alphagram: "AZ", Words: []*pb.Word{{Word: "ZA", Definition: "pizza"}}},
+		{Alphagram: "IQ", Probability: 12},
+	}}
+	want := proto.Clone(sr)
+	var cache *ExpandCache
+	buf := &[]byte{}
+	assert.Nil(t, cache.preencode(sr, buf))
+	assert.Nil(t, sr.Alphagrams)
+	bts, err := proto.Marshal(sr)
+	assert.Nil(t, err)
+	got := &pb.SearchResponse{}
+	assert.Nil(t, proto.Unmarshal(bts, got))
+	assert.True(t, proto.Equal(want, got))
+}
+
+// expandedResponse is like a big expanded quiz: a thousand alphagrams with
+// a few words and their definitions each.
+func expandedResponse() *pb.SearchResponse {
+	sr := &pb.SearchResponse{Lexicon: "FOO"}
+	for i := 0; i < 1000; i++ {
+		a := &pb.Alphagram{Alphagram: fmt.Sprintf("AEINRST%d", i), Probability: int32(i),
+			Combinations: 1000000}
+		for j := 0; j < 3; j++ {
+			a.Words = append(a.Words, &pb.Word{
+				Word:       fmt.Sprintf("RETAINS%d", j),
+				Definition: strings.Repeat("to keep possession of, ", 4),
+				FrontHooks: "S", BackHooks: "ES",
+			})
+		}
+		sr.Alphagrams = append(sr.Alphagrams, a)
+	}
+	return sr
+}
+
+func BenchmarkMarshalExpanded(b *testing.B) {
+	sr := expandedResponse()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := proto.Marshal(sr); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMarshalPreencoded(b *testing.B) {
+	cache := NewExpandCache(&config.Config{ExpandCacheSize: 1000})
+	alphs := expandedResponse().Alphagrams
+	cache.store("FOO", alphs, alphs)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sr := &pb.SearchResponse{Lexicon: "FOO", Alphagrams: alphs}
+		buf := encodeBuffers.Get().(*[]byte)
+		if err := cache.preencode(sr, buf); err != nil {
+			b.Fatal(err)
+		}
+		if _, err := proto.Marshal(sr); err != nil {
+			b.Fatal(err)
+		}
+		encodeBuffers.Put(buf)
+	}
+}
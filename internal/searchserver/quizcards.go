@@ -0,0 +1,124 @@
+package searchserver
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/domino14/word_db_server/internal/common"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// A QuizCard is everything needed to draw one quiz question, without
+// having to know the database schema or the letter distribution.
+type QuizCard struct {
+	Alphagram   string           `json:"alphagram"`
+	Length      int32            `json:"length"`
+	Probability int32            `json:"probability"`
+	Tiles       []QuizCardTile   `json:"tiles"`
+	Answers     []QuizCardAnswer `json:"answers"`
+}
+
+type QuizCardTile struct {
+	Letter string `json:"letter"`
+	Score  int    `json:"score"`
+}
+
+type QuizCardAnswer struct {
+	Word           string `json:"word"`
+	Definition     string `json:"definition"`
+	FrontHooks     string `json:"front_hooks"`
+	BackHooks      string `json:"back_hooks"`
+	InnerFrontHook bool   `json:"inner_front_hook"`
+	InnerBackHook  bool   `json:"inner_back_hook"`
+	LexiconSymbols string `json:"lexicon_symbols"`
+}
+
+// QuizCards turns expanded alphagrams into quiz cards, with tile scores
+// from the given letter distribution.
+func QuizCards(alphs []*pb.Alphagram, dist *tilemapping.LetterDistribution) ([]QuizCard, error) {
+	tm := dist.TileMapping()
+	cards := make([]QuizCard, 0, len(alphs))
+	for _, a := range alphs {
+		mls, err := tilemapping.ToMachineLetters(a.Alphagram, tm)
+		if err != nil {
+			return nil, err
+		}
+		card := QuizCard{
+			Alphagram:   a.Alphagram,
+			Length:      a.Length,
+			Probability: a.Probability,
+			Tiles:       make([]QuizCardTile, 0, len(mls)),
+			Answers:     make([]QuizCardAnswer, 0, len(a.Words)),
+		}
+		for _, ml := range mls {
+			card.Tiles = append(card.Tiles, QuizCardTile{
+				Letter: ml.UserVisible(tm, false),
+				Score:  dist.Score(ml),
+			})
+		}
+		for _, w := range a.Words {
+			card.Answers = append(card.Answers, QuizCardAnswer{
+				Word:           w.Word,
+				Definition:     w.Definition,
+				FrontHooks:     w.FrontHooks,
+				BackHooks:      w.BackHooks,
+				InnerFrontHook: w.InnerFrontHook,
+				InnerBackHook:  w.InnerBackHook,
+				LexiconSymbols: w.LexiconSymbols,
+			})
+		}
+		cards = append(cards, card)
+	}
+	return cards, nil
+}
+
+// QuizCardsHandler serves searches as quiz cards. It takes a POSTed
+// SearchRequest in protobuf JSON form, and always expands the results.
+func QuizCardsHandler(s *Server) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "POST a search request", http.StatusMethodNotAllowed)
+			return
+		}
+		body, err := io.ReadAll(io.LimitReader(r.Body, 1<<20))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		req := &pb.SearchRequest{}
+		if err := protojson.Unmarshal(body, req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		req.Expand = true
+		resp, err := s.Search(r.Context(), req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		dist, err := common.LetterDistribution(
+			map[string]any{"data-path": s.Config.DataPath}, resp.Lexicon)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		cards, err := QuizCards(resp.Alphagrams, dist)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(struct {
+			Lexicon string     `json:"lexicon"`
+			Cards   []QuizCard `json:"cards"`
+		}{resp.Lexicon, cards})
+		if err != nil {
+			log.Err(err).Msg("writing-quiz-cards")
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package searchserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/domino14/word_db_server/config"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestQuizCards(t *testing.T) {
+	ld, err := tilemapping.ScanLetterDistribution(strings.NewReader(miniSpanishDist))
+	assert.Nil(t, err)
+
+	cards, err := QuizCards([]*pb.Alphagram{{
+		Alphagram:   "ACHO",
+		Length:      3,
+		Probability: 12,
+		Words: []*pb.Word{{
+			Word: "OCHA", Definition: "a word", BackHooks: "R", InnerFrontHook: true,
+		}},
+	}}, ld)
+	assert.Nil(t, err)
+	assert.Equal(t, []QuizCard{{
+		Alphagram:   "ACHO",
+		Length:      3,
+		Probability: 12,
+		Tiles: []QuizCardTile{
+			{Letter: "A", Score: 1}, {Letter: "CH", Score: 5}, {Letter: "O", Score: 1}},
+		Answers: []QuizCardAnswer{{
+			Word: "OCHA", Definition: "a word", BackHooks: "R", InnerFrontHook: true}},
+	}}, cards)
+
+	_, err = QuizCards([]*pb.Alphagram{{Alphagram: "AQ"}}, ld)
+	assert.NotNil(t, err)
+}
+
+func TestQuizCardsHandlerErrors(t *testing.T) {
+	h := QuizCardsHandler(&Server{Config: &config.Config{DataPath: t.TempDir()}})
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/quizcards", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/quizcards",
+		strings.NewReader(`{"searchparams": 3}`)))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/quizcards",
+		strings.NewReader(`{"searchparams": [{"condition": "LEXICON", "stringvalue": {"value": "NWL18"}}]}`)))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "NWL18")
+}
@@ -0,0 +1,304 @@
+package searchserver
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/rs/zerolog/log"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/domino14/word_db_server/internal/cardbox"
+	"github.com/domino14/word_db_server/internal/common"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// QuizSchedulerServer implements the QuizScheduler service. Cardboxes are
+// made from the results of Searcher's searches.
+type QuizSchedulerServer struct {
+	Searcher *Server
+	Store    cardbox.Store
+	// Retention is how long deleted cardboxes can be restored for;
+	// cardbox.DefaultRetention if 0.
+	Retention time.Duration
+}
+
+func (s *QuizSchedulerServer) retention() time.Duration {
+	if s.Retention <= 0 {
+		return cardbox.DefaultRetention
+	}
+	return s.Retention
+}
+
+// cardboxError converts the store's errors to Twirp errors.
+func cardboxError(err error) error {
+	switch {
+	case errors.Is(err, cardbox.ErrNotFound):
+		return twirp.NotFoundError("cardbox or card not found")
+	case errors.Is(err, cardbox.ErrInvalidUser):
+		return twirp.InvalidArgumentError("user", err.Error())
+	}
+	return err
+}
+
+func cardToPB(c *cardbox.Card) *pb.Card {
+	return &pb.Card{
+		Alphagram:    c.Alphagram,
+		Box:          int32(c.Box),
+		Due:          c.Due.Unix(),
+		NumCorrect:   int32(c.NumCorrect),
+		NumIncorrect: int32(c.NumIncorrect),
+	}
+}
+
+// CreateCardbox creates a cardbox from the results of a search.
+func (s *QuizSchedulerServer) CreateCardbox(ctx context.Context, req *pb.CreateCardboxRequest) (
+	*pb.CreateCardboxResponse, error) {
+
+	if err := cardbox.ValidateUser(req.User); err != nil {
+		return nil, cardboxError(err)
+	}
+	if req.Search == nil {
+		return nil, twirp.RequiredArgumentError("search")
+	}
+	search := proto.Clone(req.Search).(*pb.SearchRequest)
+	search.Expand = false
+	results, err := s.Searcher.Search(ctx, search)
+	if err != nil {
+		return nil, err
+	}
+	cards := make([]*cardbox.Card, 0, len(results.Alphagrams))
+	seen := map[string]bool{}
+	for _, a := range results.Alphagrams {
+		if seen[a.Alphagram] {
+			continue
+		}
+		seen[a.Alphagram] = true
+		c := &cardbox.Card{Alphagram: a.Alphagram}
+		for _, w := range a.Words {
+			c.Words = append(c.Words, w.Word)
+		}
+		cards = append(cards, c)
+	}
+	id, err := s.Store.CreateCardbox(ctx, &cardbox.Cardbox{
+		User:    req.User,
+		Name:    req.Name,
+		Lexicon: results.Lexicon,
+		Created: time.Now(),
+	}, cards)
+	if err != nil {
+		return nil, cardboxError(err)
+	}
+	return &pb.CreateCardboxResponse{CardboxId: id, NumCards: int32(len(cards))}, nil
+}
+
+// RecordAnswer records an answer to a card and reschedules it.
+func (s *QuizSchedulerServer) RecordAnswer(ctx context.Context, req *pb.RecordAnswerRequest) (
+	*pb.RecordAnswerResponse, error) {
+
+	if err := cardbox.ValidateUser(req.User); err != nil {
+		return nil, cardboxError(err)
+	}
+	c, err := s.Store.RecordAnswer(ctx, req.User, req.CardboxId, req.Alphagram,
+		req.Correct, time.Now())
+	if err != nil {
+		return nil, cardboxError(err)
+	}
+	return &pb.RecordAnswerResponse{Card: cardToPB(c)}, nil
+}
+
+// DueCards returns the cards of a cardbox that are due now.
+func (s *QuizSchedulerServer) DueCards(ctx context.Context, req *pb.DueCardsRequest) (
+	*pb.DueCardsResponse, error) {
+
+	if err := cardbox.ValidateUser(req.User); err != nil {
+		return nil, cardboxError(err)
+	}
+	box, err := s.Store.Cardbox(ctx, req.User, req.CardboxId)
+	if err != nil {
+		return nil, cardboxError(err)
+	}
+	cards, numDue, err := s.Store.DueCards(ctx, req.User, req.CardboxId, time.Now(),
+		int(max(req.Limit, 0)))
+	if err != nil {
+		return nil, cardboxError(err)
+	}
+	resp := &pb.DueCardsResponse{Lexicon: box.Lexicon, NumDue: int32(numDue)}
+	for _, c := range cards {
+		resp.Cards = append(resp.Cards, cardToPB(c))
+	}
+	if req.Expand && len(cards) > 0 {
+		toExpand := &pb.SearchResponse{Lexicon: box.Lexicon}
+		for _, c := range cards {
+			a := &pb.Alphagram{Alphagram: c.Alphagram}
+			for _, w := range c.Words {
+				a.Words = append(a.Words, &pb.Word{Word: w})
+			}
+			toExpand.Alphagrams = append(toExpand.Alphagrams, a)
+		}
+		expanded, err := s.Searcher.Expand(ctx, toExpand)
+		if err != nil {
+			return nil, err
+		}
+		for i, a := range expanded.Alphagrams {
+			resp.Cards[i].Expanded = a
+		}
+	}
+	return resp, nil
+}
+
+// ImportHistory creates a cardbox from quiz history exported from another
+// tool.
+func (s *QuizSchedulerServer) ImportHistory(ctx context.Context, req *pb.ImportHistoryRequest) (
+	*pb.ImportHistoryResponse, error) {
+
+	if err := cardbox.ValidateUser(req.User); err != nil {
+		return nil, cardboxError(err)
+	}
+	if req.Lexicon == "" {
+		return nil, twirp.RequiredArgumentError("lexicon")
+	}
+	history, err := cardbox.ParseHistoryCSV(bytes.NewReader(req.Csv))
+	if err != nil {
+		return nil, twirp.InvalidArgumentError("csv", err.Error())
+	}
+	dist, err := common.LetterDistribution(
+		map[string]any{"data-path": s.Searcher.Config.DataPath}, req.Lexicon)
+	if err != nil {
+		return nil, twirp.InvalidArgumentError("lexicon", err.Error())
+	}
+
+	// Other tools may order the letters of a question differently, so make
+	// our own alphagrams of them.
+	resp := &pb.ImportHistoryResponse{}
+	byAlphagram := map[string]*cardbox.Card{}
+	alphagrams := []string{}
+	for _, c := range history {
+		mls, err := tilemapping.ToMachineLetters(c.Alphagram, dist.TileMapping())
+		if err != nil || slices.Contains(mls, 0) {
+			resp.NotFound = append(resp.NotFound, c.Alphagram)
+			continue
+		}
+		alph := common.InitializeWord(c.Alphagram, dist).MakeAlphagram()
+		if _, dup := byAlphagram[alph]; dup {
+			continue
+		}
+		c.Alphagram = alph
+		byAlphagram[alph] = c
+		alphagrams = append(alphagrams, alph)
+	}
+
+	cards := []*cardbox.Card{}
+	if len(alphagrams) > 0 {
+		found, err := s.Searcher.Search(ctx, &pb.SearchRequest{
+			Searchparams: []*pb.SearchRequest_SearchParam{
+				SearchDescLexicon(req.Lexicon), SearchDescAlphagramList(alphagrams)},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range found.Alphagrams {
+			c := byAlphagram[a.Alphagram]
+			for _, w := range a.Words {
+				c.Words = append(c.Words, w.Word)
+			}
+			cards = append(cards, c)
+			delete(byAlphagram, a.Alphagram)
+		}
+	}
+	for _, alph := range alphagrams {
+		if _, missing := byAlphagram[alph]; missing {
+			resp.NotFound = append(resp.NotFound, alph)
+		}
+	}
+
+	resp.CardboxId, err = s.Store.CreateCardbox(ctx, &cardbox.Cardbox{
+		User:    req.User,
+		Name:    req.Name,
+		Lexicon: req.Lexicon,
+		Created: time.Now(),
+	}, cards)
+	if err != nil {
+		return nil, cardboxError(err)
+	}
+	resp.NumCards = int32(len(cards))
+	return resp, nil
+}
+
+// DeleteCardbox deletes a cardbox, which can be restored until the
+// retention window has passed.
+func (s *QuizSchedulerServer) DeleteCardbox(ctx context.Context, req *pb.DeleteCardboxRequest) (
+	*pb.DeleteCardboxResponse, error) {
+
+	if err := cardbox.ValidateUser(req.User); err != nil {
+		return nil, cardboxError(err)
+	}
+	now := time.Now()
+	if err := s.Store.DeleteCardbox(ctx, req.User, req.CardboxId, now); err != nil {
+		return nil, cardboxError(err)
+	}
+	return &pb.DeleteCardboxResponse{RestorableUntil: now.Add(s.retention()).Unix()}, nil
+}
+
+// RestoreCardbox restores a cardbox deleted within the retention window.
+func (s *QuizSchedulerServer) RestoreCardbox(ctx context.Context, req *pb.RestoreCardboxRequest) (
+	*pb.RestoreCardboxResponse, error) {
+
+	if err := cardbox.ValidateUser(req.User); err != nil {
+		return nil, cardboxError(err)
+	}
+	box, err := s.Store.RestoreCardbox(ctx, req.User, req.CardboxId,
+		time.Now().Add(-s.retention()))
+	if err != nil {
+		return nil, cardboxError(err)
+	}
+	return &pb.RestoreCardboxResponse{CardboxId: box.ID, Name: box.Name, Lexicon: box.Lexicon}, nil
+}
+
+// DeletedCardboxes lists the cardboxes that can be restored.
+func (s *QuizSchedulerServer) DeletedCardboxes(ctx context.Context,
+	req *pb.DeletedCardboxesRequest) (*pb.DeletedCardboxesResponse, error) {
+
+	if err := cardbox.ValidateUser(req.User); err != nil {
+		return nil, cardboxError(err)
+	}
+	boxes, err := s.Store.DeletedCardboxes(ctx, req.User, time.Now().Add(-s.retention()))
+	if err != nil {
+		return nil, cardboxError(err)
+	}
+	resp := &pb.DeletedCardboxesResponse{}
+	for _, box := range boxes {
+		resp.Cardboxes = append(resp.Cardboxes, &pb.DeletedCardbox{
+			CardboxId:       box.ID,
+			Name:            box.Name,
+			Lexicon:         box.Lexicon,
+			Deleted:         box.Deleted.Unix(),
+			RestorableUntil: box.Deleted.Add(s.retention()).Unix(),
+		})
+	}
+	return resp, nil
+}
+
+// PurgeDeletedCardboxes removes the cardboxes whose retention window has
+// passed, and again every interval until ctx is done.
+func (s *QuizSchedulerServer) PurgeDeletedCardboxes(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		n, err := s.Store.PurgeDeleted(ctx, time.Now().Add(-s.retention()))
+		if err != nil {
+			log.Error().Err(err).Msg("could not purge deleted cardboxes")
+		} else if n > 0 {
+			log.Info().Int("cardboxes", n).Msg("purged deleted cardboxes")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
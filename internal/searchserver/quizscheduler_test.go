@@ -0,0 +1,160 @@
+package searchserver
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/word_db_server/config"
+	"github.com/domino14/word_db_server/internal/cardbox"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestQuizScheduler(t *testing.T) {
+	ctx := context.Background()
+	store, err := cardbox.OpenSQLite(t.TempDir())
+	assert.Nil(t, err)
+	s := &QuizSchedulerServer{
+		Searcher: &Server{Config: &config.Config{DataPath: makeExpandLexicon(t)}},
+		Store:    store,
+	}
+
+	created, err := s.CreateCardbox(ctx, &pb.CreateCardboxRequest{
+		User: "cesar",
+		Name: "twos",
+		Search: &pb.SearchRequest{Searchparams: []*pb.SearchRequest_SearchParam{
+			SearchDescLexicon("FOO"), SearchDescLength(2, 2)}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), created.NumCards)
+
+	due, err := s.DueCards(ctx, &pb.DueCardsRequest{User: "cesar",
+		CardboxId: created.CardboxId, Expand: true})
+	assert.Nil(t, err)
+	assert.Equal(t, "FOO", due.Lexicon)
+	assert.Equal(t, int32(2), due.NumDue)
+	assert.Equal(t, "AZ", due.Cards[0].Alphagram)
+	assert.Equal(t, "pizza", due.Cards[0].Expanded.Words[0].Definition)
+	assert.Equal(t, "a life force", due.Cards[1].Expanded.Words[0].Definition)
+
+	answered, err := s.RecordAnswer(ctx, &pb.RecordAnswerRequest{User: "cesar",
+		CardboxId: created.CardboxId, Alphagram: "IQ", Correct: true})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), answered.Card.Box)
+	assert.Equal(t, int32(1), answered.Card.NumCorrect)
+
+	due, err = s.DueCards(ctx, &pb.DueCardsRequest{User: "cesar", CardboxId: created.CardboxId})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), due.NumDue)
+	assert.Equal(t, "AZ", due.Cards[0].Alphagram)
+	assert.Nil(t, due.Cards[0].Expanded)
+
+	_, err = s.DueCards(ctx, &pb.DueCardsRequest{User: "mallory", CardboxId: created.CardboxId})
+	assert.Equal(t, twirp.NotFound, err.(twirp.Error).Code())
+	_, err = s.RecordAnswer(ctx, &pb.RecordAnswerRequest{User: "../cesar"})
+	assert.Equal(t, twirp.InvalidArgument, err.(twirp.Error).Code())
+	_, err = s.CreateCardbox(ctx, &pb.CreateCardboxRequest{User: "cesar"})
+	assert.Equal(t, twirp.InvalidArgument, err.(twirp.Error).Code())
+}
+
+func TestDeleteCardbox(t *testing.T) {
+	ctx := context.Background()
+	store, err := cardbox.OpenSQLite(t.TempDir())
+	assert.Nil(t, err)
+	s := &QuizSchedulerServer{
+		Searcher: &Server{Config: &config.Config{DataPath: makeExpandLexicon(t)}},
+		Store:    store,
+	}
+	created, err := s.CreateCardbox(ctx, &pb.CreateCardboxRequest{
+		User: "cesar",
+		Name: "twos",
+		Search: &pb.SearchRequest{Searchparams: []*pb.SearchRequest_SearchParam{
+			SearchDescLexicon("FOO"), SearchDescLength(2, 2)}},
+	})
+	assert.Nil(t, err)
+
+	deleted, err := s.DeleteCardbox(ctx, &pb.DeleteCardboxRequest{User: "cesar",
+		CardboxId: created.CardboxId})
+	assert.Nil(t, err)
+	assert.Greater(t, deleted.RestorableUntil, time.Now().Add(29*24*time.Hour).Unix())
+	_, err = s.DueCards(ctx, &pb.DueCardsRequest{User: "cesar", CardboxId: created.CardboxId})
+	assert.Equal(t, twirp.NotFound, err.(twirp.Error).Code())
+
+	list, err := s.DeletedCardboxes(ctx, &pb.DeletedCardboxesRequest{User: "cesar"})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(list.Cardboxes))
+	assert.Equal(t, "twos", list.Cardboxes[0].Name)
+	assert.Equal(t, deleted.RestorableUntil, list.Cardboxes[0].RestorableUntil)
+
+	restored, err := s.RestoreCardbox(ctx, &pb.RestoreCardboxRequest{User: "cesar",
+		CardboxId: created.CardboxId})
+	assert.Nil(t, err)
+	assert.Equal(t, "FOO", restored.Lexicon)
+	due, err := s.DueCards(ctx, &pb.DueCardsRequest{User: "cesar", CardboxId: created.CardboxId})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), due.NumDue)
+	_, err = s.RestoreCardbox(ctx, &pb.RestoreCardboxRequest{User: "cesar",
+		CardboxId: created.CardboxId})
+	assert.Equal(t, twirp.NotFound, err.(twirp.Error).Code())
+}
+
+func TestImportHistory(t *testing.T) {
+	ctx := context.Background()
+	dataPath := makeExpandLexicon(t)
+	// The letter distribution is found from the lexicon's name.
+	dbDir := filepath.Join(dataPath, "lexica", "db")
+	assert.Nil(t, os.Rename(filepath.Join(dbDir, "FOO.db"), filepath.Join(dbDir, "NWL99.db")))
+	assert.Nil(t, os.MkdirAll(filepath.Join(dataPath, "letterdistributions"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(dataPath, "letterdistributions", "english"),
+		[]byte("?,2,0,0\nA,9,1,1\nE,12,1,1\nI,9,1,1\nO,8,1,1\nQ,1,10,0\nV,2,4,0\nZ,1,10,0\n"), 0644))
+	store, err := cardbox.OpenSQLite(t.TempDir())
+	assert.Nil(t, err)
+	s := &QuizSchedulerServer{
+		Searcher: &Server{Config: &config.Config{DataPath: dataPath}},
+		Store:    store,
+	}
+
+	resp, err := s.ImportHistory(ctx, &pb.ImportHistoryRequest{
+		User:    "cesar",
+		Name:    "from zyzzyva",
+		Lexicon: "NWL99",
+		Csv: []byte(`question,correct,incorrect,cardbox,next_scheduled
+qi,3,1,2,4102444800
+VEO,0,1,0,0
+ZZ,1,0,1,0
+IQ,0,0,5,0
+AXE,1,0,1,0
+`),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), resp.NumCards)
+	assert.Equal(t, []string{"AXE", "ZZ"}, resp.NotFound)
+
+	cards, err := store.Cards(ctx, "cesar", resp.CardboxId)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(cards))
+	assert.Equal(t, "EOV", cards[0].Alphagram)
+	assert.Equal(t, []string{"EVO"}, cards[0].Words)
+	assert.Equal(t, 1, cards[0].NumIncorrect)
+	// The first row for a question wins.
+	assert.Equal(t, "IQ", cards[1].Alphagram)
+	assert.Equal(t, 2, cards[1].Box)
+	assert.Equal(t, int64(4102444800), cards[1].Due.Unix())
+
+	due, err := s.DueCards(ctx, &pb.DueCardsRequest{User: "cesar", CardboxId: resp.CardboxId})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), due.NumDue)
+	assert.Equal(t, "EOV", due.Cards[0].Alphagram)
+
+	_, err = s.ImportHistory(ctx, &pb.ImportHistoryRequest{User: "cesar", Lexicon: "FOO",
+		Csv: []byte("question\nIQ\n")})
+	assert.Equal(t, twirp.InvalidArgument, err.(twirp.Error).Code())
+	_, err = s.ImportHistory(ctx, &pb.ImportHistoryRequest{User: "cesar", Lexicon: "NWL99",
+		Csv: []byte("word\nQI\n")})
+	assert.Equal(t, twirp.InvalidArgument, err.(twirp.Error).Code())
+}
@@ -0,0 +1,98 @@
+package searchserver
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// RecordedSearch is one line of a search recording file. Only the request
+// itself and how long it took are kept; nothing about the caller (IP,
+// headers, etc) is recorded.
+type RecordedSearch struct {
+	Request   json.RawMessage `json:"request"`
+	ElapsedMS int64           `json:"elapsed_ms"`
+}
+
+// Recorder appends search requests to a file as JSON lines, so that they
+// can be replayed later with the searchbench command.
+type Recorder struct {
+	mu sync.Mutex
+	f  *os.File
+}
+
+// NewRecorder opens (or creates) the given file for appending.
+func NewRecorder(filename string) (*Recorder, error) {
+	f, err := os.OpenFile(filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &Recorder{f: f}, nil
+}
+
+// Record writes the request to the recording. Errors are only logged, since
+// recording should never make a search fail.
+func (r *Recorder) Record(req *pb.SearchRequest, elapsed time.Duration) {
+	bts, err := protojson.Marshal(req)
+	if err != nil {
+		log.Err(err).Msg("recorder-marshal")
+		return
+	}
+	line, err := json.Marshal(RecordedSearch{Request: bts, ElapsedMS: elapsed.Milliseconds()})
+	if err != nil {
+		log.Err(err).Msg("recorder-marshal")
+		return
+	}
+	line = append(line, '\n')
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, err := r.f.Write(line); err != nil {
+		log.Err(err).Msg("recorder-write")
+	}
+}
+
+// Close closes the underlying file.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.f.Close()
+}
+
+// ReadRecording reads all the search requests from a recording.
+func ReadRecording(rd io.Reader) ([]*pb.SearchRequest, error) {
+	reqs, _, err := ReadTimedRecording(rd)
+	return reqs, err
+}
+
+// ReadTimedRecording reads all the search requests from a recording, with
+// how long each took.
+func ReadTimedRecording(rd io.Reader) ([]*pb.SearchRequest, []time.Duration, error) {
+	reqs := []*pb.SearchRequest{}
+	elapsed := []time.Duration{}
+	scanner := bufio.NewScanner(rd)
+	scanner.Buffer(make([]byte, 1024*1024), 64*1024*1024)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		rec := RecordedSearch{}
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			return nil, nil, err
+		}
+		req := &pb.SearchRequest{}
+		if err := protojson.Unmarshal(rec.Request, req); err != nil {
+			return nil, nil, err
+		}
+		reqs = append(reqs, req)
+		elapsed = append(elapsed, time.Duration(rec.ElapsedMS)*time.Millisecond)
+	}
+	return reqs, elapsed, scanner.Err()
+}
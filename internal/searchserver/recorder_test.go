@@ -0,0 +1,45 @@
+package searchserver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestRecordAndRead(t *testing.T) {
+	fn := filepath.Join(t.TempDir(), "searches.jsonl")
+	r, err := NewRecorder(fn)
+	assert.Nil(t, err)
+
+	reqs := []*pb.SearchRequest{
+		WordSearch([]*pb.SearchRequest_SearchParam{
+			SearchDescLexicon("NWL23"),
+			SearchDescLength(7, 8),
+			SearchDescProbRange(100, 200),
+		}, true),
+		WordSearch([]*pb.SearchRequest_SearchParam{
+			SearchDescLexicon("CSW21"),
+			SearchDescAlphagramList([]string{"AEINRST", "DGOS"}),
+		}, false),
+	}
+	for _, req := range reqs {
+		r.Record(req, 15*time.Millisecond)
+	}
+	assert.Nil(t, r.Close())
+
+	f, err := os.Open(fn)
+	assert.Nil(t, err)
+	defer f.Close()
+	read, err := ReadRecording(f)
+	assert.Nil(t, err)
+	assert.Equal(t, len(reqs), len(read))
+	for i := range reqs {
+		assert.True(t, proto.Equal(reqs[i], read[i]))
+	}
+}
@@ -0,0 +1,226 @@
+package searchserver
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/rs/zerolog/log"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/domino14/word_db_server/internal/common"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// RESTPrefix is the path that the REST API is served under.
+const RESTPrefix = "/api/v1/"
+
+// restRangeParams are the search query parameters that take a number or a
+// range, like length=7 or probability=1-500, and what they search by.
+var restRangeParams = []struct {
+	name string
+	desc func(min, max int) *pb.SearchRequest_SearchParam
+}{
+	{"length", SearchDescLength},
+	{"probability", SearchDescProbRange},
+	{"playability", SearchDescPlayabilityRange},
+	{"difficulty", SearchDescDifficultyRange},
+	{"point_value", SearchDescPointValue},
+	{"num_anagrams", SearchDescNumAnagrams},
+	{"combinations", func(min, max int) *pb.SearchRequest_SearchParam {
+		return SearchDescCombinations(int64(min), int64(max))
+	}},
+}
+
+// restLetterParams are the search query parameters that take letters or
+// text.
+var restLetterParams = []struct {
+	name string
+	desc func(string) *pb.SearchRequest_SearchParam
+}{
+	{"contains", SearchDescContainsLetters},
+	{"excludes", SearchDescExcludesLetters},
+	{"definition", SearchDescDefinitionContains},
+	{"tag", SearchDescHasTag},
+}
+
+// RESTHandler serves a few GET endpoints for browsers and curl:
+//
+//	/api/v1/lexica/{lexicon}/word/{word}
+//	/api/v1/lexica/{lexicon}/alphagram/{letters}
+//	/api/v1/lexica/{lexicon}/search?length=7&probability=1-100
+//
+// They call the same searchers as the Twirp services, so requests are
+// checked the same way. Responses are in Twirp's JSON form, and errors are
+// Twirp errors. The alphagram and search endpoints expand their results
+// unless they're given expand=false.
+func RESTHandler(s *Server, words *WordSearchServer) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+RESTPrefix+"lexica/{lexicon}/word/{word}",
+		func(w http.ResponseWriter, r *http.Request) {
+			resp, err := words.GetWordInformation(r.Context(), &pb.DefineRequest{
+				Lexicon: r.PathValue("lexicon"), Word: r.PathValue("word")})
+			if err == nil && len(resp.Words) == 0 {
+				err = twirp.NotFoundError("word " + strings.ToUpper(r.PathValue("word")) + " not found")
+			}
+			if err != nil {
+				writeRESTError(w, err)
+				return
+			}
+			writeREST(w, resp.Words[0])
+		})
+	mux.HandleFunc("GET "+RESTPrefix+"lexica/{lexicon}/alphagram/{letters}",
+		func(w http.ResponseWriter, r *http.Request) {
+			lexicon := r.PathValue("lexicon")
+			alph, err := restAlphagram(s, lexicon, r.PathValue("letters"))
+			if err != nil {
+				writeRESTError(w, err)
+				return
+			}
+			expand, err := restExpand(r)
+			if err != nil {
+				writeRESTError(w, err)
+				return
+			}
+			resp, err := s.Search(r.Context(), WordSearch([]*pb.SearchRequest_SearchParam{
+				SearchDescLexicon(lexicon),
+				SearchDescAlphagramList([]string{alph}),
+			}, expand))
+			if err == nil && len(resp.Alphagrams) == 0 {
+				err = twirp.NotFoundError("no words with the letters " + alph)
+			}
+			if err != nil {
+				writeRESTError(w, err)
+				return
+			}
+			writeREST(w, resp.Alphagrams[0])
+		})
+	mux.HandleFunc("GET "+RESTPrefix+"lexica/{lexicon}/search",
+		func(w http.ResponseWriter, r *http.Request) {
+			req, err := restSearchRequest(r)
+			if err != nil {
+				writeRESTError(w, err)
+				return
+			}
+			resp, err := s.Search(r.Context(), req)
+			if err != nil {
+				writeRESTError(w, err)
+				return
+			}
+			writeREST(w, resp)
+		})
+	mux.HandleFunc(RESTPrefix, func(w http.ResponseWriter, r *http.Request) {
+		writeRESTError(w, twirp.NewError(twirp.BadRoute, "no such endpoint: "+r.Method+" "+r.URL.Path))
+	})
+	return mux
+}
+
+// restAlphagram returns the alphagram of the letters in the lexicon's
+// letter distribution.
+func restAlphagram(s *Server, lexicon, letters string) (string, error) {
+	dist, err := common.LetterDistribution(map[string]any{"data-path": s.Config.DataPath}, lexicon)
+	if err != nil {
+		return "", twirp.NotFoundError(err.Error())
+	}
+	letters = strings.ToUpper(letters)
+	if _, err := tilemapping.ToMachineLetters(letters, dist.TileMapping()); err != nil {
+		return "", twirp.InvalidArgumentError("letters", err.Error())
+	}
+	return common.InitializeWord(letters, dist).MakeAlphagram(), nil
+}
+
+func restExpand(r *http.Request) (bool, error) {
+	v := r.URL.Query().Get("expand")
+	if v == "" {
+		return true, nil
+	}
+	expand, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, twirp.InvalidArgumentError("expand", "must be true or false")
+	}
+	return expand, nil
+}
+
+// restSearchRequest makes a SearchRequest from a search's query
+// parameters.
+func restSearchRequest(r *http.Request) (*pb.SearchRequest, error) {
+	query := r.URL.Query()
+	known := map[string]bool{"expand": true, "page_size": true, "cursor": true}
+	params := []*pb.SearchRequest_SearchParam{SearchDescLexicon(r.PathValue("lexicon"))}
+	for _, p := range restRangeParams {
+		known[p.name] = true
+		v := query.Get(p.name)
+		if v == "" {
+			continue
+		}
+		min, max, ok := restRange(v)
+		if !ok {
+			return nil, twirp.InvalidArgumentError(p.name, "must be a number or a range like 1-100")
+		}
+		params = append(params, p.desc(min, max))
+	}
+	for _, p := range restLetterParams {
+		known[p.name] = true
+		if v := query.Get(p.name); v != "" {
+			params = append(params, p.desc(v))
+		}
+	}
+	for name := range query {
+		if !known[name] {
+			return nil, twirp.InvalidArgumentError(name, "is not a search parameter")
+		}
+	}
+	expand, err := restExpand(r)
+	if err != nil {
+		return nil, err
+	}
+	req := WordSearch(params, expand)
+	if v := query.Get("page_size"); v != "" {
+		size, err := strconv.Atoi(v)
+		if err != nil || size < 0 {
+			return nil, twirp.InvalidArgumentError("page_size", "must be a number")
+		}
+		req.PageSize = int32(size)
+	}
+	req.Cursor = query.Get("cursor")
+	return req, nil
+}
+
+// restRange parses a number, or a range like 1-500.
+func restRange(v string) (int, int, bool) {
+	lo, hi, isRange := strings.Cut(v, "-")
+	min, err := strconv.Atoi(lo)
+	if err != nil {
+		return 0, 0, false
+	}
+	if !isRange {
+		return min, min, true
+	}
+	max, err := strconv.Atoi(hi)
+	if err != nil {
+		return 0, 0, false
+	}
+	return min, max, true
+}
+
+func writeREST(w http.ResponseWriter, m proto.Message) {
+	// As Twirp writes its JSON responses.
+	bts, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(m)
+	if err != nil {
+		writeRESTError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(bts); err != nil {
+		log.Err(err).Msg("writing-rest-response")
+	}
+}
+
+func writeRESTError(w http.ResponseWriter, err error) {
+	if werr := twirp.WriteError(w, err); werr != nil {
+		log.Err(werr).Msg("writing-rest-error")
+	}
+}
@@ -0,0 +1,75 @@
+package searchserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/domino14/word_db_server/config"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestRESTSearchRequest(t *testing.T) {
+	parse := func(url string) (*pb.SearchRequest, error) {
+		var req *pb.SearchRequest
+		var err error
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /api/v1/lexica/{lexicon}/search", func(w http.ResponseWriter, r *http.Request) {
+			req, err = restSearchRequest(r)
+		})
+		mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", url, nil))
+		return req, err
+	}
+	req, err := parse("/api/v1/lexica/NWL20/search?length=7&probability=1-100&contains=Q&page_size=50")
+	assert.Nil(t, err)
+	assert.Equal(t, WordSearch([]*pb.SearchRequest_SearchParam{
+		SearchDescLexicon("NWL20"),
+		SearchDescLength(7, 7),
+		SearchDescProbRange(1, 100),
+		SearchDescContainsLetters("Q"),
+	}, true).String(), func() string { req.PageSize = 0; return req.String() }())
+
+	req, err = parse("/api/v1/lexica/NWL20/search?num_anagrams=2-3&expand=false&cursor=abc")
+	assert.Nil(t, err)
+	assert.False(t, req.Expand)
+	assert.Equal(t, "abc", req.Cursor)
+	assert.Equal(t, pb.SearchRequest_NUMBER_OF_ANAGRAMS, req.Searchparams[1].Condition)
+
+	for _, bad := range []string{"length=seven", "length=7-", "lenght=7", "expand=maybe", "page_size=-1"} {
+		_, err = parse("/api/v1/lexica/NWL20/search?" + bad)
+		assert.NotNil(t, err, bad)
+	}
+}
+
+func TestRESTHandler(t *testing.T) {
+	cfg := &config.Config{DataPath: makeExpandLexicon(t)}
+	h := RESTHandler(&Server{Config: cfg}, &WordSearchServer{Config: cfg})
+	get := func(url string) (int, map[string]any) {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest("GET", url, nil))
+		body := map[string]any{}
+		assert.Nil(t, json.Unmarshal(rec.Body.Bytes(), &body), rec.Body.String())
+		return rec.Code, body
+	}
+
+	code, body := get("/api/v1/lexica/FOO/word/qi")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "QI", body["word"])
+	assert.Equal(t, "a life force", body["definition"])
+
+	code, body = get("/api/v1/lexica/FOO/word/QAT")
+	assert.Equal(t, http.StatusNotFound, code)
+	assert.Equal(t, "not_found", body["code"])
+
+	code, body = get("/api/v1/lexica/FOO/nope")
+	assert.Equal(t, http.StatusNotFound, code)
+	assert.Equal(t, "bad_route", body["code"])
+
+	code, body = get("/api/v1/lexica/FOO/search?length=two")
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, "invalid_argument", body["code"])
+	assert.Equal(t, "length", body["meta"].(map[string]any)["argument"])
+}
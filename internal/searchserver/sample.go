@@ -0,0 +1,83 @@
+package searchserver
+
+import (
+	"context"
+	"hash/fnv"
+	"math/rand"
+	"sort"
+
+	"github.com/domino14/word_db_server/config"
+	"github.com/domino14/word_db_server/internal/querygen"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// sampleAlphagrams picks count of the alphagrams at random, and returns
+// them in their original order. The same seed always picks the same
+// positions out of the same number of alphagrams.
+func sampleAlphagrams(alphagrams []*pb.Alphagram, count int, seed int64) []*pb.Alphagram {
+	if count >= len(alphagrams) {
+		return alphagrams
+	}
+	picked := rand.New(rand.NewSource(seed)).Perm(len(alphagrams))[:count]
+	sort.Ints(picked)
+	sampled := make([]*pb.Alphagram, 0, count)
+	for _, i := range picked {
+		sampled = append(sampled, alphagrams[i])
+	}
+	return sampled
+}
+
+// expandSample fetches the full info for a sample of alphagrams that were
+// searched for without expanding, keeping them in the same order.
+func expandSample(ctx context.Context, lexName string, sortOrder pb.SearchRequest_SortOrder,
+	sampled []*pb.Alphagram, cfg *config.Config, db queryer) ([]*pb.Alphagram, error) {
+
+	if len(sampled) == 0 {
+		return sampled, nil
+	}
+	position := map[string]int{}
+	alphas := make([]string, 0, len(sampled))
+	for i, a := range sampled {
+		position[a.Alphagram] = i
+		alphas = append(alphas, a.Alphagram)
+	}
+	qgen := querygen.NewQueryGen(lexName, querygen.FullExpanded,
+		[]*pb.SearchRequest_SearchParam{SearchDescAlphagramList(alphas)},
+		MaxSQLChunkSize, cfg)
+	qgen.SetSortOrder(sortOrder)
+	queries, err := qgen.Generate()
+	if err != nil {
+		return nil, err
+	}
+	expanded, _, err := combineQueryResults(ctx, queries, db, true, qgen.Type(), 0)
+	if err != nil {
+		return nil, err
+	}
+	// Each chunk of the list comes back sorted on its own, so put them
+	// back in the sampled order.
+	sort.SliceStable(expanded, func(i, j int) bool {
+		return position[expanded[i].Alphagram] < position[expanded[j].Alphagram]
+	})
+	return expanded, nil
+}
+
+// pickSingleAnagrams keeps one word, picked at random, of each alphagram,
+// and shuffles the alphagrams if asked to. The word depends only on the
+// seed and the alphagram, so that pages and repeated searches agree.
+func pickSingleAnagrams(alphagrams []*pb.Alphagram, opts *pb.SearchRequest_SingleAnagram) []*pb.Alphagram {
+	for _, a := range alphagrams {
+		if len(a.Words) < 2 {
+			continue
+		}
+		h := fnv.New64a()
+		h.Write([]byte(a.Alphagram))
+		r := rand.New(rand.NewSource(opts.Seed ^ int64(h.Sum64())))
+		a.Words = []*pb.Word{a.Words[r.Intn(len(a.Words))]}
+	}
+	if opts.Shuffle {
+		rand.New(rand.NewSource(opts.Seed)).Shuffle(len(alphagrams), func(i, j int) {
+			alphagrams[i], alphagrams[j] = alphagrams[j], alphagrams[i]
+		})
+	}
+	return alphagrams
+}
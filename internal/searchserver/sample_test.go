@@ -0,0 +1,61 @@
+package searchserver
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestSampleAlphagrams(t *testing.T) {
+	alphs := []*pb.Alphagram{}
+	for i := 1; i <= 5000; i++ {
+		alphs = append(alphs, &pb.Alphagram{Alphagram: fmt.Sprintf("A%d", i), Probability: int32(i)})
+	}
+	sampled := sampleAlphagrams(alphs, 50, 1234)
+	assert.Equal(t, 50, len(sampled))
+	for i := 1; i < len(sampled); i++ {
+		assert.Less(t, sampled[i-1].Probability, sampled[i].Probability)
+	}
+	// Same seed, same sample; different seed, different sample.
+	assert.Equal(t, alphsFromPB(sampled), alphsFromPB(sampleAlphagrams(alphs, 50, 1234)))
+	assert.NotEqual(t, alphsFromPB(sampled), alphsFromPB(sampleAlphagrams(alphs, 50, 1235)))
+
+	assert.Equal(t, 3, len(sampleAlphagrams(alphs[:3], 50, 1234)))
+}
+
+func TestPickSingleAnagrams(t *testing.T) {
+	alphs := func() []*pb.Alphagram {
+		list := []*pb.Alphagram{}
+		for i := 1; i <= 100; i++ {
+			a := &pb.Alphagram{Alphagram: fmt.Sprintf("A%d", i), Probability: int32(i)}
+			for j := 0; j < 5; j++ {
+				a.Words = append(a.Words, &pb.Word{Word: fmt.Sprintf("W%d-%d", i, j)})
+			}
+			list = append(list, a)
+		}
+		return list
+	}
+	words := func(list []*pb.Alphagram) []string {
+		ws := []string{}
+		for _, a := range list {
+			assert.Equal(t, 1, len(a.Words))
+			ws = append(ws, a.Words[0].Word)
+		}
+		return ws
+	}
+	opts := &pb.SearchRequest_SingleAnagram{Seed: 42}
+	picked := words(pickSingleAnagrams(alphs(), opts))
+	assert.Equal(t, picked, words(pickSingleAnagrams(alphs(), opts)))
+	assert.NotEqual(t, picked, words(pickSingleAnagrams(alphs(), &pb.SearchRequest_SingleAnagram{Seed: 43})))
+	// An alphagram's word doesn't depend on what it's searched with.
+	assert.Equal(t, picked[50:], words(pickSingleAnagrams(alphs()[50:], opts)))
+
+	opts.Shuffle = true
+	shuffled := pickSingleAnagrams(alphs(), opts)
+	assert.NotEqual(t, picked, words(shuffled))
+	assert.ElementsMatch(t, picked, words(shuffled))
+	assert.Equal(t, words(shuffled), words(pickSingleAnagrams(alphs(), opts)))
+}
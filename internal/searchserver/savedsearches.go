@@ -0,0 +1,171 @@
+package searchserver
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/domino14/word_db_server/internal/apikeys"
+	"github.com/domino14/word_db_server/internal/cardbox"
+	"github.com/domino14/word_db_server/internal/validation"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// SavedSearchServer implements the SavedSearches service. The searches are
+// kept in the cardbox store, and run with Searcher.
+type SavedSearchServer struct {
+	Searcher *Server
+	Store    cardbox.Store
+}
+
+// savedSearchError converts the store's errors to Twirp errors.
+func savedSearchError(err error) error {
+	switch {
+	case errors.Is(err, cardbox.ErrNotFound):
+		return twirp.NotFoundError("saved search not found")
+	case errors.Is(err, cardbox.ErrInvalidSearchName):
+		return twirp.InvalidArgumentError("name", err.Error())
+	}
+	return cardboxError(err)
+}
+
+// apiKeyName returns the name of the API key the request was made with,
+// which saved searches are kept under, or "" without API keys.
+func apiKeyName(ctx context.Context) string {
+	if k := apikeys.FromContext(ctx); k != nil {
+		return k.Name
+	}
+	return ""
+}
+
+func savedSearchToPB(s *cardbox.SavedSearch) (*pb.SavedSearch, error) {
+	search := &pb.SearchRequest{}
+	// Searches saved by newer versions may have fields this one lacks.
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(
+		[]byte(s.Search), search); err != nil {
+		return nil, err
+	}
+	return &pb.SavedSearch{
+		Name:    s.Name,
+		Search:  search,
+		Created: s.Created.Unix(),
+		Updated: s.Updated.Unix(),
+	}, nil
+}
+
+// SaveSearch saves a search under a name. The search is checked as Search
+// would check it, but not run.
+func (s *SavedSearchServer) SaveSearch(ctx context.Context, req *pb.SaveSearchRequest) (
+	*pb.SaveSearchResponse, error) {
+
+	if err := cardbox.ValidateUser(req.User); err != nil {
+		return nil, savedSearchError(err)
+	}
+	if err := cardbox.ValidateSearchName(req.Name); err != nil {
+		return nil, savedSearchError(err)
+	}
+	if req.Search == nil {
+		return nil, twirp.RequiredArgumentError("search")
+	}
+	if err := validation.SearchRequest(req.Search); err != nil {
+		return nil, err.(*validation.Error).Twirp()
+	}
+	search := proto.Clone(req.Search).(*pb.SearchRequest)
+	search.Cursor = ""
+	search.SnapshotId = ""
+	search.PinSnapshot = false
+	encoded, err := protojson.Marshal(search)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	saved := &cardbox.SavedSearch{
+		User:    req.User,
+		APIKey:  apiKeyName(ctx),
+		Name:    req.Name,
+		Search:  string(encoded),
+		Created: now,
+		Updated: now,
+	}
+	replaced, err := s.Store.SaveSearch(ctx, saved)
+	if err != nil {
+		return nil, savedSearchError(err)
+	}
+	if replaced {
+		// The creation time is the original one.
+		if saved, err = s.Store.SavedSearch(ctx, req.User, saved.APIKey, req.Name); err != nil {
+			return nil, savedSearchError(err)
+		}
+	}
+	resp := &pb.SaveSearchResponse{Replaced: replaced}
+	if resp.SavedSearch, err = savedSearchToPB(saved); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ListSavedSearches lists the user's saved searches.
+func (s *SavedSearchServer) ListSavedSearches(ctx context.Context, req *pb.ListSavedSearchesRequest) (
+	*pb.ListSavedSearchesResponse, error) {
+
+	if err := cardbox.ValidateUser(req.User); err != nil {
+		return nil, savedSearchError(err)
+	}
+	saved, err := s.Store.SavedSearches(ctx, req.User, apiKeyName(ctx))
+	if err != nil {
+		return nil, savedSearchError(err)
+	}
+	resp := &pb.ListSavedSearchesResponse{SavedSearches: make([]*pb.SavedSearch, len(saved))}
+	for i, ss := range saved {
+		if resp.SavedSearches[i], err = savedSearchToPB(ss); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// RunSavedSearch runs one of the user's saved searches, with the paging
+// and expansion that the request asks for.
+func (s *SavedSearchServer) RunSavedSearch(ctx context.Context, req *pb.RunSavedSearchRequest) (
+	*pb.SearchResponse, error) {
+
+	if err := cardbox.ValidateUser(req.User); err != nil {
+		return nil, savedSearchError(err)
+	}
+	saved, err := s.Store.SavedSearch(ctx, req.User, apiKeyName(ctx), req.Name)
+	if err != nil {
+		return nil, savedSearchError(err)
+	}
+	ss, err := savedSearchToPB(saved)
+	if err != nil {
+		return nil, err
+	}
+	search := ss.Search
+	search.Expand = search.Expand || req.Expand
+	if req.PageSize > 0 {
+		search.PageSize = req.PageSize
+	}
+	if req.Cursor != "" && search.PageSize == 0 {
+		return nil, twirp.InvalidArgumentError("page_size",
+			"must be set to use a cursor, as the saved search isn't paged")
+	}
+	search.Cursor = req.Cursor
+	return s.Searcher.Search(ctx, search)
+}
+
+// DeleteSavedSearch deletes one of the user's saved searches.
+func (s *SavedSearchServer) DeleteSavedSearch(ctx context.Context, req *pb.DeleteSavedSearchRequest) (
+	*pb.DeleteSavedSearchResponse, error) {
+
+	if err := cardbox.ValidateUser(req.User); err != nil {
+		return nil, savedSearchError(err)
+	}
+	if err := s.Store.DeleteSavedSearch(ctx, req.User, apiKeyName(ctx), req.Name); err != nil {
+		return nil, savedSearchError(err)
+	}
+	return &pb.DeleteSavedSearchResponse{}, nil
+}
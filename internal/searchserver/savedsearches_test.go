@@ -0,0 +1,119 @@
+package searchserver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/word_db_server/config"
+	"github.com/domino14/word_db_server/internal/apikeys"
+	"github.com/domino14/word_db_server/internal/cardbox"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestSavedSearches(t *testing.T) {
+	ctx := context.Background()
+	store, err := cardbox.OpenSQLite(t.TempDir())
+	assert.Nil(t, err)
+	s := &SavedSearchServer{
+		Searcher: &Server{Config: &config.Config{DataPath: makeExpandLexicon(t)}},
+		Store:    store,
+	}
+	twos := &pb.SearchRequest{
+		Searchparams: []*pb.SearchRequest_SearchParam{SearchDescLexicon("FOO"), SearchDescLength(2, 2)},
+		Cursor:       "stale",
+	}
+
+	saved, err := s.SaveSearch(ctx, &pb.SaveSearchRequest{User: "cesar", Name: "twos", Search: twos})
+	assert.Nil(t, err)
+	assert.False(t, saved.Replaced)
+	assert.Equal(t, "twos", saved.SavedSearch.Name)
+	// The cursor isn't saved.
+	assert.Equal(t, "", saved.SavedSearch.Search.Cursor)
+
+	list, err := s.ListSavedSearches(ctx, &pb.ListSavedSearchesRequest{User: "cesar"})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(list.SavedSearches))
+	assert.Equal(t, 2, len(list.SavedSearches[0].Search.Searchparams))
+
+	resp, err := s.RunSavedSearch(ctx, &pb.RunSavedSearchRequest{User: "cesar", Name: "twos"})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(resp.Alphagrams))
+	assert.Equal(t, "", resp.Alphagrams[0].Words[0].Definition)
+
+	paged, err := s.RunSavedSearch(ctx, &pb.RunSavedSearchRequest{User: "cesar", Name: "twos",
+		Expand: true, PageSize: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(paged.Alphagrams))
+	assert.Equal(t, "a life force", paged.Alphagrams[0].Words[0].Definition)
+	assert.NotEmpty(t, paged.NextCursor)
+	next, err := s.RunSavedSearch(ctx, &pb.RunSavedSearchRequest{User: "cesar", Name: "twos",
+		PageSize: 1, Cursor: paged.NextCursor})
+	assert.Nil(t, err)
+	assert.Equal(t, "AZ", next.Alphagrams[0].Alphagram)
+	// The saved search isn't paged, so a cursor needs a page size.
+	_, err = s.RunSavedSearch(ctx, &pb.RunSavedSearchRequest{User: "cesar", Name: "twos",
+		Cursor: paged.NextCursor})
+	assert.Equal(t, twirp.InvalidArgument, err.(twirp.Error).Code())
+	assert.Equal(t, "page_size", err.(twirp.Error).Meta("argument"))
+
+	threes := &pb.SearchRequest{
+		Searchparams: []*pb.SearchRequest_SearchParam{SearchDescLexicon("FOO"), SearchDescLength(3, 3)}}
+	saved, err = s.SaveSearch(ctx, &pb.SaveSearchRequest{User: "cesar", Name: "twos", Search: threes})
+	assert.Nil(t, err)
+	assert.True(t, saved.Replaced)
+	resp, err = s.RunSavedSearch(ctx, &pb.RunSavedSearchRequest{User: "cesar", Name: "twos"})
+	assert.Nil(t, err)
+	assert.Equal(t, "EOV", resp.Alphagrams[0].Alphagram)
+
+	_, err = s.RunSavedSearch(ctx, &pb.RunSavedSearchRequest{User: "mallory", Name: "twos"})
+	assert.Equal(t, twirp.NotFound, err.(twirp.Error).Code())
+	_, err = s.SaveSearch(ctx, &pb.SaveSearchRequest{User: "cesar", Name: "bad",
+		Search: &pb.SearchRequest{}})
+	assert.Equal(t, twirp.InvalidArgument, err.(twirp.Error).Code())
+	_, err = s.SaveSearch(ctx, &pb.SaveSearchRequest{User: "cesar", Search: twos})
+	assert.Equal(t, twirp.InvalidArgument, err.(twirp.Error).Code())
+
+	_, err = s.DeleteSavedSearch(ctx, &pb.DeleteSavedSearchRequest{User: "cesar", Name: "twos"})
+	assert.Nil(t, err)
+	_, err = s.DeleteSavedSearch(ctx, &pb.DeleteSavedSearchRequest{User: "cesar", Name: "twos"})
+	assert.Equal(t, twirp.NotFound, err.(twirp.Error).Code())
+}
+
+func TestSavedSearchesPerAPIKey(t *testing.T) {
+	store, err := cardbox.OpenSQLite(t.TempDir())
+	assert.Nil(t, err)
+	keys, err := apikeys.New([]*apikeys.Key{{Name: "app", Key: "a"}, {Name: "other", Key: "o"}}, 0)
+	assert.Nil(t, err)
+	srv := httptest.NewServer(keys.Middleware(pb.NewSavedSearchesServer(&SavedSearchServer{
+		Searcher: &Server{Config: &config.Config{DataPath: makeExpandLexicon(t)}},
+		Store:    store,
+	})))
+	defer srv.Close()
+	client := pb.NewSavedSearchesProtobufClient(srv.URL, http.DefaultClient)
+	withKey := func(key string) context.Context {
+		ctx, err := twirp.WithHTTPRequestHeaders(context.Background(),
+			http.Header{"Authorization": {"Bearer " + key}})
+		assert.Nil(t, err)
+		return ctx
+	}
+
+	_, err = client.SaveSearch(withKey("a"), &pb.SaveSearchRequest{User: "cesar", Name: "twos",
+		Search: &pb.SearchRequest{Searchparams: []*pb.SearchRequest_SearchParam{
+			SearchDescLexicon("FOO"), SearchDescLength(2, 2)}}})
+	assert.Nil(t, err)
+	list, err := client.ListSavedSearches(withKey("a"), &pb.ListSavedSearchesRequest{User: "cesar"})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(list.SavedSearches))
+
+	// The same user through another key has none.
+	list, err = client.ListSavedSearches(withKey("o"), &pb.ListSavedSearchesRequest{User: "cesar"})
+	assert.Nil(t, err)
+	assert.Empty(t, list.SavedSearches)
+	_, err = client.RunSavedSearch(withKey("o"), &pb.RunSavedSearchRequest{User: "cesar", Name: "twos"})
+	assert.Equal(t, twirp.NotFound, err.(twirp.Error).Code())
+}
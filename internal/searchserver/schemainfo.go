@@ -0,0 +1,249 @@
+package searchserver
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"strings"
+
+	"github.com/twitchtv/twirp"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/domino14/word_db_server/internal/dbschema"
+	"github.com/domino14/word_db_server/internal/querygen"
+	"github.com/domino14/word_db_server/internal/wordstore"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// GetSchemaInfo returns the schema of one or all of the lexicon databases.
+func (s *LexiconInfoServer) GetSchemaInfo(ctx context.Context, req *pb.SchemaInfoRequest) (
+	*pb.SchemaInfoResponse, error) {
+
+	lexica := []string{req.Lexicon}
+	if req.Lexicon == "" {
+		var err error
+		lexica, err = servedLexica(ctx, s.Config)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	store, err := lexiconStore(s.Config)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.SchemaInfoResponse{LatestVersion: dbschema.CurrentVersion}
+	for _, lex := range lexica {
+		db, err := store.Open(lex)
+		if err != nil {
+			if req.Lexicon != "" {
+				return nil, twirp.NotFoundError(err.Error())
+			}
+			resp.Lexica = append(resp.Lexica, &pb.SchemaInfo{Lexicon: lex, Error: err.Error()})
+			continue
+		}
+		info, err := schemaInfo(ctx, db, store.Dialect())
+		db.Close()
+		if err != nil {
+			info = &pb.SchemaInfo{Error: err.Error()}
+		}
+		info.Lexicon = lex
+		resp.Lexica = append(resp.Lexica, info)
+	}
+	return resp, nil
+}
+
+// searchParamOneof is the oneof of SearchParam that conditions' parameters
+// go in.
+var searchParamOneof = (&pb.SearchRequest_SearchParam{}).ProtoReflect().Descriptor().
+	Oneofs().ByName("conditionparam")
+
+// GetSearchSchema describes the search conditions, from the query
+// generator's list of the ones it handles.
+func (s *LexiconInfoServer) GetSearchSchema(ctx context.Context, req *pb.SearchSchemaRequest) (
+	*pb.SearchSchema, error) {
+
+	resp := &pb.SearchSchema{}
+	var caps map[string]bool
+	if req.Lexicon != "" {
+		store, err := lexiconStore(s.Config)
+		if err != nil {
+			return nil, err
+		}
+		db, err := store.Open(req.Lexicon)
+		if err != nil {
+			return nil, twirp.NotFoundError(err.Error())
+		}
+		defer db.Close()
+		if caps, err = servedCapabilities(s.Config, req.Lexicon, db); err != nil {
+			return nil, err
+		}
+		if resp.Schema, err = schemaInfo(ctx, db, store.Dialect()); err != nil {
+			return nil, err
+		}
+		resp.Schema.Lexicon = req.Lexicon
+	}
+	tr := s.Translations.For(req.Locale)
+	for _, info := range querygen.Conditions() {
+		name := info.Condition.String()
+		c := &pb.SearchSchema_Condition{
+			Name:        name,
+			Number:      int32(info.Condition),
+			Description: tr.Condition(name, info.Description),
+			Param:       info.Param,
+			Capability:  info.Capability,
+			// Lexica from before the capabilities table may have anything.
+			Available:  caps == nil || info.Capability == "" || caps[info.Capability],
+			Combinable: info.Combinable,
+			MustBeLast: info.Last,
+		}
+		if info.Param != "" {
+			c.Fields = paramFields(info)
+		}
+		resp.Conditions = append(resp.Conditions, c)
+	}
+	return resp, nil
+}
+
+// paramFields describes the fields of a condition's parameter.
+func paramFields(info *querygen.ConditionInfo) []*pb.SearchSchema_Field {
+	msg := searchParamOneof.Fields().ByName(protoreflect.Name(info.Param)).Message()
+	fields := []*pb.SearchSchema_Field{}
+	for i := 0; i < msg.Fields().Len(); i++ {
+		fd := msg.Fields().Get(i)
+		f := &pb.SearchSchema_Field{
+			Name:     string(fd.Name()),
+			Type:     fd.Kind().String(),
+			Repeated: fd.IsList(),
+		}
+		enum := fd.Enum()
+		if enum == nil && info.Enum != nil {
+			// A number that stands for one of the enum's values.
+			enum = info.Enum
+			f.Type = protoreflect.EnumKind.String()
+		}
+		if enum != nil {
+			for j := 0; j < enum.Values().Len(); j++ {
+				f.EnumValues = append(f.EnumValues, string(enum.Values().Get(j).Name()))
+			}
+		}
+		if fd.Message() != nil {
+			f.Message = string(fd.Message().FullName())
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+func schemaInfo(ctx context.Context, db *sql.DB, dialect wordstore.Dialect) (*pb.SchemaInfo, error) {
+	info := &pb.SchemaInfo{}
+	err := db.QueryRowContext(ctx, `SELECT version FROM db_version`).Scan(&info.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	var tables []string
+	if dialect == wordstore.Postgres {
+		tables, err = queryStrings(ctx, db, `SELECT table_name FROM information_schema.tables
+			WHERE table_schema = current_schema() AND table_type = 'BASE TABLE'
+			ORDER BY table_name`)
+	} else {
+		tables, err = sqliteTables(ctx, db)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	hasMigrations := false
+	for _, name := range tables {
+		if name == "schema_migrations" {
+			hasMigrations = true
+		}
+		var columns []string
+		if dialect == wordstore.Postgres {
+			columns, err = queryStrings(ctx, db, `SELECT column_name FROM information_schema.columns
+				WHERE table_schema = current_schema() AND table_name = ?
+				ORDER BY ordinal_position`, name)
+		} else {
+			// Names come from sqlite_master, so they're safe to put in the
+			// query. pragma_table_info can't take a bound table name on
+			// older sqlite versions.
+			columns, err = queryStrings(ctx, db, `SELECT name FROM pragma_table_info('`+
+				strings.ReplaceAll(name, "'", "''")+`') ORDER BY cid`)
+		}
+		if err != nil {
+			return nil, err
+		}
+		info.Tables = append(info.Tables, &pb.SchemaInfo_Table{Name: name, Columns: columns})
+	}
+
+	if !hasMigrations {
+		return info, nil
+	}
+	rows, err := db.QueryContext(ctx, `SELECT version, kind, applied_at FROM schema_migrations
+		ORDER BY applied_at, version`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		m := &pb.SchemaInfo_Migration{}
+		if err := rows.Scan(&m.Version, &m.Kind, &m.AppliedAt); err != nil {
+			return nil, err
+		}
+		info.Migrations = append(info.Migrations, m)
+	}
+	return info, rows.Err()
+}
+
+// sqliteTables returns the tables of a SQLite database, leaving out the
+// ones that virtual tables (the FTS index) keep their data in.
+func sqliteTables(ctx context.Context, db *sql.DB) ([]string, error) {
+	tables := []string{}
+	virtual := []string{}
+	rows, err := db.QueryContext(ctx, `SELECT name, sql FROM sqlite_master WHERE type = 'table'
+		AND name NOT LIKE 'sqlite_%' ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name, createSQL string
+		if err := rows.Scan(&name, &createSQL); err != nil {
+			return nil, err
+		}
+		tables = append(tables, name)
+		if strings.HasPrefix(createSQL, "CREATE VIRTUAL TABLE") {
+			virtual = append(virtual, name)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(tables, func(name string) bool {
+		for _, v := range virtual {
+			if strings.HasPrefix(name, v+"_") {
+				return true
+			}
+		}
+		return false
+	}), nil
+}
+
+// queryStrings returns the first column of a query's rows.
+func queryStrings(ctx context.Context, db *sql.DB, query string, args ...any) ([]string, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	values := []string{}
+	for rows.Next() {
+		var v string
+		if err := rows.Scan(&v); err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, rows.Err()
+}
@@ -0,0 +1,128 @@
+package searchserver
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/domino14/word_db_server/config"
+	"github.com/domino14/word_db_server/internal/localize"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestGetSchemaInfo(t *testing.T) {
+	dataPath := t.TempDir()
+	dbDir := filepath.Join(dataPath, "lexica", "db")
+	assert.Nil(t, os.MkdirAll(dbDir, 0755))
+
+	db, err := sql.Open("sqlite3", filepath.Join(dbDir, "NEW.db"))
+	assert.Nil(t, err)
+	_, err = db.Exec(`CREATE TABLE db_version (version integer);
+		INSERT INTO db_version VALUES(13);
+		CREATE TABLE words (word varchar(20), alphagram varchar(20));
+		CREATE TABLE schema_migrations (version int, kind varchar(16), applied_at int);
+		INSERT INTO schema_migrations VALUES(12, 'created', 100), (13, 'migrated', 200);`)
+	assert.Nil(t, err)
+	db.Close()
+
+	db, err = sql.Open("sqlite3", filepath.Join(dbDir, "OLD.db"))
+	assert.Nil(t, err)
+	_, err = db.Exec(`CREATE TABLE db_version (version integer);
+		INSERT INTO db_version VALUES(4);`)
+	assert.Nil(t, err)
+	db.Close()
+	assert.Nil(t, os.WriteFile(filepath.Join(dbDir, "README"), []byte("hi"), 0644))
+
+	s := &LexiconInfoServer{Config: &config.Config{DataPath: dataPath}}
+	resp, err := s.GetSchemaInfo(context.Background(), &pb.SchemaInfoRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(resp.Lexica))
+
+	assert.Equal(t, "NEW", resp.Lexica[0].Lexicon)
+	assert.Equal(t, int32(13), resp.Lexica[0].Version)
+	assert.Equal(t, 2, len(resp.Lexica[0].Migrations))
+	assert.Equal(t, "migrated", resp.Lexica[0].Migrations[1].Kind)
+	assert.Equal(t, "words", resp.Lexica[0].Tables[2].Name)
+	assert.Equal(t, []string{"word", "alphagram"}, resp.Lexica[0].Tables[2].Columns)
+
+	assert.Equal(t, "OLD", resp.Lexica[1].Lexicon)
+	assert.Equal(t, int32(4), resp.Lexica[1].Version)
+	assert.Empty(t, resp.Lexica[1].Migrations)
+
+	_, err = s.GetSchemaInfo(context.Background(), &pb.SchemaInfoRequest{Lexicon: "NOPE"})
+	assert.NotNil(t, err)
+}
+
+func TestGetSearchSchema(t *testing.T) {
+	dataPath := t.TempDir()
+	dbDir := filepath.Join(dataPath, "lexica", "db")
+	assert.Nil(t, os.MkdirAll(dbDir, 0755))
+	db, err := sql.Open("sqlite3", filepath.Join(dbDir, "NEW.db"))
+	assert.Nil(t, err)
+	_, err = db.Exec(`CREATE TABLE db_version (version integer);
+		INSERT INTO db_version VALUES(13);
+		CREATE TABLE capabilities (name varchar(32) PRIMARY KEY, enabled int);
+		INSERT INTO capabilities VALUES ('difficulty', 1), ('definitions', 0);`)
+	assert.Nil(t, err)
+	db.Close()
+
+	s := &LexiconInfoServer{Config: &config.Config{DataPath: dataPath}}
+	byName := func(resp *pb.SearchSchema) map[string]*pb.SearchSchema_Condition {
+		m := map[string]*pb.SearchSchema_Condition{}
+		for _, c := range resp.Conditions {
+			m[c.Name] = c
+		}
+		return m
+	}
+	resp, err := s.GetSearchSchema(context.Background(), &pb.SearchSchemaRequest{})
+	assert.Nil(t, err)
+	assert.Nil(t, resp.Schema)
+	conds := byName(resp)
+
+	length := conds["LENGTH"]
+	assert.Equal(t, int32(1), length.Number)
+	assert.Equal(t, "minmax", length.Param)
+	assert.Equal(t, []*pb.SearchSchema_Field{{Name: "min", Type: "int32"}, {Name: "max", Type: "int32"}},
+		length.Fields)
+	assert.True(t, length.Combinable)
+	assert.False(t, length.MustBeLast)
+
+	notIn := conds["NOT_IN_LEXICON"].Fields[0]
+	assert.Equal(t, "enum", notIn.Type)
+	assert.Equal(t, []string{"OTHER_ENGLISH", "PREVIOUS_VERSION"}, notIn.EnumValues)
+	diffMode := conds["LEXICON_DIFF"].Fields[1]
+	assert.Equal(t, "mode", diffMode.Name)
+	assert.Equal(t, []string{"NOT_IN_OTHER", "WORDS_DIFFER"}, diffMode.EnumValues)
+	params := conds["COMBINATOR"].Fields[1]
+	assert.True(t, params.Repeated)
+	assert.Equal(t, "wordsearcher.SearchRequest.SearchParam", params.Message)
+	assert.True(t, conds["ALPHAGRAM_LIST"].MustBeLast)
+	assert.Empty(t, conds["DELETED_WORD"].Fields)
+	assert.NotContains(t, conds, "HAS_TAGS")
+	assert.True(t, conds["DEFINITION_CONTAINS"].Available)
+
+	s.Translations = localize.Translations{"es": {Conditions: map[string]string{"LENGTH": "Longitud"}}}
+	resp, err = s.GetSearchSchema(context.Background(), &pb.SearchSchemaRequest{Locale: "es-AR"})
+	assert.Nil(t, err)
+	conds = byName(resp)
+	assert.Equal(t, "Longitud", conds["LENGTH"].Description)
+	assert.NotEqual(t, "Longitud", length.Description)
+	s.Translations = nil
+
+	resp, err = s.GetSearchSchema(context.Background(), &pb.SearchSchemaRequest{Lexicon: "NEW"})
+	assert.Nil(t, err)
+	assert.Equal(t, "NEW", resp.Schema.Lexicon)
+	assert.Equal(t, int32(13), resp.Schema.Version)
+	conds = byName(resp)
+	assert.True(t, conds["DIFFICULTY_RANGE"].Available)
+	assert.False(t, conds["DEFINITION_CONTAINS"].Available)
+	assert.False(t, conds["WORD_SOURCE"].Available)
+	assert.True(t, conds["LENGTH"].Available)
+
+	_, err = s.GetSearchSchema(context.Background(), &pb.SearchSchemaRequest{Lexicon: "NOPE"})
+	assert.NotNil(t, err)
+}
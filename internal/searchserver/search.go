@@ -4,58 +4,184 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/rs/zerolog/log"
+	"github.com/twitchtv/twirp"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/domino14/word_db_server/config"
 	"github.com/domino14/word_db_server/internal/querygen"
+	"github.com/domino14/word_db_server/internal/tracing"
+	"github.com/domino14/word_db_server/internal/validation"
+	"github.com/domino14/word_db_server/internal/wordstore"
 	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
 )
 
 // Search implements the search for alphagrams/words
 func (s *Server) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResponse, error) {
 	defer timeTrack(time.Now(), "search")
+	if s.Recorder != nil {
+		defer func(start time.Time) { s.Recorder.Record(req, time.Since(start)) }(time.Now())
+	}
 	qgen, err := createQueryGen(req, s.Config, MaxSQLChunkSize)
 	if err != nil {
 		return nil, err
 	}
+	if alphagrams, truncated, ok := s.AlphagramIndex.search(req, qgen.LexiconName(),
+		s.Config.MaxSearchResults); ok {
+
+		if req.SingleAnagram != nil {
+			alphagrams = pickSingleAnagrams(alphagrams, req.SingleAnagram)
+		}
+		stripDefinitions(s.Config, qgen.LexiconName(), alphagrams)
+		return &pb.SearchResponse{
+			Alphagrams: alphagrams,
+			Lexicon:    qgen.LexiconName(),
+			Truncated:  truncated,
+		}, nil
+	}
+	ctx, cancel := s.withSearchTimeout(ctx)
+	defer cancel()
 
-	db, err := getDbConnection(s.Config, qgen.LexiconName())
+	db, snapshotID, release, err := s.searchDB(qgen.LexiconName(), req.SnapshotId, req.PinSnapshot)
 	if err != nil {
+		return nil, lexiconError(req, err)
+	}
+	defer release()
+
+	caps, err := servedCapabilities(s.Config, qgen.LexiconName(), db)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCapabilities(qgen.LexiconName(), req.Searchparams, caps); err != nil {
 		return nil, err
 	}
-	defer db.Close()
 
+	_, buildSpan := tracing.Start(ctx, "build_query", attribute.String("lexicon", qgen.LexiconName()))
+	if s.Config.WordDBDSN == "" {
+		// Postgres plans its own queries.
+		stats, err := s.DBs.tableStats(db)
+		if err != nil {
+			// The search still works, it just isn't planned.
+			log.Err(err).Str("lexicon", qgen.LexiconName()).Msg("could not read table stats")
+		}
+		qgen.SetStats(stats)
+	}
 	queries, err := qgen.Generate()
+	buildSpan.SetAttributes(attribute.Int("queries", len(queries)))
+	tracing.End(buildSpan, err)
 	if err != nil {
 		return nil, err
 	}
 	log.Debug().Msgf("Generated queries %v", queries)
 
-	alphagrams, err := combineQueryResults(queries, db, req.Expand, qgen.Type())
+	q := s.DBs.queryer(db)
+	if diff := qgen.LexiconDiff(); diff != nil {
+		conn, detach, err := s.attachOtherLexicon(ctx, db, diff.OtherLexicon)
+		if err != nil {
+			return nil, lexiconError(req, err)
+		}
+		defer detach()
+		q = conn
+	}
+
+	alphagrams, truncated, err := combineQueryResults(ctx, queries, q, req.Expand, qgen.Type(),
+		s.Config.MaxSearchResults)
 	if err != nil {
-		return nil, err
+		return nil, searchError(ctx, err)
+	}
+	if sample := qgen.RandomSample(); sample != nil {
+		// The search was done without expanding, so that we only fetch
+		// the details for the alphagrams we keep.
+		alphagrams = sampleAlphagrams(alphagrams, int(sample.Count), sample.Seed)
+		if req.Expand {
+			alphagrams, err = expandSample(ctx, qgen.LexiconName(), req.SortOrder, alphagrams, s.Config, q)
+			if err != nil {
+				return nil, searchError(ctx, err)
+			}
+		}
+	}
+
+	if list := qgen.OrderedList(); list != nil {
+		var missing []string
+		alphagrams, missing = list.Order(alphagrams)
+		// A truncated search can't tell what is missing.
+		if len(missing) > 0 && !truncated {
+			return nil, orderedListError(qgen.LexiconName(), missing)
+		}
+	}
+
+	if req.SingleAnagram != nil {
+		alphagrams = pickSingleAnagrams(alphagrams, req.SingleAnagram)
+	}
+	stripDefinitions(s.Config, qgen.LexiconName(), alphagrams)
+
+	var nextCursor string
+	if req.PageSize > 0 {
+		alphagrams, nextCursor = cutPage(alphagrams, int(req.PageSize))
 	}
 
 	return &pb.SearchResponse{
 		Alphagrams: alphagrams,
 		Lexicon:    qgen.LexiconName(),
+		SnapshotId: snapshotID,
+		NextCursor: nextCursor,
+		Truncated:  truncated,
 	}, nil
 }
 
+// lexiconError reports a lexicon that isn't served as a problem with the
+// search param that names it. Other errors are returned as they are.
+func lexiconError(req *pb.SearchRequest, err error) error {
+	var unknown *wordstore.UnknownLexiconError
+	if !errors.As(err, &unknown) {
+		return err
+	}
+	for i, p := range req.Searchparams {
+		switch {
+		case p.Condition == pb.SearchRequest_LEXICON && p.GetStringvalue().GetValue() == unknown.Lexicon:
+			return (&validation.Error{Index: i, Field: "stringvalue.value", Msg: unknown.Error()}).Twirp()
+		case p.Condition == pb.SearchRequest_LEXICON_DIFF &&
+			p.GetLexicondiff().GetOtherLexicon() == unknown.Lexicon:
+			return (&validation.Error{Index: i, Field: "lexicondiff.other_lexicon",
+				Msg: unknown.Error()}).Twirp()
+		}
+	}
+	return err
+}
+
+// maxMissingEntries is how many of the entries of an ordered list that
+// aren't in the lexicon are named in the error.
+const maxMissingEntries = 10
+
+func orderedListError(lexicon string, missing []string) error {
+	named := strings.Join(missing[:min(len(missing), maxMissingEntries)], ", ")
+	if len(missing) > maxMissingEntries {
+		named += fmt.Sprintf(" and %d more", len(missing)-maxMissingEntries)
+	}
+	return twirp.NewError(twirp.InvalidArgument,
+		fmt.Sprintf("listed alphagrams not in %s: %s", lexicon, named))
+}
+
 func createQueryGen(req *pb.SearchRequest, cfg *config.Config, maxChunkSize int) (*querygen.QueryGen, error) {
 	log.Info().Msgf("Creating query gen for request %v", req)
-	if req.Searchparams == nil || len(req.Searchparams) < 1 {
-		return nil, errors.New("no search params provided")
-	}
-	if req.Searchparams[0].Condition != pb.SearchRequest_LEXICON {
-		return nil, errors.New("the first condition must be a lexicon")
+	if err := validation.SearchRequest(req); err != nil {
+		return nil, err.(*validation.Error).Twirp()
 	}
 	lexName := req.Searchparams[0].GetStringvalue().GetValue()
 
+	sampled := false
+	for _, p := range req.Searchparams {
+		if p.Condition == pb.SearchRequest_RANDOM_SAMPLE {
+			sampled = true
+		}
+	}
 	var queryType querygen.QueryType
-	if req.Expand {
+	if req.Expand && !sampled {
 		queryType = querygen.FullExpanded
 	} else {
 		queryType = querygen.AlphagramsAndWords
@@ -69,53 +195,178 @@ func createQueryGen(req *pb.SearchRequest, cfg *config.Config, maxChunkSize int)
 	}
 
 	qgen := querygen.NewQueryGen(lexName, queryType, req.Searchparams[1:], maxChunkSize, cfg)
+	qgen.SetSortOrder(req.SortOrder)
+	if !req.Expand {
+		if err := qgen.SetWordFields(req.PartialExpand); err != nil {
+			return nil, twirp.InvalidArgumentError("partial_expand", err.Error())
+		}
+	}
+	if req.PageSize != 0 {
+		page := &querygen.Page{Size: int(req.PageSize)}
+		if req.Cursor != "" {
+			after, err := decodeCursor(req.Cursor)
+			if err != nil {
+				return nil, twirp.InvalidArgumentError("cursor", err.Error())
+			}
+			page.After = after
+		}
+		qgen.SetPage(page)
+	} else if req.Cursor != "" {
+		return nil, twirp.InvalidArgumentError("page_size", "must be set to use a cursor")
+	}
+	if req.SingleAnagram.GetShuffle() && req.PageSize != 0 {
+		return nil, twirp.InvalidArgumentError("single_anagram", "shuffled results can't be paged")
+	}
 	log.Debug().Msgf("Creating new querygen with lexicon name %v, search params %v, expand %v",
 		lexName, req.Searchparams[1:], req.Expand)
 
-	err := qgen.Validate()
+	// The conditions are each well-formed, but may not go together.
+	if err := qgen.Validate(); err != nil {
+		return nil, twirp.InvalidArgumentError("searchparams", err.Error())
+	}
+	return qgen, nil
+}
+
+// alphagramStats reads the statistics that ANALYZE stored for the
+// alphagrams table. It returns nil if the database hasn't been analyzed.
+func alphagramStats(db *sql.DB) (*querygen.TableStats, error) {
+	rows, err := db.Query(`SELECT s.stat, i.name FROM sqlite_stat1 s
+		LEFT JOIN pragma_index_info(s.idx) i ON i.seqno = 0
+		WHERE s.tbl = 'alphagrams'`)
 	if err != nil {
+		if strings.Contains(err.Error(), "no such table") {
+			return nil, nil
+		}
 		return nil, err
 	}
-	return qgen, nil
+	defer rows.Close()
+	var stats *querygen.TableStats
+	for rows.Next() {
+		var stat string
+		var column sql.NullString
+		if err := rows.Scan(&stat, &column); err != nil {
+			return nil, err
+		}
+		// The stat is the number of rows, then the average number of rows
+		// with the same values in the first column, the first two, etc.
+		fields := strings.Fields(stat)
+		if len(fields) == 0 {
+			continue
+		}
+		n, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil {
+			continue
+		}
+		if stats == nil {
+			stats = &querygen.TableStats{RowsPerValue: map[string]float64{}}
+		}
+		stats.Rows = max(stats.Rows, n)
+		if column.Valid && len(fields) > 1 {
+			if perValue, err := strconv.ParseFloat(fields[1], 64); err == nil {
+				stats.RowsPerValue[column.String] = perValue
+			}
+		}
+	}
+	return stats, rows.Err()
+}
+
+// queryer is a *sql.DB, or a *sql.Conn when the queries need something
+// set up on the connection first.
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
 }
 
-func combineQueryResults(queries []*querygen.Query, db *sql.DB, expand bool, qtype querygen.QueryType) (
-	[]*pb.Alphagram, error) {
+// attachOtherLexicon attaches the other lexicon's database to a connection
+// from db, for a LEXICON_DIFF search. The returned function detaches it
+// and returns the connection to the pool.
+func (s *Server) attachOtherLexicon(ctx context.Context, db *sql.DB, other string) (
+	*sql.Conn, func(), error) {
+
+	if s.Config.WordDBDSN != "" {
+		return nil, nil, twirp.NewError(twirp.Unimplemented,
+			"LEXICON_DIFF searches are not available when the lexica are in Postgres")
+	}
+	otherPath, err := lexiconDBPath(s.Config, other)
+	if err != nil {
+		return nil, nil, err
+	}
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	_, err = conn.ExecContext(ctx, "ATTACH DATABASE ? AS "+querygen.OtherLexiconSchema, otherPath)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	return conn, func() {
+		_, err := conn.ExecContext(context.Background(), "DETACH DATABASE "+querygen.OtherLexiconSchema)
+		if err != nil {
+			log.Err(err).Msg("could not detach other lexicon")
+		}
+		conn.Close()
+	}, nil
+}
+
+// combineQueryResults runs the queries and returns their alphagrams. If max
+// is more than 0, it returns no more than max of them, and whether it cut
+// off any others.
+func combineQueryResults(ctx context.Context, queries []*querygen.Query, db queryer, expand bool,
+	qtype querygen.QueryType, max int) ([]*pb.Alphagram, bool, error) {
 
 	alphagrams := []*pb.Alphagram{}
 	// Execute the queries.
 	for _, query := range queries {
-		rows, err := db.Query(query.Rendered(), query.BindParams()...)
+		qctx, span := tracing.StartQuery(ctx, query.Rendered())
+		rows, err := db.QueryContext(qctx, query.Rendered(), query.BindParams()...)
 		if err != nil {
-			return nil, err
+			tracing.End(span, err)
+			return nil, false, err
 		}
-		alphagrams = append(alphagrams, processQuestionRows(rows, expand, qtype)...)
+		left := 0
+		if max > 0 {
+			// One more than are wanted, to tell if there are others.
+			left = max - len(alphagrams) + 1
+		}
+		// Filter as the rows are read, so that only the alphagrams kept
+		// count toward the limit.
+		found, more := processQuestionRows(rows, expand, qtype, left, query.Keeps)
+		// The rows stop early if the context ends.
+		err = rows.Err()
 		rows.Close()
+		span.SetAttributes(attribute.Int("rows", len(found)))
+		tracing.End(span, err)
+		if err != nil {
+			return nil, false, err
+		}
+		alphagrams = append(alphagrams, found...)
+		if max > 0 && (more || len(alphagrams) > max) {
+			return alphagrams[:min(max, len(alphagrams))], true, nil
+		}
 	}
 
-	return alphagrams, nil
+	return alphagrams, false, nil
 }
 
-func processQuestionRows(rows *sql.Rows, expanded bool, qtype querygen.QueryType) []*pb.Alphagram {
+// processQuestionRows reads the rows' alphagrams, leaving out the ones
+// that keep rejects if it isn't nil. If max is more than 0, it stops once
+// it has max of them, and returns whether it stopped before the last row.
+func processQuestionRows(rows *sql.Rows, expanded bool, qtype querygen.QueryType, max int,
+	keep func(alphagram string) bool) ([]*pb.Alphagram, bool) {
 	alphagrams := []*pb.Alphagram{}
 	start := time.Now()
 
 	var lastAlphagram *pb.Alphagram
 	curWords := []*pb.Word{}
-	var rawBuffer []sql.RawBytes
-	var numColumns int
-	if expanded {
-		numColumns = 11
-	} else {
-		numColumns = 2
-	}
-	// Ignore expand if we're dealing with DeletedWords.
-	// DeletedWords come from a special table, have no alphagrams, definitions, etc.
-	if qtype == querygen.DeletedWords {
-		numColumns = 1
+	// The columns differ depending on the query type (expanded, unexpanded,
+	// deleted words), so look them up by name.
+	columns, err := rows.Columns()
+	if err != nil {
+		log.Error().Err(err).Msg("error getting columns")
+		return alphagrams, false
 	}
 	// We are using raw bytes here because scanning is slow otherwise.
-	rawBuffer = make([]sql.RawBytes, numColumns)
+	rawBuffer := make([]sql.RawBytes, len(columns))
 	scanCallArgs := make([]interface{}, len(rawBuffer))
 	for i := range rawBuffer {
 		scanCallArgs[i] = &rawBuffer[i]
@@ -125,40 +376,51 @@ func processQuestionRows(rows *sql.Rows, expanded bool, qtype querygen.QueryType
 	log.Info().Msgf("before rows.Next() took %s", time.Since(start))
 
 	for rows.Next() {
-		var word, alphagram string
+		var word, alphagram, displayAlphagram string
 		var lexSymbols, definition, frontHooks, backHooks string
-		var probability, difficulty int32
+		var probability, difficulty, playability, length, vowelProbability int32
 		var combinations int64
 		var innerFrontHook, innerBackHook bool
+		var sources []string
 		err := rows.Scan(scanCallArgs...)
 		if err != nil {
 			log.Error().Err(err).Msg("error while scanning")
 			continue
 		}
 		for i, col := range rawBuffer {
-			switch i {
-			case 0:
+			switch columns[i] {
+			case "word":
 				word = string(col)
-			case 1:
+			case "alphagram":
 				alphagram = string(col)
-			case 2:
+			case "lexicon_symbols":
 				lexSymbols = string(col)
-			case 3:
+			case "definition":
 				definition = string(col)
-			case 4:
+			case "front_hooks":
 				frontHooks = string(col)
-			case 5:
+			case "back_hooks":
 				backHooks = string(col)
-			case 6:
+			case "inner_front_hook":
 				innerFrontHook = tobool(col)
-			case 7:
+			case "inner_back_hook":
 				innerBackHook = tobool(col)
-			case 8:
+			case "probability":
 				probability = toint32(col)
-			case 9:
+			case "combinations":
 				combinations = toint64(col)
-			case 10:
+			case "difficulty":
 				difficulty = toint32(col)
+			case "display_alphagram":
+				displayAlphagram = string(col)
+			case "playability":
+				playability = toint32(col)
+			case "length":
+				length = toint32(col)
+			case "vowel_probability":
+				vowelProbability = toint32(col)
+			case "sources":
+				sources = splitSources(string(col))
 			}
 		}
 		if qtype == querygen.DeletedWords {
@@ -166,16 +428,26 @@ func processQuestionRows(rows *sql.Rows, expanded bool, qtype querygen.QueryType
 		}
 
 		alpha := &pb.Alphagram{
-			Alphagram:    alphagram,
-			Probability:  probability,
-			Combinations: combinations,
-			Length:       int32(len([]rune(alphagram))),
-			ExpandedRepr: expanded,
-			Difficulty:   difficulty,
+			Alphagram:        alphagram,
+			Probability:      probability,
+			Combinations:     combinations,
+			Length:           length,
+			ExpandedRepr:     expanded,
+			Difficulty:       difficulty,
+			DisplayAlphagram: displayAlphagram,
+			Playability:      playability,
+			VowelProbability: vowelProbability,
+			Deleted:          qtype == querygen.DeletedWords,
 		}
 		if lastAlphagram != nil && alpha.Alphagram != lastAlphagram.Alphagram {
-			lastAlphagram.Words = curWords
-			alphagrams = append(alphagrams, lastAlphagram)
+			if keep == nil || keep(lastAlphagram.Alphagram) {
+				lastAlphagram.Words = curWords
+				alphagrams = append(alphagrams, lastAlphagram)
+				if max > 0 && len(alphagrams) == max {
+					log.Debug().Msgf("Stopped after %v alphagrams", max)
+					return alphagrams, true
+				}
+			}
 			curWords = []*pb.Word{}
 		}
 		if !expanded {
@@ -193,15 +465,17 @@ func processQuestionRows(rows *sql.Rows, expanded bool, qtype querygen.QueryType
 			LexiconSymbols: lexSymbols,
 			InnerFrontHook: innerFrontHook,
 			InnerBackHook:  innerBackHook,
+			Deleted:        qtype == querygen.DeletedWords,
+			Sources:        sources,
 		})
 
 		lastAlphagram = alpha
 		rowCtr++
 	}
-	if lastAlphagram != nil {
+	if lastAlphagram != nil && (keep == nil || keep(lastAlphagram.Alphagram)) {
 		lastAlphagram.Words = curWords
 		alphagrams = append(alphagrams, lastAlphagram)
 	}
 	log.Debug().Msgf("Scanned %v rows", rowCtr)
-	return alphagrams
+	return alphagrams, false
 }
@@ -2,10 +2,16 @@ package searchserver
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/twitchtv/twirp"
+
 	"github.com/domino14/word_db_server/config"
+	"github.com/domino14/word_db_server/internal/querygen"
 	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
 	"github.com/stretchr/testify/assert"
 )
@@ -137,7 +143,8 @@ func TestNotEnoughParams(t *testing.T) {
 	}, false)
 	resp, err := searchHelper(req)
 	assert.Nil(t, resp)
-	assert.EqualError(t, err, "the first condition must be a lexicon")
+	assert.Equal(t, twirp.InvalidArgument, err.(twirp.Error).Code())
+	assert.Equal(t, "searchparams[0].condition must be LEXICON", err.(twirp.Error).Msg())
 }
 
 func TestNoLexicon(t *testing.T) {
@@ -147,7 +154,8 @@ func TestNoLexicon(t *testing.T) {
 	}, false)
 	resp, err := searchHelper(req)
 	assert.Nil(t, resp)
-	assert.EqualError(t, err, "the first condition must be a lexicon")
+	assert.Equal(t, "0", err.(twirp.Error).Meta("param_index"))
+	assert.Equal(t, "condition", err.(twirp.Error).Meta("field"))
 }
 
 func TestProbabilityLimitUnallowed(t *testing.T) {
@@ -159,7 +167,9 @@ func TestProbabilityLimitUnallowed(t *testing.T) {
 	}, false)
 	resp, err := searchHelper(req)
 	assert.Nil(t, resp)
-	assert.EqualError(t, err, "mutually exclusive search conditions not allowed")
+	assert.Equal(t, twirp.InvalidArgument, err.(twirp.Error).Code())
+	assert.Equal(t, "searchparams mutually exclusive search conditions not allowed",
+		err.(twirp.Error).Msg())
 }
 
 func TestProbabilityLimitSecond(t *testing.T) {
@@ -257,7 +267,7 @@ func TestProbabilityListMultipleQueries(t *testing.T) {
 	assert.Nil(t, err)
 	// There should be 5 queries (max chunk size is 2 and we have 9 elements in list)
 	assert.Equal(t, 5, len(queries))
-	pbAlphas, err := combineQueryResults(queries, db, expand, qgen.Type())
+	pbAlphas, _, err := combineQueryResults(context.Background(), queries, db, expand, qgen.Type(), 0)
 	assert.Nil(t, err)
 	assert.Equal(t, []string{
 		"ADELNOR", "EILNORS", // 73, 92
@@ -286,10 +296,373 @@ func TestProbabilityListMultipleQueriesOther(t *testing.T) {
 	queries, _ := qgen.Generate()
 	// There should be 3 queries (max chunk size is 2 and we have 9 elements in list)
 	assert.Equal(t, 3, len(queries))
-	pbAlphas, _ := combineQueryResults(queries, db, expand, qgen.Type())
+	pbAlphas, _, _ := combineQueryResults(context.Background(), queries, db, expand, qgen.Type(), 0)
 	assert.Equal(t, []string{
 		"ADELNOR", "AENORSU", "EILNORS", // 73, 85, 92
 		"AEGINOS", "AINORTU", "CEINORT", // 43, 61, 185
 		"AEEILNT", "AAEEINT", "AEINNRT", // 25, 33, 99
 	}, alphsFromPB(pbAlphas))
 }
+
+func TestAlphagramStats(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.Nil(t, err)
+	defer db.Close()
+	_, err = db.Exec(`CREATE TABLE alphagrams (alphagram varchar(20), length int, probability int);
+		CREATE INDEX alpha_index on alphagrams(alphagram);
+		CREATE INDEX length_index on alphagrams(length);`)
+	assert.Nil(t, err)
+	for i := 0; i < 100; i++ {
+		_, err = db.Exec(`INSERT INTO alphagrams VALUES(?, ?, ?)`,
+			fmt.Sprintf("A%03d", i), 2+i%4, i/4+1)
+		assert.Nil(t, err)
+	}
+
+	stats, err := alphagramStats(db)
+	assert.Nil(t, err)
+	assert.Nil(t, stats)
+
+	_, err = db.Exec(`ANALYZE`)
+	assert.Nil(t, err)
+	stats, err = alphagramStats(db)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(100), stats.Rows)
+	assert.Equal(t, map[string]float64{"alphagram": 1, "length": 25}, stats.RowsPerValue)
+}
+
+func TestHasTag(t *testing.T) {
+	cfg := &config.Config{DataPath: makeExpandLexicon(t)}
+	db, err := getDbConnection(cfg, "FOO")
+	assert.Nil(t, err)
+	defer db.Close()
+	_, err = db.Exec(`CREATE TABLE word_tags (word varchar(20), tag varchar(32));
+		INSERT INTO word_tags VALUES ('ZA', 'common'), ('QI', 'obscure'), ('EVO', 'common')`)
+	assert.Nil(t, err)
+
+	qgen := querygen.NewQueryGen("FOO", querygen.AlphagramsOnly, []*pb.SearchRequest_SearchParam{
+		SearchDescLength(2, 3), SearchDescHasTag("Common")}, MaxSQLChunkSize, cfg)
+	queries, err := qgen.Generate()
+	assert.Nil(t, err)
+	alphas, _, err := combineQueryResults(context.Background(), queries, db, false, qgen.Type(), 0)
+	assert.Nil(t, err)
+	found := []string{}
+	for _, a := range alphas {
+		found = append(found, a.Alphagram)
+	}
+	assert.Equal(t, []string{"AZ", "EOV"}, found)
+}
+
+func TestOrderedLists(t *testing.T) {
+	dataPath := makeExpandLexicon(t)
+	// The letter distribution is found from the lexicon's name.
+	dbDir := filepath.Join(dataPath, "lexica", "db")
+	assert.Nil(t, os.Rename(filepath.Join(dbDir, "FOO.db"), filepath.Join(dbDir, "NWL99.db")))
+	assert.Nil(t, os.MkdirAll(filepath.Join(dataPath, "letterdistributions"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(dataPath, "letterdistributions", "english"),
+		[]byte("?,2,0,0\nA,9,1,1\nE,12,1,1\nI,9,1,1\nO,8,1,1\nQ,1,10,0\nV,2,4,0\nZ,1,10,0\n"), 0644))
+	s := &Server{Config: &config.Config{DataPath: dataPath}}
+	search := func(params ...*pb.SearchRequest_SearchParam) (*pb.SearchResponse, error) {
+		return s.Search(context.Background(), WordSearch(
+			append([]*pb.SearchRequest_SearchParam{SearchDescLexicon("NWL99")}, params...), true))
+	}
+
+	// Words and alphagrams, with a repeat.
+	resp, err := search(SearchDescOrderedAlphagramList([]string{"EVO", "iq", "AZ", "QI"}))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"EOV", "IQ", "AZ"}, alphagrams(resp))
+
+	resp, err = search(SearchDescOrderedProbabilityList(2, 2, 3, 3, 2, 1))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"AZ", "EOV", "IQ"}, alphagrams(resp))
+	assert.Equal(t, "pizza", resp.Alphagrams[0].Words[0].Definition)
+
+	_, err = search(SearchDescOrderedAlphagramList([]string{"AZ", "ZOE", "VIE"}))
+	assert.Equal(t, twirp.InvalidArgument, err.(twirp.Error).Code())
+	assert.Equal(t, "listed alphagrams not in NWL99: EOZ, EIV", err.(twirp.Error).Msg())
+
+	_, err = search(SearchDescOrderedProbabilityList(2, 2, 2, 5))
+	assert.Equal(t, "listed alphagrams not in NWL99: 2:5", err.(twirp.Error).Msg())
+
+	_, err = search(SearchDescOrderedAlphagramList([]string{"AZ", "XYZ"}))
+	assert.Equal(t, `"XYZ" is not a valid alphagram`, err.Error())
+
+	_, err = search(SearchDescLength(2, 2), SearchDescOrderedAlphagramList([]string{"AZ"}))
+	assert.Equal(t, twirp.InvalidArgument, err.(twirp.Error).Code())
+	assert.Equal(t, "searchparams an ordered list can't be combined with other conditions",
+		err.(twirp.Error).Msg())
+}
+
+func TestSearchPartialExpand(t *testing.T) {
+	s := &Server{Config: &config.Config{DataPath: makeExpandLexicon(t)}}
+	req := WordSearch([]*pb.SearchRequest_SearchParam{
+		SearchDescLexicon("FOO"),
+		SearchDescLength(3, 3),
+	}, false)
+	req.PartialExpand = []pb.SearchRequest_WordField{
+		pb.SearchRequest_FRONT_HOOKS, pb.SearchRequest_BACK_HOOKS, pb.SearchRequest_SOURCES}
+	resp, err := s.Search(context.Background(), req)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"EOV"}, alphagrams(resp))
+	assert.False(t, resp.Alphagrams[0].ExpandedRepr)
+	assert.Equal(t, int32(3), resp.Alphagrams[0].Length)
+	assert.Equal(t, int64(0), resp.Alphagrams[0].Combinations)
+	w := resp.Alphagrams[0].Words[0]
+	assert.Equal(t, "EVO", w.Word)
+	assert.Equal(t, "D", w.FrontHooks)
+	assert.Equal(t, "S", w.BackHooks)
+	assert.Equal(t, []string{"NZ"}, w.Sources)
+	assert.Equal(t, "", w.Definition)
+
+	req.PartialExpand = []pb.SearchRequest_WordField{99}
+	_, err = s.Search(context.Background(), req)
+	assert.Equal(t, twirp.InvalidArgument, err.(twirp.Error).Code())
+}
+
+func TestSearchInnerHooks(t *testing.T) {
+	dataPath := makeExpandLexicon(t)
+	db, err := sql.Open("sqlite3", filepath.Join(dataPath, "lexica", "db", "FOO.db"))
+	assert.Nil(t, err)
+	_, err = db.Exec(`UPDATE words SET inner_back_hook = 1 WHERE word = 'EVO';
+		UPDATE words SET inner_front_hook = 1 WHERE word = 'ZA'`)
+	assert.Nil(t, err)
+	db.Close()
+	s := &Server{Config: &config.Config{DataPath: dataPath}}
+
+	resp, err := s.Search(context.Background(), WordSearch([]*pb.SearchRequest_SearchParam{
+		SearchDescLexicon("FOO"),
+		SearchDescLength(2, 3),
+		SearchDescHasInnerHooks(),
+	}, false))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"AZ", "EOV"}, alphagrams(resp))
+
+	resp, err = s.Search(context.Background(), WordSearch([]*pb.SearchRequest_SearchParam{
+		SearchDescLexicon("FOO"),
+		SearchDescLength(2, 3),
+		SearchDescNoInnerHooks(),
+	}, false))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"IQ"}, alphagrams(resp))
+}
+
+func TestSearchAddedInLastUpdates(t *testing.T) {
+	dataPath := makeExpandLexicon(t)
+	db, err := sql.Open("sqlite3", filepath.Join(dataPath, "lexica", "db", "FOO.db"))
+	assert.Nil(t, err)
+	_, err = db.Exec(`CREATE TABLE capabilities (name varchar(32) PRIMARY KEY, enabled int);
+		ALTER TABLE words ADD COLUMN valid_since varchar(20);`)
+	assert.Nil(t, err)
+	db.Close()
+	s := &Server{Config: &config.Config{DataPath: dataPath}}
+	search := func(n int) ([]string, error) {
+		resp, err := s.Search(context.Background(), WordSearch([]*pb.SearchRequest_SearchParam{
+			SearchDescLexicon("FOO"),
+			SearchDescLength(2, 3),
+			SearchDescAddedInLastUpdates(n),
+		}, false))
+		if err != nil {
+			return nil, err
+		}
+		return alphagrams(resp), nil
+	}
+	_, err = search(1)
+	assert.Equal(t, twirp.FailedPrecondition, err.(twirp.Error).Code())
+
+	db, err = sql.Open("sqlite3", filepath.Join(dataPath, "lexica", "db", "FOO.db"))
+	assert.Nil(t, err)
+	_, err = db.Exec(`INSERT INTO capabilities VALUES ('history', 1);
+		CREATE TABLE lexicon_history (lexicon varchar(20) PRIMARY KEY, updates_ago int);
+		INSERT INTO lexicon_history VALUES ('FOO', 0), ('FOO2', 1), ('FOO1', 2);
+		UPDATE words SET valid_since = 'FOO' WHERE word = 'EVO';
+		UPDATE words SET valid_since = 'FOO2' WHERE word = 'ZA';
+		UPDATE words SET valid_since = 'FOO1' WHERE word = 'QI';`)
+	assert.Nil(t, err)
+	db.Close()
+
+	found, err := search(1)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"EOV"}, found)
+	found, err = search(2)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"AZ", "EOV"}, found)
+	// QI may be older than the oldest version known.
+	found, err = search(5)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"AZ", "EOV"}, found)
+	_, err = search(0)
+	assert.NotNil(t, err)
+}
+
+func TestSearchSingleAnagram(t *testing.T) {
+	dataPath := makeExpandLexicon(t)
+	db, err := sql.Open("sqlite3", filepath.Join(dataPath, "lexica", "db", "FOO.db"))
+	assert.Nil(t, err)
+	_, err = db.Exec(`INSERT INTO words VALUES ('VOE', 'EOV', '', 'a ewe', '', '', 0, 0, '')`)
+	assert.Nil(t, err)
+	db.Close()
+	s := &Server{Config: &config.Config{DataPath: dataPath}}
+
+	req := WordSearch([]*pb.SearchRequest_SearchParam{
+		SearchDescLexicon("FOO"),
+		SearchDescLength(3, 3),
+	}, true)
+	resp, err := s.Search(context.Background(), req)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(resp.Alphagrams[0].Words))
+
+	seen := map[string]bool{}
+	for seed := int64(0); seed < 20; seed++ {
+		req.SingleAnagram = &pb.SearchRequest_SingleAnagram{Seed: seed}
+		resp, err = s.Search(context.Background(), req)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(resp.Alphagrams[0].Words))
+		assert.Equal(t, "EOV", resp.Alphagrams[0].Words[0].Alphagram)
+		seen[resp.Alphagrams[0].Words[0].Word] = true
+	}
+	assert.Equal(t, map[string]bool{"EVO": true, "VOE": true}, seen)
+
+	req.SingleAnagram.Shuffle = true
+	req.PageSize = 10
+	_, err = s.Search(context.Background(), req)
+	assert.NotNil(t, err)
+}
+
+func TestSearchStemPlusOne(t *testing.T) {
+	dataPath := makeExpandLexicon(t)
+	dbDir := filepath.Join(dataPath, "lexica", "db")
+	assert.Nil(t, os.Rename(filepath.Join(dbDir, "FOO.db"), filepath.Join(dbDir, "NWL99.db")))
+	assert.Nil(t, os.MkdirAll(filepath.Join(dataPath, "letterdistributions"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(dataPath, "letterdistributions", "english"),
+		[]byte("?,2,0,0\nA,9,1,1\nE,12,1,1\nI,9,1,1\nO,8,1,1\nQ,1,10,0\nV,2,4,0\nZ,1,10,0\n"), 0644))
+	s := &Server{Config: &config.Config{DataPath: dataPath}}
+	search := func(params ...*pb.SearchRequest_SearchParam) (*pb.SearchResponse, error) {
+		return s.Search(context.Background(), WordSearch(
+			append([]*pb.SearchRequest_SearchParam{SearchDescLexicon("NWL99")}, params...), false))
+	}
+
+	resp, err := search(SearchDescStemPlusOne("ve"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"EOV"}, alphagrams(resp))
+	resp, err = search(SearchDescStemPlusOne("Z"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"AZ"}, alphagrams(resp))
+	resp, err = search(SearchDescLength(3, 3), SearchDescStemPlusOne("Q"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{}, alphagrams(resp))
+
+	_, err = search(SearchDescStemPlusOne(""))
+	assert.NotNil(t, err)
+	_, err = search(SearchDescStemPlusOne("Q?"))
+	assert.NotNil(t, err)
+}
+
+func TestSearchProbLimitPerLength(t *testing.T) {
+	s := &Server{Config: &config.Config{DataPath: makeExpandLexicon(t)}}
+	search := func(params ...*pb.SearchRequest_SearchParam) (*pb.SearchResponse, error) {
+		return s.Search(context.Background(), WordSearch(
+			append([]*pb.SearchRequest_SearchParam{SearchDescLexicon("FOO")}, params...), false))
+	}
+
+	resp, err := search(SearchDescLength(2, 3), SearchDescProbLimitPerLength(1, 1))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"IQ", "EOV"}, alphagrams(resp))
+	resp, err = search(SearchDescLength(2, 3), SearchDescProbLimitPerLength(2, 5))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"AZ"}, alphagrams(resp))
+	resp, err = search(SearchDescLength(2, 3), SearchDescProbRange(2, 3), SearchDescProbLimitPerLength(1, 1))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"AZ", "EOV"}, alphagrams(resp))
+
+	_, err = search(SearchDescProbLimitPerLength(1, 1))
+	assert.NotNil(t, err)
+	_, err = search(SearchDescLength(2, 30), SearchDescProbLimitPerLength(1, 1))
+	assert.NotNil(t, err)
+	_, err = search(SearchDescLength(2, 3), SearchDescProbLimitPerLength(0, 1))
+	assert.NotNil(t, err)
+}
+
+func TestSearchMostProbable(t *testing.T) {
+	dataPath := makeExpandLexicon(t)
+	db, err := sql.Open("sqlite3", filepath.Join(dataPath, "lexica", "db", "FOO.db"))
+	assert.Nil(t, err)
+	// Ranked across lengths, EVO is the most probable.
+	_, err = db.Exec(`UPDATE alphagrams SET combinations = 50 WHERE alphagram = 'EOV';
+		UPDATE alphagrams SET combinations = 10 WHERE alphagram = 'AZ'`)
+	assert.Nil(t, err)
+	db.Close()
+	s := &Server{Config: &config.Config{DataPath: dataPath}}
+	search := func(params ...*pb.SearchRequest_SearchParam) (*pb.SearchResponse, error) {
+		return s.Search(context.Background(), WordSearch(
+			append([]*pb.SearchRequest_SearchParam{SearchDescLexicon("FOO")}, params...), true))
+	}
+
+	resp, err := search(SearchDescLength(2, 3), SearchDescMostProbable(1, 3))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"EOV", "AZ", "IQ"}, alphagrams(resp))
+	resp, err = search(SearchDescLength(2, 3), SearchDescMostProbable(2, 5))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"AZ", "IQ"}, alphagrams(resp))
+	resp, err = search(SearchDescLength(2, 2), SearchDescMostProbable(1, 1))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"AZ"}, alphagrams(resp))
+	resp, err = search(SearchDescLength(2, 3), SearchDescLeastProbable(1, 2))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"IQ", "AZ"}, alphagrams(resp))
+	resp, err = s.Search(context.Background(), WordSearch([]*pb.SearchRequest_SearchParam{
+		SearchDescLexicon("FOO"), SearchDescLength(2, 3), SearchDescMostProbable(1, 2)}, false))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"EOV", "AZ"}, alphagrams(resp))
+
+	_, err = search(SearchDescLength(2, 3), SearchDescMostProbable(0, 2))
+	assert.NotNil(t, err)
+	_, err = search(SearchDescMostProbable(1, 2), SearchDescLength(2, 3))
+	assert.NotNil(t, err)
+}
+
+func TestSearchContradictoryRequest(t *testing.T) {
+	s := &Server{Config: &config.Config{DataPath: makeExpandLexicon(t)}}
+	search := func(req *pb.SearchRequest) twirp.Error {
+		_, err := s.Search(context.Background(), req)
+		assert.NotNil(t, err)
+		return err.(twirp.Error)
+	}
+	terr := search(WordSearch([]*pb.SearchRequest_SearchParam{SearchDescLexicon("FOO"),
+		SearchDescProbLimitPerLength(1, 2)}, false))
+	assert.Equal(t, twirp.InvalidArgument, terr.Code())
+	assert.Equal(t, "searchparams", terr.Meta("argument"))
+
+	terr = search(WordSearch([]*pb.SearchRequest_SearchParam{SearchDescLexicon("FOO"),
+		SearchDescLength(2, 3), SearchDescRandomSample(1, 1), SearchDescRandomSample(2, 1)}, false))
+	assert.Equal(t, twirp.InvalidArgument, terr.Code())
+	assert.Equal(t, "searchparams", terr.Meta("argument"))
+
+	req := WordSearch([]*pb.SearchRequest_SearchParam{SearchDescLexicon("FOO"),
+		SearchDescLength(2, 3)}, false)
+	req.Cursor = "anything"
+	terr = search(req)
+	assert.Equal(t, twirp.InvalidArgument, terr.Code())
+	assert.Equal(t, "page_size", terr.Meta("argument"))
+
+	req.PageSize = 10
+	req.Cursor = "not a cursor"
+	terr = search(req)
+	assert.Equal(t, twirp.InvalidArgument, terr.Code())
+	assert.Equal(t, "cursor", terr.Meta("argument"))
+}
+
+func TestSearchUnknownLexicon(t *testing.T) {
+	s := &Server{Config: &config.Config{DataPath: makeExpandLexicon(t)}}
+	_, err := s.Search(context.Background(), WordSearch([]*pb.SearchRequest_SearchParam{
+		SearchDescLexicon("NOPE"), SearchDescLength(2, 3)}, false))
+	terr := err.(twirp.Error)
+	assert.Equal(t, twirp.InvalidArgument, terr.Code())
+	assert.Equal(t, "0", terr.Meta("param_index"))
+	assert.Equal(t, "stringvalue.value", terr.Meta("field"))
+
+	_, err = s.Search(context.Background(), WordSearch([]*pb.SearchRequest_SearchParam{
+		SearchDescLexicon("FOO"), SearchDescLength(2, 3),
+		SearchDescLexiconDiff("NOPE", pb.SearchRequest_LexiconDiff_NOT_IN_OTHER)}, false))
+	terr = err.(twirp.Error)
+	assert.Equal(t, twirp.InvalidArgument, terr.Code())
+	assert.Equal(t, "searchparams[2].lexicondiff.other_lexicon", terr.Meta("argument"))
+}
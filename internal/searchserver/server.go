@@ -2,16 +2,16 @@ package searchserver
 
 import (
 	"database/sql"
-	"errors"
-	"fmt"
-	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	// sqlite3 driver is used by this server.
 	"github.com/domino14/word_db_server/config"
+	"github.com/domino14/word_db_server/internal/wordstore"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/rs/zerolog/log"
+	"github.com/twitchtv/twirp"
 )
 
 const (
@@ -24,22 +24,99 @@ const (
 // Server implements the WordSearcher service
 type Server struct {
 	Config *config.Config
+	// Recorder, if set, records every search request for later replay.
+	Recorder *Recorder
+	// Snapshots, if set, lets clients pin a lexicon database across
+	// paginated requests.
+	Snapshots *Snapshots
+	// DBs, if set, keeps lexicon databases open between requests.
+	DBs *DBCache
+	// ExpandCache, if set, keeps expanded alphagrams between requests.
+	ExpandCache *ExpandCache
+	// AlphagramIndex, if set, does the simplest searches of the hot lexica
+	// in memory.
+	AlphagramIndex *AlphagramIndex
+}
+
+// searchDB returns the database to search in, and a function to call when
+// done with it. If snapshotID is set, it is the pinned database for that
+// snapshot; otherwise if pin is set a new snapshot is pinned.
+func (s *Server) searchDB(lexName, snapshotID string, pin bool) (*sql.DB, string, func(), error) {
+	if snapshotID == "" && !pin {
+		if s.DBs != nil {
+			db, release, err := s.DBs.Get(lexName)
+			return db, "", release, err
+		}
+		db, err := getDbConnection(s.Config, lexName)
+		if err != nil {
+			return nil, "", nil, err
+		}
+		return db, "", func() { db.Close() }, nil
+	}
+	if s.Snapshots == nil {
+		return nil, "", nil, twirp.NewError(twirp.FailedPrecondition,
+			"snapshots are not enabled on this server")
+	}
+	if snapshotID != "" {
+		db, release, err := s.Snapshots.Get(snapshotID, lexName)
+		return db, snapshotID, release, err
+	}
+	id, db, release, err := s.Snapshots.Pin(lexName)
+	return db, id, release, err
 }
 
 func getDbConnection(cfg *config.Config, lexName string) (*sql.DB, error) {
-	// Try to connect to the db.
-	if lexName == "" {
-		return nil, errors.New("lexicon not specified")
+	store, err := lexiconStore(cfg)
+	if err != nil {
+		return nil, err
 	}
+	return store.Open(lexName)
+}
 
-	lexPath := filepath.Join(cfg.DataPath, "lexica")
+var postgresStores struct {
+	sync.Mutex
+	byDSN map[string]*wordstore.PostgresStore
+}
 
-	fileName := filepath.Join(lexPath, "db", lexName+".db")
-	_, err := os.Stat(fileName)
-	if os.IsNotExist(err) {
-		return nil, fmt.Errorf("the lexicon %v is not supported", lexName)
+// lexiconStore returns the store of the lexicon databases: the SQLite files
+// in the data path, or the Postgres database of the config's WordDBDSN.
+func lexiconStore(cfg *config.Config) (wordstore.Store, error) {
+	if cfg.WordDBDSN == "" {
+		return sqliteStore(cfg), nil
+	}
+	c := &postgresStores
+	c.Lock()
+	defer c.Unlock()
+	if store, ok := c.byDSN[cfg.WordDBDSN]; ok {
+		return store, nil
 	}
-	return sql.Open("sqlite3", fileName)
+	store, err := wordstore.OpenPostgres(cfg.WordDBDSN)
+	if err != nil {
+		return nil, err
+	}
+	if c.byDSN == nil {
+		c.byDSN = map[string]*wordstore.PostgresStore{}
+	}
+	c.byDSN[cfg.WordDBDSN] = store
+	return store, nil
+}
+
+func sqliteStore(cfg *config.Config) *wordstore.SQLiteStore {
+	return &wordstore.SQLiteStore{Dir: filepath.Join(cfg.DataPath, "lexica", "db")}
+}
+
+// lexiconDBPath returns the path of the SQLite database for the lexicon, if
+// it exists. It's for what only works on SQLite files, such as attaching
+// another lexicon's database.
+func lexiconDBPath(cfg *config.Config, lexName string) (string, error) {
+	return sqliteStore(cfg).Path(lexName)
+}
+
+// errNotOnPostgres is the error for what can't be done when the lexica are
+// in Postgres.
+func errNotOnPostgres(what string) error {
+	return twirp.NewError(twirp.FailedPrecondition,
+		what+" is not available when the lexica are in Postgres")
 }
 
 func timeTrack(start time.Time, name string) {
@@ -0,0 +1,158 @@
+package searchserver
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/word_db_server/config"
+)
+
+// MaxPinnedSnapshots is how many snapshots can be pinned at once.
+const MaxPinnedSnapshots = 200
+
+type snapshot struct {
+	lexicon string
+	db      *sql.DB
+	expires time.Time
+	// inUse counts requests that are using db right now; it must not be
+	// closed until they are done.
+	inUse int
+}
+
+// Snapshots pins open database handles so that a client paging through a
+// search sees the same lexicon database on every page, even if the file is
+// replaced by a new version in the meantime. Each pinned handle has exactly
+// one connection, which keeps the old file open after it is renamed over.
+// A snapshot expires if it goes unused for the TTL.
+//
+// Only replacing the file is isolated; writes made in place (such as
+// definition corrections) are still seen.
+type Snapshots struct {
+	mu    sync.Mutex
+	ttl   time.Duration
+	byID  map[string]*snapshot
+	open  func(lexicon string) (*sql.DB, error)
+	now   func() time.Time
+	newID func() string
+}
+
+// NewSnapshots creates a Snapshots for the lexica in the config's data
+// path. It returns nil, which leaves snapshots disabled, if the lexica are
+// in Postgres: a handle there doesn't keep the lexicon it was opened on
+// once it's reloaded.
+func NewSnapshots(cfg *config.Config) *Snapshots {
+	if cfg.WordDBDSN != "" {
+		return nil
+	}
+	return &Snapshots{
+		ttl:  cfg.SnapshotTTL,
+		byID: map[string]*snapshot{},
+		open: func(lexicon string) (*sql.DB, error) {
+			return getDbConnection(cfg, lexicon)
+		},
+		now:   time.Now,
+		newID: randomSnapshotID,
+	}
+}
+
+func randomSnapshotID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+// Pin opens the lexicon and pins it. The returned release function must be
+// called when the caller is done with the handle for this request.
+func (s *Snapshots) Pin(lexicon string) (string, *sql.DB, func(), error) {
+	db, err := s.open(lexicon)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	db.SetMaxOpenConns(1)
+	db.SetMaxIdleConns(1)
+	db.SetConnMaxLifetime(0)
+	db.SetConnMaxIdleTime(0)
+	// Open the connection now, so the file it refers to is fixed.
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return "", nil, nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reap()
+	if len(s.byID) >= MaxPinnedSnapshots {
+		db.Close()
+		return "", nil, nil, twirp.NewError(twirp.ResourceExhausted,
+			"too many pinned snapshots; try again later")
+	}
+	id := s.newID()
+	snap := &snapshot{lexicon: lexicon, db: db, expires: s.now().Add(s.ttl), inUse: 1}
+	s.byID[id] = snap
+	log.Debug().Str("id", id).Str("lexicon", lexicon).Msg("pinned snapshot")
+	return id, db, s.releaser(snap), nil
+}
+
+// Get returns the pinned handle for the snapshot, and extends its life.
+func (s *Snapshots) Get(id, lexicon string) (*sql.DB, func(), error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reap()
+	snap, ok := s.byID[id]
+	if !ok {
+		return nil, nil, twirp.NewError(twirp.FailedPrecondition,
+			"snapshot has expired; start the search again")
+	}
+	if snap.lexicon != lexicon {
+		return nil, nil, twirp.InvalidArgumentError("snapshot_id",
+			fmt.Sprintf("snapshot is for lexicon %v, not %v", snap.lexicon, lexicon))
+	}
+	snap.expires = s.now().Add(s.ttl)
+	snap.inUse++
+	return snap.db, s.releaser(snap), nil
+}
+
+func (s *Snapshots) releaser(snap *snapshot) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			snap.inUse--
+		})
+	}
+}
+
+// reap closes expired snapshots that aren't in use. s.mu must be held.
+func (s *Snapshots) reap() {
+	now := s.now()
+	for id, snap := range s.byID {
+		if snap.inUse == 0 && now.After(snap.expires) {
+			snap.db.Close()
+			delete(s.byID, id)
+			log.Debug().Str("id", id).Msg("released snapshot")
+		}
+	}
+}
+
+// Close closes all the pinned handles.
+func (s *Snapshots) Close() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, snap := range s.byID {
+		snap.db.Close()
+		delete(s.byID, id)
+	}
+}
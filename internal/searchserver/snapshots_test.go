@@ -0,0 +1,79 @@
+package searchserver
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func makeVersionDB(t *testing.T, path, version string) {
+	db, err := sql.Open("sqlite3", path)
+	assert.Nil(t, err)
+	defer db.Close()
+	_, err = db.Exec(`CREATE TABLE v (version varchar(10)); INSERT INTO v VALUES(?)`, version)
+	assert.Nil(t, err)
+}
+
+func dbVersion(t *testing.T, db *sql.DB) string {
+	var v string
+	assert.Nil(t, db.QueryRow(`SELECT version FROM v`).Scan(&v))
+	return v
+}
+
+func TestSnapshotSurvivesHotSwap(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "FOO.db")
+	makeVersionDB(t, path, "old")
+
+	now := time.Unix(1000, 0)
+	s := &Snapshots{
+		ttl:  time.Minute,
+		byID: map[string]*snapshot{},
+		open: func(lexicon string) (*sql.DB, error) {
+			return sql.Open("sqlite3", filepath.Join(dir, lexicon+".db"))
+		},
+		now:   func() time.Time { return now },
+		newID: randomSnapshotID,
+	}
+	defer s.Close()
+
+	id, db, release, err := s.Pin("FOO")
+	assert.Nil(t, err)
+	assert.Equal(t, "old", dbVersion(t, db))
+	release()
+
+	// Swap in a new version of the lexicon.
+	makeVersionDB(t, filepath.Join(dir, "new.db"), "new")
+	assert.Nil(t, os.Rename(filepath.Join(dir, "new.db"), path))
+
+	db, release, err = s.Get(id, "FOO")
+	assert.Nil(t, err)
+	assert.Equal(t, "old", dbVersion(t, db))
+	release()
+
+	fresh, err := s.open("FOO")
+	assert.Nil(t, err)
+	assert.Equal(t, "new", dbVersion(t, fresh))
+	fresh.Close()
+
+	_, _, err = s.Get(id, "BAR")
+	assert.NotNil(t, err)
+
+	// Using the snapshot keeps it alive; leaving it alone doesn't.
+	now = now.Add(50 * time.Second)
+	_, release, err = s.Get(id, "FOO")
+	assert.Nil(t, err)
+	release()
+	now = now.Add(50 * time.Second)
+	_, release, err = s.Get(id, "FOO")
+	assert.Nil(t, err)
+	release()
+	now = now.Add(2 * time.Minute)
+	_, _, err = s.Get(id, "FOO")
+	assert.NotNil(t, err)
+	assert.Empty(t, s.byID)
+}
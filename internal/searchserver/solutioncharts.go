@@ -0,0 +1,113 @@
+package searchserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// innerHookMark marks the side of a word in a solution chart's text that
+// is an inner hook.
+const innerHookMark = "·"
+
+// SolutionCharts implements the SolutionCharts rpc. The alphagrams are
+// expanded like Expand's, so the charts come from the same data.
+func (s *Server) SolutionCharts(ctx context.Context, req *pb.SearchResponse) (
+	*pb.SolutionChartsResponse, error) {
+
+	expanded, err := s.Expand(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.SolutionChartsResponse{
+		Lexicon: expanded.Lexicon,
+		Charts:  make([]*pb.SolutionChart, 0, len(expanded.Alphagrams)),
+	}
+	for _, a := range expanded.Alphagrams {
+		resp.Charts = append(resp.Charts, solutionChart(a))
+	}
+	return resp, nil
+}
+
+// solutionChart returns the solution chart of an expanded alphagram.
+func solutionChart(a *pb.Alphagram) *pb.SolutionChart {
+	chart := &pb.SolutionChart{
+		Alphagram:   a.Alphagram,
+		Length:      a.Length,
+		Probability: a.Probability,
+		Rows:        make([]*pb.SolutionChart_Row, 0, len(a.Words)),
+	}
+	for _, w := range a.Words {
+		chart.Rows = append(chart.Rows, &pb.SolutionChart_Row{
+			Word:           w.Word,
+			FrontHooks:     w.FrontHooks,
+			BackHooks:      w.BackHooks,
+			InnerFrontHook: w.InnerFrontHook,
+			InnerBackHook:  w.InnerBackHook,
+			LexiconSymbols: w.LexiconSymbols,
+			Definition:     w.Definition,
+			Deleted:        w.Deleted,
+		})
+	}
+	chart.Text = renderSolutionChart(chart)
+	return chart
+}
+
+// renderSolutionChart renders a chart as text. The columns are as wide as
+// their widest entries, and the inner hook marks are only given room if
+// some word has one on that side.
+func renderSolutionChart(chart *pb.SolutionChart) string {
+	var innerFront, innerBack bool
+	for _, r := range chart.Rows {
+		innerFront = innerFront || r.InnerFrontHook
+		innerBack = innerBack || r.InnerBackHook
+	}
+	mark := func(column, hook bool) string {
+		switch {
+		case !column:
+			return ""
+		case hook:
+			return innerHookMark
+		}
+		return " "
+	}
+	pad := func(s string, width int) string {
+		return strings.Repeat(" ", width-utf8.RuneCountInString(s))
+	}
+
+	// The words with their inner hook marks and symbols.
+	words := make([]string, len(chart.Rows))
+	var frontWidth, wordWidth, backWidth int
+	for i, r := range chart.Rows {
+		words[i] = mark(innerFront, r.InnerFrontHook) + r.Word +
+			mark(innerBack, r.InnerBackHook) + r.LexiconSymbols
+		frontWidth = max(frontWidth, utf8.RuneCountInString(r.FrontHooks))
+		wordWidth = max(wordWidth, utf8.RuneCountInString(words[i]))
+		backWidth = max(backWidth, utf8.RuneCountInString(r.BackHooks))
+	}
+
+	var sb strings.Builder
+	sb.WriteString(chart.Alphagram)
+	if chart.Probability > 0 {
+		fmt.Fprintf(&sb, "  probability %d", chart.Probability)
+	}
+	sb.WriteString("\n")
+	for i, r := range chart.Rows {
+		var line strings.Builder
+		line.WriteString(pad(r.FrontHooks, frontWidth) + r.FrontHooks + " ")
+		line.WriteString(words[i] + pad(words[i], wordWidth) + " ")
+		line.WriteString(r.BackHooks + pad(r.BackHooks, backWidth))
+		definition := r.Definition
+		if r.Deleted {
+			definition = strings.TrimSpace("[deleted] " + definition)
+		}
+		if definition != "" {
+			line.WriteString("  " + definition)
+		}
+		sb.WriteString(strings.TrimRight(line.String(), " ") + "\n")
+	}
+	return sb.String()
+}
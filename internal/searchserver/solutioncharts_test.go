@@ -0,0 +1,58 @@
+package searchserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/domino14/word_db_server/config"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestRenderSolutionChart(t *testing.T) {
+	chart := &pb.SolutionChart{
+		Alphagram:   "AEINRST",
+		Probability: 1,
+		Rows: []*pb.SolutionChart_Row{
+			{Word: "ANESTRI", Definition: "a bird"},
+			{Word: "RETAINS", FrontHooks: "C", BackHooks: "", LexiconSymbols: "#",
+				Definition: "to keep"},
+			{Word: "STAINER", FrontHooks: "", BackHooks: "S", InnerBackHook: true},
+			{Word: "STEARIN", FrontHooks: "DT", BackHooks: "ES", InnerFrontHook: true,
+				Deleted: true},
+		},
+	}
+	assert.Equal(t, "AEINRST  probability 1\n"+
+		"    ANESTRI       a bird\n"+
+		" C  RETAINS #     to keep\n"+
+		"    STAINER·  S\n"+
+		"DT ·STEARIN   ES  [deleted]\n",
+		renderSolutionChart(chart))
+
+	// Without inner hooks, no room is made for them.
+	assert.Equal(t, "IQ\n QI S  a life force\n", renderSolutionChart(&pb.SolutionChart{
+		Alphagram: "IQ",
+		Rows:      []*pb.SolutionChart_Row{{Word: "QI", BackHooks: "S", Definition: "a life force"}},
+	}))
+}
+
+func TestSolutionCharts(t *testing.T) {
+	s := &Server{Config: &config.Config{DataPath: makeExpandLexicon(t)}}
+	resp, err := s.SolutionCharts(context.Background(), &pb.SearchResponse{
+		Lexicon: "FOO",
+		Alphagrams: []*pb.Alphagram{
+			{Alphagram: "EOV", Words: []*pb.Word{{Word: "EVO"}}},
+			{Alphagram: "IQ", Words: []*pb.Word{{Word: "QI"}}},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "FOO", resp.Lexicon)
+	assert.Equal(t, 2, len(resp.Charts))
+	evo := resp.Charts[0]
+	assert.Equal(t, "EOV", evo.Alphagram)
+	assert.Equal(t, int32(3), evo.Probability)
+	assert.Equal(t, "D", evo.Rows[0].FrontHooks)
+	assert.Equal(t, "evolution", evo.Rows[0].Definition)
+	assert.Equal(t, "EOV  probability 3\nD EVO S  evolution\n", evo.Text)
+}
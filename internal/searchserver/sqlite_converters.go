@@ -3,6 +3,8 @@ package searchserver
 import (
 	"database/sql"
 	"strconv"
+	"strings"
+	"sync"
 )
 
 // This file contains custom-built sqlite converters. We use these instead of
@@ -23,3 +25,90 @@ func toint64(bts sql.RawBytes) int64 {
 	val, _ := strconv.ParseInt(string(bts), 10, 64)
 	return val
 }
+
+// splitSources splits a words.sources value into its flags.
+func splitSources(sources string) []string {
+	if sources == "" {
+		return nil
+	}
+	return strings.Split(sources, ",")
+}
+
+// maxPooledRowBuffer is the size above which a rowScanner's text buffer
+// isn't kept for another query.
+const maxPooledRowBuffer = 64 << 10
+
+var rowScanners = sync.Pool{New: func() any { return &rowScanner{} }}
+
+// A rowScanner holds the buffers that rows are scanned into. They're
+// pooled, so that scanning a query's rows allocates only what's returned.
+type rowScanner struct {
+	raw  []sql.RawBytes
+	args []any
+	buf  []byte
+}
+
+// getRowScanner returns a rowScanner for rows of the given number of
+// columns. It should be released once the rows are scanned.
+func getRowScanner(columns int) *rowScanner {
+	rs := rowScanners.Get().(*rowScanner)
+	if len(rs.raw) != columns {
+		rs.raw = make([]sql.RawBytes, columns)
+		rs.args = make([]any, columns)
+		for i := range rs.raw {
+			rs.args[i] = &rs.raw[i]
+		}
+	}
+	return rs
+}
+
+func (rs *rowScanner) release() {
+	clear(rs.raw)
+	if cap(rs.buf) > maxPooledRowBuffer {
+		rs.buf = nil
+	}
+	rowScanners.Put(rs)
+}
+
+// text sets each of dst to the column of the same index in cols, as parts
+// of one string, so that a row's text takes one allocation rather than one
+// per column.
+func (rs *rowScanner) text(cols []int, dst []*string) {
+	rs.buf = rs.buf[:0]
+	for _, c := range cols {
+		rs.buf = append(rs.buf, rs.raw[c]...)
+	}
+	s := string(rs.buf)
+	start := 0
+	for i, c := range cols {
+		end := start + len(rs.raw[c])
+		*dst[i] = s[start:end]
+		start = end
+	}
+}
+
+// maxSlab is the size a slab stops growing at.
+const maxSlab = 1024
+
+// A slab hands out pointers to values allocated many at a time, starting
+// with the number expected and growing if there are more.
+type slab[T any] struct {
+	free []T
+	size int
+}
+
+func newSlab[T any](size int) *slab[T] {
+	return &slab[T]{size: max(size, 1)}
+}
+
+func (s *slab[T]) next() *T {
+	if len(s.free) == 0 {
+		s.free = make([]T, s.size)
+		if s.size < maxSlab {
+			s.size = min(2*s.size, maxSlab)
+		}
+	}
+	p := &s.free[0]
+	s.free = s.free[1:]
+	return p
+}
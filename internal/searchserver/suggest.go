@@ -0,0 +1,269 @@
+package searchserver
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/rs/zerolog/log"
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/word_db_server/internal/common"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+const (
+	maxSuggestDistance  = 2
+	defaultSuggestLimit = 10
+	maxSuggestLimit     = 100
+	// suggestPrefixLength is how many tiles at the start of a word go into
+	// its deletion neighborhood. Long words would otherwise have hundreds
+	// of neighbors each; candidates are still checked against the whole
+	// word.
+	suggestPrefixLength = 7
+)
+
+// A suggestIndex finds the words of a lexicon that are within a few edits
+// of a given word. It's a symmetric delete index: every string made by
+// deleting up to maxSuggestDistance tiles from a word's prefix points back
+// to the word, so the words near an input are found among the words that
+// share a deletion with it, without comparing the input to every word.
+type suggestIndex struct {
+	stamp       string
+	words       []string
+	mls         []tilemapping.MachineWord
+	playability []int32
+	// byTiles maps a word's tiles to its index.
+	byTiles map[string]int32
+	deletes map[string][]int32
+}
+
+// suggestIndexes are the indexes of the lexica that have been asked for
+// suggestions. The zero value is ready to use.
+type suggestIndexes struct {
+	mu        sync.Mutex
+	byLexicon map[string]*suggestIndex
+}
+
+// tileKey is a machine word as a string, so that it can be a map key.
+func tileKey(mw tilemapping.MachineWord) string {
+	b := make([]byte, len(mw))
+	for i, ml := range mw {
+		b[i] = byte(ml)
+	}
+	return string(b)
+}
+
+// deletionNeighborhood returns the strings made by deleting up to distance
+// tiles from the prefix of the word, including the prefix itself.
+func deletionNeighborhood(mw tilemapping.MachineWord, distance int) []string {
+	if len(mw) > suggestPrefixLength {
+		mw = mw[:suggestPrefixLength]
+	}
+	key := tileKey(mw)
+	seen := map[string]bool{key: true}
+	neighborhood := []string{key}
+	frontier := []string{key}
+	for d := 0; d < distance; d++ {
+		next := []string{}
+		for _, s := range frontier {
+			for i := 0; i < len(s); i++ {
+				del := s[:i] + s[i+1:]
+				if !seen[del] {
+					seen[del] = true
+					next = append(next, del)
+				}
+			}
+		}
+		neighborhood = append(neighborhood, next...)
+		frontier = next
+	}
+	return neighborhood
+}
+
+// editDistance is the optimal string alignment distance between two words:
+// the number of insertions, deletions, substitutions and swaps of adjacent
+// tiles it takes to turn one into the other.
+func editDistance(a, b tilemapping.MachineWord) int {
+	// Three rows of the usual table: two back, one back and this one.
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(b)]
+}
+
+// buildSuggestIndex reads every word of the lexicon into a new index.
+func buildSuggestIndex(ctx context.Context, db *sql.DB, dist *tilemapping.LetterDistribution) (
+	*suggestIndex, error) {
+
+	rows, err := db.QueryContext(ctx, `
+	SELECT words.word, alphagrams.playability FROM words
+	JOIN alphagrams ON words.alphagram = alphagrams.alphagram`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	idx := &suggestIndex{byTiles: map[string]int32{}, deletes: map[string][]int32{}}
+	for rows.Next() {
+		var word string
+		var playability sql.NullInt32
+		if err := rows.Scan(&word, &playability); err != nil {
+			return nil, err
+		}
+		mw, err := tilemapping.ToMachineLetters(word, dist.TileMapping())
+		if err != nil {
+			log.Warn().Err(err).Str("word", word).Msg("not indexing word for suggestions")
+			continue
+		}
+		i := int32(len(idx.words))
+		idx.words = append(idx.words, word)
+		idx.mls = append(idx.mls, mw)
+		idx.playability = append(idx.playability, playability.Int32)
+		idx.byTiles[tileKey(mw)] = i
+		for _, del := range deletionNeighborhood(mw, maxSuggestDistance) {
+			idx.deletes[del] = append(idx.deletes[del], i)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	log.Info().Int("words", len(idx.words)).Int("deletes", len(idx.deletes)).
+		Msg("built suggestion index")
+	return idx, nil
+}
+
+// suggest returns the words within maxDistance edits of the given word,
+// closest first and then most playable first, leaving out the word itself.
+func (idx *suggestIndex) suggest(mw tilemapping.MachineWord, maxDistance, limit int) (
+	bool, []*pb.SuggestResponse_Suggestion) {
+
+	_, valid := idx.byTiles[tileKey(mw)]
+	checked := map[int32]bool{}
+	suggestions := []*pb.SuggestResponse_Suggestion{}
+	for _, del := range deletionNeighborhood(mw, maxDistance) {
+		for _, i := range idx.deletes[del] {
+			if checked[i] {
+				continue
+			}
+			checked[i] = true
+			cand := idx.mls[i]
+			if len(cand)-len(mw) > maxDistance || len(mw)-len(cand) > maxDistance {
+				continue
+			}
+			d := editDistance(mw, cand)
+			if d == 0 || d > maxDistance {
+				continue
+			}
+			suggestions = append(suggestions, &pb.SuggestResponse_Suggestion{
+				Word: idx.words[i], Distance: int32(d), Playability: idx.playability[i]})
+		}
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		a, b := suggestions[i], suggestions[j]
+		if a.Distance != b.Distance {
+			return a.Distance < b.Distance
+		}
+		if a.Playability != b.Playability {
+			return a.Playability > b.Playability
+		}
+		return a.Word < b.Word
+	})
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return valid, suggestions
+}
+
+// suggestIndex returns the lexicon's suggestion index, building it if it
+// hasn't been built since the lexicon's database last changed.
+func (s *WordSearchServer) suggestIndex(ctx context.Context, lexicon string,
+	dist *tilemapping.LetterDistribution) (*suggestIndex, error) {
+
+	store, err := lexiconStore(s.Config)
+	if err != nil {
+		return nil, err
+	}
+	stamp, err := store.Stamp(ctx, lexicon)
+	if err != nil {
+		return nil, err
+	}
+	c := &s.suggestIndexes
+	// Building holds the lock; it's slow, but only needs to happen once.
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if idx, ok := c.byLexicon[lexicon]; ok && idx.stamp == stamp {
+		return idx, nil
+	}
+	db, err := store.Open(lexicon)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	idx, err := buildSuggestIndex(ctx, db, dist)
+	if err != nil {
+		return nil, err
+	}
+	idx.stamp = stamp
+	if c.byLexicon == nil {
+		c.byLexicon = map[string]*suggestIndex{}
+	}
+	c.byLexicon[lexicon] = idx
+	return idx, nil
+}
+
+// Suggest returns "did you mean" suggestions for a word, from the words of
+// the lexicon that are a few edits away from it.
+func (s *WordSearchServer) Suggest(ctx context.Context, req *pb.SuggestRequest) (*pb.SuggestResponse, error) {
+	if req.Lexicon == "" {
+		return nil, twirp.RequiredArgumentError("lexicon")
+	}
+	maxDistance := int(req.MaxDistance)
+	if maxDistance == 0 {
+		maxDistance = maxSuggestDistance
+	}
+	if maxDistance < 0 || maxDistance > maxSuggestDistance {
+		return nil, twirp.InvalidArgumentError("max_distance", "must be between 1 and 2")
+	}
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultSuggestLimit
+	}
+	if limit < 0 || limit > maxSuggestLimit {
+		return nil, twirp.InvalidArgumentError("limit", "must be between 1 and 100")
+	}
+	dist, err := common.LetterDistribution(map[string]any{"data-path": s.Config.DataPath}, req.Lexicon)
+	if err != nil {
+		return nil, twirp.InvalidArgumentError("lexicon", err.Error())
+	}
+	mw, err := tilemapping.ToMachineLetters(strings.ToUpper(strings.TrimSpace(req.Word)),
+		dist.TileMapping())
+	if err != nil || len(mw) == 0 {
+		return nil, twirp.InvalidArgumentError("word", "must be letters of the lexicon")
+	}
+	idx, err := s.suggestIndex(ctx, req.Lexicon, dist)
+	if err != nil {
+		return nil, err
+	}
+	valid, suggestions := idx.suggest(mw, maxDistance, limit)
+	return &pb.SuggestResponse{Valid: valid, Suggestions: suggestions}, nil
+}
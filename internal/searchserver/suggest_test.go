@@ -0,0 +1,98 @@
+package searchserver
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/stretchr/testify/assert"
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/word_db_server/config"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestEditDistance(t *testing.T) {
+	mw := func(s string) tilemapping.MachineWord {
+		m := make(tilemapping.MachineWord, len(s))
+		for i := range s {
+			m[i] = tilemapping.MachineLetter(s[i] - 'A' + 1)
+		}
+		return m
+	}
+	for _, c := range []struct {
+		a, b string
+		d    int
+	}{
+		{"QI", "QI", 0},
+		{"QI", "IQ", 1},
+		{"QAT", "QATS", 1},
+		{"QUIXOTIC", "QIXOTIC", 1},
+		{"RETAINS", "RETIANS", 1},
+		{"CAT", "DOG", 3},
+		{"", "ZA", 2},
+	} {
+		assert.Equal(t, c.d, editDistance(mw(c.a), mw(c.b)), c.a+" "+c.b)
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	ctx := context.Background()
+	dataPath := makeExpandLexicon(t)
+	dbDir := filepath.Join(dataPath, "lexica", "db")
+	assert.Nil(t, os.Rename(filepath.Join(dbDir, "FOO.db"), filepath.Join(dbDir, "NWL99.db")))
+	assert.Nil(t, os.MkdirAll(filepath.Join(dataPath, "letterdistributions"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(dataPath, "letterdistributions", "english"),
+		[]byte("?,2,0,0\nA,9,1,1\nE,12,1,1\nI,9,1,1\nO,8,1,1\nQ,1,10,0\nV,2,4,0\nZ,1,10,0\n"), 0644))
+	db, err := sql.Open("sqlite3", filepath.Join(dbDir, "NWL99.db"))
+	assert.Nil(t, err)
+	_, err = db.Exec(`UPDATE alphagrams SET playability = 5 WHERE alphagram = 'AZ';
+		INSERT INTO alphagrams (alphagram, playability) VALUES ('IO', 9);
+		INSERT INTO words (word, alphagram) VALUES ('IO', 'IO');`)
+	assert.Nil(t, err)
+	db.Close()
+	s := &WordSearchServer{Config: &config.Config{DataPath: dataPath}}
+
+	resp, err := s.Suggest(ctx, &pb.SuggestRequest{Lexicon: "NWL99", Word: "zi"})
+	assert.Nil(t, err)
+	assert.False(t, resp.Valid)
+	// ZA and QI are one edit away, and ZA is more playable. IO is more
+	// playable still, but two edits away.
+	words := []string{}
+	distances := []int32{}
+	for _, sg := range resp.Suggestions {
+		words = append(words, sg.Word)
+		distances = append(distances, sg.Distance)
+	}
+	assert.Equal(t, []string{"ZA", "QI", "IO"}, words)
+	assert.Equal(t, []int32{1, 1, 2}, distances)
+
+	resp, err = s.Suggest(ctx, &pb.SuggestRequest{Lexicon: "NWL99", Word: "EVO"})
+	assert.Nil(t, err)
+	assert.True(t, resp.Valid)
+	assert.Equal(t, 1, len(resp.Suggestions))
+	assert.Equal(t, "IO", resp.Suggestions[0].Word)
+
+	// EVO and IO are both two edits away.
+	resp, err = s.Suggest(ctx, &pb.SuggestRequest{Lexicon: "NWL99", Word: "VOE", Limit: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(resp.Suggestions))
+	assert.Equal(t, "IO", resp.Suggestions[0].Word)
+	assert.Equal(t, int32(2), resp.Suggestions[0].Distance)
+	resp, err = s.Suggest(ctx, &pb.SuggestRequest{Lexicon: "NWL99", Word: "VOE", Limit: 5})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(resp.Suggestions))
+	assert.Equal(t, "EVO", resp.Suggestions[1].Word)
+
+	resp, err = s.Suggest(ctx, &pb.SuggestRequest{Lexicon: "NWL99", Word: "VOE", MaxDistance: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(resp.Suggestions))
+
+	_, err = s.Suggest(ctx, &pb.SuggestRequest{Lexicon: "NWL99", Word: "QUA"})
+	assert.Equal(t, twirp.InvalidArgument, err.(twirp.Error).Code())
+	_, err = s.Suggest(ctx, &pb.SuggestRequest{Lexicon: "NWL99", Word: "QI", MaxDistance: 3})
+	assert.Equal(t, twirp.InvalidArgument, err.(twirp.Error).Code())
+}
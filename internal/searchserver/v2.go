@@ -0,0 +1,341 @@
+package searchserver
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/word_db_server/config"
+	"github.com/domino14/word_db_server/internal/common"
+	"github.com/domino14/word_db_server/internal/querygen"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+	pbv2 "github.com/domino14/word_db_server/rpc/wordsearcher/v2"
+)
+
+// V2PathPrefix is the path prefix that version 2 of the services is served
+// under, in place of Twirp's /twirp.
+const V2PathPrefix = "/v2"
+
+// V2Server implements version 2 of the QuestionSearcher service by turning
+// its requests into version 1 requests for Searcher, and the responses
+// back. Searcher is the same one that serves version 1, so the two can't
+// give different results.
+type V2Server struct {
+	Config   *config.Config
+	Searcher pb.QuestionSearcher
+}
+
+// Search implements the version 2 Search.
+func (s *V2Server) Search(ctx context.Context, req *pbv2.SearchRequest) (*pbv2.SearchResponse, error) {
+	lexicon, err := s.lexicon(req.Lexicon, "lexicon")
+	if err != nil {
+		return nil, err
+	}
+	params := []*pb.SearchRequest_SearchParam{SearchDescLexicon(lexicon.Name())}
+	for i, c := range req.Conditions {
+		p, err := s.v1Condition(c)
+		if err != nil {
+			return nil, twirp.InvalidArgumentError(fmt.Sprintf("conditions[%d]", i), err.Error())
+		}
+		params = append(params, p)
+	}
+	v1 := &pb.SearchRequest{
+		Searchparams: params,
+		Expand:       req.Expand,
+		SortOrder:    pb.SearchRequest_SortOrder(req.SortOrder),
+		PinSnapshot:  req.PinSnapshot,
+		SnapshotId:   req.SnapshotId,
+		PageSize:     req.PageSize,
+		Cursor:       req.Cursor,
+	}
+	for _, f := range req.PartialExpand {
+		v1.PartialExpand = append(v1.PartialExpand, pb.SearchRequest_WordField(f))
+	}
+	if req.SingleAnagram != nil {
+		v1.SingleAnagram = &pb.SearchRequest_SingleAnagram{
+			Seed: req.SingleAnagram.Seed, Shuffle: req.SingleAnagram.Shuffle}
+	}
+	resp, err := s.Searcher.Search(ctx, v1)
+	if err != nil {
+		return nil, err
+	}
+	return &pbv2.SearchResponse{
+		Alphagrams: v2Alphagrams(resp.Alphagrams, nil),
+		Lexicon:    lexicon,
+		SnapshotId: resp.SnapshotId,
+		NextCursor: resp.NextCursor,
+		Truncated:  resp.Truncated,
+	}, nil
+}
+
+// Expand implements the version 2 Expand.
+func (s *V2Server) Expand(ctx context.Context, req *pbv2.ExpandRequest) (*pbv2.SearchResponse, error) {
+	lexicon, err := s.lexicon(req.Lexicon, "lexicon")
+	if err != nil {
+		return nil, err
+	}
+	v1 := &pb.SearchResponse{
+		Lexicon:          lexicon.Name(),
+		SnapshotId:       req.SnapshotId,
+		ExpandIndexes:    req.ExpandIndexes,
+		ExpandAlphagrams: req.ExpandAlphagrams,
+	}
+	// The alphagrams' own lexica are returned as they were sent.
+	own := map[string]*pbv2.Lexicon{}
+	for i, a := range req.Alphagrams {
+		v1a := v1Alphagram(a)
+		if a.Lexicon != nil {
+			l, err := s.lexicon(a.Lexicon, fmt.Sprintf("alphagrams[%d].lexicon", i))
+			if err != nil {
+				return nil, err
+			}
+			v1a.Lexicon = l.Name()
+			own[v1a.Lexicon] = a.Lexicon
+		}
+		v1.Alphagrams = append(v1.Alphagrams, v1a)
+	}
+	selected, err := selectedForExpansion(v1)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.Searcher.Expand(ctx, v1)
+	if err != nil {
+		return nil, err
+	}
+	alphagrams := v2Alphagrams(resp.Alphagrams, own)
+	// Version 1 leaves expandedRepr as it was sent.
+	if selected == nil {
+		for _, a := range alphagrams {
+			a.Expanded = true
+		}
+	}
+	for _, i := range selected {
+		alphagrams[i].Expanded = true
+	}
+	return &pbv2.SearchResponse{
+		Alphagrams: alphagrams,
+		Lexicon:    lexicon,
+		SnapshotId: resp.SnapshotId,
+	}, nil
+}
+
+// lexicon checks a requested lexicon, and returns it with its letter
+// distribution filled in. field names it in errors.
+func (s *V2Server) lexicon(l *pbv2.Lexicon, field string) (*pbv2.Lexicon, error) {
+	if l.GetFamily() == "" {
+		return nil, twirp.RequiredArgumentError(field + ".family")
+	}
+	dist, err := common.LetterDistribution(map[string]any{"data-path": s.Config.DataPath}, l.Name())
+	if err != nil {
+		// Let the search fail on the lexicon itself.
+		return l, nil
+	}
+	if l.LetterDistribution != "" && !strings.EqualFold(l.LetterDistribution, dist.Name) {
+		return nil, twirp.InvalidArgumentError(field+".letter_distribution",
+			fmt.Sprintf("%s uses the %s letter distribution", l.Name(), dist.Name))
+	}
+	return &pbv2.Lexicon{Family: l.Family, Version: l.Version, LetterDistribution: dist.Name}, nil
+}
+
+// v1Condition returns the version 1 search param of a condition, which has
+// the same number. The condition's value must be the one that the version
+// 1 param takes.
+func (s *V2Server) v1Condition(c *pbv2.Condition) (*pb.SearchRequest_SearchParam, error) {
+	cond := pb.SearchRequest_Condition(c.Type)
+	info := querygen.Condition(cond)
+	if c.Type == pbv2.ConditionType_CONDITION_TYPE_UNSPECIFIED || info == nil {
+		return nil, fmt.Errorf("unknown condition type %v", c.Type)
+	}
+	p := &pb.SearchRequest_SearchParam{Condition: cond}
+	missing := fmt.Errorf("%v takes a %s", c.Type, v2ValueNames[info.Param])
+	switch info.Param {
+	case "":
+	case "minmax":
+		r := c.GetRange()
+		if r == nil {
+			return nil, missing
+		}
+		if r.Min < math.MinInt32 || r.Max > math.MaxInt32 {
+			return nil, fmt.Errorf("%v range is out of bounds", c.Type)
+		}
+		p.Conditionparam = minMaxParam(int(r.Min), int(r.Max))
+	case "minmax64":
+		r := c.GetRange()
+		if r == nil {
+			return nil, missing
+		}
+		p.Conditionparam = &pb.SearchRequest_SearchParam_Minmax64{
+			Minmax64: &pb.SearchRequest_MinMax64{Min: r.Min, Max: r.Max}}
+	case "stringvalue":
+		v, ok := c.Value.(*pbv2.Condition_Text)
+		if !ok {
+			return nil, missing
+		}
+		p.Conditionparam = stringParam(v.Text)
+	case "stringarray":
+		if c.GetStrings() == nil {
+			return nil, missing
+		}
+		p.Conditionparam = stringArrayParam(c.GetStrings().Values)
+	case "numberarray":
+		if c.GetNumbers() == nil {
+			return nil, missing
+		}
+		p.Conditionparam = intArrayParam(c.GetNumbers().Values)
+	case "numbervalue":
+		switch v := c.Value.(type) {
+		case *pbv2.Condition_Number:
+			if info.Enum != nil {
+				return nil, missing
+			}
+			p.Conditionparam = numberParam(int(v.Number))
+		case *pbv2.Condition_NotInLexicon:
+			if info.Enum == nil {
+				return nil, missing
+			}
+			p.Conditionparam = numberParam(int(v.NotInLexicon))
+		default:
+			return nil, missing
+		}
+	case "randomsample":
+		rs := c.GetRandomSample()
+		if rs == nil {
+			return nil, missing
+		}
+		p.Conditionparam = &pb.SearchRequest_SearchParam_Randomsample{
+			Randomsample: &pb.SearchRequest_RandomSample{Count: rs.Count, Seed: rs.Seed}}
+	case "lexicondiff":
+		d := c.GetLexiconDiff()
+		if d == nil {
+			return nil, missing
+		}
+		other, err := s.lexicon(d.OtherLexicon, "other_lexicon")
+		if err != nil {
+			return nil, err
+		}
+		p.Conditionparam = &pb.SearchRequest_SearchParam_Lexicondiff{
+			Lexicondiff: &pb.SearchRequest_LexiconDiff{
+				OtherLexicon: other.Name(), Mode: pb.SearchRequest_LexiconDiff_Mode(d.Mode)}}
+	case "combinator":
+		comb := c.GetCombinator()
+		if comb == nil {
+			return nil, missing
+		}
+		v1 := &pb.SearchRequest_Combinator{Op: pb.SearchRequest_Combinator_Op(comb.Op)}
+		for _, sub := range comb.Conditions {
+			sp, err := s.v1Condition(sub)
+			if err != nil {
+				return nil, err
+			}
+			v1.Params = append(v1.Params, sp)
+		}
+		p.Conditionparam = &pb.SearchRequest_SearchParam_Combinator{Combinator: v1}
+	case "lengthprobabilities":
+		lps := c.GetLengthProbabilities()
+		if lps == nil {
+			return nil, missing
+		}
+		v1 := &pb.SearchRequest_LengthProbabilityList{}
+		for _, lp := range lps.Values {
+			v1.Values = append(v1.Values, &pb.SearchRequest_LengthProbability{
+				Length: lp.Length, Probability: lp.Probability})
+		}
+		p.Conditionparam = &pb.SearchRequest_SearchParam_Lengthprobabilities{Lengthprobabilities: v1}
+	case "build":
+		b := c.GetBuild()
+		if b == nil {
+			return nil, missing
+		}
+		p.Conditionparam = &pb.SearchRequest_SearchParam_Build{Build: &pb.SearchRequest_Build{
+			Letters: b.Letters, MinLength: b.MinLength, MaxLength: b.MaxLength}}
+	default:
+		return nil, fmt.Errorf("%v can't be searched for in version 2", c.Type)
+	}
+	return p, nil
+}
+
+// v2ValueNames are the version 2 condition values that take the place of
+// each version 1 param.
+var v2ValueNames = map[string]string{
+	"minmax":              "range",
+	"minmax64":            "range",
+	"stringvalue":         "text",
+	"stringarray":         "strings",
+	"numberarray":         "numbers",
+	"numbervalue":         "number or not_in_lexicon",
+	"randomsample":        "random_sample",
+	"lexicondiff":         "lexicon_diff",
+	"combinator":          "combinator",
+	"lengthprobabilities": "length_probabilities",
+	"build":               "build",
+}
+
+func v1Alphagram(a *pbv2.Alphagram) *pb.Alphagram {
+	v1 := &pb.Alphagram{
+		Alphagram:        a.Alphagram,
+		ExpandedRepr:     a.Expanded,
+		Length:           a.Length,
+		Probability:      a.Probability,
+		Combinations:     a.Combinations,
+		Difficulty:       a.Difficulty,
+		DisplayAlphagram: a.DisplayAlphagram,
+		Playability:      a.Playability,
+		VowelProbability: a.VowelProbability,
+		Deleted:          a.Deleted,
+	}
+	for _, w := range a.Words {
+		v1.Words = append(v1.Words, &pb.Word{
+			Word:           w.Word,
+			Alphagram:      w.Alphagram,
+			Definition:     w.Definition,
+			FrontHooks:     w.FrontHooks,
+			BackHooks:      w.BackHooks,
+			LexiconSymbols: w.LexiconSymbols,
+			InnerFrontHook: w.InnerFrontHook,
+			InnerBackHook:  w.InnerBackHook,
+			Deleted:        w.Deleted,
+			Sources:        w.Sources,
+		})
+	}
+	return v1
+}
+
+// v2Alphagrams converts alphagrams to version 2. lexica are the version 2
+// lexica of the alphagrams' own lexica, by name.
+func v2Alphagrams(alphs []*pb.Alphagram, lexica map[string]*pbv2.Lexicon) []*pbv2.Alphagram {
+	v2 := make([]*pbv2.Alphagram, 0, len(alphs))
+	for _, a := range alphs {
+		v2a := &pbv2.Alphagram{
+			Alphagram:        a.Alphagram,
+			Expanded:         a.ExpandedRepr,
+			Length:           a.Length,
+			Probability:      a.Probability,
+			Combinations:     a.Combinations,
+			Difficulty:       a.Difficulty,
+			DisplayAlphagram: a.DisplayAlphagram,
+			Playability:      a.Playability,
+			VowelProbability: a.VowelProbability,
+			Deleted:          a.Deleted,
+			Lexicon:          lexica[a.Lexicon],
+		}
+		for _, w := range a.Words {
+			v2a.Words = append(v2a.Words, &pbv2.Word{
+				Word:           w.Word,
+				Alphagram:      w.Alphagram,
+				Definition:     w.Definition,
+				FrontHooks:     w.FrontHooks,
+				BackHooks:      w.BackHooks,
+				LexiconSymbols: w.LexiconSymbols,
+				InnerFrontHook: w.InnerFrontHook,
+				InnerBackHook:  w.InnerBackHook,
+				Deleted:        w.Deleted,
+				Sources:        w.Sources,
+			})
+		}
+		v2 = append(v2, v2a)
+	}
+	return v2
+}
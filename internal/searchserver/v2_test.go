@@ -0,0 +1,97 @@
+package searchserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/twitchtv/twirp"
+
+	"github.com/domino14/word_db_server/config"
+	pbv2 "github.com/domino14/word_db_server/rpc/wordsearcher/v2"
+)
+
+func lengthCondition(min, max int64) *pbv2.Condition {
+	return &pbv2.Condition{Type: pbv2.ConditionType_CONDITION_TYPE_LENGTH,
+		Value: &pbv2.Condition_Range{Range: &pbv2.Range{Min: min, Max: max}}}
+}
+
+func TestV2Search(t *testing.T) {
+	cfg := &config.Config{DataPath: makeExpandLexicon(t)}
+	s := &V2Server{Config: cfg, Searcher: &Server{Config: cfg}}
+	foo := &pbv2.Lexicon{Family: "FOO"}
+
+	resp, err := s.Search(context.Background(), &pbv2.SearchRequest{
+		Lexicon:    foo,
+		Conditions: []*pbv2.Condition{lengthCondition(2, 3)},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "FOO", resp.Lexicon.Family)
+	assert.Equal(t, 3, len(resp.Alphagrams))
+	assert.Equal(t, "IQ", resp.Alphagrams[0].Alphagram)
+	assert.Equal(t, "QI", resp.Alphagrams[0].Words[0].Word)
+	assert.False(t, resp.Alphagrams[0].Expanded)
+
+	// Conditions can be combined, and take the values their types say.
+	resp, err = s.Search(context.Background(), &pbv2.SearchRequest{
+		Lexicon: foo,
+		Expand:  true,
+		Conditions: []*pbv2.Condition{lengthCondition(2, 3), {
+			Type: pbv2.ConditionType_CONDITION_TYPE_COMBINATOR,
+			Value: &pbv2.Condition_Combinator{Combinator: &pbv2.Combinator{
+				Op: pbv2.Combinator_OP_OR,
+				Conditions: []*pbv2.Condition{lengthCondition(3, 3), {
+					Type:  pbv2.ConditionType_CONDITION_TYPE_ALPHAGRAM_LIST,
+					Value: &pbv2.Condition_Strings{Strings: &pbv2.StringList{Values: []string{"AZ"}}},
+				}},
+			}},
+		}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(resp.Alphagrams))
+	assert.Equal(t, "pizza", resp.Alphagrams[0].Words[0].Definition)
+	assert.Equal(t, []string{"NZ"}, resp.Alphagrams[1].Words[0].Sources)
+
+	invalid := func(conds ...*pbv2.Condition) bool {
+		_, err := s.Search(context.Background(), &pbv2.SearchRequest{Lexicon: foo, Conditions: conds})
+		terr, ok := err.(twirp.Error)
+		return ok && terr.Code() == twirp.InvalidArgument
+	}
+	assert.True(t, invalid(&pbv2.Condition{Type: pbv2.ConditionType_CONDITION_TYPE_LENGTH,
+		Value: &pbv2.Condition_Text{Text: "2"}}))
+	assert.True(t, invalid(&pbv2.Condition{}))
+	assert.True(t, invalid(lengthCondition(2, 1<<40)))
+	assert.True(t, invalid(&pbv2.Condition{Type: pbv2.ConditionType_CONDITION_TYPE_NOT_IN_LEXICON,
+		Value: &pbv2.Condition_Number{Number: 1}}))
+
+	_, err = s.Search(context.Background(), &pbv2.SearchRequest{})
+	assert.NotNil(t, err)
+}
+
+func TestV2Expand(t *testing.T) {
+	cfg := &config.Config{DataPath: makeExpandLexicon(t)}
+	s := &V2Server{Config: cfg, Searcher: &Server{Config: cfg}}
+	foo := &pbv2.Lexicon{Family: "FOO"}
+
+	resp, err := s.Expand(context.Background(), &pbv2.ExpandRequest{
+		Lexicon: foo,
+		Alphagrams: []*pbv2.Alphagram{
+			{Alphagram: "IQ", Words: []*pbv2.Word{{Word: "QI"}}},
+			{Alphagram: "EOV", Words: []*pbv2.Word{{Word: "EVO"}}, Lexicon: foo},
+		},
+		ExpandIndexes: []int32{1},
+	})
+	assert.Nil(t, err)
+	assert.False(t, resp.Alphagrams[0].Expanded)
+	assert.True(t, resp.Alphagrams[1].Expanded)
+	assert.Equal(t, "evolution", resp.Alphagrams[1].Words[0].Definition)
+	assert.Equal(t, "FOO", resp.Alphagrams[1].Lexicon.Family)
+	assert.Nil(t, resp.Alphagrams[0].Lexicon)
+}
+
+func TestLexiconName(t *testing.T) {
+	assert.Equal(t, "NWL23", (&pbv2.Lexicon{Family: "NWL", Version: "23"}).Name())
+	assert.Equal(t, "VOCAB", (&pbv2.Lexicon{Family: "VOCAB"}).Name())
+	var none *pbv2.Lexicon
+	assert.Equal(t, "", none.Name())
+}
@@ -0,0 +1,164 @@
+package searchserver
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/domino14/word_db_server/config"
+)
+
+// ValidityPrefix is the path the validity exports are served under.
+const ValidityPrefix = "/validity/"
+
+// ValidityExport is a lexicon's validity export, as it's listed.
+type ValidityExport struct {
+	Lexicon string `json:"lexicon"`
+	// Version is a hash of the export's contents, and its ETag.
+	Version string `json:"version"`
+	Size    int64  `json:"size"`
+}
+
+// validityVersion is the version of an export file, which is only
+// rehashed when the file changes.
+type validityVersion struct {
+	modTime time.Time
+	size    int64
+	version string
+}
+
+type validityExports struct {
+	dir string
+
+	mu       sync.Mutex
+	versions map[string]validityVersion
+}
+
+// open opens the lexicon's export, and returns it with its version.
+func (v *validityExports) open(lexicon string) (*os.File, os.FileInfo, string, error) {
+	f, err := os.Open(filepath.Join(v.dir, lexicon+".kwg"))
+	if err != nil {
+		return nil, nil, "", err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, nil, "", err
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	cached, ok := v.versions[lexicon]
+	if ok && cached.modTime.Equal(fi.ModTime()) && cached.size == fi.Size() {
+		return f, fi, cached.version, nil
+	}
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		f.Close()
+		return nil, nil, "", err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		f.Close()
+		return nil, nil, "", err
+	}
+	version := hex.EncodeToString(h.Sum(nil))[:16]
+	v.versions[lexicon] = validityVersion{fi.ModTime(), fi.Size(), version}
+	return f, fi, version, nil
+}
+
+// list returns the exports there are, by lexicon.
+func (v *validityExports) list() ([]ValidityExport, error) {
+	entries, err := os.ReadDir(v.dir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	exports := []ValidityExport{}
+	for _, e := range entries {
+		lexicon, ok := strings.CutSuffix(e.Name(), ".kwg")
+		if !ok || e.IsDir() {
+			continue
+		}
+		f, fi, version, err := v.open(lexicon)
+		if err != nil {
+			return nil, err
+		}
+		f.Close()
+		exports = append(exports, ValidityExport{lexicon, version, fi.Size()})
+	}
+	slices.SortFunc(exports, func(a, b ValidityExport) int {
+		return strings.Compare(a.Lexicon, b.Lexicon)
+	})
+	return exports, nil
+}
+
+// ValidityHandler serves the validity exports that dbmaker export-validity
+// writes, from lexica/validity in the data path: minimized DAWGs of the
+// lexica's words, in KWG form, for clients to check words offline.
+//
+// GET /validity/ lists them with their versions, and GET /validity/NWL20
+// sends one. Each is sent with its version as its ETag, so clients can
+// check for a new one with If-None-Match. A request for a given version,
+// with ?version=, can be cached for good, and fails with 404 once the
+// export has changed.
+func ValidityHandler(cfg *config.Config) http.Handler {
+	v := &validityExports{
+		dir:      filepath.Join(cfg.DataPath, "lexica", "validity"),
+		versions: map[string]validityVersion{},
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, "GET a lexicon's validity export", http.StatusMethodNotAllowed)
+			return
+		}
+		lexicon := strings.TrimPrefix(r.URL.Path, ValidityPrefix)
+		if lexicon == "" {
+			exports, err := v.list()
+			if err != nil {
+				log.Err(err).Msg("listing-validity-exports")
+				http.Error(w, "could not list the exports", http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]any{"exports": exports})
+			return
+		}
+		if strings.ContainsAny(lexicon, `/\`) || strings.Contains(lexicon, "..") {
+			http.NotFound(w, r)
+			return
+		}
+		f, fi, version, err := v.open(lexicon)
+		if os.IsNotExist(err) {
+			http.Error(w, "no validity export for lexicon "+lexicon, http.StatusNotFound)
+			return
+		} else if err != nil {
+			log.Err(err).Str("lexicon", lexicon).Msg("opening-validity-export")
+			http.Error(w, "could not open the export", http.StatusInternalServerError)
+			return
+		}
+		defer f.Close()
+		cacheControl := "no-cache"
+		if want := r.URL.Query().Get("version"); want != "" {
+			if want != version {
+				http.Error(w, "version "+want+" of "+lexicon+" is no longer available; the current one is "+
+					version, http.StatusNotFound)
+				return
+			}
+			cacheControl = "public, max-age=31536000, immutable"
+		}
+		w.Header().Set("Cache-Control", cacheControl)
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", `attachment; filename="`+lexicon+`.kwg"`)
+		w.Header().Set("ETag", `"`+version+`"`)
+		// ServeContent answers If-None-Match and Range requests.
+		http.ServeContent(w, r, lexicon+".kwg", fi.ModTime(), f)
+	})
+}
@@ -0,0 +1,63 @@
+package searchserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/domino14/word_db_server/config"
+)
+
+func TestValidityHandler(t *testing.T) {
+	dataPath := t.TempDir()
+	dir := filepath.Join(dataPath, "lexica", "validity")
+	h := ValidityHandler(&config.Config{DataPath: dataPath})
+	get := func(path string, header http.Header) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		for k := range header {
+			req.Header.Set(k, header.Get(k))
+		}
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// Without exports, the list is empty.
+	rec := get("/validity/", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"exports": []}`, rec.Body.String())
+
+	assert.Nil(t, os.MkdirAll(dir, 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "FOO.kwg"), []byte("abcd"), 0644))
+	rec = get("/validity/FOO", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "abcd", rec.Body.String())
+	assert.Equal(t, "no-cache", rec.Header().Get("Cache-Control"))
+	// The first 16 hex digits of the SHA-256 of abcd.
+	etag := rec.Header().Get("ETag")
+	assert.Equal(t, `"88d4266fd4e6338d"`, etag)
+	assert.JSONEq(t, `{"exports": [{"lexicon": "FOO", "version": "88d4266fd4e6338d", "size": 4}]}`,
+		get("/validity/", nil).Body.String())
+
+	rec = get("/validity/FOO", http.Header{"If-None-Match": {etag}})
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Empty(t, rec.Body.String())
+
+	rec = get("/validity/FOO?version=88d4266fd4e6338d", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "public, max-age=31536000, immutable", rec.Header().Get("Cache-Control"))
+
+	// A new export is a new version.
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "FOO.kwg"), []byte("abcde"), 0644))
+	rec = get("/validity/FOO", http.Header{"If-None-Match": {etag}})
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NotEqual(t, etag, rec.Header().Get("ETag"))
+	assert.Equal(t, http.StatusNotFound, get("/validity/FOO?version=88d4266fd4e6338d", nil).Code)
+
+	assert.Equal(t, http.StatusNotFound, get("/validity/BAR", nil).Code)
+	assert.Equal(t, http.StatusNotFound, get("/validity/..%2FFOO", nil).Code)
+}
@@ -10,10 +10,13 @@ import (
 	"github.com/domino14/word_db_server/internal/querygen"
 	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
 	"github.com/rs/zerolog/log"
+	"github.com/twitchtv/twirp"
 )
 
 type WordSearchServer struct {
 	Config *config.Config
+
+	suggestIndexes suggestIndexes
 }
 
 func (s *WordSearchServer) WordSearch(ctx context.Context, req *pb.WordSearchRequest) (*pb.WordSearchResponse, error) {
@@ -29,6 +32,10 @@ func (s *WordSearchServer) WordSearch(ctx context.Context, req *pb.WordSearchReq
 	case "word":
 		column = "word"
 	case "definition":
+		if !s.Config.DefinitionsAllowed(req.Lexicon) {
+			return nil, twirp.NewError(twirp.FailedPrecondition,
+				fmt.Sprintf("lexicon %v has no definitions data", req.Lexicon))
+		}
 		column = "definition"
 	default:
 		return nil, errors.New("applies_to must be only word or definition")
@@ -47,8 +54,14 @@ func (s *WordSearchServer) WordSearch(ctx context.Context, req *pb.WordSearchReq
 		return nil, err
 	}
 	defer rows.Close()
-	words := []*pb.Word{}
-	words = append(words, processWordRows(rows)...)
+	words, err := processWordRows(rows, 0)
+	if err == nil {
+		err = rows.Err()
+	}
+	if err != nil {
+		return nil, err
+	}
+	stripWordDefinitions(s.Config, req.Lexicon, words)
 
 	return &pb.WordSearchResponse{Words: words}, nil
 }
@@ -68,8 +81,14 @@ func (s *WordSearchServer) GetWordInformation(ctx context.Context, req *pb.Defin
 		return nil, err
 	}
 	defer rows.Close()
-	words := []*pb.Word{}
-	words = append(words, processWordRows(rows)...)
+	words, err := processWordRows(rows, 0)
+	if err == nil {
+		err = rows.Err()
+	}
+	if err != nil {
+		return nil, err
+	}
+	stripWordDefinitions(s.Config, req.Lexicon, words)
 
 	return &pb.WordSearchResponse{Words: words}, nil
 }
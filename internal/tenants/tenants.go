@@ -0,0 +1,259 @@
+// Package tenants lets one server give several tenants (partners) access
+// to different sets of lexica. A tenant is recognized by the URL prefix it
+// is served under, or by the API key it sends in the X-Api-Key header.
+package tenants
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/twitchtv/twirp"
+
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+	pbv2 "github.com/domino14/word_db_server/rpc/wordsearcher/v2"
+)
+
+// APIKeyHeader is the header that a tenant's API key is sent in.
+const APIKeyHeader = "X-Api-Key"
+
+// A Tenant is a set of clients that can use the same lexica.
+type Tenant struct {
+	Name string `json:"name"`
+	// Prefix, if set, is the URL prefix the tenant's services are served
+	// under, e.g. /acme.
+	Prefix string `json:"prefix"`
+	// APIKeys identify the tenant's requests. If the tenant has a prefix
+	// and keys, requests under the prefix must have one of the keys.
+	APIKeys []string `json:"api_keys"`
+	// Lexica are the lexica the tenant can use.
+	Lexica []string `json:"lexica"`
+
+	allowed map[string]bool
+}
+
+// Allowed returns whether the tenant can use the lexicon.
+func (t *Tenant) Allowed(lexicon string) bool {
+	return t.allowed[lexicon]
+}
+
+// Tenants is the set of tenants the server knows about.
+type Tenants struct {
+	list  []*Tenant
+	byKey map[string]*Tenant
+}
+
+// Load reads the tenants from a JSON file with a list of tenants.
+func Load(path string) (*Tenants, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var list []*Tenant
+	if err := json.NewDecoder(f).Decode(&list); err != nil {
+		return nil, fmt.Errorf("reading tenants from %v: %w", path, err)
+	}
+	return New(list)
+}
+
+// New checks the tenants and indexes them.
+func New(list []*Tenant) (*Tenants, error) {
+	ts := &Tenants{list: list, byKey: map[string]*Tenant{}}
+	names := map[string]bool{}
+	prefixes := map[string]bool{}
+	for _, t := range list {
+		if t.Name == "" || names[t.Name] {
+			return nil, fmt.Errorf("tenant names must be unique and not empty: %q", t.Name)
+		}
+		names[t.Name] = true
+		if t.Prefix == "" && len(t.APIKeys) == 0 {
+			return nil, fmt.Errorf("tenant %v needs a prefix or API keys", t.Name)
+		}
+		if t.Prefix != "" {
+			if !strings.HasPrefix(t.Prefix, "/") || strings.HasSuffix(t.Prefix, "/") {
+				return nil, fmt.Errorf("tenant %v: prefix must start and not end with /", t.Name)
+			}
+			if prefixes[t.Prefix] {
+				return nil, fmt.Errorf("tenant %v: prefix %v is already used", t.Name, t.Prefix)
+			}
+			prefixes[t.Prefix] = true
+		}
+		for _, k := range t.APIKeys {
+			if k == "" || ts.byKey[k] != nil {
+				return nil, fmt.Errorf("tenant %v: API keys must be unique and not empty", t.Name)
+			}
+			ts.byKey[k] = t
+		}
+		t.allowed = map[string]bool{}
+		for _, lex := range t.Lexica {
+			t.allowed[lex] = true
+		}
+	}
+	return ts, nil
+}
+
+// WithPrefixes returns the tenants that have a URL prefix.
+func (ts *Tenants) WithPrefixes() []*Tenant {
+	var prefixed []*Tenant
+	for _, t := range ts.list {
+		if t.Prefix != "" {
+			prefixed = append(prefixed, t)
+		}
+	}
+	return prefixed
+}
+
+type ctxKey struct{}
+
+// FromContext returns the tenant making the request, or nil if the request
+// isn't from a tenant.
+func FromContext(ctx context.Context) *Tenant {
+	t, _ := ctx.Value(ctxKey{}).(*Tenant)
+	return t
+}
+
+// Identify is middleware that recognizes tenants by their API keys.
+// Requests without a key aren't from a tenant, and are passed on as is; an
+// unknown key is rejected.
+func (ts *Tenants) Identify(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(APIKeyHeader)
+		if key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		t, ok := ts.byKey[key]
+		if !ok {
+			http.Error(w, "unknown API key", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), ctxKey{}, t)))
+	})
+}
+
+// Serve serves next under the tenant's prefix, as the tenant. It's meant
+// to be mounted at t.Prefix + "/".
+func (ts *Tenants) Serve(t *Tenant, next http.Handler) http.Handler {
+	h := http.StripPrefix(t.Prefix, next)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(t.APIKeys) > 0 && ts.byKey[r.Header.Get(APIKeyHeader)] != t {
+			http.Error(w, "missing or wrong API key", http.StatusUnauthorized)
+			return
+		}
+		h.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), ctxKey{}, t)))
+	})
+}
+
+// NotForTenants is middleware for handlers that don't check lexicon access,
+// so tenants can't use them.
+func NotForTenants(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if FromContext(r.Context()) != nil {
+			http.Error(w, "not available", http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+type lexiconGetter interface {
+	GetLexicon() string
+}
+
+// requestLexica returns the lexica a request uses.
+func requestLexica(req any) []string {
+	switch r := req.(type) {
+	case *pb.SearchRequest:
+		lexica := []string{}
+		for _, p := range r.Searchparams {
+			switch p.Condition {
+			case pb.SearchRequest_LEXICON:
+				lexica = append(lexica, p.GetStringvalue().GetValue())
+			case pb.SearchRequest_LEXICON_DIFF:
+				lexica = append(lexica, p.GetLexicondiff().GetOtherLexicon())
+			}
+		}
+		return lexica
+	case *pb.SearchResponse:
+		// An Expand request; its alphagrams can be of other lexica.
+		lexica := []string{r.Lexicon}
+		for _, a := range r.Alphagrams {
+			if a.Lexicon != "" {
+				lexica = append(lexica, a.Lexicon)
+			}
+		}
+		return lexica
+	case *pbv2.SearchRequest:
+		lexica := []string{r.Lexicon.Name()}
+		for _, c := range r.Conditions {
+			if d := c.GetLexiconDiff(); d != nil {
+				lexica = append(lexica, d.OtherLexicon.Name())
+			}
+		}
+		return lexica
+	case *pbv2.ExpandRequest:
+		lexica := []string{r.Lexicon.Name()}
+		for _, a := range r.Alphagrams {
+			if a.Lexicon != nil {
+				lexica = append(lexica, a.Lexicon.Name())
+			}
+		}
+		return lexica
+	case lexiconGetter:
+		return []string{r.GetLexicon()}
+	}
+	return nil
+}
+
+// LexiconInterceptor is a Twirp interceptor that stops tenants from using
+// lexica they don't have access to. Requests that aren't from a tenant
+// are let through.
+func LexiconInterceptor() twirp.Interceptor {
+	return func(next twirp.Method) twirp.Method {
+		return func(ctx context.Context, req any) (any, error) {
+			t := FromContext(ctx)
+			if t == nil {
+				return next(ctx, req)
+			}
+			lexica := requestLexica(req)
+			if lexica == nil {
+				// Every service request names its lexicon; don't let a
+				// new one through by accident.
+				return nil, twirp.NewError(twirp.PermissionDenied,
+					fmt.Sprintf("%T is not available to tenants", req))
+			}
+			for _, lex := range lexica {
+				// An empty lexicon is left for the service to reject, or
+				// means all lexica (see filterResponse).
+				if lex != "" && !t.Allowed(lex) {
+					return nil, twirp.NewError(twirp.PermissionDenied,
+						fmt.Sprintf("lexicon %v is not available", lex))
+				}
+			}
+			resp, err := next(ctx, req)
+			if err != nil {
+				return resp, err
+			}
+			return filterResponse(t, resp), nil
+		}
+	}
+}
+
+// filterResponse removes lexica the tenant can't use from responses that
+// list lexica.
+func filterResponse(t *Tenant, resp any) any {
+	if r, ok := resp.(*pb.SchemaInfoResponse); ok {
+		kept := []*pb.SchemaInfo{}
+		for _, info := range r.Lexica {
+			if t.Allowed(info.Lexicon) {
+				kept = append(kept, info)
+			}
+		}
+		r.Lexica = kept
+	}
+	return resp
+}
@@ -0,0 +1,160 @@
+package tenants
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/twitchtv/twirp"
+
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+	pbv2 "github.com/domino14/word_db_server/rpc/wordsearcher/v2"
+)
+
+func testTenants(t *testing.T) *Tenants {
+	ts, err := New([]*Tenant{
+		{Name: "acme", Prefix: "/acme", Lexica: []string{"NWL20"}},
+		{Name: "globex", Prefix: "/globex", APIKeys: []string{"g1", "g2"},
+			Lexica: []string{"CSW21", "NWL20"}},
+		{Name: "initech", APIKeys: []string{"i1"}, Lexica: []string{"FISE2"}},
+	})
+	assert.Nil(t, err)
+	return ts
+}
+
+func TestNewValidates(t *testing.T) {
+	for _, list := range [][]*Tenant{
+		{{Name: "", Prefix: "/a"}},
+		{{Name: "a", Prefix: "/a"}, {Name: "a", Prefix: "/b"}},
+		{{Name: "a"}},
+		{{Name: "a", Prefix: "a"}},
+		{{Name: "a", Prefix: "/a/"}},
+		{{Name: "a", Prefix: "/a"}, {Name: "b", Prefix: "/a"}},
+		{{Name: "a", APIKeys: []string{"k"}}, {Name: "b", APIKeys: []string{"k"}}},
+	} {
+		_, err := New(list)
+		assert.NotNil(t, err)
+	}
+}
+
+func tenantOf(w http.ResponseWriter, r *http.Request) {
+	if t := FromContext(r.Context()); t != nil {
+		w.Write([]byte(t.Name + " " + r.URL.Path))
+		return
+	}
+	w.Write([]byte("none " + r.URL.Path))
+}
+
+func get(h http.Handler, path, key string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if key != "" {
+		req.Header.Set(APIKeyHeader, key)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestIdentifyAndServe(t *testing.T) {
+	ts := testTenants(t)
+	inner := http.HandlerFunc(tenantOf)
+	mux := http.NewServeMux()
+	mux.Handle("/", inner)
+	for _, tn := range ts.WithPrefixes() {
+		mux.Handle(tn.Prefix+"/", ts.Serve(tn, inner))
+	}
+	h := ts.Identify(mux)
+
+	assert.Equal(t, "none /twirp/x", get(h, "/twirp/x", "").Body.String())
+	assert.Equal(t, "initech /twirp/x", get(h, "/twirp/x", "i1").Body.String())
+	assert.Equal(t, http.StatusUnauthorized, get(h, "/twirp/x", "nope").Code)
+
+	assert.Equal(t, "acme /twirp/x", get(h, "/acme/twirp/x", "").Body.String())
+	assert.Equal(t, http.StatusUnauthorized, get(h, "/globex/twirp/x", "").Code)
+	assert.Equal(t, http.StatusUnauthorized, get(h, "/globex/twirp/x", "i1").Code)
+	assert.Equal(t, "globex /twirp/x", get(h, "/globex/twirp/x", "g2").Body.String())
+
+	denied := ts.Identify(NotForTenants(inner))
+	assert.Equal(t, http.StatusForbidden, get(denied, "/plainsearch", "i1").Code)
+	assert.Equal(t, http.StatusOK, get(denied, "/plainsearch", "").Code)
+}
+
+func TestLexiconInterceptor(t *testing.T) {
+	ts := testTenants(t)
+	globex := ts.byKey["g1"]
+	ctx := context.WithValue(context.Background(), ctxKey{}, globex)
+	method := LexiconInterceptor()(func(ctx context.Context, req any) (any, error) {
+		if _, ok := req.(*pb.SchemaInfoRequest); ok {
+			return &pb.SchemaInfoResponse{Lexica: []*pb.SchemaInfo{
+				{Lexicon: "CSW21"}, {Lexicon: "FISE2"}, {Lexicon: "NWL20"}}}, nil
+		}
+		return "ok", nil
+	})
+	search := func(lex, other string) *pb.SearchRequest {
+		req := &pb.SearchRequest{Searchparams: []*pb.SearchRequest_SearchParam{{
+			Condition: pb.SearchRequest_LEXICON,
+			Conditionparam: &pb.SearchRequest_SearchParam_Stringvalue{
+				Stringvalue: &pb.SearchRequest_StringValue{Value: lex}},
+		}}}
+		if other != "" {
+			req.Searchparams = append(req.Searchparams, &pb.SearchRequest_SearchParam{
+				Condition: pb.SearchRequest_LEXICON_DIFF,
+				Conditionparam: &pb.SearchRequest_SearchParam_Lexicondiff{
+					Lexicondiff: &pb.SearchRequest_LexiconDiff{OtherLexicon: other}},
+			})
+		}
+		return req
+	}
+	denied := func(err error) bool {
+		terr, ok := err.(twirp.Error)
+		return ok && terr.Code() == twirp.PermissionDenied
+	}
+
+	_, err := method(ctx, search("CSW21", "NWL20"))
+	assert.Nil(t, err)
+	_, err = method(ctx, search("CSW21", "FISE2"))
+	assert.True(t, denied(err))
+	_, err = method(ctx, &pb.AnagramRequest{Lexicon: "FISE2"})
+	assert.True(t, denied(err))
+	_, err = method(ctx, &pb.SearchResponse{Lexicon: "NWL20"})
+	assert.Nil(t, err)
+	_, err = method(ctx, &pb.SearchResponse{Lexicon: "NWL20",
+		Alphagrams: []*pb.Alphagram{{Alphagram: "AEINST", Lexicon: "CSW21"}}})
+	assert.Nil(t, err)
+	_, err = method(ctx, &pb.SearchResponse{Lexicon: "NWL20",
+		Alphagrams: []*pb.Alphagram{{Alphagram: "AEINST", Lexicon: "FISE2"}}})
+	assert.True(t, denied(err))
+	_, err = method(ctx, &pb.SearchRequest{})
+	assert.Nil(t, err)
+	_, err = method(ctx, &pb.WordSearchResponse{})
+	assert.True(t, denied(err))
+
+	nwl20 := &pbv2.Lexicon{Family: "NWL", Version: "20"}
+	fise2 := &pbv2.Lexicon{Family: "FISE", Version: "2"}
+	_, err = method(ctx, &pbv2.SearchRequest{Lexicon: nwl20})
+	assert.Nil(t, err)
+	_, err = method(ctx, &pbv2.SearchRequest{Lexicon: nwl20, Conditions: []*pbv2.Condition{{
+		Type: pbv2.ConditionType_CONDITION_TYPE_LEXICON_DIFF,
+		Value: &pbv2.Condition_LexiconDiff{
+			LexiconDiff: &pbv2.LexiconDiff{OtherLexicon: fise2}},
+	}}})
+	assert.True(t, denied(err))
+	_, err = method(ctx, &pbv2.ExpandRequest{Lexicon: nwl20,
+		Alphagrams: []*pbv2.Alphagram{{Alphagram: "AEINST", Lexicon: fise2}}})
+	assert.True(t, denied(err))
+
+	resp, err := method(ctx, &pb.SchemaInfoRequest{})
+	assert.Nil(t, err)
+	lexica := resp.(*pb.SchemaInfoResponse).Lexica
+	assert.Equal(t, 2, len(lexica))
+	assert.Equal(t, "NWL20", lexica[1].Lexicon)
+
+	// Requests that aren't from a tenant aren't checked.
+	_, err = method(context.Background(), search("FISE2", ""))
+	assert.Nil(t, err)
+	resp, err = method(context.Background(), &pb.SchemaInfoRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(resp.(*pb.SchemaInfoResponse).Lexica))
+}
@@ -0,0 +1,147 @@
+// Package tracing traces requests with OpenTelemetry: a span for each
+// Twirp RPC, continuing the trace of the caller's traceparent header, and
+// child spans for the work done for it, such as building and running SQL
+// queries. Spans are exported with OTLP over HTTP, configured by the
+// standard OTEL_* environment variables.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/twitchtv/twirp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// instrumentation names the tracer the spans are made with.
+	instrumentation = "github.com/domino14/word_db_server"
+	// DefaultServiceName is the service name of the spans, unless
+	// OTEL_SERVICE_NAME says otherwise.
+	DefaultServiceName = "word_db_server"
+)
+
+// Enabled returns whether the environment asks for spans to be exported:
+// an OTLP endpoint is set, and the SDK isn't disabled.
+func Enabled() bool {
+	if disabled, _ := strconv.ParseBool(os.Getenv("OTEL_SDK_DISABLED")); disabled {
+		return false
+	}
+	if os.Getenv("OTEL_TRACES_EXPORTER") == "none" {
+		return false
+	}
+	return os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" ||
+		os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+}
+
+// Setup makes the spans of this process be exported, if Enabled, and the
+// trace context of incoming requests be used either way. The returned
+// function flushes the spans not yet exported, and should be called before
+// the process exits.
+func Setup(ctx context.Context) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{}))
+	if !Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+	// The exporter reads its endpoint, headers, and so on from the
+	// environment.
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// The environment's resource attributes come after the default, so
+	// they take its place.
+	res, err := resource.New(ctx,
+		resource.WithAttributes(semconv.ServiceName(DefaultServiceName)),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+		resource.WithHost())
+	if err != nil && !errors.Is(err, resource.ErrPartialResource) {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res))
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Start starts a span named name, a child of the span in ctx if there is
+// one. It must be ended, such as with End.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentation).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// StartQuery starts the span of a SQL query.
+func StartQuery(ctx context.Context, statement string) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentation).Start(ctx, "sql.query",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBQueryText(statement)))
+}
+
+// End ends the span, marking it failed if err isn't nil.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Middleware continues the trace of a request's traceparent header, if it
+// has one, in the spans made for it.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+type spanKey struct{}
+
+// ServerOption returns the option that makes a Twirp server start a span
+// for each RPC, which the handler's spans are children of.
+func ServerOption() twirp.ServerOption {
+	return twirp.WithServerHooks(&twirp.ServerHooks{
+		RequestRouted: func(ctx context.Context) (context.Context, error) {
+			service, _ := twirp.ServiceName(ctx)
+			method, _ := twirp.MethodName(ctx)
+			ctx, span := otel.Tracer(instrumentation).Start(ctx, service+"/"+method,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					semconv.RPCSystemKey.String("twirp"),
+					semconv.RPCService(service),
+					semconv.RPCMethod(method)))
+			return context.WithValue(ctx, spanKey{}, span), nil
+		},
+		Error: func(ctx context.Context, err twirp.Error) context.Context {
+			if span, ok := ctx.Value(spanKey{}).(trace.Span); ok {
+				span.SetAttributes(attribute.String("twirp.error_code", string(err.Code())))
+				span.SetStatus(codes.Error, err.Msg())
+			}
+			return ctx
+		},
+		ResponseSent: func(ctx context.Context) {
+			if span, ok := ctx.Value(spanKey{}).(trace.Span); ok {
+				if status, ok := twirp.StatusCode(ctx); ok {
+					if code, err := strconv.Atoi(status); err == nil {
+						span.SetAttributes(semconv.HTTPResponseStatusCode(code))
+					}
+				}
+				span.End()
+			}
+		},
+	})
+}
@@ -0,0 +1,101 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/twitchtv/twirp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+type judge struct {
+	pb.Anagrammer
+}
+
+func (judge) Judge(ctx context.Context, req *pb.WordJudgeRequest) (*pb.WordJudgeResponse, error) {
+	_, span := Start(ctx, "judge", attribute.Int("words", len(req.Words)))
+	defer span.End()
+	if len(req.Words) == 0 {
+		return nil, twirp.RequiredArgumentError("words")
+	}
+	return &pb.WordJudgeResponse{}, nil
+}
+
+func attr(span sdktrace.ReadOnlySpan, key string) attribute.Value {
+	for _, kv := range span.Attributes() {
+		if string(kv.Key) == key {
+			return kv.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+func TestServerSpans(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "")
+	shutdown, err := Setup(context.Background())
+	assert.Nil(t, err)
+	defer shutdown(context.Background())
+	rec := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec)))
+
+	srv := httptest.NewServer(Middleware(pb.NewAnagrammerServer(judge{}, ServerOption())))
+	defer srv.Close()
+	client := pb.NewAnagrammerProtobufClient(srv.URL, http.DefaultClient)
+	header := http.Header{}
+	header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	ctx, err := twirp.WithHTTPRequestHeaders(context.Background(), header)
+	assert.Nil(t, err)
+
+	_, err = client.Judge(ctx, &pb.WordJudgeRequest{Lexicon: "NWL20", Words: []string{"QI"}})
+	assert.Nil(t, err)
+	spans := rec.Ended()
+	assert.Len(t, spans, 2)
+	child, rpc := spans[0], spans[1]
+	assert.Equal(t, "judge", child.Name())
+	assert.Equal(t, "Anagrammer/Judge", rpc.Name())
+	// The RPC continues the caller's trace, and the handler's span is its.
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", rpc.SpanContext().TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", rpc.Parent().SpanID().String())
+	assert.True(t, rpc.Parent().IsRemote())
+	assert.Equal(t, rpc.SpanContext().SpanID(), child.Parent().SpanID())
+	assert.Equal(t, "twirp", attr(rpc, "rpc.system").AsString())
+	assert.Equal(t, "Judge", attr(rpc, "rpc.method").AsString())
+	assert.Equal(t, int64(200), attr(rpc, "http.response.status_code").AsInt64())
+	assert.Equal(t, codes.Unset, rpc.Status().Code)
+
+	// Without a traceparent, the RPC starts a trace.
+	_, err = client.Judge(context.Background(), &pb.WordJudgeRequest{Lexicon: "NWL20"})
+	assert.NotNil(t, err)
+	spans = rec.Ended()
+	assert.Len(t, spans, 4)
+	rpc = spans[3]
+	assert.False(t, rpc.Parent().IsValid())
+	assert.Equal(t, codes.Error, rpc.Status().Code)
+	assert.Equal(t, "invalid_argument", attr(rpc, "twirp.error_code").AsString())
+	assert.Equal(t, int64(400), attr(rpc, "http.response.status_code").AsInt64())
+}
+
+func TestEnabled(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "")
+	t.Setenv("OTEL_SDK_DISABLED", "")
+	t.Setenv("OTEL_TRACES_EXPORTER", "")
+	assert.False(t, Enabled())
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://localhost:4318")
+	assert.True(t, Enabled())
+	t.Setenv("OTEL_TRACES_EXPORTER", "none")
+	assert.False(t, Enabled())
+	t.Setenv("OTEL_TRACES_EXPORTER", "")
+	t.Setenv("OTEL_SDK_DISABLED", "true")
+	assert.False(t, Enabled())
+}
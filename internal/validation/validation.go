@@ -0,0 +1,178 @@
+// Package validation checks the shape of search requests before any query
+// is generated, so that a malformed or contradictory request is reported
+// with the search param and field at fault, rather than as whatever error
+// the query generator or database happens to return for it.
+package validation
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/twitchtv/twirp"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/domino14/word_db_server/internal/querygen"
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// An Error is a problem with one field of a search request.
+type Error struct {
+	// Index is the index of the search param at fault, or -1 if the
+	// problem isn't with one.
+	Index int
+	// Field is the field of the search param at fault, like minmax.max or
+	// combinator.params[1].minmax; it's the request's field if Index is
+	// -1.
+	Field string
+	Msg   string
+}
+
+// Path is the field's path in the request, like searchparams[2].minmax.max.
+func (e *Error) Path() string {
+	if e.Index < 0 {
+		return e.Field
+	}
+	return fmt.Sprintf("searchparams[%d].%s", e.Index, e.Field)
+}
+
+func (e *Error) Error() string {
+	return e.Path() + " " + e.Msg
+}
+
+// Twirp returns the error as a Twirp InvalidArgument error. Its argument
+// meta is the path, and its param_index and field metas are the Index and
+// Field.
+func (e *Error) Twirp() twirp.Error {
+	return twirp.InvalidArgumentError(e.Path(), e.Msg).
+		WithMeta("param_index", strconv.Itoa(e.Index)).
+		WithMeta("field", e.Field)
+}
+
+// SearchRequest returns an *Error for the first problem with the request's
+// search params, or nil. It checks each param on its own, against its
+// condition; the rules for combining conditions are left to the query
+// generator's Validate.
+func SearchRequest(req *pb.SearchRequest) error {
+	params := req.GetSearchparams()
+	if len(params) == 0 {
+		return &Error{Index: -1, Field: "searchparams", Msg: "must have a lexicon condition"}
+	}
+	if params[0].Condition != pb.SearchRequest_LEXICON {
+		return &Error{Index: 0, Field: "condition", Msg: "must be LEXICON"}
+	}
+	if params[0].GetStringvalue().GetValue() == "" {
+		return &Error{Index: 0, Field: "stringvalue.value", Msg: "must name a lexicon"}
+	}
+	for i, p := range params[1:] {
+		if field, msg := checkParam(p); msg != "" {
+			return &Error{Index: i + 1, Field: field, Msg: msg}
+		}
+	}
+	return nil
+}
+
+// checkParam returns the field at fault and what's wrong with it, or an
+// empty message if nothing is.
+func checkParam(p *pb.SearchRequest_SearchParam) (string, string) {
+	if p.Condition == pb.SearchRequest_LEXICON {
+		return "condition", "LEXICON must only be the first condition"
+	}
+	info := querygen.Condition(p.Condition)
+	if info == nil {
+		return "condition", fmt.Sprintf("%v is not a condition the server searches for", p.Condition)
+	}
+	if info.Param == "" {
+		return "", ""
+	}
+	given := ""
+	if fd := p.ProtoReflect().WhichOneof(p.ProtoReflect().Descriptor().Oneofs().ByName("conditionparam")); fd != nil {
+		given = string(fd.Name())
+	}
+	if given == "" {
+		return info.Param, fmt.Sprintf("is required for %v", p.Condition)
+	}
+	if given != info.Param {
+		return info.Param, fmt.Sprintf("is required for %v, not %s", p.Condition, given)
+	}
+
+	switch info.Param {
+	case "minmax":
+		mm := p.GetMinmax()
+		return checkRange(p.Condition, int64(mm.Min), int64(mm.Max), "minmax")
+	case "minmax64":
+		mm := p.GetMinmax64()
+		return checkRange(p.Condition, mm.Min, mm.Max, "minmax64")
+	case "numbervalue":
+		if info.Enum != nil &&
+			info.Enum.Values().ByNumber(protoreflect.EnumNumber(p.GetNumbervalue().GetValue())) == nil {
+			return "numbervalue.value", fmt.Sprintf("must be one of %s", enumNames(info.Enum))
+		}
+	case "randomsample":
+		if p.GetRandomsample().GetCount() < 1 {
+			return "randomsample.count", "must be positive"
+		}
+	case "lexicondiff":
+		if p.GetLexicondiff().GetOtherLexicon() == "" {
+			return "lexicondiff.other_lexicon", "must name a lexicon"
+		}
+	case "build":
+		b := p.GetBuild()
+		if b.Letters == "" {
+			return "build.letters", "must not be empty"
+		}
+		if b.MinLength < 0 || b.MaxLength < 0 {
+			return "build", "lengths must not be negative"
+		}
+		if b.MaxLength != 0 && b.MinLength > b.MaxLength {
+			return "build.min_length", "must not be more than max_length"
+		}
+	case "combinator":
+		comb := p.GetCombinator()
+		if len(comb.Params) == 0 {
+			return "combinator.params", "must not be empty"
+		}
+		if comb.Op == pb.SearchRequest_Combinator_NOT && len(comb.Params) != 1 {
+			return "combinator.params", "must have exactly one param for NOT"
+		}
+		for i, sub := range comb.Params {
+			prefix := fmt.Sprintf("combinator.params[%d]", i)
+			if info := querygen.Condition(sub.Condition); info != nil && !info.Combinable {
+				return prefix + ".condition", fmt.Sprintf("%v can't be combined", sub.Condition)
+			}
+			if field, msg := checkParam(sub); msg != "" {
+				return prefix + "." + field, msg
+			}
+		}
+	}
+	return "", ""
+}
+
+func checkRange(c pb.SearchRequest_Condition, min, max int64, field string) (string, string) {
+	if min < 0 {
+		return field + ".min", "must not be negative"
+	}
+	switch c {
+	case pb.SearchRequest_PROBABILITY_LIMIT, pb.SearchRequest_PROBABILITY_LIMIT_PER_LENGTH,
+		pb.SearchRequest_MOST_PROBABLE, pb.SearchRequest_LEAST_PROBABLE:
+		// These are ranks, which start at 1.
+		if min < 1 {
+			return field + ".min", "must be at least 1"
+		}
+	}
+	if min > max {
+		return field + ".min", "must not be more than max"
+	}
+	return "", ""
+}
+
+func enumNames(e protoreflect.EnumDescriptor) string {
+	names := ""
+	for i := 0; i < e.Values().Len(); i++ {
+		if i > 0 {
+			names += ", "
+		}
+		names += string(e.Values().Get(i).Name())
+	}
+	return names
+}
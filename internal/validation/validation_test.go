@@ -0,0 +1,111 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/twitchtv/twirp"
+
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func lexicon(name string) *pb.SearchRequest_SearchParam {
+	return &pb.SearchRequest_SearchParam{
+		Condition: pb.SearchRequest_LEXICON,
+		Conditionparam: &pb.SearchRequest_SearchParam_Stringvalue{
+			Stringvalue: &pb.SearchRequest_StringValue{Value: name}},
+	}
+}
+
+func minmax(c pb.SearchRequest_Condition, min, max int32) *pb.SearchRequest_SearchParam {
+	return &pb.SearchRequest_SearchParam{
+		Condition: c,
+		Conditionparam: &pb.SearchRequest_SearchParam_Minmax{
+			Minmax: &pb.SearchRequest_MinMax{Min: min, Max: max}},
+	}
+}
+
+func combinator(op pb.SearchRequest_Combinator_Op, params ...*pb.SearchRequest_SearchParam) *pb.SearchRequest_SearchParam {
+	return &pb.SearchRequest_SearchParam{
+		Condition: pb.SearchRequest_COMBINATOR,
+		Conditionparam: &pb.SearchRequest_SearchParam_Combinator{
+			Combinator: &pb.SearchRequest_Combinator{Op: op, Params: params}},
+	}
+}
+
+func TestSearchRequest(t *testing.T) {
+	check := func(params ...*pb.SearchRequest_SearchParam) string {
+		err := SearchRequest(&pb.SearchRequest{Searchparams: params})
+		if err == nil {
+			return ""
+		}
+		return err.Error()
+	}
+	length := pb.SearchRequest_LENGTH
+
+	assert.Equal(t, "", check(lexicon("NWL23"), minmax(length, 7, 8),
+		&pb.SearchRequest_SearchParam{Condition: pb.SearchRequest_DELETED_WORD}))
+	assert.Equal(t, "", check(lexicon("NWL23"), combinator(pb.SearchRequest_Combinator_OR,
+		minmax(length, 7, 7), minmax(pb.SearchRequest_POINT_VALUE, 20, 99))))
+
+	cases := []struct {
+		params []*pb.SearchRequest_SearchParam
+		want   string
+	}{
+		{nil, "searchparams must have a lexicon condition"},
+		{[]*pb.SearchRequest_SearchParam{minmax(length, 7, 7)}, "searchparams[0].condition must be LEXICON"},
+		{[]*pb.SearchRequest_SearchParam{lexicon("")}, "searchparams[0].stringvalue.value must name a lexicon"},
+		{[]*pb.SearchRequest_SearchParam{lexicon("NWL23"), lexicon("CSW21")},
+			"searchparams[1].condition LEXICON must only be the first condition"},
+		{[]*pb.SearchRequest_SearchParam{lexicon("NWL23"), minmax(length, 8, 7)},
+			"searchparams[1].minmax.min must not be more than max"},
+		{[]*pb.SearchRequest_SearchParam{lexicon("NWL23"), minmax(length, -1, 7)},
+			"searchparams[1].minmax.min must not be negative"},
+		{[]*pb.SearchRequest_SearchParam{lexicon("NWL23"), minmax(pb.SearchRequest_PROBABILITY_LIMIT, 0, 5)},
+			"searchparams[1].minmax.min must be at least 1"},
+		{[]*pb.SearchRequest_SearchParam{lexicon("NWL23"), {Condition: length}},
+			"searchparams[1].minmax is required for LENGTH"},
+		{[]*pb.SearchRequest_SearchParam{lexicon("NWL23"), {Condition: length,
+			Conditionparam: &pb.SearchRequest_SearchParam_Stringvalue{
+				Stringvalue: &pb.SearchRequest_StringValue{Value: "7"}}}},
+			"searchparams[1].minmax is required for LENGTH, not stringvalue"},
+		{[]*pb.SearchRequest_SearchParam{lexicon("NWL23"), {Condition: pb.SearchRequest_NOT_IN_LEXICON,
+			Conditionparam: &pb.SearchRequest_SearchParam_Numbervalue{
+				Numbervalue: &pb.SearchRequest_NumberValue{Value: 7}}}},
+			"searchparams[1].numbervalue.value must be one of OTHER_ENGLISH, PREVIOUS_VERSION"},
+		{[]*pb.SearchRequest_SearchParam{lexicon("NWL23"), {Condition: pb.SearchRequest_Condition(99)}},
+			"searchparams[1].condition 99 is not a condition the server searches for"},
+		{[]*pb.SearchRequest_SearchParam{lexicon("NWL23"), {Condition: pb.SearchRequest_RANDOM_SAMPLE,
+			Conditionparam: &pb.SearchRequest_SearchParam_Randomsample{
+				Randomsample: &pb.SearchRequest_RandomSample{}}}},
+			"searchparams[1].randomsample.count must be positive"},
+		{[]*pb.SearchRequest_SearchParam{lexicon("NWL23"), {Condition: pb.SearchRequest_BUILD,
+			Conditionparam: &pb.SearchRequest_SearchParam_Build{
+				Build: &pb.SearchRequest_Build{Letters: "RETINAS", MinLength: 7, MaxLength: 5}}}},
+			"searchparams[1].build.min_length must not be more than max_length"},
+		{[]*pb.SearchRequest_SearchParam{lexicon("NWL23"), minmax(length, 7, 7),
+			combinator(pb.SearchRequest_Combinator_OR, minmax(length, 2, 3),
+				combinator(pb.SearchRequest_Combinator_AND, minmax(length, 5, 4)))},
+			"searchparams[2].combinator.params[1].combinator.params[0].minmax.min must not be more than max"},
+		{[]*pb.SearchRequest_SearchParam{lexicon("NWL23"), combinator(pb.SearchRequest_Combinator_NOT,
+			minmax(length, 2, 3), minmax(length, 4, 5))},
+			"searchparams[1].combinator.params must have exactly one param for NOT"},
+		{[]*pb.SearchRequest_SearchParam{lexicon("NWL23"), combinator(pb.SearchRequest_Combinator_AND,
+			minmax(pb.SearchRequest_PROBABILITY_LIMIT, 1, 5))},
+			"searchparams[1].combinator.params[0].condition PROBABILITY_LIMIT can't be combined"},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.want, check(c.params...))
+	}
+}
+
+func TestTwirp(t *testing.T) {
+	err := SearchRequest(&pb.SearchRequest{Searchparams: []*pb.SearchRequest_SearchParam{
+		lexicon("NWL23"), minmax(pb.SearchRequest_LENGTH, 8, 7)}})
+	terr := err.(*Error).Twirp()
+	assert.Equal(t, twirp.InvalidArgument, terr.Code())
+	assert.Equal(t, "searchparams[1].minmax.min", terr.Meta("argument"))
+	assert.Equal(t, "1", terr.Meta("param_index"))
+	assert.Equal(t, "minmax.min", terr.Meta("field"))
+	assert.Equal(t, "searchparams[1].minmax.min must not be more than max", terr.Msg())
+}
@@ -0,0 +1,371 @@
+package wordstore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/rs/zerolog/log"
+)
+
+type column struct {
+	name    string
+	pgType  string
+	notNull bool
+	// dflt is the column's default, as an SQL expression.
+	dflt sql.NullString
+	// pk is the column's place in the primary key, from 1, or 0 if it
+	// isn't in it.
+	pk int
+}
+
+type table struct {
+	name    string
+	columns []column
+}
+
+type index struct {
+	name    string
+	table   string
+	unique  bool
+	columns []string
+}
+
+// pgType maps the declared type of a SQLite column to a Postgres type, by
+// the rules SQLite uses to give columns their type affinity. Text gets no
+// length limit, since SQLite doesn't enforce the ones it's declared with.
+func pgType(decl string) string {
+	d := strings.ToUpper(decl)
+	switch {
+	case strings.Contains(d, "INT"):
+		return "bigint"
+	case strings.Contains(d, "CHAR"), strings.Contains(d, "CLOB"), strings.Contains(d, "TEXT"):
+		return "text"
+	case strings.Contains(d, "BLOB"):
+		return "bytea"
+	case strings.Contains(d, "REAL"), strings.Contains(d, "FLOA"), strings.Contains(d, "DOUB"):
+		return "double precision"
+	case d == "":
+		// Untyped columns can hold anything; everything we store in
+		// them is text.
+		return "text"
+	}
+	return "numeric"
+}
+
+// quoteName quotes a name that came from sqlite_master for a SQLite query.
+func quoteName(name string) string {
+	return "'" + strings.ReplaceAll(name, "'", "''") + "'"
+}
+
+// sqliteSchema reads the tables and indexes of a SQLite database. Virtual
+// tables, such as the definitions' full-text index, and the tables they
+// keep their data in are left out; Postgres has no equivalent.
+func sqliteSchema(ctx context.Context, db *sql.DB) ([]*table, []*index, error) {
+	rows, err := db.QueryContext(ctx, `SELECT name, sql FROM sqlite_master WHERE type = 'table'
+		AND name NOT LIKE 'sqlite_%' ORDER BY name`)
+	if err != nil {
+		return nil, nil, err
+	}
+	names := []string{}
+	virtual := []string{}
+	for rows.Next() {
+		var name, createSQL string
+		if err := rows.Scan(&name, &createSQL); err != nil {
+			rows.Close()
+			return nil, nil, err
+		}
+		if strings.HasPrefix(createSQL, "CREATE VIRTUAL TABLE") {
+			virtual = append(virtual, name)
+			continue
+		}
+		names = append(names, name)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	tables := []*table{}
+	indexes := []*index{}
+	for _, name := range names {
+		shadow := false
+		for _, v := range virtual {
+			shadow = shadow || strings.HasPrefix(name, v+"_")
+		}
+		if shadow {
+			continue
+		}
+		t, err := sqliteTable(ctx, db, name)
+		if err != nil {
+			return nil, nil, err
+		}
+		tables = append(tables, t)
+		idxs, err := sqliteIndexes(ctx, db, name)
+		if err != nil {
+			return nil, nil, err
+		}
+		indexes = append(indexes, idxs...)
+	}
+	return tables, indexes, nil
+}
+
+func sqliteTable(ctx context.Context, db *sql.DB, name string) (*table, error) {
+	rows, err := db.QueryContext(ctx, `SELECT name, type, "notnull", dflt_value, pk
+		FROM pragma_table_info(`+quoteName(name)+`) ORDER BY cid`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	t := &table{name: name}
+	for rows.Next() {
+		var c column
+		var decl string
+		if err := rows.Scan(&c.name, &decl, &c.notNull, &c.dflt, &c.pk); err != nil {
+			return nil, err
+		}
+		c.pgType = pgType(decl)
+		t.columns = append(t.columns, c)
+	}
+	return t, rows.Err()
+}
+
+// sqliteIndexes reads a table's indexes, including the ones SQLite makes
+// for UNIQUE constraints. Primary keys are part of the table.
+func sqliteIndexes(ctx context.Context, db *sql.DB, tableName string) ([]*index, error) {
+	rows, err := db.QueryContext(ctx, `SELECT name, "unique", origin, partial
+		FROM pragma_index_list(`+quoteName(tableName)+`) ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	indexes := []*index{}
+	for rows.Next() {
+		idx := &index{table: tableName}
+		var origin string
+		var partial bool
+		if err := rows.Scan(&idx.name, &idx.unique, &origin, &partial); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		if partial {
+			rows.Close()
+			return nil, fmt.Errorf("index %v is partial, which can't be loaded", idx.name)
+		}
+		if origin != "pk" {
+			indexes = append(indexes, idx)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	for _, idx := range indexes {
+		rows, err := db.QueryContext(ctx, `SELECT name FROM pragma_index_info(`+
+			quoteName(idx.name)+`) ORDER BY seqno`)
+		if err != nil {
+			return nil, err
+		}
+		for rows.Next() {
+			var col sql.NullString
+			if err := rows.Scan(&col); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			if !col.Valid {
+				rows.Close()
+				return nil, fmt.Errorf("index %v is on an expression, which can't be loaded", idx.name)
+			}
+			idx.columns = append(idx.columns, col.String)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		// SQLite's names for the indexes of UNIQUE constraints are
+		// reserved in SQLite, but not here.
+		idx.name = strings.TrimPrefix(idx.name, "sqlite_")
+	}
+	return indexes, nil
+}
+
+func quoteColumns(names []string) string {
+	quoted := make([]string, len(names))
+	for i, n := range names {
+		quoted[i] = pq.QuoteIdentifier(n)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func (t *table) createSQL() string {
+	defs := []string{}
+	pk := map[int]string{}
+	for _, c := range t.columns {
+		def := pq.QuoteIdentifier(c.name) + " " + c.pgType
+		if c.notNull {
+			def += " NOT NULL"
+		}
+		if c.dflt.Valid {
+			def += " DEFAULT " + c.dflt.String
+		}
+		defs = append(defs, def)
+		if c.pk > 0 {
+			pk[c.pk] = c.name
+		}
+	}
+	if len(pk) > 0 {
+		keys := make([]string, len(pk))
+		for i := range keys {
+			keys[i] = pk[i+1]
+		}
+		defs = append(defs, "PRIMARY KEY ("+quoteColumns(keys)+")")
+	}
+	return "CREATE TABLE " + pq.QuoteIdentifier(t.name) + " (" + strings.Join(defs, ", ") + ")"
+}
+
+func (idx *index) createSQL() string {
+	create := "CREATE INDEX "
+	if idx.unique {
+		create = "CREATE UNIQUE INDEX "
+	}
+	return create + pq.QuoteIdentifier(idx.name) + " ON " + pq.QuoteIdentifier(idx.table) +
+		" (" + quoteColumns(idx.columns) + ")"
+}
+
+func (t *table) columnNames() []string {
+	names := make([]string, len(t.columns))
+	for i, c := range t.columns {
+		names[i] = c.name
+	}
+	return names
+}
+
+// copyTable copies the rows of a table from the SQLite database.
+func copyTable(ctx context.Context, src *sql.DB, tx *sql.Tx, schema string, t *table) (int, error) {
+	rows, err := src.QueryContext(ctx, "SELECT "+quoteColumns(t.columnNames())+
+		" FROM "+pq.QuoteIdentifier(t.name))
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	stmt, err := tx.PrepareContext(ctx, pq.CopyInSchema(schema, t.name, t.columnNames()...))
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+	vals := make([]any, len(t.columns))
+	ptrs := make([]any, len(t.columns))
+	for i := range vals {
+		ptrs[i] = &vals[i]
+	}
+	n := 0
+	for rows.Next() {
+		if err := rows.Scan(ptrs...); err != nil {
+			return 0, err
+		}
+		for i, c := range t.columns {
+			// The SQLite driver gives text as bytes, which would be
+			// copied as bytea.
+			if b, ok := vals[i].([]byte); ok && c.pgType == "text" {
+				vals[i] = string(b)
+			}
+		}
+		if _, err := stmt.ExecContext(ctx, vals...); err != nil {
+			return 0, err
+		}
+		n++
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	// Flush the copy.
+	if _, err := stmt.ExecContext(ctx); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// LoadSQLite loads a lexicon database made by dbmaker into the Postgres
+// store, with the same tables and indexes, replacing the lexicon if it's
+// already there. The load is a single transaction, so searches see either
+// the old lexicon or the new one. The definitions' full-text index isn't
+// loaded, so the lexicon's definitions capability is turned off.
+func LoadSQLite(ctx context.Context, p *PostgresStore, lexicon, path string) error {
+	if err := validateName(lexicon); err != nil {
+		return err
+	}
+	src, err := sql.Open("sqlite3", "file:"+path+"?mode=ro")
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	tables, indexes, err := sqliteSchema(ctx, src)
+	if err != nil {
+		return err
+	}
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	exec := func(query string, args ...any) error {
+		_, err := tx.ExecContext(ctx, query, args...)
+		return err
+	}
+	// Loads of the same lexicon wait for each other.
+	if err := exec(`SELECT pg_advisory_xact_lock(hashtext($1))`, lexicon); err != nil {
+		return err
+	}
+	schema := schemaName(lexicon)
+	staging := schema + "_loading"
+	err = exec(`DROP SCHEMA IF EXISTS ` + pq.QuoteIdentifier(staging) + ` CASCADE;
+		CREATE SCHEMA ` + pq.QuoteIdentifier(staging) + `;
+		SET LOCAL search_path TO ` + pq.QuoteIdentifier(staging))
+	if err != nil {
+		return err
+	}
+	hasCapabilities := false
+	for _, t := range tables {
+		if err := exec(t.createSQL()); err != nil {
+			return fmt.Errorf("creating %v: %w", t.name, err)
+		}
+		n, err := copyTable(ctx, src, tx, staging, t)
+		if err != nil {
+			return fmt.Errorf("copying %v: %w", t.name, err)
+		}
+		log.Info().Str("lexicon", lexicon).Str("table", t.name).Int("rows", n).Msg("copied table")
+		hasCapabilities = hasCapabilities || t.name == "capabilities"
+	}
+	for _, idx := range indexes {
+		if err := exec(idx.createSQL()); err != nil {
+			return fmt.Errorf("creating index %v: %w", idx.name, err)
+		}
+	}
+	if hasCapabilities {
+		if err := exec(`UPDATE capabilities SET enabled = 0 WHERE name = 'definitions'`); err != nil {
+			return err
+		}
+	}
+	for _, t := range tables {
+		if err := exec(`ANALYZE ` + pq.QuoteIdentifier(t.name)); err != nil {
+			return err
+		}
+	}
+
+	err = exec(`SET LOCAL search_path TO DEFAULT;
+		DROP SCHEMA IF EXISTS ` + pq.QuoteIdentifier(schema) + ` CASCADE;
+		ALTER SCHEMA ` + pq.QuoteIdentifier(staging) + ` RENAME TO ` + pq.QuoteIdentifier(schema))
+	if err != nil {
+		return err
+	}
+	err = exec(`INSERT INTO word_db_lexica (name, schema_name, loaded_at) VALUES ($1, $2, $3)
+		ON CONFLICT (name) DO UPDATE SET schema_name = EXCLUDED.schema_name,
+		loaded_at = EXCLUDED.loaded_at`, lexicon, schema, time.Now().UnixNano())
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,62 @@
+package wordstore
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPGType(t *testing.T) {
+	for decl, want := range map[string]string{
+		"int":          "bigint",
+		"INTEGER":      "bigint",
+		"varchar(20)":  "text",
+		"text":         "text",
+		"REAL":         "double precision",
+		"BLOB":         "bytea",
+		"":             "text",
+		"BOOLEAN":      "numeric",
+		"decimal(5,2)": "numeric",
+	} {
+		assert.Equal(t, want, pgType(decl), decl)
+	}
+}
+
+func TestSQLiteSchema(t *testing.T) {
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "FOO.db"))
+	assert.Nil(t, err)
+	defer db.Close()
+	_, err = db.Exec(`
+	CREATE TABLE words (word varchar(20), alphagram varchar(20),
+		sources varchar(32) NOT NULL DEFAULT '');
+	CREATE INDEX word_index on words(word);
+	CREATE INDEX alpha_len_index on words(alphagram, word);
+	CREATE TABLE capabilities (name varchar(32) PRIMARY KEY, enabled int);
+	CREATE TABLE pairs (a int, b int, c text UNIQUE, PRIMARY KEY (b, a));
+	`)
+	assert.Nil(t, err)
+
+	tables, indexes, err := sqliteSchema(context.Background(), db)
+	assert.Nil(t, err)
+	sqls := []string{}
+	for _, t := range tables {
+		sqls = append(sqls, t.createSQL())
+	}
+	assert.Equal(t, []string{
+		`CREATE TABLE "capabilities" ("name" text, "enabled" bigint, PRIMARY KEY ("name"))`,
+		`CREATE TABLE "pairs" ("a" bigint, "b" bigint, "c" text, PRIMARY KEY ("b", "a"))`,
+		`CREATE TABLE "words" ("word" text, "alphagram" text, "sources" text NOT NULL DEFAULT '')`,
+	}, sqls)
+	sqls = []string{}
+	for _, idx := range indexes {
+		sqls = append(sqls, idx.createSQL())
+	}
+	assert.Equal(t, []string{
+		`CREATE UNIQUE INDEX "autoindex_pairs_1" ON "pairs" ("c")`,
+		`CREATE INDEX "alpha_len_index" ON "words" ("alphagram", "word")`,
+		`CREATE INDEX "word_index" ON "words" ("word")`,
+	}, sqls)
+}
@@ -0,0 +1,272 @@
+package wordstore
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/lib/pq"
+)
+
+// registrySchema is the table that lists the lexica loaded into a Postgres
+// database. Each lexicon's tables are in a schema of their own, so that
+// the queries written for SQLite work on them unchanged once the
+// connection's search_path points there.
+const registrySchema = `
+CREATE TABLE IF NOT EXISTS word_db_lexica (
+	name text PRIMARY KEY,
+	schema_name text NOT NULL,
+	loaded_at bigint NOT NULL
+);
+`
+
+// maxIdleConns is how many connections to each lexicon's schema are kept
+// open between requests.
+const maxIdleConns = 8
+
+// PostgresStore keeps the lexica in a Postgres database. They're loaded
+// into it from the SQLite files that dbmaker makes; see LoadSQLite.
+type PostgresStore struct {
+	dsn  string
+	db   *sql.DB
+	base driver.Connector
+
+	mu    sync.Mutex
+	pools map[string]*connPool
+}
+
+// OpenPostgres connects to the Postgres database of the DSN, and creates
+// the table of lexica if it doesn't exist.
+func OpenPostgres(dsn string) (*PostgresStore, error) {
+	base, err := pq.NewConnector(dsn)
+	if err != nil {
+		return nil, err
+	}
+	db := sql.OpenDB(base)
+	if _, err := db.Exec(registrySchema); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &PostgresStore{dsn: dsn, db: db, base: base, pools: map[string]*connPool{}}, nil
+}
+
+func (p *PostgresStore) Dialect() Dialect {
+	return Postgres
+}
+
+// schemaName is the schema that a lexicon's tables are loaded into.
+func schemaName(lexicon string) string {
+	return "lex_" + lexicon
+}
+
+// Open returns a handle on the lexicon's schema. Handles are cheap: the
+// connections under them are pooled by the store, and outlive the
+// handle.
+func (p *PostgresStore) Open(lexicon string) (*sql.DB, error) {
+	if err := validateName(lexicon); err != nil {
+		return nil, err
+	}
+	var schema string
+	err := p.db.QueryRow(`SELECT schema_name FROM word_db_lexica WHERE name = $1`,
+		lexicon).Scan(&schema)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, &UnknownLexiconError{Lexicon: lexicon}
+	} else if err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	pool, ok := p.pools[schema]
+	if !ok {
+		pool = &connPool{base: p.base, schema: schema}
+		p.pools[schema] = pool
+	}
+	return sql.OpenDB(pool), nil
+}
+
+func (p *PostgresStore) Lexica(ctx context.Context) ([]string, error) {
+	rows, err := p.db.QueryContext(ctx, `SELECT name FROM word_db_lexica ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	lexica := []string{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		lexica = append(lexica, name)
+	}
+	return lexica, rows.Err()
+}
+
+// Stamp changes every time the lexicon is loaded.
+func (p *PostgresStore) Stamp(ctx context.Context, lexicon string) (string, error) {
+	var loadedAt int64
+	err := p.db.QueryRowContext(ctx, `SELECT loaded_at FROM word_db_lexica WHERE name = $1`,
+		lexicon).Scan(&loadedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", &UnknownLexiconError{Lexicon: lexicon}
+	}
+	return strconv.FormatInt(loadedAt, 10), err
+}
+
+// Close closes the store's idle connections.
+func (p *PostgresStore) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, pool := range p.pools {
+		pool.close()
+	}
+	return p.db.Close()
+}
+
+// A connPool is a driver.Connector for the connections to one lexicon's
+// schema. Closing a connection puts it back in the pool, so that the
+// handles opened per request don't each make new connections.
+type connPool struct {
+	base   driver.Connector
+	schema string
+
+	mu   sync.Mutex
+	idle []driver.Conn
+}
+
+func (p *connPool) Connect(ctx context.Context) (driver.Conn, error) {
+	p.mu.Lock()
+	if n := len(p.idle); n > 0 {
+		c := p.idle[n-1]
+		p.idle = p.idle[:n-1]
+		p.mu.Unlock()
+		return &conn{Conn: c, pool: p}, nil
+	}
+	p.mu.Unlock()
+	c, err := p.base.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, err = c.(driver.ExecerContext).ExecContext(ctx,
+		"SET search_path TO "+pq.QuoteIdentifier(p.schema), nil)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+	return &conn{Conn: c, pool: p}, nil
+}
+
+func (p *connPool) Driver() driver.Driver {
+	return p.base.Driver()
+}
+
+func (p *connPool) put(c driver.Conn) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.idle) >= maxIdleConns {
+		return c.Close()
+	}
+	p.idle = append(p.idle, c)
+	return nil
+}
+
+func (p *connPool) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, c := range p.idle {
+		c.Close()
+	}
+	p.idle = nil
+}
+
+// conn is a connection from a connPool. It rewrites the ? placeholders of
+// queries for Postgres.
+type conn struct {
+	driver.Conn
+	pool *connPool
+	bad  bool
+}
+
+// check notes whether the connection broke, so that it isn't reused.
+func (c *conn) check(err error) error {
+	if errors.Is(err, driver.ErrBadConn) {
+		c.bad = true
+	}
+	return err
+}
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	stmt, err := c.Conn.Prepare(rebind(query))
+	return stmt, c.check(err)
+}
+
+func (c *conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (
+	driver.Rows, error) {
+
+	rows, err := c.Conn.(driver.QueryerContext).QueryContext(ctx, rebind(query), args)
+	return rows, c.check(err)
+}
+
+func (c *conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (
+	driver.Result, error) {
+
+	res, err := c.Conn.(driver.ExecerContext).ExecContext(ctx, rebind(query), args)
+	return res, c.check(err)
+}
+
+func (c *conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	tx, err := c.Conn.(driver.ConnBeginTx).BeginTx(ctx, opts)
+	return tx, c.check(err)
+}
+
+func (c *conn) Ping(ctx context.Context) error {
+	return c.check(c.Conn.(driver.Pinger).Ping(ctx))
+}
+
+func (c *conn) IsValid() bool {
+	return !c.bad
+}
+
+func (c *conn) ResetSession(ctx context.Context) error {
+	if c.bad {
+		return driver.ErrBadConn
+	}
+	return nil
+}
+
+func (c *conn) Close() error {
+	if c.bad {
+		return c.Conn.Close()
+	}
+	return c.pool.put(c.Conn)
+}
+
+// rebind rewrites a query's ? placeholders as Postgres's $1, $2 and so on,
+// leaving alone any in quoted strings and identifiers.
+func rebind(query string) string {
+	if !strings.Contains(query, "?") {
+		return query
+	}
+	var sb strings.Builder
+	n := 0
+	var quote rune
+	for _, r := range query {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == '?':
+			n++
+			sb.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
@@ -0,0 +1,128 @@
+// Package wordstore opens the lexicon databases that the searcher serves.
+// They are either SQLite files in the data path, as made by dbmaker, or
+// schemas in a Postgres database that those files have been loaded into,
+// so that replicas can share them.
+package wordstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// A Dialect is the SQL dialect of a store's databases.
+type Dialect string
+
+const (
+	SQLite   Dialect = "sqlite3"
+	Postgres Dialect = "postgres"
+)
+
+// A Store holds the databases of a set of lexica. The databases have the
+// same tables and indexes whichever store they're in, and the queries on
+// them are written with ? placeholders for either.
+type Store interface {
+	Dialect() Dialect
+	// Open opens the lexicon's database. The caller closes it when done.
+	Open(lexicon string) (*sql.DB, error)
+	// Lexica returns the names of the lexica in the store, in order.
+	Lexica(ctx context.Context) ([]string, error)
+	// Stamp returns a string that changes whenever the lexicon's data
+	// does, for caches to check.
+	Stamp(ctx context.Context, lexicon string) (string, error)
+}
+
+// An UnknownLexiconError is returned for a lexicon that the store doesn't
+// have.
+type UnknownLexiconError struct {
+	Lexicon string
+}
+
+func (e *UnknownLexiconError) Error() string {
+	return fmt.Sprintf("the lexicon %v is not supported", e.Lexicon)
+}
+
+// SQLiteStore keeps every lexicon in its own SQLite file, <lexicon>.db.
+type SQLiteStore struct {
+	Dir string
+}
+
+func (s *SQLiteStore) Dialect() Dialect {
+	return SQLite
+}
+
+// Path returns the path of the database for the lexicon, if it exists.
+func (s *SQLiteStore) Path(lexicon string) (string, error) {
+	if err := validateName(lexicon); err != nil {
+		return "", err
+	}
+	path := filepath.Join(s.Dir, lexicon+".db")
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return "", &UnknownLexiconError{Lexicon: lexicon}
+	}
+	return path, nil
+}
+
+// Open opens the lexicon's database. It doesn't create a database for a
+// lexicon that doesn't have one.
+func (s *SQLiteStore) Open(lexicon string) (*sql.DB, error) {
+	path, err := s.Path(lexicon)
+	if err != nil {
+		return nil, err
+	}
+	return sql.Open("sqlite3", path)
+}
+
+func (s *SQLiteStore) Lexica(ctx context.Context) ([]string, error) {
+	entries, err := os.ReadDir(s.Dir)
+	if err != nil {
+		return nil, err
+	}
+	lexica := []string{}
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), ".db") {
+			lexica = append(lexica, strings.TrimSuffix(e.Name(), ".db"))
+		}
+	}
+	sort.Strings(lexica)
+	return lexica, nil
+}
+
+func (s *SQLiteStore) Stamp(ctx context.Context, lexicon string) (string, error) {
+	path, err := s.Path(lexicon)
+	if err != nil {
+		return "", err
+	}
+	return FileStamp(path)
+}
+
+// FileStamp describes a SQLite database and its WAL, so that any write to
+// either changes it.
+func FileStamp(path string) (string, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+	stamp := fmt.Sprintf("%d:%d", fi.Size(), fi.ModTime().UnixNano())
+	if wal, err := os.Stat(path + "-wal"); err == nil {
+		stamp += fmt.Sprintf(":%d:%d", wal.Size(), wal.ModTime().UnixNano())
+	}
+	return stamp, nil
+}
+
+func validateName(lexicon string) error {
+	if lexicon == "" {
+		return errors.New("lexicon not specified")
+	}
+	if strings.ContainsAny(lexicon, `/\`) || strings.Contains(lexicon, "..") {
+		return &UnknownLexiconError{Lexicon: lexicon}
+	}
+	return nil
+}
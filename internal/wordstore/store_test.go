@@ -0,0 +1,60 @@
+package wordstore
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRebind(t *testing.T) {
+	assert.Equal(t, "SELECT 1", rebind("SELECT 1"))
+	assert.Equal(t, "SELECT word FROM words WHERE alphagram IN ($1, $2) LIMIT $3 OFFSET $4",
+		rebind("SELECT word FROM words WHERE alphagram IN (?, ?) LIMIT ? OFFSET ?"))
+	assert.Equal(t, `SELECT '?', 'it''s ?', "a?" FROM t WHERE x = $1`,
+		rebind(`SELECT '?', 'it''s ?', "a?" FROM t WHERE x = ?`))
+}
+
+func TestSQLiteStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	for _, lex := range []string{"NWL20", "CSW21"} {
+		db, err := sql.Open("sqlite3", filepath.Join(dir, lex+".db"))
+		assert.Nil(t, err)
+		_, err = db.Exec(`CREATE TABLE db_version (version integer); INSERT INTO db_version VALUES (5)`)
+		assert.Nil(t, err)
+		db.Close()
+	}
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "notes.txt"), nil, 0644))
+	s := &SQLiteStore{Dir: dir}
+
+	lexica, err := s.Lexica(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"CSW21", "NWL20"}, lexica)
+
+	db, err := s.Open("NWL20")
+	assert.Nil(t, err)
+	var version int
+	assert.Nil(t, db.QueryRow(`SELECT version FROM db_version`).Scan(&version))
+	assert.Equal(t, 5, version)
+
+	stamp, err := s.Stamp(ctx, "NWL20")
+	assert.Nil(t, err)
+	_, err = db.Exec(`UPDATE db_version SET version = 6`)
+	assert.Nil(t, err)
+	db.Close()
+	newStamp, err := s.Stamp(ctx, "NWL20")
+	assert.Nil(t, err)
+	assert.NotEqual(t, stamp, newStamp)
+
+	for _, lex := range []string{"", "FOO", "../NWL20", `a\b`} {
+		_, err := s.Open(lex)
+		assert.NotNil(t, err, lex)
+	}
+	// A missing lexicon doesn't get an empty database made for it.
+	_, err = os.Stat(filepath.Join(dir, "FOO.db"))
+	assert.True(t, os.IsNotExist(err))
+}
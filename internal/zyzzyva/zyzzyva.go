@@ -0,0 +1,120 @@
+// Package zyzzyva reads the word lists and lexicon files of Zyzzyva, so
+// that its users can bring them to this server. Study lists become
+// ordered alphagram list searches, and lexicon files become dbmaker word
+// lists. Zyzzyva's DAWG files can't be read; use the lexicon's text file.
+package zyzzyva
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+// symbols are the characters that Zyzzyva can put after a word in a
+// list, to mark the lexica it's in or that it's new.
+const symbols = "#+$*^&!"
+
+// ReadList reads a word list that Zyzzyva saved, or any text file with a
+// word or alphagram at the start of each line, such as Zyzzyva's one
+// question per line format, which follows each alphagram with its words.
+// Lexicon symbols after an entry are dropped, as are blank lines and
+// lines starting with #. The entries are upper-cased and kept in order,
+// repeats and all.
+func ReadList(r io.Reader) ([]string, error) {
+	entries := []string{}
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		entry := strings.TrimRight(strings.Fields(text)[0], symbols)
+		if entry == "" {
+			return nil, fmt.Errorf("line %d: no word or alphagram", line)
+		}
+		entries = append(entries, strings.ToUpper(entry))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("the list is empty")
+	}
+	return entries, nil
+}
+
+// ListSearch returns the search for the list's alphagrams in the lexicon,
+// in the list's order. It fails with the entries that aren't in the
+// lexicon, if there are any.
+func ListSearch(lexicon string, entries []string, expand bool) *pb.SearchRequest {
+	return &pb.SearchRequest{
+		Searchparams: []*pb.SearchRequest_SearchParam{{
+			Condition: pb.SearchRequest_LEXICON,
+			Conditionparam: &pb.SearchRequest_SearchParam_Stringvalue{
+				Stringvalue: &pb.SearchRequest_StringValue{Value: lexicon}},
+		}, {
+			Condition: pb.SearchRequest_ORDERED_ALPHAGRAM_LIST,
+			Conditionparam: &pb.SearchRequest_SearchParam_Stringarray{
+				Stringarray: &pb.SearchRequest_StringArray{Values: entries}},
+		}},
+		Expand: expand,
+	}
+}
+
+// An Entry is a word in a lexicon file and its definition.
+type Entry struct {
+	Word       string
+	Definition string
+}
+
+// ReadLexicon reads one of Zyzzyva's lexicon text files, with a word and
+// its definition on each line. The definitions are kept as they are:
+// dbmaker knows their conventions, {} and <> for links to other words, []
+// for inflections and / between senses. A word that's listed again
+// replaces the earlier one.
+func ReadLexicon(r io.Reader) ([]Entry, error) {
+	entries := []Entry{}
+	index := map[string]int{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		e := Entry{Word: strings.ToUpper(fields[0]), Definition: strings.Join(fields[1:], " ")}
+		if i, ok := index[e.Word]; ok {
+			entries[i] = e
+			continue
+		}
+		index[e.Word] = len(entries)
+		entries = append(entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("the lexicon is empty")
+	}
+	return entries, nil
+}
+
+// WriteWordList writes the entries as a word list for dbmaker's -wordlist
+// option, or a custom lexicon's file.
+func WriteWordList(w io.Writer, entries []Entry) error {
+	bw := bufio.NewWriter(w)
+	for _, e := range entries {
+		line := e.Word
+		if e.Definition != "" {
+			line += " " + e.Definition
+		}
+		if _, err := fmt.Fprintln(bw, line); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,60 @@
+package zyzzyva
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/domino14/word_db_server/rpc/wordsearcher"
+)
+
+func TestReadList(t *testing.T) {
+	entries, err := ReadList(strings.NewReader(`# saved from Zyzzyva
+retinas#
+  aeinrst RETAINS RETINAS STAINER
+
+QI+
+qi
+`))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"RETINAS", "AEINRST", "QI", "QI"}, entries)
+
+	_, err = ReadList(strings.NewReader("\n# nothing\n"))
+	assert.NotNil(t, err)
+	_, err = ReadList(strings.NewReader("QI\n#+\n++\n"))
+	assert.Equal(t, "line 3: no word or alphagram", err.Error())
+
+	req := ListSearch("NWL23", entries, true)
+	assert.True(t, req.Expand)
+	assert.Equal(t, "NWL23", req.Searchparams[0].GetStringvalue().GetValue())
+	assert.Equal(t, pb.SearchRequest_ORDERED_ALPHAGRAM_LIST, req.Searchparams[1].Condition)
+	assert.Equal(t, entries, req.Searchparams[1].GetStringarray().GetValues())
+}
+
+func TestReadLexicon(t *testing.T) {
+	entries, err := ReadLexicon(strings.NewReader(`aa  a rough, cindery lava [n AAS]
+AAS <aa=n> [n]
+
+QI a vital force [n QIS]
+AA rough lava [n AAS] / a Hawaiian word
+`))
+	assert.Nil(t, err)
+	assert.Equal(t, []Entry{
+		{"AA", "rough lava [n AAS] / a Hawaiian word"},
+		{"AAS", "<aa=n> [n]"},
+		{"QI", "a vital force [n QIS]"},
+	}, entries)
+
+	var b bytes.Buffer
+	assert.Nil(t, WriteWordList(&b, append(entries, Entry{Word: "ZA"})))
+	assert.Equal(t, `AA rough lava [n AAS] / a Hawaiian word
+AAS <aa=n> [n]
+QI a vital force [n QIS]
+ZA
+`, b.String())
+
+	_, err = ReadLexicon(strings.NewReader(""))
+	assert.NotNil(t, err)
+}
@@ -1,3 +1,4 @@
 package rpc
 
-//go:generate protoc --twirp_out=. --twirp_opt=paths=source_relative --go_out=. --go_opt=paths=source_relative ./wordsearcher/searcher.proto
+//go:generate protoc --twirp_out=. --twirp_opt=paths=source_relative --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative,require_unimplemented_servers=false ./wordsearcher/searcher.proto
+//go:generate protoc --twirp_out=. --twirp_opt=paths=source_relative --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative,require_unimplemented_servers=false ./wordsearcher/v2/searcher.proto
@@ -0,0 +1,86 @@
+package wordsearcher
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/klauspost/compress/gzip"
+	"github.com/klauspost/compress/zstd"
+)
+
+// CompressionTransport is an http.RoundTripper that asks the server for
+// responses compressed with zstd or gzip, and decompresses them. Go's own
+// transport only does this for gzip. Use it under the Twirp clients, with
+// NewCompressionClient, to have large searches and expansions sent
+// compressed.
+type CompressionTransport struct {
+	// Base makes the requests; http.DefaultTransport if nil.
+	Base http.RoundTripper
+}
+
+func (t *CompressionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if req.Header.Get("Accept-Encoding") != "" {
+		// The caller will decompress the response itself.
+		return base.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Accept-Encoding", "zstd, gzip")
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	var body io.ReadCloser
+	switch strings.ToLower(resp.Header.Get("Content-Encoding")) {
+	case "zstd":
+		dec, err := zstd.NewReader(resp.Body, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		body = &decodedBody{Reader: dec, closeDecoder: func() error { dec.Close(); return nil },
+			body: resp.Body}
+	case "gzip":
+		dec, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		body = &decodedBody{Reader: dec, closeDecoder: dec.Close, body: resp.Body}
+	default:
+		return resp, nil
+	}
+	resp.Body = body
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return resp, nil
+}
+
+type decodedBody struct {
+	io.Reader
+	closeDecoder func() error
+	body         io.ReadCloser
+}
+
+func (b *decodedBody) Close() error {
+	b.closeDecoder()
+	return b.body.Close()
+}
+
+// NewCompressionClient returns a copy of the client that asks for and
+// decompresses compressed responses. A nil client is taken to be
+// http.DefaultClient.
+func NewCompressionClient(client *http.Client) *http.Client {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	c := *client
+	c.Transport = &CompressionTransport{Base: client.Transport}
+	return &c
+}
@@ -20,6 +20,113 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SearchRequest_SortOrder int32
+
+const (
+	// Sort by probability within each length.
+	SearchRequest_SORT_PROBABILITY SearchRequest_SortOrder = 0
+	// Sort by probability within each (length, number of vowels) bucket.
+	SearchRequest_SORT_VOWEL_PROBABILITY SearchRequest_SortOrder = 1
+)
+
+// Enum value maps for SearchRequest_SortOrder.
+var (
+	SearchRequest_SortOrder_name = map[int32]string{
+		0: "SORT_PROBABILITY",
+		1: "SORT_VOWEL_PROBABILITY",
+	}
+	SearchRequest_SortOrder_value = map[string]int32{
+		"SORT_PROBABILITY":       0,
+		"SORT_VOWEL_PROBABILITY": 1,
+	}
+)
+
+func (x SearchRequest_SortOrder) Enum() *SearchRequest_SortOrder {
+	p := new(SearchRequest_SortOrder)
+	*p = x
+	return p
+}
+
+func (x SearchRequest_SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchRequest_SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_wordsearcher_searcher_proto_enumTypes[0].Descriptor()
+}
+
+func (SearchRequest_SortOrder) Type() protoreflect.EnumType {
+	return &file_wordsearcher_searcher_proto_enumTypes[0]
+}
+
+func (x SearchRequest_SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchRequest_SortOrder.Descriptor instead.
+func (SearchRequest_SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type SearchRequest_WordField int32
+
+const (
+	SearchRequest_DEFINITION      SearchRequest_WordField = 0
+	SearchRequest_FRONT_HOOKS     SearchRequest_WordField = 1
+	SearchRequest_BACK_HOOKS      SearchRequest_WordField = 2
+	SearchRequest_LEXICON_SYMBOLS SearchRequest_WordField = 3
+	// The inner_front_hook and inner_back_hook dots.
+	SearchRequest_INNER_HOOKS SearchRequest_WordField = 4
+	SearchRequest_SOURCES     SearchRequest_WordField = 5
+)
+
+// Enum value maps for SearchRequest_WordField.
+var (
+	SearchRequest_WordField_name = map[int32]string{
+		0: "DEFINITION",
+		1: "FRONT_HOOKS",
+		2: "BACK_HOOKS",
+		3: "LEXICON_SYMBOLS",
+		4: "INNER_HOOKS",
+		5: "SOURCES",
+	}
+	SearchRequest_WordField_value = map[string]int32{
+		"DEFINITION":      0,
+		"FRONT_HOOKS":     1,
+		"BACK_HOOKS":      2,
+		"LEXICON_SYMBOLS": 3,
+		"INNER_HOOKS":     4,
+		"SOURCES":         5,
+	}
+)
+
+func (x SearchRequest_WordField) Enum() *SearchRequest_WordField {
+	p := new(SearchRequest_WordField)
+	*p = x
+	return p
+}
+
+func (x SearchRequest_WordField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchRequest_WordField) Descriptor() protoreflect.EnumDescriptor {
+	return file_wordsearcher_searcher_proto_enumTypes[1].Descriptor()
+}
+
+func (SearchRequest_WordField) Type() protoreflect.EnumType {
+	return &file_wordsearcher_searcher_proto_enumTypes[1]
+}
+
+func (x SearchRequest_WordField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchRequest_WordField.Descriptor instead.
+func (SearchRequest_WordField) EnumDescriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 1}
+}
+
 type SearchRequest_Condition int32
 
 const (
@@ -50,6 +157,95 @@ const (
 	SearchRequest_DIFFICULTY_RANGE  SearchRequest_Condition = 17
 	SearchRequest_PLAYABILITY_RANGE SearchRequest_Condition = 18
 	SearchRequest_DELETED_WORD      SearchRequest_Condition = 19
+	// Hook searches. An alphagram matches if any of its words matches.
+	SearchRequest_NUMBER_OF_FRONT_HOOKS SearchRequest_Condition = 20
+	SearchRequest_NUMBER_OF_BACK_HOOKS  SearchRequest_Condition = 21
+	SearchRequest_FRONT_HOOKS_INCLUDE   SearchRequest_Condition = 22
+	SearchRequest_BACK_HOOKS_INCLUDE    SearchRequest_Condition = 23
+	// Full-text search on definitions. An alphagram matches if any of its
+	// words' definitions contains all of the given terms.
+	SearchRequest_DEFINITION_CONTAINS SearchRequest_Condition = 24
+	// Probability within (length, number of vowels) buckets. See
+	// vowel_probability in Alphagram.
+	SearchRequest_VOWEL_PROBABILITY_RANGE SearchRequest_Condition = 25
+	// Not a filter: returns `count` alphagrams picked at random from the
+	// ones matching the other conditions, in the usual sort order. The
+	// same seed picks the same alphagrams from the same database.
+	SearchRequest_RANDOM_SAMPLE SearchRequest_Condition = 26
+	// Compares this lexicon with another one. See LexiconDiff.
+	SearchRequest_LEXICON_DIFF SearchRequest_Condition = 27
+	// Alphagrams with a word that has the given source flag (stringvalue).
+	// See sources in Word.
+	SearchRequest_WORD_SOURCE SearchRequest_Condition = 28
+	// Combines other conditions with AND, OR or NOT. See Combinator.
+	SearchRequest_COMBINATOR SearchRequest_Condition = 29
+	// Alphagrams that have all of the given letters (stringvalue),
+	// counting repeats: JQXZ, or EE for alphagrams with at least two Es.
+	// Letters are read as the longest tiles they can be; separate them
+	// with spaces or brackets otherwise (R R is two Rs, RR is Spanish RR).
+	SearchRequest_CONTAINS_LETTERS SearchRequest_Condition = 30
+	// Alphagrams that have none of the given letters (stringvalue), e.g.
+	// AEIOU for a vowelless quiz.
+	SearchRequest_EXCLUDES_LETTERS SearchRequest_Condition = 31
+	// Alphagrams whose tiles are between min and max percent vowels
+	// (minmax, from 0 to 100), i.e. num_vowels / length. 40-60 picks
+	// balanced racks.
+	SearchRequest_VOWEL_RATIO SearchRequest_Condition = 32
+	// Alphagrams that make between min and max words one tile longer with
+	// a blank (minmax), for studying bingos with a blank.
+	SearchRequest_NUMBER_OF_BLANK_ANAGRAMS SearchRequest_Condition = 33
+	// Alphagrams with between min and max combinations (minmax64): the
+	// number of ways to draw their tiles from a full bag, which probability
+	// orders are ranked by. Unlike the orders, it can be compared across
+	// lengths and letter distributions.
+	SearchRequest_COMBINATIONS_RANGE SearchRequest_Condition = 34
+	// Alphagrams with a word that has the given tag (stringvalue), such as
+	// a frequency tier like "common", from the lexicon's tags file. Unlike
+	// HAS_TAGS, which are users' own tags, these are the same for everyone.
+	SearchRequest_HAS_TAG SearchRequest_Condition = 35
+	// The given alphagrams (stringarray), returned in the order given
+	// instead of the sort order. Words can be given instead of alphagrams,
+	// for a list exported from a cardbox; they are searched for as their
+	// alphagrams, and repeats are dropped. It can't be combined with
+	// other filters, and the search fails with invalid_argument if any of
+	// them aren't in the lexicon.
+	SearchRequest_ORDERED_ALPHAGRAM_LIST SearchRequest_Condition = 36
+	// Like ORDERED_ALPHAGRAM_LIST, but for a list of (length, probability)
+	// pairs (lengthprobabilities).
+	SearchRequest_ORDERED_PROBABILITY_LIST SearchRequest_Condition = 37
+	// Alphagrams of the words that can be built from some of the given
+	// letters (build), such as RETINAS?, with between min_length and
+	// max_length letters. Like MATCHING_ANAGRAM, the letters may have
+	// blanks (?) and ranges ([AEI]).
+	SearchRequest_BUILD SearchRequest_Condition = 38
+	// Alphagrams with a word that takes an inner hook: a letter that can be
+	// added just inside its first or last letter, as TOAD takes R to make
+	// TROAD. They take no parameter.
+	SearchRequest_HAS_INNER_HOOKS SearchRequest_Condition = 39
+	// Alphagrams with a word that takes no inner hook on either side.
+	SearchRequest_NO_INNER_HOOKS SearchRequest_Condition = 40
+	// Alphagrams with a word that was added to the lexicon in one of its
+	// last n updates (numbervalue): 1 is words that are new in this
+	// version, 2 those new in it or the one before, and so on. Words that
+	// have been in every version dbmaker knew of don't count as added.
+	SearchRequest_ADDED_IN_LAST_UPDATES SearchRequest_Condition = 41
+	// Alphagrams made of the given stem (stringvalue) plus one more tile,
+	// for stem study: SATINE gives the alphagrams of SATINE + ?. The
+	// candidate alphagrams are looked up directly, without a word graph.
+	SearchRequest_STEM_PLUS_ONE SearchRequest_Condition = 42
+	// Like PROBABILITY_LIMIT (minmax), but picks the min-th to max-th
+	// alphagrams of each length in the LENGTH condition, which the search
+	// must have, so "the top 1000 sevens and eights" is one request. The
+	// results are grouped by length, shortest first.
+	SearchRequest_PROBABILITY_LIMIT_PER_LENGTH SearchRequest_Condition = 43
+	// The min-th to max-th most probable alphagrams (minmax) matching the
+	// other conditions, ranked by their number of combinations across all
+	// lengths, most probable first. 1-1000 is the top 1000; 5001-6000 the
+	// next thousand after the first 5000.
+	SearchRequest_MOST_PROBABLE SearchRequest_Condition = 44
+	// Like MOST_PROBABLE, but ranked from the least probable, which comes
+	// first.
+	SearchRequest_LEAST_PROBABLE SearchRequest_Condition = 45
 )
 
 // Enum value maps for SearchRequest_Condition.
@@ -74,27 +270,79 @@ var (
 		17: "DIFFICULTY_RANGE",
 		18: "PLAYABILITY_RANGE",
 		19: "DELETED_WORD",
+		20: "NUMBER_OF_FRONT_HOOKS",
+		21: "NUMBER_OF_BACK_HOOKS",
+		22: "FRONT_HOOKS_INCLUDE",
+		23: "BACK_HOOKS_INCLUDE",
+		24: "DEFINITION_CONTAINS",
+		25: "VOWEL_PROBABILITY_RANGE",
+		26: "RANDOM_SAMPLE",
+		27: "LEXICON_DIFF",
+		28: "WORD_SOURCE",
+		29: "COMBINATOR",
+		30: "CONTAINS_LETTERS",
+		31: "EXCLUDES_LETTERS",
+		32: "VOWEL_RATIO",
+		33: "NUMBER_OF_BLANK_ANAGRAMS",
+		34: "COMBINATIONS_RANGE",
+		35: "HAS_TAG",
+		36: "ORDERED_ALPHAGRAM_LIST",
+		37: "ORDERED_PROBABILITY_LIST",
+		38: "BUILD",
+		39: "HAS_INNER_HOOKS",
+		40: "NO_INNER_HOOKS",
+		41: "ADDED_IN_LAST_UPDATES",
+		42: "STEM_PLUS_ONE",
+		43: "PROBABILITY_LIMIT_PER_LENGTH",
+		44: "MOST_PROBABLE",
+		45: "LEAST_PROBABLE",
 	}
 	SearchRequest_Condition_value = map[string]int32{
-		"LEXICON":             0,
-		"LENGTH":              1,
-		"PROBABILITY_RANGE":   2,
-		"PROBABILITY_LIST":    3,
-		"PROBABILITY_LIMIT":   4,
-		"NUMBER_OF_ANAGRAMS":  5,
-		"NUMBER_OF_VOWELS":    6,
-		"HAS_TAGS":            7,
-		"POINT_VALUE":         8,
-		"MATCHING_ANAGRAM":    9,
-		"ALPHAGRAM_LIST":      10,
-		"NOT_IN_LEXICON":      11,
-		"WORD_LIST":           13,
-		"SINGLE_VALUE_LENGTH": 14,
-		"NUM_TWO_BLANKS":      15,
-		"MAX_SOLUTIONS":       16,
-		"DIFFICULTY_RANGE":    17,
-		"PLAYABILITY_RANGE":   18,
-		"DELETED_WORD":        19,
+		"LEXICON":                      0,
+		"LENGTH":                       1,
+		"PROBABILITY_RANGE":            2,
+		"PROBABILITY_LIST":             3,
+		"PROBABILITY_LIMIT":            4,
+		"NUMBER_OF_ANAGRAMS":           5,
+		"NUMBER_OF_VOWELS":             6,
+		"HAS_TAGS":                     7,
+		"POINT_VALUE":                  8,
+		"MATCHING_ANAGRAM":             9,
+		"ALPHAGRAM_LIST":               10,
+		"NOT_IN_LEXICON":               11,
+		"WORD_LIST":                    13,
+		"SINGLE_VALUE_LENGTH":          14,
+		"NUM_TWO_BLANKS":               15,
+		"MAX_SOLUTIONS":                16,
+		"DIFFICULTY_RANGE":             17,
+		"PLAYABILITY_RANGE":            18,
+		"DELETED_WORD":                 19,
+		"NUMBER_OF_FRONT_HOOKS":        20,
+		"NUMBER_OF_BACK_HOOKS":         21,
+		"FRONT_HOOKS_INCLUDE":          22,
+		"BACK_HOOKS_INCLUDE":           23,
+		"DEFINITION_CONTAINS":          24,
+		"VOWEL_PROBABILITY_RANGE":      25,
+		"RANDOM_SAMPLE":                26,
+		"LEXICON_DIFF":                 27,
+		"WORD_SOURCE":                  28,
+		"COMBINATOR":                   29,
+		"CONTAINS_LETTERS":             30,
+		"EXCLUDES_LETTERS":             31,
+		"VOWEL_RATIO":                  32,
+		"NUMBER_OF_BLANK_ANAGRAMS":     33,
+		"COMBINATIONS_RANGE":           34,
+		"HAS_TAG":                      35,
+		"ORDERED_ALPHAGRAM_LIST":       36,
+		"ORDERED_PROBABILITY_LIST":     37,
+		"BUILD":                        38,
+		"HAS_INNER_HOOKS":              39,
+		"NO_INNER_HOOKS":               40,
+		"ADDED_IN_LAST_UPDATES":        41,
+		"STEM_PLUS_ONE":                42,
+		"PROBABILITY_LIMIT_PER_LENGTH": 43,
+		"MOST_PROBABLE":                44,
+		"LEAST_PROBABLE":               45,
 	}
 )
 
@@ -109,11 +357,11 @@ func (x SearchRequest_Condition) String() string {
 }
 
 func (SearchRequest_Condition) Descriptor() protoreflect.EnumDescriptor {
-	return file_wordsearcher_searcher_proto_enumTypes[0].Descriptor()
+	return file_wordsearcher_searcher_proto_enumTypes[2].Descriptor()
 }
 
 func (SearchRequest_Condition) Type() protoreflect.EnumType {
-	return &file_wordsearcher_searcher_proto_enumTypes[0]
+	return &file_wordsearcher_searcher_proto_enumTypes[2]
 }
 
 func (x SearchRequest_Condition) Number() protoreflect.EnumNumber {
@@ -122,7 +370,7 @@ func (x SearchRequest_Condition) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SearchRequest_Condition.Descriptor instead.
 func (SearchRequest_Condition) EnumDescriptor() ([]byte, []int) {
-	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 0}
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 2}
 }
 
 type SearchRequest_NotInLexCondition int32
@@ -155,11 +403,11 @@ func (x SearchRequest_NotInLexCondition) String() string {
 }
 
 func (SearchRequest_NotInLexCondition) Descriptor() protoreflect.EnumDescriptor {
-	return file_wordsearcher_searcher_proto_enumTypes[1].Descriptor()
+	return file_wordsearcher_searcher_proto_enumTypes[3].Descriptor()
 }
 
 func (SearchRequest_NotInLexCondition) Type() protoreflect.EnumType {
-	return &file_wordsearcher_searcher_proto_enumTypes[1]
+	return &file_wordsearcher_searcher_proto_enumTypes[3]
 }
 
 func (x SearchRequest_NotInLexCondition) Number() protoreflect.EnumNumber {
@@ -168,7 +416,116 @@ func (x SearchRequest_NotInLexCondition) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SearchRequest_NotInLexCondition.Descriptor instead.
 func (SearchRequest_NotInLexCondition) EnumDescriptor() ([]byte, []int) {
-	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 1}
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 3}
+}
+
+// Used for lexicon diff
+type SearchRequest_LexiconDiff_Mode int32
+
+const (
+	// Alphagrams with at least one word that isn't in the other lexicon.
+	SearchRequest_LexiconDiff_NOT_IN_OTHER SearchRequest_LexiconDiff_Mode = 0
+	// Alphagrams whose set of words is different in the other lexicon
+	// (words were added or removed). Alphagrams that are only in the
+	// other lexicon aren't returned; search the other lexicon for those.
+	SearchRequest_LexiconDiff_WORDS_DIFFER SearchRequest_LexiconDiff_Mode = 1
+)
+
+// Enum value maps for SearchRequest_LexiconDiff_Mode.
+var (
+	SearchRequest_LexiconDiff_Mode_name = map[int32]string{
+		0: "NOT_IN_OTHER",
+		1: "WORDS_DIFFER",
+	}
+	SearchRequest_LexiconDiff_Mode_value = map[string]int32{
+		"NOT_IN_OTHER": 0,
+		"WORDS_DIFFER": 1,
+	}
+)
+
+func (x SearchRequest_LexiconDiff_Mode) Enum() *SearchRequest_LexiconDiff_Mode {
+	p := new(SearchRequest_LexiconDiff_Mode)
+	*p = x
+	return p
+}
+
+func (x SearchRequest_LexiconDiff_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchRequest_LexiconDiff_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_wordsearcher_searcher_proto_enumTypes[4].Descriptor()
+}
+
+func (SearchRequest_LexiconDiff_Mode) Type() protoreflect.EnumType {
+	return &file_wordsearcher_searcher_proto_enumTypes[4]
+}
+
+func (x SearchRequest_LexiconDiff_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchRequest_LexiconDiff_Mode.Descriptor instead.
+func (SearchRequest_LexiconDiff_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 10, 0}
+}
+
+// Used for combinator. The top-level search params are ANDed; a
+// combinator lets a search express something like "length 7 AND (no
+// vowels OR point value > 20)". Its params may be combinators too.
+// Only filters can be combined: not lexicon, probability limit, random
+// sample, deleted word or lexicon diff. Lists can't be longer than the
+// server's chunk size.
+type SearchRequest_Combinator_Op int32
+
+const (
+	SearchRequest_Combinator_AND SearchRequest_Combinator_Op = 0
+	SearchRequest_Combinator_OR  SearchRequest_Combinator_Op = 1
+	// NOT takes exactly one param. Alphagrams without the data a
+	// condition needs (such as a NULL difficulty) match neither the
+	// condition nor its NOT.
+	SearchRequest_Combinator_NOT SearchRequest_Combinator_Op = 2
+)
+
+// Enum value maps for SearchRequest_Combinator_Op.
+var (
+	SearchRequest_Combinator_Op_name = map[int32]string{
+		0: "AND",
+		1: "OR",
+		2: "NOT",
+	}
+	SearchRequest_Combinator_Op_value = map[string]int32{
+		"AND": 0,
+		"OR":  1,
+		"NOT": 2,
+	}
+)
+
+func (x SearchRequest_Combinator_Op) Enum() *SearchRequest_Combinator_Op {
+	p := new(SearchRequest_Combinator_Op)
+	*p = x
+	return p
+}
+
+func (x SearchRequest_Combinator_Op) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchRequest_Combinator_Op) Descriptor() protoreflect.EnumDescriptor {
+	return file_wordsearcher_searcher_proto_enumTypes[5].Descriptor()
+}
+
+func (SearchRequest_Combinator_Op) Type() protoreflect.EnumType {
+	return &file_wordsearcher_searcher_proto_enumTypes[5]
+}
+
+func (x SearchRequest_Combinator_Op) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchRequest_Combinator_Op.Descriptor instead.
+func (SearchRequest_Combinator_Op) EnumDescriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 11, 0}
 }
 
 type AnagramRequest_Mode int32
@@ -204,11 +561,11 @@ func (x AnagramRequest_Mode) String() string {
 }
 
 func (AnagramRequest_Mode) Descriptor() protoreflect.EnumDescriptor {
-	return file_wordsearcher_searcher_proto_enumTypes[2].Descriptor()
+	return file_wordsearcher_searcher_proto_enumTypes[6].Descriptor()
 }
 
 func (AnagramRequest_Mode) Type() protoreflect.EnumType {
-	return &file_wordsearcher_searcher_proto_enumTypes[2]
+	return &file_wordsearcher_searcher_proto_enumTypes[6]
 }
 
 func (x AnagramRequest_Mode) Number() protoreflect.EnumNumber {
@@ -220,6 +577,113 @@ func (AnagramRequest_Mode) EnumDescriptor() ([]byte, []int) {
 	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{4, 0}
 }
 
+// These are SQLite's checkpoint modes. PASSIVE never waits for readers
+// or writers, so it may not checkpoint everything. The others wait for
+// them (up to the busy timeout); RESTART and TRUNCATE also wait for
+// readers to be done with the WAL so that it starts over from the
+// beginning, and TRUNCATE truncates the file.
+type CheckpointRequest_Mode int32
+
+const (
+	CheckpointRequest_PASSIVE  CheckpointRequest_Mode = 0
+	CheckpointRequest_FULL     CheckpointRequest_Mode = 1
+	CheckpointRequest_RESTART  CheckpointRequest_Mode = 2
+	CheckpointRequest_TRUNCATE CheckpointRequest_Mode = 3
+)
+
+// Enum value maps for CheckpointRequest_Mode.
+var (
+	CheckpointRequest_Mode_name = map[int32]string{
+		0: "PASSIVE",
+		1: "FULL",
+		2: "RESTART",
+		3: "TRUNCATE",
+	}
+	CheckpointRequest_Mode_value = map[string]int32{
+		"PASSIVE":  0,
+		"FULL":     1,
+		"RESTART":  2,
+		"TRUNCATE": 3,
+	}
+)
+
+func (x CheckpointRequest_Mode) Enum() *CheckpointRequest_Mode {
+	p := new(CheckpointRequest_Mode)
+	*p = x
+	return p
+}
+
+func (x CheckpointRequest_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CheckpointRequest_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_wordsearcher_searcher_proto_enumTypes[7].Descriptor()
+}
+
+func (CheckpointRequest_Mode) Type() protoreflect.EnumType {
+	return &file_wordsearcher_searcher_proto_enumTypes[7]
+}
+
+func (x CheckpointRequest_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CheckpointRequest_Mode.Descriptor instead.
+func (CheckpointRequest_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{23, 0}
+}
+
+type ListOperation_Op int32
+
+const (
+	ListOperation_UNION        ListOperation_Op = 0
+	ListOperation_INTERSECTION ListOperation_Op = 1
+	// The first operand minus everything in the others.
+	ListOperation_DIFFERENCE ListOperation_Op = 2
+)
+
+// Enum value maps for ListOperation_Op.
+var (
+	ListOperation_Op_name = map[int32]string{
+		0: "UNION",
+		1: "INTERSECTION",
+		2: "DIFFERENCE",
+	}
+	ListOperation_Op_value = map[string]int32{
+		"UNION":        0,
+		"INTERSECTION": 1,
+		"DIFFERENCE":   2,
+	}
+)
+
+func (x ListOperation_Op) Enum() *ListOperation_Op {
+	p := new(ListOperation_Op)
+	*p = x
+	return p
+}
+
+func (x ListOperation_Op) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListOperation_Op) Descriptor() protoreflect.EnumDescriptor {
+	return file_wordsearcher_searcher_proto_enumTypes[8].Descriptor()
+}
+
+func (ListOperation_Op) Type() protoreflect.EnumType {
+	return &file_wordsearcher_searcher_proto_enumTypes[8]
+}
+
+func (x ListOperation_Op) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListOperation_Op.Descriptor instead.
+func (ListOperation_Op) EnumDescriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{55, 0}
+}
+
 // An Alphagram encapsulates info about an alphagram, including the words,
 // length, probability, combinations.
 type Alphagram struct {
@@ -237,7 +701,24 @@ type Alphagram struct {
 	Length       int32 `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`
 	Probability  int32 `protobuf:"varint,5,opt,name=probability,proto3" json:"probability,omitempty"`
 	Combinations int64 `protobuf:"varint,6,opt,name=combinations,proto3" json:"combinations,omitempty"`
-	Difficulty   int32 `protobuf:"varint,7,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	// difficulty and playability are 0 if the lexicon has no such data
+	// for this alphagram.
+	Difficulty int32 `protobuf:"varint,7,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	// display_alphagram is the alphagram with any multi-character tiles
+	// surrounded by brackets, e.g. A[CH]O[RR]. It is the same as `alphagram`
+	// for languages without multi-character tiles.
+	DisplayAlphagram string `protobuf:"bytes,8,opt,name=display_alphagram,json=displayAlphagram,proto3" json:"display_alphagram,omitempty"`
+	Playability      int32  `protobuf:"varint,9,opt,name=playability,proto3" json:"playability,omitempty"`
+	// vowel_probability is the probability rank of this alphagram among the
+	// alphagrams with the same length and number of vowels.
+	VowelProbability int32 `protobuf:"varint,10,opt,name=vowel_probability,json=vowelProbability,proto3" json:"vowel_probability,omitempty"`
+	// deleted is set by Expand if every word in this alphagram has been
+	// deleted from the lexicon.
+	Deleted bool `protobuf:"varint,11,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// lexicon is, for Expand, the lexicon of this alphagram if it isn't the
+	// request's, so that a list mixing lexica can be expanded in one call.
+	// Expand returns it as it was sent.
+	Lexicon string `protobuf:"bytes,12,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
 }
 
 func (x *Alphagram) Reset() {
@@ -321,6 +802,41 @@ func (x *Alphagram) GetDifficulty() int32 {
 	return 0
 }
 
+func (x *Alphagram) GetDisplayAlphagram() string {
+	if x != nil {
+		return x.DisplayAlphagram
+	}
+	return ""
+}
+
+func (x *Alphagram) GetPlayability() int32 {
+	if x != nil {
+		return x.Playability
+	}
+	return 0
+}
+
+func (x *Alphagram) GetVowelProbability() int32 {
+	if x != nil {
+		return x.VowelProbability
+	}
+	return 0
+}
+
+func (x *Alphagram) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *Alphagram) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
 // A Word is more than just the string representing the word. It has other
 // info like the definition, hooks, lex symbols, etc.
 type Word struct {
@@ -339,6 +855,14 @@ type Word struct {
 	LexiconSymbols string `protobuf:"bytes,6,opt,name=lexicon_symbols,json=lexiconSymbols,proto3" json:"lexicon_symbols,omitempty"`
 	InnerFrontHook bool   `protobuf:"varint,7,opt,name=inner_front_hook,json=innerFrontHook,proto3" json:"inner_front_hook,omitempty"`
 	InnerBackHook  bool   `protobuf:"varint,8,opt,name=inner_back_hook,json=innerBackHook,proto3" json:"inner_back_hook,omitempty"`
+	// deleted is set for words that are no longer in the lexicon (they're
+	// in the lexicon's deleted words list instead), either by Expand or by a
+	// DELETED_WORD search. Only the word, alphagram and last known
+	// definition are filled in for these.
+	Deleted bool `protobuf:"varint,9,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// sources are the word's source flags, such as a regional addendum it
+	// comes from. Words in the base lexicon have none.
+	Sources []string `protobuf:"bytes,10,rep,name=sources,proto3" json:"sources,omitempty"`
 }
 
 func (x *Word) Reset() {
@@ -429,6 +953,20 @@ func (x *Word) GetInnerBackHook() bool {
 	return false
 }
 
+func (x *Word) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *Word) GetSources() []string {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
 // A SearchRequest encapsulates a number of varied conditions and lets one
 // search for questions.
 type SearchRequest struct {
@@ -438,6 +976,35 @@ type SearchRequest struct {
 
 	Searchparams []*SearchRequest_SearchParam `protobuf:"bytes,1,rep,name=searchparams,proto3" json:"searchparams,omitempty"`
 	Expand       bool                         `protobuf:"varint,2,opt,name=expand,proto3" json:"expand,omitempty"`
+	// sort_order also determines which alphagrams a PROBABILITY_LIMIT picks.
+	SortOrder SearchRequest_SortOrder `protobuf:"varint,3,opt,name=sort_order,json=sortOrder,proto3,enum=wordsearcher.SearchRequest_SortOrder" json:"sort_order,omitempty"`
+	// To page through a search, set pin_snapshot on the first request and
+	// pass the snapshot_id from its response in the requests for the other
+	// pages (and to Expand). They will all use the same lexicon database,
+	// even if it is replaced in the meantime. A snapshot expires if it goes
+	// unused for a while.
+	PinSnapshot bool   `protobuf:"varint,4,opt,name=pin_snapshot,json=pinSnapshot,proto3" json:"pin_snapshot,omitempty"`
+	SnapshotId  string `protobuf:"bytes,5,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	// To page through a search with cursors instead, set page_size, then
+	// pass the next_cursor from each response as the cursor for the next
+	// page. Paged results are ordered by length, then probability, then
+	// alphagram. A cursor is the place of the last alphagram in that order,
+	// so it keeps working across server restarts, and pages neither skip
+	// nor repeat alphagrams if the database is unchanged. Paging can't be
+	// combined with a probability limit, a random sample, a deleted word
+	// search or the vowel probability sort order.
+	PageSize int32  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor   string `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// partial_expand picks the word fields that an unexpanded search fills
+	// in, for clients that need, say, the hooks but not the definitions.
+	// Only those columns are read, so it costs far less than expand. The
+	// alphagrams stay unexpanded (expandedRepr is false), with only their
+	// length and probability. It is ignored if expand is set.
+	PartialExpand []SearchRequest_WordField `protobuf:"varint,8,rep,packed,name=partial_expand,json=partialExpand,proto3,enum=wordsearcher.SearchRequest_WordField" json:"partial_expand,omitempty"`
+	// single_anagram, if set, keeps one word of each alphagram, picked at
+	// random, for single-anagram quizzes. The same seed always picks the
+	// same word of an alphagram, whatever else is searched for with it.
+	SingleAnagram *SearchRequest_SingleAnagram `protobuf:"bytes,9,opt,name=single_anagram,json=singleAnagram,proto3" json:"single_anagram,omitempty"`
 }
 
 func (x *SearchRequest) Reset() {
@@ -486,6 +1053,55 @@ func (x *SearchRequest) GetExpand() bool {
 	return false
 }
 
+func (x *SearchRequest) GetSortOrder() SearchRequest_SortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return SearchRequest_SORT_PROBABILITY
+}
+
+func (x *SearchRequest) GetPinSnapshot() bool {
+	if x != nil {
+		return x.PinSnapshot
+	}
+	return false
+}
+
+func (x *SearchRequest) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetPartialExpand() []SearchRequest_WordField {
+	if x != nil {
+		return x.PartialExpand
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetSingleAnagram() *SearchRequest_SingleAnagram {
+	if x != nil {
+		return x.SingleAnagram
+	}
+	return nil
+}
+
 type SearchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -493,6 +1109,21 @@ type SearchResponse struct {
 
 	Alphagrams []*Alphagram `protobuf:"bytes,1,rep,name=alphagrams,proto3" json:"alphagrams,omitempty"`
 	Lexicon    string       `protobuf:"bytes,2,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	// The snapshot that this response came from, if one was pinned or
+	// requested.
+	SnapshotId string `protobuf:"bytes,3,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	// For Expand only: if either of these is set, only the alphagrams at
+	// these indexes, or with these alphagrams, are expanded. The others are
+	// returned as they were sent.
+	ExpandIndexes    []int32  `protobuf:"varint,4,rep,packed,name=expand_indexes,json=expandIndexes,proto3" json:"expand_indexes,omitempty"`
+	ExpandAlphagrams []string `protobuf:"bytes,5,rep,name=expand_alphagrams,json=expandAlphagrams,proto3" json:"expand_alphagrams,omitempty"`
+	// The cursor for the next page of a paged search, or empty if this is
+	// the last page.
+	NextCursor string `protobuf:"bytes,6,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// Whether the search matched more alphagrams than the server returns,
+	// and was cut off. Narrow the search, or page through it, to get the
+	// rest.
+	Truncated bool `protobuf:"varint,7,opt,name=truncated,proto3" json:"truncated,omitempty"`
 }
 
 func (x *SearchResponse) Reset() {
@@ -541,20 +1172,55 @@ func (x *SearchResponse) GetLexicon() string {
 	return ""
 }
 
-type AnagramRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *SearchResponse) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
 
-	Lexicon string              `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
-	Letters string              `protobuf:"bytes,2,opt,name=letters,proto3" json:"letters,omitempty"`
-	Mode    AnagramRequest_Mode `protobuf:"varint,3,opt,name=mode,proto3,enum=wordsearcher.AnagramRequest_Mode" json:"mode,omitempty"`
-	Expand  bool                `protobuf:"varint,4,opt,name=expand,proto3" json:"expand,omitempty"` // Whether to provide hooks, defos, ec.
+func (x *SearchResponse) GetExpandIndexes() []int32 {
+	if x != nil {
+		return x.ExpandIndexes
+	}
+	return nil
 }
 
-func (x *AnagramRequest) Reset() {
-	*x = AnagramRequest{}
-	if protoimpl.UnsafeEnabled {
+func (x *SearchResponse) GetExpandAlphagrams() []string {
+	if x != nil {
+		return x.ExpandAlphagrams
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *SearchResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type AnagramRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lexicon string              `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	Letters string              `protobuf:"bytes,2,opt,name=letters,proto3" json:"letters,omitempty"`
+	Mode    AnagramRequest_Mode `protobuf:"varint,3,opt,name=mode,proto3,enum=wordsearcher.AnagramRequest_Mode" json:"mode,omitempty"`
+	Expand  bool                `protobuf:"varint,4,opt,name=expand,proto3" json:"expand,omitempty"` // Whether to provide hooks, defos, ec.
+}
+
+func (x *AnagramRequest) Reset() {
+	*x = AnagramRequest{}
+	if protoimpl.UnsafeEnabled {
 		mi := &file_wordsearcher_searcher_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
@@ -833,21 +1499,20 @@ func (x *BuildChallengeCreateRequest) GetRequireLengthSolution() bool {
 	return false
 }
 
-type WordSearchRequest struct {
+type LexiconMetadataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Lexicon string `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
-	// the only acceptable glob characters are * and ?. These get mapped
-	// to SQLITE % and _, respectively.
-	Glob string `protobuf:"bytes,2,opt,name=glob,proto3" json:"glob,omitempty"`
-	// Which field the glob applies to (word or definition?)
-	AppliesTo string `protobuf:"bytes,3,opt,name=applies_to,json=appliesTo,proto3" json:"applies_to,omitempty"`
+	// Optional: the locale, like es or pt-BR, to give the symbols' labels
+	// and descriptions in, from the server's translations file. Anything
+	// without a translation is in English.
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
 }
 
-func (x *WordSearchRequest) Reset() {
-	*x = WordSearchRequest{}
+func (x *LexiconMetadataRequest) Reset() {
+	*x = LexiconMetadataRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_wordsearcher_searcher_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -855,13 +1520,13 @@ func (x *WordSearchRequest) Reset() {
 	}
 }
 
-func (x *WordSearchRequest) String() string {
+func (x *LexiconMetadataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WordSearchRequest) ProtoMessage() {}
+func (*LexiconMetadataRequest) ProtoMessage() {}
 
-func (x *WordSearchRequest) ProtoReflect() protoreflect.Message {
+func (x *LexiconMetadataRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_wordsearcher_searcher_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -873,43 +1538,47 @@ func (x *WordSearchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WordSearchRequest.ProtoReflect.Descriptor instead.
-func (*WordSearchRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LexiconMetadataRequest.ProtoReflect.Descriptor instead.
+func (*LexiconMetadataRequest) Descriptor() ([]byte, []int) {
 	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *WordSearchRequest) GetLexicon() string {
+func (x *LexiconMetadataRequest) GetLexicon() string {
 	if x != nil {
 		return x.Lexicon
 	}
 	return ""
 }
 
-func (x *WordSearchRequest) GetGlob() string {
-	if x != nil {
-		return x.Glob
-	}
-	return ""
-}
-
-func (x *WordSearchRequest) GetAppliesTo() string {
+func (x *LexiconMetadataRequest) GetLocale() string {
 	if x != nil {
-		return x.AppliesTo
+		return x.Locale
 	}
 	return ""
 }
 
-type DefineRequest struct {
+type LexiconMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Lexicon string `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
-	Word    string `protobuf:"bytes,2,opt,name=word,proto3" json:"word,omitempty"`
-}
-
-func (x *DefineRequest) Reset() {
-	*x = DefineRequest{}
+	// family and descriptive_name are empty for databases that were made
+	// before this metadata was stored.
+	Family          string                         `protobuf:"bytes,2,opt,name=family,proto3" json:"family,omitempty"`
+	DescriptiveName string                         `protobuf:"bytes,3,opt,name=descriptive_name,json=descriptiveName,proto3" json:"descriptive_name,omitempty"`
+	DbVersion       int32                          `protobuf:"varint,4,opt,name=db_version,json=dbVersion,proto3" json:"db_version,omitempty"`
+	LengthCounts    []*LexiconMetadata_LengthCount `protobuf:"bytes,5,rep,name=length_counts,json=lengthCounts,proto3" json:"length_counts,omitempty"`
+	// The letter distribution; the blank is the tile with letter `?`.
+	LetterDistribution []*LexiconMetadata_Tile          `protobuf:"bytes,6,rep,name=letter_distribution,json=letterDistribution,proto3" json:"letter_distribution,omitempty"`
+	LexiconSymbols     []*LexiconMetadata_LexiconSymbol `protobuf:"bytes,7,rep,name=lexicon_symbols,json=lexiconSymbols,proto3" json:"lexicon_symbols,omitempty"`
+	// The optional data this lexicon has (difficulty, playability,
+	// definitions).
+	Capabilities []string `protobuf:"bytes,8,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+}
+
+func (x *LexiconMetadata) Reset() {
+	*x = LexiconMetadata{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_wordsearcher_searcher_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -917,13 +1586,13 @@ func (x *DefineRequest) Reset() {
 	}
 }
 
-func (x *DefineRequest) String() string {
+func (x *LexiconMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DefineRequest) ProtoMessage() {}
+func (*LexiconMetadata) ProtoMessage() {}
 
-func (x *DefineRequest) ProtoReflect() protoreflect.Message {
+func (x *LexiconMetadata) ProtoReflect() protoreflect.Message {
 	mi := &file_wordsearcher_searcher_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -935,35 +1604,77 @@ func (x *DefineRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DefineRequest.ProtoReflect.Descriptor instead.
-func (*DefineRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LexiconMetadata.ProtoReflect.Descriptor instead.
+func (*LexiconMetadata) Descriptor() ([]byte, []int) {
 	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DefineRequest) GetLexicon() string {
+func (x *LexiconMetadata) GetLexicon() string {
 	if x != nil {
 		return x.Lexicon
 	}
 	return ""
 }
 
-func (x *DefineRequest) GetWord() string {
+func (x *LexiconMetadata) GetFamily() string {
 	if x != nil {
-		return x.Word
+		return x.Family
 	}
 	return ""
 }
 
-type WordSearchResponse struct {
+func (x *LexiconMetadata) GetDescriptiveName() string {
+	if x != nil {
+		return x.DescriptiveName
+	}
+	return ""
+}
+
+func (x *LexiconMetadata) GetDbVersion() int32 {
+	if x != nil {
+		return x.DbVersion
+	}
+	return 0
+}
+
+func (x *LexiconMetadata) GetLengthCounts() []*LexiconMetadata_LengthCount {
+	if x != nil {
+		return x.LengthCounts
+	}
+	return nil
+}
+
+func (x *LexiconMetadata) GetLetterDistribution() []*LexiconMetadata_Tile {
+	if x != nil {
+		return x.LetterDistribution
+	}
+	return nil
+}
+
+func (x *LexiconMetadata) GetLexiconSymbols() []*LexiconMetadata_LexiconSymbol {
+	if x != nil {
+		return x.LexiconSymbols
+	}
+	return nil
+}
+
+func (x *LexiconMetadata) GetCapabilities() []string {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+type LexiconStatsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Words []*Word `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`
+	Lexicon string `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
 }
 
-func (x *WordSearchResponse) Reset() {
-	*x = WordSearchResponse{}
+func (x *LexiconStatsRequest) Reset() {
+	*x = LexiconStatsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_wordsearcher_searcher_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -971,13 +1682,13 @@ func (x *WordSearchResponse) Reset() {
 	}
 }
 
-func (x *WordSearchResponse) String() string {
+func (x *LexiconStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WordSearchResponse) ProtoMessage() {}
+func (*LexiconStatsRequest) ProtoMessage() {}
 
-func (x *WordSearchResponse) ProtoReflect() protoreflect.Message {
+func (x *LexiconStatsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_wordsearcher_searcher_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -989,31 +1700,34 @@ func (x *WordSearchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WordSearchResponse.ProtoReflect.Descriptor instead.
-func (*WordSearchResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LexiconStatsRequest.ProtoReflect.Descriptor instead.
+func (*LexiconStatsRequest) Descriptor() ([]byte, []int) {
 	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *WordSearchResponse) GetWords() []*Word {
+func (x *LexiconStatsRequest) GetLexicon() string {
 	if x != nil {
-		return x.Words
+		return x.Lexicon
 	}
-	return nil
+	return ""
 }
 
-type SearchRequest_MinMax struct {
+// LexiconStats has counts of a lexicon's alphagrams and words, which dbmaker
+// works out when it builds the database.
+type LexiconStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Used for length, prob range, prob limit, num anagrams,
-	// num_vowels, point value
-	Min int32 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
-	Max int32 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+	Lexicon string `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	// Totals over all of the lengths.
+	Totals        *LexiconStats_LengthStats    `protobuf:"bytes,2,opt,name=totals,proto3" json:"totals,omitempty"`
+	Lengths       []*LexiconStats_LengthStats  `protobuf:"bytes,3,rep,name=lengths,proto3" json:"lengths,omitempty"`
+	AnagramCounts []*LexiconStats_AnagramCount `protobuf:"bytes,4,rep,name=anagram_counts,json=anagramCounts,proto3" json:"anagram_counts,omitempty"`
 }
 
-func (x *SearchRequest_MinMax) Reset() {
-	*x = SearchRequest_MinMax{}
+func (x *LexiconStats) Reset() {
+	*x = LexiconStats{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_wordsearcher_searcher_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1021,13 +1735,13 @@ func (x *SearchRequest_MinMax) Reset() {
 	}
 }
 
-func (x *SearchRequest_MinMax) String() string {
+func (x *LexiconStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchRequest_MinMax) ProtoMessage() {}
+func (*LexiconStats) ProtoMessage() {}
 
-func (x *SearchRequest_MinMax) ProtoReflect() protoreflect.Message {
+func (x *LexiconStats) ProtoReflect() protoreflect.Message {
 	mi := &file_wordsearcher_searcher_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1039,36 +1753,50 @@ func (x *SearchRequest_MinMax) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchRequest_MinMax.ProtoReflect.Descriptor instead.
-func (*SearchRequest_MinMax) Descriptor() ([]byte, []int) {
-	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 0}
+// Deprecated: Use LexiconStats.ProtoReflect.Descriptor instead.
+func (*LexiconStats) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SearchRequest_MinMax) GetMin() int32 {
+func (x *LexiconStats) GetLexicon() string {
 	if x != nil {
-		return x.Min
+		return x.Lexicon
 	}
-	return 0
+	return ""
 }
 
-func (x *SearchRequest_MinMax) GetMax() int32 {
+func (x *LexiconStats) GetTotals() *LexiconStats_LengthStats {
 	if x != nil {
-		return x.Max
+		return x.Totals
 	}
-	return 0
+	return nil
 }
 
-type SearchRequest_StringValue struct {
+func (x *LexiconStats) GetLengths() []*LexiconStats_LengthStats {
+	if x != nil {
+		return x.Lengths
+	}
+	return nil
+}
+
+func (x *LexiconStats) GetAnagramCounts() []*LexiconStats_AnagramCount {
+	if x != nil {
+		return x.AnagramCounts
+	}
+	return nil
+}
+
+type SchemaInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Used for lexicon, matching anagram, not_in_lexicon
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// Leave this empty to get every lexicon being served.
+	Lexicon string `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
 }
 
-func (x *SearchRequest_StringValue) Reset() {
-	*x = SearchRequest_StringValue{}
+func (x *SchemaInfoRequest) Reset() {
+	*x = SchemaInfoRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_wordsearcher_searcher_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1076,13 +1804,13 @@ func (x *SearchRequest_StringValue) Reset() {
 	}
 }
 
-func (x *SearchRequest_StringValue) String() string {
+func (x *SchemaInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchRequest_StringValue) ProtoMessage() {}
+func (*SchemaInfoRequest) ProtoMessage() {}
 
-func (x *SearchRequest_StringValue) ProtoReflect() protoreflect.Message {
+func (x *SchemaInfoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_wordsearcher_searcher_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1094,29 +1822,35 @@ func (x *SearchRequest_StringValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchRequest_StringValue.ProtoReflect.Descriptor instead.
-func (*SearchRequest_StringValue) Descriptor() ([]byte, []int) {
-	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 1}
+// Deprecated: Use SchemaInfoRequest.ProtoReflect.Descriptor instead.
+func (*SchemaInfoRequest) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *SearchRequest_StringValue) GetValue() string {
+func (x *SchemaInfoRequest) GetLexicon() string {
 	if x != nil {
-		return x.Value
+		return x.Lexicon
 	}
 	return ""
 }
 
-type SearchRequest_StringArray struct {
+type SchemaInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Used for alphagram_list
-	Values []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	Lexicon string `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	Version int32  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Only databases made or migrated after migrations started being
+	// recorded have these.
+	Migrations []*SchemaInfo_Migration `protobuf:"bytes,3,rep,name=migrations,proto3" json:"migrations,omitempty"`
+	Tables     []*SchemaInfo_Table     `protobuf:"bytes,4,rep,name=tables,proto3" json:"tables,omitempty"`
+	// Set if the database couldn't be read; the other fields are then empty.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *SearchRequest_StringArray) Reset() {
-	*x = SearchRequest_StringArray{}
+func (x *SchemaInfo) Reset() {
+	*x = SchemaInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_wordsearcher_searcher_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1124,13 +1858,13 @@ func (x *SearchRequest_StringArray) Reset() {
 	}
 }
 
-func (x *SearchRequest_StringArray) String() string {
+func (x *SchemaInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchRequest_StringArray) ProtoMessage() {}
+func (*SchemaInfo) ProtoMessage() {}
 
-func (x *SearchRequest_StringArray) ProtoReflect() protoreflect.Message {
+func (x *SchemaInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_wordsearcher_searcher_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1142,29 +1876,58 @@ func (x *SearchRequest_StringArray) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchRequest_StringArray.ProtoReflect.Descriptor instead.
-func (*SearchRequest_StringArray) Descriptor() ([]byte, []int) {
-	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 2}
+// Deprecated: Use SchemaInfo.ProtoReflect.Descriptor instead.
+func (*SchemaInfo) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *SearchRequest_StringArray) GetValues() []string {
+func (x *SchemaInfo) GetLexicon() string {
 	if x != nil {
-		return x.Values
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *SchemaInfo) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SchemaInfo) GetMigrations() []*SchemaInfo_Migration {
+	if x != nil {
+		return x.Migrations
 	}
 	return nil
 }
 
-type SearchRequest_NumberArray struct {
+func (x *SchemaInfo) GetTables() []*SchemaInfo_Table {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+func (x *SchemaInfo) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SchemaInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Used for prob list
-	Values []int32 `protobuf:"varint,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+	// The schema version that this server's dbmaker creates.
+	LatestVersion int32         `protobuf:"varint,1,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	Lexica        []*SchemaInfo `protobuf:"bytes,2,rep,name=lexica,proto3" json:"lexica,omitempty"`
 }
 
-func (x *SearchRequest_NumberArray) Reset() {
-	*x = SearchRequest_NumberArray{}
+func (x *SchemaInfoResponse) Reset() {
+	*x = SchemaInfoResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_wordsearcher_searcher_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1172,13 +1935,13 @@ func (x *SearchRequest_NumberArray) Reset() {
 	}
 }
 
-func (x *SearchRequest_NumberArray) String() string {
+func (x *SchemaInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchRequest_NumberArray) ProtoMessage() {}
+func (*SchemaInfoResponse) ProtoMessage() {}
 
-func (x *SearchRequest_NumberArray) ProtoReflect() protoreflect.Message {
+func (x *SchemaInfoResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_wordsearcher_searcher_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1190,28 +1953,40 @@ func (x *SearchRequest_NumberArray) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchRequest_NumberArray.ProtoReflect.Descriptor instead.
-func (*SearchRequest_NumberArray) Descriptor() ([]byte, []int) {
-	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 3}
+// Deprecated: Use SchemaInfoResponse.ProtoReflect.Descriptor instead.
+func (*SchemaInfoResponse) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *SearchRequest_NumberArray) GetValues() []int32 {
+func (x *SchemaInfoResponse) GetLatestVersion() int32 {
 	if x != nil {
-		return x.Values
+		return x.LatestVersion
+	}
+	return 0
+}
+
+func (x *SchemaInfoResponse) GetLexica() []*SchemaInfo {
+	if x != nil {
+		return x.Lexica
 	}
 	return nil
 }
 
-type SearchRequest_NumberValue struct {
+type SearchSchemaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value int32 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	// Optional: a lexicon to check the conditions against, and to include
+	// the schema of.
+	Lexicon string `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	// Optional: the locale to give the conditions' descriptions in, as in
+	// LexiconMetadataRequest.
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
 }
 
-func (x *SearchRequest_NumberValue) Reset() {
-	*x = SearchRequest_NumberValue{}
+func (x *SearchSchemaRequest) Reset() {
+	*x = SearchSchemaRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_wordsearcher_searcher_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1219,13 +1994,13 @@ func (x *SearchRequest_NumberValue) Reset() {
 	}
 }
 
-func (x *SearchRequest_NumberValue) String() string {
+func (x *SearchSchemaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchRequest_NumberValue) ProtoMessage() {}
+func (*SearchSchemaRequest) ProtoMessage() {}
 
-func (x *SearchRequest_NumberValue) ProtoReflect() protoreflect.Message {
+func (x *SearchSchemaRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_wordsearcher_searcher_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1237,35 +2012,39 @@ func (x *SearchRequest_NumberValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchRequest_NumberValue.ProtoReflect.Descriptor instead.
-func (*SearchRequest_NumberValue) Descriptor() ([]byte, []int) {
-	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 4}
+// Deprecated: Use SearchSchemaRequest.ProtoReflect.Descriptor instead.
+func (*SearchSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *SearchRequest_NumberValue) GetValue() int32 {
+func (x *SearchSchemaRequest) GetLexicon() string {
 	if x != nil {
-		return x.Value
+		return x.Lexicon
 	}
-	return 0
+	return ""
 }
 
-type SearchRequest_SearchParam struct {
+func (x *SearchSchemaRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// SearchSchema describes the search conditions, so that clients can build
+// their condition pickers from it.
+type SearchSchema struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Condition SearchRequest_Condition `protobuf:"varint,1,opt,name=condition,proto3,enum=wordsearcher.SearchRequest_Condition" json:"condition,omitempty"`
-	// Types that are assignable to Conditionparam:
-	//	*SearchRequest_SearchParam_Minmax
-	//	*SearchRequest_SearchParam_Stringvalue
-	//	*SearchRequest_SearchParam_Stringarray
-	//	*SearchRequest_SearchParam_Numberarray
-	//	*SearchRequest_SearchParam_Numbervalue
-	Conditionparam isSearchRequest_SearchParam_Conditionparam `protobuf_oneof:"conditionparam"`
+	Conditions []*SearchSchema_Condition `protobuf:"bytes,1,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	// The schema of the lexicon asked about.
+	Schema *SchemaInfo `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
 }
 
-func (x *SearchRequest_SearchParam) Reset() {
-	*x = SearchRequest_SearchParam{}
+func (x *SearchSchema) Reset() {
+	*x = SearchSchema{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_wordsearcher_searcher_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1273,13 +2052,13 @@ func (x *SearchRequest_SearchParam) Reset() {
 	}
 }
 
-func (x *SearchRequest_SearchParam) String() string {
+func (x *SearchSchema) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchRequest_SearchParam) ProtoMessage() {}
+func (*SearchSchema) ProtoMessage() {}
 
-func (x *SearchRequest_SearchParam) ProtoReflect() protoreflect.Message {
+func (x *SearchSchema) ProtoReflect() protoreflect.Message {
 	mi := &file_wordsearcher_searcher_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1291,401 +2070,7719 @@ func (x *SearchRequest_SearchParam) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchRequest_SearchParam.ProtoReflect.Descriptor instead.
-func (*SearchRequest_SearchParam) Descriptor() ([]byte, []int) {
-	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 5}
+// Deprecated: Use SearchSchema.ProtoReflect.Descriptor instead.
+func (*SearchSchema) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SearchRequest_SearchParam) GetCondition() SearchRequest_Condition {
+func (x *SearchSchema) GetConditions() []*SearchSchema_Condition {
 	if x != nil {
-		return x.Condition
+		return x.Conditions
 	}
-	return SearchRequest_LEXICON
+	return nil
 }
 
-func (m *SearchRequest_SearchParam) GetConditionparam() isSearchRequest_SearchParam_Conditionparam {
-	if m != nil {
-		return m.Conditionparam
+func (x *SearchSchema) GetSchema() *SchemaInfo {
+	if x != nil {
+		return x.Schema
 	}
 	return nil
 }
 
-func (x *SearchRequest_SearchParam) GetMinmax() *SearchRequest_MinMax {
-	if x, ok := x.GetConditionparam().(*SearchRequest_SearchParam_Minmax); ok {
-		return x.Minmax
+type LetterDistributionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lexicon string `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+}
+
+func (x *LetterDistributionRequest) Reset() {
+	*x = LetterDistributionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *SearchRequest_SearchParam) GetStringvalue() *SearchRequest_StringValue {
-	if x, ok := x.GetConditionparam().(*SearchRequest_SearchParam_Stringvalue); ok {
-		return x.Stringvalue
+func (x *LetterDistributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LetterDistributionRequest) ProtoMessage() {}
+
+func (x *LetterDistributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *SearchRequest_SearchParam) GetStringarray() *SearchRequest_StringArray {
-	if x, ok := x.GetConditionparam().(*SearchRequest_SearchParam_Stringarray); ok {
-		return x.Stringarray
+// Deprecated: Use LetterDistributionRequest.ProtoReflect.Descriptor instead.
+func (*LetterDistributionRequest) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *LetterDistributionRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
 	}
-	return nil
+	return ""
 }
 
-func (x *SearchRequest_SearchParam) GetNumberarray() *SearchRequest_NumberArray {
-	if x, ok := x.GetConditionparam().(*SearchRequest_SearchParam_Numberarray); ok {
-		return x.Numberarray
+// LetterDistribution is the bag of tiles a lexicon is played with, so front
+// ends can draw racks and score words.
+type LetterDistribution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lexicon string `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	// The distribution's name, such as english.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The tiles in the distribution's order; the blank is the tile with
+	// letter `?`.
+	Tiles []*LexiconMetadata_Tile `protobuf:"bytes,3,rep,name=tiles,proto3" json:"tiles,omitempty"`
+	// The number of tiles in the bag, blanks included.
+	TotalTiles int32 `protobuf:"varint,4,opt,name=total_tiles,json=totalTiles,proto3" json:"total_tiles,omitempty"`
+}
+
+func (x *LetterDistribution) Reset() {
+	*x = LetterDistribution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *SearchRequest_SearchParam) GetNumbervalue() *SearchRequest_NumberValue {
-	if x, ok := x.GetConditionparam().(*SearchRequest_SearchParam_Numbervalue); ok {
-		return x.Numbervalue
+func (x *LetterDistribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LetterDistribution) ProtoMessage() {}
+
+func (x *LetterDistribution) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LetterDistribution.ProtoReflect.Descriptor instead.
+func (*LetterDistribution) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *LetterDistribution) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *LetterDistribution) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LetterDistribution) GetTiles() []*LexiconMetadata_Tile {
+	if x != nil {
+		return x.Tiles
 	}
 	return nil
 }
 
-type isSearchRequest_SearchParam_Conditionparam interface {
-	isSearchRequest_SearchParam_Conditionparam()
+func (x *LetterDistribution) GetTotalTiles() int32 {
+	if x != nil {
+		return x.TotalTiles
+	}
+	return 0
 }
 
-type SearchRequest_SearchParam_Minmax struct {
-	Minmax *SearchRequest_MinMax `protobuf:"bytes,2,opt,name=minmax,proto3,oneof"`
+type RackValidationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lexicon string `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	// The rack, with `?` for a blank. Lowercase letters are designated
+	// blanks and count as blanks.
+	Rack string `protobuf:"bytes,2,opt,name=rack,proto3" json:"rack,omitempty"`
 }
 
-type SearchRequest_SearchParam_Stringvalue struct {
-	Stringvalue *SearchRequest_StringValue `protobuf:"bytes,3,opt,name=stringvalue,proto3,oneof"`
+func (x *RackValidationRequest) Reset() {
+	*x = RackValidationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type SearchRequest_SearchParam_Stringarray struct {
-	Stringarray *SearchRequest_StringArray `protobuf:"bytes,4,opt,name=stringarray,proto3,oneof"`
+func (x *RackValidationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type SearchRequest_SearchParam_Numberarray struct {
-	Numberarray *SearchRequest_NumberArray `protobuf:"bytes,5,opt,name=numberarray,proto3,oneof"`
+func (*RackValidationRequest) ProtoMessage() {}
+
+func (x *RackValidationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type SearchRequest_SearchParam_Numbervalue struct {
-	Numbervalue *SearchRequest_NumberValue `protobuf:"bytes,6,opt,name=numbervalue,proto3,oneof"`
+// Deprecated: Use RackValidationRequest.ProtoReflect.Descriptor instead.
+func (*RackValidationRequest) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{19}
 }
 
-func (*SearchRequest_SearchParam_Minmax) isSearchRequest_SearchParam_Conditionparam() {}
+func (x *RackValidationRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
 
-func (*SearchRequest_SearchParam_Stringvalue) isSearchRequest_SearchParam_Conditionparam() {}
+func (x *RackValidationRequest) GetRack() string {
+	if x != nil {
+		return x.Rack
+	}
+	return ""
+}
 
-func (*SearchRequest_SearchParam_Stringarray) isSearchRequest_SearchParam_Conditionparam() {}
+type RackValidationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (*SearchRequest_SearchParam_Numberarray) isSearchRequest_SearchParam_Conditionparam() {}
+	// valid is true if the whole rack can be drawn from one bag.
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// The tiles that appear more often in the rack than in the bag. Blanks
+	// in the rack are only counted against the blanks in the bag; they don't
+	// make up for other tiles.
+	ExcessTiles []*RackValidationResponse_ExcessTile `protobuf:"bytes,2,rep,name=excess_tiles,json=excessTiles,proto3" json:"excess_tiles,omitempty"`
+}
 
-func (*SearchRequest_SearchParam_Numbervalue) isSearchRequest_SearchParam_Conditionparam() {}
+func (x *RackValidationResponse) Reset() {
+	*x = RackValidationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-var File_wordsearcher_searcher_proto protoreflect.FileDescriptor
+func (x *RackValidationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-var file_wordsearcher_searcher_proto_rawDesc = []byte{
-	0x0a, 0x1b, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2f, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x77,
-	0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x22, 0xf5, 0x01, 0x0a, 0x09,
-	0x41, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x28, 0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x64,
-	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x65, 0x70,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65,
-	0x64, 0x52, 0x65, 0x70, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x20, 0x0a,
-	0x0b, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
-	0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74,
-	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75,
-	0x6c, 0x74, 0x79, 0x22, 0x93, 0x02, 0x0a, 0x04, 0x57, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64,
-	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x1e,
-	0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
-	0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12,
-	0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x63, 0x6b, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x27,
-	0x0a, 0x0f, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
-	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e,
-	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x6e, 0x6e, 0x65, 0x72,
-	0x5f, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0e, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x48, 0x6f, 0x6f,
-	0x6b, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x5f,
-	0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x6e, 0x65,
-	0x72, 0x42, 0x61, 0x63, 0x6b, 0x48, 0x6f, 0x6f, 0x6b, 0x22, 0xdb, 0x09, 0x0a, 0x0d, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x0c, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x27, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72,
-	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x0c, 0x73, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x61,
-	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64,
-	0x1a, 0x2c, 0x0a, 0x06, 0x4d, 0x69, 0x6e, 0x4d, 0x61, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03,
-	0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x1a, 0x23,
-	0x0a, 0x0b, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x1a, 0x25, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x72,
-	0x61, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x25, 0x0a, 0x0b, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x1a, 0x23, 0x0a, 0x0b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0xd6, 0x03, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x43, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x77, 0x6f, 0x72, 0x64,
-	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x06, 0x6d,
-	0x69, 0x6e, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x6f,
-	0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x69, 0x6e, 0x4d, 0x61, 0x78, 0x48,
-	0x00, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x6d, 0x61, 0x78, 0x12, 0x4b, 0x0a, 0x0b, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
-	0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4b, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x61, 0x72, 0x72, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x6f,
-	0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41,
-	0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x61, 0x72,
-	0x72, 0x61, 0x79, 0x12, 0x4b, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x61, 0x72, 0x72,
-	0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x72, 0x72, 0x61,
-	0x79, 0x48, 0x00, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x61, 0x72, 0x72, 0x61, 0x79,
-	0x12, 0x4b, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00,
-	0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x10, 0x0a,
-	0x0e, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x22,
-	0x87, 0x03, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0b, 0x0a,
-	0x07, 0x4c, 0x45, 0x58, 0x49, 0x43, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x45,
-	0x4e, 0x47, 0x54, 0x48, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x4f, 0x42, 0x41, 0x42,
-	0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x02, 0x12, 0x14, 0x0a,
-	0x10, 0x50, 0x52, 0x4f, 0x42, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x49, 0x53,
-	0x54, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x4f, 0x42, 0x41, 0x42, 0x49, 0x4c, 0x49,
-	0x54, 0x59, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x4e, 0x55,
-	0x4d, 0x42, 0x45, 0x52, 0x5f, 0x4f, 0x46, 0x5f, 0x41, 0x4e, 0x41, 0x47, 0x52, 0x41, 0x4d, 0x53,
-	0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x4f, 0x46, 0x5f,
-	0x56, 0x4f, 0x57, 0x45, 0x4c, 0x53, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x48, 0x41, 0x53, 0x5f,
-	0x54, 0x41, 0x47, 0x53, 0x10, 0x07, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f,
-	0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x08, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x41, 0x54, 0x43, 0x48,
-	0x49, 0x4e, 0x47, 0x5f, 0x41, 0x4e, 0x41, 0x47, 0x52, 0x41, 0x4d, 0x10, 0x09, 0x12, 0x12, 0x0a,
-	0x0e, 0x41, 0x4c, 0x50, 0x48, 0x41, 0x47, 0x52, 0x41, 0x4d, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x10,
-	0x0a, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x4c, 0x45, 0x58, 0x49,
-	0x43, 0x4f, 0x4e, 0x10, 0x0b, 0x12, 0x0d, 0x0a, 0x09, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x4c, 0x49,
-	0x53, 0x54, 0x10, 0x0d, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x49, 0x4e, 0x47, 0x4c, 0x45, 0x5f, 0x56,
-	0x41, 0x4c, 0x55, 0x45, 0x5f, 0x4c, 0x45, 0x4e, 0x47, 0x54, 0x48, 0x10, 0x0e, 0x12, 0x12, 0x0a,
-	0x0e, 0x4e, 0x55, 0x4d, 0x5f, 0x54, 0x57, 0x4f, 0x5f, 0x42, 0x4c, 0x41, 0x4e, 0x4b, 0x53, 0x10,
-	0x0f, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x41, 0x58, 0x5f, 0x53, 0x4f, 0x4c, 0x55, 0x54, 0x49, 0x4f,
-	0x4e, 0x53, 0x10, 0x10, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c,
-	0x54, 0x59, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x11, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x4c,
-	0x41, 0x59, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10,
-	0x12, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x5f, 0x57, 0x4f, 0x52,
-	0x44, 0x10, 0x13, 0x22, 0x04, 0x08, 0x0c, 0x10, 0x0c, 0x22, 0x3c, 0x0a, 0x11, 0x4e, 0x6f, 0x74,
-	0x49, 0x6e, 0x4c, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x11,
-	0x0a, 0x0d, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x45, 0x4e, 0x47, 0x4c, 0x49, 0x53, 0x48, 0x10,
-	0x00, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x52, 0x45, 0x56, 0x49, 0x4f, 0x55, 0x53, 0x5f, 0x56, 0x45,
-	0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x22, 0x63, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41, 0x6c, 0x70,
-	0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x0a, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x22, 0xbc, 0x01, 0x0a,
-	0x0e, 0x41, 0x6e, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x74,
-	0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x74, 0x74,
-	0x65, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x21, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72,
-	0x2e, 0x41, 0x6e, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
-	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78,
-	0x70, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x70, 0x61,
-	0x6e, 0x64, 0x22, 0x27, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x58,
-	0x41, 0x43, 0x54, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x10, 0x01,
-	0x12, 0x09, 0x0a, 0x05, 0x53, 0x55, 0x50, 0x45, 0x52, 0x10, 0x02, 0x22, 0x58, 0x0a, 0x0f, 0x41,
-	0x6e, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
-	0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x57, 0x6f, 0x72,
-	0x64, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f,
-	0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x75, 0x6d,
-	0x57, 0x6f, 0x72, 0x64, 0x73, 0x22, 0xcd, 0x01, 0x0a, 0x1b, 0x42, 0x6c, 0x61, 0x6e, 0x6b, 0x43,
-	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12,
-	0x23, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x6f, 0x6c, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x61, 0x78,
-	0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x11, 0x6e, 0x75, 0x6d,
-	0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x32, 0x5f, 0x62, 0x6c, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x57, 0x69, 0x74, 0x68, 0x32, 0x42, 0x6c,
-	0x61, 0x6e, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x6c, 0x65, 0x6e,
-	0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x64, 0x4c,
-	0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0xf7, 0x01, 0x0a, 0x1b, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x43,
-	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12,
-	0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x53, 0x6f, 0x6c, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x6f, 0x6c, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x61, 0x78,
-	0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e,
-	0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d,
-	0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f,
-	0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61,
-	0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x65, 0x71, 0x75, 0x69,
-	0x72, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x5f, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
-	0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x60, 0x0a, 0x11, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x12,
-	0x0a, 0x04, 0x67, 0x6c, 0x6f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x6c,
-	0x6f, 0x62, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x5f, 0x74, 0x6f,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x54,
-	0x6f, 0x22, 0x3d, 0x0a, 0x0d, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64,
-	0x22, 0x3e, 0x0a, 0x12, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x65, 0x72, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73,
-	0x32, 0x9d, 0x01, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12,
-	0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x77,
-	0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x06, 0x45, 0x78,
-	0x70, 0x61, 0x6e, 0x64, 0x12, 0x1c, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x1a, 0x1c, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
-	0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x32, 0x98, 0x02, 0x0a, 0x0a, 0x41, 0x6e, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x12,
-	0x46, 0x0a, 0x07, 0x41, 0x6e, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x1c, 0x2e, 0x77, 0x6f, 0x72,
-	0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41, 0x6e, 0x61, 0x67, 0x72, 0x61,
-	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41, 0x6e, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x15, 0x42, 0x6c, 0x61, 0x6e, 0x6b,
-	0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
-	0x12, 0x29, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e,
-	0x42, 0x6c, 0x61, 0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x77, 0x6f,
-	0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x15, 0x42, 0x75, 0x69,
-	0x6c, 0x64, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x6f, 0x72, 0x12, 0x29, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
-	0x72, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xb4, 0x01, 0x0a, 0x0c,
-	0x57, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x12, 0x53, 0x0a, 0x12,
-	0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
-	0x72, 0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x20, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x57,
-	0x6f, 0x72, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x4f, 0x0a, 0x0a, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12,
-	0x1f, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x57,
-	0x6f, 0x72, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x20, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e,
-	0x57, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x64, 0x6f, 0x6d, 0x69, 0x6e, 0x6f, 0x31, 0x34, 0x2f, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x64,
-	0x62, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x77, 0x6f, 0x72,
-	0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+func (*RackValidationResponse) ProtoMessage() {}
+
+func (x *RackValidationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var (
-	file_wordsearcher_searcher_proto_rawDescOnce sync.Once
-	file_wordsearcher_searcher_proto_rawDescData = file_wordsearcher_searcher_proto_rawDesc
-)
+// Deprecated: Use RackValidationResponse.ProtoReflect.Descriptor instead.
+func (*RackValidationResponse) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{20}
+}
 
-func file_wordsearcher_searcher_proto_rawDescGZIP() []byte {
-	file_wordsearcher_searcher_proto_rawDescOnce.Do(func() {
-		file_wordsearcher_searcher_proto_rawDescData = protoimpl.X.CompressGZIP(file_wordsearcher_searcher_proto_rawDescData)
-	})
-	return file_wordsearcher_searcher_proto_rawDescData
+func (x *RackValidationResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
 }
 
-var file_wordsearcher_searcher_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_wordsearcher_searcher_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
-var file_wordsearcher_searcher_proto_goTypes = []interface{}{
-	(SearchRequest_Condition)(0),         // 0: wordsearcher.SearchRequest.Condition
-	(SearchRequest_NotInLexCondition)(0), // 1: wordsearcher.SearchRequest.NotInLexCondition
-	(AnagramRequest_Mode)(0),             // 2: wordsearcher.AnagramRequest.Mode
-	(*Alphagram)(nil),                    // 3: wordsearcher.Alphagram
-	(*Word)(nil),                         // 4: wordsearcher.Word
-	(*SearchRequest)(nil),                // 5: wordsearcher.SearchRequest
-	(*SearchResponse)(nil),               // 6: wordsearcher.SearchResponse
-	(*AnagramRequest)(nil),               // 7: wordsearcher.AnagramRequest
-	(*AnagramResponse)(nil),              // 8: wordsearcher.AnagramResponse
-	(*BlankChallengeCreateRequest)(nil),  // 9: wordsearcher.BlankChallengeCreateRequest
-	(*BuildChallengeCreateRequest)(nil),  // 10: wordsearcher.BuildChallengeCreateRequest
-	(*WordSearchRequest)(nil),            // 11: wordsearcher.WordSearchRequest
-	(*DefineRequest)(nil),                // 12: wordsearcher.DefineRequest
-	(*WordSearchResponse)(nil),           // 13: wordsearcher.WordSearchResponse
-	(*SearchRequest_MinMax)(nil),         // 14: wordsearcher.SearchRequest.MinMax
-	(*SearchRequest_StringValue)(nil),    // 15: wordsearcher.SearchRequest.StringValue
-	(*SearchRequest_StringArray)(nil),    // 16: wordsearcher.SearchRequest.StringArray
-	(*SearchRequest_NumberArray)(nil),    // 17: wordsearcher.SearchRequest.NumberArray
-	(*SearchRequest_NumberValue)(nil),    // 18: wordsearcher.SearchRequest.NumberValue
-	(*SearchRequest_SearchParam)(nil),    // 19: wordsearcher.SearchRequest.SearchParam
+func (x *RackValidationResponse) GetExcessTiles() []*RackValidationResponse_ExcessTile {
+	if x != nil {
+		return x.ExcessTiles
+	}
+	return nil
 }
-var file_wordsearcher_searcher_proto_depIdxs = []int32{
-	4,  // 0: wordsearcher.Alphagram.words:type_name -> wordsearcher.Word
-	19, // 1: wordsearcher.SearchRequest.searchparams:type_name -> wordsearcher.SearchRequest.SearchParam
-	3,  // 2: wordsearcher.SearchResponse.alphagrams:type_name -> wordsearcher.Alphagram
-	2,  // 3: wordsearcher.AnagramRequest.mode:type_name -> wordsearcher.AnagramRequest.Mode
-	4,  // 4: wordsearcher.AnagramResponse.words:type_name -> wordsearcher.Word
-	4,  // 5: wordsearcher.WordSearchResponse.words:type_name -> wordsearcher.Word
-	0,  // 6: wordsearcher.SearchRequest.SearchParam.condition:type_name -> wordsearcher.SearchRequest.Condition
-	14, // 7: wordsearcher.SearchRequest.SearchParam.minmax:type_name -> wordsearcher.SearchRequest.MinMax
-	15, // 8: wordsearcher.SearchRequest.SearchParam.stringvalue:type_name -> wordsearcher.SearchRequest.StringValue
-	16, // 9: wordsearcher.SearchRequest.SearchParam.stringarray:type_name -> wordsearcher.SearchRequest.StringArray
-	17, // 10: wordsearcher.SearchRequest.SearchParam.numberarray:type_name -> wordsearcher.SearchRequest.NumberArray
-	18, // 11: wordsearcher.SearchRequest.SearchParam.numbervalue:type_name -> wordsearcher.SearchRequest.NumberValue
-	5,  // 12: wordsearcher.QuestionSearcher.Search:input_type -> wordsearcher.SearchRequest
-	6,  // 13: wordsearcher.QuestionSearcher.Expand:input_type -> wordsearcher.SearchResponse
-	7,  // 14: wordsearcher.Anagrammer.Anagram:input_type -> wordsearcher.AnagramRequest
-	9,  // 15: wordsearcher.Anagrammer.BlankChallengeCreator:input_type -> wordsearcher.BlankChallengeCreateRequest
-	10, // 16: wordsearcher.Anagrammer.BuildChallengeCreator:input_type -> wordsearcher.BuildChallengeCreateRequest
-	12, // 17: wordsearcher.WordSearcher.GetWordInformation:input_type -> wordsearcher.DefineRequest
-	11, // 18: wordsearcher.WordSearcher.WordSearch:input_type -> wordsearcher.WordSearchRequest
-	6,  // 19: wordsearcher.QuestionSearcher.Search:output_type -> wordsearcher.SearchResponse
-	6,  // 20: wordsearcher.QuestionSearcher.Expand:output_type -> wordsearcher.SearchResponse
-	8,  // 21: wordsearcher.Anagrammer.Anagram:output_type -> wordsearcher.AnagramResponse
-	6,  // 22: wordsearcher.Anagrammer.BlankChallengeCreator:output_type -> wordsearcher.SearchResponse
-	6,  // 23: wordsearcher.Anagrammer.BuildChallengeCreator:output_type -> wordsearcher.SearchResponse
-	13, // 24: wordsearcher.WordSearcher.GetWordInformation:output_type -> wordsearcher.WordSearchResponse
-	13, // 25: wordsearcher.WordSearcher.WordSearch:output_type -> wordsearcher.WordSearchResponse
-	19, // [19:26] is the sub-list for method output_type
-	12, // [12:19] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+
+type DefinitionUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lexicon string                                      `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	Updates []*DefinitionUpdateRequest_DefinitionUpdate `protobuf:"bytes,2,rep,name=updates,proto3" json:"updates,omitempty"`
+	// Who is making the change, for the audit log.
+	Author string `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
 }
 
-func init() { file_wordsearcher_searcher_proto_init() }
-func file_wordsearcher_searcher_proto_init() {
-	if File_wordsearcher_searcher_proto != nil {
-		return
+func (x *DefinitionUpdateRequest) Reset() {
+	*x = DefinitionUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_wordsearcher_searcher_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Alphagram); i {
+}
+
+func (x *DefinitionUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefinitionUpdateRequest) ProtoMessage() {}
+
+func (x *DefinitionUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefinitionUpdateRequest.ProtoReflect.Descriptor instead.
+func (*DefinitionUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DefinitionUpdateRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *DefinitionUpdateRequest) GetUpdates() []*DefinitionUpdateRequest_DefinitionUpdate {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+func (x *DefinitionUpdateRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+type DefinitionUpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of definitions that changed.
+	NumUpdated int32 `protobuf:"varint,1,opt,name=num_updated,json=numUpdated,proto3" json:"num_updated,omitempty"`
+	// Words that aren't in the lexicon. Nothing is done for these.
+	NotFound []string `protobuf:"bytes,2,rep,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+}
+
+func (x *DefinitionUpdateResponse) Reset() {
+	*x = DefinitionUpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DefinitionUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefinitionUpdateResponse) ProtoMessage() {}
+
+func (x *DefinitionUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefinitionUpdateResponse.ProtoReflect.Descriptor instead.
+func (*DefinitionUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DefinitionUpdateResponse) GetNumUpdated() int32 {
+	if x != nil {
+		return x.NumUpdated
+	}
+	return 0
+}
+
+func (x *DefinitionUpdateResponse) GetNotFound() []string {
+	if x != nil {
+		return x.NotFound
+	}
+	return nil
+}
+
+type CheckpointRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lexicon string                 `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	Mode    CheckpointRequest_Mode `protobuf:"varint,2,opt,name=mode,proto3,enum=wordsearcher.CheckpointRequest_Mode" json:"mode,omitempty"`
+}
+
+func (x *CheckpointRequest) Reset() {
+	*x = CheckpointRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckpointRequest) ProtoMessage() {}
+
+func (x *CheckpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckpointRequest.ProtoReflect.Descriptor instead.
+func (*CheckpointRequest) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CheckpointRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *CheckpointRequest) GetMode() CheckpointRequest_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return CheckpointRequest_PASSIVE
+}
+
+type CheckpointResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// wal is false if the database isn't in WAL mode. There is nothing to
+	// checkpoint then, and the other fields are unset.
+	Wal bool `protobuf:"varint,1,opt,name=wal,proto3" json:"wal,omitempty"`
+	// busy is set if the checkpoint couldn't finish because of other
+	// readers or writers.
+	Busy bool `protobuf:"varint,2,opt,name=busy,proto3" json:"busy,omitempty"`
+	// The number of frames in the WAL, and how many of them have been
+	// written back to the database.
+	LogFrames          int32 `protobuf:"varint,3,opt,name=log_frames,json=logFrames,proto3" json:"log_frames,omitempty"`
+	CheckpointedFrames int32 `protobuf:"varint,4,opt,name=checkpointed_frames,json=checkpointedFrames,proto3" json:"checkpointed_frames,omitempty"`
+}
+
+func (x *CheckpointResponse) Reset() {
+	*x = CheckpointResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckpointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckpointResponse) ProtoMessage() {}
+
+func (x *CheckpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckpointResponse.ProtoReflect.Descriptor instead.
+func (*CheckpointResponse) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CheckpointResponse) GetWal() bool {
+	if x != nil {
+		return x.Wal
+	}
+	return false
+}
+
+func (x *CheckpointResponse) GetBusy() bool {
+	if x != nil {
+		return x.Busy
+	}
+	return false
+}
+
+func (x *CheckpointResponse) GetLogFrames() int32 {
+	if x != nil {
+		return x.LogFrames
+	}
+	return 0
+}
+
+func (x *CheckpointResponse) GetCheckpointedFrames() int32 {
+	if x != nil {
+		return x.CheckpointedFrames
+	}
+	return 0
+}
+
+type WordJudgeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lexicon string `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	// The words to judge; for a challenged play, every word the play forms.
+	Words []string `protobuf:"bytes,2,rep,name=words,proto3" json:"words,omitempty"`
+}
+
+func (x *WordJudgeRequest) Reset() {
+	*x = WordJudgeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WordJudgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordJudgeRequest) ProtoMessage() {}
+
+func (x *WordJudgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordJudgeRequest.ProtoReflect.Descriptor instead.
+func (*WordJudgeRequest) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *WordJudgeRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *WordJudgeRequest) GetWords() []string {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+type WordJudgeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// In the same order as the request.
+	Words []*WordJudgeResponse_JudgedWord `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`
+	// acceptable is true if every word is valid, i.e. the play stands.
+	Acceptable bool `protobuf:"varint,2,opt,name=acceptable,proto3" json:"acceptable,omitempty"`
+}
+
+func (x *WordJudgeResponse) Reset() {
+	*x = WordJudgeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WordJudgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordJudgeResponse) ProtoMessage() {}
+
+func (x *WordJudgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordJudgeResponse.ProtoReflect.Descriptor instead.
+func (*WordJudgeResponse) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *WordJudgeResponse) GetWords() []*WordJudgeResponse_JudgedWord {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *WordJudgeResponse) GetAcceptable() bool {
+	if x != nil {
+		return x.Acceptable
+	}
+	return false
+}
+
+type HookTreeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lexicon string `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	Word    string `protobuf:"bytes,2,opt,name=word,proto3" json:"word,omitempty"`
+	// How many hooks deep to go; 2 if not given, and at most 7.
+	Depth int32 `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	// The most words in the tree; 1000 if not given, and at most 10000.
+	MaxNodes int32 `protobuf:"varint,4,opt,name=max_nodes,json=maxNodes,proto3" json:"max_nodes,omitempty"`
+}
+
+func (x *HookTreeRequest) Reset() {
+	*x = HookTreeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HookTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HookTreeRequest) ProtoMessage() {}
+
+func (x *HookTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HookTreeRequest.ProtoReflect.Descriptor instead.
+func (*HookTreeRequest) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *HookTreeRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *HookTreeRequest) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *HookTreeRequest) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *HookTreeRequest) GetMaxNodes() int32 {
+	if x != nil {
+		return x.MaxNodes
+	}
+	return 0
+}
+
+// A HookNode is a word and the words made by hooking a letter onto it.
+type HookNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Word string `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	// The letter hooked onto the parent word to make this one. The root has
+	// none.
+	Hook string `protobuf:"bytes,2,opt,name=hook,proto3" json:"hook,omitempty"`
+	// front is true if the hook went on the front of the parent word.
+	Front bool `protobuf:"varint,3,opt,name=front,proto3" json:"front,omitempty"`
+	// Front hooks first, then back hooks, in alphabet order.
+	Children []*HookNode `protobuf:"bytes,4,rep,name=children,proto3" json:"children,omitempty"`
+	// repeated is set if the word is already in the tree, closer to the
+	// root or earlier at the same depth (SCATS is both CATS with a front
+	// hook and SCAT with a back hook). Its children are only given the first
+	// time.
+	Repeated bool `protobuf:"varint,5,opt,name=repeated,proto3" json:"repeated,omitempty"`
+}
+
+func (x *HookNode) Reset() {
+	*x = HookNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HookNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HookNode) ProtoMessage() {}
+
+func (x *HookNode) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HookNode.ProtoReflect.Descriptor instead.
+func (*HookNode) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *HookNode) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *HookNode) GetHook() string {
+	if x != nil {
+		return x.Hook
+	}
+	return ""
+}
+
+func (x *HookNode) GetFront() bool {
+	if x != nil {
+		return x.Front
+	}
+	return false
+}
+
+func (x *HookNode) GetChildren() []*HookNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *HookNode) GetRepeated() bool {
+	if x != nil {
+		return x.Repeated
+	}
+	return false
+}
+
+type HookTreeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Root *HookNode `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// truncated is set if the tree has more words than max_nodes. The tree
+	// is filled in a depth at a time, so the words that are left out are
+	// all at least as deep as the deepest ones given.
+	Truncated bool `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (x *HookTreeResponse) Reset() {
+	*x = HookTreeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HookTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HookTreeResponse) ProtoMessage() {}
+
+func (x *HookTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HookTreeResponse.ProtoReflect.Descriptor instead.
+func (*HookTreeResponse) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *HookTreeResponse) GetRoot() *HookNode {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *HookTreeResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type CreateCardboxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The user the cardbox belongs to. Each user's cardboxes are kept apart.
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The search whose results become the cards. It isn't expanded; the
+	// cards keep each question's alphagram and words.
+	Search *SearchRequest `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+}
+
+func (x *CreateCardboxRequest) Reset() {
+	*x = CreateCardboxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCardboxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCardboxRequest) ProtoMessage() {}
+
+func (x *CreateCardboxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCardboxRequest.ProtoReflect.Descriptor instead.
+func (*CreateCardboxRequest) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateCardboxRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *CreateCardboxRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCardboxRequest) GetSearch() *SearchRequest {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+type CreateCardboxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardboxId int64 `protobuf:"varint,1,opt,name=cardbox_id,json=cardboxId,proto3" json:"cardbox_id,omitempty"`
+	NumCards  int32 `protobuf:"varint,2,opt,name=num_cards,json=numCards,proto3" json:"num_cards,omitempty"`
+}
+
+func (x *CreateCardboxResponse) Reset() {
+	*x = CreateCardboxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCardboxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCardboxResponse) ProtoMessage() {}
+
+func (x *CreateCardboxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCardboxResponse.ProtoReflect.Descriptor instead.
+func (*CreateCardboxResponse) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateCardboxResponse) GetCardboxId() int64 {
+	if x != nil {
+		return x.CardboxId
+	}
+	return 0
+}
+
+func (x *CreateCardboxResponse) GetNumCards() int32 {
+	if x != nil {
+		return x.NumCards
+	}
+	return 0
+}
+
+type Card struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alphagram string `protobuf:"bytes,1,opt,name=alphagram,proto3" json:"alphagram,omitempty"`
+	// The Leitner box: 0 for new or missed cards, and one more for every
+	// correct answer in a row.
+	Box int32 `protobuf:"varint,2,opt,name=box,proto3" json:"box,omitempty"`
+	// Unix time at which the card is next due.
+	Due          int64 `protobuf:"varint,3,opt,name=due,proto3" json:"due,omitempty"`
+	NumCorrect   int32 `protobuf:"varint,4,opt,name=num_correct,json=numCorrect,proto3" json:"num_correct,omitempty"`
+	NumIncorrect int32 `protobuf:"varint,5,opt,name=num_incorrect,json=numIncorrect,proto3" json:"num_incorrect,omitempty"`
+	// Only set if the cards were requested expanded.
+	Expanded *Alphagram `protobuf:"bytes,6,opt,name=expanded,proto3" json:"expanded,omitempty"`
+}
+
+func (x *Card) Reset() {
+	*x = Card{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Card) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Card) ProtoMessage() {}
+
+func (x *Card) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Card.ProtoReflect.Descriptor instead.
+func (*Card) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Card) GetAlphagram() string {
+	if x != nil {
+		return x.Alphagram
+	}
+	return ""
+}
+
+func (x *Card) GetBox() int32 {
+	if x != nil {
+		return x.Box
+	}
+	return 0
+}
+
+func (x *Card) GetDue() int64 {
+	if x != nil {
+		return x.Due
+	}
+	return 0
+}
+
+func (x *Card) GetNumCorrect() int32 {
+	if x != nil {
+		return x.NumCorrect
+	}
+	return 0
+}
+
+func (x *Card) GetNumIncorrect() int32 {
+	if x != nil {
+		return x.NumIncorrect
+	}
+	return 0
+}
+
+func (x *Card) GetExpanded() *Alphagram {
+	if x != nil {
+		return x.Expanded
+	}
+	return nil
+}
+
+type RecordAnswerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User      string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	CardboxId int64  `protobuf:"varint,2,opt,name=cardbox_id,json=cardboxId,proto3" json:"cardbox_id,omitempty"`
+	Alphagram string `protobuf:"bytes,3,opt,name=alphagram,proto3" json:"alphagram,omitempty"`
+	Correct   bool   `protobuf:"varint,4,opt,name=correct,proto3" json:"correct,omitempty"`
+}
+
+func (x *RecordAnswerRequest) Reset() {
+	*x = RecordAnswerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordAnswerRequest) ProtoMessage() {}
+
+func (x *RecordAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordAnswerRequest.ProtoReflect.Descriptor instead.
+func (*RecordAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RecordAnswerRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *RecordAnswerRequest) GetCardboxId() int64 {
+	if x != nil {
+		return x.CardboxId
+	}
+	return 0
+}
+
+func (x *RecordAnswerRequest) GetAlphagram() string {
+	if x != nil {
+		return x.Alphagram
+	}
+	return ""
+}
+
+func (x *RecordAnswerRequest) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+type RecordAnswerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Card *Card `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+}
+
+func (x *RecordAnswerResponse) Reset() {
+	*x = RecordAnswerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordAnswerResponse) ProtoMessage() {}
+
+func (x *RecordAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordAnswerResponse.ProtoReflect.Descriptor instead.
+func (*RecordAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RecordAnswerResponse) GetCard() *Card {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+type DueCardsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User      string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	CardboxId int64  `protobuf:"varint,2,opt,name=cardbox_id,json=cardboxId,proto3" json:"cardbox_id,omitempty"`
+	// The most cards to return; 0 returns all of the due cards.
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Expand the cards' alphagrams, as QuestionSearcher.Expand does.
+	Expand bool `protobuf:"varint,4,opt,name=expand,proto3" json:"expand,omitempty"`
+}
+
+func (x *DueCardsRequest) Reset() {
+	*x = DueCardsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DueCardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DueCardsRequest) ProtoMessage() {}
+
+func (x *DueCardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DueCardsRequest.ProtoReflect.Descriptor instead.
+func (*DueCardsRequest) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DueCardsRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *DueCardsRequest) GetCardboxId() int64 {
+	if x != nil {
+		return x.CardboxId
+	}
+	return 0
+}
+
+func (x *DueCardsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *DueCardsRequest) GetExpand() bool {
+	if x != nil {
+		return x.Expand
+	}
+	return false
+}
+
+type DueCardsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lexicon string `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	// The due cards, the longest overdue first.
+	Cards []*Card `protobuf:"bytes,2,rep,name=cards,proto3" json:"cards,omitempty"`
+	// How many cards are due in all, which may be more than were returned.
+	NumDue int32 `protobuf:"varint,3,opt,name=num_due,json=numDue,proto3" json:"num_due,omitempty"`
+}
+
+func (x *DueCardsResponse) Reset() {
+	*x = DueCardsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DueCardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DueCardsResponse) ProtoMessage() {}
+
+func (x *DueCardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DueCardsResponse.ProtoReflect.Descriptor instead.
+func (*DueCardsResponse) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DueCardsResponse) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *DueCardsResponse) GetCards() []*Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *DueCardsResponse) GetNumDue() int32 {
+	if x != nil {
+		return x.NumDue
+	}
+	return 0
+}
+
+type ImportHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// The name of the cardbox to create.
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Lexicon string `protobuf:"bytes,3,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	// Quiz history as CSV with a header row. Only a question (or alphagram)
+	// column is required; cardbox (or box), next_scheduled (or due),
+	// correct and incorrect are used if present. Due times can be Unix
+	// times, RFC 3339 times or dates. Zyzzyva's cardboxes can be exported
+	// from its quiz databases with
+	//   sqlite3 -header -csv Anagrams.db 'SELECT * FROM questions'
+	Csv []byte `protobuf:"bytes,4,opt,name=csv,proto3" json:"csv,omitempty"`
+}
+
+func (x *ImportHistoryRequest) Reset() {
+	*x = ImportHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportHistoryRequest) ProtoMessage() {}
+
+func (x *ImportHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ImportHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ImportHistoryRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ImportHistoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportHistoryRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *ImportHistoryRequest) GetCsv() []byte {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+type ImportHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardboxId int64 `protobuf:"varint,1,opt,name=cardbox_id,json=cardboxId,proto3" json:"cardbox_id,omitempty"`
+	NumCards  int32 `protobuf:"varint,2,opt,name=num_cards,json=numCards,proto3" json:"num_cards,omitempty"`
+	// Questions that aren't alphagrams in the lexicon. They are left out.
+	NotFound []string `protobuf:"bytes,3,rep,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+}
+
+func (x *ImportHistoryResponse) Reset() {
+	*x = ImportHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportHistoryResponse) ProtoMessage() {}
+
+func (x *ImportHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ImportHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ImportHistoryResponse) GetCardboxId() int64 {
+	if x != nil {
+		return x.CardboxId
+	}
+	return 0
+}
+
+func (x *ImportHistoryResponse) GetNumCards() int32 {
+	if x != nil {
+		return x.NumCards
+	}
+	return 0
+}
+
+func (x *ImportHistoryResponse) GetNotFound() []string {
+	if x != nil {
+		return x.NotFound
+	}
+	return nil
+}
+
+type DeleteCardboxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User      string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	CardboxId int64  `protobuf:"varint,2,opt,name=cardbox_id,json=cardboxId,proto3" json:"cardbox_id,omitempty"`
+}
+
+func (x *DeleteCardboxRequest) Reset() {
+	*x = DeleteCardboxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCardboxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCardboxRequest) ProtoMessage() {}
+
+func (x *DeleteCardboxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCardboxRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCardboxRequest) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DeleteCardboxRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *DeleteCardboxRequest) GetCardboxId() int64 {
+	if x != nil {
+		return x.CardboxId
+	}
+	return 0
+}
+
+type DeleteCardboxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unix time until which the cardbox can be restored.
+	RestorableUntil int64 `protobuf:"varint,1,opt,name=restorable_until,json=restorableUntil,proto3" json:"restorable_until,omitempty"`
+}
+
+func (x *DeleteCardboxResponse) Reset() {
+	*x = DeleteCardboxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCardboxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCardboxResponse) ProtoMessage() {}
+
+func (x *DeleteCardboxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCardboxResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCardboxResponse) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteCardboxResponse) GetRestorableUntil() int64 {
+	if x != nil {
+		return x.RestorableUntil
+	}
+	return 0
+}
+
+type RestoreCardboxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User      string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	CardboxId int64  `protobuf:"varint,2,opt,name=cardbox_id,json=cardboxId,proto3" json:"cardbox_id,omitempty"`
+}
+
+func (x *RestoreCardboxRequest) Reset() {
+	*x = RestoreCardboxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreCardboxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCardboxRequest) ProtoMessage() {}
+
+func (x *RestoreCardboxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCardboxRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCardboxRequest) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RestoreCardboxRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *RestoreCardboxRequest) GetCardboxId() int64 {
+	if x != nil {
+		return x.CardboxId
+	}
+	return 0
+}
+
+type RestoreCardboxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardboxId int64  `protobuf:"varint,1,opt,name=cardbox_id,json=cardboxId,proto3" json:"cardbox_id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Lexicon   string `protobuf:"bytes,3,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+}
+
+func (x *RestoreCardboxResponse) Reset() {
+	*x = RestoreCardboxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreCardboxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCardboxResponse) ProtoMessage() {}
+
+func (x *RestoreCardboxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCardboxResponse.ProtoReflect.Descriptor instead.
+func (*RestoreCardboxResponse) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RestoreCardboxResponse) GetCardboxId() int64 {
+	if x != nil {
+		return x.CardboxId
+	}
+	return 0
+}
+
+func (x *RestoreCardboxResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RestoreCardboxResponse) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+type DeletedCardboxesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *DeletedCardboxesRequest) Reset() {
+	*x = DeletedCardboxesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletedCardboxesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletedCardboxesRequest) ProtoMessage() {}
+
+func (x *DeletedCardboxesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletedCardboxesRequest.ProtoReflect.Descriptor instead.
+func (*DeletedCardboxesRequest) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DeletedCardboxesRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type DeletedCardbox struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardboxId int64  `protobuf:"varint,1,opt,name=cardbox_id,json=cardboxId,proto3" json:"cardbox_id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Lexicon   string `protobuf:"bytes,3,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	// Unix times of when the cardbox was deleted, and until when it can be
+	// restored.
+	Deleted         int64 `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	RestorableUntil int64 `protobuf:"varint,5,opt,name=restorable_until,json=restorableUntil,proto3" json:"restorable_until,omitempty"`
+}
+
+func (x *DeletedCardbox) Reset() {
+	*x = DeletedCardbox{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletedCardbox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletedCardbox) ProtoMessage() {}
+
+func (x *DeletedCardbox) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletedCardbox.ProtoReflect.Descriptor instead.
+func (*DeletedCardbox) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DeletedCardbox) GetCardboxId() int64 {
+	if x != nil {
+		return x.CardboxId
+	}
+	return 0
+}
+
+func (x *DeletedCardbox) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeletedCardbox) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *DeletedCardbox) GetDeleted() int64 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+func (x *DeletedCardbox) GetRestorableUntil() int64 {
+	if x != nil {
+		return x.RestorableUntil
+	}
+	return 0
+}
+
+type DeletedCardboxesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The most recently deleted first.
+	Cardboxes []*DeletedCardbox `protobuf:"bytes,1,rep,name=cardboxes,proto3" json:"cardboxes,omitempty"`
+}
+
+func (x *DeletedCardboxesResponse) Reset() {
+	*x = DeletedCardboxesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletedCardboxesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletedCardboxesResponse) ProtoMessage() {}
+
+func (x *DeletedCardboxesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletedCardboxesResponse.ProtoReflect.Descriptor instead.
+func (*DeletedCardboxesResponse) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DeletedCardboxesResponse) GetCardboxes() []*DeletedCardbox {
+	if x != nil {
+		return x.Cardboxes
+	}
+	return nil
+}
+
+type SavedSearch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Search *SearchRequest `protobuf:"bytes,2,opt,name=search,proto3" json:"search,omitempty"`
+	// Unix times of when the search was first saved, and last replaced.
+	Created int64 `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	Updated int64 `protobuf:"varint,4,opt,name=updated,proto3" json:"updated,omitempty"`
+}
+
+func (x *SavedSearch) Reset() {
+	*x = SavedSearch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SavedSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearch) ProtoMessage() {}
+
+func (x *SavedSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearch.ProtoReflect.Descriptor instead.
+func (*SavedSearch) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SavedSearch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetSearch() *SearchRequest {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+func (x *SavedSearch) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *SavedSearch) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+type SaveSearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The user the search belongs to. Each user's saved searches are kept
+	// apart, and with API keys, each key's users' are too.
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The search to save. Its cursor and snapshot aren't saved, as they
+	// expire.
+	Search *SearchRequest `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+}
+
+func (x *SaveSearchRequest) Reset() {
+	*x = SaveSearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSearchRequest) ProtoMessage() {}
+
+func (x *SaveSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSearchRequest.ProtoReflect.Descriptor instead.
+func (*SaveSearchRequest) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SaveSearchRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *SaveSearchRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SaveSearchRequest) GetSearch() *SearchRequest {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+type SaveSearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SavedSearch *SavedSearch `protobuf:"bytes,1,opt,name=saved_search,json=savedSearch,proto3" json:"saved_search,omitempty"`
+	// Whether a search the user had saved under the name was replaced.
+	Replaced bool `protobuf:"varint,2,opt,name=replaced,proto3" json:"replaced,omitempty"`
+}
+
+func (x *SaveSearchResponse) Reset() {
+	*x = SaveSearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSearchResponse) ProtoMessage() {}
+
+func (x *SaveSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSearchResponse.ProtoReflect.Descriptor instead.
+func (*SaveSearchResponse) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SaveSearchResponse) GetSavedSearch() *SavedSearch {
+	if x != nil {
+		return x.SavedSearch
+	}
+	return nil
+}
+
+func (x *SaveSearchResponse) GetReplaced() bool {
+	if x != nil {
+		return x.Replaced
+	}
+	return false
+}
+
+type ListSavedSearchesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *ListSavedSearchesRequest) Reset() {
+	*x = ListSavedSearchesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSavedSearchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedSearchesRequest) ProtoMessage() {}
+
+func (x *ListSavedSearchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedSearchesRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchesRequest) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListSavedSearchesRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type ListSavedSearchesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// By name.
+	SavedSearches []*SavedSearch `protobuf:"bytes,1,rep,name=saved_searches,json=savedSearches,proto3" json:"saved_searches,omitempty"`
+}
+
+func (x *ListSavedSearchesResponse) Reset() {
+	*x = ListSavedSearchesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSavedSearchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedSearchesResponse) ProtoMessage() {}
+
+func (x *ListSavedSearchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedSearchesResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchesResponse) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListSavedSearchesResponse) GetSavedSearches() []*SavedSearch {
+	if x != nil {
+		return x.SavedSearches
+	}
+	return nil
+}
+
+type RunSavedSearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Expands the results even if the saved search doesn't.
+	Expand bool `protobuf:"varint,3,opt,name=expand,proto3" json:"expand,omitempty"`
+	// Page the results, in place of the saved search's page size, and the
+	// cursor of the page to return.
+	PageSize int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor   string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *RunSavedSearchRequest) Reset() {
+	*x = RunSavedSearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunSavedSearchRequest) ProtoMessage() {}
+
+func (x *RunSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*RunSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RunSavedSearchRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *RunSavedSearchRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RunSavedSearchRequest) GetExpand() bool {
+	if x != nil {
+		return x.Expand
+	}
+	return false
+}
+
+func (x *RunSavedSearchRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *RunSavedSearchRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type DeleteSavedSearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteSavedSearchRequest) Reset() {
+	*x = DeleteSavedSearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedSearchRequest) ProtoMessage() {}
+
+func (x *DeleteSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteSavedSearchRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *DeleteSavedSearchRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteSavedSearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteSavedSearchResponse) Reset() {
+	*x = DeleteSavedSearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSavedSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedSearchResponse) ProtoMessage() {}
+
+func (x *DeleteSavedSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedSearchResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSavedSearchResponse) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{53}
+}
+
+type ListOperand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Operand:
+	//	*ListOperand_Search
+	//	*ListOperand_CardboxId
+	//	*ListOperand_Alphagrams
+	//	*ListOperand_Operation
+	Operand isListOperand_Operand `protobuf_oneof:"operand"`
+}
+
+func (x *ListOperand) Reset() {
+	*x = ListOperand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOperand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperand) ProtoMessage() {}
+
+func (x *ListOperand) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperand.ProtoReflect.Descriptor instead.
+func (*ListOperand) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{54}
+}
+
+func (m *ListOperand) GetOperand() isListOperand_Operand {
+	if m != nil {
+		return m.Operand
+	}
+	return nil
+}
+
+func (x *ListOperand) GetSearch() *SearchRequest {
+	if x, ok := x.GetOperand().(*ListOperand_Search); ok {
+		return x.Search
+	}
+	return nil
+}
+
+func (x *ListOperand) GetCardboxId() int64 {
+	if x, ok := x.GetOperand().(*ListOperand_CardboxId); ok {
+		return x.CardboxId
+	}
+	return 0
+}
+
+func (x *ListOperand) GetAlphagrams() *SearchRequest_StringArray {
+	if x, ok := x.GetOperand().(*ListOperand_Alphagrams); ok {
+		return x.Alphagrams
+	}
+	return nil
+}
+
+func (x *ListOperand) GetOperation() *ListOperation {
+	if x, ok := x.GetOperand().(*ListOperand_Operation); ok {
+		return x.Operation
+	}
+	return nil
+}
+
+type isListOperand_Operand interface {
+	isListOperand_Operand()
+}
+
+type ListOperand_Search struct {
+	// The results of a search, in the request's lexicon.
+	Search *SearchRequest `protobuf:"bytes,1,opt,name=search,proto3,oneof"`
+}
+
+type ListOperand_CardboxId struct {
+	// One of the user's cardboxes, in the request's lexicon.
+	CardboxId int64 `protobuf:"varint,2,opt,name=cardbox_id,json=cardboxId,proto3,oneof"`
+}
+
+type ListOperand_Alphagrams struct {
+	// Alphagrams given inline, such as a list the client keeps.
+	Alphagrams *SearchRequest_StringArray `protobuf:"bytes,3,opt,name=alphagrams,proto3,oneof"`
+}
+
+type ListOperand_Operation struct {
+	// The result of another operation.
+	Operation *ListOperation `protobuf:"bytes,4,opt,name=operation,proto3,oneof"`
+}
+
+func (*ListOperand_Search) isListOperand_Operand() {}
+
+func (*ListOperand_CardboxId) isListOperand_Operand() {}
+
+func (*ListOperand_Alphagrams) isListOperand_Operand() {}
+
+func (*ListOperand_Operation) isListOperand_Operand() {}
+
+type ListOperation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Op       ListOperation_Op `protobuf:"varint,1,opt,name=op,proto3,enum=wordsearcher.ListOperation_Op" json:"op,omitempty"`
+	Operands []*ListOperand   `protobuf:"bytes,2,rep,name=operands,proto3" json:"operands,omitempty"`
+}
+
+func (x *ListOperation) Reset() {
+	*x = ListOperation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperation) ProtoMessage() {}
+
+func (x *ListOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperation.ProtoReflect.Descriptor instead.
+func (*ListOperation) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListOperation) GetOp() ListOperation_Op {
+	if x != nil {
+		return x.Op
+	}
+	return ListOperation_UNION
+}
+
+func (x *ListOperation) GetOperands() []*ListOperand {
+	if x != nil {
+		return x.Operands
+	}
+	return nil
+}
+
+type CombineListsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only needed for cardbox operands, or to save the result.
+	User      string         `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Lexicon   string         `protobuf:"bytes,2,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	Operation *ListOperation `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	Expand    bool           `protobuf:"varint,4,opt,name=expand,proto3" json:"expand,omitempty"`
+	// If set, the result is also saved as a new cardbox with this name.
+	SaveAs string `protobuf:"bytes,5,opt,name=save_as,json=saveAs,proto3" json:"save_as,omitempty"`
+}
+
+func (x *CombineListsRequest) Reset() {
+	*x = CombineListsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CombineListsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CombineListsRequest) ProtoMessage() {}
+
+func (x *CombineListsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CombineListsRequest.ProtoReflect.Descriptor instead.
+func (*CombineListsRequest) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CombineListsRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *CombineListsRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *CombineListsRequest) GetOperation() *ListOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *CombineListsRequest) GetExpand() bool {
+	if x != nil {
+		return x.Expand
+	}
+	return false
+}
+
+func (x *CombineListsRequest) GetSaveAs() string {
+	if x != nil {
+		return x.SaveAs
+	}
+	return ""
+}
+
+type CombineListsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The alphagrams are in the order of the operands they came from.
+	Result *SearchResponse `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// Set if the result was saved.
+	CardboxId int64 `protobuf:"varint,2,opt,name=cardbox_id,json=cardboxId,proto3" json:"cardbox_id,omitempty"`
+}
+
+func (x *CombineListsResponse) Reset() {
+	*x = CombineListsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CombineListsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CombineListsResponse) ProtoMessage() {}
+
+func (x *CombineListsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CombineListsResponse.ProtoReflect.Descriptor instead.
+func (*CombineListsResponse) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CombineListsResponse) GetResult() *SearchResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *CombineListsResponse) GetCardboxId() int64 {
+	if x != nil {
+		return x.CardboxId
+	}
+	return 0
+}
+
+type MissedWordsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lexicon string                       `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	Answers []*MissedWordsRequest_Answer `protobuf:"bytes,2,rep,name=answers,proto3" json:"answers,omitempty"`
+	// Grade against this snapshot of the lexicon, e.g. the one the quiz's
+	// questions were searched in.
+	SnapshotId string `protobuf:"bytes,3,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+}
+
+func (x *MissedWordsRequest) Reset() {
+	*x = MissedWordsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MissedWordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissedWordsRequest) ProtoMessage() {}
+
+func (x *MissedWordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissedWordsRequest.ProtoReflect.Descriptor instead.
+func (*MissedWordsRequest) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *MissedWordsRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *MissedWordsRequest) GetAnswers() []*MissedWordsRequest_Answer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *MissedWordsRequest) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+type MissedWordsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// In the same order as the request's answers.
+	Alphagrams []*MissedWordsResponse_Graded `protobuf:"bytes,1,rep,name=alphagrams,proto3" json:"alphagrams,omitempty"`
+	Summary    *MissedWordsResponse_Summary  `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+}
+
+func (x *MissedWordsResponse) Reset() {
+	*x = MissedWordsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MissedWordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissedWordsResponse) ProtoMessage() {}
+
+func (x *MissedWordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissedWordsResponse.ProtoReflect.Descriptor instead.
+func (*MissedWordsResponse) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *MissedWordsResponse) GetAlphagrams() []*MissedWordsResponse_Graded {
+	if x != nil {
+		return x.Alphagrams
+	}
+	return nil
+}
+
+func (x *MissedWordsResponse) GetSummary() *MissedWordsResponse_Summary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+// A SolutionChart is what a quiz shows after a question: the alphagram's
+// words with their hooks, lexicon symbols and definitions.
+type SolutionChart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alphagram   string `protobuf:"bytes,1,opt,name=alphagram,proto3" json:"alphagram,omitempty"`
+	Length      int32  `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	Probability int32  `protobuf:"varint,3,opt,name=probability,proto3" json:"probability,omitempty"`
+	// In the order of the alphagram's words.
+	Rows []*SolutionChart_Row `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
+	// The whole chart as plain text: a line with the alphagram, then a line
+	// per word, in columns of front hooks, the word (with a · on either side
+	// that's an inner hook) and its symbols, back hooks, and definition.
+	Text string `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *SolutionChart) Reset() {
+	*x = SolutionChart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SolutionChart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolutionChart) ProtoMessage() {}
+
+func (x *SolutionChart) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolutionChart.ProtoReflect.Descriptor instead.
+func (*SolutionChart) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SolutionChart) GetAlphagram() string {
+	if x != nil {
+		return x.Alphagram
+	}
+	return ""
+}
+
+func (x *SolutionChart) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *SolutionChart) GetProbability() int32 {
+	if x != nil {
+		return x.Probability
+	}
+	return 0
+}
+
+func (x *SolutionChart) GetRows() []*SolutionChart_Row {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *SolutionChart) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type SolutionChartsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lexicon string           `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	Charts  []*SolutionChart `protobuf:"bytes,2,rep,name=charts,proto3" json:"charts,omitempty"`
+}
+
+func (x *SolutionChartsResponse) Reset() {
+	*x = SolutionChartsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SolutionChartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolutionChartsResponse) ProtoMessage() {}
+
+func (x *SolutionChartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolutionChartsResponse.ProtoReflect.Descriptor instead.
+func (*SolutionChartsResponse) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SolutionChartsResponse) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *SolutionChartsResponse) GetCharts() []*SolutionChart {
+	if x != nil {
+		return x.Charts
+	}
+	return nil
+}
+
+type WordSearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lexicon string `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	// the only acceptable glob characters are * and ?. These get mapped
+	// to SQLITE % and _, respectively.
+	Glob string `protobuf:"bytes,2,opt,name=glob,proto3" json:"glob,omitempty"`
+	// Which field the glob applies to (word or definition?)
+	AppliesTo string `protobuf:"bytes,3,opt,name=applies_to,json=appliesTo,proto3" json:"applies_to,omitempty"`
+}
+
+func (x *WordSearchRequest) Reset() {
+	*x = WordSearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WordSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordSearchRequest) ProtoMessage() {}
+
+func (x *WordSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordSearchRequest.ProtoReflect.Descriptor instead.
+func (*WordSearchRequest) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *WordSearchRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *WordSearchRequest) GetGlob() string {
+	if x != nil {
+		return x.Glob
+	}
+	return ""
+}
+
+func (x *WordSearchRequest) GetAppliesTo() string {
+	if x != nil {
+		return x.AppliesTo
+	}
+	return ""
+}
+
+type DefineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lexicon string `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	Word    string `protobuf:"bytes,2,opt,name=word,proto3" json:"word,omitempty"`
+}
+
+func (x *DefineRequest) Reset() {
+	*x = DefineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DefineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefineRequest) ProtoMessage() {}
+
+func (x *DefineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefineRequest.ProtoReflect.Descriptor instead.
+func (*DefineRequest) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DefineRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *DefineRequest) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+type WordSearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Words []*Word `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`
+}
+
+func (x *WordSearchResponse) Reset() {
+	*x = WordSearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WordSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordSearchResponse) ProtoMessage() {}
+
+func (x *WordSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordSearchResponse.ProtoReflect.Descriptor instead.
+func (*WordSearchResponse) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *WordSearchResponse) GetWords() []*Word {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+type SuggestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lexicon string `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	// The word as typed, possibly misspelled.
+	Word string `protobuf:"bytes,2,opt,name=word,proto3" json:"word,omitempty"`
+	// The largest edit distance (insertions, deletions, substitutions and
+	// swaps of adjacent letters) of a suggestion. It's 2 if not given, which
+	// is also the most allowed.
+	MaxDistance int32 `protobuf:"varint,3,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"`
+	// The most suggestions to return; 10 if not given.
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *SuggestRequest) Reset() {
+	*x = SuggestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestRequest) ProtoMessage() {}
+
+func (x *SuggestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestRequest.ProtoReflect.Descriptor instead.
+func (*SuggestRequest) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SuggestRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *SuggestRequest) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *SuggestRequest) GetMaxDistance() int32 {
+	if x != nil {
+		return x.MaxDistance
+	}
+	return 0
+}
+
+func (x *SuggestRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SuggestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// valid is true if the word itself is in the lexicon. It is never one of
+	// the suggestions.
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// Closest first, then most playable first.
+	Suggestions []*SuggestResponse_Suggestion `protobuf:"bytes,2,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+}
+
+func (x *SuggestResponse) Reset() {
+	*x = SuggestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestResponse) ProtoMessage() {}
+
+func (x *SuggestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestResponse.ProtoReflect.Descriptor instead.
+func (*SuggestResponse) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SuggestResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *SuggestResponse) GetSuggestions() []*SuggestResponse_Suggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type NeighborsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lexicon string `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	// The alphagram, or a word, whose neighbors to find.
+	Alphagram string `protobuf:"bytes,2,opt,name=alphagram,proto3" json:"alphagram,omitempty"`
+}
+
+func (x *NeighborsRequest) Reset() {
+	*x = NeighborsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NeighborsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NeighborsRequest) ProtoMessage() {}
+
+func (x *NeighborsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NeighborsRequest.ProtoReflect.Descriptor instead.
+func (*NeighborsRequest) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *NeighborsRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *NeighborsRequest) GetAlphagram() string {
+	if x != nil {
+		return x.Alphagram
+	}
+	return ""
+}
+
+type NeighborsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The request's alphagram, with its tiles in order.
+	Alphagram string `protobuf:"bytes,1,opt,name=alphagram,proto3" json:"alphagram,omitempty"`
+	// Whether the alphagram itself is in the lexicon.
+	Valid    bool  `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	NumWords int32 `protobuf:"varint,3,opt,name=num_words,json=numWords,proto3" json:"num_words,omitempty"`
+	// Shortest first, so removals, then swaps, then additions, and by
+	// probability within each.
+	Neighbors []*NeighborsResponse_Neighbor `protobuf:"bytes,4,rep,name=neighbors,proto3" json:"neighbors,omitempty"`
+}
+
+func (x *NeighborsResponse) Reset() {
+	*x = NeighborsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NeighborsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NeighborsResponse) ProtoMessage() {}
+
+func (x *NeighborsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NeighborsResponse.ProtoReflect.Descriptor instead.
+func (*NeighborsResponse) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *NeighborsResponse) GetAlphagram() string {
+	if x != nil {
+		return x.Alphagram
+	}
+	return ""
+}
+
+func (x *NeighborsResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *NeighborsResponse) GetNumWords() int32 {
+	if x != nil {
+		return x.NumWords
+	}
+	return 0
+}
+
+func (x *NeighborsResponse) GetNeighbors() []*NeighborsResponse_Neighbor {
+	if x != nil {
+		return x.Neighbors
+	}
+	return nil
+}
+
+type SearchRequest_SingleAnagram struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seed int64 `protobuf:"varint,1,opt,name=seed,proto3" json:"seed,omitempty"`
+	// shuffle also puts the alphagrams in a random order. It can't be
+	// combined with paging.
+	Shuffle bool `protobuf:"varint,2,opt,name=shuffle,proto3" json:"shuffle,omitempty"`
+}
+
+func (x *SearchRequest_SingleAnagram) Reset() {
+	*x = SearchRequest_SingleAnagram{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest_SingleAnagram) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest_SingleAnagram) ProtoMessage() {}
+
+func (x *SearchRequest_SingleAnagram) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest_SingleAnagram.ProtoReflect.Descriptor instead.
+func (*SearchRequest_SingleAnagram) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *SearchRequest_SingleAnagram) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *SearchRequest_SingleAnagram) GetShuffle() bool {
+	if x != nil {
+		return x.Shuffle
+	}
+	return false
+}
+
+type SearchRequest_MinMax struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Used for length, prob range, prob limit, num anagrams,
+	// num_vowels, point value, number of front/back hooks,
+	// vowel prob range, vowel ratio (a percentage), num blank anagrams
+	Min int32 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max int32 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *SearchRequest_MinMax) Reset() {
+	*x = SearchRequest_MinMax{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest_MinMax) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest_MinMax) ProtoMessage() {}
+
+func (x *SearchRequest_MinMax) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest_MinMax.ProtoReflect.Descriptor instead.
+func (*SearchRequest_MinMax) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *SearchRequest_MinMax) GetMin() int32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *SearchRequest_MinMax) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type SearchRequest_MinMax64 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Used for combinations range, which can be beyond an int32
+	Min int64 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max int64 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *SearchRequest_MinMax64) Reset() {
+	*x = SearchRequest_MinMax64{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest_MinMax64) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest_MinMax64) ProtoMessage() {}
+
+func (x *SearchRequest_MinMax64) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest_MinMax64.ProtoReflect.Descriptor instead.
+func (*SearchRequest_MinMax64) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *SearchRequest_MinMax64) GetMin() int64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *SearchRequest_MinMax64) GetMax() int64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type SearchRequest_StringValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Used for lexicon, matching anagram, not_in_lexicon,
+	// front/back hooks include, definition contains, word source,
+	// contains/excludes letters
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SearchRequest_StringValue) Reset() {
+	*x = SearchRequest_StringValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest_StringValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest_StringValue) ProtoMessage() {}
+
+func (x *SearchRequest_StringValue) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest_StringValue.ProtoReflect.Descriptor instead.
+func (*SearchRequest_StringValue) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 3}
+}
+
+func (x *SearchRequest_StringValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type SearchRequest_StringArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Used for alphagram_list, ordered alphagram list
+	Values []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *SearchRequest_StringArray) Reset() {
+	*x = SearchRequest_StringArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest_StringArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest_StringArray) ProtoMessage() {}
+
+func (x *SearchRequest_StringArray) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest_StringArray.ProtoReflect.Descriptor instead.
+func (*SearchRequest_StringArray) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 4}
+}
+
+func (x *SearchRequest_StringArray) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type SearchRequest_NumberArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Used for prob list
+	Values []int32 `protobuf:"varint,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *SearchRequest_NumberArray) Reset() {
+	*x = SearchRequest_NumberArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest_NumberArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest_NumberArray) ProtoMessage() {}
+
+func (x *SearchRequest_NumberArray) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest_NumberArray.ProtoReflect.Descriptor instead.
+func (*SearchRequest_NumberArray) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 5}
+}
+
+func (x *SearchRequest_NumberArray) GetValues() []int32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type SearchRequest_NumberValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value int32 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SearchRequest_NumberValue) Reset() {
+	*x = SearchRequest_NumberValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest_NumberValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest_NumberValue) ProtoMessage() {}
+
+func (x *SearchRequest_NumberValue) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest_NumberValue.ProtoReflect.Descriptor instead.
+func (*SearchRequest_NumberValue) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 6}
+}
+
+func (x *SearchRequest_NumberValue) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type SearchRequest_LengthProbability struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Length      int32 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	Probability int32 `protobuf:"varint,2,opt,name=probability,proto3" json:"probability,omitempty"`
+}
+
+func (x *SearchRequest_LengthProbability) Reset() {
+	*x = SearchRequest_LengthProbability{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest_LengthProbability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest_LengthProbability) ProtoMessage() {}
+
+func (x *SearchRequest_LengthProbability) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest_LengthProbability.ProtoReflect.Descriptor instead.
+func (*SearchRequest_LengthProbability) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 7}
+}
+
+func (x *SearchRequest_LengthProbability) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *SearchRequest_LengthProbability) GetProbability() int32 {
+	if x != nil {
+		return x.Probability
+	}
+	return 0
+}
+
+type SearchRequest_LengthProbabilityList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Used for ordered probability list
+	Values []*SearchRequest_LengthProbability `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *SearchRequest_LengthProbabilityList) Reset() {
+	*x = SearchRequest_LengthProbabilityList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest_LengthProbabilityList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest_LengthProbabilityList) ProtoMessage() {}
+
+func (x *SearchRequest_LengthProbabilityList) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest_LengthProbabilityList.ProtoReflect.Descriptor instead.
+func (*SearchRequest_LengthProbabilityList) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 8}
+}
+
+func (x *SearchRequest_LengthProbabilityList) GetValues() []*SearchRequest_LengthProbability {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type SearchRequest_RandomSample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Used for random sample
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Seed  int64 `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"`
+}
+
+func (x *SearchRequest_RandomSample) Reset() {
+	*x = SearchRequest_RandomSample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest_RandomSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest_RandomSample) ProtoMessage() {}
+
+func (x *SearchRequest_RandomSample) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest_RandomSample.ProtoReflect.Descriptor instead.
+func (*SearchRequest_RandomSample) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 9}
+}
+
+func (x *SearchRequest_RandomSample) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SearchRequest_RandomSample) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+type SearchRequest_LexiconDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OtherLexicon string                         `protobuf:"bytes,1,opt,name=other_lexicon,json=otherLexicon,proto3" json:"other_lexicon,omitempty"`
+	Mode         SearchRequest_LexiconDiff_Mode `protobuf:"varint,2,opt,name=mode,proto3,enum=wordsearcher.SearchRequest_LexiconDiff_Mode" json:"mode,omitempty"`
+}
+
+func (x *SearchRequest_LexiconDiff) Reset() {
+	*x = SearchRequest_LexiconDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest_LexiconDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest_LexiconDiff) ProtoMessage() {}
+
+func (x *SearchRequest_LexiconDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest_LexiconDiff.ProtoReflect.Descriptor instead.
+func (*SearchRequest_LexiconDiff) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 10}
+}
+
+func (x *SearchRequest_LexiconDiff) GetOtherLexicon() string {
+	if x != nil {
+		return x.OtherLexicon
+	}
+	return ""
+}
+
+func (x *SearchRequest_LexiconDiff) GetMode() SearchRequest_LexiconDiff_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return SearchRequest_LexiconDiff_NOT_IN_OTHER
+}
+
+type SearchRequest_Combinator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Op     SearchRequest_Combinator_Op  `protobuf:"varint,1,opt,name=op,proto3,enum=wordsearcher.SearchRequest_Combinator_Op" json:"op,omitempty"`
+	Params []*SearchRequest_SearchParam `protobuf:"bytes,2,rep,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *SearchRequest_Combinator) Reset() {
+	*x = SearchRequest_Combinator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest_Combinator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest_Combinator) ProtoMessage() {}
+
+func (x *SearchRequest_Combinator) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest_Combinator.ProtoReflect.Descriptor instead.
+func (*SearchRequest_Combinator) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 11}
+}
+
+func (x *SearchRequest_Combinator) GetOp() SearchRequest_Combinator_Op {
+	if x != nil {
+		return x.Op
+	}
+	return SearchRequest_Combinator_AND
+}
+
+func (x *SearchRequest_Combinator) GetParams() []*SearchRequest_SearchParam {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type SearchRequest_Build struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Used for build. A min_length or max_length of 0 means no limit.
+	Letters   string `protobuf:"bytes,1,opt,name=letters,proto3" json:"letters,omitempty"`
+	MinLength int32  `protobuf:"varint,2,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
+	MaxLength int32  `protobuf:"varint,3,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`
+}
+
+func (x *SearchRequest_Build) Reset() {
+	*x = SearchRequest_Build{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest_Build) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest_Build) ProtoMessage() {}
+
+func (x *SearchRequest_Build) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest_Build.ProtoReflect.Descriptor instead.
+func (*SearchRequest_Build) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 12}
+}
+
+func (x *SearchRequest_Build) GetLetters() string {
+	if x != nil {
+		return x.Letters
+	}
+	return ""
+}
+
+func (x *SearchRequest_Build) GetMinLength() int32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *SearchRequest_Build) GetMaxLength() int32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+type SearchRequest_SearchParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Condition SearchRequest_Condition `protobuf:"varint,1,opt,name=condition,proto3,enum=wordsearcher.SearchRequest_Condition" json:"condition,omitempty"`
+	// Types that are assignable to Conditionparam:
+	//	*SearchRequest_SearchParam_Minmax
+	//	*SearchRequest_SearchParam_Stringvalue
+	//	*SearchRequest_SearchParam_Stringarray
+	//	*SearchRequest_SearchParam_Numberarray
+	//	*SearchRequest_SearchParam_Numbervalue
+	//	*SearchRequest_SearchParam_Randomsample
+	//	*SearchRequest_SearchParam_Lexicondiff
+	//	*SearchRequest_SearchParam_Combinator
+	//	*SearchRequest_SearchParam_Minmax64
+	//	*SearchRequest_SearchParam_Lengthprobabilities
+	//	*SearchRequest_SearchParam_Build
+	Conditionparam isSearchRequest_SearchParam_Conditionparam `protobuf_oneof:"conditionparam"`
+}
+
+func (x *SearchRequest_SearchParam) Reset() {
+	*x = SearchRequest_SearchParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest_SearchParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest_SearchParam) ProtoMessage() {}
+
+func (x *SearchRequest_SearchParam) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest_SearchParam.ProtoReflect.Descriptor instead.
+func (*SearchRequest_SearchParam) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{2, 13}
+}
+
+func (x *SearchRequest_SearchParam) GetCondition() SearchRequest_Condition {
+	if x != nil {
+		return x.Condition
+	}
+	return SearchRequest_LEXICON
+}
+
+func (m *SearchRequest_SearchParam) GetConditionparam() isSearchRequest_SearchParam_Conditionparam {
+	if m != nil {
+		return m.Conditionparam
+	}
+	return nil
+}
+
+func (x *SearchRequest_SearchParam) GetMinmax() *SearchRequest_MinMax {
+	if x, ok := x.GetConditionparam().(*SearchRequest_SearchParam_Minmax); ok {
+		return x.Minmax
+	}
+	return nil
+}
+
+func (x *SearchRequest_SearchParam) GetStringvalue() *SearchRequest_StringValue {
+	if x, ok := x.GetConditionparam().(*SearchRequest_SearchParam_Stringvalue); ok {
+		return x.Stringvalue
+	}
+	return nil
+}
+
+func (x *SearchRequest_SearchParam) GetStringarray() *SearchRequest_StringArray {
+	if x, ok := x.GetConditionparam().(*SearchRequest_SearchParam_Stringarray); ok {
+		return x.Stringarray
+	}
+	return nil
+}
+
+func (x *SearchRequest_SearchParam) GetNumberarray() *SearchRequest_NumberArray {
+	if x, ok := x.GetConditionparam().(*SearchRequest_SearchParam_Numberarray); ok {
+		return x.Numberarray
+	}
+	return nil
+}
+
+func (x *SearchRequest_SearchParam) GetNumbervalue() *SearchRequest_NumberValue {
+	if x, ok := x.GetConditionparam().(*SearchRequest_SearchParam_Numbervalue); ok {
+		return x.Numbervalue
+	}
+	return nil
+}
+
+func (x *SearchRequest_SearchParam) GetRandomsample() *SearchRequest_RandomSample {
+	if x, ok := x.GetConditionparam().(*SearchRequest_SearchParam_Randomsample); ok {
+		return x.Randomsample
+	}
+	return nil
+}
+
+func (x *SearchRequest_SearchParam) GetLexicondiff() *SearchRequest_LexiconDiff {
+	if x, ok := x.GetConditionparam().(*SearchRequest_SearchParam_Lexicondiff); ok {
+		return x.Lexicondiff
+	}
+	return nil
+}
+
+func (x *SearchRequest_SearchParam) GetCombinator() *SearchRequest_Combinator {
+	if x, ok := x.GetConditionparam().(*SearchRequest_SearchParam_Combinator); ok {
+		return x.Combinator
+	}
+	return nil
+}
+
+func (x *SearchRequest_SearchParam) GetMinmax64() *SearchRequest_MinMax64 {
+	if x, ok := x.GetConditionparam().(*SearchRequest_SearchParam_Minmax64); ok {
+		return x.Minmax64
+	}
+	return nil
+}
+
+func (x *SearchRequest_SearchParam) GetLengthprobabilities() *SearchRequest_LengthProbabilityList {
+	if x, ok := x.GetConditionparam().(*SearchRequest_SearchParam_Lengthprobabilities); ok {
+		return x.Lengthprobabilities
+	}
+	return nil
+}
+
+func (x *SearchRequest_SearchParam) GetBuild() *SearchRequest_Build {
+	if x, ok := x.GetConditionparam().(*SearchRequest_SearchParam_Build); ok {
+		return x.Build
+	}
+	return nil
+}
+
+type isSearchRequest_SearchParam_Conditionparam interface {
+	isSearchRequest_SearchParam_Conditionparam()
+}
+
+type SearchRequest_SearchParam_Minmax struct {
+	Minmax *SearchRequest_MinMax `protobuf:"bytes,2,opt,name=minmax,proto3,oneof"`
+}
+
+type SearchRequest_SearchParam_Stringvalue struct {
+	Stringvalue *SearchRequest_StringValue `protobuf:"bytes,3,opt,name=stringvalue,proto3,oneof"`
+}
+
+type SearchRequest_SearchParam_Stringarray struct {
+	Stringarray *SearchRequest_StringArray `protobuf:"bytes,4,opt,name=stringarray,proto3,oneof"`
+}
+
+type SearchRequest_SearchParam_Numberarray struct {
+	Numberarray *SearchRequest_NumberArray `protobuf:"bytes,5,opt,name=numberarray,proto3,oneof"`
+}
+
+type SearchRequest_SearchParam_Numbervalue struct {
+	Numbervalue *SearchRequest_NumberValue `protobuf:"bytes,6,opt,name=numbervalue,proto3,oneof"`
+}
+
+type SearchRequest_SearchParam_Randomsample struct {
+	Randomsample *SearchRequest_RandomSample `protobuf:"bytes,7,opt,name=randomsample,proto3,oneof"`
+}
+
+type SearchRequest_SearchParam_Lexicondiff struct {
+	Lexicondiff *SearchRequest_LexiconDiff `protobuf:"bytes,8,opt,name=lexicondiff,proto3,oneof"`
+}
+
+type SearchRequest_SearchParam_Combinator struct {
+	Combinator *SearchRequest_Combinator `protobuf:"bytes,9,opt,name=combinator,proto3,oneof"`
+}
+
+type SearchRequest_SearchParam_Minmax64 struct {
+	Minmax64 *SearchRequest_MinMax64 `protobuf:"bytes,10,opt,name=minmax64,proto3,oneof"`
+}
+
+type SearchRequest_SearchParam_Lengthprobabilities struct {
+	Lengthprobabilities *SearchRequest_LengthProbabilityList `protobuf:"bytes,11,opt,name=lengthprobabilities,proto3,oneof"`
+}
+
+type SearchRequest_SearchParam_Build struct {
+	Build *SearchRequest_Build `protobuf:"bytes,12,opt,name=build,proto3,oneof"`
+}
+
+func (*SearchRequest_SearchParam_Minmax) isSearchRequest_SearchParam_Conditionparam() {}
+
+func (*SearchRequest_SearchParam_Stringvalue) isSearchRequest_SearchParam_Conditionparam() {}
+
+func (*SearchRequest_SearchParam_Stringarray) isSearchRequest_SearchParam_Conditionparam() {}
+
+func (*SearchRequest_SearchParam_Numberarray) isSearchRequest_SearchParam_Conditionparam() {}
+
+func (*SearchRequest_SearchParam_Numbervalue) isSearchRequest_SearchParam_Conditionparam() {}
+
+func (*SearchRequest_SearchParam_Randomsample) isSearchRequest_SearchParam_Conditionparam() {}
+
+func (*SearchRequest_SearchParam_Lexicondiff) isSearchRequest_SearchParam_Conditionparam() {}
+
+func (*SearchRequest_SearchParam_Combinator) isSearchRequest_SearchParam_Conditionparam() {}
+
+func (*SearchRequest_SearchParam_Minmax64) isSearchRequest_SearchParam_Conditionparam() {}
+
+func (*SearchRequest_SearchParam_Lengthprobabilities) isSearchRequest_SearchParam_Conditionparam() {}
+
+func (*SearchRequest_SearchParam_Build) isSearchRequest_SearchParam_Conditionparam() {}
+
+type LexiconMetadata_LengthCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Length        int32 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	NumWords      int32 `protobuf:"varint,2,opt,name=num_words,json=numWords,proto3" json:"num_words,omitempty"`
+	NumAlphagrams int32 `protobuf:"varint,3,opt,name=num_alphagrams,json=numAlphagrams,proto3" json:"num_alphagrams,omitempty"`
+}
+
+func (x *LexiconMetadata_LengthCount) Reset() {
+	*x = LexiconMetadata_LengthCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LexiconMetadata_LengthCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LexiconMetadata_LengthCount) ProtoMessage() {}
+
+func (x *LexiconMetadata_LengthCount) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LexiconMetadata_LengthCount.ProtoReflect.Descriptor instead.
+func (*LexiconMetadata_LengthCount) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *LexiconMetadata_LengthCount) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *LexiconMetadata_LengthCount) GetNumWords() int32 {
+	if x != nil {
+		return x.NumWords
+	}
+	return 0
+}
+
+func (x *LexiconMetadata_LengthCount) GetNumAlphagrams() int32 {
+	if x != nil {
+		return x.NumAlphagrams
+	}
+	return 0
+}
+
+type LexiconMetadata_Tile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Letter string `protobuf:"bytes,1,opt,name=letter,proto3" json:"letter,omitempty"`
+	Count  int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Score  int32  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	Vowel  bool   `protobuf:"varint,4,opt,name=vowel,proto3" json:"vowel,omitempty"`
+}
+
+func (x *LexiconMetadata_Tile) Reset() {
+	*x = LexiconMetadata_Tile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LexiconMetadata_Tile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LexiconMetadata_Tile) ProtoMessage() {}
+
+func (x *LexiconMetadata_Tile) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LexiconMetadata_Tile.ProtoReflect.Descriptor instead.
+func (*LexiconMetadata_Tile) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{9, 1}
+}
+
+func (x *LexiconMetadata_Tile) GetLetter() string {
+	if x != nil {
+		return x.Letter
+	}
+	return ""
+}
+
+func (x *LexiconMetadata_Tile) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *LexiconMetadata_Tile) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *LexiconMetadata_Tile) GetVowel() bool {
+	if x != nil {
+		return x.Vowel
+	}
+	return false
+}
+
+type LexiconMetadata_LexiconSymbol struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol      string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// A short name for the symbol, like CSW-only, for showing in place of
+	// it. Empty for databases made before labels were stored.
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (x *LexiconMetadata_LexiconSymbol) Reset() {
+	*x = LexiconMetadata_LexiconSymbol{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LexiconMetadata_LexiconSymbol) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LexiconMetadata_LexiconSymbol) ProtoMessage() {}
+
+func (x *LexiconMetadata_LexiconSymbol) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LexiconMetadata_LexiconSymbol.ProtoReflect.Descriptor instead.
+func (*LexiconMetadata_LexiconSymbol) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{9, 2}
+}
+
+func (x *LexiconMetadata_LexiconSymbol) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *LexiconMetadata_LexiconSymbol) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LexiconMetadata_LexiconSymbol) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type LexiconStats_LengthStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Length        int32 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	NumAlphagrams int32 `protobuf:"varint,2,opt,name=num_alphagrams,json=numAlphagrams,proto3" json:"num_alphagrams,omitempty"`
+	NumWords      int32 `protobuf:"varint,3,opt,name=num_words,json=numWords,proto3" json:"num_words,omitempty"`
+	// The average point value of the words of this length.
+	AvgPointValue float64 `protobuf:"fixed64,4,opt,name=avg_point_value,json=avgPointValue,proto3" json:"avg_point_value,omitempty"`
+	// The number of words on this lexicon's side of a lexicon split, such
+	// as the CSW-only words of a Collins lexicon.
+	NumUniqToLex int32 `protobuf:"varint,5,opt,name=num_uniq_to_lex,json=numUniqToLex,proto3" json:"num_uniq_to_lex,omitempty"`
+}
+
+func (x *LexiconStats_LengthStats) Reset() {
+	*x = LexiconStats_LengthStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LexiconStats_LengthStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LexiconStats_LengthStats) ProtoMessage() {}
+
+func (x *LexiconStats_LengthStats) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LexiconStats_LengthStats.ProtoReflect.Descriptor instead.
+func (*LexiconStats_LengthStats) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *LexiconStats_LengthStats) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *LexiconStats_LengthStats) GetNumAlphagrams() int32 {
+	if x != nil {
+		return x.NumAlphagrams
+	}
+	return 0
+}
+
+func (x *LexiconStats_LengthStats) GetNumWords() int32 {
+	if x != nil {
+		return x.NumWords
+	}
+	return 0
+}
+
+func (x *LexiconStats_LengthStats) GetAvgPointValue() float64 {
+	if x != nil {
+		return x.AvgPointValue
+	}
+	return 0
+}
+
+func (x *LexiconStats_LengthStats) GetNumUniqToLex() int32 {
+	if x != nil {
+		return x.NumUniqToLex
+	}
+	return 0
+}
+
+type LexiconStats_AnagramCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumAnagrams int32 `protobuf:"varint,1,opt,name=num_anagrams,json=numAnagrams,proto3" json:"num_anagrams,omitempty"`
+	// The number of alphagrams with num_anagrams words.
+	NumAlphagrams int32 `protobuf:"varint,2,opt,name=num_alphagrams,json=numAlphagrams,proto3" json:"num_alphagrams,omitempty"`
+}
+
+func (x *LexiconStats_AnagramCount) Reset() {
+	*x = LexiconStats_AnagramCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LexiconStats_AnagramCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LexiconStats_AnagramCount) ProtoMessage() {}
+
+func (x *LexiconStats_AnagramCount) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LexiconStats_AnagramCount.ProtoReflect.Descriptor instead.
+func (*LexiconStats_AnagramCount) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{11, 1}
+}
+
+func (x *LexiconStats_AnagramCount) GetNumAnagrams() int32 {
+	if x != nil {
+		return x.NumAnagrams
+	}
+	return 0
+}
+
+func (x *LexiconStats_AnagramCount) GetNumAlphagrams() int32 {
+	if x != nil {
+		return x.NumAlphagrams
+	}
+	return 0
+}
+
+type SchemaInfo_Migration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version int32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// created or migrated
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Unix time
+	AppliedAt int64 `protobuf:"varint,3,opt,name=applied_at,json=appliedAt,proto3" json:"applied_at,omitempty"`
+}
+
+func (x *SchemaInfo_Migration) Reset() {
+	*x = SchemaInfo_Migration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchemaInfo_Migration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaInfo_Migration) ProtoMessage() {}
+
+func (x *SchemaInfo_Migration) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaInfo_Migration.ProtoReflect.Descriptor instead.
+func (*SchemaInfo_Migration) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *SchemaInfo_Migration) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SchemaInfo_Migration) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SchemaInfo_Migration) GetAppliedAt() int64 {
+	if x != nil {
+		return x.AppliedAt
+	}
+	return 0
+}
+
+type SchemaInfo_Table struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Columns []string `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+}
+
+func (x *SchemaInfo_Table) Reset() {
+	*x = SchemaInfo_Table{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchemaInfo_Table) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaInfo_Table) ProtoMessage() {}
+
+func (x *SchemaInfo_Table) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaInfo_Table.ProtoReflect.Descriptor instead.
+func (*SchemaInfo_Table) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{13, 1}
+}
+
+func (x *SchemaInfo_Table) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SchemaInfo_Table) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+type SearchSchema_Field struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The protobuf type: int32, int64, string, enum or message.
+	Type     string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Repeated bool   `protobuf:"varint,3,opt,name=repeated,proto3" json:"repeated,omitempty"`
+	// For enums, the names of the values, in order of their numbers.
+	EnumValues []string `protobuf:"bytes,4,rep,name=enum_values,json=enumValues,proto3" json:"enum_values,omitempty"`
+	// For messages, the full name of the message.
+	Message string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SearchSchema_Field) Reset() {
+	*x = SearchSchema_Field{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchSchema_Field) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSchema_Field) ProtoMessage() {}
+
+func (x *SearchSchema_Field) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSchema_Field.ProtoReflect.Descriptor instead.
+func (*SearchSchema_Field) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *SearchSchema_Field) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SearchSchema_Field) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SearchSchema_Field) GetRepeated() bool {
+	if x != nil {
+		return x.Repeated
+	}
+	return false
+}
+
+func (x *SearchSchema_Field) GetEnumValues() []string {
+	if x != nil {
+		return x.EnumValues
+	}
+	return nil
+}
+
+func (x *SearchSchema_Field) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SearchSchema_Condition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name and number of the condition in SearchRequest.Condition.
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Number      int32  `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The SearchParam field that the condition's parameter goes in, such
+	// as minmax, and that parameter's fields. It's empty if the condition
+	// takes no parameter.
+	Param  string                `protobuf:"bytes,4,opt,name=param,proto3" json:"param,omitempty"`
+	Fields []*SearchSchema_Field `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	// The optional lexicon data the condition needs, if any, and whether
+	// the lexicon asked about has it. Without a lexicon, available is
+	// always true.
+	Capability string `protobuf:"bytes,6,opt,name=capability,proto3" json:"capability,omitempty"`
+	Available  bool   `protobuf:"varint,7,opt,name=available,proto3" json:"available,omitempty"`
+	// Whether the condition can be in a combinator.
+	Combinable bool `protobuf:"varint,8,opt,name=combinable,proto3" json:"combinable,omitempty"`
+	// Whether the condition must be the last filter of a search. Only one
+	// such condition can be in a search.
+	MustBeLast bool `protobuf:"varint,9,opt,name=must_be_last,json=mustBeLast,proto3" json:"must_be_last,omitempty"`
+}
+
+func (x *SearchSchema_Condition) Reset() {
+	*x = SearchSchema_Condition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchSchema_Condition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSchema_Condition) ProtoMessage() {}
+
+func (x *SearchSchema_Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSchema_Condition.ProtoReflect.Descriptor instead.
+func (*SearchSchema_Condition) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{16, 1}
+}
+
+func (x *SearchSchema_Condition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SearchSchema_Condition) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *SearchSchema_Condition) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SearchSchema_Condition) GetParam() string {
+	if x != nil {
+		return x.Param
+	}
+	return ""
+}
+
+func (x *SearchSchema_Condition) GetFields() []*SearchSchema_Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *SearchSchema_Condition) GetCapability() string {
+	if x != nil {
+		return x.Capability
+	}
+	return ""
+}
+
+func (x *SearchSchema_Condition) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *SearchSchema_Condition) GetCombinable() bool {
+	if x != nil {
+		return x.Combinable
+	}
+	return false
+}
+
+func (x *SearchSchema_Condition) GetMustBeLast() bool {
+	if x != nil {
+		return x.MustBeLast
+	}
+	return false
+}
+
+type RackValidationResponse_ExcessTile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Letter    string `protobuf:"bytes,1,opt,name=letter,proto3" json:"letter,omitempty"`
+	Requested int32  `protobuf:"varint,2,opt,name=requested,proto3" json:"requested,omitempty"`
+	Available int32  `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`
+}
+
+func (x *RackValidationResponse_ExcessTile) Reset() {
+	*x = RackValidationResponse_ExcessTile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RackValidationResponse_ExcessTile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RackValidationResponse_ExcessTile) ProtoMessage() {}
+
+func (x *RackValidationResponse_ExcessTile) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RackValidationResponse_ExcessTile.ProtoReflect.Descriptor instead.
+func (*RackValidationResponse_ExcessTile) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *RackValidationResponse_ExcessTile) GetLetter() string {
+	if x != nil {
+		return x.Letter
+	}
+	return ""
+}
+
+func (x *RackValidationResponse_ExcessTile) GetRequested() int32 {
+	if x != nil {
+		return x.Requested
+	}
+	return 0
+}
+
+func (x *RackValidationResponse_ExcessTile) GetAvailable() int32 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+type DefinitionUpdateRequest_DefinitionUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Word       string `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	Definition string `protobuf:"bytes,2,opt,name=definition,proto3" json:"definition,omitempty"`
+}
+
+func (x *DefinitionUpdateRequest_DefinitionUpdate) Reset() {
+	*x = DefinitionUpdateRequest_DefinitionUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DefinitionUpdateRequest_DefinitionUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefinitionUpdateRequest_DefinitionUpdate) ProtoMessage() {}
+
+func (x *DefinitionUpdateRequest_DefinitionUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefinitionUpdateRequest_DefinitionUpdate.ProtoReflect.Descriptor instead.
+func (*DefinitionUpdateRequest_DefinitionUpdate) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *DefinitionUpdateRequest_DefinitionUpdate) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *DefinitionUpdateRequest_DefinitionUpdate) GetDefinition() string {
+	if x != nil {
+		return x.Definition
+	}
+	return ""
+}
+
+type WordJudgeResponse_JudgedWord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Word  string `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	Valid bool   `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+}
+
+func (x *WordJudgeResponse_JudgedWord) Reset() {
+	*x = WordJudgeResponse_JudgedWord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WordJudgeResponse_JudgedWord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordJudgeResponse_JudgedWord) ProtoMessage() {}
+
+func (x *WordJudgeResponse_JudgedWord) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordJudgeResponse_JudgedWord.ProtoReflect.Descriptor instead.
+func (*WordJudgeResponse_JudgedWord) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{26, 0}
+}
+
+func (x *WordJudgeResponse_JudgedWord) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *WordJudgeResponse_JudgedWord) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+// An alphagram that was asked, and the words given for it.
+type MissedWordsRequest_Answer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alphagram string   `protobuf:"bytes,1,opt,name=alphagram,proto3" json:"alphagram,omitempty"`
+	Words     []string `protobuf:"bytes,2,rep,name=words,proto3" json:"words,omitempty"`
+}
+
+func (x *MissedWordsRequest_Answer) Reset() {
+	*x = MissedWordsRequest_Answer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MissedWordsRequest_Answer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissedWordsRequest_Answer) ProtoMessage() {}
+
+func (x *MissedWordsRequest_Answer) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissedWordsRequest_Answer.ProtoReflect.Descriptor instead.
+func (*MissedWordsRequest_Answer) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{58, 0}
+}
+
+func (x *MissedWordsRequest_Answer) GetAlphagram() string {
+	if x != nil {
+		return x.Alphagram
+	}
+	return ""
+}
+
+func (x *MissedWordsRequest_Answer) GetWords() []string {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+type MissedWordsResponse_Graded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alphagram string `protobuf:"bytes,1,opt,name=alphagram,proto3" json:"alphagram,omitempty"`
+	// The words of the alphagram that weren't given, in alphabetical
+	// order.
+	Missed []string `protobuf:"bytes,2,rep,name=missed,proto3" json:"missed,omitempty"`
+	// The words given that aren't words of the alphagram, in the order
+	// given.
+	Wrong []string `protobuf:"bytes,3,rep,name=wrong,proto3" json:"wrong,omitempty"`
+	// How many words the alphagram has, and how many of them were given.
+	NumWords   int32 `protobuf:"varint,4,opt,name=num_words,json=numWords,proto3" json:"num_words,omitempty"`
+	NumCorrect int32 `protobuf:"varint,5,opt,name=num_correct,json=numCorrect,proto3" json:"num_correct,omitempty"`
+	// solved is set if every word was given and none were wrong.
+	Solved bool `protobuf:"varint,6,opt,name=solved,proto3" json:"solved,omitempty"`
+}
+
+func (x *MissedWordsResponse_Graded) Reset() {
+	*x = MissedWordsResponse_Graded{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MissedWordsResponse_Graded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissedWordsResponse_Graded) ProtoMessage() {}
+
+func (x *MissedWordsResponse_Graded) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissedWordsResponse_Graded.ProtoReflect.Descriptor instead.
+func (*MissedWordsResponse_Graded) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{59, 0}
+}
+
+func (x *MissedWordsResponse_Graded) GetAlphagram() string {
+	if x != nil {
+		return x.Alphagram
+	}
+	return ""
+}
+
+func (x *MissedWordsResponse_Graded) GetMissed() []string {
+	if x != nil {
+		return x.Missed
+	}
+	return nil
+}
+
+func (x *MissedWordsResponse_Graded) GetWrong() []string {
+	if x != nil {
+		return x.Wrong
+	}
+	return nil
+}
+
+func (x *MissedWordsResponse_Graded) GetNumWords() int32 {
+	if x != nil {
+		return x.NumWords
+	}
+	return 0
+}
+
+func (x *MissedWordsResponse_Graded) GetNumCorrect() int32 {
+	if x != nil {
+		return x.NumCorrect
+	}
+	return 0
+}
+
+func (x *MissedWordsResponse_Graded) GetSolved() bool {
+	if x != nil {
+		return x.Solved
+	}
+	return false
+}
+
+type MissedWordsResponse_Summary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumAlphagrams int32 `protobuf:"varint,1,opt,name=num_alphagrams,json=numAlphagrams,proto3" json:"num_alphagrams,omitempty"`
+	NumSolved     int32 `protobuf:"varint,2,opt,name=num_solved,json=numSolved,proto3" json:"num_solved,omitempty"`
+	NumWords      int32 `protobuf:"varint,3,opt,name=num_words,json=numWords,proto3" json:"num_words,omitempty"`
+	NumCorrect    int32 `protobuf:"varint,4,opt,name=num_correct,json=numCorrect,proto3" json:"num_correct,omitempty"`
+	NumMissed     int32 `protobuf:"varint,5,opt,name=num_missed,json=numMissed,proto3" json:"num_missed,omitempty"`
+	NumWrong      int32 `protobuf:"varint,6,opt,name=num_wrong,json=numWrong,proto3" json:"num_wrong,omitempty"`
+}
+
+func (x *MissedWordsResponse_Summary) Reset() {
+	*x = MissedWordsResponse_Summary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MissedWordsResponse_Summary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissedWordsResponse_Summary) ProtoMessage() {}
+
+func (x *MissedWordsResponse_Summary) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissedWordsResponse_Summary.ProtoReflect.Descriptor instead.
+func (*MissedWordsResponse_Summary) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{59, 1}
+}
+
+func (x *MissedWordsResponse_Summary) GetNumAlphagrams() int32 {
+	if x != nil {
+		return x.NumAlphagrams
+	}
+	return 0
+}
+
+func (x *MissedWordsResponse_Summary) GetNumSolved() int32 {
+	if x != nil {
+		return x.NumSolved
+	}
+	return 0
+}
+
+func (x *MissedWordsResponse_Summary) GetNumWords() int32 {
+	if x != nil {
+		return x.NumWords
+	}
+	return 0
+}
+
+func (x *MissedWordsResponse_Summary) GetNumCorrect() int32 {
+	if x != nil {
+		return x.NumCorrect
+	}
+	return 0
+}
+
+func (x *MissedWordsResponse_Summary) GetNumMissed() int32 {
+	if x != nil {
+		return x.NumMissed
+	}
+	return 0
+}
+
+func (x *MissedWordsResponse_Summary) GetNumWrong() int32 {
+	if x != nil {
+		return x.NumWrong
+	}
+	return 0
+}
+
+type SolutionChart_Row struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Word           string `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	FrontHooks     string `protobuf:"bytes,2,opt,name=front_hooks,json=frontHooks,proto3" json:"front_hooks,omitempty"`
+	BackHooks      string `protobuf:"bytes,3,opt,name=back_hooks,json=backHooks,proto3" json:"back_hooks,omitempty"`
+	InnerFrontHook bool   `protobuf:"varint,4,opt,name=inner_front_hook,json=innerFrontHook,proto3" json:"inner_front_hook,omitempty"`
+	InnerBackHook  bool   `protobuf:"varint,5,opt,name=inner_back_hook,json=innerBackHook,proto3" json:"inner_back_hook,omitempty"`
+	LexiconSymbols string `protobuf:"bytes,6,opt,name=lexicon_symbols,json=lexiconSymbols,proto3" json:"lexicon_symbols,omitempty"`
+	Definition     string `protobuf:"bytes,7,opt,name=definition,proto3" json:"definition,omitempty"`
+	Deleted        bool   `protobuf:"varint,8,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (x *SolutionChart_Row) Reset() {
+	*x = SolutionChart_Row{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SolutionChart_Row) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolutionChart_Row) ProtoMessage() {}
+
+func (x *SolutionChart_Row) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolutionChart_Row.ProtoReflect.Descriptor instead.
+func (*SolutionChart_Row) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{60, 0}
+}
+
+func (x *SolutionChart_Row) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *SolutionChart_Row) GetFrontHooks() string {
+	if x != nil {
+		return x.FrontHooks
+	}
+	return ""
+}
+
+func (x *SolutionChart_Row) GetBackHooks() string {
+	if x != nil {
+		return x.BackHooks
+	}
+	return ""
+}
+
+func (x *SolutionChart_Row) GetInnerFrontHook() bool {
+	if x != nil {
+		return x.InnerFrontHook
+	}
+	return false
+}
+
+func (x *SolutionChart_Row) GetInnerBackHook() bool {
+	if x != nil {
+		return x.InnerBackHook
+	}
+	return false
+}
+
+func (x *SolutionChart_Row) GetLexiconSymbols() string {
+	if x != nil {
+		return x.LexiconSymbols
+	}
+	return ""
+}
+
+func (x *SolutionChart_Row) GetDefinition() string {
+	if x != nil {
+		return x.Definition
+	}
+	return ""
+}
+
+func (x *SolutionChart_Row) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type SuggestResponse_Suggestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Word     string `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	Distance int32  `protobuf:"varint,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	// The word's playability, if the lexicon has playability data.
+	Playability int32 `protobuf:"varint,3,opt,name=playability,proto3" json:"playability,omitempty"`
+}
+
+func (x *SuggestResponse_Suggestion) Reset() {
+	*x = SuggestResponse_Suggestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestResponse_Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestResponse_Suggestion) ProtoMessage() {}
+
+func (x *SuggestResponse_Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestResponse_Suggestion.ProtoReflect.Descriptor instead.
+func (*SuggestResponse_Suggestion) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{66, 0}
+}
+
+func (x *SuggestResponse_Suggestion) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *SuggestResponse_Suggestion) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *SuggestResponse_Suggestion) GetPlayability() int32 {
+	if x != nil {
+		return x.Playability
+	}
+	return 0
+}
+
+// A Neighbor is an alphagram in the lexicon that's one tile away: with a
+// tile removed, a tile added, or one tile swapped for another.
+type NeighborsResponse_Neighbor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alphagram string `protobuf:"bytes,1,opt,name=alphagram,proto3" json:"alphagram,omitempty"`
+	// The tile added and the tile removed; a swap has both.
+	Added       string `protobuf:"bytes,2,opt,name=added,proto3" json:"added,omitempty"`
+	Removed     string `protobuf:"bytes,3,opt,name=removed,proto3" json:"removed,omitempty"`
+	NumWords    int32  `protobuf:"varint,4,opt,name=num_words,json=numWords,proto3" json:"num_words,omitempty"`
+	Probability int32  `protobuf:"varint,5,opt,name=probability,proto3" json:"probability,omitempty"`
+}
+
+func (x *NeighborsResponse_Neighbor) Reset() {
+	*x = NeighborsResponse_Neighbor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_searcher_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NeighborsResponse_Neighbor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NeighborsResponse_Neighbor) ProtoMessage() {}
+
+func (x *NeighborsResponse_Neighbor) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_searcher_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NeighborsResponse_Neighbor.ProtoReflect.Descriptor instead.
+func (*NeighborsResponse_Neighbor) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_searcher_proto_rawDescGZIP(), []int{68, 0}
+}
+
+func (x *NeighborsResponse_Neighbor) GetAlphagram() string {
+	if x != nil {
+		return x.Alphagram
+	}
+	return ""
+}
+
+func (x *NeighborsResponse_Neighbor) GetAdded() string {
+	if x != nil {
+		return x.Added
+	}
+	return ""
+}
+
+func (x *NeighborsResponse_Neighbor) GetRemoved() string {
+	if x != nil {
+		return x.Removed
+	}
+	return ""
+}
+
+func (x *NeighborsResponse_Neighbor) GetNumWords() int32 {
+	if x != nil {
+		return x.NumWords
+	}
+	return 0
+}
+
+func (x *NeighborsResponse_Neighbor) GetProbability() int32 {
+	if x != nil {
+		return x.Probability
+	}
+	return 0
+}
+
+var File_wordsearcher_searcher_proto protoreflect.FileDescriptor
+
+var file_wordsearcher_searcher_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2f, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x22, 0xa5, 0x03, 0x0a, 0x09,
+	0x41, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x28, 0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x65, 0x70,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65,
+	0x64, 0x52, 0x65, 0x70, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x20, 0x0a,
+	0x0b, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75,
+	0x6c, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d,
+	0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x6f, 0x77, 0x65, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x62,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x76,
+	0x6f, 0x77, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x78,
+	0x69, 0x63, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x78, 0x69,
+	0x63, 0x6f, 0x6e, 0x22, 0xc7, 0x02, 0x0a, 0x04, 0x57, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x1e,
+	0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x63, 0x6b, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x27,
+	0x0a, 0x0f, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e,
+	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x6e, 0x6e, 0x65, 0x72,
+	0x5f, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x48, 0x6f, 0x6f,
+	0x6b, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x5f,
+	0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x6e, 0x65,
+	0x72, 0x42, 0x61, 0x63, 0x6b, 0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x85, 0x1c,
+	0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x4b, 0x0a, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x0c,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78,
+	0x70, 0x61, 0x6e, 0x64, 0x12, 0x44, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x69,
+	0x6e, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x70, 0x69, 0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x4c, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x65,
+	0x78, 0x70, 0x61, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x45, 0x78, 0x70, 0x61, 0x6e,
+	0x64, 0x12, 0x50, 0x0a, 0x0e, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x61, 0x6e, 0x61, 0x67,
+	0x72, 0x61, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x41, 0x6e, 0x61,
+	0x67, 0x72, 0x61, 0x6d, 0x52, 0x0d, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x41, 0x6e, 0x61, 0x67,
+	0x72, 0x61, 0x6d, 0x1a, 0x3d, 0x0a, 0x0d, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x41, 0x6e, 0x61,
+	0x67, 0x72, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x75, 0x66,
+	0x66, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x68, 0x75, 0x66, 0x66,
+	0x6c, 0x65, 0x1a, 0x2c, 0x0a, 0x06, 0x4d, 0x69, 0x6e, 0x4d, 0x61, 0x78, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x61, 0x78,
+	0x1a, 0x2e, 0x0a, 0x08, 0x4d, 0x69, 0x6e, 0x4d, 0x61, 0x78, 0x36, 0x34, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x61, 0x78,
+	0x1a, 0x23, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x25, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41,
+	0x72, 0x72, 0x61, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x25, 0x0a, 0x0b,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x1a, 0x23, 0x0a, 0x0b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x4d, 0x0a, 0x11, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x62,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x1a, 0x5e, 0x0a, 0x15, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x45, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x38, 0x0a, 0x0c, 0x52, 0x61, 0x6e, 0x64, 0x6f,
+	0x6d, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65,
+	0x64, 0x1a, 0xa0, 0x01, 0x0a, 0x0b, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x44, 0x69, 0x66,
+	0x66, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x6c, 0x65, 0x78, 0x69, 0x63,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x4c,
+	0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x2e, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x2a, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52,
+	0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x57, 0x4f, 0x52, 0x44, 0x53, 0x5f, 0x44, 0x49, 0x46, 0x46,
+	0x45, 0x52, 0x10, 0x01, 0x1a, 0xa8, 0x01, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x29, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d,
+	0x62, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4f, 0x70, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x3f,
+	0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22,
+	0x1e, 0x0a, 0x02, 0x4f, 0x70, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x06,
+	0x0a, 0x02, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x4f, 0x54, 0x10, 0x02, 0x1a,
+	0x5f, 0x0a, 0x05, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x1a, 0xa3, 0x07, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x12, 0x43, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x6d, 0x61, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x4d, 0x69, 0x6e, 0x4d, 0x61, 0x78, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x69, 0x6e,
+	0x6d, 0x61, 0x78, 0x12, 0x4b, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x4b, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x61, 0x72, 0x72, 0x61, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00,
+	0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x61, 0x72, 0x72, 0x61, 0x79, 0x12, 0x4b, 0x0a,
+	0x0b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x61, 0x72, 0x72, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
+	0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x61, 0x72, 0x72, 0x61, 0x79, 0x12, 0x4b, 0x0a, 0x0b, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4e, 0x0a, 0x0c, 0x72, 0x61, 0x6e, 0x64, 0x6f,
+	0x6d, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x6f,
+	0x6d, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x61, 0x6e, 0x64, 0x6f,
+	0x6d, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x4b, 0x0a, 0x0b, 0x6c, 0x65, 0x78, 0x69, 0x63,
+	0x6f, 0x6e, 0x64, 0x69, 0x66, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x6f,
+	0x6e, 0x44, 0x69, 0x66, 0x66, 0x48, 0x00, 0x52, 0x0b, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e,
+	0x64, 0x69, 0x66, 0x66, 0x12, 0x48, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72,
+	0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x42,
+	0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x6d, 0x61, 0x78, 0x36, 0x34, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x69,
+	0x6e, 0x4d, 0x61, 0x78, 0x36, 0x34, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x6d, 0x61, 0x78,
+	0x36, 0x34, 0x12, 0x65, 0x0a, 0x13, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x70, 0x72, 0x6f, 0x62,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x13, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x70, 0x72, 0x6f, 0x62,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x05, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x05, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x42, 0x10, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x22, 0x3d, 0x0a, 0x09, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x42,
+	0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x4f, 0x52,
+	0x54, 0x5f, 0x56, 0x4f, 0x57, 0x45, 0x4c, 0x5f, 0x50, 0x52, 0x4f, 0x42, 0x41, 0x42, 0x49, 0x4c,
+	0x49, 0x54, 0x59, 0x10, 0x01, 0x22, 0x6f, 0x0a, 0x09, 0x57, 0x6f, 0x72, 0x64, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x4f, 0x4e,
+	0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x52, 0x4f, 0x4e, 0x54, 0x5f, 0x48, 0x4f, 0x4f, 0x4b,
+	0x53, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x48, 0x4f, 0x4f, 0x4b,
+	0x53, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x45, 0x58, 0x49, 0x43, 0x4f, 0x4e, 0x5f, 0x53,
+	0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x53, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4e, 0x4e, 0x45,
+	0x52, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x53, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x53, 0x10, 0x05, 0x22, 0xce, 0x07, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0b, 0x0a, 0x07, 0x4c, 0x45, 0x58, 0x49, 0x43, 0x4f, 0x4e, 0x10,
+	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x45, 0x4e, 0x47, 0x54, 0x48, 0x10, 0x01, 0x12, 0x15, 0x0a,
+	0x11, 0x50, 0x52, 0x4f, 0x42, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x41, 0x4e,
+	0x47, 0x45, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x52, 0x4f, 0x42, 0x41, 0x42, 0x49, 0x4c,
+	0x49, 0x54, 0x59, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52,
+	0x4f, 0x42, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10,
+	0x04, 0x12, 0x16, 0x0a, 0x12, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x4f, 0x46, 0x5f, 0x41,
+	0x4e, 0x41, 0x47, 0x52, 0x41, 0x4d, 0x53, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x4e, 0x55, 0x4d,
+	0x42, 0x45, 0x52, 0x5f, 0x4f, 0x46, 0x5f, 0x56, 0x4f, 0x57, 0x45, 0x4c, 0x53, 0x10, 0x06, 0x12,
+	0x0c, 0x0a, 0x08, 0x48, 0x41, 0x53, 0x5f, 0x54, 0x41, 0x47, 0x53, 0x10, 0x07, 0x12, 0x0f, 0x0a,
+	0x0b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x08, 0x12, 0x14,
+	0x0a, 0x10, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x4e, 0x41, 0x47, 0x52,
+	0x41, 0x4d, 0x10, 0x09, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x4c, 0x50, 0x48, 0x41, 0x47, 0x52, 0x41,
+	0x4d, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x0a, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x4f, 0x54, 0x5f,
+	0x49, 0x4e, 0x5f, 0x4c, 0x45, 0x58, 0x49, 0x43, 0x4f, 0x4e, 0x10, 0x0b, 0x12, 0x0d, 0x0a, 0x09,
+	0x57, 0x4f, 0x52, 0x44, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x0d, 0x12, 0x17, 0x0a, 0x13, 0x53,
+	0x49, 0x4e, 0x47, 0x4c, 0x45, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x4c, 0x45, 0x4e, 0x47,
+	0x54, 0x48, 0x10, 0x0e, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x55, 0x4d, 0x5f, 0x54, 0x57, 0x4f, 0x5f,
+	0x42, 0x4c, 0x41, 0x4e, 0x4b, 0x53, 0x10, 0x0f, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x41, 0x58, 0x5f,
+	0x53, 0x4f, 0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x10, 0x12, 0x14, 0x0a, 0x10, 0x44,
+	0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10,
+	0x11, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x4c, 0x41, 0x59, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59,
+	0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x12, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x45, 0x4c, 0x45,
+	0x54, 0x45, 0x44, 0x5f, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x13, 0x12, 0x19, 0x0a, 0x15, 0x4e, 0x55,
+	0x4d, 0x42, 0x45, 0x52, 0x5f, 0x4f, 0x46, 0x5f, 0x46, 0x52, 0x4f, 0x4e, 0x54, 0x5f, 0x48, 0x4f,
+	0x4f, 0x4b, 0x53, 0x10, 0x14, 0x12, 0x18, 0x0a, 0x14, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x5f,
+	0x4f, 0x46, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x53, 0x10, 0x15, 0x12,
+	0x17, 0x0a, 0x13, 0x46, 0x52, 0x4f, 0x4e, 0x54, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x53, 0x5f, 0x49,
+	0x4e, 0x43, 0x4c, 0x55, 0x44, 0x45, 0x10, 0x16, 0x12, 0x16, 0x0a, 0x12, 0x42, 0x41, 0x43, 0x4b,
+	0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x53, 0x5f, 0x49, 0x4e, 0x43, 0x4c, 0x55, 0x44, 0x45, 0x10, 0x17,
+	0x12, 0x17, 0x0a, 0x13, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43,
+	0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x53, 0x10, 0x18, 0x12, 0x1b, 0x0a, 0x17, 0x56, 0x4f, 0x57,
+	0x45, 0x4c, 0x5f, 0x50, 0x52, 0x4f, 0x42, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x52,
+	0x41, 0x4e, 0x47, 0x45, 0x10, 0x19, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d,
+	0x5f, 0x53, 0x41, 0x4d, 0x50, 0x4c, 0x45, 0x10, 0x1a, 0x12, 0x10, 0x0a, 0x0c, 0x4c, 0x45, 0x58,
+	0x49, 0x43, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x10, 0x1b, 0x12, 0x0f, 0x0a, 0x0b, 0x57,
+	0x4f, 0x52, 0x44, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x1c, 0x12, 0x0e, 0x0a, 0x0a,
+	0x43, 0x4f, 0x4d, 0x42, 0x49, 0x4e, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x1d, 0x12, 0x14, 0x0a, 0x10,
+	0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x53, 0x5f, 0x4c, 0x45, 0x54, 0x54, 0x45, 0x52, 0x53,
+	0x10, 0x1e, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x58, 0x43, 0x4c, 0x55, 0x44, 0x45, 0x53, 0x5f, 0x4c,
+	0x45, 0x54, 0x54, 0x45, 0x52, 0x53, 0x10, 0x1f, 0x12, 0x0f, 0x0a, 0x0b, 0x56, 0x4f, 0x57, 0x45,
+	0x4c, 0x5f, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x10, 0x20, 0x12, 0x1c, 0x0a, 0x18, 0x4e, 0x55, 0x4d,
+	0x42, 0x45, 0x52, 0x5f, 0x4f, 0x46, 0x5f, 0x42, 0x4c, 0x41, 0x4e, 0x4b, 0x5f, 0x41, 0x4e, 0x41,
+	0x47, 0x52, 0x41, 0x4d, 0x53, 0x10, 0x21, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f, 0x4d, 0x42, 0x49,
+	0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x22, 0x12,
+	0x0b, 0x0a, 0x07, 0x48, 0x41, 0x53, 0x5f, 0x54, 0x41, 0x47, 0x10, 0x23, 0x12, 0x1a, 0x0a, 0x16,
+	0x4f, 0x52, 0x44, 0x45, 0x52, 0x45, 0x44, 0x5f, 0x41, 0x4c, 0x50, 0x48, 0x41, 0x47, 0x52, 0x41,
+	0x4d, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x24, 0x12, 0x1c, 0x0a, 0x18, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x45, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x42, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f,
+	0x4c, 0x49, 0x53, 0x54, 0x10, 0x25, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x10,
+	0x26, 0x12, 0x13, 0x0a, 0x0f, 0x48, 0x41, 0x53, 0x5f, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x5f, 0x48,
+	0x4f, 0x4f, 0x4b, 0x53, 0x10, 0x27, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x4f, 0x5f, 0x49, 0x4e, 0x4e,
+	0x45, 0x52, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x53, 0x10, 0x28, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x44,
+	0x44, 0x45, 0x44, 0x5f, 0x49, 0x4e, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x55, 0x50, 0x44, 0x41,
+	0x54, 0x45, 0x53, 0x10, 0x29, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x50, 0x4c,
+	0x55, 0x53, 0x5f, 0x4f, 0x4e, 0x45, 0x10, 0x2a, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x52, 0x4f, 0x42,
+	0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x50, 0x45,
+	0x52, 0x5f, 0x4c, 0x45, 0x4e, 0x47, 0x54, 0x48, 0x10, 0x2b, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x4f,
+	0x53, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x42, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x2c, 0x12, 0x12, 0x0a,
+	0x0e, 0x4c, 0x45, 0x41, 0x53, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x42, 0x41, 0x42, 0x4c, 0x45, 0x10,
+	0x2d, 0x22, 0x04, 0x08, 0x0c, 0x10, 0x0c, 0x22, 0x3c, 0x0a, 0x11, 0x4e, 0x6f, 0x74, 0x49, 0x6e,
+	0x4c, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x11, 0x0a, 0x0d,
+	0x4f, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x45, 0x4e, 0x47, 0x4c, 0x49, 0x53, 0x48, 0x10, 0x00, 0x12,
+	0x14, 0x0a, 0x10, 0x50, 0x52, 0x45, 0x56, 0x49, 0x4f, 0x55, 0x53, 0x5f, 0x56, 0x45, 0x52, 0x53,
+	0x49, 0x4f, 0x4e, 0x10, 0x01, 0x22, 0x97, 0x02, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41, 0x6c, 0x70, 0x68,
+	0x61, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x0a, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x05, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x5f, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10,
+	0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22,
+	0xbc, 0x01, 0x0a, 0x0e, 0x41, 0x6e, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x72, 0x2e, 0x41, 0x6e, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65,
+	0x78, 0x70, 0x61, 0x6e, 0x64, 0x22, 0x27, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x09, 0x0a,
+	0x05, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x55, 0x49, 0x4c,
+	0x44, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x55, 0x50, 0x45, 0x52, 0x10, 0x02, 0x22, 0x58,
+	0x0a, 0x0f, 0x41, 0x6e, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x28, 0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x57, 0x6f, 0x72, 0x64, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e,
+	0x75, 0x6d, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x6e, 0x75, 0x6d, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x22, 0xcd, 0x01, 0x0a, 0x1b, 0x42, 0x6c, 0x61,
+	0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x78, 0x69,
+	0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63,
+	0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x6d, 0x61, 0x78, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x11,
+	0x6e, 0x75, 0x6d, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x32, 0x5f, 0x62, 0x6c, 0x61, 0x6e, 0x6b,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x57, 0x69, 0x74, 0x68,
+	0x32, 0x42, 0x6c, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x64, 0x5f,
+	0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x77, 0x6f,
+	0x72, 0x64, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0xf7, 0x01, 0x0a, 0x1b, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x78, 0x69,
+	0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63,
+	0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x53, 0x6f,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x6d, 0x61, 0x78, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6d,
+	0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x5f, 0x73, 0x6f, 0x6c,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x4a, 0x0a, 0x16, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c,
+	0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0xda,
+	0x05, 0x0a, 0x0f, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x61,
+	0x6d, 0x69, 0x6c, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x64, 0x62, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x62, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4e,
+	0x0a, 0x0d, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x0c, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x53,
+	0x0a, 0x13, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x78, 0x69, 0x63,
+	0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x69, 0x6c, 0x65, 0x52,
+	0x12, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x54, 0x0a, 0x0f, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x78, 0x69,
+	0x63, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4c, 0x65, 0x78, 0x69,
+	0x63, 0x6f, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x0e, 0x6c, 0x65, 0x78, 0x69, 0x63,
+	0x6f, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x61, 0x70,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x69, 0x0a,
+	0x0b, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x57, 0x6f, 0x72, 0x64,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x75, 0x6d, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72,
+	0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x41, 0x6c,
+	0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x60, 0x0a, 0x04, 0x54, 0x69, 0x6c, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x77, 0x65, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x6f, 0x77, 0x65, 0x6c, 0x1a, 0x5f, 0x0a, 0x0d, 0x4c, 0x65,
+	0x78, 0x69, 0x63, 0x6f, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x2f, 0x0a, 0x13, 0x4c,
+	0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x22, 0x8f, 0x04, 0x0a,
+	0x0c, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x2e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x12, 0x40, 0x0a, 0x07, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x2e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x07, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x73, 0x12, 0x4e, 0x0a, 0x0e, 0x61, 0x6e, 0x61,
+	0x67, 0x72, 0x61, 0x6d, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72,
+	0x2e, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x41, 0x6e,
+	0x61, 0x67, 0x72, 0x61, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0d, 0x61, 0x6e, 0x61, 0x67,
+	0x72, 0x61, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0xb8, 0x01, 0x0a, 0x0b, 0x4c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x75, 0x6d, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72,
+	0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x41, 0x6c,
+	0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x75, 0x6d,
+	0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x76, 0x67, 0x5f, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d,
+	0x61, 0x76, 0x67, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x25, 0x0a,
+	0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x5f, 0x74, 0x6f, 0x5f, 0x6c, 0x65, 0x78,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x55, 0x6e, 0x69, 0x71, 0x54,
+	0x6f, 0x4c, 0x65, 0x78, 0x1a, 0x58, 0x0a, 0x0c, 0x41, 0x6e, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x61, 0x6e, 0x61, 0x67,
+	0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x41,
+	0x6e, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x75, 0x6d, 0x5f, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x6e, 0x75, 0x6d, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x2d,
+	0x0a, 0x11, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x22, 0xe3, 0x02,
+	0x0a, 0x0a, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07,
+	0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c,
+	0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x42, 0x0a, 0x0a, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d,
+	0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x1a, 0x58, 0x0a, 0x09, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x35, 0x0a, 0x05,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x73, 0x22, 0x6d, 0x0a, 0x12, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x30, 0x0a, 0x06, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x6c, 0x65, 0x78, 0x69,
+	0x63, 0x61, 0x22, 0x47, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x78,
+	0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x78, 0x69,
+	0x63, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0xbb, 0x04, 0x0a, 0x0c,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x44, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
+	0x72, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x1a, 0x86, 0x01, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0xa9, 0x02,
+	0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x12,
+	0x38, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x70,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x62, 0x69,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6d,
+	0x62, 0x69, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x75, 0x73, 0x74, 0x5f,
+	0x62, 0x65, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6d,
+	0x75, 0x73, 0x74, 0x42, 0x65, 0x4c, 0x61, 0x73, 0x74, 0x22, 0x35, 0x0a, 0x19, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e,
+	0x22, 0x9d, 0x01, 0x0a, 0x12, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x44, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x54, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x69, 0x6c, 0x65, 0x73,
+	0x22, 0x45, 0x0a, 0x15, 0x52, 0x61, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x78,
+	0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x78, 0x69,
+	0x63, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x72, 0x61, 0x63, 0x6b, 0x22, 0xe4, 0x01, 0x0a, 0x16, 0x52, 0x61, 0x63, 0x6b,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x52, 0x0a, 0x0c, 0x65, 0x78, 0x63, 0x65,
+	0x73, 0x73, 0x5f, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f,
+	0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x52, 0x61,
+	0x63, 0x6b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x78, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x6c, 0x65, 0x52,
+	0x0b, 0x65, 0x78, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x6c, 0x65, 0x73, 0x1a, 0x60, 0x0a, 0x0a,
+	0x45, 0x78, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xe5,
+	0x01, 0x0a, 0x17, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65,
+	0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x78,
+	0x69, 0x63, 0x6f, 0x6e, 0x12, 0x50, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x07, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x1a, 0x46,
+	0x0a, 0x10, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x58, 0x0a, 0x18, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64,
+	0x22, 0xa1, 0x01, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e,
+	0x12, 0x38, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24,
+	0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x38, 0x0a, 0x04, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x41, 0x53, 0x53, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12,
+	0x08, 0x0a, 0x04, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x53,
+	0x54, 0x41, 0x52, 0x54, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41,
+	0x54, 0x45, 0x10, 0x03, 0x22, 0x8a, 0x01, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x77,
+	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x77, 0x61, 0x6c, 0x12, 0x12, 0x0a,
+	0x04, 0x62, 0x75, 0x73, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x62, 0x75, 0x73,
+	0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x6f, 0x67, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x73,
+	0x12, 0x2f, 0x0a, 0x13, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x64,
+	0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x46, 0x72, 0x61, 0x6d, 0x65,
+	0x73, 0x22, 0x42, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x64, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x11, 0x57, 0x6f, 0x72, 0x64, 0x4a, 0x75,
+	0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x05, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x4a, 0x75,
+	0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4a, 0x75, 0x64, 0x67,
+	0x65, 0x64, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x1a, 0x36, 0x0a,
+	0x0a, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x22, 0x72, 0x0a, 0x0f, 0x48, 0x6f, 0x6f, 0x6b, 0x54, 0x72, 0x65,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x78, 0x69,
+	0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x6d, 0x61, 0x78, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x08, 0x48, 0x6f,
+	0x6f, 0x6b, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f,
+	0x6f, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66,
+	0x72, 0x6f, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x08,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x70, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x22, 0x5c, 0x0a, 0x10, 0x48, 0x6f, 0x6f, 0x6b, 0x54, 0x72, 0x65, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04,
+	0x72, 0x6f, 0x6f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x22, 0x73, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64,
+	0x62, 0x6f, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
+	0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x22, 0x53, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x43, 0x61, 0x72, 0x64, 0x73, 0x22, 0xc3, 0x01, 0x0a,
+	0x04, 0x43, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72,
+	0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67,
+	0x72, 0x61, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x6f, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x03, 0x62, 0x6f, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x64, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x63,
+	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e, 0x75,
+	0x6d, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x5f,
+	0x69, 0x6e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x6e, 0x75, 0x6d, 0x49, 0x6e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x12, 0x33, 0x0a,
+	0x08, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41,
+	0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x08, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64,
+	0x65, 0x64, 0x22, 0x80, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x63, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x49, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x22, 0x3e, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x41,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
+	0x04, 0x63, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52,
+	0x04, 0x63, 0x61, 0x72, 0x64, 0x22, 0x72, 0x0a, 0x0f, 0x44, 0x75, 0x65, 0x43, 0x61, 0x72, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x22, 0x6f, 0x0a, 0x10, 0x44, 0x75, 0x65,
+	0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x05, 0x63, 0x61, 0x72, 0x64,
+	0x73, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x75, 0x6d, 0x5f, 0x64, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x44, 0x75, 0x65, 0x22, 0x6a, 0x0a, 0x14, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65,
+	0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x78,
+	0x69, 0x63, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x73, 0x76, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x03, 0x63, 0x73, 0x76, 0x22, 0x70, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e,
+	0x6f, 0x74, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x6e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x49, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x61, 0x72, 0x64, 0x62, 0x6f,
+	0x78, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72,
+	0x64, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10,
+	0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x4a, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x43, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x61, 0x72, 0x64, 0x62, 0x6f,
+	0x78, 0x49, 0x64, 0x22, 0x65, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x61,
+	0x72, 0x64, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x22, 0x2d, 0x0a, 0x17, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0xa2, 0x01, 0x0a, 0x0e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x56,
+	0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x63, 0x61,
+	0x72, 0x64, 0x62, 0x6f, 0x78, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x43, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x52, 0x09, 0x63, 0x61, 0x72,
+	0x64, 0x62, 0x6f, 0x78, 0x65, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x0b, 0x53, 0x61, 0x76, 0x65, 0x64,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x22, 0x70, 0x0a, 0x11, 0x53, 0x61, 0x76, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x33, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x06, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x22, 0x6e, 0x0a, 0x12, 0x53, 0x61, 0x76, 0x65, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x73,
+	0x61, 0x76, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72,
+	0x2e, 0x53, 0x61, 0x76, 0x65, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x0b, 0x73, 0x61,
+	0x76, 0x65, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x64, 0x22, 0x2e, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x76,
+	0x65, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x5d, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x76,
+	0x65, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x73, 0x61, 0x76, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x64, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x0d, 0x73, 0x61, 0x76, 0x65, 0x64, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x15, 0x52, 0x75, 0x6e, 0x53, 0x61, 0x76, 0x65,
+	0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x22, 0x42, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x61, 0x76,
+	0x65, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x61, 0x76, 0x65, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf8, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x6e, 0x64, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0a, 0x63,
+	0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x00, 0x52, 0x09, 0x63, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x0a,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3b, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x22,
+	0xa9, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x70, 0x52, 0x02, 0x6f,
+	0x70, 0x12, 0x35, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x52, 0x08,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x22, 0x31, 0x0a, 0x02, 0x4f, 0x70, 0x12, 0x09,
+	0x0a, 0x05, 0x55, 0x4e, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e, 0x54,
+	0x45, 0x52, 0x53, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x44,
+	0x49, 0x46, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x10, 0x02, 0x22, 0xaf, 0x01, 0x0a, 0x13,
+	0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f,
+	0x6e, 0x12, 0x39, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78,
+	0x70, 0x61, 0x6e, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x61, 0x76, 0x65, 0x5f, 0x61, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x61, 0x76, 0x65, 0x41, 0x73, 0x22, 0x6b, 0x0a,
+	0x14, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x49, 0x64, 0x22, 0xd0, 0x01, 0x0a, 0x12, 0x4d,
+	0x69, 0x73, 0x73, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x07, 0x61,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x4d, 0x69, 0x73, 0x73,
+	0x65, 0x64, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x1a,
+	0x3c, 0x0a, 0x06, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x9d, 0x04,
+	0x0a, 0x13, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0a, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72,
+	0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x57,
+	0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x64, 0x52, 0x0a, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x43, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x1a, 0xaa, 0x01, 0x0a, 0x06, 0x47, 0x72, 0x61, 0x64, 0x65, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x69, 0x73, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x6e,
+	0x75, 0x6d, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x6e, 0x75, 0x6d, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f,
+	0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e,
+	0x75, 0x6d, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x64, 0x1a, 0xc9, 0x01, 0x0a, 0x07, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x25, 0x0a,
+	0x0e, 0x6e, 0x75, 0x6d, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x67,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x53, 0x6f, 0x6c,
+	0x76, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x57, 0x6f, 0x72, 0x64, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x57, 0x72, 0x6f, 0x6e, 0x67, 0x22, 0xc1, 0x03,
+	0x0a, 0x0d, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x62,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x33, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61,
+	0x72, 0x74, 0x2e, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x1a, 0x8e, 0x02, 0x0a, 0x03, 0x52, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x62, 0x61, 0x63, 0x6b, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x28, 0x0a, 0x10,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x68, 0x6f, 0x6f, 0x6b,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x46, 0x72, 0x6f,
+	0x6e, 0x74, 0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f,
+	0x62, 0x61, 0x63, 0x6b, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0d, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x42, 0x61, 0x63, 0x6b, 0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x27,
+	0x0a, 0x0f, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e,
+	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x22, 0x67, 0x0a, 0x16, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61,
+	0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6c,
+	0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65,
+	0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x72, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61,
+	0x72, 0x74, 0x52, 0x06, 0x63, 0x68, 0x61, 0x72, 0x74, 0x73, 0x22, 0x60, 0x0a, 0x11, 0x57, 0x6f,
+	0x72, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x6c, 0x6f,
+	0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x6c, 0x6f, 0x62, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x54, 0x6f, 0x22, 0x3d, 0x0a, 0x0d,
+	0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x3e, 0x0a, 0x12, 0x57,
+	0x6f, 0x72, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x28, 0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x57, 0x6f, 0x72, 0x64, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x77, 0x0a, 0x0e, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d,
+	0x61, 0x78, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0xd3, 0x01, 0x0a, 0x0f, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x4a,
+	0x0a, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x65, 0x72, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x5e, 0x0a, 0x0a, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70,
+	0x6c, 0x61, 0x79, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x4a, 0x0a, 0x10, 0x4e, 0x65,
+	0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x22, 0xc6, 0x02, 0x0a, 0x11, 0x4e, 0x65, 0x69, 0x67, 0x68,
+	0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x46, 0x0a,
+	0x09, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x52, 0x09, 0x6e, 0x65, 0x69, 0x67,
+	0x68, 0x62, 0x6f, 0x72, 0x73, 0x1a, 0x97, 0x01, 0x0a, 0x08, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62,
+	0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x32,
+	0xc7, 0x02, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1b,
+	0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x06, 0x45, 0x78, 0x70,
+	0x61, 0x6e, 0x64, 0x12, 0x1c, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x1a, 0x1c, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x52, 0x0a, 0x0b, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x20,
+	0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x4d, 0x69,
+	0x73, 0x73, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0e, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x68, 0x61, 0x72, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x1a, 0x24, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x65, 0x72, 0x2e, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xad, 0x03, 0x0a, 0x0a, 0x41, 0x6e,
+	0x61, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x07, 0x41, 0x6e, 0x61, 0x67,
+	0x72, 0x61, 0x6d, 0x12, 0x1c, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x65, 0x72, 0x2e, 0x41, 0x6e, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72,
+	0x2e, 0x41, 0x6e, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x60, 0x0a, 0x15, 0x42, 0x6c, 0x61, 0x6e, 0x6b, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x29, 0x2e, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x42, 0x6c, 0x61, 0x6e, 0x6b, 0x43, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x60, 0x0a, 0x15, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x29, 0x2e, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x05, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x12, 0x1e, 0x2e,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x57, 0x6f, 0x72,
+	0x64, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x57, 0x6f, 0x72,
+	0x64, 0x4a, 0x75, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49,
+	0x0a, 0x08, 0x48, 0x6f, 0x6f, 0x6b, 0x54, 0x72, 0x65, 0x65, 0x12, 0x1d, 0x2e, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x54, 0x72,
+	0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x54, 0x72, 0x65,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xca, 0x02, 0x0a, 0x0c, 0x57, 0x6f,
+	0x72, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x12, 0x53, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x57, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x57, 0x6f, 0x72,
+	0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4f, 0x0a, 0x0a, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1f, 0x2e,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x57, 0x6f, 0x72,
+	0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x57, 0x6f,
+	0x72, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x46, 0x0a, 0x07, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x09, 0x4e, 0x65, 0x69, 0x67,
+	0x68, 0x62, 0x6f, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x72, 0x2e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x72, 0x2e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x95, 0x04, 0x0a, 0x0b, 0x4c, 0x65, 0x78, 0x69, 0x63,
+	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x59, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x78,
+	0x69, 0x63, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x24, 0x2e, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x78, 0x69,
+	0x63, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
+	0x72, 0x2e, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x59, 0x0a, 0x0c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x63,
+	0x6b, 0x12, 0x23, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72,
+	0x2e, 0x52, 0x61, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x63, 0x6b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x44, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x52, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1f, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72,
+	0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
+	0x72, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x21, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x46, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x21, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x4c,
+	0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
+	0x72, 0x2e, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x32, 0xbc,
+	0x01, 0x0a, 0x05, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x62, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0a,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xd6, 0x05,
+	0x0a, 0x0d, 0x51, 0x75, 0x69, 0x7a, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x12,
+	0x58, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78,
+	0x12, 0x22, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x62, 0x6f,
+	0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0c, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x41,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x49, 0x0a, 0x08, 0x44, 0x75, 0x65, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1d, 0x2e, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x44, 0x75, 0x65, 0x43,
+	0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x44, 0x75, 0x65, 0x43, 0x61,
+	0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0c, 0x43,
+	0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69,
+	0x6e, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x43, 0x6f,
+	0x6d, 0x62, 0x69, 0x6e, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x58, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x22, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x65, 0x72, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0d,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x12, 0x22, 0x2e,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x43, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x12, 0x23, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43,
+	0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x61,
+	0x72, 0x64, 0x62, 0x6f, 0x78, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x61,
+	0x72, 0x64, 0x62, 0x6f, 0x78, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x61, 0x72, 0x64, 0x62, 0x6f, 0x78, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x81, 0x03, 0x0a, 0x0d, 0x53, 0x61, 0x76, 0x65, 0x64,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x12, 0x4f, 0x0a, 0x0a, 0x53, 0x61, 0x76, 0x65,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1f, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x12, 0x26,
+	0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x53, 0x0a, 0x0e, 0x52, 0x75, 0x6e, 0x53, 0x61, 0x76, 0x65, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x12, 0x23, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72,
+	0x2e, 0x52, 0x75, 0x6e, 0x53, 0x61, 0x76, 0x65, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x61,
+	0x76, 0x65, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x26, 0x2e, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x61, 0x76, 0x65, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x64, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x6f, 0x6d, 0x69, 0x6e, 0x6f, 0x31,
+	0x34, 0x2f, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x64, 0x62, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2f, 0x72, 0x70, 0x63, 0x2f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
+	0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_wordsearcher_searcher_proto_rawDescOnce sync.Once
+	file_wordsearcher_searcher_proto_rawDescData = file_wordsearcher_searcher_proto_rawDesc
+)
+
+func file_wordsearcher_searcher_proto_rawDescGZIP() []byte {
+	file_wordsearcher_searcher_proto_rawDescOnce.Do(func() {
+		file_wordsearcher_searcher_proto_rawDescData = protoimpl.X.CompressGZIP(file_wordsearcher_searcher_proto_rawDescData)
+	})
+	return file_wordsearcher_searcher_proto_rawDescData
+}
+
+var file_wordsearcher_searcher_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_wordsearcher_searcher_proto_msgTypes = make([]protoimpl.MessageInfo, 101)
+var file_wordsearcher_searcher_proto_goTypes = []interface{}{
+	(SearchRequest_SortOrder)(0),                     // 0: wordsearcher.SearchRequest.SortOrder
+	(SearchRequest_WordField)(0),                     // 1: wordsearcher.SearchRequest.WordField
+	(SearchRequest_Condition)(0),                     // 2: wordsearcher.SearchRequest.Condition
+	(SearchRequest_NotInLexCondition)(0),             // 3: wordsearcher.SearchRequest.NotInLexCondition
+	(SearchRequest_LexiconDiff_Mode)(0),              // 4: wordsearcher.SearchRequest.LexiconDiff.Mode
+	(SearchRequest_Combinator_Op)(0),                 // 5: wordsearcher.SearchRequest.Combinator.Op
+	(AnagramRequest_Mode)(0),                         // 6: wordsearcher.AnagramRequest.Mode
+	(CheckpointRequest_Mode)(0),                      // 7: wordsearcher.CheckpointRequest.Mode
+	(ListOperation_Op)(0),                            // 8: wordsearcher.ListOperation.Op
+	(*Alphagram)(nil),                                // 9: wordsearcher.Alphagram
+	(*Word)(nil),                                     // 10: wordsearcher.Word
+	(*SearchRequest)(nil),                            // 11: wordsearcher.SearchRequest
+	(*SearchResponse)(nil),                           // 12: wordsearcher.SearchResponse
+	(*AnagramRequest)(nil),                           // 13: wordsearcher.AnagramRequest
+	(*AnagramResponse)(nil),                          // 14: wordsearcher.AnagramResponse
+	(*BlankChallengeCreateRequest)(nil),              // 15: wordsearcher.BlankChallengeCreateRequest
+	(*BuildChallengeCreateRequest)(nil),              // 16: wordsearcher.BuildChallengeCreateRequest
+	(*LexiconMetadataRequest)(nil),                   // 17: wordsearcher.LexiconMetadataRequest
+	(*LexiconMetadata)(nil),                          // 18: wordsearcher.LexiconMetadata
+	(*LexiconStatsRequest)(nil),                      // 19: wordsearcher.LexiconStatsRequest
+	(*LexiconStats)(nil),                             // 20: wordsearcher.LexiconStats
+	(*SchemaInfoRequest)(nil),                        // 21: wordsearcher.SchemaInfoRequest
+	(*SchemaInfo)(nil),                               // 22: wordsearcher.SchemaInfo
+	(*SchemaInfoResponse)(nil),                       // 23: wordsearcher.SchemaInfoResponse
+	(*SearchSchemaRequest)(nil),                      // 24: wordsearcher.SearchSchemaRequest
+	(*SearchSchema)(nil),                             // 25: wordsearcher.SearchSchema
+	(*LetterDistributionRequest)(nil),                // 26: wordsearcher.LetterDistributionRequest
+	(*LetterDistribution)(nil),                       // 27: wordsearcher.LetterDistribution
+	(*RackValidationRequest)(nil),                    // 28: wordsearcher.RackValidationRequest
+	(*RackValidationResponse)(nil),                   // 29: wordsearcher.RackValidationResponse
+	(*DefinitionUpdateRequest)(nil),                  // 30: wordsearcher.DefinitionUpdateRequest
+	(*DefinitionUpdateResponse)(nil),                 // 31: wordsearcher.DefinitionUpdateResponse
+	(*CheckpointRequest)(nil),                        // 32: wordsearcher.CheckpointRequest
+	(*CheckpointResponse)(nil),                       // 33: wordsearcher.CheckpointResponse
+	(*WordJudgeRequest)(nil),                         // 34: wordsearcher.WordJudgeRequest
+	(*WordJudgeResponse)(nil),                        // 35: wordsearcher.WordJudgeResponse
+	(*HookTreeRequest)(nil),                          // 36: wordsearcher.HookTreeRequest
+	(*HookNode)(nil),                                 // 37: wordsearcher.HookNode
+	(*HookTreeResponse)(nil),                         // 38: wordsearcher.HookTreeResponse
+	(*CreateCardboxRequest)(nil),                     // 39: wordsearcher.CreateCardboxRequest
+	(*CreateCardboxResponse)(nil),                    // 40: wordsearcher.CreateCardboxResponse
+	(*Card)(nil),                                     // 41: wordsearcher.Card
+	(*RecordAnswerRequest)(nil),                      // 42: wordsearcher.RecordAnswerRequest
+	(*RecordAnswerResponse)(nil),                     // 43: wordsearcher.RecordAnswerResponse
+	(*DueCardsRequest)(nil),                          // 44: wordsearcher.DueCardsRequest
+	(*DueCardsResponse)(nil),                         // 45: wordsearcher.DueCardsResponse
+	(*ImportHistoryRequest)(nil),                     // 46: wordsearcher.ImportHistoryRequest
+	(*ImportHistoryResponse)(nil),                    // 47: wordsearcher.ImportHistoryResponse
+	(*DeleteCardboxRequest)(nil),                     // 48: wordsearcher.DeleteCardboxRequest
+	(*DeleteCardboxResponse)(nil),                    // 49: wordsearcher.DeleteCardboxResponse
+	(*RestoreCardboxRequest)(nil),                    // 50: wordsearcher.RestoreCardboxRequest
+	(*RestoreCardboxResponse)(nil),                   // 51: wordsearcher.RestoreCardboxResponse
+	(*DeletedCardboxesRequest)(nil),                  // 52: wordsearcher.DeletedCardboxesRequest
+	(*DeletedCardbox)(nil),                           // 53: wordsearcher.DeletedCardbox
+	(*DeletedCardboxesResponse)(nil),                 // 54: wordsearcher.DeletedCardboxesResponse
+	(*SavedSearch)(nil),                              // 55: wordsearcher.SavedSearch
+	(*SaveSearchRequest)(nil),                        // 56: wordsearcher.SaveSearchRequest
+	(*SaveSearchResponse)(nil),                       // 57: wordsearcher.SaveSearchResponse
+	(*ListSavedSearchesRequest)(nil),                 // 58: wordsearcher.ListSavedSearchesRequest
+	(*ListSavedSearchesResponse)(nil),                // 59: wordsearcher.ListSavedSearchesResponse
+	(*RunSavedSearchRequest)(nil),                    // 60: wordsearcher.RunSavedSearchRequest
+	(*DeleteSavedSearchRequest)(nil),                 // 61: wordsearcher.DeleteSavedSearchRequest
+	(*DeleteSavedSearchResponse)(nil),                // 62: wordsearcher.DeleteSavedSearchResponse
+	(*ListOperand)(nil),                              // 63: wordsearcher.ListOperand
+	(*ListOperation)(nil),                            // 64: wordsearcher.ListOperation
+	(*CombineListsRequest)(nil),                      // 65: wordsearcher.CombineListsRequest
+	(*CombineListsResponse)(nil),                     // 66: wordsearcher.CombineListsResponse
+	(*MissedWordsRequest)(nil),                       // 67: wordsearcher.MissedWordsRequest
+	(*MissedWordsResponse)(nil),                      // 68: wordsearcher.MissedWordsResponse
+	(*SolutionChart)(nil),                            // 69: wordsearcher.SolutionChart
+	(*SolutionChartsResponse)(nil),                   // 70: wordsearcher.SolutionChartsResponse
+	(*WordSearchRequest)(nil),                        // 71: wordsearcher.WordSearchRequest
+	(*DefineRequest)(nil),                            // 72: wordsearcher.DefineRequest
+	(*WordSearchResponse)(nil),                       // 73: wordsearcher.WordSearchResponse
+	(*SuggestRequest)(nil),                           // 74: wordsearcher.SuggestRequest
+	(*SuggestResponse)(nil),                          // 75: wordsearcher.SuggestResponse
+	(*NeighborsRequest)(nil),                         // 76: wordsearcher.NeighborsRequest
+	(*NeighborsResponse)(nil),                        // 77: wordsearcher.NeighborsResponse
+	(*SearchRequest_SingleAnagram)(nil),              // 78: wordsearcher.SearchRequest.SingleAnagram
+	(*SearchRequest_MinMax)(nil),                     // 79: wordsearcher.SearchRequest.MinMax
+	(*SearchRequest_MinMax64)(nil),                   // 80: wordsearcher.SearchRequest.MinMax64
+	(*SearchRequest_StringValue)(nil),                // 81: wordsearcher.SearchRequest.StringValue
+	(*SearchRequest_StringArray)(nil),                // 82: wordsearcher.SearchRequest.StringArray
+	(*SearchRequest_NumberArray)(nil),                // 83: wordsearcher.SearchRequest.NumberArray
+	(*SearchRequest_NumberValue)(nil),                // 84: wordsearcher.SearchRequest.NumberValue
+	(*SearchRequest_LengthProbability)(nil),          // 85: wordsearcher.SearchRequest.LengthProbability
+	(*SearchRequest_LengthProbabilityList)(nil),      // 86: wordsearcher.SearchRequest.LengthProbabilityList
+	(*SearchRequest_RandomSample)(nil),               // 87: wordsearcher.SearchRequest.RandomSample
+	(*SearchRequest_LexiconDiff)(nil),                // 88: wordsearcher.SearchRequest.LexiconDiff
+	(*SearchRequest_Combinator)(nil),                 // 89: wordsearcher.SearchRequest.Combinator
+	(*SearchRequest_Build)(nil),                      // 90: wordsearcher.SearchRequest.Build
+	(*SearchRequest_SearchParam)(nil),                // 91: wordsearcher.SearchRequest.SearchParam
+	(*LexiconMetadata_LengthCount)(nil),              // 92: wordsearcher.LexiconMetadata.LengthCount
+	(*LexiconMetadata_Tile)(nil),                     // 93: wordsearcher.LexiconMetadata.Tile
+	(*LexiconMetadata_LexiconSymbol)(nil),            // 94: wordsearcher.LexiconMetadata.LexiconSymbol
+	(*LexiconStats_LengthStats)(nil),                 // 95: wordsearcher.LexiconStats.LengthStats
+	(*LexiconStats_AnagramCount)(nil),                // 96: wordsearcher.LexiconStats.AnagramCount
+	(*SchemaInfo_Migration)(nil),                     // 97: wordsearcher.SchemaInfo.Migration
+	(*SchemaInfo_Table)(nil),                         // 98: wordsearcher.SchemaInfo.Table
+	(*SearchSchema_Field)(nil),                       // 99: wordsearcher.SearchSchema.Field
+	(*SearchSchema_Condition)(nil),                   // 100: wordsearcher.SearchSchema.Condition
+	(*RackValidationResponse_ExcessTile)(nil),        // 101: wordsearcher.RackValidationResponse.ExcessTile
+	(*DefinitionUpdateRequest_DefinitionUpdate)(nil), // 102: wordsearcher.DefinitionUpdateRequest.DefinitionUpdate
+	(*WordJudgeResponse_JudgedWord)(nil),             // 103: wordsearcher.WordJudgeResponse.JudgedWord
+	(*MissedWordsRequest_Answer)(nil),                // 104: wordsearcher.MissedWordsRequest.Answer
+	(*MissedWordsResponse_Graded)(nil),               // 105: wordsearcher.MissedWordsResponse.Graded
+	(*MissedWordsResponse_Summary)(nil),              // 106: wordsearcher.MissedWordsResponse.Summary
+	(*SolutionChart_Row)(nil),                        // 107: wordsearcher.SolutionChart.Row
+	(*SuggestResponse_Suggestion)(nil),               // 108: wordsearcher.SuggestResponse.Suggestion
+	(*NeighborsResponse_Neighbor)(nil),               // 109: wordsearcher.NeighborsResponse.Neighbor
+}
+var file_wordsearcher_searcher_proto_depIdxs = []int32{
+	10,  // 0: wordsearcher.Alphagram.words:type_name -> wordsearcher.Word
+	91,  // 1: wordsearcher.SearchRequest.searchparams:type_name -> wordsearcher.SearchRequest.SearchParam
+	0,   // 2: wordsearcher.SearchRequest.sort_order:type_name -> wordsearcher.SearchRequest.SortOrder
+	1,   // 3: wordsearcher.SearchRequest.partial_expand:type_name -> wordsearcher.SearchRequest.WordField
+	78,  // 4: wordsearcher.SearchRequest.single_anagram:type_name -> wordsearcher.SearchRequest.SingleAnagram
+	9,   // 5: wordsearcher.SearchResponse.alphagrams:type_name -> wordsearcher.Alphagram
+	6,   // 6: wordsearcher.AnagramRequest.mode:type_name -> wordsearcher.AnagramRequest.Mode
+	10,  // 7: wordsearcher.AnagramResponse.words:type_name -> wordsearcher.Word
+	92,  // 8: wordsearcher.LexiconMetadata.length_counts:type_name -> wordsearcher.LexiconMetadata.LengthCount
+	93,  // 9: wordsearcher.LexiconMetadata.letter_distribution:type_name -> wordsearcher.LexiconMetadata.Tile
+	94,  // 10: wordsearcher.LexiconMetadata.lexicon_symbols:type_name -> wordsearcher.LexiconMetadata.LexiconSymbol
+	95,  // 11: wordsearcher.LexiconStats.totals:type_name -> wordsearcher.LexiconStats.LengthStats
+	95,  // 12: wordsearcher.LexiconStats.lengths:type_name -> wordsearcher.LexiconStats.LengthStats
+	96,  // 13: wordsearcher.LexiconStats.anagram_counts:type_name -> wordsearcher.LexiconStats.AnagramCount
+	97,  // 14: wordsearcher.SchemaInfo.migrations:type_name -> wordsearcher.SchemaInfo.Migration
+	98,  // 15: wordsearcher.SchemaInfo.tables:type_name -> wordsearcher.SchemaInfo.Table
+	22,  // 16: wordsearcher.SchemaInfoResponse.lexica:type_name -> wordsearcher.SchemaInfo
+	100, // 17: wordsearcher.SearchSchema.conditions:type_name -> wordsearcher.SearchSchema.Condition
+	22,  // 18: wordsearcher.SearchSchema.schema:type_name -> wordsearcher.SchemaInfo
+	93,  // 19: wordsearcher.LetterDistribution.tiles:type_name -> wordsearcher.LexiconMetadata.Tile
+	101, // 20: wordsearcher.RackValidationResponse.excess_tiles:type_name -> wordsearcher.RackValidationResponse.ExcessTile
+	102, // 21: wordsearcher.DefinitionUpdateRequest.updates:type_name -> wordsearcher.DefinitionUpdateRequest.DefinitionUpdate
+	7,   // 22: wordsearcher.CheckpointRequest.mode:type_name -> wordsearcher.CheckpointRequest.Mode
+	103, // 23: wordsearcher.WordJudgeResponse.words:type_name -> wordsearcher.WordJudgeResponse.JudgedWord
+	37,  // 24: wordsearcher.HookNode.children:type_name -> wordsearcher.HookNode
+	37,  // 25: wordsearcher.HookTreeResponse.root:type_name -> wordsearcher.HookNode
+	11,  // 26: wordsearcher.CreateCardboxRequest.search:type_name -> wordsearcher.SearchRequest
+	9,   // 27: wordsearcher.Card.expanded:type_name -> wordsearcher.Alphagram
+	41,  // 28: wordsearcher.RecordAnswerResponse.card:type_name -> wordsearcher.Card
+	41,  // 29: wordsearcher.DueCardsResponse.cards:type_name -> wordsearcher.Card
+	53,  // 30: wordsearcher.DeletedCardboxesResponse.cardboxes:type_name -> wordsearcher.DeletedCardbox
+	11,  // 31: wordsearcher.SavedSearch.search:type_name -> wordsearcher.SearchRequest
+	11,  // 32: wordsearcher.SaveSearchRequest.search:type_name -> wordsearcher.SearchRequest
+	55,  // 33: wordsearcher.SaveSearchResponse.saved_search:type_name -> wordsearcher.SavedSearch
+	55,  // 34: wordsearcher.ListSavedSearchesResponse.saved_searches:type_name -> wordsearcher.SavedSearch
+	11,  // 35: wordsearcher.ListOperand.search:type_name -> wordsearcher.SearchRequest
+	82,  // 36: wordsearcher.ListOperand.alphagrams:type_name -> wordsearcher.SearchRequest.StringArray
+	64,  // 37: wordsearcher.ListOperand.operation:type_name -> wordsearcher.ListOperation
+	8,   // 38: wordsearcher.ListOperation.op:type_name -> wordsearcher.ListOperation.Op
+	63,  // 39: wordsearcher.ListOperation.operands:type_name -> wordsearcher.ListOperand
+	64,  // 40: wordsearcher.CombineListsRequest.operation:type_name -> wordsearcher.ListOperation
+	12,  // 41: wordsearcher.CombineListsResponse.result:type_name -> wordsearcher.SearchResponse
+	104, // 42: wordsearcher.MissedWordsRequest.answers:type_name -> wordsearcher.MissedWordsRequest.Answer
+	105, // 43: wordsearcher.MissedWordsResponse.alphagrams:type_name -> wordsearcher.MissedWordsResponse.Graded
+	106, // 44: wordsearcher.MissedWordsResponse.summary:type_name -> wordsearcher.MissedWordsResponse.Summary
+	107, // 45: wordsearcher.SolutionChart.rows:type_name -> wordsearcher.SolutionChart.Row
+	69,  // 46: wordsearcher.SolutionChartsResponse.charts:type_name -> wordsearcher.SolutionChart
+	10,  // 47: wordsearcher.WordSearchResponse.words:type_name -> wordsearcher.Word
+	108, // 48: wordsearcher.SuggestResponse.suggestions:type_name -> wordsearcher.SuggestResponse.Suggestion
+	109, // 49: wordsearcher.NeighborsResponse.neighbors:type_name -> wordsearcher.NeighborsResponse.Neighbor
+	85,  // 50: wordsearcher.SearchRequest.LengthProbabilityList.values:type_name -> wordsearcher.SearchRequest.LengthProbability
+	4,   // 51: wordsearcher.SearchRequest.LexiconDiff.mode:type_name -> wordsearcher.SearchRequest.LexiconDiff.Mode
+	5,   // 52: wordsearcher.SearchRequest.Combinator.op:type_name -> wordsearcher.SearchRequest.Combinator.Op
+	91,  // 53: wordsearcher.SearchRequest.Combinator.params:type_name -> wordsearcher.SearchRequest.SearchParam
+	2,   // 54: wordsearcher.SearchRequest.SearchParam.condition:type_name -> wordsearcher.SearchRequest.Condition
+	79,  // 55: wordsearcher.SearchRequest.SearchParam.minmax:type_name -> wordsearcher.SearchRequest.MinMax
+	81,  // 56: wordsearcher.SearchRequest.SearchParam.stringvalue:type_name -> wordsearcher.SearchRequest.StringValue
+	82,  // 57: wordsearcher.SearchRequest.SearchParam.stringarray:type_name -> wordsearcher.SearchRequest.StringArray
+	83,  // 58: wordsearcher.SearchRequest.SearchParam.numberarray:type_name -> wordsearcher.SearchRequest.NumberArray
+	84,  // 59: wordsearcher.SearchRequest.SearchParam.numbervalue:type_name -> wordsearcher.SearchRequest.NumberValue
+	87,  // 60: wordsearcher.SearchRequest.SearchParam.randomsample:type_name -> wordsearcher.SearchRequest.RandomSample
+	88,  // 61: wordsearcher.SearchRequest.SearchParam.lexicondiff:type_name -> wordsearcher.SearchRequest.LexiconDiff
+	89,  // 62: wordsearcher.SearchRequest.SearchParam.combinator:type_name -> wordsearcher.SearchRequest.Combinator
+	80,  // 63: wordsearcher.SearchRequest.SearchParam.minmax64:type_name -> wordsearcher.SearchRequest.MinMax64
+	86,  // 64: wordsearcher.SearchRequest.SearchParam.lengthprobabilities:type_name -> wordsearcher.SearchRequest.LengthProbabilityList
+	90,  // 65: wordsearcher.SearchRequest.SearchParam.build:type_name -> wordsearcher.SearchRequest.Build
+	99,  // 66: wordsearcher.SearchSchema.Condition.fields:type_name -> wordsearcher.SearchSchema.Field
+	11,  // 67: wordsearcher.QuestionSearcher.Search:input_type -> wordsearcher.SearchRequest
+	12,  // 68: wordsearcher.QuestionSearcher.Expand:input_type -> wordsearcher.SearchResponse
+	67,  // 69: wordsearcher.QuestionSearcher.MissedWords:input_type -> wordsearcher.MissedWordsRequest
+	12,  // 70: wordsearcher.QuestionSearcher.SolutionCharts:input_type -> wordsearcher.SearchResponse
+	13,  // 71: wordsearcher.Anagrammer.Anagram:input_type -> wordsearcher.AnagramRequest
+	15,  // 72: wordsearcher.Anagrammer.BlankChallengeCreator:input_type -> wordsearcher.BlankChallengeCreateRequest
+	16,  // 73: wordsearcher.Anagrammer.BuildChallengeCreator:input_type -> wordsearcher.BuildChallengeCreateRequest
+	34,  // 74: wordsearcher.Anagrammer.Judge:input_type -> wordsearcher.WordJudgeRequest
+	36,  // 75: wordsearcher.Anagrammer.HookTree:input_type -> wordsearcher.HookTreeRequest
+	72,  // 76: wordsearcher.WordSearcher.GetWordInformation:input_type -> wordsearcher.DefineRequest
+	71,  // 77: wordsearcher.WordSearcher.WordSearch:input_type -> wordsearcher.WordSearchRequest
+	74,  // 78: wordsearcher.WordSearcher.Suggest:input_type -> wordsearcher.SuggestRequest
+	76,  // 79: wordsearcher.WordSearcher.Neighbors:input_type -> wordsearcher.NeighborsRequest
+	17,  // 80: wordsearcher.LexiconInfo.GetLexiconMetadata:input_type -> wordsearcher.LexiconMetadataRequest
+	28,  // 81: wordsearcher.LexiconInfo.ValidateRack:input_type -> wordsearcher.RackValidationRequest
+	26,  // 82: wordsearcher.LexiconInfo.GetLetterDistribution:input_type -> wordsearcher.LetterDistributionRequest
+	21,  // 83: wordsearcher.LexiconInfo.GetSchemaInfo:input_type -> wordsearcher.SchemaInfoRequest
+	24,  // 84: wordsearcher.LexiconInfo.GetSearchSchema:input_type -> wordsearcher.SearchSchemaRequest
+	19,  // 85: wordsearcher.LexiconInfo.Stats:input_type -> wordsearcher.LexiconStatsRequest
+	30,  // 86: wordsearcher.Admin.UpdateDefinitions:input_type -> wordsearcher.DefinitionUpdateRequest
+	32,  // 87: wordsearcher.Admin.Checkpoint:input_type -> wordsearcher.CheckpointRequest
+	39,  // 88: wordsearcher.QuizScheduler.CreateCardbox:input_type -> wordsearcher.CreateCardboxRequest
+	42,  // 89: wordsearcher.QuizScheduler.RecordAnswer:input_type -> wordsearcher.RecordAnswerRequest
+	44,  // 90: wordsearcher.QuizScheduler.DueCards:input_type -> wordsearcher.DueCardsRequest
+	65,  // 91: wordsearcher.QuizScheduler.CombineLists:input_type -> wordsearcher.CombineListsRequest
+	46,  // 92: wordsearcher.QuizScheduler.ImportHistory:input_type -> wordsearcher.ImportHistoryRequest
+	48,  // 93: wordsearcher.QuizScheduler.DeleteCardbox:input_type -> wordsearcher.DeleteCardboxRequest
+	50,  // 94: wordsearcher.QuizScheduler.RestoreCardbox:input_type -> wordsearcher.RestoreCardboxRequest
+	52,  // 95: wordsearcher.QuizScheduler.DeletedCardboxes:input_type -> wordsearcher.DeletedCardboxesRequest
+	56,  // 96: wordsearcher.SavedSearches.SaveSearch:input_type -> wordsearcher.SaveSearchRequest
+	58,  // 97: wordsearcher.SavedSearches.ListSavedSearches:input_type -> wordsearcher.ListSavedSearchesRequest
+	60,  // 98: wordsearcher.SavedSearches.RunSavedSearch:input_type -> wordsearcher.RunSavedSearchRequest
+	61,  // 99: wordsearcher.SavedSearches.DeleteSavedSearch:input_type -> wordsearcher.DeleteSavedSearchRequest
+	12,  // 100: wordsearcher.QuestionSearcher.Search:output_type -> wordsearcher.SearchResponse
+	12,  // 101: wordsearcher.QuestionSearcher.Expand:output_type -> wordsearcher.SearchResponse
+	68,  // 102: wordsearcher.QuestionSearcher.MissedWords:output_type -> wordsearcher.MissedWordsResponse
+	70,  // 103: wordsearcher.QuestionSearcher.SolutionCharts:output_type -> wordsearcher.SolutionChartsResponse
+	14,  // 104: wordsearcher.Anagrammer.Anagram:output_type -> wordsearcher.AnagramResponse
+	12,  // 105: wordsearcher.Anagrammer.BlankChallengeCreator:output_type -> wordsearcher.SearchResponse
+	12,  // 106: wordsearcher.Anagrammer.BuildChallengeCreator:output_type -> wordsearcher.SearchResponse
+	35,  // 107: wordsearcher.Anagrammer.Judge:output_type -> wordsearcher.WordJudgeResponse
+	38,  // 108: wordsearcher.Anagrammer.HookTree:output_type -> wordsearcher.HookTreeResponse
+	73,  // 109: wordsearcher.WordSearcher.GetWordInformation:output_type -> wordsearcher.WordSearchResponse
+	73,  // 110: wordsearcher.WordSearcher.WordSearch:output_type -> wordsearcher.WordSearchResponse
+	75,  // 111: wordsearcher.WordSearcher.Suggest:output_type -> wordsearcher.SuggestResponse
+	77,  // 112: wordsearcher.WordSearcher.Neighbors:output_type -> wordsearcher.NeighborsResponse
+	18,  // 113: wordsearcher.LexiconInfo.GetLexiconMetadata:output_type -> wordsearcher.LexiconMetadata
+	29,  // 114: wordsearcher.LexiconInfo.ValidateRack:output_type -> wordsearcher.RackValidationResponse
+	27,  // 115: wordsearcher.LexiconInfo.GetLetterDistribution:output_type -> wordsearcher.LetterDistribution
+	23,  // 116: wordsearcher.LexiconInfo.GetSchemaInfo:output_type -> wordsearcher.SchemaInfoResponse
+	25,  // 117: wordsearcher.LexiconInfo.GetSearchSchema:output_type -> wordsearcher.SearchSchema
+	20,  // 118: wordsearcher.LexiconInfo.Stats:output_type -> wordsearcher.LexiconStats
+	31,  // 119: wordsearcher.Admin.UpdateDefinitions:output_type -> wordsearcher.DefinitionUpdateResponse
+	33,  // 120: wordsearcher.Admin.Checkpoint:output_type -> wordsearcher.CheckpointResponse
+	40,  // 121: wordsearcher.QuizScheduler.CreateCardbox:output_type -> wordsearcher.CreateCardboxResponse
+	43,  // 122: wordsearcher.QuizScheduler.RecordAnswer:output_type -> wordsearcher.RecordAnswerResponse
+	45,  // 123: wordsearcher.QuizScheduler.DueCards:output_type -> wordsearcher.DueCardsResponse
+	66,  // 124: wordsearcher.QuizScheduler.CombineLists:output_type -> wordsearcher.CombineListsResponse
+	47,  // 125: wordsearcher.QuizScheduler.ImportHistory:output_type -> wordsearcher.ImportHistoryResponse
+	49,  // 126: wordsearcher.QuizScheduler.DeleteCardbox:output_type -> wordsearcher.DeleteCardboxResponse
+	51,  // 127: wordsearcher.QuizScheduler.RestoreCardbox:output_type -> wordsearcher.RestoreCardboxResponse
+	54,  // 128: wordsearcher.QuizScheduler.DeletedCardboxes:output_type -> wordsearcher.DeletedCardboxesResponse
+	57,  // 129: wordsearcher.SavedSearches.SaveSearch:output_type -> wordsearcher.SaveSearchResponse
+	59,  // 130: wordsearcher.SavedSearches.ListSavedSearches:output_type -> wordsearcher.ListSavedSearchesResponse
+	12,  // 131: wordsearcher.SavedSearches.RunSavedSearch:output_type -> wordsearcher.SearchResponse
+	62,  // 132: wordsearcher.SavedSearches.DeleteSavedSearch:output_type -> wordsearcher.DeleteSavedSearchResponse
+	100, // [100:133] is the sub-list for method output_type
+	67,  // [67:100] is the sub-list for method input_type
+	67,  // [67:67] is the sub-list for extension type_name
+	67,  // [67:67] is the sub-list for extension extendee
+	0,   // [0:67] is the sub-list for field type_name
+}
+
+func init() { file_wordsearcher_searcher_proto_init() }
+func file_wordsearcher_searcher_proto_init() {
+	if File_wordsearcher_searcher_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_wordsearcher_searcher_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Alphagram); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Word); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnagramRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnagramResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlankChallengeCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildChallengeCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LexiconMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LexiconMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LexiconStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LexiconStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchemaInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchemaInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchemaInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchSchemaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchSchema); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LetterDistributionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LetterDistribution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RackValidationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RackValidationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DefinitionUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DefinitionUpdateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckpointRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckpointResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WordJudgeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WordJudgeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HookTreeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HookNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HookTreeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCardboxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCardboxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Card); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordAnswerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordAnswerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DueCardsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DueCardsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCardboxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCardboxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreCardboxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreCardboxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletedCardboxesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletedCardbox); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletedCardboxesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SavedSearch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveSearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveSearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSavedSearchesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSavedSearchesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunSavedSearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSavedSearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSavedSearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOperand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CombineListsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CombineListsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MissedWordsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MissedWordsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SolutionChart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SolutionChartsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WordSearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DefineRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1696,8 +9793,8 @@ func file_wordsearcher_searcher_proto_init() {
 				return nil
 			}
 		}
-		file_wordsearcher_searcher_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Word); i {
+		file_wordsearcher_searcher_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WordSearchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1708,8 +9805,8 @@ func file_wordsearcher_searcher_proto_init() {
 				return nil
 			}
 		}
-		file_wordsearcher_searcher_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchRequest); i {
+		file_wordsearcher_searcher_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1720,8 +9817,8 @@ func file_wordsearcher_searcher_proto_init() {
 				return nil
 			}
 		}
-		file_wordsearcher_searcher_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchResponse); i {
+		file_wordsearcher_searcher_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1732,8 +9829,8 @@ func file_wordsearcher_searcher_proto_init() {
 				return nil
 			}
 		}
-		file_wordsearcher_searcher_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AnagramRequest); i {
+		file_wordsearcher_searcher_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NeighborsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1744,8 +9841,8 @@ func file_wordsearcher_searcher_proto_init() {
 				return nil
 			}
 		}
-		file_wordsearcher_searcher_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AnagramResponse); i {
+		file_wordsearcher_searcher_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NeighborsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1756,8 +9853,8 @@ func file_wordsearcher_searcher_proto_init() {
 				return nil
 			}
 		}
-		file_wordsearcher_searcher_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlankChallengeCreateRequest); i {
+		file_wordsearcher_searcher_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest_SingleAnagram); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1768,8 +9865,8 @@ func file_wordsearcher_searcher_proto_init() {
 				return nil
 			}
 		}
-		file_wordsearcher_searcher_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BuildChallengeCreateRequest); i {
+		file_wordsearcher_searcher_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest_MinMax); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1780,8 +9877,8 @@ func file_wordsearcher_searcher_proto_init() {
 				return nil
 			}
 		}
-		file_wordsearcher_searcher_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WordSearchRequest); i {
+		file_wordsearcher_searcher_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest_MinMax64); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1792,8 +9889,8 @@ func file_wordsearcher_searcher_proto_init() {
 				return nil
 			}
 		}
-		file_wordsearcher_searcher_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DefineRequest); i {
+		file_wordsearcher_searcher_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest_StringValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1804,8 +9901,8 @@ func file_wordsearcher_searcher_proto_init() {
 				return nil
 			}
 		}
-		file_wordsearcher_searcher_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WordSearchResponse); i {
+		file_wordsearcher_searcher_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest_StringArray); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1816,8 +9913,8 @@ func file_wordsearcher_searcher_proto_init() {
 				return nil
 			}
 		}
-		file_wordsearcher_searcher_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchRequest_MinMax); i {
+		file_wordsearcher_searcher_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest_NumberArray); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1828,8 +9925,8 @@ func file_wordsearcher_searcher_proto_init() {
 				return nil
 			}
 		}
-		file_wordsearcher_searcher_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchRequest_StringValue); i {
+		file_wordsearcher_searcher_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest_NumberValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1840,8 +9937,8 @@ func file_wordsearcher_searcher_proto_init() {
 				return nil
 			}
 		}
-		file_wordsearcher_searcher_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchRequest_StringArray); i {
+		file_wordsearcher_searcher_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest_LengthProbability); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1852,8 +9949,8 @@ func file_wordsearcher_searcher_proto_init() {
 				return nil
 			}
 		}
-		file_wordsearcher_searcher_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchRequest_NumberArray); i {
+		file_wordsearcher_searcher_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest_LengthProbabilityList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1864,8 +9961,8 @@ func file_wordsearcher_searcher_proto_init() {
 				return nil
 			}
 		}
-		file_wordsearcher_searcher_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchRequest_NumberValue); i {
+		file_wordsearcher_searcher_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest_RandomSample); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1876,7 +9973,43 @@ func file_wordsearcher_searcher_proto_init() {
 				return nil
 			}
 		}
-		file_wordsearcher_searcher_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_wordsearcher_searcher_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest_LexiconDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest_Combinator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest_Build); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchRequest_SearchParam); i {
 			case 0:
 				return &v.state
@@ -1888,23 +10021,251 @@ func file_wordsearcher_searcher_proto_init() {
 				return nil
 			}
 		}
+		file_wordsearcher_searcher_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LexiconMetadata_LengthCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LexiconMetadata_Tile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LexiconMetadata_LexiconSymbol); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LexiconStats_LengthStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LexiconStats_AnagramCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchemaInfo_Migration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchemaInfo_Table); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchSchema_Field); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchSchema_Condition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RackValidationResponse_ExcessTile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DefinitionUpdateRequest_DefinitionUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WordJudgeResponse_JudgedWord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MissedWordsRequest_Answer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MissedWordsResponse_Graded); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MissedWordsResponse_Summary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SolutionChart_Row); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestResponse_Suggestion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_searcher_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NeighborsResponse_Neighbor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_wordsearcher_searcher_proto_msgTypes[54].OneofWrappers = []interface{}{
+		(*ListOperand_Search)(nil),
+		(*ListOperand_CardboxId)(nil),
+		(*ListOperand_Alphagrams)(nil),
+		(*ListOperand_Operation)(nil),
 	}
-	file_wordsearcher_searcher_proto_msgTypes[16].OneofWrappers = []interface{}{
+	file_wordsearcher_searcher_proto_msgTypes[82].OneofWrappers = []interface{}{
 		(*SearchRequest_SearchParam_Minmax)(nil),
 		(*SearchRequest_SearchParam_Stringvalue)(nil),
 		(*SearchRequest_SearchParam_Stringarray)(nil),
 		(*SearchRequest_SearchParam_Numberarray)(nil),
 		(*SearchRequest_SearchParam_Numbervalue)(nil),
+		(*SearchRequest_SearchParam_Randomsample)(nil),
+		(*SearchRequest_SearchParam_Lexicondiff)(nil),
+		(*SearchRequest_SearchParam_Combinator)(nil),
+		(*SearchRequest_SearchParam_Minmax64)(nil),
+		(*SearchRequest_SearchParam_Lengthprobabilities)(nil),
+		(*SearchRequest_SearchParam_Build)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_wordsearcher_searcher_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   17,
+			NumEnums:      9,
+			NumMessages:   101,
 			NumExtensions: 0,
-			NumServices:   3,
+			NumServices:   7,
 		},
 		GoTypes:           file_wordsearcher_searcher_proto_goTypes,
 		DependencyIndexes: file_wordsearcher_searcher_proto_depIdxs,
@@ -43,6 +43,15 @@ type QuestionSearcher interface {
 	// search response (fully expanded). See expandedRepr above in
 	// the Alphagram field.
 	Expand(context.Context, *SearchResponse) (*SearchResponse, error)
+
+	// MissedWords grades a quiz's answers: for each alphagram, the words
+	// that were missed and the ones given that are wrong. Quiz clients can
+	// grade with it instead of expanding every question up front.
+	MissedWords(context.Context, *MissedWordsRequest) (*MissedWordsResponse, error)
+
+	// SolutionCharts expands alphagrams, like Expand, and returns their
+	// solution charts, so every front end shows the same ones.
+	SolutionCharts(context.Context, *SearchResponse) (*SolutionChartsResponse, error)
 }
 
 // ================================
@@ -51,7 +60,7 @@ type QuestionSearcher interface {
 
 type questionSearcherProtobufClient struct {
 	client      HTTPClient
-	urls        [2]string
+	urls        [4]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -79,9 +88,11 @@ func NewQuestionSearcherProtobufClient(baseURL string, client HTTPClient, opts .
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "wordsearcher", "QuestionSearcher")
-	urls := [2]string{
+	urls := [4]string{
 		serviceURL + "Search",
 		serviceURL + "Expand",
+		serviceURL + "MissedWords",
+		serviceURL + "SolutionCharts",
 	}
 
 	return &questionSearcherProtobufClient{
@@ -184,13 +195,105 @@ func (c *questionSearcherProtobufClient) callExpand(ctx context.Context, in *Sea
 	return out, nil
 }
 
+func (c *questionSearcherProtobufClient) MissedWords(ctx context.Context, in *MissedWordsRequest) (*MissedWordsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "QuestionSearcher")
+	ctx = ctxsetters.WithMethodName(ctx, "MissedWords")
+	caller := c.callMissedWords
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MissedWordsRequest) (*MissedWordsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MissedWordsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MissedWordsRequest) when calling interceptor")
+					}
+					return c.callMissedWords(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MissedWordsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MissedWordsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *questionSearcherProtobufClient) callMissedWords(ctx context.Context, in *MissedWordsRequest) (*MissedWordsResponse, error) {
+	out := new(MissedWordsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *questionSearcherProtobufClient) SolutionCharts(ctx context.Context, in *SearchResponse) (*SolutionChartsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "QuestionSearcher")
+	ctx = ctxsetters.WithMethodName(ctx, "SolutionCharts")
+	caller := c.callSolutionCharts
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SearchResponse) (*SolutionChartsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SearchResponse)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SearchResponse) when calling interceptor")
+					}
+					return c.callSolutionCharts(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SolutionChartsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SolutionChartsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *questionSearcherProtobufClient) callSolutionCharts(ctx context.Context, in *SearchResponse) (*SolutionChartsResponse, error) {
+	out := new(SolutionChartsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // ============================
 // QuestionSearcher JSON Client
 // ============================
 
 type questionSearcherJSONClient struct {
 	client      HTTPClient
-	urls        [2]string
+	urls        [4]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -218,9 +321,11 @@ func NewQuestionSearcherJSONClient(baseURL string, client HTTPClient, opts ...tw
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "wordsearcher", "QuestionSearcher")
-	urls := [2]string{
+	urls := [4]string{
 		serviceURL + "Search",
 		serviceURL + "Expand",
+		serviceURL + "MissedWords",
+		serviceURL + "SolutionCharts",
 	}
 
 	return &questionSearcherJSONClient{
@@ -323,6 +428,98 @@ func (c *questionSearcherJSONClient) callExpand(ctx context.Context, in *SearchR
 	return out, nil
 }
 
+func (c *questionSearcherJSONClient) MissedWords(ctx context.Context, in *MissedWordsRequest) (*MissedWordsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "QuestionSearcher")
+	ctx = ctxsetters.WithMethodName(ctx, "MissedWords")
+	caller := c.callMissedWords
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *MissedWordsRequest) (*MissedWordsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MissedWordsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MissedWordsRequest) when calling interceptor")
+					}
+					return c.callMissedWords(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MissedWordsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MissedWordsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *questionSearcherJSONClient) callMissedWords(ctx context.Context, in *MissedWordsRequest) (*MissedWordsResponse, error) {
+	out := new(MissedWordsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *questionSearcherJSONClient) SolutionCharts(ctx context.Context, in *SearchResponse) (*SolutionChartsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "QuestionSearcher")
+	ctx = ctxsetters.WithMethodName(ctx, "SolutionCharts")
+	caller := c.callSolutionCharts
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SearchResponse) (*SolutionChartsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SearchResponse)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SearchResponse) when calling interceptor")
+					}
+					return c.callSolutionCharts(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SolutionChartsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SolutionChartsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *questionSearcherJSONClient) callSolutionCharts(ctx context.Context, in *SearchResponse) (*SolutionChartsResponse, error) {
+	out := new(SolutionChartsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // ===============================
 // QuestionSearcher Server Handler
 // ===============================
@@ -426,6 +623,12 @@ func (s *questionSearcherServer) ServeHTTP(resp http.ResponseWriter, req *http.R
 	case "Expand":
 		s.serveExpand(ctx, resp, req)
 		return
+	case "MissedWords":
+		s.serveMissedWords(ctx, resp, req)
+		return
+	case "SolutionCharts":
+		s.serveSolutionCharts(ctx, resp, req)
+		return
 	default:
 		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
 		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
@@ -793,237 +996,419 @@ func (s *questionSearcherServer) serveExpandProtobuf(ctx context.Context, resp h
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *questionSearcherServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor0, 0
+func (s *questionSearcherServer) serveMissedWords(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveMissedWordsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveMissedWordsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
 }
 
-func (s *questionSearcherServer) ProtocGenTwirpVersion() string {
-	return "v8.1.2"
-}
+func (s *questionSearcherServer) serveMissedWordsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MissedWords")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
 
-// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
-// that is everything in a Twirp route except for the <Method>. This can be used for routing,
-// for example to identify the requests that are targeted to this service in a mux.
-func (s *questionSearcherServer) PathPrefix() string {
-	return baseServicePath(s.pathPrefix, "wordsearcher", "QuestionSearcher")
-}
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(MissedWordsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
 
-// ====================
-// Anagrammer Interface
-// ====================
+	handler := s.QuestionSearcher.MissedWords
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MissedWordsRequest) (*MissedWordsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*MissedWordsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*MissedWordsRequest) when calling interceptor")
+					}
+					return s.QuestionSearcher.MissedWords(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*MissedWordsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*MissedWordsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
 
-type Anagrammer interface {
-	// Anagram does a simple anagram search; it can either be
-	// build mode or regular (exact) mode.
-	Anagram(context.Context, *AnagramRequest) (*AnagramResponse, error)
+	// Call service method
+	var respContent *MissedWordsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
 
-	// BlankChallengeCreator creates blank challenges for Aerolith
-	BlankChallengeCreator(context.Context, *BlankChallengeCreateRequest) (*SearchResponse, error)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *MissedWordsResponse and nil error while calling MissedWords. nil responses are not supported"))
+		return
+	}
 
-	// BuildChallengeCreator creates build challenges for Aerolith.
-	BuildChallengeCreator(context.Context, *BuildChallengeCreateRequest) (*SearchResponse, error)
-}
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-// ==========================
-// Anagrammer Protobuf Client
-// ==========================
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
 
-type anagrammerProtobufClient struct {
-	client      HTTPClient
-	urls        [3]string
-	interceptor twirp.Interceptor
-	opts        twirp.ClientOptions
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-// NewAnagrammerProtobufClient creates a Protobuf client that implements the Anagrammer interface.
-// It communicates using Protobuf and can be configured with a custom HTTPClient.
-func NewAnagrammerProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) Anagrammer {
-	if c, ok := client.(*http.Client); ok {
-		client = withoutRedirects(c)
-	}
-
-	clientOpts := twirp.ClientOptions{}
-	for _, o := range opts {
-		o(&clientOpts)
-	}
-
-	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
-	literalURLs := false
-	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
-	var pathPrefix string
-	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+func (s *questionSearcherServer) serveMissedWordsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "MissedWords")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
 	}
 
-	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
-	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "wordsearcher", "Anagrammer")
-	urls := [3]string{
-		serviceURL + "Anagram",
-		serviceURL + "BlankChallengeCreator",
-		serviceURL + "BuildChallengeCreator",
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
 	}
-
-	return &anagrammerProtobufClient{
-		client:      client,
-		urls:        urls,
-		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
-		opts:        clientOpts,
+	reqContent := new(MissedWordsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
 	}
-}
 
-func (c *anagrammerProtobufClient) Anagram(ctx context.Context, in *AnagramRequest) (*AnagramResponse, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
-	ctx = ctxsetters.WithServiceName(ctx, "Anagrammer")
-	ctx = ctxsetters.WithMethodName(ctx, "Anagram")
-	caller := c.callAnagram
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *AnagramRequest) (*AnagramResponse, error) {
-			resp, err := c.interceptor(
+	handler := s.QuestionSearcher.MissedWords
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *MissedWordsRequest) (*MissedWordsResponse, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*AnagramRequest)
+					typedReq, ok := req.(*MissedWordsRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*AnagramRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*MissedWordsRequest) when calling interceptor")
 					}
-					return c.callAnagram(ctx, typedReq)
+					return s.QuestionSearcher.MissedWords(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AnagramResponse)
+				typedResp, ok := resp.(*MissedWordsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AnagramResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*MissedWordsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *anagrammerProtobufClient) callAnagram(ctx context.Context, in *AnagramRequest) (*AnagramResponse, error) {
-	out := new(AnagramResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	// Call service method
+	var respContent *MissedWordsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *MissedWordsResponse and nil error while calling MissedWords. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-	return out, nil
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (c *anagrammerProtobufClient) BlankChallengeCreator(ctx context.Context, in *BlankChallengeCreateRequest) (*SearchResponse, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
-	ctx = ctxsetters.WithServiceName(ctx, "Anagrammer")
-	ctx = ctxsetters.WithMethodName(ctx, "BlankChallengeCreator")
-	caller := c.callBlankChallengeCreator
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *BlankChallengeCreateRequest) (*SearchResponse, error) {
-			resp, err := c.interceptor(
+func (s *questionSearcherServer) serveSolutionCharts(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSolutionChartsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSolutionChartsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *questionSearcherServer) serveSolutionChartsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SolutionCharts")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SearchResponse)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.QuestionSearcher.SolutionCharts
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SearchResponse) (*SolutionChartsResponse, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*BlankChallengeCreateRequest)
+					typedReq, ok := req.(*SearchResponse)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*BlankChallengeCreateRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*SearchResponse) when calling interceptor")
 					}
-					return c.callBlankChallengeCreator(ctx, typedReq)
+					return s.QuestionSearcher.SolutionCharts(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*SearchResponse)
+				typedResp, ok := resp.(*SolutionChartsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*SearchResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SolutionChartsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *anagrammerProtobufClient) callBlankChallengeCreator(ctx context.Context, in *BlankChallengeCreateRequest) (*SearchResponse, error) {
-	out := new(SearchResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	// Call service method
+	var respContent *SolutionChartsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SolutionChartsResponse and nil error while calling SolutionCharts. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-	return out, nil
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (c *anagrammerProtobufClient) BuildChallengeCreator(ctx context.Context, in *BuildChallengeCreateRequest) (*SearchResponse, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
-	ctx = ctxsetters.WithServiceName(ctx, "Anagrammer")
-	ctx = ctxsetters.WithMethodName(ctx, "BuildChallengeCreator")
-	caller := c.callBuildChallengeCreator
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *BuildChallengeCreateRequest) (*SearchResponse, error) {
-			resp, err := c.interceptor(
+func (s *questionSearcherServer) serveSolutionChartsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SolutionCharts")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SearchResponse)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.QuestionSearcher.SolutionCharts
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SearchResponse) (*SolutionChartsResponse, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*BuildChallengeCreateRequest)
+					typedReq, ok := req.(*SearchResponse)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*BuildChallengeCreateRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*SearchResponse) when calling interceptor")
 					}
-					return c.callBuildChallengeCreator(ctx, typedReq)
+					return s.QuestionSearcher.SolutionCharts(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*SearchResponse)
+				typedResp, ok := resp.(*SolutionChartsResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*SearchResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SolutionChartsResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *anagrammerProtobufClient) callBuildChallengeCreator(ctx context.Context, in *BuildChallengeCreateRequest) (*SearchResponse, error) {
-	out := new(SearchResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	// Call service method
+	var respContent *SolutionChartsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SolutionChartsResponse and nil error while calling SolutionCharts. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
-
-	return out, nil
-}
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-// ======================
-// Anagrammer JSON Client
-// ======================
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
 
-type anagrammerJSONClient struct {
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *questionSearcherServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 0
+}
+
+func (s *questionSearcherServer) ProtocGenTwirpVersion() string {
+	return "v8.1.2"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *questionSearcherServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "wordsearcher", "QuestionSearcher")
+}
+
+// ====================
+// Anagrammer Interface
+// ====================
+
+type Anagrammer interface {
+	// Anagram does a simple anagram search; it can either be
+	// build mode or regular (exact) mode.
+	Anagram(context.Context, *AnagramRequest) (*AnagramResponse, error)
+
+	// BlankChallengeCreator creates blank challenges for Aerolith
+	BlankChallengeCreator(context.Context, *BlankChallengeCreateRequest) (*SearchResponse, error)
+
+	// BuildChallengeCreator creates build challenges for Aerolith.
+	BuildChallengeCreator(context.Context, *BuildChallengeCreateRequest) (*SearchResponse, error)
+
+	// Judge adjudicates a play: it says which of its words are valid, and
+	// whether the play is acceptable as a whole.
+	Judge(context.Context, *WordJudgeRequest) (*WordJudgeResponse, error)
+
+	// HookTree returns a word's hooks, their hooks, and so on, for showing
+	// how short words extend into long ones.
+	HookTree(context.Context, *HookTreeRequest) (*HookTreeResponse, error)
+}
+
+// ==========================
+// Anagrammer Protobuf Client
+// ==========================
+
+type anagrammerProtobufClient struct {
 	client      HTTPClient
-	urls        [3]string
+	urls        [5]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
 
-// NewAnagrammerJSONClient creates a JSON client that implements the Anagrammer interface.
-// It communicates using JSON and can be configured with a custom HTTPClient.
-func NewAnagrammerJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) Anagrammer {
+// NewAnagrammerProtobufClient creates a Protobuf client that implements the Anagrammer interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewAnagrammerProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) Anagrammer {
 	if c, ok := client.(*http.Client); ok {
 		client = withoutRedirects(c)
 	}
@@ -1044,13 +1429,15 @@ func NewAnagrammerJSONClient(baseURL string, client HTTPClient, opts ...twirp.Cl
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(pathPrefix, "wordsearcher", "Anagrammer")
-	urls := [3]string{
+	urls := [5]string{
 		serviceURL + "Anagram",
 		serviceURL + "BlankChallengeCreator",
 		serviceURL + "BuildChallengeCreator",
+		serviceURL + "Judge",
+		serviceURL + "HookTree",
 	}
 
-	return &anagrammerJSONClient{
+	return &anagrammerProtobufClient{
 		client:      client,
 		urls:        urls,
 		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
@@ -1058,7 +1445,7 @@ func NewAnagrammerJSONClient(baseURL string, client HTTPClient, opts ...twirp.Cl
 	}
 }
 
-func (c *anagrammerJSONClient) Anagram(ctx context.Context, in *AnagramRequest) (*AnagramResponse, error) {
+func (c *anagrammerProtobufClient) Anagram(ctx context.Context, in *AnagramRequest) (*AnagramResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
 	ctx = ctxsetters.WithServiceName(ctx, "Anagrammer")
 	ctx = ctxsetters.WithMethodName(ctx, "Anagram")
@@ -1087,9 +1474,9 @@ func (c *anagrammerJSONClient) Anagram(ctx context.Context, in *AnagramRequest)
 	return caller(ctx, in)
 }
 
-func (c *anagrammerJSONClient) callAnagram(ctx context.Context, in *AnagramRequest) (*AnagramResponse, error) {
+func (c *anagrammerProtobufClient) callAnagram(ctx context.Context, in *AnagramRequest) (*AnagramResponse, error) {
 	out := new(AnagramResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1104,7 +1491,7 @@ func (c *anagrammerJSONClient) callAnagram(ctx context.Context, in *AnagramReque
 	return out, nil
 }
 
-func (c *anagrammerJSONClient) BlankChallengeCreator(ctx context.Context, in *BlankChallengeCreateRequest) (*SearchResponse, error) {
+func (c *anagrammerProtobufClient) BlankChallengeCreator(ctx context.Context, in *BlankChallengeCreateRequest) (*SearchResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
 	ctx = ctxsetters.WithServiceName(ctx, "Anagrammer")
 	ctx = ctxsetters.WithMethodName(ctx, "BlankChallengeCreator")
@@ -1133,9 +1520,9 @@ func (c *anagrammerJSONClient) BlankChallengeCreator(ctx context.Context, in *Bl
 	return caller(ctx, in)
 }
 
-func (c *anagrammerJSONClient) callBlankChallengeCreator(ctx context.Context, in *BlankChallengeCreateRequest) (*SearchResponse, error) {
+func (c *anagrammerProtobufClient) callBlankChallengeCreator(ctx context.Context, in *BlankChallengeCreateRequest) (*SearchResponse, error) {
 	out := new(SearchResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1150,7 +1537,7 @@ func (c *anagrammerJSONClient) callBlankChallengeCreator(ctx context.Context, in
 	return out, nil
 }
 
-func (c *anagrammerJSONClient) BuildChallengeCreator(ctx context.Context, in *BuildChallengeCreateRequest) (*SearchResponse, error) {
+func (c *anagrammerProtobufClient) BuildChallengeCreator(ctx context.Context, in *BuildChallengeCreateRequest) (*SearchResponse, error) {
 	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
 	ctx = ctxsetters.WithServiceName(ctx, "Anagrammer")
 	ctx = ctxsetters.WithMethodName(ctx, "BuildChallengeCreator")
@@ -1179,9 +1566,9 @@ func (c *anagrammerJSONClient) BuildChallengeCreator(ctx context.Context, in *Bu
 	return caller(ctx, in)
 }
 
-func (c *anagrammerJSONClient) callBuildChallengeCreator(ctx context.Context, in *BuildChallengeCreateRequest) (*SearchResponse, error) {
+func (c *anagrammerProtobufClient) callBuildChallengeCreator(ctx context.Context, in *BuildChallengeCreateRequest) (*SearchResponse, error) {
 	out := new(SearchResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
 	if err != nil {
 		twerr, ok := err.(twirp.Error)
 		if !ok {
@@ -1196,169 +1583,162 @@ func (c *anagrammerJSONClient) callBuildChallengeCreator(ctx context.Context, in
 	return out, nil
 }
 
-// =========================
-// Anagrammer Server Handler
-// =========================
-
-type anagrammerServer struct {
-	Anagrammer
-	interceptor      twirp.Interceptor
-	hooks            *twirp.ServerHooks
-	pathPrefix       string // prefix for routing
-	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
-	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+func (c *anagrammerProtobufClient) Judge(ctx context.Context, in *WordJudgeRequest) (*WordJudgeResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "Anagrammer")
+	ctx = ctxsetters.WithMethodName(ctx, "Judge")
+	caller := c.callJudge
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *WordJudgeRequest) (*WordJudgeResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WordJudgeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WordJudgeRequest) when calling interceptor")
+					}
+					return c.callJudge(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*WordJudgeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*WordJudgeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
 }
 
-// NewAnagrammerServer builds a TwirpServer that can be used as an http.Handler to handle
-// HTTP requests that are routed to the right method in the provided svc implementation.
-// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
-func NewAnagrammerServer(svc Anagrammer, opts ...interface{}) TwirpServer {
-	serverOpts := newServerOpts(opts)
-
-	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
-	jsonSkipDefaults := false
-	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
-	jsonCamelCase := false
-	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
-	var pathPrefix string
-	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+func (c *anagrammerProtobufClient) callJudge(ctx context.Context, in *WordJudgeRequest) (*WordJudgeResponse, error) {
+	out := new(WordJudgeResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	return &anagrammerServer{
-		Anagrammer:       svc,
-		hooks:            serverOpts.Hooks,
-		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
-		pathPrefix:       pathPrefix,
-		jsonSkipDefaults: jsonSkipDefaults,
-		jsonCamelCase:    jsonCamelCase,
-	}
-}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
-// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
-func (s *anagrammerServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
-	writeError(ctx, resp, err, s.hooks)
+	return out, nil
 }
 
-// handleRequestBodyError is used to handle error when the twirp server cannot read request
-func (s *anagrammerServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
-	if context.Canceled == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
-		return
+func (c *anagrammerProtobufClient) HookTree(ctx context.Context, in *HookTreeRequest) (*HookTreeResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "Anagrammer")
+	ctx = ctxsetters.WithMethodName(ctx, "HookTree")
+	caller := c.callHookTree
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *HookTreeRequest) (*HookTreeResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*HookTreeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*HookTreeRequest) when calling interceptor")
+					}
+					return c.callHookTree(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*HookTreeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*HookTreeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
-	if context.DeadlineExceeded == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
-		return
+	return caller(ctx, in)
+}
+
+func (c *anagrammerProtobufClient) callHookTree(ctx context.Context, in *HookTreeRequest) (*HookTreeResponse, error) {
+	out := new(HookTreeResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
-	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
 }
 
-// AnagrammerPathPrefix is a convenience constant that may identify URL paths.
-// Should be used with caution, it only matches routes generated by Twirp Go clients,
-// with the default "/twirp" prefix and default CamelCase service and method names.
-// More info: https://twitchtv.github.io/twirp/docs/routing.html
-const AnagrammerPathPrefix = "/twirp/wordsearcher.Anagrammer/"
+// ======================
+// Anagrammer JSON Client
+// ======================
 
-func (s *anagrammerServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
-	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
-	ctx = ctxsetters.WithServiceName(ctx, "Anagrammer")
-	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+type anagrammerJSONClient struct {
+	client      HTTPClient
+	urls        [5]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
 
-	var err error
-	ctx, err = callRequestReceived(ctx, s.hooks)
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
+// NewAnagrammerJSONClient creates a JSON client that implements the Anagrammer interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewAnagrammerJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) Anagrammer {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
 	}
 
-	if req.Method != "POST" {
-		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
 	}
 
-	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
-	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
-	if pkgService != "wordsearcher.Anagrammer" {
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
 	}
-	if prefix != s.pathPrefix {
-		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "wordsearcher", "Anagrammer")
+	urls := [5]string{
+		serviceURL + "Anagram",
+		serviceURL + "BlankChallengeCreator",
+		serviceURL + "BuildChallengeCreator",
+		serviceURL + "Judge",
+		serviceURL + "HookTree",
 	}
 
-	switch method {
-	case "Anagram":
-		s.serveAnagram(ctx, resp, req)
-		return
-	case "BlankChallengeCreator":
-		s.serveBlankChallengeCreator(ctx, resp, req)
-		return
-	case "BuildChallengeCreator":
-		s.serveBuildChallengeCreator(ctx, resp, req)
-		return
-	default:
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
-	}
-}
-
-func (s *anagrammerServer) serveAnagram(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	header := req.Header.Get("Content-Type")
-	i := strings.Index(header, ";")
-	if i == -1 {
-		i = len(header)
-	}
-	switch strings.TrimSpace(strings.ToLower(header[:i])) {
-	case "application/json":
-		s.serveAnagramJSON(ctx, resp, req)
-	case "application/protobuf":
-		s.serveAnagramProtobuf(ctx, resp, req)
-	default:
-		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
-		twerr := badRouteError(msg, req.Method, req.URL.Path)
-		s.writeError(ctx, resp, twerr)
+	return &anagrammerJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
 	}
 }
 
-func (s *anagrammerServer) serveAnagramJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	var err error
+func (c *anagrammerJSONClient) Anagram(ctx context.Context, in *AnagramRequest) (*AnagramResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "Anagrammer")
 	ctx = ctxsetters.WithMethodName(ctx, "Anagram")
-	ctx, err = callRequestRouted(ctx, s.hooks)
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-
-	d := json.NewDecoder(req.Body)
-	rawReqBody := json.RawMessage{}
-	if err := d.Decode(&rawReqBody); err != nil {
-		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
-		return
-	}
-	reqContent := new(AnagramRequest)
-	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
-	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
-		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
-		return
-	}
-
-	handler := s.Anagrammer.Anagram
-	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *AnagramRequest) (*AnagramResponse, error) {
-			resp, err := s.interceptor(
+	caller := c.callAnagram
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *AnagramRequest) (*AnagramResponse, error) {
+			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
 					typedReq, ok := req.(*AnagramRequest)
 					if !ok {
 						return nil, twirp.InternalError("failed type assertion req.(*AnagramRequest) when calling interceptor")
 					}
-					return s.Anagrammer.Anagram(ctx, typedReq)
+					return c.callAnagram(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -1371,174 +1751,86 @@ func (s *anagrammerServer) serveAnagramJSON(ctx context.Context, resp http.Respo
 			return nil, err
 		}
 	}
+	return caller(ctx, in)
+}
 
-	// Call service method
-	var respContent *AnagramResponse
-	func() {
-		defer ensurePanicResponses(ctx, resp, s.hooks)
-		respContent, err = handler(ctx, reqContent)
-	}()
-
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *AnagramResponse and nil error while calling Anagram. nil responses are not supported"))
-		return
-	}
-
-	ctx = callResponsePrepared(ctx, s.hooks)
-
-	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
-	respBytes, err := marshaler.Marshal(respContent)
+func (c *anagrammerJSONClient) callAnagram(ctx context.Context, in *AnagramRequest) (*AnagramResponse, error) {
+	out := new(AnagramResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
-	resp.Header().Set("Content-Type", "application/json")
-	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
-	resp.WriteHeader(http.StatusOK)
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	if n, err := resp.Write(respBytes); err != nil {
-		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
-		twerr := twirp.NewError(twirp.Unknown, msg)
-		ctx = callError(ctx, s.hooks, twerr)
-	}
-	callResponseSent(ctx, s.hooks)
+	return out, nil
 }
 
-func (s *anagrammerServer) serveAnagramProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "Anagram")
-	ctx, err = callRequestRouted(ctx, s.hooks)
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-
-	buf, err := ioutil.ReadAll(req.Body)
-	if err != nil {
-		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
-		return
-	}
-	reqContent := new(AnagramRequest)
-	if err = proto.Unmarshal(buf, reqContent); err != nil {
-		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
-		return
-	}
-
-	handler := s.Anagrammer.Anagram
-	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *AnagramRequest) (*AnagramResponse, error) {
-			resp, err := s.interceptor(
+func (c *anagrammerJSONClient) BlankChallengeCreator(ctx context.Context, in *BlankChallengeCreateRequest) (*SearchResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "Anagrammer")
+	ctx = ctxsetters.WithMethodName(ctx, "BlankChallengeCreator")
+	caller := c.callBlankChallengeCreator
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *BlankChallengeCreateRequest) (*SearchResponse, error) {
+			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*AnagramRequest)
+					typedReq, ok := req.(*BlankChallengeCreateRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*AnagramRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*BlankChallengeCreateRequest) when calling interceptor")
 					}
-					return s.Anagrammer.Anagram(ctx, typedReq)
+					return c.callBlankChallengeCreator(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*AnagramResponse)
+				typedResp, ok := resp.(*SearchResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*AnagramResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
+	return caller(ctx, in)
+}
 
-	// Call service method
-	var respContent *AnagramResponse
-	func() {
-		defer ensurePanicResponses(ctx, resp, s.hooks)
-		respContent, err = handler(ctx, reqContent)
-	}()
-
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *AnagramResponse and nil error while calling Anagram. nil responses are not supported"))
-		return
-	}
-
-	ctx = callResponsePrepared(ctx, s.hooks)
-
-	respBytes, err := proto.Marshal(respContent)
+func (c *anagrammerJSONClient) callBlankChallengeCreator(ctx context.Context, in *BlankChallengeCreateRequest) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
-	resp.Header().Set("Content-Type", "application/protobuf")
-	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
-	resp.WriteHeader(http.StatusOK)
-	if n, err := resp.Write(respBytes); err != nil {
-		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
-		twerr := twirp.NewError(twirp.Unknown, msg)
-		ctx = callError(ctx, s.hooks, twerr)
-	}
-	callResponseSent(ctx, s.hooks)
-}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-func (s *anagrammerServer) serveBlankChallengeCreator(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	header := req.Header.Get("Content-Type")
-	i := strings.Index(header, ";")
-	if i == -1 {
-		i = len(header)
-	}
-	switch strings.TrimSpace(strings.ToLower(header[:i])) {
-	case "application/json":
-		s.serveBlankChallengeCreatorJSON(ctx, resp, req)
-	case "application/protobuf":
-		s.serveBlankChallengeCreatorProtobuf(ctx, resp, req)
-	default:
-		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
-		twerr := badRouteError(msg, req.Method, req.URL.Path)
-		s.writeError(ctx, resp, twerr)
-	}
+	return out, nil
 }
 
-func (s *anagrammerServer) serveBlankChallengeCreatorJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "BlankChallengeCreator")
-	ctx, err = callRequestRouted(ctx, s.hooks)
-	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-
-	d := json.NewDecoder(req.Body)
-	rawReqBody := json.RawMessage{}
-	if err := d.Decode(&rawReqBody); err != nil {
-		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
-		return
-	}
-	reqContent := new(BlankChallengeCreateRequest)
-	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
-	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
-		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
-		return
-	}
-
-	handler := s.Anagrammer.BlankChallengeCreator
-	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *BlankChallengeCreateRequest) (*SearchResponse, error) {
-			resp, err := s.interceptor(
+func (c *anagrammerJSONClient) BuildChallengeCreator(ctx context.Context, in *BuildChallengeCreateRequest) (*SearchResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "Anagrammer")
+	ctx = ctxsetters.WithMethodName(ctx, "BuildChallengeCreator")
+	caller := c.callBuildChallengeCreator
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *BuildChallengeCreateRequest) (*SearchResponse, error) {
+			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*BlankChallengeCreateRequest)
+					typedReq, ok := req.(*BuildChallengeCreateRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*BlankChallengeCreateRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*BuildChallengeCreateRequest) when calling interceptor")
 					}
-					return s.Anagrammer.BlankChallengeCreator(ctx, typedReq)
+					return c.callBuildChallengeCreator(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
@@ -1551,125 +1843,238 @@ func (s *anagrammerServer) serveBlankChallengeCreatorJSON(ctx context.Context, r
 			return nil, err
 		}
 	}
+	return caller(ctx, in)
+}
 
-	// Call service method
-	var respContent *SearchResponse
-	func() {
-		defer ensurePanicResponses(ctx, resp, s.hooks)
-		respContent, err = handler(ctx, reqContent)
-	}()
-
+func (c *anagrammerJSONClient) callBuildChallengeCreator(ctx context.Context, in *BuildChallengeCreateRequest) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
-	}
-	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *SearchResponse and nil error while calling BlankChallengeCreator. nil responses are not supported"))
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	ctx = callResponsePrepared(ctx, s.hooks)
-
-	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
-	respBytes, err := marshaler.Marshal(respContent)
-	if err != nil {
-		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
-		return
-	}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
-	resp.Header().Set("Content-Type", "application/json")
-	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
-	resp.WriteHeader(http.StatusOK)
+	return out, nil
+}
 
-	if n, err := resp.Write(respBytes); err != nil {
-		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
-		twerr := twirp.NewError(twirp.Unknown, msg)
-		ctx = callError(ctx, s.hooks, twerr)
+func (c *anagrammerJSONClient) Judge(ctx context.Context, in *WordJudgeRequest) (*WordJudgeResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "Anagrammer")
+	ctx = ctxsetters.WithMethodName(ctx, "Judge")
+	caller := c.callJudge
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *WordJudgeRequest) (*WordJudgeResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WordJudgeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WordJudgeRequest) when calling interceptor")
+					}
+					return c.callJudge(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*WordJudgeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*WordJudgeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
 	}
-	callResponseSent(ctx, s.hooks)
+	return caller(ctx, in)
 }
 
-func (s *anagrammerServer) serveBlankChallengeCreatorProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
-	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "BlankChallengeCreator")
-	ctx, err = callRequestRouted(ctx, s.hooks)
+func (c *anagrammerJSONClient) callJudge(ctx context.Context, in *WordJudgeRequest) (*WordJudgeResponse, error) {
+	out := new(WordJudgeResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, err)
-		return
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
 	}
 
-	buf, err := ioutil.ReadAll(req.Body)
-	if err != nil {
-		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
-		return
-	}
-	reqContent := new(BlankChallengeCreateRequest)
-	if err = proto.Unmarshal(buf, reqContent); err != nil {
-		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
-		return
-	}
+	callClientResponseReceived(ctx, c.opts.Hooks)
 
-	handler := s.Anagrammer.BlankChallengeCreator
-	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *BlankChallengeCreateRequest) (*SearchResponse, error) {
-			resp, err := s.interceptor(
+	return out, nil
+}
+
+func (c *anagrammerJSONClient) HookTree(ctx context.Context, in *HookTreeRequest) (*HookTreeResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "Anagrammer")
+	ctx = ctxsetters.WithMethodName(ctx, "HookTree")
+	caller := c.callHookTree
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *HookTreeRequest) (*HookTreeResponse, error) {
+			resp, err := c.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*BlankChallengeCreateRequest)
+					typedReq, ok := req.(*HookTreeRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*BlankChallengeCreateRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*HookTreeRequest) when calling interceptor")
 					}
-					return s.Anagrammer.BlankChallengeCreator(ctx, typedReq)
+					return c.callHookTree(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*SearchResponse)
+				typedResp, ok := resp.(*HookTreeResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*SearchResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*HookTreeResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
+	return caller(ctx, in)
+}
 
-	// Call service method
-	var respContent *SearchResponse
-	func() {
-		defer ensurePanicResponses(ctx, resp, s.hooks)
-		respContent, err = handler(ctx, reqContent)
-	}()
-
+func (c *anagrammerJSONClient) callHookTree(ctx context.Context, in *HookTreeRequest) (*HookTreeResponse, error) {
+	out := new(HookTreeResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
 	if err != nil {
-		s.writeError(ctx, resp, err)
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =========================
+// Anagrammer Server Handler
+// =========================
+
+type anagrammerServer struct {
+	Anagrammer
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewAnagrammerServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewAnagrammerServer(svc Anagrammer, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &anagrammerServer{
+		Anagrammer:       svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *anagrammerServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *anagrammerServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
 		return
 	}
-	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *SearchResponse and nil error while calling BlankChallengeCreator. nil responses are not supported"))
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
 		return
 	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
 
-	ctx = callResponsePrepared(ctx, s.hooks)
+// AnagrammerPathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const AnagrammerPathPrefix = "/twirp/wordsearcher.Anagrammer/"
 
-	respBytes, err := proto.Marshal(respContent)
+func (s *anagrammerServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "Anagrammer")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
 	if err != nil {
-		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		s.writeError(ctx, resp, err)
 		return
 	}
 
-	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
-	resp.Header().Set("Content-Type", "application/protobuf")
-	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
-	resp.WriteHeader(http.StatusOK)
-	if n, err := resp.Write(respBytes); err != nil {
-		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
-		twerr := twirp.NewError(twirp.Unknown, msg)
-		ctx = callError(ctx, s.hooks, twerr)
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "wordsearcher.Anagrammer" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "Anagram":
+		s.serveAnagram(ctx, resp, req)
+		return
+	case "BlankChallengeCreator":
+		s.serveBlankChallengeCreator(ctx, resp, req)
+		return
+	case "BuildChallengeCreator":
+		s.serveBuildChallengeCreator(ctx, resp, req)
+		return
+	case "Judge":
+		s.serveJudge(ctx, resp, req)
+		return
+	case "HookTree":
+		s.serveHookTree(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
 	}
-	callResponseSent(ctx, s.hooks)
 }
 
-func (s *anagrammerServer) serveBuildChallengeCreator(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *anagrammerServer) serveAnagram(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -1677,9 +2082,9 @@ func (s *anagrammerServer) serveBuildChallengeCreator(ctx context.Context, resp
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveBuildChallengeCreatorJSON(ctx, resp, req)
+		s.serveAnagramJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveBuildChallengeCreatorProtobuf(ctx, resp, req)
+		s.serveAnagramProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -1687,9 +2092,9 @@ func (s *anagrammerServer) serveBuildChallengeCreator(ctx context.Context, resp
 	}
 }
 
-func (s *anagrammerServer) serveBuildChallengeCreatorJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *anagrammerServer) serveAnagramJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "BuildChallengeCreator")
+	ctx = ctxsetters.WithMethodName(ctx, "Anagram")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1702,29 +2107,29 @@ func (s *anagrammerServer) serveBuildChallengeCreatorJSON(ctx context.Context, r
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(BuildChallengeCreateRequest)
+	reqContent := new(AnagramRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.Anagrammer.BuildChallengeCreator
+	handler := s.Anagrammer.Anagram
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *BuildChallengeCreateRequest) (*SearchResponse, error) {
+		handler = func(ctx context.Context, req *AnagramRequest) (*AnagramResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*BuildChallengeCreateRequest)
+					typedReq, ok := req.(*AnagramRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*BuildChallengeCreateRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AnagramRequest) when calling interceptor")
 					}
-					return s.Anagrammer.BuildChallengeCreator(ctx, typedReq)
+					return s.Anagrammer.Anagram(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*SearchResponse)
+				typedResp, ok := resp.(*AnagramResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*SearchResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*AnagramResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1733,7 +2138,7 @@ func (s *anagrammerServer) serveBuildChallengeCreatorJSON(ctx context.Context, r
 	}
 
 	// Call service method
-	var respContent *SearchResponse
+	var respContent *AnagramResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1744,7 +2149,7 @@ func (s *anagrammerServer) serveBuildChallengeCreatorJSON(ctx context.Context, r
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *SearchResponse and nil error while calling BuildChallengeCreator. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AnagramResponse and nil error while calling Anagram. nil responses are not supported"))
 		return
 	}
 
@@ -1770,9 +2175,9 @@ func (s *anagrammerServer) serveBuildChallengeCreatorJSON(ctx context.Context, r
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *anagrammerServer) serveBuildChallengeCreatorProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *anagrammerServer) serveAnagramProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "BuildChallengeCreator")
+	ctx = ctxsetters.WithMethodName(ctx, "Anagram")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -1784,28 +2189,28 @@ func (s *anagrammerServer) serveBuildChallengeCreatorProtobuf(ctx context.Contex
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(BuildChallengeCreateRequest)
+	reqContent := new(AnagramRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.Anagrammer.BuildChallengeCreator
+	handler := s.Anagrammer.Anagram
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *BuildChallengeCreateRequest) (*SearchResponse, error) {
+		handler = func(ctx context.Context, req *AnagramRequest) (*AnagramResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*BuildChallengeCreateRequest)
+					typedReq, ok := req.(*AnagramRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*BuildChallengeCreateRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*AnagramRequest) when calling interceptor")
 					}
-					return s.Anagrammer.BuildChallengeCreator(ctx, typedReq)
+					return s.Anagrammer.Anagram(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*SearchResponse)
+				typedResp, ok := resp.(*AnagramResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*SearchResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*AnagramResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -1814,7 +2219,7 @@ func (s *anagrammerServer) serveBuildChallengeCreatorProtobuf(ctx context.Contex
 	}
 
 	// Call service method
-	var respContent *SearchResponse
+	var respContent *AnagramResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -1825,7 +2230,7 @@ func (s *anagrammerServer) serveBuildChallengeCreatorProtobuf(ctx context.Contex
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *SearchResponse and nil error while calling BuildChallengeCreator. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *AnagramResponse and nil error while calling Anagram. nil responses are not supported"))
 		return
 	}
 
@@ -1849,423 +2254,8190 @@ func (s *anagrammerServer) serveBuildChallengeCreatorProtobuf(ctx context.Contex
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *anagrammerServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor0, 1
-}
-
-func (s *anagrammerServer) ProtocGenTwirpVersion() string {
-	return "v8.1.2"
-}
-
-// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
-// that is everything in a Twirp route except for the <Method>. This can be used for routing,
-// for example to identify the requests that are targeted to this service in a mux.
-func (s *anagrammerServer) PathPrefix() string {
-	return baseServicePath(s.pathPrefix, "wordsearcher", "Anagrammer")
-}
-
-// ======================
-// WordSearcher Interface
-// ======================
-
-// A WordSearcher is simpler than a QuestionSearcher, in that a QuestionSearcher
-// will search across alphagram information and return questions,
-// and a WordSearcher just cares about the individual words.
-type WordSearcher interface {
-	GetWordInformation(context.Context, *DefineRequest) (*WordSearchResponse, error)
-
-	WordSearch(context.Context, *WordSearchRequest) (*WordSearchResponse, error)
-}
-
-// ============================
-// WordSearcher Protobuf Client
-// ============================
-
-type wordSearcherProtobufClient struct {
-	client      HTTPClient
-	urls        [2]string
-	interceptor twirp.Interceptor
-	opts        twirp.ClientOptions
-}
-
-// NewWordSearcherProtobufClient creates a Protobuf client that implements the WordSearcher interface.
-// It communicates using Protobuf and can be configured with a custom HTTPClient.
-func NewWordSearcherProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) WordSearcher {
-	if c, ok := client.(*http.Client); ok {
-		client = withoutRedirects(c)
+func (s *anagrammerServer) serveBlankChallengeCreator(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
 	}
-
-	clientOpts := twirp.ClientOptions{}
-	for _, o := range opts {
-		o(&clientOpts)
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveBlankChallengeCreatorJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveBlankChallengeCreatorProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
 	}
+}
 
-	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
-	literalURLs := false
-	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
-	var pathPrefix string
-	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+func (s *anagrammerServer) serveBlankChallengeCreatorJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "BlankChallengeCreator")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
 	}
 
-	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
-	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "wordsearcher", "WordSearcher")
-	urls := [2]string{
-		serviceURL + "GetWordInformation",
-		serviceURL + "WordSearch",
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
 	}
-
-	return &wordSearcherProtobufClient{
-		client:      client,
-		urls:        urls,
-		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
-		opts:        clientOpts,
+	reqContent := new(BlankChallengeCreateRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
 	}
-}
 
-func (c *wordSearcherProtobufClient) GetWordInformation(ctx context.Context, in *DefineRequest) (*WordSearchResponse, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
-	ctx = ctxsetters.WithServiceName(ctx, "WordSearcher")
-	ctx = ctxsetters.WithMethodName(ctx, "GetWordInformation")
-	caller := c.callGetWordInformation
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *DefineRequest) (*WordSearchResponse, error) {
-			resp, err := c.interceptor(
+	handler := s.Anagrammer.BlankChallengeCreator
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *BlankChallengeCreateRequest) (*SearchResponse, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*DefineRequest)
+					typedReq, ok := req.(*BlankChallengeCreateRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*DefineRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*BlankChallengeCreateRequest) when calling interceptor")
 					}
-					return c.callGetWordInformation(ctx, typedReq)
+					return s.Anagrammer.BlankChallengeCreator(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*WordSearchResponse)
+				typedResp, ok := resp.(*SearchResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*WordSearchResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *wordSearcherProtobufClient) callGetWordInformation(ctx context.Context, in *DefineRequest) (*WordSearchResponse, error) {
-	out := new(WordSearchResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	// Call service method
+	var respContent *SearchResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SearchResponse and nil error while calling BlankChallengeCreator. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-	return out, nil
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (c *wordSearcherProtobufClient) WordSearch(ctx context.Context, in *WordSearchRequest) (*WordSearchResponse, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
-	ctx = ctxsetters.WithServiceName(ctx, "WordSearcher")
-	ctx = ctxsetters.WithMethodName(ctx, "WordSearch")
-	caller := c.callWordSearch
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *WordSearchRequest) (*WordSearchResponse, error) {
-			resp, err := c.interceptor(
+func (s *anagrammerServer) serveBlankChallengeCreatorProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "BlankChallengeCreator")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(BlankChallengeCreateRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Anagrammer.BlankChallengeCreator
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *BlankChallengeCreateRequest) (*SearchResponse, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*WordSearchRequest)
+					typedReq, ok := req.(*BlankChallengeCreateRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*WordSearchRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*BlankChallengeCreateRequest) when calling interceptor")
 					}
-					return c.callWordSearch(ctx, typedReq)
+					return s.Anagrammer.BlankChallengeCreator(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*WordSearchResponse)
+				typedResp, ok := resp.(*SearchResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*WordSearchResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *wordSearcherProtobufClient) callWordSearch(ctx context.Context, in *WordSearchRequest) (*WordSearchResponse, error) {
-	out := new(WordSearchResponse)
-	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	// Call service method
+	var respContent *SearchResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SearchResponse and nil error while calling BlankChallengeCreator. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *anagrammerServer) serveBuildChallengeCreator(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveBuildChallengeCreatorJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveBuildChallengeCreatorProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *anagrammerServer) serveBuildChallengeCreatorJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "BuildChallengeCreator")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(BuildChallengeCreateRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Anagrammer.BuildChallengeCreator
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *BuildChallengeCreateRequest) (*SearchResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*BuildChallengeCreateRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*BuildChallengeCreateRequest) when calling interceptor")
+					}
+					return s.Anagrammer.BuildChallengeCreator(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SearchResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SearchResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SearchResponse and nil error while calling BuildChallengeCreator. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *anagrammerServer) serveBuildChallengeCreatorProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "BuildChallengeCreator")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(BuildChallengeCreateRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Anagrammer.BuildChallengeCreator
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *BuildChallengeCreateRequest) (*SearchResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*BuildChallengeCreateRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*BuildChallengeCreateRequest) when calling interceptor")
+					}
+					return s.Anagrammer.BuildChallengeCreator(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SearchResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SearchResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SearchResponse and nil error while calling BuildChallengeCreator. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *anagrammerServer) serveJudge(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveJudgeJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveJudgeProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *anagrammerServer) serveJudgeJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Judge")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(WordJudgeRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Anagrammer.Judge
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *WordJudgeRequest) (*WordJudgeResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WordJudgeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WordJudgeRequest) when calling interceptor")
+					}
+					return s.Anagrammer.Judge(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*WordJudgeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*WordJudgeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *WordJudgeResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *WordJudgeResponse and nil error while calling Judge. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *anagrammerServer) serveJudgeProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Judge")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(WordJudgeRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Anagrammer.Judge
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *WordJudgeRequest) (*WordJudgeResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WordJudgeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WordJudgeRequest) when calling interceptor")
+					}
+					return s.Anagrammer.Judge(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*WordJudgeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*WordJudgeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *WordJudgeResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *WordJudgeResponse and nil error while calling Judge. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *anagrammerServer) serveHookTree(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveHookTreeJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveHookTreeProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *anagrammerServer) serveHookTreeJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "HookTree")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(HookTreeRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Anagrammer.HookTree
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *HookTreeRequest) (*HookTreeResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*HookTreeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*HookTreeRequest) when calling interceptor")
+					}
+					return s.Anagrammer.HookTree(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*HookTreeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*HookTreeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *HookTreeResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *HookTreeResponse and nil error while calling HookTree. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *anagrammerServer) serveHookTreeProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "HookTree")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(HookTreeRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Anagrammer.HookTree
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *HookTreeRequest) (*HookTreeResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*HookTreeRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*HookTreeRequest) when calling interceptor")
+					}
+					return s.Anagrammer.HookTree(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*HookTreeResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*HookTreeResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *HookTreeResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *HookTreeResponse and nil error while calling HookTree. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *anagrammerServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 1
+}
+
+func (s *anagrammerServer) ProtocGenTwirpVersion() string {
+	return "v8.1.2"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *anagrammerServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "wordsearcher", "Anagrammer")
+}
+
+// ======================
+// WordSearcher Interface
+// ======================
+
+// A WordSearcher is simpler than a QuestionSearcher, in that a QuestionSearcher
+// will search across alphagram information and return questions,
+// and a WordSearcher just cares about the individual words.
+type WordSearcher interface {
+	GetWordInformation(context.Context, *DefineRequest) (*WordSearchResponse, error)
+
+	WordSearch(context.Context, *WordSearchRequest) (*WordSearchResponse, error)
+
+	// Suggest returns "did you mean" suggestions for a word that may be
+	// misspelled.
+	Suggest(context.Context, *SuggestRequest) (*SuggestResponse, error)
+
+	// Neighbors returns the alphagrams that are one tile away from an
+	// alphagram, for exploring the lexicon.
+	Neighbors(context.Context, *NeighborsRequest) (*NeighborsResponse, error)
+}
+
+// ============================
+// WordSearcher Protobuf Client
+// ============================
+
+type wordSearcherProtobufClient struct {
+	client      HTTPClient
+	urls        [4]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewWordSearcherProtobufClient creates a Protobuf client that implements the WordSearcher interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewWordSearcherProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) WordSearcher {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "wordsearcher", "WordSearcher")
+	urls := [4]string{
+		serviceURL + "GetWordInformation",
+		serviceURL + "WordSearch",
+		serviceURL + "Suggest",
+		serviceURL + "Neighbors",
+	}
+
+	return &wordSearcherProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *wordSearcherProtobufClient) GetWordInformation(ctx context.Context, in *DefineRequest) (*WordSearchResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "WordSearcher")
+	ctx = ctxsetters.WithMethodName(ctx, "GetWordInformation")
+	caller := c.callGetWordInformation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DefineRequest) (*WordSearchResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DefineRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DefineRequest) when calling interceptor")
+					}
+					return c.callGetWordInformation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*WordSearchResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*WordSearchResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *wordSearcherProtobufClient) callGetWordInformation(ctx context.Context, in *DefineRequest) (*WordSearchResponse, error) {
+	out := new(WordSearchResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *wordSearcherProtobufClient) WordSearch(ctx context.Context, in *WordSearchRequest) (*WordSearchResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "WordSearcher")
+	ctx = ctxsetters.WithMethodName(ctx, "WordSearch")
+	caller := c.callWordSearch
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *WordSearchRequest) (*WordSearchResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WordSearchRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WordSearchRequest) when calling interceptor")
+					}
+					return c.callWordSearch(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*WordSearchResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*WordSearchResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *wordSearcherProtobufClient) callWordSearch(ctx context.Context, in *WordSearchRequest) (*WordSearchResponse, error) {
+	out := new(WordSearchResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *wordSearcherProtobufClient) Suggest(ctx context.Context, in *SuggestRequest) (*SuggestResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "WordSearcher")
+	ctx = ctxsetters.WithMethodName(ctx, "Suggest")
+	caller := c.callSuggest
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SuggestRequest) (*SuggestResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SuggestRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SuggestRequest) when calling interceptor")
+					}
+					return c.callSuggest(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SuggestResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SuggestResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *wordSearcherProtobufClient) callSuggest(ctx context.Context, in *SuggestRequest) (*SuggestResponse, error) {
+	out := new(SuggestResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *wordSearcherProtobufClient) Neighbors(ctx context.Context, in *NeighborsRequest) (*NeighborsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "WordSearcher")
+	ctx = ctxsetters.WithMethodName(ctx, "Neighbors")
+	caller := c.callNeighbors
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *NeighborsRequest) (*NeighborsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*NeighborsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*NeighborsRequest) when calling interceptor")
+					}
+					return c.callNeighbors(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*NeighborsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*NeighborsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *wordSearcherProtobufClient) callNeighbors(ctx context.Context, in *NeighborsRequest) (*NeighborsResponse, error) {
+	out := new(NeighborsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ========================
+// WordSearcher JSON Client
+// ========================
+
+type wordSearcherJSONClient struct {
+	client      HTTPClient
+	urls        [4]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewWordSearcherJSONClient creates a JSON client that implements the WordSearcher interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewWordSearcherJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) WordSearcher {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "wordsearcher", "WordSearcher")
+	urls := [4]string{
+		serviceURL + "GetWordInformation",
+		serviceURL + "WordSearch",
+		serviceURL + "Suggest",
+		serviceURL + "Neighbors",
+	}
+
+	return &wordSearcherJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *wordSearcherJSONClient) GetWordInformation(ctx context.Context, in *DefineRequest) (*WordSearchResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "WordSearcher")
+	ctx = ctxsetters.WithMethodName(ctx, "GetWordInformation")
+	caller := c.callGetWordInformation
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DefineRequest) (*WordSearchResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DefineRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DefineRequest) when calling interceptor")
+					}
+					return c.callGetWordInformation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*WordSearchResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*WordSearchResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *wordSearcherJSONClient) callGetWordInformation(ctx context.Context, in *DefineRequest) (*WordSearchResponse, error) {
+	out := new(WordSearchResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *wordSearcherJSONClient) WordSearch(ctx context.Context, in *WordSearchRequest) (*WordSearchResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "WordSearcher")
+	ctx = ctxsetters.WithMethodName(ctx, "WordSearch")
+	caller := c.callWordSearch
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *WordSearchRequest) (*WordSearchResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WordSearchRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WordSearchRequest) when calling interceptor")
+					}
+					return c.callWordSearch(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*WordSearchResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*WordSearchResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *wordSearcherJSONClient) callWordSearch(ctx context.Context, in *WordSearchRequest) (*WordSearchResponse, error) {
+	out := new(WordSearchResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *wordSearcherJSONClient) Suggest(ctx context.Context, in *SuggestRequest) (*SuggestResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "WordSearcher")
+	ctx = ctxsetters.WithMethodName(ctx, "Suggest")
+	caller := c.callSuggest
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SuggestRequest) (*SuggestResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SuggestRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SuggestRequest) when calling interceptor")
+					}
+					return c.callSuggest(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SuggestResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SuggestResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *wordSearcherJSONClient) callSuggest(ctx context.Context, in *SuggestRequest) (*SuggestResponse, error) {
+	out := new(SuggestResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *wordSearcherJSONClient) Neighbors(ctx context.Context, in *NeighborsRequest) (*NeighborsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "WordSearcher")
+	ctx = ctxsetters.WithMethodName(ctx, "Neighbors")
+	caller := c.callNeighbors
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *NeighborsRequest) (*NeighborsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*NeighborsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*NeighborsRequest) when calling interceptor")
+					}
+					return c.callNeighbors(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*NeighborsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*NeighborsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *wordSearcherJSONClient) callNeighbors(ctx context.Context, in *NeighborsRequest) (*NeighborsResponse, error) {
+	out := new(NeighborsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ===========================
+// WordSearcher Server Handler
+// ===========================
+
+type wordSearcherServer struct {
+	WordSearcher
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewWordSearcherServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewWordSearcherServer(svc WordSearcher, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &wordSearcherServer{
+		WordSearcher:     svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *wordSearcherServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *wordSearcherServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// WordSearcherPathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const WordSearcherPathPrefix = "/twirp/wordsearcher.WordSearcher/"
+
+func (s *wordSearcherServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "WordSearcher")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "wordsearcher.WordSearcher" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "GetWordInformation":
+		s.serveGetWordInformation(ctx, resp, req)
+		return
+	case "WordSearch":
+		s.serveWordSearch(ctx, resp, req)
+		return
+	case "Suggest":
+		s.serveSuggest(ctx, resp, req)
+		return
+	case "Neighbors":
+		s.serveNeighbors(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *wordSearcherServer) serveGetWordInformation(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetWordInformationJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetWordInformationProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *wordSearcherServer) serveGetWordInformationJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetWordInformation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(DefineRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.WordSearcher.GetWordInformation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DefineRequest) (*WordSearchResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DefineRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DefineRequest) when calling interceptor")
+					}
+					return s.WordSearcher.GetWordInformation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*WordSearchResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*WordSearchResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *WordSearchResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *WordSearchResponse and nil error while calling GetWordInformation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *wordSearcherServer) serveGetWordInformationProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetWordInformation")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(DefineRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.WordSearcher.GetWordInformation
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DefineRequest) (*WordSearchResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DefineRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DefineRequest) when calling interceptor")
+					}
+					return s.WordSearcher.GetWordInformation(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*WordSearchResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*WordSearchResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *WordSearchResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *WordSearchResponse and nil error while calling GetWordInformation. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *wordSearcherServer) serveWordSearch(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveWordSearchJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveWordSearchProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *wordSearcherServer) serveWordSearchJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "WordSearch")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(WordSearchRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.WordSearcher.WordSearch
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *WordSearchRequest) (*WordSearchResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WordSearchRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WordSearchRequest) when calling interceptor")
+					}
+					return s.WordSearcher.WordSearch(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*WordSearchResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*WordSearchResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *WordSearchResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *WordSearchResponse and nil error while calling WordSearch. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *wordSearcherServer) serveWordSearchProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "WordSearch")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(WordSearchRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.WordSearcher.WordSearch
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *WordSearchRequest) (*WordSearchResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*WordSearchRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*WordSearchRequest) when calling interceptor")
+					}
+					return s.WordSearcher.WordSearch(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*WordSearchResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*WordSearchResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *WordSearchResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *WordSearchResponse and nil error while calling WordSearch. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *wordSearcherServer) serveSuggest(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSuggestJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSuggestProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *wordSearcherServer) serveSuggestJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Suggest")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SuggestRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.WordSearcher.Suggest
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SuggestRequest) (*SuggestResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SuggestRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SuggestRequest) when calling interceptor")
+					}
+					return s.WordSearcher.Suggest(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SuggestResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SuggestResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SuggestResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SuggestResponse and nil error while calling Suggest. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *wordSearcherServer) serveSuggestProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Suggest")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SuggestRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.WordSearcher.Suggest
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SuggestRequest) (*SuggestResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SuggestRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SuggestRequest) when calling interceptor")
+					}
+					return s.WordSearcher.Suggest(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SuggestResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SuggestResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SuggestResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SuggestResponse and nil error while calling Suggest. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *wordSearcherServer) serveNeighbors(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveNeighborsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveNeighborsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *wordSearcherServer) serveNeighborsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Neighbors")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(NeighborsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.WordSearcher.Neighbors
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *NeighborsRequest) (*NeighborsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*NeighborsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*NeighborsRequest) when calling interceptor")
+					}
+					return s.WordSearcher.Neighbors(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*NeighborsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*NeighborsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *NeighborsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *NeighborsResponse and nil error while calling Neighbors. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *wordSearcherServer) serveNeighborsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Neighbors")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(NeighborsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.WordSearcher.Neighbors
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *NeighborsRequest) (*NeighborsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*NeighborsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*NeighborsRequest) when calling interceptor")
+					}
+					return s.WordSearcher.Neighbors(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*NeighborsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*NeighborsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *NeighborsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *NeighborsResponse and nil error while calling Neighbors. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *wordSearcherServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 2
+}
+
+func (s *wordSearcherServer) ProtocGenTwirpVersion() string {
+	return "v8.1.2"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *wordSearcherServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "wordsearcher", "WordSearcher")
+}
+
+// =====================
+// LexiconInfo Interface
+// =====================
+
+// LexiconInfo has information about the lexica themselves.
+type LexiconInfo interface {
+	// GetLexiconMetadata returns the family, word counts, letter distribution
+	// and so on for a lexicon.
+	GetLexiconMetadata(context.Context, *LexiconMetadataRequest) (*LexiconMetadata, error)
+
+	// ValidateRack checks whether a rack can be drawn from the lexicon's
+	// letter distribution.
+	ValidateRack(context.Context, *RackValidationRequest) (*RackValidationResponse, error)
+
+	// GetLetterDistribution returns the tiles, their counts and their scores
+	// of the lexicon's letter distribution.
+	GetLetterDistribution(context.Context, *LetterDistributionRequest) (*LetterDistribution, error)
+
+	// GetSchemaInfo returns the schema version, migration history and
+	// columns of the lexicon databases.
+	GetSchemaInfo(context.Context, *SchemaInfoRequest) (*SchemaInfoResponse, error)
+
+	// GetSearchSchema describes the search conditions and the parameters
+	// they take.
+	GetSearchSchema(context.Context, *SearchSchemaRequest) (*SearchSchema, error)
+
+	// Stats returns the counts of the lexicon's alphagrams and words by
+	// length, and how many alphagrams have each number of anagrams.
+	Stats(context.Context, *LexiconStatsRequest) (*LexiconStats, error)
+}
+
+// ===========================
+// LexiconInfo Protobuf Client
+// ===========================
+
+type lexiconInfoProtobufClient struct {
+	client      HTTPClient
+	urls        [6]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewLexiconInfoProtobufClient creates a Protobuf client that implements the LexiconInfo interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewLexiconInfoProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) LexiconInfo {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "wordsearcher", "LexiconInfo")
+	urls := [6]string{
+		serviceURL + "GetLexiconMetadata",
+		serviceURL + "ValidateRack",
+		serviceURL + "GetLetterDistribution",
+		serviceURL + "GetSchemaInfo",
+		serviceURL + "GetSearchSchema",
+		serviceURL + "Stats",
+	}
+
+	return &lexiconInfoProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *lexiconInfoProtobufClient) GetLexiconMetadata(ctx context.Context, in *LexiconMetadataRequest) (*LexiconMetadata, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "LexiconInfo")
+	ctx = ctxsetters.WithMethodName(ctx, "GetLexiconMetadata")
+	caller := c.callGetLexiconMetadata
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *LexiconMetadataRequest) (*LexiconMetadata, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LexiconMetadataRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LexiconMetadataRequest) when calling interceptor")
+					}
+					return c.callGetLexiconMetadata(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LexiconMetadata)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LexiconMetadata) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *lexiconInfoProtobufClient) callGetLexiconMetadata(ctx context.Context, in *LexiconMetadataRequest) (*LexiconMetadata, error) {
+	out := new(LexiconMetadata)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *lexiconInfoProtobufClient) ValidateRack(ctx context.Context, in *RackValidationRequest) (*RackValidationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "LexiconInfo")
+	ctx = ctxsetters.WithMethodName(ctx, "ValidateRack")
+	caller := c.callValidateRack
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RackValidationRequest) (*RackValidationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RackValidationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RackValidationRequest) when calling interceptor")
+					}
+					return c.callValidateRack(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RackValidationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RackValidationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *lexiconInfoProtobufClient) callValidateRack(ctx context.Context, in *RackValidationRequest) (*RackValidationResponse, error) {
+	out := new(RackValidationResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *lexiconInfoProtobufClient) GetLetterDistribution(ctx context.Context, in *LetterDistributionRequest) (*LetterDistribution, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "LexiconInfo")
+	ctx = ctxsetters.WithMethodName(ctx, "GetLetterDistribution")
+	caller := c.callGetLetterDistribution
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *LetterDistributionRequest) (*LetterDistribution, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LetterDistributionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LetterDistributionRequest) when calling interceptor")
+					}
+					return c.callGetLetterDistribution(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LetterDistribution)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LetterDistribution) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *lexiconInfoProtobufClient) callGetLetterDistribution(ctx context.Context, in *LetterDistributionRequest) (*LetterDistribution, error) {
+	out := new(LetterDistribution)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *lexiconInfoProtobufClient) GetSchemaInfo(ctx context.Context, in *SchemaInfoRequest) (*SchemaInfoResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "LexiconInfo")
+	ctx = ctxsetters.WithMethodName(ctx, "GetSchemaInfo")
+	caller := c.callGetSchemaInfo
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SchemaInfoRequest) (*SchemaInfoResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SchemaInfoRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SchemaInfoRequest) when calling interceptor")
+					}
+					return c.callGetSchemaInfo(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SchemaInfoResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SchemaInfoResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *lexiconInfoProtobufClient) callGetSchemaInfo(ctx context.Context, in *SchemaInfoRequest) (*SchemaInfoResponse, error) {
+	out := new(SchemaInfoResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *lexiconInfoProtobufClient) GetSearchSchema(ctx context.Context, in *SearchSchemaRequest) (*SearchSchema, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "LexiconInfo")
+	ctx = ctxsetters.WithMethodName(ctx, "GetSearchSchema")
+	caller := c.callGetSearchSchema
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SearchSchemaRequest) (*SearchSchema, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SearchSchemaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SearchSchemaRequest) when calling interceptor")
+					}
+					return c.callGetSearchSchema(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SearchSchema)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchSchema) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *lexiconInfoProtobufClient) callGetSearchSchema(ctx context.Context, in *SearchSchemaRequest) (*SearchSchema, error) {
+	out := new(SearchSchema)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *lexiconInfoProtobufClient) Stats(ctx context.Context, in *LexiconStatsRequest) (*LexiconStats, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "LexiconInfo")
+	ctx = ctxsetters.WithMethodName(ctx, "Stats")
+	caller := c.callStats
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *LexiconStatsRequest) (*LexiconStats, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LexiconStatsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LexiconStatsRequest) when calling interceptor")
+					}
+					return c.callStats(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LexiconStats)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LexiconStats) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *lexiconInfoProtobufClient) callStats(ctx context.Context, in *LexiconStatsRequest) (*LexiconStats, error) {
+	out := new(LexiconStats)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =======================
+// LexiconInfo JSON Client
+// =======================
+
+type lexiconInfoJSONClient struct {
+	client      HTTPClient
+	urls        [6]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewLexiconInfoJSONClient creates a JSON client that implements the LexiconInfo interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewLexiconInfoJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) LexiconInfo {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "wordsearcher", "LexiconInfo")
+	urls := [6]string{
+		serviceURL + "GetLexiconMetadata",
+		serviceURL + "ValidateRack",
+		serviceURL + "GetLetterDistribution",
+		serviceURL + "GetSchemaInfo",
+		serviceURL + "GetSearchSchema",
+		serviceURL + "Stats",
+	}
+
+	return &lexiconInfoJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *lexiconInfoJSONClient) GetLexiconMetadata(ctx context.Context, in *LexiconMetadataRequest) (*LexiconMetadata, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "LexiconInfo")
+	ctx = ctxsetters.WithMethodName(ctx, "GetLexiconMetadata")
+	caller := c.callGetLexiconMetadata
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *LexiconMetadataRequest) (*LexiconMetadata, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LexiconMetadataRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LexiconMetadataRequest) when calling interceptor")
+					}
+					return c.callGetLexiconMetadata(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LexiconMetadata)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LexiconMetadata) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *lexiconInfoJSONClient) callGetLexiconMetadata(ctx context.Context, in *LexiconMetadataRequest) (*LexiconMetadata, error) {
+	out := new(LexiconMetadata)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *lexiconInfoJSONClient) ValidateRack(ctx context.Context, in *RackValidationRequest) (*RackValidationResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "LexiconInfo")
+	ctx = ctxsetters.WithMethodName(ctx, "ValidateRack")
+	caller := c.callValidateRack
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RackValidationRequest) (*RackValidationResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RackValidationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RackValidationRequest) when calling interceptor")
+					}
+					return c.callValidateRack(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RackValidationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RackValidationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *lexiconInfoJSONClient) callValidateRack(ctx context.Context, in *RackValidationRequest) (*RackValidationResponse, error) {
+	out := new(RackValidationResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *lexiconInfoJSONClient) GetLetterDistribution(ctx context.Context, in *LetterDistributionRequest) (*LetterDistribution, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "LexiconInfo")
+	ctx = ctxsetters.WithMethodName(ctx, "GetLetterDistribution")
+	caller := c.callGetLetterDistribution
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *LetterDistributionRequest) (*LetterDistribution, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LetterDistributionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LetterDistributionRequest) when calling interceptor")
+					}
+					return c.callGetLetterDistribution(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LetterDistribution)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LetterDistribution) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *lexiconInfoJSONClient) callGetLetterDistribution(ctx context.Context, in *LetterDistributionRequest) (*LetterDistribution, error) {
+	out := new(LetterDistribution)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *lexiconInfoJSONClient) GetSchemaInfo(ctx context.Context, in *SchemaInfoRequest) (*SchemaInfoResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "LexiconInfo")
+	ctx = ctxsetters.WithMethodName(ctx, "GetSchemaInfo")
+	caller := c.callGetSchemaInfo
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SchemaInfoRequest) (*SchemaInfoResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SchemaInfoRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SchemaInfoRequest) when calling interceptor")
+					}
+					return c.callGetSchemaInfo(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SchemaInfoResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SchemaInfoResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *lexiconInfoJSONClient) callGetSchemaInfo(ctx context.Context, in *SchemaInfoRequest) (*SchemaInfoResponse, error) {
+	out := new(SchemaInfoResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *lexiconInfoJSONClient) GetSearchSchema(ctx context.Context, in *SearchSchemaRequest) (*SearchSchema, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "LexiconInfo")
+	ctx = ctxsetters.WithMethodName(ctx, "GetSearchSchema")
+	caller := c.callGetSearchSchema
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SearchSchemaRequest) (*SearchSchema, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SearchSchemaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SearchSchemaRequest) when calling interceptor")
+					}
+					return c.callGetSearchSchema(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SearchSchema)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchSchema) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *lexiconInfoJSONClient) callGetSearchSchema(ctx context.Context, in *SearchSchemaRequest) (*SearchSchema, error) {
+	out := new(SearchSchema)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *lexiconInfoJSONClient) Stats(ctx context.Context, in *LexiconStatsRequest) (*LexiconStats, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "LexiconInfo")
+	ctx = ctxsetters.WithMethodName(ctx, "Stats")
+	caller := c.callStats
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *LexiconStatsRequest) (*LexiconStats, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LexiconStatsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LexiconStatsRequest) when calling interceptor")
+					}
+					return c.callStats(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LexiconStats)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LexiconStats) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *lexiconInfoJSONClient) callStats(ctx context.Context, in *LexiconStatsRequest) (*LexiconStats, error) {
+	out := new(LexiconStats)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ==========================
+// LexiconInfo Server Handler
+// ==========================
+
+type lexiconInfoServer struct {
+	LexiconInfo
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewLexiconInfoServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewLexiconInfoServer(svc LexiconInfo, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &lexiconInfoServer{
+		LexiconInfo:      svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *lexiconInfoServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *lexiconInfoServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// LexiconInfoPathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const LexiconInfoPathPrefix = "/twirp/wordsearcher.LexiconInfo/"
+
+func (s *lexiconInfoServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "LexiconInfo")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "wordsearcher.LexiconInfo" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "GetLexiconMetadata":
+		s.serveGetLexiconMetadata(ctx, resp, req)
+		return
+	case "ValidateRack":
+		s.serveValidateRack(ctx, resp, req)
+		return
+	case "GetLetterDistribution":
+		s.serveGetLetterDistribution(ctx, resp, req)
+		return
+	case "GetSchemaInfo":
+		s.serveGetSchemaInfo(ctx, resp, req)
+		return
+	case "GetSearchSchema":
+		s.serveGetSearchSchema(ctx, resp, req)
+		return
+	case "Stats":
+		s.serveStats(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *lexiconInfoServer) serveGetLexiconMetadata(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetLexiconMetadataJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetLexiconMetadataProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *lexiconInfoServer) serveGetLexiconMetadataJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetLexiconMetadata")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(LexiconMetadataRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.LexiconInfo.GetLexiconMetadata
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *LexiconMetadataRequest) (*LexiconMetadata, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LexiconMetadataRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LexiconMetadataRequest) when calling interceptor")
+					}
+					return s.LexiconInfo.GetLexiconMetadata(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LexiconMetadata)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LexiconMetadata) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *LexiconMetadata
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *LexiconMetadata and nil error while calling GetLexiconMetadata. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *lexiconInfoServer) serveGetLexiconMetadataProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetLexiconMetadata")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(LexiconMetadataRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.LexiconInfo.GetLexiconMetadata
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *LexiconMetadataRequest) (*LexiconMetadata, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LexiconMetadataRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LexiconMetadataRequest) when calling interceptor")
+					}
+					return s.LexiconInfo.GetLexiconMetadata(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LexiconMetadata)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LexiconMetadata) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *LexiconMetadata
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *LexiconMetadata and nil error while calling GetLexiconMetadata. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *lexiconInfoServer) serveValidateRack(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveValidateRackJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveValidateRackProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *lexiconInfoServer) serveValidateRackJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ValidateRack")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RackValidationRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.LexiconInfo.ValidateRack
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RackValidationRequest) (*RackValidationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RackValidationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RackValidationRequest) when calling interceptor")
+					}
+					return s.LexiconInfo.ValidateRack(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RackValidationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RackValidationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RackValidationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RackValidationResponse and nil error while calling ValidateRack. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *lexiconInfoServer) serveValidateRackProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ValidateRack")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RackValidationRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.LexiconInfo.ValidateRack
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RackValidationRequest) (*RackValidationResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RackValidationRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RackValidationRequest) when calling interceptor")
+					}
+					return s.LexiconInfo.ValidateRack(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RackValidationResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RackValidationResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RackValidationResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RackValidationResponse and nil error while calling ValidateRack. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *lexiconInfoServer) serveGetLetterDistribution(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetLetterDistributionJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetLetterDistributionProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *lexiconInfoServer) serveGetLetterDistributionJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetLetterDistribution")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(LetterDistributionRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.LexiconInfo.GetLetterDistribution
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *LetterDistributionRequest) (*LetterDistribution, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LetterDistributionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LetterDistributionRequest) when calling interceptor")
+					}
+					return s.LexiconInfo.GetLetterDistribution(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LetterDistribution)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LetterDistribution) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *LetterDistribution
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *LetterDistribution and nil error while calling GetLetterDistribution. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *lexiconInfoServer) serveGetLetterDistributionProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetLetterDistribution")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(LetterDistributionRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.LexiconInfo.GetLetterDistribution
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *LetterDistributionRequest) (*LetterDistribution, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LetterDistributionRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LetterDistributionRequest) when calling interceptor")
+					}
+					return s.LexiconInfo.GetLetterDistribution(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LetterDistribution)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LetterDistribution) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *LetterDistribution
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *LetterDistribution and nil error while calling GetLetterDistribution. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *lexiconInfoServer) serveGetSchemaInfo(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetSchemaInfoJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetSchemaInfoProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *lexiconInfoServer) serveGetSchemaInfoJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetSchemaInfo")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SchemaInfoRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.LexiconInfo.GetSchemaInfo
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SchemaInfoRequest) (*SchemaInfoResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SchemaInfoRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SchemaInfoRequest) when calling interceptor")
+					}
+					return s.LexiconInfo.GetSchemaInfo(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SchemaInfoResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SchemaInfoResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SchemaInfoResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SchemaInfoResponse and nil error while calling GetSchemaInfo. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *lexiconInfoServer) serveGetSchemaInfoProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetSchemaInfo")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SchemaInfoRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.LexiconInfo.GetSchemaInfo
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SchemaInfoRequest) (*SchemaInfoResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SchemaInfoRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SchemaInfoRequest) when calling interceptor")
+					}
+					return s.LexiconInfo.GetSchemaInfo(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SchemaInfoResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SchemaInfoResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SchemaInfoResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SchemaInfoResponse and nil error while calling GetSchemaInfo. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *lexiconInfoServer) serveGetSearchSchema(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetSearchSchemaJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetSearchSchemaProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *lexiconInfoServer) serveGetSearchSchemaJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetSearchSchema")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SearchSchemaRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.LexiconInfo.GetSearchSchema
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SearchSchemaRequest) (*SearchSchema, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SearchSchemaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SearchSchemaRequest) when calling interceptor")
+					}
+					return s.LexiconInfo.GetSearchSchema(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SearchSchema)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchSchema) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SearchSchema
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SearchSchema and nil error while calling GetSearchSchema. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *lexiconInfoServer) serveGetSearchSchemaProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetSearchSchema")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SearchSchemaRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.LexiconInfo.GetSearchSchema
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SearchSchemaRequest) (*SearchSchema, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SearchSchemaRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SearchSchemaRequest) when calling interceptor")
+					}
+					return s.LexiconInfo.GetSearchSchema(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SearchSchema)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchSchema) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *SearchSchema
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SearchSchema and nil error while calling GetSearchSchema. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *lexiconInfoServer) serveStats(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveStatsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveStatsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *lexiconInfoServer) serveStatsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Stats")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(LexiconStatsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.LexiconInfo.Stats
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *LexiconStatsRequest) (*LexiconStats, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LexiconStatsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LexiconStatsRequest) when calling interceptor")
+					}
+					return s.LexiconInfo.Stats(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LexiconStats)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LexiconStats) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *LexiconStats
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *LexiconStats and nil error while calling Stats. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *lexiconInfoServer) serveStatsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Stats")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(LexiconStatsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.LexiconInfo.Stats
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *LexiconStatsRequest) (*LexiconStats, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*LexiconStatsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*LexiconStatsRequest) when calling interceptor")
+					}
+					return s.LexiconInfo.Stats(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*LexiconStats)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*LexiconStats) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *LexiconStats
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *LexiconStats and nil error while calling Stats. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *lexiconInfoServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 3
+}
+
+func (s *lexiconInfoServer) ProtocGenTwirpVersion() string {
+	return "v8.1.2"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *lexiconInfoServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "wordsearcher", "LexiconInfo")
+}
+
+// ===============
+// Admin Interface
+// ===============
+
+// Admin has calls that modify the lexicon databases. It is only served if
+// an admin token is configured.
+type Admin interface {
+	// UpdateDefinitions applies a batch of definition corrections to a
+	// lexicon database in one transaction.
+	UpdateDefinitions(context.Context, *DefinitionUpdateRequest) (*DefinitionUpdateResponse, error)
+
+	// Checkpoint writes a lexicon database's WAL back into the database.
+	Checkpoint(context.Context, *CheckpointRequest) (*CheckpointResponse, error)
+}
+
+// =====================
+// Admin Protobuf Client
+// =====================
+
+type adminProtobufClient struct {
+	client      HTTPClient
+	urls        [2]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewAdminProtobufClient creates a Protobuf client that implements the Admin interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewAdminProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) Admin {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "wordsearcher", "Admin")
+	urls := [2]string{
+		serviceURL + "UpdateDefinitions",
+		serviceURL + "Checkpoint",
+	}
+
+	return &adminProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *adminProtobufClient) UpdateDefinitions(ctx context.Context, in *DefinitionUpdateRequest) (*DefinitionUpdateResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "Admin")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateDefinitions")
+	caller := c.callUpdateDefinitions
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DefinitionUpdateRequest) (*DefinitionUpdateResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DefinitionUpdateRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DefinitionUpdateRequest) when calling interceptor")
+					}
+					return c.callUpdateDefinitions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DefinitionUpdateResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DefinitionUpdateResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminProtobufClient) callUpdateDefinitions(ctx context.Context, in *DefinitionUpdateRequest) (*DefinitionUpdateResponse, error) {
+	out := new(DefinitionUpdateResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminProtobufClient) Checkpoint(ctx context.Context, in *CheckpointRequest) (*CheckpointResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "Admin")
+	ctx = ctxsetters.WithMethodName(ctx, "Checkpoint")
+	caller := c.callCheckpoint
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CheckpointRequest) (*CheckpointResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CheckpointRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CheckpointRequest) when calling interceptor")
+					}
+					return c.callCheckpoint(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CheckpointResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CheckpointResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminProtobufClient) callCheckpoint(ctx context.Context, in *CheckpointRequest) (*CheckpointResponse, error) {
+	out := new(CheckpointResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =================
+// Admin JSON Client
+// =================
+
+type adminJSONClient struct {
+	client      HTTPClient
+	urls        [2]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewAdminJSONClient creates a JSON client that implements the Admin interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewAdminJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) Admin {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "wordsearcher", "Admin")
+	urls := [2]string{
+		serviceURL + "UpdateDefinitions",
+		serviceURL + "Checkpoint",
+	}
+
+	return &adminJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *adminJSONClient) UpdateDefinitions(ctx context.Context, in *DefinitionUpdateRequest) (*DefinitionUpdateResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "Admin")
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateDefinitions")
+	caller := c.callUpdateDefinitions
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DefinitionUpdateRequest) (*DefinitionUpdateResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DefinitionUpdateRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DefinitionUpdateRequest) when calling interceptor")
+					}
+					return c.callUpdateDefinitions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DefinitionUpdateResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DefinitionUpdateResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminJSONClient) callUpdateDefinitions(ctx context.Context, in *DefinitionUpdateRequest) (*DefinitionUpdateResponse, error) {
+	out := new(DefinitionUpdateResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *adminJSONClient) Checkpoint(ctx context.Context, in *CheckpointRequest) (*CheckpointResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "Admin")
+	ctx = ctxsetters.WithMethodName(ctx, "Checkpoint")
+	caller := c.callCheckpoint
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CheckpointRequest) (*CheckpointResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CheckpointRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CheckpointRequest) when calling interceptor")
+					}
+					return c.callCheckpoint(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CheckpointResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CheckpointResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *adminJSONClient) callCheckpoint(ctx context.Context, in *CheckpointRequest) (*CheckpointResponse, error) {
+	out := new(CheckpointResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ====================
+// Admin Server Handler
+// ====================
+
+type adminServer struct {
+	Admin
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewAdminServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewAdminServer(svc Admin, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &adminServer{
+		Admin:            svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *adminServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *adminServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// AdminPathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const AdminPathPrefix = "/twirp/wordsearcher.Admin/"
+
+func (s *adminServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "Admin")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "wordsearcher.Admin" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "UpdateDefinitions":
+		s.serveUpdateDefinitions(ctx, resp, req)
+		return
+	case "Checkpoint":
+		s.serveCheckpoint(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *adminServer) serveUpdateDefinitions(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveUpdateDefinitionsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveUpdateDefinitionsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *adminServer) serveUpdateDefinitionsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateDefinitions")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(DefinitionUpdateRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Admin.UpdateDefinitions
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DefinitionUpdateRequest) (*DefinitionUpdateResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DefinitionUpdateRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DefinitionUpdateRequest) when calling interceptor")
+					}
+					return s.Admin.UpdateDefinitions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DefinitionUpdateResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DefinitionUpdateResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DefinitionUpdateResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DefinitionUpdateResponse and nil error while calling UpdateDefinitions. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServer) serveUpdateDefinitionsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "UpdateDefinitions")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(DefinitionUpdateRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Admin.UpdateDefinitions
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DefinitionUpdateRequest) (*DefinitionUpdateResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DefinitionUpdateRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DefinitionUpdateRequest) when calling interceptor")
+					}
+					return s.Admin.UpdateDefinitions(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DefinitionUpdateResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DefinitionUpdateResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DefinitionUpdateResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DefinitionUpdateResponse and nil error while calling UpdateDefinitions. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServer) serveCheckpoint(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCheckpointJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCheckpointProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *adminServer) serveCheckpointJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Checkpoint")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CheckpointRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.Admin.Checkpoint
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CheckpointRequest) (*CheckpointResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CheckpointRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CheckpointRequest) when calling interceptor")
+					}
+					return s.Admin.Checkpoint(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CheckpointResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CheckpointResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CheckpointResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CheckpointResponse and nil error while calling Checkpoint. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServer) serveCheckpointProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Checkpoint")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CheckpointRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.Admin.Checkpoint
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CheckpointRequest) (*CheckpointResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CheckpointRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CheckpointRequest) when calling interceptor")
+					}
+					return s.Admin.Checkpoint(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CheckpointResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CheckpointResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CheckpointResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CheckpointResponse and nil error while calling Checkpoint. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *adminServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 4
+}
+
+func (s *adminServer) ProtocGenTwirpVersion() string {
+	return "v8.1.2"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *adminServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "wordsearcher", "Admin")
+}
+
+// =======================
+// QuizScheduler Interface
+// =======================
+
+// QuizScheduler keeps users' cardboxes, and schedules their cards for
+// review with a Leitner system. It is only served if a cardbox store is
+// configured.
+type QuizScheduler interface {
+	// CreateCardbox creates a cardbox from the results of a search, with
+	// every card due now.
+	CreateCardbox(context.Context, *CreateCardboxRequest) (*CreateCardboxResponse, error)
+
+	// RecordAnswer moves a card up a box if it was answered correctly, or
+	// back to the first box if not, and schedules it accordingly.
+	RecordAnswer(context.Context, *RecordAnswerRequest) (*RecordAnswerResponse, error)
+
+	// DueCards returns the cards of a cardbox that are due for review.
+	DueCards(context.Context, *DueCardsRequest) (*DueCardsResponse, error)
+
+	// CombineLists computes the union, intersection or difference of
+	// searches, cardboxes and alphagram lists, e.g. a cardbox minus the
+	// results of this week's challenge. The result can be saved as a new
+	// cardbox.
+	CombineLists(context.Context, *CombineListsRequest) (*CombineListsResponse, error)
+
+	// ImportHistory creates a cardbox from quiz history exported from
+	// another tool, keeping each question's box and due date.
+	ImportHistory(context.Context, *ImportHistoryRequest) (*ImportHistoryResponse, error)
+
+	// DeleteCardbox deletes a cardbox. It can be restored for a while, the
+	// server's retention window, before it's gone for good.
+	DeleteCardbox(context.Context, *DeleteCardboxRequest) (*DeleteCardboxResponse, error)
+
+	// RestoreCardbox restores a deleted cardbox, with its cards as they were.
+	RestoreCardbox(context.Context, *RestoreCardboxRequest) (*RestoreCardboxResponse, error)
+
+	// DeletedCardboxes lists the user's deleted cardboxes that can still be
+	// restored.
+	DeletedCardboxes(context.Context, *DeletedCardboxesRequest) (*DeletedCardboxesResponse, error)
+}
+
+// =============================
+// QuizScheduler Protobuf Client
+// =============================
+
+type quizSchedulerProtobufClient struct {
+	client      HTTPClient
+	urls        [8]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewQuizSchedulerProtobufClient creates a Protobuf client that implements the QuizScheduler interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewQuizSchedulerProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) QuizScheduler {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "wordsearcher", "QuizScheduler")
+	urls := [8]string{
+		serviceURL + "CreateCardbox",
+		serviceURL + "RecordAnswer",
+		serviceURL + "DueCards",
+		serviceURL + "CombineLists",
+		serviceURL + "ImportHistory",
+		serviceURL + "DeleteCardbox",
+		serviceURL + "RestoreCardbox",
+		serviceURL + "DeletedCardboxes",
+	}
+
+	return &quizSchedulerProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *quizSchedulerProtobufClient) CreateCardbox(ctx context.Context, in *CreateCardboxRequest) (*CreateCardboxResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "QuizScheduler")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateCardbox")
+	caller := c.callCreateCardbox
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateCardboxRequest) (*CreateCardboxResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateCardboxRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateCardboxRequest) when calling interceptor")
+					}
+					return c.callCreateCardbox(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateCardboxResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateCardboxResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *quizSchedulerProtobufClient) callCreateCardbox(ctx context.Context, in *CreateCardboxRequest) (*CreateCardboxResponse, error) {
+	out := new(CreateCardboxResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *quizSchedulerProtobufClient) RecordAnswer(ctx context.Context, in *RecordAnswerRequest) (*RecordAnswerResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "QuizScheduler")
+	ctx = ctxsetters.WithMethodName(ctx, "RecordAnswer")
+	caller := c.callRecordAnswer
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RecordAnswerRequest) (*RecordAnswerResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RecordAnswerRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RecordAnswerRequest) when calling interceptor")
+					}
+					return c.callRecordAnswer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RecordAnswerResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RecordAnswerResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *quizSchedulerProtobufClient) callRecordAnswer(ctx context.Context, in *RecordAnswerRequest) (*RecordAnswerResponse, error) {
+	out := new(RecordAnswerResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *quizSchedulerProtobufClient) DueCards(ctx context.Context, in *DueCardsRequest) (*DueCardsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "QuizScheduler")
+	ctx = ctxsetters.WithMethodName(ctx, "DueCards")
+	caller := c.callDueCards
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DueCardsRequest) (*DueCardsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DueCardsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DueCardsRequest) when calling interceptor")
+					}
+					return c.callDueCards(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DueCardsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DueCardsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *quizSchedulerProtobufClient) callDueCards(ctx context.Context, in *DueCardsRequest) (*DueCardsResponse, error) {
+	out := new(DueCardsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *quizSchedulerProtobufClient) CombineLists(ctx context.Context, in *CombineListsRequest) (*CombineListsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "QuizScheduler")
+	ctx = ctxsetters.WithMethodName(ctx, "CombineLists")
+	caller := c.callCombineLists
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CombineListsRequest) (*CombineListsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CombineListsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CombineListsRequest) when calling interceptor")
+					}
+					return c.callCombineLists(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CombineListsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CombineListsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *quizSchedulerProtobufClient) callCombineLists(ctx context.Context, in *CombineListsRequest) (*CombineListsResponse, error) {
+	out := new(CombineListsResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *quizSchedulerProtobufClient) ImportHistory(ctx context.Context, in *ImportHistoryRequest) (*ImportHistoryResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "QuizScheduler")
+	ctx = ctxsetters.WithMethodName(ctx, "ImportHistory")
+	caller := c.callImportHistory
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ImportHistoryRequest) (*ImportHistoryResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ImportHistoryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ImportHistoryRequest) when calling interceptor")
+					}
+					return c.callImportHistory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ImportHistoryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ImportHistoryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *quizSchedulerProtobufClient) callImportHistory(ctx context.Context, in *ImportHistoryRequest) (*ImportHistoryResponse, error) {
+	out := new(ImportHistoryResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *quizSchedulerProtobufClient) DeleteCardbox(ctx context.Context, in *DeleteCardboxRequest) (*DeleteCardboxResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "QuizScheduler")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteCardbox")
+	caller := c.callDeleteCardbox
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteCardboxRequest) (*DeleteCardboxResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteCardboxRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteCardboxRequest) when calling interceptor")
+					}
+					return c.callDeleteCardbox(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteCardboxResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteCardboxResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *quizSchedulerProtobufClient) callDeleteCardbox(ctx context.Context, in *DeleteCardboxRequest) (*DeleteCardboxResponse, error) {
+	out := new(DeleteCardboxResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *quizSchedulerProtobufClient) RestoreCardbox(ctx context.Context, in *RestoreCardboxRequest) (*RestoreCardboxResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "QuizScheduler")
+	ctx = ctxsetters.WithMethodName(ctx, "RestoreCardbox")
+	caller := c.callRestoreCardbox
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RestoreCardboxRequest) (*RestoreCardboxResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RestoreCardboxRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RestoreCardboxRequest) when calling interceptor")
+					}
+					return c.callRestoreCardbox(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RestoreCardboxResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RestoreCardboxResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *quizSchedulerProtobufClient) callRestoreCardbox(ctx context.Context, in *RestoreCardboxRequest) (*RestoreCardboxResponse, error) {
+	out := new(RestoreCardboxResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *quizSchedulerProtobufClient) DeletedCardboxes(ctx context.Context, in *DeletedCardboxesRequest) (*DeletedCardboxesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "QuizScheduler")
+	ctx = ctxsetters.WithMethodName(ctx, "DeletedCardboxes")
+	caller := c.callDeletedCardboxes
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeletedCardboxesRequest) (*DeletedCardboxesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeletedCardboxesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeletedCardboxesRequest) when calling interceptor")
+					}
+					return c.callDeletedCardboxes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeletedCardboxesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeletedCardboxesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *quizSchedulerProtobufClient) callDeletedCardboxes(ctx context.Context, in *DeletedCardboxesRequest) (*DeletedCardboxesResponse, error) {
+	out := new(DeletedCardboxesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =========================
+// QuizScheduler JSON Client
+// =========================
+
+type quizSchedulerJSONClient struct {
+	client      HTTPClient
+	urls        [8]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewQuizSchedulerJSONClient creates a JSON client that implements the QuizScheduler interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewQuizSchedulerJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) QuizScheduler {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "wordsearcher", "QuizScheduler")
+	urls := [8]string{
+		serviceURL + "CreateCardbox",
+		serviceURL + "RecordAnswer",
+		serviceURL + "DueCards",
+		serviceURL + "CombineLists",
+		serviceURL + "ImportHistory",
+		serviceURL + "DeleteCardbox",
+		serviceURL + "RestoreCardbox",
+		serviceURL + "DeletedCardboxes",
+	}
+
+	return &quizSchedulerJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *quizSchedulerJSONClient) CreateCardbox(ctx context.Context, in *CreateCardboxRequest) (*CreateCardboxResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "QuizScheduler")
+	ctx = ctxsetters.WithMethodName(ctx, "CreateCardbox")
+	caller := c.callCreateCardbox
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CreateCardboxRequest) (*CreateCardboxResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateCardboxRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateCardboxRequest) when calling interceptor")
+					}
+					return c.callCreateCardbox(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateCardboxResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateCardboxResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *quizSchedulerJSONClient) callCreateCardbox(ctx context.Context, in *CreateCardboxRequest) (*CreateCardboxResponse, error) {
+	out := new(CreateCardboxResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *quizSchedulerJSONClient) RecordAnswer(ctx context.Context, in *RecordAnswerRequest) (*RecordAnswerResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "QuizScheduler")
+	ctx = ctxsetters.WithMethodName(ctx, "RecordAnswer")
+	caller := c.callRecordAnswer
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RecordAnswerRequest) (*RecordAnswerResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RecordAnswerRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RecordAnswerRequest) when calling interceptor")
+					}
+					return c.callRecordAnswer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RecordAnswerResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RecordAnswerResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *quizSchedulerJSONClient) callRecordAnswer(ctx context.Context, in *RecordAnswerRequest) (*RecordAnswerResponse, error) {
+	out := new(RecordAnswerResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *quizSchedulerJSONClient) DueCards(ctx context.Context, in *DueCardsRequest) (*DueCardsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "QuizScheduler")
+	ctx = ctxsetters.WithMethodName(ctx, "DueCards")
+	caller := c.callDueCards
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DueCardsRequest) (*DueCardsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DueCardsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DueCardsRequest) when calling interceptor")
+					}
+					return c.callDueCards(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DueCardsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DueCardsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *quizSchedulerJSONClient) callDueCards(ctx context.Context, in *DueCardsRequest) (*DueCardsResponse, error) {
+	out := new(DueCardsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *quizSchedulerJSONClient) CombineLists(ctx context.Context, in *CombineListsRequest) (*CombineListsResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "QuizScheduler")
+	ctx = ctxsetters.WithMethodName(ctx, "CombineLists")
+	caller := c.callCombineLists
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *CombineListsRequest) (*CombineListsResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CombineListsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CombineListsRequest) when calling interceptor")
+					}
+					return c.callCombineLists(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CombineListsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CombineListsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *quizSchedulerJSONClient) callCombineLists(ctx context.Context, in *CombineListsRequest) (*CombineListsResponse, error) {
+	out := new(CombineListsResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *quizSchedulerJSONClient) ImportHistory(ctx context.Context, in *ImportHistoryRequest) (*ImportHistoryResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "QuizScheduler")
+	ctx = ctxsetters.WithMethodName(ctx, "ImportHistory")
+	caller := c.callImportHistory
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ImportHistoryRequest) (*ImportHistoryResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ImportHistoryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ImportHistoryRequest) when calling interceptor")
+					}
+					return c.callImportHistory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ImportHistoryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ImportHistoryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *quizSchedulerJSONClient) callImportHistory(ctx context.Context, in *ImportHistoryRequest) (*ImportHistoryResponse, error) {
+	out := new(ImportHistoryResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[4], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *quizSchedulerJSONClient) DeleteCardbox(ctx context.Context, in *DeleteCardboxRequest) (*DeleteCardboxResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "QuizScheduler")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteCardbox")
+	caller := c.callDeleteCardbox
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteCardboxRequest) (*DeleteCardboxResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteCardboxRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteCardboxRequest) when calling interceptor")
+					}
+					return c.callDeleteCardbox(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteCardboxResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteCardboxResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *quizSchedulerJSONClient) callDeleteCardbox(ctx context.Context, in *DeleteCardboxRequest) (*DeleteCardboxResponse, error) {
+	out := new(DeleteCardboxResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[5], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *quizSchedulerJSONClient) RestoreCardbox(ctx context.Context, in *RestoreCardboxRequest) (*RestoreCardboxResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "QuizScheduler")
+	ctx = ctxsetters.WithMethodName(ctx, "RestoreCardbox")
+	caller := c.callRestoreCardbox
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RestoreCardboxRequest) (*RestoreCardboxResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RestoreCardboxRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RestoreCardboxRequest) when calling interceptor")
+					}
+					return c.callRestoreCardbox(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RestoreCardboxResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RestoreCardboxResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *quizSchedulerJSONClient) callRestoreCardbox(ctx context.Context, in *RestoreCardboxRequest) (*RestoreCardboxResponse, error) {
+	out := new(RestoreCardboxResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *quizSchedulerJSONClient) DeletedCardboxes(ctx context.Context, in *DeletedCardboxesRequest) (*DeletedCardboxesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "QuizScheduler")
+	ctx = ctxsetters.WithMethodName(ctx, "DeletedCardboxes")
+	caller := c.callDeletedCardboxes
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeletedCardboxesRequest) (*DeletedCardboxesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeletedCardboxesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeletedCardboxesRequest) when calling interceptor")
+					}
+					return c.callDeletedCardboxes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeletedCardboxesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeletedCardboxesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *quizSchedulerJSONClient) callDeletedCardboxes(ctx context.Context, in *DeletedCardboxesRequest) (*DeletedCardboxesResponse, error) {
+	out := new(DeletedCardboxesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ============================
+// QuizScheduler Server Handler
+// ============================
+
+type quizSchedulerServer struct {
+	QuizScheduler
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewQuizSchedulerServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewQuizSchedulerServer(svc QuizScheduler, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &quizSchedulerServer{
+		QuizScheduler:    svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *quizSchedulerServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *quizSchedulerServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
+	}
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
+}
+
+// QuizSchedulerPathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const QuizSchedulerPathPrefix = "/twirp/wordsearcher.QuizScheduler/"
+
+func (s *quizSchedulerServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "QuizScheduler")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "wordsearcher.QuizScheduler" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+
+	switch method {
+	case "CreateCardbox":
+		s.serveCreateCardbox(ctx, resp, req)
+		return
+	case "RecordAnswer":
+		s.serveRecordAnswer(ctx, resp, req)
+		return
+	case "DueCards":
+		s.serveDueCards(ctx, resp, req)
+		return
+	case "CombineLists":
+		s.serveCombineLists(ctx, resp, req)
+		return
+	case "ImportHistory":
+		s.serveImportHistory(ctx, resp, req)
+		return
+	case "DeleteCardbox":
+		s.serveDeleteCardbox(ctx, resp, req)
+		return
+	case "RestoreCardbox":
+		s.serveRestoreCardbox(ctx, resp, req)
+		return
+	case "DeletedCardboxes":
+		s.serveDeletedCardboxes(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+}
+
+func (s *quizSchedulerServer) serveCreateCardbox(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCreateCardboxJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCreateCardboxProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *quizSchedulerServer) serveCreateCardboxJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateCardbox")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CreateCardboxRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.QuizScheduler.CreateCardbox
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateCardboxRequest) (*CreateCardboxResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateCardboxRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateCardboxRequest) when calling interceptor")
+					}
+					return s.QuizScheduler.CreateCardbox(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateCardboxResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateCardboxResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateCardboxResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateCardboxResponse and nil error while calling CreateCardbox. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *quizSchedulerServer) serveCreateCardboxProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CreateCardbox")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CreateCardboxRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.QuizScheduler.CreateCardbox
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CreateCardboxRequest) (*CreateCardboxResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CreateCardboxRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CreateCardboxRequest) when calling interceptor")
+					}
+					return s.QuizScheduler.CreateCardbox(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CreateCardboxResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CreateCardboxResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CreateCardboxResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CreateCardboxResponse and nil error while calling CreateCardbox. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *quizSchedulerServer) serveRecordAnswer(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRecordAnswerJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRecordAnswerProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *quizSchedulerServer) serveRecordAnswerJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RecordAnswer")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RecordAnswerRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.QuizScheduler.RecordAnswer
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RecordAnswerRequest) (*RecordAnswerResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RecordAnswerRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RecordAnswerRequest) when calling interceptor")
+					}
+					return s.QuizScheduler.RecordAnswer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RecordAnswerResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RecordAnswerResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RecordAnswerResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RecordAnswerResponse and nil error while calling RecordAnswer. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *quizSchedulerServer) serveRecordAnswerProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RecordAnswer")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RecordAnswerRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.QuizScheduler.RecordAnswer
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RecordAnswerRequest) (*RecordAnswerResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RecordAnswerRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RecordAnswerRequest) when calling interceptor")
+					}
+					return s.QuizScheduler.RecordAnswer(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RecordAnswerResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RecordAnswerResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RecordAnswerResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RecordAnswerResponse and nil error while calling RecordAnswer. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *quizSchedulerServer) serveDueCards(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDueCardsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDueCardsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *quizSchedulerServer) serveDueCardsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DueCards")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(DueCardsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.QuizScheduler.DueCards
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DueCardsRequest) (*DueCardsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DueCardsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DueCardsRequest) when calling interceptor")
+					}
+					return s.QuizScheduler.DueCards(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DueCardsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DueCardsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DueCardsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DueCardsResponse and nil error while calling DueCards. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *quizSchedulerServer) serveDueCardsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DueCards")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(DueCardsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.QuizScheduler.DueCards
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DueCardsRequest) (*DueCardsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DueCardsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DueCardsRequest) when calling interceptor")
+					}
+					return s.QuizScheduler.DueCards(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DueCardsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DueCardsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DueCardsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DueCardsResponse and nil error while calling DueCards. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *quizSchedulerServer) serveCombineLists(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveCombineListsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveCombineListsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *quizSchedulerServer) serveCombineListsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CombineLists")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(CombineListsRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.QuizScheduler.CombineLists
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CombineListsRequest) (*CombineListsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CombineListsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CombineListsRequest) when calling interceptor")
+					}
+					return s.QuizScheduler.CombineLists(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CombineListsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CombineListsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CombineListsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CombineListsResponse and nil error while calling CombineLists. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *quizSchedulerServer) serveCombineListsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "CombineLists")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(CombineListsRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.QuizScheduler.CombineLists
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *CombineListsRequest) (*CombineListsResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*CombineListsRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*CombineListsRequest) when calling interceptor")
+					}
+					return s.QuizScheduler.CombineLists(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*CombineListsResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*CombineListsResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *CombineListsResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *CombineListsResponse and nil error while calling CombineLists. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *quizSchedulerServer) serveImportHistory(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveImportHistoryJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveImportHistoryProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *quizSchedulerServer) serveImportHistoryJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ImportHistory")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ImportHistoryRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.QuizScheduler.ImportHistory
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ImportHistoryRequest) (*ImportHistoryResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ImportHistoryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ImportHistoryRequest) when calling interceptor")
+					}
+					return s.QuizScheduler.ImportHistory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ImportHistoryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ImportHistoryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ImportHistoryResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ImportHistoryResponse and nil error while calling ImportHistory. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *quizSchedulerServer) serveImportHistoryProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ImportHistory")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(ImportHistoryRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.QuizScheduler.ImportHistory
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ImportHistoryRequest) (*ImportHistoryResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ImportHistoryRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ImportHistoryRequest) when calling interceptor")
+					}
+					return s.QuizScheduler.ImportHistory(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ImportHistoryResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ImportHistoryResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *ImportHistoryResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ImportHistoryResponse and nil error while calling ImportHistory. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *quizSchedulerServer) serveDeleteCardbox(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDeleteCardboxJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDeleteCardboxProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *quizSchedulerServer) serveDeleteCardboxJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteCardbox")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(DeleteCardboxRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.QuizScheduler.DeleteCardbox
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteCardboxRequest) (*DeleteCardboxResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteCardboxRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteCardboxRequest) when calling interceptor")
+					}
+					return s.QuizScheduler.DeleteCardbox(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteCardboxResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteCardboxResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeleteCardboxResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteCardboxResponse and nil error while calling DeleteCardbox. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *quizSchedulerServer) serveDeleteCardboxProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteCardbox")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(DeleteCardboxRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.QuizScheduler.DeleteCardbox
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeleteCardboxRequest) (*DeleteCardboxResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteCardboxRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteCardboxRequest) when calling interceptor")
+					}
+					return s.QuizScheduler.DeleteCardbox(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteCardboxResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteCardboxResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeleteCardboxResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteCardboxResponse and nil error while calling DeleteCardbox. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *quizSchedulerServer) serveRestoreCardbox(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveRestoreCardboxJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveRestoreCardboxProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *quizSchedulerServer) serveRestoreCardboxJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RestoreCardbox")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(RestoreCardboxRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.QuizScheduler.RestoreCardbox
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RestoreCardboxRequest) (*RestoreCardboxResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RestoreCardboxRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RestoreCardboxRequest) when calling interceptor")
+					}
+					return s.QuizScheduler.RestoreCardbox(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RestoreCardboxResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RestoreCardboxResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RestoreCardboxResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RestoreCardboxResponse and nil error while calling RestoreCardbox. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *quizSchedulerServer) serveRestoreCardboxProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "RestoreCardbox")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(RestoreCardboxRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.QuizScheduler.RestoreCardbox
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *RestoreCardboxRequest) (*RestoreCardboxResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RestoreCardboxRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RestoreCardboxRequest) when calling interceptor")
+					}
+					return s.QuizScheduler.RestoreCardbox(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*RestoreCardboxResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*RestoreCardboxResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *RestoreCardboxResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *RestoreCardboxResponse and nil error while calling RestoreCardbox. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *quizSchedulerServer) serveDeletedCardboxes(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveDeletedCardboxesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveDeletedCardboxesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *quizSchedulerServer) serveDeletedCardboxesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeletedCardboxes")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(DeletedCardboxesRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.QuizScheduler.DeletedCardboxes
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeletedCardboxesRequest) (*DeletedCardboxesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeletedCardboxesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeletedCardboxesRequest) when calling interceptor")
+					}
+					return s.QuizScheduler.DeletedCardboxes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeletedCardboxesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeletedCardboxesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeletedCardboxesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeletedCardboxesResponse and nil error while calling DeletedCardboxes. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *quizSchedulerServer) serveDeletedCardboxesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "DeletedCardboxes")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(DeletedCardboxesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.QuizScheduler.DeletedCardboxes
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *DeletedCardboxesRequest) (*DeletedCardboxesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeletedCardboxesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeletedCardboxesRequest) when calling interceptor")
+					}
+					return s.QuizScheduler.DeletedCardboxes(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeletedCardboxesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeletedCardboxesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *DeletedCardboxesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeletedCardboxesResponse and nil error while calling DeletedCardboxes. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *quizSchedulerServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 5
+}
+
+func (s *quizSchedulerServer) ProtocGenTwirpVersion() string {
+	return "v8.1.2"
+}
+
+// PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
+// that is everything in a Twirp route except for the <Method>. This can be used for routing,
+// for example to identify the requests that are targeted to this service in a mux.
+func (s *quizSchedulerServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "wordsearcher", "QuizScheduler")
+}
+
+// =======================
+// SavedSearches Interface
+// =======================
+
+// SavedSearches keeps users' searches under names, so that searches they
+// study from again and again can be run by name. It is only served if a
+// cardbox store is configured, and keeps them in the same store.
+type SavedSearches interface {
+	// SaveSearch saves a search under a name, replacing the user's search of
+	// that name, if any.
+	SaveSearch(context.Context, *SaveSearchRequest) (*SaveSearchResponse, error)
+
+	// ListSavedSearches lists the user's saved searches.
+	ListSavedSearches(context.Context, *ListSavedSearchesRequest) (*ListSavedSearchesResponse, error)
+
+	// RunSavedSearch runs one of the user's saved searches.
+	RunSavedSearch(context.Context, *RunSavedSearchRequest) (*SearchResponse, error)
+
+	// DeleteSavedSearch deletes one of the user's saved searches.
+	DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error)
+}
+
+// =============================
+// SavedSearches Protobuf Client
+// =============================
+
+type savedSearchesProtobufClient struct {
+	client      HTTPClient
+	urls        [4]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewSavedSearchesProtobufClient creates a Protobuf client that implements the SavedSearches interface.
+// It communicates using Protobuf and can be configured with a custom HTTPClient.
+func NewSavedSearchesProtobufClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) SavedSearches {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "wordsearcher", "SavedSearches")
+	urls := [4]string{
+		serviceURL + "SaveSearch",
+		serviceURL + "ListSavedSearches",
+		serviceURL + "RunSavedSearch",
+		serviceURL + "DeleteSavedSearch",
+	}
+
+	return &savedSearchesProtobufClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *savedSearchesProtobufClient) SaveSearch(ctx context.Context, in *SaveSearchRequest) (*SaveSearchResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "SavedSearches")
+	ctx = ctxsetters.WithMethodName(ctx, "SaveSearch")
+	caller := c.callSaveSearch
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SaveSearchRequest) (*SaveSearchResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SaveSearchRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SaveSearchRequest) when calling interceptor")
+					}
+					return c.callSaveSearch(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SaveSearchResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SaveSearchResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *savedSearchesProtobufClient) callSaveSearch(ctx context.Context, in *SaveSearchRequest) (*SaveSearchResponse, error) {
+	out := new(SaveSearchResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *savedSearchesProtobufClient) ListSavedSearches(ctx context.Context, in *ListSavedSearchesRequest) (*ListSavedSearchesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "SavedSearches")
+	ctx = ctxsetters.WithMethodName(ctx, "ListSavedSearches")
+	caller := c.callListSavedSearches
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListSavedSearchesRequest) (*ListSavedSearchesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListSavedSearchesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListSavedSearchesRequest) when calling interceptor")
+					}
+					return c.callListSavedSearches(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListSavedSearchesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListSavedSearchesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *savedSearchesProtobufClient) callListSavedSearches(ctx context.Context, in *ListSavedSearchesRequest) (*ListSavedSearchesResponse, error) {
+	out := new(ListSavedSearchesResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *savedSearchesProtobufClient) RunSavedSearch(ctx context.Context, in *RunSavedSearchRequest) (*SearchResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "SavedSearches")
+	ctx = ctxsetters.WithMethodName(ctx, "RunSavedSearch")
+	caller := c.callRunSavedSearch
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RunSavedSearchRequest) (*SearchResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RunSavedSearchRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RunSavedSearchRequest) when calling interceptor")
+					}
+					return c.callRunSavedSearch(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SearchResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *savedSearchesProtobufClient) callRunSavedSearch(ctx context.Context, in *RunSavedSearchRequest) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *savedSearchesProtobufClient) DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "SavedSearches")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteSavedSearch")
+	caller := c.callDeleteSavedSearch
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteSavedSearchRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteSavedSearchRequest) when calling interceptor")
+					}
+					return c.callDeleteSavedSearch(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteSavedSearchResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteSavedSearchResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *savedSearchesProtobufClient) callDeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error) {
+	out := new(DeleteSavedSearchResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// =========================
+// SavedSearches JSON Client
+// =========================
+
+type savedSearchesJSONClient struct {
+	client      HTTPClient
+	urls        [4]string
+	interceptor twirp.Interceptor
+	opts        twirp.ClientOptions
+}
+
+// NewSavedSearchesJSONClient creates a JSON client that implements the SavedSearches interface.
+// It communicates using JSON and can be configured with a custom HTTPClient.
+func NewSavedSearchesJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) SavedSearches {
+	if c, ok := client.(*http.Client); ok {
+		client = withoutRedirects(c)
+	}
+
+	clientOpts := twirp.ClientOptions{}
+	for _, o := range opts {
+		o(&clientOpts)
+	}
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	literalURLs := false
+	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
+	var pathPrefix string
+	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
+	serviceURL := sanitizeBaseURL(baseURL)
+	serviceURL += baseServicePath(pathPrefix, "wordsearcher", "SavedSearches")
+	urls := [4]string{
+		serviceURL + "SaveSearch",
+		serviceURL + "ListSavedSearches",
+		serviceURL + "RunSavedSearch",
+		serviceURL + "DeleteSavedSearch",
+	}
+
+	return &savedSearchesJSONClient{
+		client:      client,
+		urls:        urls,
+		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
+		opts:        clientOpts,
+	}
+}
+
+func (c *savedSearchesJSONClient) SaveSearch(ctx context.Context, in *SaveSearchRequest) (*SaveSearchResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "SavedSearches")
+	ctx = ctxsetters.WithMethodName(ctx, "SaveSearch")
+	caller := c.callSaveSearch
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *SaveSearchRequest) (*SaveSearchResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SaveSearchRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SaveSearchRequest) when calling interceptor")
+					}
+					return c.callSaveSearch(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SaveSearchResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SaveSearchResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *savedSearchesJSONClient) callSaveSearch(ctx context.Context, in *SaveSearchRequest) (*SaveSearchResponse, error) {
+	out := new(SaveSearchResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *savedSearchesJSONClient) ListSavedSearches(ctx context.Context, in *ListSavedSearchesRequest) (*ListSavedSearchesResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "SavedSearches")
+	ctx = ctxsetters.WithMethodName(ctx, "ListSavedSearches")
+	caller := c.callListSavedSearches
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *ListSavedSearchesRequest) (*ListSavedSearchesResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListSavedSearchesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListSavedSearchesRequest) when calling interceptor")
+					}
+					return c.callListSavedSearches(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListSavedSearchesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListSavedSearchesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *savedSearchesJSONClient) callListSavedSearches(ctx context.Context, in *ListSavedSearchesRequest) (*ListSavedSearchesResponse, error) {
+	out := new(ListSavedSearchesResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *savedSearchesJSONClient) RunSavedSearch(ctx context.Context, in *RunSavedSearchRequest) (*SearchResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "SavedSearches")
+	ctx = ctxsetters.WithMethodName(ctx, "RunSavedSearch")
+	caller := c.callRunSavedSearch
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *RunSavedSearchRequest) (*SearchResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*RunSavedSearchRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*RunSavedSearchRequest) when calling interceptor")
+					}
+					return c.callRunSavedSearch(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SearchResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *savedSearchesJSONClient) callRunSavedSearch(ctx context.Context, in *RunSavedSearchRequest) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[2], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *savedSearchesJSONClient) DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "SavedSearches")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteSavedSearch")
+	caller := c.callDeleteSavedSearch
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*DeleteSavedSearchRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteSavedSearchRequest) when calling interceptor")
+					}
+					return c.callDeleteSavedSearch(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*DeleteSavedSearchResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteSavedSearchResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *savedSearchesJSONClient) callDeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error) {
+	out := new(DeleteSavedSearchResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[3], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+// ============================
+// SavedSearches Server Handler
+// ============================
+
+type savedSearchesServer struct {
+	SavedSearches
+	interceptor      twirp.Interceptor
+	hooks            *twirp.ServerHooks
+	pathPrefix       string // prefix for routing
+	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
+	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
+}
+
+// NewSavedSearchesServer builds a TwirpServer that can be used as an http.Handler to handle
+// HTTP requests that are routed to the right method in the provided svc implementation.
+// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
+func NewSavedSearchesServer(svc SavedSearches, opts ...interface{}) TwirpServer {
+	serverOpts := newServerOpts(opts)
+
+	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
+	jsonSkipDefaults := false
+	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
+	jsonCamelCase := false
+	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
+	var pathPrefix string
+	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
+		pathPrefix = "/twirp" // default prefix
+	}
+
+	return &savedSearchesServer{
+		SavedSearches:    svc,
+		hooks:            serverOpts.Hooks,
+		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
+		pathPrefix:       pathPrefix,
+		jsonSkipDefaults: jsonSkipDefaults,
+		jsonCamelCase:    jsonCamelCase,
+	}
+}
+
+// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
+// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
+func (s *savedSearchesServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
+	writeError(ctx, resp, err, s.hooks)
+}
+
+// handleRequestBodyError is used to handle error when the twirp server cannot read request
+func (s *savedSearchesServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
+	if context.Canceled == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+		return
+	}
+	if context.DeadlineExceeded == ctx.Err() {
+		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+		return
 	}
-
-	callClientResponseReceived(ctx, c.opts.Hooks)
-
-	return out, nil
+	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
 }
 
-// ========================
-// WordSearcher JSON Client
-// ========================
+// SavedSearchesPathPrefix is a convenience constant that may identify URL paths.
+// Should be used with caution, it only matches routes generated by Twirp Go clients,
+// with the default "/twirp" prefix and default CamelCase service and method names.
+// More info: https://twitchtv.github.io/twirp/docs/routing.html
+const SavedSearchesPathPrefix = "/twirp/wordsearcher.SavedSearches/"
 
-type wordSearcherJSONClient struct {
-	client      HTTPClient
-	urls        [2]string
-	interceptor twirp.Interceptor
-	opts        twirp.ClientOptions
-}
+func (s *savedSearchesServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
+	ctx = ctxsetters.WithServiceName(ctx, "SavedSearches")
+	ctx = ctxsetters.WithResponseWriter(ctx, resp)
 
-// NewWordSearcherJSONClient creates a JSON client that implements the WordSearcher interface.
-// It communicates using JSON and can be configured with a custom HTTPClient.
-func NewWordSearcherJSONClient(baseURL string, client HTTPClient, opts ...twirp.ClientOption) WordSearcher {
-	if c, ok := client.(*http.Client); ok {
-		client = withoutRedirects(c)
+	var err error
+	ctx, err = callRequestReceived(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
 	}
 
-	clientOpts := twirp.ClientOptions{}
-	for _, o := range opts {
-		o(&clientOpts)
+	if req.Method != "POST" {
+		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
 	}
 
-	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
-	literalURLs := false
-	_ = clientOpts.ReadOpt("literalURLs", &literalURLs)
-	var pathPrefix string
-	if ok := clientOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
+	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
+	if pkgService != "wordsearcher.SavedSearches" {
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
+	}
+	if prefix != s.pathPrefix {
+		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
 	}
 
-	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
-	serviceURL := sanitizeBaseURL(baseURL)
-	serviceURL += baseServicePath(pathPrefix, "wordsearcher", "WordSearcher")
-	urls := [2]string{
-		serviceURL + "GetWordInformation",
-		serviceURL + "WordSearch",
+	switch method {
+	case "SaveSearch":
+		s.serveSaveSearch(ctx, resp, req)
+		return
+	case "ListSavedSearches":
+		s.serveListSavedSearches(ctx, resp, req)
+		return
+	case "RunSavedSearch":
+		s.serveRunSavedSearch(ctx, resp, req)
+		return
+	case "DeleteSavedSearch":
+		s.serveDeleteSavedSearch(ctx, resp, req)
+		return
+	default:
+		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
+		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+		return
 	}
+}
 
-	return &wordSearcherJSONClient{
-		client:      client,
-		urls:        urls,
-		interceptor: twirp.ChainInterceptors(clientOpts.Interceptors...),
-		opts:        clientOpts,
+func (s *savedSearchesServer) serveSaveSearch(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveSaveSearchJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveSaveSearchProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
 	}
 }
 
-func (c *wordSearcherJSONClient) GetWordInformation(ctx context.Context, in *DefineRequest) (*WordSearchResponse, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
-	ctx = ctxsetters.WithServiceName(ctx, "WordSearcher")
-	ctx = ctxsetters.WithMethodName(ctx, "GetWordInformation")
-	caller := c.callGetWordInformation
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *DefineRequest) (*WordSearchResponse, error) {
-			resp, err := c.interceptor(
+func (s *savedSearchesServer) serveSaveSearchJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SaveSearch")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(SaveSearchRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SavedSearches.SaveSearch
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SaveSearchRequest) (*SaveSearchResponse, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*DefineRequest)
+					typedReq, ok := req.(*SaveSearchRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*DefineRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*SaveSearchRequest) when calling interceptor")
 					}
-					return c.callGetWordInformation(ctx, typedReq)
+					return s.SavedSearches.SaveSearch(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*WordSearchResponse)
+				typedResp, ok := resp.(*SaveSearchResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*WordSearchResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SaveSearchResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
+
+	// Call service method
+	var respContent *SaveSearchResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SaveSearchResponse and nil error while calling SaveSearch. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (c *wordSearcherJSONClient) callGetWordInformation(ctx context.Context, in *DefineRequest) (*WordSearchResponse, error) {
-	out := new(WordSearchResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[0], in, out)
+func (s *savedSearchesServer) serveSaveSearchProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "SaveSearch")
+	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
+		return
+	}
+	reqContent := new(SaveSearchRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.SavedSearches.SaveSearch
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *SaveSearchRequest) (*SaveSearchResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*SaveSearchRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*SaveSearchRequest) when calling interceptor")
+					}
+					return s.SavedSearches.SaveSearch(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*SaveSearchResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*SaveSearchResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
 		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
+	// Call service method
+	var respContent *SaveSearchResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
 
-	return out, nil
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SaveSearchResponse and nil error while calling SaveSearch. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *savedSearchesServer) serveListSavedSearches(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListSavedSearchesJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListSavedSearchesProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
 }
 
-func (c *wordSearcherJSONClient) WordSearch(ctx context.Context, in *WordSearchRequest) (*WordSearchResponse, error) {
-	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
-	ctx = ctxsetters.WithServiceName(ctx, "WordSearcher")
-	ctx = ctxsetters.WithMethodName(ctx, "WordSearch")
-	caller := c.callWordSearch
-	if c.interceptor != nil {
-		caller = func(ctx context.Context, req *WordSearchRequest) (*WordSearchResponse, error) {
-			resp, err := c.interceptor(
+func (s *savedSearchesServer) serveListSavedSearchesJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListSavedSearches")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	d := json.NewDecoder(req.Body)
+	rawReqBody := json.RawMessage{}
+	if err := d.Decode(&rawReqBody); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+	reqContent := new(ListSavedSearchesRequest)
+	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
+		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
+		return
+	}
+
+	handler := s.SavedSearches.ListSavedSearches
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListSavedSearchesRequest) (*ListSavedSearchesResponse, error) {
+			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*WordSearchRequest)
+					typedReq, ok := req.(*ListSavedSearchesRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*WordSearchRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*ListSavedSearchesRequest) when calling interceptor")
 					}
-					return c.callWordSearch(ctx, typedReq)
+					return s.SavedSearches.ListSavedSearches(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*WordSearchResponse)
+				typedResp, ok := resp.(*ListSavedSearchesResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*WordSearchResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*ListSavedSearchesResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
 			return nil, err
 		}
 	}
-	return caller(ctx, in)
-}
 
-func (c *wordSearcherJSONClient) callWordSearch(ctx context.Context, in *WordSearchRequest) (*WordSearchResponse, error) {
-	out := new(WordSearchResponse)
-	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[1], in, out)
+	// Call service method
+	var respContent *ListSavedSearchesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
 	if err != nil {
-		twerr, ok := err.(twirp.Error)
-		if !ok {
-			twerr = twirp.InternalErrorWith(err)
-		}
-		callClientError(ctx, c.opts.Hooks, twerr)
-		return nil, err
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListSavedSearchesResponse and nil error while calling ListSavedSearches. nil responses are not supported"))
+		return
 	}
 
-	callClientResponseReceived(ctx, c.opts.Hooks)
-
-	return out, nil
-}
-
-// ===========================
-// WordSearcher Server Handler
-// ===========================
-
-type wordSearcherServer struct {
-	WordSearcher
-	interceptor      twirp.Interceptor
-	hooks            *twirp.ServerHooks
-	pathPrefix       string // prefix for routing
-	jsonSkipDefaults bool   // do not include unpopulated fields (default values) in the response
-	jsonCamelCase    bool   // JSON fields are serialized as lowerCamelCase rather than keeping the original proto names
-}
-
-// NewWordSearcherServer builds a TwirpServer that can be used as an http.Handler to handle
-// HTTP requests that are routed to the right method in the provided svc implementation.
-// The opts are twirp.ServerOption modifiers, for example twirp.WithServerHooks(hooks).
-func NewWordSearcherServer(svc WordSearcher, opts ...interface{}) TwirpServer {
-	serverOpts := newServerOpts(opts)
+	ctx = callResponsePrepared(ctx, s.hooks)
 
-	// Using ReadOpt allows backwards and forwads compatibility with new options in the future
-	jsonSkipDefaults := false
-	_ = serverOpts.ReadOpt("jsonSkipDefaults", &jsonSkipDefaults)
-	jsonCamelCase := false
-	_ = serverOpts.ReadOpt("jsonCamelCase", &jsonCamelCase)
-	var pathPrefix string
-	if ok := serverOpts.ReadOpt("pathPrefix", &pathPrefix); !ok {
-		pathPrefix = "/twirp" // default prefix
+	marshaler := &protojson.MarshalOptions{UseProtoNames: !s.jsonCamelCase, EmitUnpopulated: !s.jsonSkipDefaults}
+	respBytes, err := marshaler.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
 	}
 
-	return &wordSearcherServer{
-		WordSearcher:     svc,
-		hooks:            serverOpts.Hooks,
-		interceptor:      twirp.ChainInterceptors(serverOpts.Interceptors...),
-		pathPrefix:       pathPrefix,
-		jsonSkipDefaults: jsonSkipDefaults,
-		jsonCamelCase:    jsonCamelCase,
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
 	}
+	callResponseSent(ctx, s.hooks)
 }
 
-// writeError writes an HTTP response with a valid Twirp error format, and triggers hooks.
-// If err is not a twirp.Error, it will get wrapped with twirp.InternalErrorWith(err)
-func (s *wordSearcherServer) writeError(ctx context.Context, resp http.ResponseWriter, err error) {
-	writeError(ctx, resp, err, s.hooks)
-}
+func (s *savedSearchesServer) serveListSavedSearchesProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListSavedSearches")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
 
-// handleRequestBodyError is used to handle error when the twirp server cannot read request
-func (s *wordSearcherServer) handleRequestBodyError(ctx context.Context, resp http.ResponseWriter, msg string, err error) {
-	if context.Canceled == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.Canceled, "failed to read request: context canceled"))
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	if context.DeadlineExceeded == ctx.Err() {
-		s.writeError(ctx, resp, twirp.NewError(twirp.DeadlineExceeded, "failed to read request: deadline exceeded"))
+	reqContent := new(ListSavedSearchesRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
-	s.writeError(ctx, resp, twirp.WrapError(malformedRequestError(msg), err))
-}
 
-// WordSearcherPathPrefix is a convenience constant that may identify URL paths.
-// Should be used with caution, it only matches routes generated by Twirp Go clients,
-// with the default "/twirp" prefix and default CamelCase service and method names.
-// More info: https://twitchtv.github.io/twirp/docs/routing.html
-const WordSearcherPathPrefix = "/twirp/wordsearcher.WordSearcher/"
+	handler := s.SavedSearches.ListSavedSearches
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *ListSavedSearchesRequest) (*ListSavedSearchesResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*ListSavedSearchesRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*ListSavedSearchesRequest) when calling interceptor")
+					}
+					return s.SavedSearches.ListSavedSearches(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*ListSavedSearchesResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*ListSavedSearchesResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
 
-func (s *wordSearcherServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
-	ctx = ctxsetters.WithPackageName(ctx, "wordsearcher")
-	ctx = ctxsetters.WithServiceName(ctx, "WordSearcher")
-	ctx = ctxsetters.WithResponseWriter(ctx, resp)
+	// Call service method
+	var respContent *ListSavedSearchesResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
 
-	var err error
-	ctx, err = callRequestReceived(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
 		return
 	}
-
-	if req.Method != "POST" {
-		msg := fmt.Sprintf("unsupported method %q (only POST is allowed)", req.Method)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListSavedSearchesResponse and nil error while calling ListSavedSearches. nil responses are not supported"))
 		return
 	}
 
-	// Verify path format: [<prefix>]/<package>.<Service>/<Method>
-	prefix, pkgService, method := parseTwirpPath(req.URL.Path)
-	if pkgService != "wordsearcher.WordSearcher" {
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
-	}
-	if prefix != s.pathPrefix {
-		msg := fmt.Sprintf("invalid path prefix %q, expected %q, on path %q", prefix, s.pathPrefix, req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
 		return
 	}
 
-	switch method {
-	case "GetWordInformation":
-		s.serveGetWordInformation(ctx, resp, req)
-		return
-	case "WordSearch":
-		s.serveWordSearch(ctx, resp, req)
-		return
-	default:
-		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
-		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
-		return
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
 	}
+	callResponseSent(ctx, s.hooks)
 }
 
-func (s *wordSearcherServer) serveGetWordInformation(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *savedSearchesServer) serveRunSavedSearch(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -2273,9 +10445,9 @@ func (s *wordSearcherServer) serveGetWordInformation(ctx context.Context, resp h
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveGetWordInformationJSON(ctx, resp, req)
+		s.serveRunSavedSearchJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveGetWordInformationProtobuf(ctx, resp, req)
+		s.serveRunSavedSearchProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -2283,9 +10455,9 @@ func (s *wordSearcherServer) serveGetWordInformation(ctx context.Context, resp h
 	}
 }
 
-func (s *wordSearcherServer) serveGetWordInformationJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *savedSearchesServer) serveRunSavedSearchJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetWordInformation")
+	ctx = ctxsetters.WithMethodName(ctx, "RunSavedSearch")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2298,29 +10470,29 @@ func (s *wordSearcherServer) serveGetWordInformationJSON(ctx context.Context, re
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(DefineRequest)
+	reqContent := new(RunSavedSearchRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.WordSearcher.GetWordInformation
+	handler := s.SavedSearches.RunSavedSearch
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *DefineRequest) (*WordSearchResponse, error) {
+		handler = func(ctx context.Context, req *RunSavedSearchRequest) (*SearchResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*DefineRequest)
+					typedReq, ok := req.(*RunSavedSearchRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*DefineRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RunSavedSearchRequest) when calling interceptor")
 					}
-					return s.WordSearcher.GetWordInformation(ctx, typedReq)
+					return s.SavedSearches.RunSavedSearch(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*WordSearchResponse)
+				typedResp, ok := resp.(*SearchResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*WordSearchResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2329,7 +10501,7 @@ func (s *wordSearcherServer) serveGetWordInformationJSON(ctx context.Context, re
 	}
 
 	// Call service method
-	var respContent *WordSearchResponse
+	var respContent *SearchResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2340,7 +10512,7 @@ func (s *wordSearcherServer) serveGetWordInformationJSON(ctx context.Context, re
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *WordSearchResponse and nil error while calling GetWordInformation. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SearchResponse and nil error while calling RunSavedSearch. nil responses are not supported"))
 		return
 	}
 
@@ -2366,9 +10538,9 @@ func (s *wordSearcherServer) serveGetWordInformationJSON(ctx context.Context, re
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *wordSearcherServer) serveGetWordInformationProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *savedSearchesServer) serveRunSavedSearchProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "GetWordInformation")
+	ctx = ctxsetters.WithMethodName(ctx, "RunSavedSearch")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2380,28 +10552,28 @@ func (s *wordSearcherServer) serveGetWordInformationProtobuf(ctx context.Context
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(DefineRequest)
+	reqContent := new(RunSavedSearchRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.WordSearcher.GetWordInformation
+	handler := s.SavedSearches.RunSavedSearch
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *DefineRequest) (*WordSearchResponse, error) {
+		handler = func(ctx context.Context, req *RunSavedSearchRequest) (*SearchResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*DefineRequest)
+					typedReq, ok := req.(*RunSavedSearchRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*DefineRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*RunSavedSearchRequest) when calling interceptor")
 					}
-					return s.WordSearcher.GetWordInformation(ctx, typedReq)
+					return s.SavedSearches.RunSavedSearch(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*WordSearchResponse)
+				typedResp, ok := resp.(*SearchResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*WordSearchResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*SearchResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2410,7 +10582,7 @@ func (s *wordSearcherServer) serveGetWordInformationProtobuf(ctx context.Context
 	}
 
 	// Call service method
-	var respContent *WordSearchResponse
+	var respContent *SearchResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2421,7 +10593,7 @@ func (s *wordSearcherServer) serveGetWordInformationProtobuf(ctx context.Context
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *WordSearchResponse and nil error while calling GetWordInformation. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *SearchResponse and nil error while calling RunSavedSearch. nil responses are not supported"))
 		return
 	}
 
@@ -2445,7 +10617,7 @@ func (s *wordSearcherServer) serveGetWordInformationProtobuf(ctx context.Context
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *wordSearcherServer) serveWordSearch(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *savedSearchesServer) serveDeleteSavedSearch(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	header := req.Header.Get("Content-Type")
 	i := strings.Index(header, ";")
 	if i == -1 {
@@ -2453,9 +10625,9 @@ func (s *wordSearcherServer) serveWordSearch(ctx context.Context, resp http.Resp
 	}
 	switch strings.TrimSpace(strings.ToLower(header[:i])) {
 	case "application/json":
-		s.serveWordSearchJSON(ctx, resp, req)
+		s.serveDeleteSavedSearchJSON(ctx, resp, req)
 	case "application/protobuf":
-		s.serveWordSearchProtobuf(ctx, resp, req)
+		s.serveDeleteSavedSearchProtobuf(ctx, resp, req)
 	default:
 		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
 		twerr := badRouteError(msg, req.Method, req.URL.Path)
@@ -2463,9 +10635,9 @@ func (s *wordSearcherServer) serveWordSearch(ctx context.Context, resp http.Resp
 	}
 }
 
-func (s *wordSearcherServer) serveWordSearchJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *savedSearchesServer) serveDeleteSavedSearchJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "WordSearch")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteSavedSearch")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2478,29 +10650,29 @@ func (s *wordSearcherServer) serveWordSearchJSON(ctx context.Context, resp http.
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
-	reqContent := new(WordSearchRequest)
+	reqContent := new(DeleteSavedSearchRequest)
 	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
 	if err = unmarshaler.Unmarshal(rawReqBody, reqContent); err != nil {
 		s.handleRequestBodyError(ctx, resp, "the json request could not be decoded", err)
 		return
 	}
 
-	handler := s.WordSearcher.WordSearch
+	handler := s.SavedSearches.DeleteSavedSearch
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *WordSearchRequest) (*WordSearchResponse, error) {
+		handler = func(ctx context.Context, req *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*WordSearchRequest)
+					typedReq, ok := req.(*DeleteSavedSearchRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*WordSearchRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteSavedSearchRequest) when calling interceptor")
 					}
-					return s.WordSearcher.WordSearch(ctx, typedReq)
+					return s.SavedSearches.DeleteSavedSearch(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*WordSearchResponse)
+				typedResp, ok := resp.(*DeleteSavedSearchResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*WordSearchResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteSavedSearchResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2509,7 +10681,7 @@ func (s *wordSearcherServer) serveWordSearchJSON(ctx context.Context, resp http.
 	}
 
 	// Call service method
-	var respContent *WordSearchResponse
+	var respContent *DeleteSavedSearchResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2520,7 +10692,7 @@ func (s *wordSearcherServer) serveWordSearchJSON(ctx context.Context, resp http.
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *WordSearchResponse and nil error while calling WordSearch. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteSavedSearchResponse and nil error while calling DeleteSavedSearch. nil responses are not supported"))
 		return
 	}
 
@@ -2546,9 +10718,9 @@ func (s *wordSearcherServer) serveWordSearchJSON(ctx context.Context, resp http.
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *wordSearcherServer) serveWordSearchProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+func (s *savedSearchesServer) serveDeleteSavedSearchProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
 	var err error
-	ctx = ctxsetters.WithMethodName(ctx, "WordSearch")
+	ctx = ctxsetters.WithMethodName(ctx, "DeleteSavedSearch")
 	ctx, err = callRequestRouted(ctx, s.hooks)
 	if err != nil {
 		s.writeError(ctx, resp, err)
@@ -2560,28 +10732,28 @@ func (s *wordSearcherServer) serveWordSearchProtobuf(ctx context.Context, resp h
 		s.handleRequestBodyError(ctx, resp, "failed to read request body", err)
 		return
 	}
-	reqContent := new(WordSearchRequest)
+	reqContent := new(DeleteSavedSearchRequest)
 	if err = proto.Unmarshal(buf, reqContent); err != nil {
 		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
 		return
 	}
 
-	handler := s.WordSearcher.WordSearch
+	handler := s.SavedSearches.DeleteSavedSearch
 	if s.interceptor != nil {
-		handler = func(ctx context.Context, req *WordSearchRequest) (*WordSearchResponse, error) {
+		handler = func(ctx context.Context, req *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error) {
 			resp, err := s.interceptor(
 				func(ctx context.Context, req interface{}) (interface{}, error) {
-					typedReq, ok := req.(*WordSearchRequest)
+					typedReq, ok := req.(*DeleteSavedSearchRequest)
 					if !ok {
-						return nil, twirp.InternalError("failed type assertion req.(*WordSearchRequest) when calling interceptor")
+						return nil, twirp.InternalError("failed type assertion req.(*DeleteSavedSearchRequest) when calling interceptor")
 					}
-					return s.WordSearcher.WordSearch(ctx, typedReq)
+					return s.SavedSearches.DeleteSavedSearch(ctx, typedReq)
 				},
 			)(ctx, req)
 			if resp != nil {
-				typedResp, ok := resp.(*WordSearchResponse)
+				typedResp, ok := resp.(*DeleteSavedSearchResponse)
 				if !ok {
-					return nil, twirp.InternalError("failed type assertion resp.(*WordSearchResponse) when calling interceptor")
+					return nil, twirp.InternalError("failed type assertion resp.(*DeleteSavedSearchResponse) when calling interceptor")
 				}
 				return typedResp, err
 			}
@@ -2590,7 +10762,7 @@ func (s *wordSearcherServer) serveWordSearchProtobuf(ctx context.Context, resp h
 	}
 
 	// Call service method
-	var respContent *WordSearchResponse
+	var respContent *DeleteSavedSearchResponse
 	func() {
 		defer ensurePanicResponses(ctx, resp, s.hooks)
 		respContent, err = handler(ctx, reqContent)
@@ -2601,7 +10773,7 @@ func (s *wordSearcherServer) serveWordSearchProtobuf(ctx context.Context, resp h
 		return
 	}
 	if respContent == nil {
-		s.writeError(ctx, resp, twirp.InternalError("received a nil *WordSearchResponse and nil error while calling WordSearch. nil responses are not supported"))
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *DeleteSavedSearchResponse and nil error while calling DeleteSavedSearch. nil responses are not supported"))
 		return
 	}
 
@@ -2625,19 +10797,19 @@ func (s *wordSearcherServer) serveWordSearchProtobuf(ctx context.Context, resp h
 	callResponseSent(ctx, s.hooks)
 }
 
-func (s *wordSearcherServer) ServiceDescriptor() ([]byte, int) {
-	return twirpFileDescriptor0, 2
+func (s *savedSearchesServer) ServiceDescriptor() ([]byte, int) {
+	return twirpFileDescriptor0, 6
 }
 
-func (s *wordSearcherServer) ProtocGenTwirpVersion() string {
+func (s *savedSearchesServer) ProtocGenTwirpVersion() string {
 	return "v8.1.2"
 }
 
 // PathPrefix returns the base service path, in the form: "/<prefix>/<package>.<Service>/"
 // that is everything in a Twirp route except for the <Method>. This can be used for routing,
 // for example to identify the requests that are targeted to this service in a mux.
-func (s *wordSearcherServer) PathPrefix() string {
-	return baseServicePath(s.pathPrefix, "wordsearcher", "WordSearcher")
+func (s *savedSearchesServer) PathPrefix() string {
+	return baseServicePath(s.pathPrefix, "wordsearcher", "SavedSearches")
 }
 
 // =====
@@ -3203,96 +11375,354 @@ func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error)
 }
 
 var twirpFileDescriptor0 = []byte{
-	// 1444 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xc9, 0x72, 0xdb, 0xc8,
-	0x19, 0x16, 0xc4, 0x45, 0xc4, 0xcf, 0x45, 0x50, 0x7b, 0x63, 0x49, 0x76, 0xac, 0xc0, 0xe5, 0x58,
-	0xae, 0x4a, 0x49, 0x15, 0x3a, 0x4e, 0x2e, 0x4e, 0xaa, 0x40, 0x0a, 0x22, 0x51, 0x06, 0x41, 0x05,
-	0x20, 0x25, 0x39, 0x17, 0x18, 0x24, 0x5b, 0x22, 0xca, 0x04, 0x40, 0x03, 0xa0, 0x43, 0x3d, 0x41,
-	0x1e, 0x60, 0x2e, 0x73, 0x99, 0xb7, 0x98, 0xe3, 0xbc, 0xc2, 0x5c, 0xe7, 0x32, 0xcf, 0x31, 0x35,
-	0xd7, 0xa9, 0x5e, 0x40, 0x02, 0xb2, 0xb6, 0x99, 0x5b, 0xf7, 0xd7, 0xdf, 0xff, 0xfd, 0x5b, 0xa3,
-	0xbb, 0x01, 0x3b, 0xff, 0x0b, 0xc2, 0x71, 0x84, 0x9d, 0x70, 0x34, 0xc1, 0xe1, 0x41, 0x32, 0xd8,
-	0x9f, 0x85, 0x41, 0x1c, 0xa0, 0x4a, 0x7a, 0x51, 0xfe, 0x45, 0x00, 0x51, 0x99, 0xce, 0x26, 0xce,
-	0x45, 0xe8, 0x78, 0xe8, 0x29, 0x88, 0x4e, 0x32, 0xa9, 0x0b, 0xbb, 0xc2, 0x9e, 0x68, 0xae, 0x00,
-	0xb4, 0x07, 0x05, 0x6a, 0x5b, 0x5f, 0xdf, 0xcd, 0xed, 0x95, 0x1b, 0x68, 0x3f, 0xad, 0xb4, 0x7f,
-	0x1a, 0x84, 0x63, 0x93, 0x11, 0x90, 0x0c, 0x15, 0xbc, 0x98, 0x39, 0xfe, 0x18, 0x8f, 0x4d, 0x3c,
-	0x0b, 0xeb, 0xb9, 0x5d, 0x61, 0xaf, 0x64, 0x66, 0x30, 0xf4, 0x18, 0x8a, 0x53, 0xec, 0x5f, 0xc4,
-	0x93, 0x7a, 0x7e, 0x57, 0xd8, 0x2b, 0x98, 0x7c, 0x86, 0x76, 0xa1, 0x3c, 0x0b, 0x83, 0xa1, 0x33,
-	0x74, 0xa7, 0x6e, 0x7c, 0x59, 0x2f, 0xd0, 0xc5, 0x34, 0x44, 0xd4, 0x47, 0x81, 0x37, 0x74, 0x7d,
-	0x27, 0x76, 0x03, 0x3f, 0xaa, 0x17, 0x77, 0x85, 0xbd, 0x9c, 0x99, 0xc1, 0xd0, 0x9f, 0x00, 0xc6,
-	0xee, 0xf9, 0xb9, 0x3b, 0x9a, 0x4f, 0xe3, 0xcb, 0xfa, 0x06, 0x15, 0x49, 0x21, 0xf2, 0x37, 0xeb,
-	0x90, 0x27, 0x11, 0x23, 0x04, 0x79, 0x12, 0x33, 0xcf, 0x96, 0x8e, 0xb3, 0x65, 0x58, 0xbf, 0x5a,
-	0x06, 0x22, 0x8d, 0xcf, 0x5d, 0xdf, 0x25, 0x9e, 0x68, 0x6a, 0xa2, 0x99, 0x42, 0xd0, 0x73, 0x28,
-	0x9f, 0x87, 0x81, 0x1f, 0xdb, 0x93, 0x20, 0xf8, 0x14, 0xd1, 0xec, 0x44, 0x13, 0x28, 0xd4, 0x21,
-	0x08, 0x7a, 0x06, 0x30, 0x74, 0x46, 0x9f, 0xf8, 0x7a, 0x81, 0xe9, 0x13, 0x84, 0x2d, 0xbf, 0x82,
-	0xcd, 0x29, 0x5e, 0xb8, 0xa3, 0xc0, 0xb7, 0xa3, 0x4b, 0x6f, 0x18, 0x4c, 0x59, 0x86, 0xa2, 0x59,
-	0xe3, 0xb0, 0xc5, 0x50, 0xb4, 0x07, 0x92, 0xeb, 0xfb, 0x38, 0xb4, 0x57, 0xee, 0x68, 0xa6, 0x25,
-	0xb3, 0x46, 0xf1, 0xa3, 0xc4, 0x25, 0xfa, 0x0b, 0x6c, 0x32, 0xe6, 0xd2, 0x6f, 0xbd, 0x44, 0x89,
-	0x55, 0x0a, 0x37, 0xb9, 0x6f, 0xf9, 0x67, 0x11, 0xaa, 0x16, 0x6d, 0xa8, 0x89, 0x3f, 0xcf, 0x71,
-	0x14, 0xa3, 0xf7, 0x50, 0x61, 0x1d, 0x9e, 0x39, 0xa1, 0xe3, 0x45, 0x75, 0x81, 0xb6, 0xfe, 0x55,
-	0xb6, 0xf5, 0x19, 0x13, 0x3e, 0x3b, 0x26, 0x7c, 0x33, 0x63, 0x4c, 0x5a, 0xce, 0xb6, 0x00, 0x2d,
-	0x6a, 0xc9, 0xe4, 0xb3, 0xed, 0xbf, 0x42, 0xb1, 0xeb, 0xfa, 0x5d, 0x67, 0x81, 0x24, 0xc8, 0x79,
-	0xae, 0x4f, 0x9b, 0x51, 0x30, 0xc9, 0x90, 0x22, 0xce, 0x82, 0x1a, 0x10, 0xc4, 0x59, 0x6c, 0xbf,
-	0x80, 0xb2, 0x15, 0x87, 0xae, 0x7f, 0x71, 0xe2, 0x4c, 0xe7, 0x18, 0x3d, 0x84, 0xc2, 0x17, 0x32,
-	0xe0, 0x1d, 0x64, 0x93, 0xed, 0x97, 0x09, 0x49, 0x09, 0x43, 0xe7, 0x92, 0x78, 0xa6, 0x38, 0x4b,
-	0x40, 0x34, 0xf9, 0x8c, 0xd0, 0x8c, 0xb9, 0x37, 0xc4, 0xe1, 0x75, 0xb4, 0xc2, 0x92, 0xf6, 0x22,
-	0xa1, 0x5d, 0xe3, 0xb2, 0x90, 0xb8, 0xfc, 0x29, 0x07, 0xe5, 0x54, 0xee, 0xa8, 0x05, 0xe2, 0x28,
-	0xf0, 0xc7, 0x6c, 0x9b, 0x10, 0x66, 0xad, 0xf1, 0xf2, 0xb6, 0xba, 0xb5, 0x12, 0xb2, 0xb9, 0xb2,
-	0x43, 0xef, 0xa0, 0xe8, 0xb9, 0x7e, 0x52, 0x81, 0x72, 0x43, 0xbe, 0x4d, 0x81, 0x15, 0xb1, 0xb3,
-	0x66, 0x72, 0x1b, 0xf4, 0x1e, 0xca, 0x11, 0xad, 0x02, 0x0b, 0x37, 0x47, 0x25, 0x6e, 0x6f, 0xde,
-	0xaa, 0xb2, 0x9d, 0x35, 0x33, 0x6d, 0xbd, 0x12, 0x73, 0x48, 0xad, 0xe8, 0xbe, 0xbe, 0x97, 0x18,
-	0x2d, 0xed, 0x4a, 0x8c, 0x5a, 0x13, 0x31, 0x9f, 0x56, 0x94, 0x89, 0x15, 0xee, 0x16, 0x4b, 0xf5,
-	0x89, 0x88, 0xa5, 0xac, 0x57, 0x62, 0x2c, 0xcd, 0xe2, 0x7d, 0xc5, 0x96, 0x69, 0xa6, 0xac, 0x9b,
-	0x12, 0xd4, 0x96, 0xe5, 0xa7, 0xfb, 0x56, 0xfe, 0x7f, 0x0e, 0xc4, 0x65, 0x73, 0x50, 0x19, 0x36,
-	0x74, 0xf5, 0x4c, 0x6b, 0xf5, 0x0c, 0x69, 0x0d, 0x01, 0x14, 0x75, 0xd5, 0x68, 0xf7, 0x3b, 0x92,
-	0x80, 0x1e, 0xc1, 0xd6, 0xb1, 0xd9, 0x6b, 0x2a, 0x4d, 0x4d, 0xd7, 0xfa, 0x1f, 0x6c, 0x53, 0x31,
-	0xda, 0xaa, 0xb4, 0x8e, 0x1e, 0x82, 0x94, 0x86, 0x75, 0xcd, 0xea, 0x4b, 0xb9, 0xab, 0x64, 0x5d,
-	0xeb, 0x6a, 0x7d, 0x29, 0x8f, 0x1e, 0x03, 0x32, 0x06, 0xdd, 0xa6, 0x6a, 0xda, 0xbd, 0x23, 0x5b,
-	0x31, 0x94, 0xb6, 0xa9, 0x74, 0x2d, 0xa9, 0x40, 0x44, 0x56, 0xf8, 0x49, 0xef, 0x54, 0xd5, 0x2d,
-	0xa9, 0x88, 0x2a, 0x50, 0xea, 0x28, 0x96, 0xdd, 0x57, 0xda, 0x96, 0xb4, 0x81, 0x36, 0xa1, 0x7c,
-	0xdc, 0xd3, 0x8c, 0xbe, 0x7d, 0xa2, 0xe8, 0x03, 0x55, 0x2a, 0x11, 0xa3, 0xae, 0xd2, 0x6f, 0x75,
-	0x34, 0xa3, 0x9d, 0x68, 0x49, 0x22, 0x42, 0x50, 0x53, 0xf4, 0xe3, 0x0e, 0x9d, 0xb2, 0x68, 0x80,
-	0x60, 0x46, 0xaf, 0x6f, 0x6b, 0x86, 0x9d, 0xa4, 0x56, 0x46, 0x55, 0x10, 0x4f, 0x7b, 0xe6, 0x21,
-	0xa3, 0x54, 0xd1, 0x13, 0x78, 0x60, 0x69, 0x46, 0x5b, 0x57, 0x99, 0xbc, 0xcd, 0xd3, 0xae, 0x51,
-	0xdb, 0x41, 0xd7, 0xee, 0x9f, 0xf6, 0xec, 0xa6, 0xae, 0x18, 0xef, 0x2d, 0x69, 0x13, 0x6d, 0x41,
-	0xb5, 0xab, 0x9c, 0xd9, 0x56, 0x4f, 0x1f, 0xf4, 0xb5, 0x9e, 0x61, 0x49, 0x12, 0x09, 0xe6, 0x50,
-	0x3b, 0x3a, 0xd2, 0x5a, 0x03, 0x7d, 0x59, 0x9c, 0x2d, 0x5a, 0x06, 0x5d, 0xf9, 0x90, 0xad, 0x19,
-	0x42, 0x12, 0x54, 0x0e, 0x55, 0x5d, 0xed, 0xab, 0x87, 0x36, 0x89, 0x41, 0x7a, 0x20, 0xe7, 0x4b,
-	0x15, 0xa9, 0x22, 0xbf, 0x83, 0x2d, 0x23, 0x88, 0x35, 0x5f, 0xc7, 0x8b, 0x55, 0x43, 0xb6, 0xa0,
-	0xda, 0xeb, 0x77, 0x54, 0xd3, 0x56, 0x8d, 0xb6, 0xae, 0x59, 0x1d, 0x69, 0x8d, 0xd5, 0x5c, 0x3d,
-	0xd1, 0x7a, 0x03, 0xcb, 0x3e, 0x51, 0x4d, 0x4b, 0xeb, 0x19, 0x92, 0x20, 0x8f, 0xa0, 0x96, 0xec,
-	0x82, 0x68, 0x16, 0xf8, 0x11, 0x46, 0xff, 0x04, 0x58, 0x9e, 0xeb, 0xc9, 0xd9, 0xf6, 0x24, 0xbb,
-	0x6f, 0x96, 0x97, 0xa3, 0x99, 0xa2, 0xa2, 0x3a, 0x6c, 0xf0, 0xc3, 0x98, 0xdf, 0x0f, 0xc9, 0x54,
-	0xfe, 0x41, 0x80, 0x9a, 0xe2, 0x33, 0x0b, 0x7e, 0x86, 0xa6, 0xc8, 0x42, 0x86, 0xcc, 0x56, 0xe2,
-	0x18, 0x87, 0xd1, 0x4a, 0x86, 0x4e, 0xd1, 0x5b, 0xc8, 0x7b, 0xc1, 0x98, 0x7d, 0xb2, 0xb5, 0xc6,
-	0x9f, 0xaf, 0xc4, 0x94, 0xd1, 0xdf, 0xef, 0x06, 0x63, 0x6c, 0x52, 0x7a, 0xea, 0x84, 0xcd, 0xa7,
-	0x4f, 0x58, 0xf9, 0x15, 0xe4, 0x09, 0x0b, 0x89, 0x50, 0x50, 0xcf, 0x94, 0x56, 0x5f, 0x5a, 0x23,
-	0xc3, 0xe6, 0x40, 0xd3, 0x0f, 0x25, 0x81, 0x0c, 0xad, 0xc1, 0xb1, 0x6a, 0x4a, 0xeb, 0xf2, 0x19,
-	0x6c, 0x2e, 0xd5, 0x79, 0x91, 0x96, 0xd7, 0xbe, 0x70, 0xd7, 0xb5, 0xbf, 0x03, 0xa2, 0x3f, 0xf7,
-	0xec, 0xe4, 0x91, 0x40, 0xce, 0xc6, 0x92, 0x3f, 0xf7, 0x08, 0x25, 0x92, 0x7f, 0x14, 0x60, 0xa7,
-	0x39, 0x75, 0xfc, 0x4f, 0xad, 0x89, 0x33, 0x25, 0x77, 0x3d, 0x6e, 0x85, 0xd8, 0x89, 0xf1, 0xdd,
-	0x55, 0x7a, 0x01, 0x55, 0x22, 0x4b, 0x69, 0xf4, 0xc2, 0x67, 0xd2, 0x15, 0x7f, 0xee, 0xfd, 0x27,
-	0xc1, 0x08, 0xc9, 0x73, 0x16, 0x76, 0x14, 0x4c, 0xe7, 0x8c, 0x94, 0x63, 0x24, 0xcf, 0x59, 0x58,
-	0x09, 0x86, 0x5e, 0xc3, 0x16, 0x0d, 0xd0, 0x8d, 0x27, 0x76, 0xc3, 0x1e, 0x92, 0x68, 0x22, 0xfe,
-	0xfc, 0xa8, 0x91, 0x40, 0xdd, 0x78, 0xd2, 0xa0, 0x31, 0x46, 0xe4, 0x16, 0x27, 0x79, 0xd8, 0xfc,
-	0x8d, 0xc2, 0x9e, 0x21, 0x40, 0x20, 0x9d, 0x22, 0xf2, 0xaf, 0x24, 0x9f, 0xb9, 0x3b, 0x1d, 0xff,
-	0x91, 0x7c, 0x3c, 0xd7, 0x4f, 0x85, 0xca, 0xf3, 0xf1, 0x5c, 0x7f, 0x15, 0xea, 0xbd, 0xf2, 0x79,
-	0x06, 0x40, 0x94, 0x32, 0xef, 0x28, 0xd1, 0x73, 0x7d, 0x16, 0x22, 0x5d, 0x76, 0x16, 0xd9, 0x14,
-	0x44, 0xcf, 0x59, 0xf0, 0xe5, 0x7f, 0xc0, 0x93, 0x10, 0x7f, 0x9e, 0xbb, 0x21, 0xe6, 0x94, 0xa5,
-	0x37, 0x7a, 0x84, 0x96, 0xcc, 0x47, 0x7c, 0x99, 0xf1, 0x13, 0xb7, 0xf2, 0x47, 0xd8, 0x22, 0x2d,
-	0xcd, 0x3e, 0x14, 0x6e, 0x4e, 0x17, 0x41, 0xfe, 0x62, 0x1a, 0x0c, 0xf9, 0x0e, 0xa7, 0x63, 0x12,
-	0x99, 0x33, 0x9b, 0x4d, 0x5d, 0x1c, 0xd9, 0x71, 0xc0, 0xdf, 0x50, 0x22, 0x47, 0xfa, 0x81, 0xfc,
-	0x2f, 0xa8, 0x1e, 0x92, 0x07, 0x15, 0xbe, 0x97, 0x3a, 0x7d, 0xbf, 0xad, 0xaf, 0xde, 0x6f, 0xf2,
-	0xbf, 0x01, 0xa5, 0x03, 0xfc, 0xbd, 0xfb, 0xb8, 0xf1, 0x9d, 0x00, 0x52, 0xb2, 0xb3, 0x2c, 0x4e,
-	0x40, 0x2d, 0x28, 0xb2, 0x31, 0xda, 0xb9, 0xe5, 0x66, 0xd9, 0x7e, 0x7a, 0xfd, 0x22, 0x8f, 0xe1,
-	0x10, 0x8a, 0x2a, 0xfd, 0x22, 0xd1, 0xad, 0xbc, 0xdb, 0x55, 0x1a, 0xdf, 0xae, 0x03, 0xf0, 0xaf,
-	0xd4, 0xc3, 0x21, 0x3a, 0x82, 0x0d, 0x3e, 0xbb, 0xaa, 0x9a, 0x3d, 0x28, 0xb6, 0x9f, 0xdd, 0xb0,
-	0xca, 0x83, 0xfb, 0x08, 0x8f, 0xae, 0xf9, 0x40, 0x83, 0x10, 0xbd, 0xce, 0xda, 0xdd, 0xf2, 0x15,
-	0xdf, 0x91, 0x3e, 0xf1, 0xf0, 0xf5, 0x27, 0x73, 0x8d, 0x87, 0x9b, 0xbf, 0xab, 0x3b, 0x4a, 0xf3,
-	0xbd, 0x00, 0x95, 0x55, 0xef, 0x71, 0x88, 0x2c, 0x40, 0x6d, 0x1c, 0x13, 0x48, 0xf3, 0xcf, 0x83,
-	0xd0, 0xa3, 0xff, 0x07, 0x57, 0x5b, 0x98, 0xd9, 0x6c, 0xdb, 0xbb, 0x5f, 0xef, 0x8c, 0x2b, 0x79,
-	0xf4, 0x00, 0x56, 0x28, 0x7a, 0x7e, 0x33, 0xff, 0x9e, 0x82, 0xcd, 0xb7, 0xff, 0x7d, 0x73, 0xe1,
-	0xc6, 0x93, 0xf9, 0x70, 0x7f, 0x14, 0x78, 0x07, 0xe3, 0xc0, 0x73, 0xfd, 0xe0, 0x6f, 0x7f, 0x3f,
-	0xa0, 0x27, 0xd0, 0x78, 0x68, 0x47, 0x38, 0xfc, 0x82, 0xc3, 0x83, 0x70, 0x36, 0x3a, 0x48, 0x2b,
-	0x0d, 0x8b, 0xf4, 0x97, 0xee, 0xcd, 0x6f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8a, 0xec, 0x15, 0x66,
-	0xf1, 0x0d, 0x00, 0x00,
+	// 5576 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3c, 0x5d, 0x6f, 0x1b, 0x49,
+	0x72, 0xe2, 0x97, 0x44, 0x16, 0x49, 0x69, 0xd4, 0xb6, 0x6c, 0x79, 0xfc, 0xa5, 0x1d, 0xaf, 0xbd,
+	0xde, 0xbd, 0x5b, 0x39, 0xa7, 0x5d, 0x3b, 0x7b, 0xc9, 0xde, 0xe5, 0x28, 0x89, 0xb2, 0xb8, 0x4b,
+	0x91, 0xba, 0x21, 0xe5, 0xf5, 0xe6, 0xe3, 0xe6, 0x86, 0x64, 0x4b, 0x9a, 0x98, 0x9c, 0xe1, 0xce,
+	0x0c, 0x6d, 0x79, 0x9f, 0x92, 0x87, 0xe4, 0x21, 0x08, 0x92, 0xa7, 0xe0, 0xf2, 0x72, 0x40, 0x3e,
+	0x10, 0x20, 0x17, 0xe0, 0x90, 0xd7, 0x00, 0xb9, 0x97, 0x20, 0x40, 0x0e, 0x09, 0x90, 0x20, 0x40,
+	0x80, 0x3c, 0xe4, 0x35, 0xc9, 0x6f, 0x48, 0x1e, 0x83, 0xea, 0x8f, 0xf9, 0xe2, 0x90, 0x94, 0xf7,
+	0x2e, 0x6f, 0xd3, 0xd5, 0xd5, 0xd5, 0xd5, 0xd5, 0xd5, 0xd5, 0xd5, 0x55, 0x45, 0xc2, 0xcd, 0x57,
+	0x8e, 0x3b, 0xf0, 0xa8, 0xe9, 0xf6, 0xcf, 0xa9, 0xfb, 0x48, 0x7e, 0x6c, 0x8f, 0x5d, 0xc7, 0x77,
+	0x48, 0x25, 0xda, 0xa9, 0xfd, 0x45, 0x0e, 0x4a, 0xb5, 0xe1, 0xf8, 0xdc, 0x3c, 0x73, 0xcd, 0x11,
+	0xb9, 0x05, 0x25, 0x53, 0x36, 0x36, 0x33, 0x5b, 0x99, 0x87, 0x25, 0x3d, 0x04, 0x90, 0x87, 0x50,
+	0x60, 0x63, 0x37, 0xb3, 0x5b, 0xb9, 0x87, 0xe5, 0x1d, 0xb2, 0x1d, 0xa5, 0xb4, 0xfd, 0x99, 0xe3,
+	0x0e, 0x74, 0x8e, 0x40, 0x34, 0xa8, 0xd0, 0x8b, 0xb1, 0x69, 0x0f, 0xe8, 0x40, 0xa7, 0x63, 0x77,
+	0x33, 0xb7, 0x95, 0x79, 0x58, 0xd4, 0x63, 0x30, 0x72, 0x0d, 0x96, 0x87, 0xd4, 0x3e, 0xf3, 0xcf,
+	0x37, 0xf3, 0x5b, 0x99, 0x87, 0x05, 0x5d, 0xb4, 0xc8, 0x16, 0x94, 0xc7, 0xae, 0xd3, 0x33, 0x7b,
+	0xd6, 0xd0, 0xf2, 0x5f, 0x6f, 0x16, 0x58, 0x67, 0x14, 0x84, 0xd4, 0xfb, 0xce, 0xa8, 0x67, 0xd9,
+	0xa6, 0x6f, 0x39, 0xb6, 0xb7, 0xb9, 0xbc, 0x95, 0x79, 0x98, 0xd3, 0x63, 0x30, 0x72, 0x07, 0x60,
+	0x60, 0x9d, 0x9e, 0x5a, 0xfd, 0xc9, 0xd0, 0x7f, 0xbd, 0xb9, 0xc2, 0x88, 0x44, 0x20, 0xe4, 0x6b,
+	0xb0, 0x3e, 0xb0, 0xbc, 0xf1, 0xd0, 0x7c, 0x6d, 0x84, 0x2b, 0x2e, 0xb2, 0x15, 0x2b, 0xa2, 0x23,
+	0x14, 0x0b, 0xb2, 0x34, 0x34, 0x5f, 0x4b, 0x96, 0x4a, 0x82, 0xa5, 0x10, 0x84, 0xe4, 0x5e, 0x3a,
+	0xaf, 0xe8, 0xd0, 0x88, 0xb2, 0x0e, 0x0c, 0x4f, 0x61, 0x1d, 0xc7, 0x11, 0xfe, 0x37, 0x61, 0x65,
+	0x40, 0x87, 0xd4, 0xa7, 0x83, 0xcd, 0x32, 0x13, 0x8c, 0x6c, 0x62, 0xcf, 0x90, 0x5e, 0x58, 0x7d,
+	0xc7, 0xde, 0xac, 0x30, 0x5e, 0x64, 0x53, 0xfb, 0x69, 0x16, 0xf2, 0x28, 0x61, 0x42, 0x20, 0x8f,
+	0x32, 0x16, 0xbb, 0xc3, 0xbe, 0xe3, 0xdb, 0x96, 0x4d, 0x6e, 0x1b, 0x8a, 0x82, 0x9e, 0x5a, 0xb6,
+	0x85, 0x92, 0x61, 0x5b, 0x51, 0xd2, 0x23, 0x10, 0x72, 0x17, 0xca, 0xa7, 0xae, 0x63, 0xfb, 0xc6,
+	0xb9, 0xe3, 0xbc, 0xf0, 0xd8, 0x6e, 0x94, 0x74, 0x60, 0xa0, 0x43, 0x84, 0x90, 0xdb, 0x00, 0x3d,
+	0xb3, 0xff, 0x42, 0xf4, 0x17, 0x38, 0x7d, 0x84, 0xf0, 0xee, 0x77, 0x60, 0x4d, 0x70, 0x69, 0x78,
+	0xaf, 0x47, 0x3d, 0x67, 0xc8, 0x77, 0xa4, 0xa4, 0xaf, 0x0a, 0x70, 0x87, 0x43, 0xc9, 0x43, 0x50,
+	0x2c, 0xdb, 0xa6, 0xae, 0x11, 0x4e, 0xc7, 0x76, 0xa6, 0xa8, 0xaf, 0x32, 0xf8, 0x81, 0x9c, 0x92,
+	0x3c, 0x80, 0x35, 0x8e, 0x19, 0xcc, 0xcb, 0xf6, 0xa6, 0xa8, 0x57, 0x19, 0x78, 0x57, 0xcc, 0x1d,
+	0x95, 0x64, 0x69, 0x4a, 0x92, 0x9e, 0x33, 0x71, 0xfb, 0xd4, 0xdb, 0x84, 0xad, 0x1c, 0x4a, 0x52,
+	0x34, 0xb5, 0xdf, 0xb9, 0x05, 0xd5, 0x0e, 0x53, 0x5a, 0x9d, 0x7e, 0x31, 0xa1, 0x9e, 0x4f, 0x3e,
+	0x85, 0x0a, 0xd7, 0xe2, 0xb1, 0xe9, 0x9a, 0x23, 0x6f, 0x33, 0xc3, 0xd4, 0xfb, 0x9d, 0xb8, 0x7a,
+	0xc7, 0x86, 0x88, 0xd6, 0x31, 0xe2, 0xeb, 0xb1, 0xc1, 0xa8, 0xd6, 0x5c, 0xcd, 0xd9, 0x46, 0x14,
+	0x75, 0xd1, 0x22, 0xfb, 0x00, 0x9e, 0xe3, 0xfa, 0x86, 0xe3, 0x0e, 0x28, 0x3f, 0x10, 0xab, 0x3b,
+	0xf7, 0xe7, 0x4e, 0xe1, 0xb8, 0x7e, 0x1b, 0x91, 0xf5, 0x92, 0x27, 0x3f, 0xc9, 0x5b, 0x50, 0x19,
+	0x5b, 0xb6, 0xe1, 0xd9, 0xe6, 0xd8, 0x3b, 0x77, 0x7c, 0xb6, 0x59, 0x45, 0xbd, 0x3c, 0xb6, 0xec,
+	0x8e, 0x00, 0xe1, 0x76, 0xca, 0x6e, 0xc3, 0x1a, 0x88, 0xed, 0x02, 0x09, 0x6a, 0x0c, 0xc8, 0x4d,
+	0x28, 0x8d, 0xcd, 0x33, 0x6a, 0x78, 0xd6, 0x97, 0x94, 0xed, 0x54, 0x41, 0x2f, 0x22, 0xa0, 0x63,
+	0x7d, 0x49, 0x91, 0xfd, 0xfe, 0xc4, 0xf5, 0x1c, 0x97, 0xed, 0x4c, 0x49, 0x17, 0x2d, 0xd2, 0x84,
+	0xd5, 0xb1, 0xe9, 0xfa, 0x96, 0x39, 0x34, 0xc4, 0xf2, 0x8a, 0x5b, 0xb9, 0x45, 0x4b, 0x40, 0x85,
+	0x3d, 0xb0, 0xe8, 0x70, 0xa0, 0x57, 0xc5, 0xe0, 0x3a, 0x17, 0xc6, 0x31, 0xac, 0x7a, 0x96, 0x7d,
+	0x36, 0xa4, 0x86, 0x69, 0x73, 0xad, 0xc5, 0xed, 0x2b, 0xef, 0xbc, 0x3b, 0x57, 0x20, 0x6c, 0x44,
+	0x8d, 0x0f, 0xd0, 0xab, 0x5e, 0xb4, 0xa9, 0x7e, 0x0b, 0xaa, 0xb1, 0x7e, 0x3c, 0x27, 0x1e, 0xa5,
+	0xfc, 0x9c, 0xe4, 0x74, 0xf6, 0xcd, 0x94, 0xe2, 0x7c, 0x72, 0x7a, 0x3a, 0xa4, 0x62, 0x73, 0x64,
+	0x53, 0xfd, 0x3a, 0x2c, 0x1f, 0x59, 0xf6, 0x91, 0x79, 0x41, 0x14, 0xc8, 0x8d, 0x2c, 0x9b, 0x0d,
+	0x2b, 0xe8, 0xf8, 0xc9, 0x20, 0xe6, 0xc5, 0x66, 0x56, 0x40, 0xcc, 0x0b, 0x75, 0x1b, 0x8a, 0x1c,
+	0xfb, 0xc9, 0x87, 0x51, 0xfc, 0xdc, 0x14, 0x7e, 0x8e, 0xe3, 0xdf, 0x83, 0x72, 0xc7, 0x77, 0x2d,
+	0xfb, 0xec, 0x99, 0x39, 0x9c, 0x50, 0x72, 0x15, 0x0a, 0x2f, 0xf1, 0x43, 0x9c, 0x61, 0xde, 0x50,
+	0xef, 0x4b, 0xa4, 0x9a, 0xeb, 0x9a, 0xaf, 0x71, 0x23, 0x18, 0x9c, 0xab, 0x63, 0x49, 0x17, 0x2d,
+	0x44, 0x6b, 0x4d, 0x46, 0x3d, 0xea, 0xa6, 0xa1, 0x15, 0x02, 0xb4, 0x7b, 0x12, 0x2d, 0x65, 0xca,
+	0x82, 0x9c, 0xf2, 0x08, 0xd6, 0x9b, 0xcc, 0xe8, 0x46, 0xad, 0x53, 0x68, 0x97, 0x33, 0xf3, 0xec,
+	0x72, 0x76, 0xca, 0x2e, 0xab, 0xdf, 0x83, 0x8d, 0x29, 0x72, 0x4d, 0xcb, 0xf3, 0x49, 0x3d, 0xc6,
+	0x64, 0x79, 0xe7, 0xfd, 0x79, 0xdb, 0x3c, 0x45, 0x22, 0x58, 0xd3, 0x47, 0x50, 0xd1, 0x4d, 0x7b,
+	0xe0, 0x8c, 0x3a, 0xe6, 0x68, 0x3c, 0x64, 0x8b, 0xea, 0x3b, 0x13, 0xdb, 0x97, 0x8b, 0x62, 0x8d,
+	0x60, 0xe3, 0xb3, 0xe1, 0xc6, 0xab, 0x7f, 0x92, 0x81, 0x72, 0x93, 0x1b, 0xa3, 0x7d, 0xeb, 0xf4,
+	0x94, 0xdc, 0x83, 0xaa, 0xe3, 0x9f, 0x53, 0xd7, 0x90, 0xd6, 0x96, 0xef, 0x44, 0x85, 0x01, 0x05,
+	0x22, 0xf9, 0x0e, 0xe4, 0x47, 0xce, 0x80, 0xab, 0xca, 0xea, 0xce, 0xd7, 0xe7, 0xf3, 0x1c, 0xd0,
+	0xde, 0x3e, 0x72, 0x06, 0x54, 0x67, 0x23, 0xb5, 0xf7, 0x20, 0x8f, 0x2d, 0xa2, 0x40, 0xa5, 0xd5,
+	0xee, 0x1a, 0x8d, 0x96, 0xd1, 0xee, 0x1e, 0xd6, 0x75, 0x65, 0x09, 0x21, 0x9f, 0xb5, 0xf5, 0xfd,
+	0x8e, 0xb1, 0xdf, 0x38, 0x38, 0xa8, 0xeb, 0x4a, 0x46, 0xfd, 0xcb, 0x0c, 0xc0, 0x9e, 0xb8, 0xc1,
+	0x1c, 0x97, 0x7c, 0x13, 0xb2, 0xce, 0x98, 0xb1, 0xb5, 0x3a, 0xff, 0x54, 0x84, 0x63, 0xb6, 0xdb,
+	0x63, 0x3d, 0xeb, 0x8c, 0xc9, 0xaf, 0xc0, 0xb2, 0x30, 0x64, 0xd9, 0x37, 0x33, 0x64, 0x62, 0x98,
+	0x76, 0x07, 0xb2, 0xed, 0x31, 0x59, 0x81, 0x5c, 0xad, 0xb5, 0xaf, 0x2c, 0x91, 0x65, 0xc8, 0xb6,
+	0x75, 0x25, 0x83, 0x80, 0x56, 0xbb, 0xab, 0x64, 0x55, 0x03, 0x0a, 0xbb, 0x13, 0x6b, 0x28, 0xae,
+	0x2b, 0xdf, 0xa7, 0xae, 0x27, 0x04, 0x28, 0x9b, 0x78, 0x65, 0x8c, 0x2c, 0xdb, 0x10, 0x8a, 0xc4,
+	0x75, 0xa5, 0x34, 0xb2, 0x6c, 0xbe, 0xb9, 0xac, 0xdb, 0xbc, 0x90, 0xdd, 0x39, 0xd1, 0x6d, 0x5e,
+	0xf0, 0x6e, 0xf5, 0xcf, 0x57, 0xa0, 0x1c, 0x61, 0x8c, 0xec, 0x41, 0xa9, 0xef, 0xd8, 0x03, 0x7e,
+	0x81, 0x65, 0x16, 0x9b, 0xce, 0x3d, 0x89, 0xac, 0x87, 0xe3, 0xc8, 0xc7, 0xb0, 0x3c, 0xb2, 0x6c,
+	0x79, 0x32, 0xcb, 0x3b, 0xda, 0x3c, 0x0a, 0xfc, 0x78, 0x1f, 0x2e, 0xe9, 0x62, 0x0c, 0xf9, 0x14,
+	0xca, 0x1e, 0x3b, 0x9d, 0xfc, 0x18, 0xe5, 0xb6, 0x32, 0x0b, 0x25, 0x1b, 0x9e, 0xf8, 0xc3, 0x25,
+	0x3d, 0x3a, 0x3a, 0x24, 0x66, 0xe2, 0x19, 0xde, 0xcc, 0x5f, 0x96, 0x18, 0x3b, 0xf2, 0x21, 0x31,
+	0x36, 0x1a, 0x89, 0xd9, 0xec, 0xa4, 0x73, 0x62, 0x85, 0xc5, 0xc4, 0x22, 0xf6, 0x03, 0x89, 0x45,
+	0x46, 0x87, 0xc4, 0xf8, 0x32, 0x97, 0x2f, 0x4b, 0x2c, 0x58, 0x66, 0x64, 0x34, 0x69, 0x41, 0xc5,
+	0x65, 0xe7, 0xd5, 0x63, 0xe7, 0x95, 0xdd, 0x28, 0xe5, 0x9d, 0x87, 0xf3, 0xa8, 0x45, 0xcf, 0xf7,
+	0xe1, 0x92, 0x1e, 0x1b, 0x8f, 0xcc, 0x89, 0xf3, 0x8a, 0x8e, 0xdc, 0x66, 0x71, 0x31, 0x73, 0x91,
+	0x73, 0x89, 0xcc, 0x45, 0x46, 0x93, 0x43, 0x80, 0x7e, 0x70, 0x74, 0xc4, 0xf5, 0xf3, 0xe0, 0x72,
+	0x07, 0xed, 0x70, 0x49, 0x8f, 0x8c, 0x25, 0xbb, 0x50, 0xe4, 0x4a, 0xf2, 0xe4, 0x43, 0xe6, 0xf2,
+	0x95, 0x77, 0xde, 0x5e, 0xac, 0x5a, 0x4f, 0x3e, 0x3c, 0x5c, 0xd2, 0x83, 0x71, 0x84, 0xc2, 0x15,
+	0x7e, 0x18, 0x42, 0x7b, 0x6a, 0x51, 0x8f, 0xb9, 0x87, 0xe5, 0x9d, 0x6f, 0xbc, 0x91, 0xb9, 0x44,
+	0x8b, 0x7b, 0xb8, 0xa4, 0xa7, 0xd1, 0x23, 0xdf, 0x84, 0x42, 0x0f, 0x4f, 0x2e, 0xf3, 0x2e, 0xcb,
+	0x3b, 0x6f, 0xcd, 0x23, 0xcc, 0x8e, 0xf8, 0xe1, 0x92, 0xce, 0x47, 0xec, 0x2a, 0xb0, 0x1a, 0x9c,
+	0x25, 0x66, 0x27, 0xb4, 0x6f, 0x41, 0x29, 0xf0, 0x51, 0xc8, 0x55, 0x50, 0x3a, 0x6d, 0xbd, 0x6b,
+	0x1c, 0xeb, 0xed, 0xdd, 0xda, 0x6e, 0xa3, 0xd9, 0xe8, 0x7e, 0xae, 0x2c, 0x11, 0x15, 0xae, 0x31,
+	0xe8, 0xb3, 0xf6, 0x67, 0xf5, 0x66, 0xac, 0x2f, 0xa3, 0x39, 0x50, 0x0a, 0xfc, 0x03, 0xb2, 0x0a,
+	0xb0, 0x5f, 0x3f, 0x68, 0xb4, 0x1a, 0xdd, 0x46, 0xbb, 0xa5, 0x2c, 0x91, 0x35, 0x28, 0x1f, 0xe8,
+	0xed, 0x56, 0xd7, 0x38, 0x6c, 0xb7, 0x3f, 0xed, 0x28, 0x19, 0x44, 0xd8, 0xad, 0xed, 0x7d, 0x2a,
+	0xda, 0x59, 0x72, 0x05, 0xd6, 0x9a, 0xf5, 0xe7, 0x8d, 0xbd, 0x76, 0xcb, 0xe8, 0x7c, 0x7e, 0xb4,
+	0xdb, 0x6e, 0x76, 0x94, 0x1c, 0x8e, 0x6a, 0xb4, 0x5a, 0x75, 0x5d, 0x60, 0xe5, 0x49, 0x19, 0x56,
+	0x3a, 0xed, 0x13, 0x7d, 0xaf, 0xde, 0x51, 0x0a, 0xda, 0xbf, 0xac, 0x40, 0x29, 0xb0, 0x0c, 0xd8,
+	0x25, 0x08, 0x28, 0x4b, 0x04, 0x60, 0xb9, 0x59, 0x6f, 0x3d, 0xed, 0x1e, 0x2a, 0x19, 0xb2, 0x01,
+	0xeb, 0x11, 0x46, 0x0d, 0xbd, 0xd6, 0x7a, 0x5a, 0x57, 0xb2, 0xb8, 0xc0, 0x28, 0xb8, 0xd9, 0xe8,
+	0x74, 0x95, 0x5c, 0x12, 0xb9, 0xd9, 0x38, 0x6a, 0x74, 0x95, 0x3c, 0xb9, 0x06, 0xa4, 0x75, 0x72,
+	0xb4, 0x5b, 0xd7, 0x8d, 0xf6, 0x81, 0x51, 0x6b, 0xd5, 0x9e, 0xea, 0xb5, 0xa3, 0x8e, 0x52, 0x40,
+	0x22, 0x21, 0x9c, 0x09, 0xa5, 0xa3, 0x2c, 0x93, 0x0a, 0x14, 0x0f, 0x6b, 0x1d, 0xa3, 0x5b, 0x7b,
+	0xda, 0x51, 0x56, 0x70, 0x11, 0xc7, 0xed, 0x46, 0xab, 0x6b, 0x3c, 0xab, 0x35, 0x4f, 0xea, 0x4a,
+	0x11, 0x07, 0x1d, 0xd5, 0xba, 0x7b, 0x87, 0x8d, 0xd6, 0x53, 0x49, 0x4b, 0x29, 0x11, 0x02, 0xab,
+	0xb5, 0xe6, 0xf1, 0x21, 0x6b, 0x72, 0x6e, 0x00, 0x61, 0xe2, 0x9e, 0x91, 0x4b, 0x2b, 0x93, 0x2a,
+	0x94, 0xf0, 0xa6, 0xe1, 0x28, 0x55, 0x72, 0x1d, 0xae, 0x74, 0x1a, 0xad, 0xa7, 0xcd, 0x3a, 0x27,
+	0x6f, 0x88, 0x65, 0xaf, 0xb2, 0xb1, 0x27, 0x47, 0x46, 0xf7, 0xb3, 0xb6, 0xb1, 0xdb, 0xac, 0xb5,
+	0x3e, 0xed, 0x28, 0x6b, 0x64, 0x1d, 0xaa, 0x47, 0xb5, 0xe7, 0x46, 0xa7, 0xdd, 0x3c, 0xc1, 0x7d,
+	0xe9, 0x28, 0x0a, 0x32, 0x83, 0x57, 0x56, 0x63, 0xef, 0xa4, 0x19, 0x08, 0x67, 0x9d, 0x89, 0xa1,
+	0x59, 0xfb, 0x3c, 0x2e, 0x33, 0x82, 0xb7, 0xdc, 0x7e, 0xbd, 0x59, 0xef, 0xd6, 0xf7, 0x0d, 0xe4,
+	0x41, 0xb9, 0x42, 0x6e, 0xc0, 0x46, 0x28, 0x80, 0xe8, 0x0e, 0x5f, 0x25, 0x9b, 0x70, 0x35, 0xec,
+	0x8a, 0xec, 0xf5, 0x06, 0xf2, 0x1c, 0x41, 0x35, 0x1a, 0xad, 0xbd, 0xe6, 0xc9, 0x7e, 0x5d, 0xb9,
+	0x86, 0x62, 0x0e, 0x11, 0x03, 0xf8, 0x75, 0x1c, 0x10, 0x6a, 0x93, 0xb1, 0xd7, 0x6e, 0x75, 0x6b,
+	0x8d, 0x56, 0x47, 0xd9, 0x24, 0x37, 0xe1, 0xfa, 0x94, 0x2a, 0x0a, 0x6e, 0x6f, 0xe0, 0x6a, 0xf5,
+	0x5a, 0x6b, 0xbf, 0x7d, 0x64, 0x74, 0x6a, 0x47, 0xc7, 0xcd, 0xba, 0xa2, 0xe2, 0x02, 0xa4, 0x96,
+	0xe1, 0xaa, 0x95, 0x9b, 0xb8, 0x3b, 0x4c, 0x9c, 0x5c, 0xad, 0x94, 0x5b, 0xa8, 0x98, 0x7b, 0xed,
+	0xa3, 0xdd, 0x46, 0xab, 0xd6, 0x6d, 0xeb, 0xca, 0x6d, 0x14, 0x90, 0x9c, 0xd0, 0x68, 0xd6, 0xbb,
+	0xdd, 0xba, 0xde, 0x51, 0xee, 0x20, 0xb4, 0xfe, 0x9c, 0xb1, 0x17, 0x42, 0xef, 0x22, 0x31, 0xce,
+	0x8e, 0x5e, 0xeb, 0x36, 0xda, 0xca, 0x16, 0xb9, 0x05, 0x9b, 0x11, 0x19, 0xe0, 0x36, 0x84, 0xda,
+	0xf3, 0x16, 0x2e, 0x57, 0x4e, 0x85, 0xbb, 0x21, 0x18, 0xd7, 0x50, 0x95, 0x85, 0xfe, 0x28, 0xf7,
+	0xf0, 0xc8, 0xb5, 0xf5, 0xfd, 0xba, 0x5e, 0xdf, 0x37, 0x12, 0xfa, 0xf1, 0x36, 0x92, 0x97, 0x7d,
+	0x53, 0xba, 0x7c, 0x9f, 0x94, 0xa0, 0xb0, 0x7b, 0xd2, 0x68, 0xee, 0x2b, 0x0f, 0xf0, 0x74, 0x21,
+	0xc5, 0xe8, 0x61, 0x7a, 0x87, 0x6b, 0x57, 0x0c, 0xf6, 0x10, 0xf7, 0xb3, 0xb6, 0xbf, 0x5f, 0xdf,
+	0x67, 0x3a, 0x57, 0xeb, 0x74, 0x8d, 0x93, 0xe3, 0xfd, 0x5a, 0xb7, 0xde, 0x51, 0xde, 0x45, 0x71,
+	0x76, 0xba, 0xf5, 0x23, 0xe3, 0xb8, 0x79, 0xd2, 0x31, 0xda, 0xad, 0xba, 0xf2, 0x1e, 0xd9, 0x82,
+	0x5b, 0x53, 0xa7, 0xc5, 0x38, 0xae, 0xeb, 0x52, 0x0b, 0xbf, 0xc6, 0x34, 0xae, 0xdd, 0x91, 0x66,
+	0xa4, 0x59, 0x57, 0xbe, 0x8e, 0xd3, 0x36, 0xeb, 0xb5, 0x28, 0xec, 0x7d, 0x2d, 0x5f, 0xac, 0x28,
+	0x15, 0xed, 0x63, 0x58, 0x6f, 0x39, 0x7e, 0xc3, 0x6e, 0xd2, 0x8b, 0xf0, 0x5c, 0xaf, 0x43, 0x95,
+	0x39, 0x59, 0x46, 0xbd, 0xf5, 0xb4, 0xd9, 0xe8, 0x1c, 0x2a, 0x4b, 0xfc, 0xe8, 0xd6, 0x9f, 0x35,
+	0xda, 0x27, 0x1d, 0xe3, 0x59, 0x5d, 0xef, 0xa0, 0x89, 0xc9, 0x68, 0x3f, 0xc8, 0xc2, 0xaa, 0xb4,
+	0x78, 0xde, 0xd8, 0xb1, 0x3d, 0x4a, 0x7e, 0x11, 0x20, 0x78, 0x36, 0x4b, 0x5f, 0xf5, 0x7a, 0xdc,
+	0x46, 0x06, 0x41, 0x01, 0x3d, 0x82, 0x1a, 0x7d, 0xb7, 0x67, 0x63, 0xef, 0xf6, 0xe4, 0x6b, 0x2c,
+	0x37, 0xf5, 0x1a, 0xbb, 0x0f, 0xab, 0xfc, 0x41, 0x65, 0x58, 0xf6, 0x80, 0x5e, 0x50, 0x7c, 0x80,
+	0xa3, 0x23, 0x5f, 0xe5, 0xd0, 0x06, 0x07, 0x62, 0x80, 0x41, 0xa0, 0x45, 0x38, 0x2c, 0xb0, 0x97,
+	0x81, 0xc2, 0x3b, 0x6a, 0x21, 0x3b, 0x77, 0xa1, 0x6c, 0xd3, 0x0b, 0xdf, 0x10, 0x2f, 0x39, 0xfe,
+	0x1a, 0x07, 0x04, 0xed, 0x31, 0x08, 0x06, 0x0c, 0x7c, 0x77, 0x62, 0xf7, 0x4d, 0x7c, 0x39, 0xf3,
+	0x27, 0x78, 0x08, 0xd0, 0x7e, 0x92, 0x81, 0x55, 0xf9, 0xcc, 0x12, 0x4f, 0xe4, 0xc8, 0x02, 0x33,
+	0xf1, 0x05, 0x46, 0x7c, 0xc0, 0x6c, 0xdc, 0x07, 0x7c, 0x2c, 0xfc, 0x67, 0xfe, 0xd6, 0x4d, 0xdc,
+	0x35, 0x71, 0xfa, 0x11, 0xa7, 0x39, 0xf2, 0x80, 0xce, 0x47, 0x1f, 0xd0, 0xda, 0x3b, 0xc2, 0x99,
+	0x2e, 0x41, 0xa1, 0xfe, 0xbc, 0xb6, 0xd7, 0x55, 0x96, 0x42, 0x8d, 0xcd, 0xe0, 0x67, 0xe7, 0xe4,
+	0xb8, 0xae, 0x2b, 0x59, 0xed, 0x39, 0xac, 0x05, 0xd4, 0xc5, 0xc6, 0x06, 0x91, 0xab, 0xcc, 0xa2,
+	0xc8, 0xd5, 0x4d, 0x28, 0xd9, 0x93, 0x91, 0x21, 0xe3, 0x5c, 0xec, 0x71, 0x6c, 0x4f, 0x46, 0x88,
+	0xe2, 0x69, 0xff, 0x9c, 0x81, 0x9b, 0xbb, 0x43, 0xd3, 0x7e, 0xb1, 0x77, 0x6e, 0x0e, 0xf1, 0x7e,
+	0xa5, 0x7b, 0x2e, 0x35, 0x7d, 0xba, 0x58, 0x4a, 0xf7, 0xa0, 0x8a, 0x64, 0x19, 0x1a, 0x8b, 0x59,
+	0x71, 0xd2, 0x15, 0x7b, 0x32, 0xfa, 0xae, 0x84, 0x21, 0x12, 0x7a, 0xc5, 0x9e, 0x33, 0x9c, 0x70,
+	0x24, 0xee, 0x18, 0x57, 0x46, 0xe6, 0x45, 0x47, 0xc2, 0xc8, 0xbb, 0xb0, 0xce, 0x18, 0xb4, 0xfc,
+	0x73, 0x63, 0xc7, 0xe8, 0x21, 0x37, 0x9e, 0x88, 0xa0, 0xad, 0x22, 0xa3, 0x96, 0x7f, 0xbe, 0xc3,
+	0x78, 0x64, 0x6a, 0x80, 0xeb, 0x90, 0x6e, 0x36, 0x8f, 0xa4, 0x01, 0x82, 0xb8, 0xd3, 0xa0, 0xfd,
+	0x0f, 0xae, 0x07, 0x6f, 0xf7, 0xaf, 0xb2, 0x1e, 0xf4, 0xef, 0x43, 0x56, 0xc5, 0x7a, 0x46, 0x96,
+	0x1d, 0xb2, 0x7a, 0xa9, 0xf5, 0xc4, 0x5f, 0x0a, 0xf9, 0xf9, 0x2f, 0x85, 0x42, 0xe2, 0xa5, 0x40,
+	0x9e, 0xc0, 0x75, 0x97, 0x7e, 0x31, 0xb1, 0x5c, 0x2a, 0x50, 0x82, 0xd9, 0x98, 0xd6, 0x17, 0xf5,
+	0x0d, 0xd1, 0xcd, 0xf1, 0xe5, 0xb4, 0xda, 0x27, 0x70, 0x4d, 0xf8, 0x86, 0x47, 0xd4, 0x37, 0x07,
+	0xa6, 0x6f, 0x2e, 0x5e, 0x33, 0x3e, 0x8c, 0x9d, 0xbe, 0x29, 0x82, 0x07, 0x25, 0x5d, 0xb4, 0xb4,
+	0xff, 0x28, 0xc0, 0x5a, 0x82, 0xd8, 0x7c, 0x2a, 0xa7, 0xe6, 0xc8, 0x1a, 0xbe, 0x96, 0x54, 0x78,
+	0x8b, 0xbc, 0x0b, 0xca, 0x80, 0x7a, 0x7d, 0xd7, 0x1a, 0xfb, 0xd6, 0x4b, 0x6a, 0xd8, 0xe6, 0x88,
+	0x0a, 0x6b, 0xb1, 0x16, 0x81, 0xb7, 0xcc, 0x11, 0x45, 0x99, 0x0c, 0x7a, 0xc6, 0x4b, 0xea, 0x7a,
+	0xb8, 0x4e, 0x21, 0xb2, 0x41, 0xef, 0x19, 0x07, 0x90, 0x16, 0x54, 0x85, 0x2c, 0xd8, 0x83, 0x98,
+	0x9b, 0x89, 0xa9, 0xd8, 0x4a, 0x82, 0x63, 0xe1, 0x47, 0xee, 0xe1, 0x08, 0xbd, 0x32, 0x0c, 0x1b,
+	0x1e, 0xe9, 0xc0, 0x15, 0x7e, 0xa4, 0x8d, 0x81, 0x85, 0x0f, 0x8f, 0x9e, 0x94, 0x6f, 0x6e, 0xfa,
+	0x15, 0x95, 0xa4, 0xda, 0xb5, 0x86, 0x54, 0x27, 0x7c, 0xf8, 0x7e, 0x64, 0x34, 0xe9, 0x4e, 0x07,
+	0x0d, 0x57, 0x18, 0xc1, 0xaf, 0x2d, 0x62, 0x33, 0x12, 0x52, 0x9c, 0x8a, 0x30, 0x62, 0x64, 0xd8,
+	0x1c, 0x87, 0xfe, 0x73, 0x91, 0x19, 0xc8, 0x18, 0x4c, 0xb5, 0x30, 0x14, 0x10, 0x2c, 0x6f, 0x66,
+	0xb8, 0x63, 0x9e, 0x21, 0x40, 0xa3, 0x8d, 0x9d, 0x11, 0x53, 0xcc, 0x55, 0x1b, 0x0f, 0x79, 0x68,
+	0x87, 0xd5, 0xef, 0x43, 0x1e, 0x05, 0xc0, 0xe7, 0x40, 0x11, 0x08, 0x65, 0x10, 0xad, 0x30, 0x80,
+	0x91, 0x8d, 0x06, 0x30, 0xae, 0x42, 0xc1, 0xeb, 0x3b, 0x2e, 0x15, 0x34, 0x79, 0x03, 0xa1, 0x2c,
+	0x90, 0x2c, 0xac, 0x22, 0x6f, 0xa8, 0x06, 0x54, 0x63, 0x12, 0xc1, 0xa9, 0xb8, 0x3c, 0xe5, 0x54,
+	0xbc, 0x85, 0xd1, 0x9b, 0x40, 0x8d, 0x82, 0x5b, 0x2a, 0x0a, 0xc2, 0x09, 0x86, 0x66, 0x8f, 0x0e,
+	0x85, 0xd6, 0xf1, 0x86, 0xf6, 0x08, 0xae, 0xc8, 0x09, 0x7c, 0xd3, 0xf7, 0x16, 0x9e, 0x12, 0xed,
+	0x0f, 0xf3, 0x50, 0x89, 0x8e, 0x98, 0x73, 0x14, 0xbe, 0x0d, 0xcb, 0xbe, 0xe3, 0x9b, 0x43, 0x6f,
+	0x33, 0x9b, 0xf6, 0xfc, 0x8a, 0x52, 0x11, 0xea, 0xc9, 0x79, 0x10, 0xa3, 0xc8, 0x77, 0x90, 0x32,
+	0x82, 0x51, 0xfc, 0xb9, 0x37, 0x20, 0x20, 0x87, 0x91, 0x16, 0xac, 0x8a, 0x00, 0xa4, 0x3c, 0x2b,
+	0xf9, 0xb4, 0x90, 0x49, 0x8c, 0x90, 0xb8, 0x5b, 0xf8, 0x49, 0xa9, 0x9a, 0x91, 0x96, 0xa7, 0xfe,
+	0x4d, 0x46, 0x2a, 0x17, 0x5f, 0xfb, 0x2c, 0xe5, 0x9a, 0xd6, 0x9f, 0x6c, 0x8a, 0xfe, 0xc4, 0x75,
+	0x30, 0x97, 0xd0, 0xc1, 0x07, 0xb0, 0x66, 0xbe, 0x3c, 0x33, 0xc6, 0x8e, 0x65, 0xfb, 0x06, 0x7f,
+	0xae, 0xa3, 0x6a, 0x64, 0xf4, 0xaa, 0xf9, 0xf2, 0xec, 0x18, 0xa1, 0x3c, 0xf4, 0x77, 0x1f, 0xd6,
+	0x90, 0xc8, 0xc4, 0xb6, 0xbe, 0x30, 0x7c, 0x07, 0x23, 0x5e, 0x9b, 0x85, 0xe0, 0xf2, 0x39, 0xb1,
+	0xad, 0x2f, 0xba, 0x4e, 0x93, 0x5e, 0xa8, 0xcf, 0xa1, 0x12, 0x5d, 0x19, 0x46, 0x9a, 0x19, 0x8b,
+	0x76, 0xe0, 0x0d, 0xe1, 0x18, 0x7c, 0xdf, 0x0b, 0x34, 0xef, 0x92, 0xab, 0xd0, 0xde, 0x87, 0xf5,
+	0x4e, 0xff, 0x9c, 0x8e, 0xcc, 0x86, 0x7d, 0xea, 0x2c, 0x56, 0xa0, 0xff, 0xcc, 0x02, 0x84, 0xf8,
+	0xf3, 0x3d, 0x0f, 0x69, 0x03, 0xf9, 0xbc, 0xb2, 0x49, 0x76, 0xf1, 0x4e, 0x39, 0x73, 0x4d, 0x79,
+	0xeb, 0xa4, 0x18, 0xaa, 0x70, 0x86, 0xed, 0x23, 0x89, 0xaa, 0x47, 0x46, 0x91, 0x27, 0xb0, 0xec,
+	0x9b, 0xbd, 0x21, 0x95, 0x2a, 0x71, 0x67, 0xe6, 0xf8, 0x2e, 0xa2, 0xe9, 0x02, 0x1b, 0x8f, 0x11,
+	0x75, 0x5d, 0xc7, 0x15, 0x81, 0x77, 0xde, 0x50, 0x9f, 0x43, 0x29, 0x98, 0x26, 0xca, 0x78, 0x26,
+	0xce, 0x38, 0x81, 0xfc, 0x0b, 0x4b, 0xa4, 0x0e, 0x4a, 0x3a, 0xfb, 0x46, 0x6b, 0x6f, 0x8e, 0xc7,
+	0x43, 0x8b, 0x0e, 0x0c, 0xd3, 0x67, 0x5a, 0x90, 0xd3, 0x4b, 0x02, 0x52, 0xf3, 0xd5, 0xc7, 0x50,
+	0x60, 0x0c, 0xe0, 0x58, 0x76, 0x69, 0x88, 0xc4, 0x10, 0x7e, 0xe3, 0x4c, 0x7d, 0x67, 0x38, 0x19,
+	0xd9, 0x3c, 0x16, 0x58, 0xd2, 0x65, 0x53, 0x1b, 0x01, 0x89, 0x6e, 0x8a, 0xf0, 0x93, 0xee, 0xc3,
+	0xea, 0xd0, 0xf4, 0xa9, 0xe7, 0x1b, 0x71, 0x06, 0xab, 0x1c, 0x2a, 0x6f, 0x98, 0x5f, 0x40, 0xb5,
+	0xbe, 0xb0, 0xfa, 0xa6, 0x88, 0x30, 0x6e, 0xce, 0x92, 0x8d, 0x2e, 0xf0, 0xb4, 0xa7, 0x70, 0x85,
+	0xfb, 0xda, 0xbc, 0xef, 0xab, 0x5f, 0xb6, 0x7f, 0x9b, 0x87, 0x4a, 0x94, 0x12, 0xe6, 0x55, 0x82,
+	0xb8, 0x84, 0xf4, 0xef, 0x52, 0xe3, 0x2f, 0x1c, 0x3f, 0x12, 0x1b, 0x8c, 0x8c, 0xc3, 0x15, 0x79,
+	0xac, 0x5f, 0x98, 0xa2, 0x39, 0x2b, 0xe2, 0x78, 0xea, 0xef, 0x66, 0xa0, 0xc0, 0x63, 0x17, 0x69,
+	0x82, 0x27, 0x90, 0xf7, 0x5f, 0x8f, 0x25, 0xf3, 0xec, 0x9b, 0xa8, 0x50, 0x74, 0xe9, 0x98, 0x32,
+	0x9f, 0x9b, 0x27, 0x44, 0x83, 0x36, 0xba, 0x6a, 0x14, 0xcf, 0x92, 0x08, 0x93, 0xe7, 0xd9, 0x66,
+	0x01, 0x82, 0xd8, 0x21, 0x66, 0x56, 0x74, 0x44, 0x3d, 0xcf, 0x3c, 0xa3, 0x42, 0xb1, 0x64, 0x53,
+	0xfd, 0x51, 0x36, 0x1a, 0xd6, 0x48, 0x63, 0xe6, 0x1a, 0x2c, 0xf3, 0xb0, 0x9c, 0x38, 0x27, 0xa2,
+	0x95, 0xbc, 0x13, 0x72, 0xa9, 0x77, 0x02, 0x8b, 0xf5, 0x88, 0xa4, 0x20, 0x6f, 0x90, 0x8f, 0x60,
+	0xf9, 0x14, 0x57, 0x2e, 0x3d, 0x8b, 0xad, 0x39, 0xe2, 0xe6, 0xe9, 0x1f, 0x81, 0x8f, 0xa9, 0xc8,
+	0xe0, 0x2e, 0x7e, 0x2d, 0xdf, 0x25, 0x21, 0x84, 0x25, 0x32, 0x5f, 0x9a, 0xd6, 0x10, 0x15, 0x5a,
+	0xbe, 0x4b, 0x02, 0x00, 0x1b, 0xcd, 0xc3, 0x6e, 0xd8, 0xcd, 0x13, 0x82, 0x11, 0x08, 0xd9, 0x82,
+	0xca, 0x68, 0xe2, 0xf9, 0x46, 0x8f, 0x1a, 0x43, 0xd3, 0xf3, 0x45, 0x4a, 0x10, 0x10, 0xb6, 0x4b,
+	0x9b, 0xa6, 0xe7, 0x6b, 0x8f, 0xe1, 0x46, 0x73, 0xca, 0x17, 0x59, 0x6c, 0x92, 0x7e, 0x98, 0x01,
+	0x32, 0x3d, 0x6e, 0x8e, 0xf6, 0xca, 0x5d, 0xc8, 0x46, 0x76, 0xe1, 0x23, 0x28, 0xf8, 0xd6, 0x90,
+	0xce, 0xb0, 0x47, 0xa9, 0x8e, 0x13, 0x1f, 0x80, 0xca, 0xc1, 0x6e, 0x3c, 0x83, 0x8f, 0xe7, 0x0e,
+	0x1f, 0x30, 0x10, 0xa2, 0x79, 0x5a, 0x1d, 0x36, 0x74, 0xb3, 0xff, 0xe2, 0x99, 0x39, 0xb4, 0x06,
+	0xe6, 0xa5, 0x96, 0x84, 0x1c, 0xba, 0x66, 0xff, 0x85, 0xe4, 0x10, 0xbf, 0xb5, 0xff, 0xca, 0xc0,
+	0xb5, 0x24, 0x1d, 0x61, 0x18, 0x78, 0xfe, 0xc8, 0xe2, 0xe9, 0xb4, 0xa2, 0xce, 0x1b, 0x44, 0xc7,
+	0x34, 0x7f, 0x9f, 0x7a, 0x9e, 0xe0, 0x8c, 0x5b, 0x83, 0x47, 0xf1, 0x95, 0xa5, 0x53, 0xdc, 0xae,
+	0xb3, 0x81, 0x6c, 0x99, 0x65, 0x1a, 0x7c, 0xa3, 0xcf, 0x04, 0x61, 0xd7, 0x4c, 0xcf, 0xe9, 0x16,
+	0x94, 0x5c, 0xbe, 0x46, 0x91, 0xe9, 0x29, 0xe8, 0x21, 0x20, 0xae, 0x46, 0x22, 0xbb, 0x10, 0x00,
+	0xb4, 0xff, 0xce, 0xc0, 0xf5, 0xfd, 0x20, 0xfd, 0x7d, 0x32, 0x1e, 0x5c, 0xea, 0xc5, 0x73, 0x0c,
+	0x2b, 0x13, 0x86, 0x2a, 0x97, 0xf9, 0x24, 0xbe, 0xcc, 0x19, 0x14, 0xa7, 0xe1, 0x92, 0x0c, 0xae,
+	0xcd, 0x9c, 0xf8, 0xe7, 0x8e, 0x2b, 0x4e, 0x9e, 0x68, 0xa9, 0x07, 0xa0, 0x24, 0x07, 0xa5, 0x66,
+	0xfd, 0xe3, 0x79, 0xfd, 0x6c, 0x32, 0xaf, 0xaf, 0x3d, 0x87, 0xcd, 0x69, 0xa6, 0xc4, 0x7e, 0xde,
+	0x65, 0x71, 0x7e, 0x83, 0xb3, 0x32, 0x10, 0x56, 0x1e, 0xd0, 0x21, 0xe0, 0x10, 0xe6, 0x7a, 0x38,
+	0xbe, 0x71, 0xea, 0x4c, 0xd8, 0x75, 0x84, 0xe6, 0xa8, 0x68, 0x3b, 0xfe, 0x01, 0xb6, 0xb5, 0x3f,
+	0xcd, 0xc0, 0xfa, 0xde, 0x39, 0xed, 0xbf, 0x60, 0xce, 0xc7, 0x62, 0xd9, 0x7d, 0x14, 0xcb, 0xa4,
+	0x25, 0xac, 0xf3, 0x14, 0xa1, 0x68, 0x06, 0xed, 0x23, 0xf1, 0xe8, 0x2f, 0xc3, 0xca, 0x71, 0xad,
+	0xd3, 0x69, 0x3c, 0xab, 0x2b, 0x4b, 0xa4, 0x08, 0xf9, 0x83, 0x93, 0x66, 0x53, 0xc9, 0x20, 0x58,
+	0xaf, 0x77, 0xba, 0x35, 0xbd, 0xab, 0x64, 0x31, 0x8c, 0xda, 0xd5, 0x4f, 0x5a, 0x7b, 0xb5, 0x6e,
+	0x5d, 0xc9, 0x69, 0xbf, 0x97, 0x01, 0x12, 0x25, 0x2d, 0x16, 0xae, 0x40, 0xee, 0x95, 0x39, 0x14,
+	0x6a, 0x8c, 0x9f, 0x28, 0xda, 0xde, 0xc4, 0x7b, 0x2d, 0x32, 0xc2, 0xec, 0x1b, 0xef, 0xdc, 0xa1,
+	0x73, 0x66, 0x9c, 0xba, 0xe6, 0x88, 0x4a, 0xcf, 0xab, 0x34, 0x74, 0xce, 0x0e, 0x18, 0x80, 0x3c,
+	0x82, 0x2b, 0xfd, 0x80, 0x34, 0x1d, 0x48, 0x3c, 0x7e, 0x30, 0x49, 0xb4, 0x8b, 0x0f, 0xd0, 0x76,
+	0x41, 0x41, 0xa7, 0xed, 0x93, 0xc9, 0xe0, 0xec, 0x12, 0xaa, 0x76, 0x35, 0x5a, 0x67, 0x53, 0x12,
+	0x91, 0x09, 0xed, 0xc7, 0x19, 0x58, 0x8f, 0x10, 0x11, 0xeb, 0xf9, 0x4e, 0x3c, 0xb2, 0xf1, 0xde,
+	0x74, 0x64, 0x23, 0x86, 0xbf, 0xcd, 0x5a, 0x83, 0x68, 0xc4, 0xe3, 0x0e, 0x80, 0xd9, 0xef, 0xd3,
+	0x31, 0xf3, 0x5f, 0x84, 0x14, 0x22, 0x10, 0xf5, 0x09, 0x40, 0x38, 0x28, 0x55, 0x11, 0x03, 0xe3,
+	0x90, 0x8d, 0x18, 0x07, 0xcd, 0x85, 0x35, 0xac, 0xd1, 0xe8, 0xba, 0x94, 0x5e, 0xca, 0x1c, 0x31,
+	0xb2, 0xd9, 0x38, 0xd9, 0x01, 0x1d, 0x07, 0xf9, 0x41, 0xde, 0x40, 0xc5, 0xc4, 0x80, 0x80, 0xed,
+	0x0c, 0x02, 0x89, 0x17, 0x47, 0xe6, 0x45, 0x0b, 0xdb, 0xda, 0x1f, 0x67, 0xa0, 0x88, 0x93, 0x62,
+	0x2b, 0x95, 0x55, 0x02, 0x79, 0x56, 0x4d, 0x22, 0xe6, 0xc1, 0x6f, 0x9c, 0x87, 0x15, 0xa4, 0x88,
+	0x4b, 0x99, 0x37, 0xc8, 0x0e, 0x14, 0xfb, 0xe7, 0xd6, 0x70, 0xe0, 0x52, 0x5b, 0x78, 0x80, 0xd7,
+	0xe2, 0xb2, 0x95, 0xf3, 0xe8, 0x01, 0x5e, 0xec, 0x86, 0x2f, 0xc4, 0x6f, 0x78, 0xed, 0xd7, 0x41,
+	0x09, 0xc5, 0x21, 0x36, 0xef, 0x3d, 0xc8, 0xbb, 0x8e, 0xc3, 0xf3, 0xd7, 0xb3, 0xe9, 0x33, 0x9c,
+	0x78, 0xc8, 0x2e, 0x9b, 0x0c, 0xd9, 0x79, 0x70, 0x95, 0x87, 0x6e, 0xf6, 0x4c, 0x77, 0xd0, 0x73,
+	0x2e, 0xa4, 0xc4, 0x09, 0xe4, 0x27, 0x5e, 0x60, 0x3d, 0xd9, 0x77, 0xea, 0xe5, 0xf4, 0x01, 0x2c,
+	0xf3, 0x89, 0x45, 0x66, 0xf3, 0xe6, 0x9c, 0xcc, 0x90, 0x2e, 0x50, 0xb5, 0x0e, 0x6c, 0x24, 0x26,
+	0x15, 0xeb, 0xba, 0x8d, 0xd7, 0x3c, 0x03, 0x19, 0x96, 0xac, 0xc0, 0x28, 0x09, 0x08, 0x2f, 0x40,
+	0x41, 0xe3, 0xd3, 0x37, 0xb9, 0x8e, 0xcb, 0x67, 0x0d, 0x52, 0xf1, 0xb4, 0xbf, 0xcf, 0x40, 0x1e,
+	0xbf, 0x16, 0xd4, 0xa2, 0x29, 0x90, 0xeb, 0x39, 0x41, 0x51, 0x46, 0xcf, 0x61, 0x85, 0x1b, 0x03,
+	0x91, 0x99, 0xcd, 0xe9, 0xf8, 0x29, 0x8d, 0x5c, 0xdf, 0x71, 0x5d, 0xda, 0xf7, 0xe5, 0xbd, 0x89,
+	0x33, 0x71, 0x88, 0x8c, 0xca, 0x59, 0xb6, 0x44, 0x09, 0x1f, 0x46, 0x0d, 0x09, 0x23, 0x1f, 0x40,
+	0x51, 0xd6, 0xad, 0x89, 0x7c, 0xe8, 0xcc, 0x90, 0x70, 0x80, 0xa8, 0xfd, 0x56, 0x06, 0xae, 0xe8,
+	0xb4, 0xef, 0xb8, 0x83, 0x9a, 0xed, 0xbd, 0xa2, 0xee, 0xbc, 0xfd, 0x88, 0x4b, 0x2b, 0x9b, 0x94,
+	0x56, 0x4c, 0x0e, 0xb9, 0xa4, 0x1c, 0x98, 0x87, 0x1f, 0xae, 0xaf, 0xa8, 0xcb, 0xa6, 0xf6, 0x6d,
+	0xb8, 0x1a, 0xe7, 0x40, 0x6c, 0xce, 0x03, 0xc8, 0x23, 0x71, 0xa1, 0x74, 0x89, 0x50, 0x28, 0x4a,
+	0x5e, 0x67, 0xfd, 0x78, 0x7e, 0xf7, 0x27, 0x6c, 0x6b, 0xbd, 0x9f, 0x81, 0x7b, 0x8c, 0x2a, 0x58,
+	0x23, 0xcb, 0x97, 0x87, 0x98, 0x35, 0x66, 0xc6, 0x78, 0x1d, 0x50, 0xc2, 0x39, 0x05, 0xbf, 0xb3,
+	0x8d, 0xc6, 0x43, 0x28, 0x48, 0x1d, 0xca, 0xcd, 0x58, 0x0a, 0x47, 0x20, 0xd7, 0x61, 0x05, 0x37,
+	0x5a, 0xea, 0x07, 0x77, 0x81, 0xf7, 0x27, 0x54, 0xfb, 0x4d, 0xb8, 0xda, 0x18, 0x8d, 0x1d, 0xd7,
+	0x3f, 0xb4, 0x3c, 0xdf, 0x71, 0x5f, 0xbf, 0xe9, 0xb9, 0x89, 0x30, 0x97, 0x8b, 0x33, 0xa7, 0x40,
+	0xae, 0xef, 0xbd, 0x64, 0xeb, 0xab, 0xe8, 0xf8, 0xa9, 0x8d, 0x61, 0x23, 0x31, 0xd7, 0xcf, 0x7e,
+	0x5c, 0xe2, 0xf7, 0x74, 0x2e, 0x71, 0x4f, 0x37, 0xe0, 0xea, 0x3e, 0xab, 0x87, 0xbb, 0x84, 0x55,
+	0x98, 0xbf, 0x8f, 0xda, 0x2e, 0x6c, 0x24, 0x48, 0x09, 0xe6, 0xdf, 0x05, 0xc5, 0xa5, 0xb8, 0x1e,
+	0xbc, 0x2c, 0x8c, 0x89, 0xed, 0x5b, 0x43, 0xb1, 0x84, 0xb5, 0x10, 0x7e, 0x82, 0x60, 0xed, 0x13,
+	0xd8, 0xd0, 0x19, 0xe8, 0xe7, 0xc0, 0x0f, 0x85, 0x6b, 0x49, 0x5a, 0x97, 0x93, 0xe6, 0x1b, 0xed,
+	0xa2, 0xf6, 0x3e, 0x5c, 0xe7, 0xcb, 0x1e, 0x88, 0x69, 0xe8, 0xbc, 0xc3, 0xa0, 0xfd, 0x59, 0x06,
+	0x56, 0xe3, 0xf8, 0x3f, 0x57, 0x76, 0xa2, 0xf5, 0x8e, 0x79, 0x46, 0x49, 0x36, 0x53, 0xb7, 0xa1,
+	0x90, 0xbe, 0x0d, 0xcf, 0xd0, 0x2f, 0x4c, 0xae, 0x49, 0x08, 0xef, 0x97, 0x40, 0xf2, 0x16, 0x14,
+	0x6b, 0xdd, 0x4a, 0xfa, 0xb9, 0xd1, 0xa1, 0x7a, 0x88, 0x8e, 0x1e, 0x57, 0xb9, 0x63, 0xbe, 0xa4,
+	0x03, 0x7e, 0x5b, 0xa4, 0x3e, 0x45, 0xc3, 0x7b, 0x26, 0x7b, 0xe9, 0x7b, 0x86, 0xd9, 0x38, 0x37,
+	0x7c, 0x37, 0xe7, 0x74, 0xd9, 0xc4, 0x1e, 0xe9, 0xc2, 0x0a, 0x79, 0x88, 0xa6, 0x36, 0x86, 0x75,
+	0xe4, 0x25, 0x46, 0xf0, 0xff, 0xf7, 0x36, 0xb4, 0x81, 0x44, 0x67, 0x14, 0x02, 0xfd, 0x18, 0x2a,
+	0x1e, 0xca, 0xc4, 0x10, 0x04, 0xb9, 0xd5, 0xbd, 0x91, 0x20, 0x18, 0x4a, 0x4d, 0x2f, 0x7b, 0x61,
+	0x43, 0x38, 0x14, 0x43, 0xb3, 0x1f, 0xdc, 0xf9, 0x41, 0x5b, 0xdb, 0x86, 0x4d, 0x2c, 0xf5, 0x88,
+	0x8c, 0x9d, 0xaf, 0x9b, 0xbf, 0x01, 0x37, 0x52, 0xf0, 0x03, 0x37, 0x72, 0x35, 0xca, 0x66, 0xb0,
+	0xf9, 0x73, 0x18, 0xad, 0x7a, 0x51, 0x4a, 0xda, 0xef, 0x67, 0x60, 0x43, 0x9f, 0xd8, 0x51, 0x8c,
+	0x37, 0x94, 0x7a, 0x78, 0x29, 0xe4, 0x62, 0x95, 0xb3, 0xb1, 0x7a, 0xd5, 0xfc, 0xcc, 0x7a, 0xd5,
+	0x42, 0xb4, 0x5e, 0x55, 0xdb, 0x95, 0x4a, 0xfe, 0xd5, 0x19, 0xd2, 0x6e, 0xc2, 0x8d, 0x14, 0x1a,
+	0x5c, 0x62, 0xda, 0xff, 0x62, 0xa8, 0xd7, 0xf2, 0xfc, 0xf6, 0x98, 0xba, 0xc8, 0xe5, 0xe3, 0x40,
+	0x67, 0x32, 0x0b, 0x75, 0x06, 0xeb, 0xca, 0x78, 0x0f, 0xb9, 0x3b, 0x6d, 0xe6, 0x0e, 0x97, 0xa2,
+	0x06, 0xa2, 0x11, 0xcb, 0x49, 0xe7, 0xde, 0xb4, 0x54, 0x2c, 0x32, 0x98, 0xfc, 0x32, 0x94, 0x1c,
+	0xe4, 0xd6, 0x97, 0x69, 0xa3, 0x29, 0x2e, 0x83, 0x05, 0x21, 0x0a, 0xf2, 0x11, 0xe0, 0xef, 0x96,
+	0x60, 0xc5, 0xe1, 0x4b, 0xd5, 0x7e, 0x94, 0x81, 0x6a, 0x0c, 0x93, 0x6c, 0x47, 0xaa, 0x15, 0xef,
+	0xcc, 0x21, 0x29, 0x4b, 0x14, 0x1f, 0x43, 0x51, 0x10, 0x93, 0x97, 0xf7, 0x8d, 0x19, 0xa3, 0xec,
+	0x81, 0x1e, 0xa0, 0x6a, 0xdf, 0x60, 0x85, 0x89, 0x25, 0x28, 0x9c, 0xb4, 0x78, 0x99, 0x90, 0x02,
+	0x95, 0x46, 0x0b, 0x8b, 0x29, 0xea, 0x7b, 0xac, 0x70, 0x88, 0xd5, 0x09, 0xf1, 0x92, 0xca, 0x7a,
+	0x6b, 0xaf, 0xae, 0x64, 0xb5, 0xbf, 0xce, 0xc0, 0x15, 0x5e, 0xb9, 0x45, 0x91, 0xe6, 0x5c, 0x57,
+	0x66, 0x76, 0x16, 0xff, 0x9b, 0x51, 0xc9, 0xe5, 0x16, 0x4a, 0x2e, 0x22, 0xb7, 0x59, 0xae, 0x0e,
+	0xba, 0x24, 0x78, 0x80, 0x0c, 0x53, 0x56, 0xd4, 0x2f, 0x63, 0xb3, 0xe6, 0x69, 0x2f, 0xe0, 0x6a,
+	0x9c, 0x61, 0x71, 0x44, 0x3f, 0x84, 0x65, 0x97, 0x7a, 0x93, 0xa1, 0x7c, 0x2e, 0xdc, 0x4a, 0x57,
+	0x02, 0x8e, 0xad, 0x0b, 0xdc, 0x45, 0xd7, 0xe8, 0xbf, 0x66, 0x80, 0x1c, 0x59, 0x9e, 0xc7, 0x5f,
+	0x77, 0x8b, 0xd3, 0x3b, 0xa4, 0x06, 0x2b, 0x26, 0xf3, 0x27, 0x67, 0x54, 0x97, 0x4e, 0x13, 0xdb,
+	0x16, 0xfe, 0xa7, 0x1c, 0xb7, 0xb0, 0x24, 0x42, 0xfd, 0x18, 0x96, 0xf9, 0x98, 0x05, 0x6f, 0x80,
+	0xf4, 0x77, 0xf2, 0x0f, 0xf3, 0x70, 0x25, 0xc6, 0x85, 0x90, 0xdf, 0x61, 0x4a, 0x71, 0xc7, 0xc3,
+	0x39, 0xcc, 0xf3, 0x61, 0xdb, 0x4f, 0x5d, 0x73, 0x40, 0x07, 0xb1, 0x73, 0xb4, 0x07, 0x2b, 0xde,
+	0x64, 0x34, 0x32, 0xdd, 0xd7, 0xe2, 0x16, 0x7b, 0x77, 0x31, 0x99, 0x0e, 0x1f, 0xa0, 0xcb, 0x91,
+	0xea, 0x5f, 0x65, 0x60, 0x99, 0xd3, 0x5e, 0xb0, 0xca, 0x6b, 0x58, 0xb7, 0x8a, 0x04, 0xc5, 0x32,
+	0x45, 0x8b, 0xad, 0xde, 0x75, 0xec, 0x33, 0xe1, 0xf5, 0xf1, 0x46, 0x3c, 0x65, 0x94, 0x4f, 0xa4,
+	0x8c, 0x12, 0x0f, 0xa2, 0xc2, 0xd4, 0x83, 0x08, 0xb3, 0x87, 0xce, 0xf0, 0xa5, 0x78, 0xe9, 0x14,
+	0x75, 0xd1, 0x52, 0xff, 0x31, 0x03, 0x2b, 0x62, 0x05, 0x29, 0x59, 0x9f, 0x4c, 0x5a, 0xee, 0xea,
+	0x36, 0x20, 0x61, 0x43, 0x90, 0x13, 0x21, 0x3a, 0x7b, 0x32, 0xea, 0x30, 0xc0, 0xfc, 0xd4, 0xd6,
+	0xc2, 0x87, 0x9b, 0x20, 0x2e, 0xe4, 0x52, 0x08, 0x88, 0x73, 0xc9, 0x07, 0xc4, 0x99, 0x78, 0x96,
+	0x43, 0xe2, 0xd8, 0xd6, 0xfe, 0x2e, 0x07, 0x55, 0x59, 0x07, 0xb0, 0x77, 0x6e, 0xba, 0xfe, 0x62,
+	0xf9, 0xc7, 0xca, 0x98, 0x67, 0xd4, 0xc3, 0xe7, 0xa6, 0x7f, 0xa7, 0xf4, 0x01, 0x3e, 0xef, 0x5f,
+	0xc9, 0x04, 0xd2, 0xdd, 0xc4, 0x79, 0x8d, 0xb2, 0xb0, 0xad, 0x3b, 0xaf, 0x74, 0x86, 0xcc, 0x32,
+	0x07, 0xf4, 0xc2, 0x17, 0x46, 0x81, 0x7d, 0xab, 0x7f, 0x90, 0x85, 0x9c, 0xee, 0xbc, 0x4a, 0x8d,
+	0x68, 0x24, 0x7e, 0xbd, 0x93, 0x5d, 0xf0, 0xeb, 0x9d, 0x5c, 0xf2, 0xd7, 0x3b, 0x69, 0x3f, 0xca,
+	0xc9, 0x5f, 0xf6, 0x47, 0x39, 0x85, 0xb4, 0x1f, 0xe5, 0x5c, 0xfa, 0xf7, 0x40, 0xf1, 0x00, 0xe6,
+	0xca, 0xd4, 0x0f, 0x93, 0x22, 0xde, 0x6e, 0x31, 0xf6, 0xeb, 0x1e, 0xed, 0x0c, 0xae, 0xc5, 0xe4,
+	0x77, 0x99, 0xd7, 0xe2, 0x07, 0xb0, 0xdc, 0x67, 0xb8, 0xc2, 0x70, 0xdd, 0x9c, 0xb3, 0x1f, 0xba,
+	0x40, 0xd5, 0xbe, 0xcf, 0x63, 0x6e, 0x71, 0xff, 0x61, 0x6e, 0x18, 0xeb, 0x6c, 0xe8, 0xf4, 0xa4,
+	0x17, 0x81, 0xdf, 0x61, 0xfe, 0xce, 0x33, 0x7c, 0x27, 0x78, 0xc0, 0x73, 0x48, 0xd7, 0xd1, 0xbe,
+	0x05, 0x55, 0x16, 0xa5, 0xfd, 0x6a, 0x41, 0x32, 0xed, 0xdb, 0x40, 0xa2, 0x0c, 0xbe, 0x69, 0xbd,
+	0x93, 0xf6, 0x0a, 0x56, 0x3b, 0x93, 0xb3, 0x33, 0x74, 0x64, 0xbf, 0xca, 0xfc, 0x98, 0x26, 0xc6,
+	0x70, 0x1c, 0x56, 0x86, 0x98, 0x76, 0x5f, 0x3e, 0xaf, 0xcb, 0x23, 0xf3, 0x62, 0x5f, 0x80, 0xc2,
+	0x10, 0x40, 0x3e, 0x12, 0x02, 0xd0, 0xfe, 0x2d, 0x03, 0x6b, 0xc1, 0xcc, 0x73, 0xb3, 0x0c, 0x9f,
+	0x40, 0xd9, 0xe3, 0x88, 0xa2, 0xd2, 0x28, 0xc5, 0x72, 0x27, 0x28, 0xc9, 0x36, 0xde, 0xc5, 0xd1,
+	0xc1, 0xea, 0xf7, 0x00, 0xc2, 0xae, 0xd4, 0xf3, 0xa4, 0x42, 0x31, 0x58, 0x8c, 0x78, 0x6c, 0xcb,
+	0x76, 0xf2, 0x77, 0x80, 0xb9, 0xa9, 0xdf, 0x01, 0x6a, 0x9f, 0x80, 0xd2, 0xa2, 0xd6, 0xd9, 0x79,
+	0xcf, 0x71, 0x2f, 0x71, 0x99, 0xce, 0xfd, 0xdd, 0x9e, 0xf6, 0x0f, 0x59, 0x58, 0x8f, 0x10, 0x13,
+	0x32, 0x5a, 0x78, 0x25, 0x4e, 0x87, 0x62, 0xe7, 0x1b, 0xdb, 0x03, 0x28, 0xd9, 0x72, 0x96, 0xcd,
+	0x7c, 0x9a, 0x70, 0xa7, 0x98, 0x08, 0x20, 0x7a, 0x38, 0x54, 0xfd, 0x41, 0x06, 0x8a, 0x12, 0xbe,
+	0x98, 0x4b, 0x73, 0x30, 0xa0, 0x52, 0x93, 0x78, 0x03, 0xe5, 0xe4, 0xd2, 0x91, 0xf3, 0x92, 0x4a,
+	0x9f, 0x40, 0x36, 0xe7, 0x5f, 0x6a, 0x0b, 0x7f, 0x2f, 0xba, 0xf3, 0xd3, 0x2c, 0x28, 0xb2, 0xca,
+	0x4e, 0xbc, 0x57, 0x5c, 0xb2, 0x07, 0xcb, 0xfc, 0x9b, 0xcc, 0xf3, 0xd4, 0xd5, 0xb9, 0x5e, 0x16,
+	0xd9, 0x87, 0x65, 0xf1, 0x8b, 0xb6, 0xb9, 0x78, 0x0b, 0xa8, 0xe8, 0x50, 0x8e, 0xb8, 0x0c, 0x64,
+	0x6b, 0x91, 0x47, 0xa5, 0xbe, 0xb5, 0xd0, 0xdf, 0x20, 0x5d, 0x58, 0x8d, 0x5b, 0xc8, 0x05, 0x1c,
+	0xbe, 0x3d, 0xc7, 0x1a, 0x06, 0x54, 0x77, 0x7e, 0x9c, 0x03, 0x10, 0xe5, 0x1f, 0x23, 0xea, 0x92,
+	0x03, 0x58, 0x11, 0xad, 0x24, 0xf5, 0x78, 0x79, 0xa7, 0x7a, 0x7b, 0x46, 0xaf, 0x60, 0xf6, 0xfb,
+	0xb0, 0x91, 0x52, 0x56, 0xe9, 0xb8, 0x24, 0xe1, 0x58, 0xcd, 0xa9, 0xbd, 0x5c, 0x20, 0x62, 0x9c,
+	0x61, 0xba, 0xd0, 0x31, 0x65, 0x86, 0xd9, 0xd5, 0x90, 0x0b, 0x66, 0x38, 0x84, 0x02, 0x4b, 0x93,
+	0x90, 0x3b, 0x33, 0x53, 0x30, 0x9c, 0xcc, 0xdd, 0x05, 0x29, 0x1a, 0xd2, 0x80, 0xa2, 0xcc, 0x14,
+	0x90, 0xdb, 0xd3, 0x39, 0x81, 0x48, 0x42, 0x45, 0xbd, 0x33, 0xab, 0x5b, 0xec, 0xd7, 0x3f, 0x65,
+	0xa1, 0x12, 0x5e, 0x0f, 0xd4, 0x25, 0x1d, 0x20, 0x4f, 0xa9, 0x8f, 0x20, 0x2c, 0x66, 0x70, 0x47,
+	0xfc, 0x8d, 0x72, 0x33, 0x25, 0x95, 0x19, 0xcc, 0xb1, 0x35, 0xcd, 0x6f, 0x62, 0xe9, 0x6d, 0x80,
+	0x10, 0x4a, 0xee, 0xce, 0xc6, 0xbf, 0x2c, 0xc1, 0x03, 0x58, 0x11, 0x56, 0x7a, 0x4a, 0x6b, 0x63,
+	0x77, 0x95, 0x7a, 0x7b, 0x46, 0xaf, 0xa0, 0xd3, 0x84, 0x52, 0x60, 0xbb, 0x92, 0xfb, 0x92, 0x34,
+	0xd3, 0xea, 0xdd, 0x99, 0xfd, 0x42, 0x98, 0x7f, 0x94, 0x0f, 0x7e, 0x44, 0x88, 0xc2, 0x23, 0x9f,
+	0x33, 0x59, 0x26, 0x2b, 0x3f, 0xdf, 0x9e, 0x9b, 0xd7, 0x9f, 0xc1, 0x78, 0x92, 0xc8, 0xe7, 0x50,
+	0x11, 0x29, 0x73, 0x8a, 0xe9, 0x73, 0x72, 0x6f, 0x7e, 0x4a, 0x9d, 0xd3, 0x7c, 0xfb, 0x32, 0x79,
+	0x77, 0xd2, 0x83, 0x0d, 0xc6, 0xf5, 0x54, 0x35, 0xc3, 0x54, 0xcd, 0xdb, 0x8c, 0x3a, 0x09, 0x75,
+	0x6b, 0x11, 0x22, 0xd1, 0xa1, 0xfa, 0x94, 0xfa, 0x91, 0x22, 0xae, 0xbb, 0x33, 0xcb, 0x69, 0xd2,
+	0x69, 0xa6, 0x94, 0x26, 0x1d, 0xc3, 0x1a, 0xd2, 0x8c, 0x96, 0xfe, 0xbc, 0x35, 0xbb, 0xee, 0x44,
+	0xd2, 0x55, 0x67, 0xa3, 0x90, 0x03, 0x28, 0xf0, 0x2a, 0xbd, 0xb7, 0x66, 0x57, 0xfb, 0xcd, 0xa0,
+	0x13, 0x45, 0xd9, 0xf9, 0x49, 0x06, 0x0a, 0xb5, 0x01, 0xfe, 0xf2, 0xb7, 0x07, 0xeb, 0x3c, 0x7f,
+	0x1e, 0xe6, 0xdd, 0x3d, 0x72, 0xff, 0x52, 0x75, 0x02, 0xea, 0x83, 0x45, 0x68, 0xe1, 0x61, 0x0b,
+	0xd3, 0xda, 0x49, 0xc1, 0x4e, 0xe5, 0xd2, 0xd5, 0xad, 0xd9, 0x08, 0x42, 0xad, 0xff, 0xbd, 0x00,
+	0xd5, 0xef, 0x4e, 0xac, 0x2f, 0x51, 0x2a, 0x83, 0xc9, 0x90, 0xba, 0xe4, 0x39, 0x54, 0x63, 0x79,
+	0x3d, 0x92, 0xa8, 0x55, 0x49, 0xcb, 0x34, 0xaa, 0xf7, 0xe6, 0xe2, 0x08, 0xe6, 0x4f, 0xa0, 0x12,
+	0xcd, 0x49, 0x25, 0x25, 0x9f, 0x92, 0x31, 0x53, 0xb5, 0x79, 0x28, 0xa1, 0xc5, 0x94, 0x69, 0xa3,
+	0xa4, 0xc5, 0x4c, 0xa4, 0xb0, 0xd4, 0x3b, 0xb3, 0xba, 0x43, 0x0e, 0xa3, 0xd1, 0x97, 0x24, 0x87,
+	0x29, 0xa1, 0x24, 0x55, 0x9b, 0x87, 0x22, 0xc8, 0x3e, 0x87, 0x6a, 0x2c, 0xf7, 0x93, 0x14, 0x69,
+	0x5a, 0x12, 0x4a, 0xbd, 0x37, 0x17, 0x27, 0xa4, 0x1c, 0x4b, 0xcc, 0x24, 0x29, 0xa7, 0x25, 0x80,
+	0xd4, 0x7b, 0x73, 0x71, 0x04, 0xe5, 0x5f, 0x83, 0xd5, 0x78, 0x8a, 0x65, 0xca, 0x0c, 0xa5, 0x25,
+	0x73, 0xd4, 0xb7, 0xe7, 0x23, 0x09, 0xe2, 0x26, 0x28, 0x7c, 0xd6, 0x30, 0x09, 0x31, 0x7d, 0x52,
+	0x52, 0x13, 0x2f, 0xea, 0x83, 0x45, 0x68, 0x42, 0xb1, 0x7f, 0x1b, 0x1f, 0xfa, 0xd1, 0x18, 0x35,
+	0x9e, 0x9d, 0x30, 0x44, 0x3f, 0x65, 0x94, 0x92, 0xe9, 0x02, 0x75, 0x6b, 0x36, 0x82, 0x58, 0xc5,
+	0x00, 0xd6, 0xa7, 0x62, 0xea, 0xe4, 0xc1, 0x74, 0x64, 0x30, 0x2d, 0x48, 0xaf, 0xbe, 0xb3, 0x10,
+	0x4f, 0xcc, 0xd2, 0x81, 0xd5, 0x78, 0x64, 0x7d, 0x6a, 0x23, 0xd2, 0xe2, 0xee, 0x0b, 0xfc, 0x95,
+	0x01, 0xac, 0x4f, 0x05, 0xb7, 0x49, 0xaa, 0x68, 0x53, 0x48, 0xbf, 0xb3, 0x10, 0x8f, 0xcf, 0xb2,
+	0xfb, 0xf8, 0x57, 0x3f, 0x38, 0xb3, 0xfc, 0xf3, 0x49, 0x6f, 0xbb, 0xef, 0x8c, 0x1e, 0x0d, 0x9c,
+	0x91, 0x65, 0x3b, 0xdf, 0xf8, 0xf0, 0x11, 0x8e, 0x36, 0x06, 0x3d, 0xc3, 0xa3, 0xee, 0x4b, 0xea,
+	0x3e, 0x72, 0xc7, 0xfd, 0x47, 0x51, 0x82, 0xbd, 0x65, 0xf6, 0x57, 0x35, 0x1f, 0xfc, 0xdf, 0x00,
+	0xd9, 0x34, 0x41, 0x60, 0xc9, 0x46, 0x00, 0x00,
 }
@@ -0,0 +1,1715 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.4
+// source: wordsearcher/searcher.proto
+
+package wordsearcher
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	QuestionSearcher_Search_FullMethodName         = "/wordsearcher.QuestionSearcher/Search"
+	QuestionSearcher_Expand_FullMethodName         = "/wordsearcher.QuestionSearcher/Expand"
+	QuestionSearcher_MissedWords_FullMethodName    = "/wordsearcher.QuestionSearcher/MissedWords"
+	QuestionSearcher_SolutionCharts_FullMethodName = "/wordsearcher.QuestionSearcher/SolutionCharts"
+)
+
+// QuestionSearcherClient is the client API for QuestionSearcher service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QuestionSearcherClient interface {
+	// Search takes in a search request and returns a search response.
+	// This response can be expanded or not, depending on the `expand` field
+	// in SearchRequest.
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// Expand takes in an unexpanded search response and returns a
+	// search response (fully expanded). See expandedRepr above in
+	// the Alphagram field.
+	Expand(ctx context.Context, in *SearchResponse, opts ...grpc.CallOption) (*SearchResponse, error)
+	// MissedWords grades a quiz's answers: for each alphagram, the words
+	// that were missed and the ones given that are wrong. Quiz clients can
+	// grade with it instead of expanding every question up front.
+	MissedWords(ctx context.Context, in *MissedWordsRequest, opts ...grpc.CallOption) (*MissedWordsResponse, error)
+	// SolutionCharts expands alphagrams, like Expand, and returns their
+	// solution charts, so every front end shows the same ones.
+	SolutionCharts(ctx context.Context, in *SearchResponse, opts ...grpc.CallOption) (*SolutionChartsResponse, error)
+}
+
+type questionSearcherClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQuestionSearcherClient(cc grpc.ClientConnInterface) QuestionSearcherClient {
+	return &questionSearcherClient{cc}
+}
+
+func (c *questionSearcherClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, QuestionSearcher_Search_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionSearcherClient) Expand(ctx context.Context, in *SearchResponse, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, QuestionSearcher_Expand_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionSearcherClient) MissedWords(ctx context.Context, in *MissedWordsRequest, opts ...grpc.CallOption) (*MissedWordsResponse, error) {
+	out := new(MissedWordsResponse)
+	err := c.cc.Invoke(ctx, QuestionSearcher_MissedWords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionSearcherClient) SolutionCharts(ctx context.Context, in *SearchResponse, opts ...grpc.CallOption) (*SolutionChartsResponse, error) {
+	out := new(SolutionChartsResponse)
+	err := c.cc.Invoke(ctx, QuestionSearcher_SolutionCharts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuestionSearcherServer is the server API for QuestionSearcher service.
+// All implementations should embed UnimplementedQuestionSearcherServer
+// for forward compatibility
+type QuestionSearcherServer interface {
+	// Search takes in a search request and returns a search response.
+	// This response can be expanded or not, depending on the `expand` field
+	// in SearchRequest.
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	// Expand takes in an unexpanded search response and returns a
+	// search response (fully expanded). See expandedRepr above in
+	// the Alphagram field.
+	Expand(context.Context, *SearchResponse) (*SearchResponse, error)
+	// MissedWords grades a quiz's answers: for each alphagram, the words
+	// that were missed and the ones given that are wrong. Quiz clients can
+	// grade with it instead of expanding every question up front.
+	MissedWords(context.Context, *MissedWordsRequest) (*MissedWordsResponse, error)
+	// SolutionCharts expands alphagrams, like Expand, and returns their
+	// solution charts, so every front end shows the same ones.
+	SolutionCharts(context.Context, *SearchResponse) (*SolutionChartsResponse, error)
+}
+
+// UnimplementedQuestionSearcherServer should be embedded to have forward compatible implementations.
+type UnimplementedQuestionSearcherServer struct {
+}
+
+func (UnimplementedQuestionSearcherServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedQuestionSearcherServer) Expand(context.Context, *SearchResponse) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Expand not implemented")
+}
+func (UnimplementedQuestionSearcherServer) MissedWords(context.Context, *MissedWordsRequest) (*MissedWordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MissedWords not implemented")
+}
+func (UnimplementedQuestionSearcherServer) SolutionCharts(context.Context, *SearchResponse) (*SolutionChartsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SolutionCharts not implemented")
+}
+
+// UnsafeQuestionSearcherServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QuestionSearcherServer will
+// result in compilation errors.
+type UnsafeQuestionSearcherServer interface {
+	mustEmbedUnimplementedQuestionSearcherServer()
+}
+
+func RegisterQuestionSearcherServer(s grpc.ServiceRegistrar, srv QuestionSearcherServer) {
+	s.RegisterService(&QuestionSearcher_ServiceDesc, srv)
+}
+
+func _QuestionSearcher_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionSearcherServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionSearcher_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionSearcherServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionSearcher_Expand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchResponse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionSearcherServer).Expand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionSearcher_Expand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionSearcherServer).Expand(ctx, req.(*SearchResponse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionSearcher_MissedWords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MissedWordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionSearcherServer).MissedWords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionSearcher_MissedWords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionSearcherServer).MissedWords(ctx, req.(*MissedWordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionSearcher_SolutionCharts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchResponse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionSearcherServer).SolutionCharts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionSearcher_SolutionCharts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionSearcherServer).SolutionCharts(ctx, req.(*SearchResponse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QuestionSearcher_ServiceDesc is the grpc.ServiceDesc for QuestionSearcher service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QuestionSearcher_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wordsearcher.QuestionSearcher",
+	HandlerType: (*QuestionSearcherServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Search",
+			Handler:    _QuestionSearcher_Search_Handler,
+		},
+		{
+			MethodName: "Expand",
+			Handler:    _QuestionSearcher_Expand_Handler,
+		},
+		{
+			MethodName: "MissedWords",
+			Handler:    _QuestionSearcher_MissedWords_Handler,
+		},
+		{
+			MethodName: "SolutionCharts",
+			Handler:    _QuestionSearcher_SolutionCharts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "wordsearcher/searcher.proto",
+}
+
+const (
+	Anagrammer_Anagram_FullMethodName               = "/wordsearcher.Anagrammer/Anagram"
+	Anagrammer_BlankChallengeCreator_FullMethodName = "/wordsearcher.Anagrammer/BlankChallengeCreator"
+	Anagrammer_BuildChallengeCreator_FullMethodName = "/wordsearcher.Anagrammer/BuildChallengeCreator"
+	Anagrammer_Judge_FullMethodName                 = "/wordsearcher.Anagrammer/Judge"
+	Anagrammer_HookTree_FullMethodName              = "/wordsearcher.Anagrammer/HookTree"
+)
+
+// AnagrammerClient is the client API for Anagrammer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AnagrammerClient interface {
+	// Anagram does a simple anagram search; it can either be
+	// build mode or regular (exact) mode.
+	Anagram(ctx context.Context, in *AnagramRequest, opts ...grpc.CallOption) (*AnagramResponse, error)
+	// BlankChallengeCreator creates blank challenges for Aerolith
+	BlankChallengeCreator(ctx context.Context, in *BlankChallengeCreateRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// BuildChallengeCreator creates build challenges for Aerolith.
+	BuildChallengeCreator(ctx context.Context, in *BuildChallengeCreateRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// Judge adjudicates a play: it says which of its words are valid, and
+	// whether the play is acceptable as a whole.
+	Judge(ctx context.Context, in *WordJudgeRequest, opts ...grpc.CallOption) (*WordJudgeResponse, error)
+	// HookTree returns a word's hooks, their hooks, and so on, for showing
+	// how short words extend into long ones.
+	HookTree(ctx context.Context, in *HookTreeRequest, opts ...grpc.CallOption) (*HookTreeResponse, error)
+}
+
+type anagrammerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAnagrammerClient(cc grpc.ClientConnInterface) AnagrammerClient {
+	return &anagrammerClient{cc}
+}
+
+func (c *anagrammerClient) Anagram(ctx context.Context, in *AnagramRequest, opts ...grpc.CallOption) (*AnagramResponse, error) {
+	out := new(AnagramResponse)
+	err := c.cc.Invoke(ctx, Anagrammer_Anagram_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *anagrammerClient) BlankChallengeCreator(ctx context.Context, in *BlankChallengeCreateRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, Anagrammer_BlankChallengeCreator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *anagrammerClient) BuildChallengeCreator(ctx context.Context, in *BuildChallengeCreateRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, Anagrammer_BuildChallengeCreator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *anagrammerClient) Judge(ctx context.Context, in *WordJudgeRequest, opts ...grpc.CallOption) (*WordJudgeResponse, error) {
+	out := new(WordJudgeResponse)
+	err := c.cc.Invoke(ctx, Anagrammer_Judge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *anagrammerClient) HookTree(ctx context.Context, in *HookTreeRequest, opts ...grpc.CallOption) (*HookTreeResponse, error) {
+	out := new(HookTreeResponse)
+	err := c.cc.Invoke(ctx, Anagrammer_HookTree_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AnagrammerServer is the server API for Anagrammer service.
+// All implementations should embed UnimplementedAnagrammerServer
+// for forward compatibility
+type AnagrammerServer interface {
+	// Anagram does a simple anagram search; it can either be
+	// build mode or regular (exact) mode.
+	Anagram(context.Context, *AnagramRequest) (*AnagramResponse, error)
+	// BlankChallengeCreator creates blank challenges for Aerolith
+	BlankChallengeCreator(context.Context, *BlankChallengeCreateRequest) (*SearchResponse, error)
+	// BuildChallengeCreator creates build challenges for Aerolith.
+	BuildChallengeCreator(context.Context, *BuildChallengeCreateRequest) (*SearchResponse, error)
+	// Judge adjudicates a play: it says which of its words are valid, and
+	// whether the play is acceptable as a whole.
+	Judge(context.Context, *WordJudgeRequest) (*WordJudgeResponse, error)
+	// HookTree returns a word's hooks, their hooks, and so on, for showing
+	// how short words extend into long ones.
+	HookTree(context.Context, *HookTreeRequest) (*HookTreeResponse, error)
+}
+
+// UnimplementedAnagrammerServer should be embedded to have forward compatible implementations.
+type UnimplementedAnagrammerServer struct {
+}
+
+func (UnimplementedAnagrammerServer) Anagram(context.Context, *AnagramRequest) (*AnagramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Anagram not implemented")
+}
+func (UnimplementedAnagrammerServer) BlankChallengeCreator(context.Context, *BlankChallengeCreateRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlankChallengeCreator not implemented")
+}
+func (UnimplementedAnagrammerServer) BuildChallengeCreator(context.Context, *BuildChallengeCreateRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuildChallengeCreator not implemented")
+}
+func (UnimplementedAnagrammerServer) Judge(context.Context, *WordJudgeRequest) (*WordJudgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Judge not implemented")
+}
+func (UnimplementedAnagrammerServer) HookTree(context.Context, *HookTreeRequest) (*HookTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HookTree not implemented")
+}
+
+// UnsafeAnagrammerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AnagrammerServer will
+// result in compilation errors.
+type UnsafeAnagrammerServer interface {
+	mustEmbedUnimplementedAnagrammerServer()
+}
+
+func RegisterAnagrammerServer(s grpc.ServiceRegistrar, srv AnagrammerServer) {
+	s.RegisterService(&Anagrammer_ServiceDesc, srv)
+}
+
+func _Anagrammer_Anagram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnagramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnagrammerServer).Anagram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Anagrammer_Anagram_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnagrammerServer).Anagram(ctx, req.(*AnagramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Anagrammer_BlankChallengeCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlankChallengeCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnagrammerServer).BlankChallengeCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Anagrammer_BlankChallengeCreator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnagrammerServer).BlankChallengeCreator(ctx, req.(*BlankChallengeCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Anagrammer_BuildChallengeCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildChallengeCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnagrammerServer).BuildChallengeCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Anagrammer_BuildChallengeCreator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnagrammerServer).BuildChallengeCreator(ctx, req.(*BuildChallengeCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Anagrammer_Judge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WordJudgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnagrammerServer).Judge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Anagrammer_Judge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnagrammerServer).Judge(ctx, req.(*WordJudgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Anagrammer_HookTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HookTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnagrammerServer).HookTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Anagrammer_HookTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnagrammerServer).HookTree(ctx, req.(*HookTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Anagrammer_ServiceDesc is the grpc.ServiceDesc for Anagrammer service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Anagrammer_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wordsearcher.Anagrammer",
+	HandlerType: (*AnagrammerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Anagram",
+			Handler:    _Anagrammer_Anagram_Handler,
+		},
+		{
+			MethodName: "BlankChallengeCreator",
+			Handler:    _Anagrammer_BlankChallengeCreator_Handler,
+		},
+		{
+			MethodName: "BuildChallengeCreator",
+			Handler:    _Anagrammer_BuildChallengeCreator_Handler,
+		},
+		{
+			MethodName: "Judge",
+			Handler:    _Anagrammer_Judge_Handler,
+		},
+		{
+			MethodName: "HookTree",
+			Handler:    _Anagrammer_HookTree_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "wordsearcher/searcher.proto",
+}
+
+const (
+	WordSearcher_GetWordInformation_FullMethodName = "/wordsearcher.WordSearcher/GetWordInformation"
+	WordSearcher_WordSearch_FullMethodName         = "/wordsearcher.WordSearcher/WordSearch"
+	WordSearcher_Suggest_FullMethodName            = "/wordsearcher.WordSearcher/Suggest"
+	WordSearcher_Neighbors_FullMethodName          = "/wordsearcher.WordSearcher/Neighbors"
+)
+
+// WordSearcherClient is the client API for WordSearcher service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WordSearcherClient interface {
+	GetWordInformation(ctx context.Context, in *DefineRequest, opts ...grpc.CallOption) (*WordSearchResponse, error)
+	WordSearch(ctx context.Context, in *WordSearchRequest, opts ...grpc.CallOption) (*WordSearchResponse, error)
+	// Suggest returns "did you mean" suggestions for a word that may be
+	// misspelled.
+	Suggest(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*SuggestResponse, error)
+	// Neighbors returns the alphagrams that are one tile away from an
+	// alphagram, for exploring the lexicon.
+	Neighbors(ctx context.Context, in *NeighborsRequest, opts ...grpc.CallOption) (*NeighborsResponse, error)
+}
+
+type wordSearcherClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWordSearcherClient(cc grpc.ClientConnInterface) WordSearcherClient {
+	return &wordSearcherClient{cc}
+}
+
+func (c *wordSearcherClient) GetWordInformation(ctx context.Context, in *DefineRequest, opts ...grpc.CallOption) (*WordSearchResponse, error) {
+	out := new(WordSearchResponse)
+	err := c.cc.Invoke(ctx, WordSearcher_GetWordInformation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearcherClient) WordSearch(ctx context.Context, in *WordSearchRequest, opts ...grpc.CallOption) (*WordSearchResponse, error) {
+	out := new(WordSearchResponse)
+	err := c.cc.Invoke(ctx, WordSearcher_WordSearch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearcherClient) Suggest(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*SuggestResponse, error) {
+	out := new(SuggestResponse)
+	err := c.cc.Invoke(ctx, WordSearcher_Suggest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordSearcherClient) Neighbors(ctx context.Context, in *NeighborsRequest, opts ...grpc.CallOption) (*NeighborsResponse, error) {
+	out := new(NeighborsResponse)
+	err := c.cc.Invoke(ctx, WordSearcher_Neighbors_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WordSearcherServer is the server API for WordSearcher service.
+// All implementations should embed UnimplementedWordSearcherServer
+// for forward compatibility
+type WordSearcherServer interface {
+	GetWordInformation(context.Context, *DefineRequest) (*WordSearchResponse, error)
+	WordSearch(context.Context, *WordSearchRequest) (*WordSearchResponse, error)
+	// Suggest returns "did you mean" suggestions for a word that may be
+	// misspelled.
+	Suggest(context.Context, *SuggestRequest) (*SuggestResponse, error)
+	// Neighbors returns the alphagrams that are one tile away from an
+	// alphagram, for exploring the lexicon.
+	Neighbors(context.Context, *NeighborsRequest) (*NeighborsResponse, error)
+}
+
+// UnimplementedWordSearcherServer should be embedded to have forward compatible implementations.
+type UnimplementedWordSearcherServer struct {
+}
+
+func (UnimplementedWordSearcherServer) GetWordInformation(context.Context, *DefineRequest) (*WordSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWordInformation not implemented")
+}
+func (UnimplementedWordSearcherServer) WordSearch(context.Context, *WordSearchRequest) (*WordSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WordSearch not implemented")
+}
+func (UnimplementedWordSearcherServer) Suggest(context.Context, *SuggestRequest) (*SuggestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Suggest not implemented")
+}
+func (UnimplementedWordSearcherServer) Neighbors(context.Context, *NeighborsRequest) (*NeighborsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Neighbors not implemented")
+}
+
+// UnsafeWordSearcherServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WordSearcherServer will
+// result in compilation errors.
+type UnsafeWordSearcherServer interface {
+	mustEmbedUnimplementedWordSearcherServer()
+}
+
+func RegisterWordSearcherServer(s grpc.ServiceRegistrar, srv WordSearcherServer) {
+	s.RegisterService(&WordSearcher_ServiceDesc, srv)
+}
+
+func _WordSearcher_GetWordInformation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DefineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearcherServer).GetWordInformation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WordSearcher_GetWordInformation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearcherServer).GetWordInformation(ctx, req.(*DefineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearcher_WordSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WordSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearcherServer).WordSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WordSearcher_WordSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearcherServer).WordSearch(ctx, req.(*WordSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearcher_Suggest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearcherServer).Suggest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WordSearcher_Suggest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearcherServer).Suggest(ctx, req.(*SuggestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordSearcher_Neighbors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NeighborsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordSearcherServer).Neighbors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WordSearcher_Neighbors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordSearcherServer).Neighbors(ctx, req.(*NeighborsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WordSearcher_ServiceDesc is the grpc.ServiceDesc for WordSearcher service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WordSearcher_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wordsearcher.WordSearcher",
+	HandlerType: (*WordSearcherServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetWordInformation",
+			Handler:    _WordSearcher_GetWordInformation_Handler,
+		},
+		{
+			MethodName: "WordSearch",
+			Handler:    _WordSearcher_WordSearch_Handler,
+		},
+		{
+			MethodName: "Suggest",
+			Handler:    _WordSearcher_Suggest_Handler,
+		},
+		{
+			MethodName: "Neighbors",
+			Handler:    _WordSearcher_Neighbors_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "wordsearcher/searcher.proto",
+}
+
+const (
+	LexiconInfo_GetLexiconMetadata_FullMethodName    = "/wordsearcher.LexiconInfo/GetLexiconMetadata"
+	LexiconInfo_ValidateRack_FullMethodName          = "/wordsearcher.LexiconInfo/ValidateRack"
+	LexiconInfo_GetLetterDistribution_FullMethodName = "/wordsearcher.LexiconInfo/GetLetterDistribution"
+	LexiconInfo_GetSchemaInfo_FullMethodName         = "/wordsearcher.LexiconInfo/GetSchemaInfo"
+	LexiconInfo_GetSearchSchema_FullMethodName       = "/wordsearcher.LexiconInfo/GetSearchSchema"
+	LexiconInfo_Stats_FullMethodName                 = "/wordsearcher.LexiconInfo/Stats"
+)
+
+// LexiconInfoClient is the client API for LexiconInfo service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LexiconInfoClient interface {
+	// GetLexiconMetadata returns the family, word counts, letter distribution
+	// and so on for a lexicon.
+	GetLexiconMetadata(ctx context.Context, in *LexiconMetadataRequest, opts ...grpc.CallOption) (*LexiconMetadata, error)
+	// ValidateRack checks whether a rack can be drawn from the lexicon's
+	// letter distribution.
+	ValidateRack(ctx context.Context, in *RackValidationRequest, opts ...grpc.CallOption) (*RackValidationResponse, error)
+	// GetLetterDistribution returns the tiles, their counts and their scores
+	// of the lexicon's letter distribution.
+	GetLetterDistribution(ctx context.Context, in *LetterDistributionRequest, opts ...grpc.CallOption) (*LetterDistribution, error)
+	// GetSchemaInfo returns the schema version, migration history and
+	// columns of the lexicon databases.
+	GetSchemaInfo(ctx context.Context, in *SchemaInfoRequest, opts ...grpc.CallOption) (*SchemaInfoResponse, error)
+	// GetSearchSchema describes the search conditions and the parameters
+	// they take.
+	GetSearchSchema(ctx context.Context, in *SearchSchemaRequest, opts ...grpc.CallOption) (*SearchSchema, error)
+	// Stats returns the counts of the lexicon's alphagrams and words by
+	// length, and how many alphagrams have each number of anagrams.
+	Stats(ctx context.Context, in *LexiconStatsRequest, opts ...grpc.CallOption) (*LexiconStats, error)
+}
+
+type lexiconInfoClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLexiconInfoClient(cc grpc.ClientConnInterface) LexiconInfoClient {
+	return &lexiconInfoClient{cc}
+}
+
+func (c *lexiconInfoClient) GetLexiconMetadata(ctx context.Context, in *LexiconMetadataRequest, opts ...grpc.CallOption) (*LexiconMetadata, error) {
+	out := new(LexiconMetadata)
+	err := c.cc.Invoke(ctx, LexiconInfo_GetLexiconMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lexiconInfoClient) ValidateRack(ctx context.Context, in *RackValidationRequest, opts ...grpc.CallOption) (*RackValidationResponse, error) {
+	out := new(RackValidationResponse)
+	err := c.cc.Invoke(ctx, LexiconInfo_ValidateRack_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lexiconInfoClient) GetLetterDistribution(ctx context.Context, in *LetterDistributionRequest, opts ...grpc.CallOption) (*LetterDistribution, error) {
+	out := new(LetterDistribution)
+	err := c.cc.Invoke(ctx, LexiconInfo_GetLetterDistribution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lexiconInfoClient) GetSchemaInfo(ctx context.Context, in *SchemaInfoRequest, opts ...grpc.CallOption) (*SchemaInfoResponse, error) {
+	out := new(SchemaInfoResponse)
+	err := c.cc.Invoke(ctx, LexiconInfo_GetSchemaInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lexiconInfoClient) GetSearchSchema(ctx context.Context, in *SearchSchemaRequest, opts ...grpc.CallOption) (*SearchSchema, error) {
+	out := new(SearchSchema)
+	err := c.cc.Invoke(ctx, LexiconInfo_GetSearchSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lexiconInfoClient) Stats(ctx context.Context, in *LexiconStatsRequest, opts ...grpc.CallOption) (*LexiconStats, error) {
+	out := new(LexiconStats)
+	err := c.cc.Invoke(ctx, LexiconInfo_Stats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LexiconInfoServer is the server API for LexiconInfo service.
+// All implementations should embed UnimplementedLexiconInfoServer
+// for forward compatibility
+type LexiconInfoServer interface {
+	// GetLexiconMetadata returns the family, word counts, letter distribution
+	// and so on for a lexicon.
+	GetLexiconMetadata(context.Context, *LexiconMetadataRequest) (*LexiconMetadata, error)
+	// ValidateRack checks whether a rack can be drawn from the lexicon's
+	// letter distribution.
+	ValidateRack(context.Context, *RackValidationRequest) (*RackValidationResponse, error)
+	// GetLetterDistribution returns the tiles, their counts and their scores
+	// of the lexicon's letter distribution.
+	GetLetterDistribution(context.Context, *LetterDistributionRequest) (*LetterDistribution, error)
+	// GetSchemaInfo returns the schema version, migration history and
+	// columns of the lexicon databases.
+	GetSchemaInfo(context.Context, *SchemaInfoRequest) (*SchemaInfoResponse, error)
+	// GetSearchSchema describes the search conditions and the parameters
+	// they take.
+	GetSearchSchema(context.Context, *SearchSchemaRequest) (*SearchSchema, error)
+	// Stats returns the counts of the lexicon's alphagrams and words by
+	// length, and how many alphagrams have each number of anagrams.
+	Stats(context.Context, *LexiconStatsRequest) (*LexiconStats, error)
+}
+
+// UnimplementedLexiconInfoServer should be embedded to have forward compatible implementations.
+type UnimplementedLexiconInfoServer struct {
+}
+
+func (UnimplementedLexiconInfoServer) GetLexiconMetadata(context.Context, *LexiconMetadataRequest) (*LexiconMetadata, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLexiconMetadata not implemented")
+}
+func (UnimplementedLexiconInfoServer) ValidateRack(context.Context, *RackValidationRequest) (*RackValidationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateRack not implemented")
+}
+func (UnimplementedLexiconInfoServer) GetLetterDistribution(context.Context, *LetterDistributionRequest) (*LetterDistribution, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLetterDistribution not implemented")
+}
+func (UnimplementedLexiconInfoServer) GetSchemaInfo(context.Context, *SchemaInfoRequest) (*SchemaInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchemaInfo not implemented")
+}
+func (UnimplementedLexiconInfoServer) GetSearchSchema(context.Context, *SearchSchemaRequest) (*SearchSchema, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSearchSchema not implemented")
+}
+func (UnimplementedLexiconInfoServer) Stats(context.Context, *LexiconStatsRequest) (*LexiconStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+
+// UnsafeLexiconInfoServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LexiconInfoServer will
+// result in compilation errors.
+type UnsafeLexiconInfoServer interface {
+	mustEmbedUnimplementedLexiconInfoServer()
+}
+
+func RegisterLexiconInfoServer(s grpc.ServiceRegistrar, srv LexiconInfoServer) {
+	s.RegisterService(&LexiconInfo_ServiceDesc, srv)
+}
+
+func _LexiconInfo_GetLexiconMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LexiconMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LexiconInfoServer).GetLexiconMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LexiconInfo_GetLexiconMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LexiconInfoServer).GetLexiconMetadata(ctx, req.(*LexiconMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LexiconInfo_ValidateRack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RackValidationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LexiconInfoServer).ValidateRack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LexiconInfo_ValidateRack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LexiconInfoServer).ValidateRack(ctx, req.(*RackValidationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LexiconInfo_GetLetterDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LetterDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LexiconInfoServer).GetLetterDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LexiconInfo_GetLetterDistribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LexiconInfoServer).GetLetterDistribution(ctx, req.(*LetterDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LexiconInfo_GetSchemaInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LexiconInfoServer).GetSchemaInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LexiconInfo_GetSchemaInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LexiconInfoServer).GetSchemaInfo(ctx, req.(*SchemaInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LexiconInfo_GetSearchSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LexiconInfoServer).GetSearchSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LexiconInfo_GetSearchSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LexiconInfoServer).GetSearchSchema(ctx, req.(*SearchSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LexiconInfo_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LexiconStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LexiconInfoServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LexiconInfo_Stats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LexiconInfoServer).Stats(ctx, req.(*LexiconStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LexiconInfo_ServiceDesc is the grpc.ServiceDesc for LexiconInfo service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LexiconInfo_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wordsearcher.LexiconInfo",
+	HandlerType: (*LexiconInfoServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetLexiconMetadata",
+			Handler:    _LexiconInfo_GetLexiconMetadata_Handler,
+		},
+		{
+			MethodName: "ValidateRack",
+			Handler:    _LexiconInfo_ValidateRack_Handler,
+		},
+		{
+			MethodName: "GetLetterDistribution",
+			Handler:    _LexiconInfo_GetLetterDistribution_Handler,
+		},
+		{
+			MethodName: "GetSchemaInfo",
+			Handler:    _LexiconInfo_GetSchemaInfo_Handler,
+		},
+		{
+			MethodName: "GetSearchSchema",
+			Handler:    _LexiconInfo_GetSearchSchema_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _LexiconInfo_Stats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "wordsearcher/searcher.proto",
+}
+
+const (
+	Admin_UpdateDefinitions_FullMethodName = "/wordsearcher.Admin/UpdateDefinitions"
+	Admin_Checkpoint_FullMethodName        = "/wordsearcher.Admin/Checkpoint"
+)
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminClient interface {
+	// UpdateDefinitions applies a batch of definition corrections to a
+	// lexicon database in one transaction.
+	UpdateDefinitions(ctx context.Context, in *DefinitionUpdateRequest, opts ...grpc.CallOption) (*DefinitionUpdateResponse, error)
+	// Checkpoint writes a lexicon database's WAL back into the database.
+	Checkpoint(ctx context.Context, in *CheckpointRequest, opts ...grpc.CallOption) (*CheckpointResponse, error)
+}
+
+type adminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminClient(cc grpc.ClientConnInterface) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) UpdateDefinitions(ctx context.Context, in *DefinitionUpdateRequest, opts ...grpc.CallOption) (*DefinitionUpdateResponse, error) {
+	out := new(DefinitionUpdateResponse)
+	err := c.cc.Invoke(ctx, Admin_UpdateDefinitions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) Checkpoint(ctx context.Context, in *CheckpointRequest, opts ...grpc.CallOption) (*CheckpointResponse, error) {
+	out := new(CheckpointResponse)
+	err := c.cc.Invoke(ctx, Admin_Checkpoint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+// All implementations should embed UnimplementedAdminServer
+// for forward compatibility
+type AdminServer interface {
+	// UpdateDefinitions applies a batch of definition corrections to a
+	// lexicon database in one transaction.
+	UpdateDefinitions(context.Context, *DefinitionUpdateRequest) (*DefinitionUpdateResponse, error)
+	// Checkpoint writes a lexicon database's WAL back into the database.
+	Checkpoint(context.Context, *CheckpointRequest) (*CheckpointResponse, error)
+}
+
+// UnimplementedAdminServer should be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
+}
+
+func (UnimplementedAdminServer) UpdateDefinitions(context.Context, *DefinitionUpdateRequest) (*DefinitionUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDefinitions not implemented")
+}
+func (UnimplementedAdminServer) Checkpoint(context.Context, *CheckpointRequest) (*CheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Checkpoint not implemented")
+}
+
+// UnsafeAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServer will
+// result in compilation errors.
+type UnsafeAdminServer interface {
+	mustEmbedUnimplementedAdminServer()
+}
+
+func RegisterAdminServer(s grpc.ServiceRegistrar, srv AdminServer) {
+	s.RegisterService(&Admin_ServiceDesc, srv)
+}
+
+func _Admin_UpdateDefinitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DefinitionUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).UpdateDefinitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_UpdateDefinitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).UpdateDefinitions(ctx, req.(*DefinitionUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_Checkpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Checkpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_Checkpoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Checkpoint(ctx, req.(*CheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Admin_ServiceDesc is the grpc.ServiceDesc for Admin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Admin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wordsearcher.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateDefinitions",
+			Handler:    _Admin_UpdateDefinitions_Handler,
+		},
+		{
+			MethodName: "Checkpoint",
+			Handler:    _Admin_Checkpoint_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "wordsearcher/searcher.proto",
+}
+
+const (
+	QuizScheduler_CreateCardbox_FullMethodName    = "/wordsearcher.QuizScheduler/CreateCardbox"
+	QuizScheduler_RecordAnswer_FullMethodName     = "/wordsearcher.QuizScheduler/RecordAnswer"
+	QuizScheduler_DueCards_FullMethodName         = "/wordsearcher.QuizScheduler/DueCards"
+	QuizScheduler_CombineLists_FullMethodName     = "/wordsearcher.QuizScheduler/CombineLists"
+	QuizScheduler_ImportHistory_FullMethodName    = "/wordsearcher.QuizScheduler/ImportHistory"
+	QuizScheduler_DeleteCardbox_FullMethodName    = "/wordsearcher.QuizScheduler/DeleteCardbox"
+	QuizScheduler_RestoreCardbox_FullMethodName   = "/wordsearcher.QuizScheduler/RestoreCardbox"
+	QuizScheduler_DeletedCardboxes_FullMethodName = "/wordsearcher.QuizScheduler/DeletedCardboxes"
+)
+
+// QuizSchedulerClient is the client API for QuizScheduler service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QuizSchedulerClient interface {
+	// CreateCardbox creates a cardbox from the results of a search, with
+	// every card due now.
+	CreateCardbox(ctx context.Context, in *CreateCardboxRequest, opts ...grpc.CallOption) (*CreateCardboxResponse, error)
+	// RecordAnswer moves a card up a box if it was answered correctly, or
+	// back to the first box if not, and schedules it accordingly.
+	RecordAnswer(ctx context.Context, in *RecordAnswerRequest, opts ...grpc.CallOption) (*RecordAnswerResponse, error)
+	// DueCards returns the cards of a cardbox that are due for review.
+	DueCards(ctx context.Context, in *DueCardsRequest, opts ...grpc.CallOption) (*DueCardsResponse, error)
+	// CombineLists computes the union, intersection or difference of
+	// searches, cardboxes and alphagram lists, e.g. a cardbox minus the
+	// results of this week's challenge. The result can be saved as a new
+	// cardbox.
+	CombineLists(ctx context.Context, in *CombineListsRequest, opts ...grpc.CallOption) (*CombineListsResponse, error)
+	// ImportHistory creates a cardbox from quiz history exported from
+	// another tool, keeping each question's box and due date.
+	ImportHistory(ctx context.Context, in *ImportHistoryRequest, opts ...grpc.CallOption) (*ImportHistoryResponse, error)
+	// DeleteCardbox deletes a cardbox. It can be restored for a while, the
+	// server's retention window, before it's gone for good.
+	DeleteCardbox(ctx context.Context, in *DeleteCardboxRequest, opts ...grpc.CallOption) (*DeleteCardboxResponse, error)
+	// RestoreCardbox restores a deleted cardbox, with its cards as they were.
+	RestoreCardbox(ctx context.Context, in *RestoreCardboxRequest, opts ...grpc.CallOption) (*RestoreCardboxResponse, error)
+	// DeletedCardboxes lists the user's deleted cardboxes that can still be
+	// restored.
+	DeletedCardboxes(ctx context.Context, in *DeletedCardboxesRequest, opts ...grpc.CallOption) (*DeletedCardboxesResponse, error)
+}
+
+type quizSchedulerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQuizSchedulerClient(cc grpc.ClientConnInterface) QuizSchedulerClient {
+	return &quizSchedulerClient{cc}
+}
+
+func (c *quizSchedulerClient) CreateCardbox(ctx context.Context, in *CreateCardboxRequest, opts ...grpc.CallOption) (*CreateCardboxResponse, error) {
+	out := new(CreateCardboxResponse)
+	err := c.cc.Invoke(ctx, QuizScheduler_CreateCardbox_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizSchedulerClient) RecordAnswer(ctx context.Context, in *RecordAnswerRequest, opts ...grpc.CallOption) (*RecordAnswerResponse, error) {
+	out := new(RecordAnswerResponse)
+	err := c.cc.Invoke(ctx, QuizScheduler_RecordAnswer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizSchedulerClient) DueCards(ctx context.Context, in *DueCardsRequest, opts ...grpc.CallOption) (*DueCardsResponse, error) {
+	out := new(DueCardsResponse)
+	err := c.cc.Invoke(ctx, QuizScheduler_DueCards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizSchedulerClient) CombineLists(ctx context.Context, in *CombineListsRequest, opts ...grpc.CallOption) (*CombineListsResponse, error) {
+	out := new(CombineListsResponse)
+	err := c.cc.Invoke(ctx, QuizScheduler_CombineLists_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizSchedulerClient) ImportHistory(ctx context.Context, in *ImportHistoryRequest, opts ...grpc.CallOption) (*ImportHistoryResponse, error) {
+	out := new(ImportHistoryResponse)
+	err := c.cc.Invoke(ctx, QuizScheduler_ImportHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizSchedulerClient) DeleteCardbox(ctx context.Context, in *DeleteCardboxRequest, opts ...grpc.CallOption) (*DeleteCardboxResponse, error) {
+	out := new(DeleteCardboxResponse)
+	err := c.cc.Invoke(ctx, QuizScheduler_DeleteCardbox_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizSchedulerClient) RestoreCardbox(ctx context.Context, in *RestoreCardboxRequest, opts ...grpc.CallOption) (*RestoreCardboxResponse, error) {
+	out := new(RestoreCardboxResponse)
+	err := c.cc.Invoke(ctx, QuizScheduler_RestoreCardbox_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizSchedulerClient) DeletedCardboxes(ctx context.Context, in *DeletedCardboxesRequest, opts ...grpc.CallOption) (*DeletedCardboxesResponse, error) {
+	out := new(DeletedCardboxesResponse)
+	err := c.cc.Invoke(ctx, QuizScheduler_DeletedCardboxes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuizSchedulerServer is the server API for QuizScheduler service.
+// All implementations should embed UnimplementedQuizSchedulerServer
+// for forward compatibility
+type QuizSchedulerServer interface {
+	// CreateCardbox creates a cardbox from the results of a search, with
+	// every card due now.
+	CreateCardbox(context.Context, *CreateCardboxRequest) (*CreateCardboxResponse, error)
+	// RecordAnswer moves a card up a box if it was answered correctly, or
+	// back to the first box if not, and schedules it accordingly.
+	RecordAnswer(context.Context, *RecordAnswerRequest) (*RecordAnswerResponse, error)
+	// DueCards returns the cards of a cardbox that are due for review.
+	DueCards(context.Context, *DueCardsRequest) (*DueCardsResponse, error)
+	// CombineLists computes the union, intersection or difference of
+	// searches, cardboxes and alphagram lists, e.g. a cardbox minus the
+	// results of this week's challenge. The result can be saved as a new
+	// cardbox.
+	CombineLists(context.Context, *CombineListsRequest) (*CombineListsResponse, error)
+	// ImportHistory creates a cardbox from quiz history exported from
+	// another tool, keeping each question's box and due date.
+	ImportHistory(context.Context, *ImportHistoryRequest) (*ImportHistoryResponse, error)
+	// DeleteCardbox deletes a cardbox. It can be restored for a while, the
+	// server's retention window, before it's gone for good.
+	DeleteCardbox(context.Context, *DeleteCardboxRequest) (*DeleteCardboxResponse, error)
+	// RestoreCardbox restores a deleted cardbox, with its cards as they were.
+	RestoreCardbox(context.Context, *RestoreCardboxRequest) (*RestoreCardboxResponse, error)
+	// DeletedCardboxes lists the user's deleted cardboxes that can still be
+	// restored.
+	DeletedCardboxes(context.Context, *DeletedCardboxesRequest) (*DeletedCardboxesResponse, error)
+}
+
+// UnimplementedQuizSchedulerServer should be embedded to have forward compatible implementations.
+type UnimplementedQuizSchedulerServer struct {
+}
+
+func (UnimplementedQuizSchedulerServer) CreateCardbox(context.Context, *CreateCardboxRequest) (*CreateCardboxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCardbox not implemented")
+}
+func (UnimplementedQuizSchedulerServer) RecordAnswer(context.Context, *RecordAnswerRequest) (*RecordAnswerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordAnswer not implemented")
+}
+func (UnimplementedQuizSchedulerServer) DueCards(context.Context, *DueCardsRequest) (*DueCardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DueCards not implemented")
+}
+func (UnimplementedQuizSchedulerServer) CombineLists(context.Context, *CombineListsRequest) (*CombineListsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CombineLists not implemented")
+}
+func (UnimplementedQuizSchedulerServer) ImportHistory(context.Context, *ImportHistoryRequest) (*ImportHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportHistory not implemented")
+}
+func (UnimplementedQuizSchedulerServer) DeleteCardbox(context.Context, *DeleteCardboxRequest) (*DeleteCardboxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCardbox not implemented")
+}
+func (UnimplementedQuizSchedulerServer) RestoreCardbox(context.Context, *RestoreCardboxRequest) (*RestoreCardboxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCardbox not implemented")
+}
+func (UnimplementedQuizSchedulerServer) DeletedCardboxes(context.Context, *DeletedCardboxesRequest) (*DeletedCardboxesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletedCardboxes not implemented")
+}
+
+// UnsafeQuizSchedulerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QuizSchedulerServer will
+// result in compilation errors.
+type UnsafeQuizSchedulerServer interface {
+	mustEmbedUnimplementedQuizSchedulerServer()
+}
+
+func RegisterQuizSchedulerServer(s grpc.ServiceRegistrar, srv QuizSchedulerServer) {
+	s.RegisterService(&QuizScheduler_ServiceDesc, srv)
+}
+
+func _QuizScheduler_CreateCardbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCardboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizSchedulerServer).CreateCardbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizScheduler_CreateCardbox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizSchedulerServer).CreateCardbox(ctx, req.(*CreateCardboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizScheduler_RecordAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizSchedulerServer).RecordAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizScheduler_RecordAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizSchedulerServer).RecordAnswer(ctx, req.(*RecordAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizScheduler_DueCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DueCardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizSchedulerServer).DueCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizScheduler_DueCards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizSchedulerServer).DueCards(ctx, req.(*DueCardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizScheduler_CombineLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CombineListsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizSchedulerServer).CombineLists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizScheduler_CombineLists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizSchedulerServer).CombineLists(ctx, req.(*CombineListsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizScheduler_ImportHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizSchedulerServer).ImportHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizScheduler_ImportHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizSchedulerServer).ImportHistory(ctx, req.(*ImportHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizScheduler_DeleteCardbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCardboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizSchedulerServer).DeleteCardbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizScheduler_DeleteCardbox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizSchedulerServer).DeleteCardbox(ctx, req.(*DeleteCardboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizScheduler_RestoreCardbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCardboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizSchedulerServer).RestoreCardbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizScheduler_RestoreCardbox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizSchedulerServer).RestoreCardbox(ctx, req.(*RestoreCardboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizScheduler_DeletedCardboxes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletedCardboxesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizSchedulerServer).DeletedCardboxes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizScheduler_DeletedCardboxes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizSchedulerServer).DeletedCardboxes(ctx, req.(*DeletedCardboxesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QuizScheduler_ServiceDesc is the grpc.ServiceDesc for QuizScheduler service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QuizScheduler_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wordsearcher.QuizScheduler",
+	HandlerType: (*QuizSchedulerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCardbox",
+			Handler:    _QuizScheduler_CreateCardbox_Handler,
+		},
+		{
+			MethodName: "RecordAnswer",
+			Handler:    _QuizScheduler_RecordAnswer_Handler,
+		},
+		{
+			MethodName: "DueCards",
+			Handler:    _QuizScheduler_DueCards_Handler,
+		},
+		{
+			MethodName: "CombineLists",
+			Handler:    _QuizScheduler_CombineLists_Handler,
+		},
+		{
+			MethodName: "ImportHistory",
+			Handler:    _QuizScheduler_ImportHistory_Handler,
+		},
+		{
+			MethodName: "DeleteCardbox",
+			Handler:    _QuizScheduler_DeleteCardbox_Handler,
+		},
+		{
+			MethodName: "RestoreCardbox",
+			Handler:    _QuizScheduler_RestoreCardbox_Handler,
+		},
+		{
+			MethodName: "DeletedCardboxes",
+			Handler:    _QuizScheduler_DeletedCardboxes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "wordsearcher/searcher.proto",
+}
+
+const (
+	SavedSearches_SaveSearch_FullMethodName        = "/wordsearcher.SavedSearches/SaveSearch"
+	SavedSearches_ListSavedSearches_FullMethodName = "/wordsearcher.SavedSearches/ListSavedSearches"
+	SavedSearches_RunSavedSearch_FullMethodName    = "/wordsearcher.SavedSearches/RunSavedSearch"
+	SavedSearches_DeleteSavedSearch_FullMethodName = "/wordsearcher.SavedSearches/DeleteSavedSearch"
+)
+
+// SavedSearchesClient is the client API for SavedSearches service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SavedSearchesClient interface {
+	// SaveSearch saves a search under a name, replacing the user's search of
+	// that name, if any.
+	SaveSearch(ctx context.Context, in *SaveSearchRequest, opts ...grpc.CallOption) (*SaveSearchResponse, error)
+	// ListSavedSearches lists the user's saved searches.
+	ListSavedSearches(ctx context.Context, in *ListSavedSearchesRequest, opts ...grpc.CallOption) (*ListSavedSearchesResponse, error)
+	// RunSavedSearch runs one of the user's saved searches.
+	RunSavedSearch(ctx context.Context, in *RunSavedSearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// DeleteSavedSearch deletes one of the user's saved searches.
+	DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*DeleteSavedSearchResponse, error)
+}
+
+type savedSearchesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSavedSearchesClient(cc grpc.ClientConnInterface) SavedSearchesClient {
+	return &savedSearchesClient{cc}
+}
+
+func (c *savedSearchesClient) SaveSearch(ctx context.Context, in *SaveSearchRequest, opts ...grpc.CallOption) (*SaveSearchResponse, error) {
+	out := new(SaveSearchResponse)
+	err := c.cc.Invoke(ctx, SavedSearches_SaveSearch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedSearchesClient) ListSavedSearches(ctx context.Context, in *ListSavedSearchesRequest, opts ...grpc.CallOption) (*ListSavedSearchesResponse, error) {
+	out := new(ListSavedSearchesResponse)
+	err := c.cc.Invoke(ctx, SavedSearches_ListSavedSearches_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedSearchesClient) RunSavedSearch(ctx context.Context, in *RunSavedSearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, SavedSearches_RunSavedSearch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedSearchesClient) DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*DeleteSavedSearchResponse, error) {
+	out := new(DeleteSavedSearchResponse)
+	err := c.cc.Invoke(ctx, SavedSearches_DeleteSavedSearch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SavedSearchesServer is the server API for SavedSearches service.
+// All implementations should embed UnimplementedSavedSearchesServer
+// for forward compatibility
+type SavedSearchesServer interface {
+	// SaveSearch saves a search under a name, replacing the user's search of
+	// that name, if any.
+	SaveSearch(context.Context, *SaveSearchRequest) (*SaveSearchResponse, error)
+	// ListSavedSearches lists the user's saved searches.
+	ListSavedSearches(context.Context, *ListSavedSearchesRequest) (*ListSavedSearchesResponse, error)
+	// RunSavedSearch runs one of the user's saved searches.
+	RunSavedSearch(context.Context, *RunSavedSearchRequest) (*SearchResponse, error)
+	// DeleteSavedSearch deletes one of the user's saved searches.
+	DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error)
+}
+
+// UnimplementedSavedSearchesServer should be embedded to have forward compatible implementations.
+type UnimplementedSavedSearchesServer struct {
+}
+
+func (UnimplementedSavedSearchesServer) SaveSearch(context.Context, *SaveSearchRequest) (*SaveSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveSearch not implemented")
+}
+func (UnimplementedSavedSearchesServer) ListSavedSearches(context.Context, *ListSavedSearchesRequest) (*ListSavedSearchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSavedSearches not implemented")
+}
+func (UnimplementedSavedSearchesServer) RunSavedSearch(context.Context, *RunSavedSearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunSavedSearch not implemented")
+}
+func (UnimplementedSavedSearchesServer) DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSavedSearch not implemented")
+}
+
+// UnsafeSavedSearchesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SavedSearchesServer will
+// result in compilation errors.
+type UnsafeSavedSearchesServer interface {
+	mustEmbedUnimplementedSavedSearchesServer()
+}
+
+func RegisterSavedSearchesServer(s grpc.ServiceRegistrar, srv SavedSearchesServer) {
+	s.RegisterService(&SavedSearches_ServiceDesc, srv)
+}
+
+func _SavedSearches_SaveSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchesServer).SaveSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearches_SaveSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchesServer).SaveSearch(ctx, req.(*SaveSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SavedSearches_ListSavedSearches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedSearchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchesServer).ListSavedSearches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearches_ListSavedSearches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchesServer).ListSavedSearches(ctx, req.(*ListSavedSearchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SavedSearches_RunSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchesServer).RunSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearches_RunSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchesServer).RunSavedSearch(ctx, req.(*RunSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SavedSearches_DeleteSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchesServer).DeleteSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearches_DeleteSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchesServer).DeleteSavedSearch(ctx, req.(*DeleteSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SavedSearches_ServiceDesc is the grpc.ServiceDesc for SavedSearches service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SavedSearches_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wordsearcher.SavedSearches",
+	HandlerType: (*SavedSearchesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SaveSearch",
+			Handler:    _SavedSearches_SaveSearch_Handler,
+		},
+		{
+			MethodName: "ListSavedSearches",
+			Handler:    _SavedSearches_ListSavedSearches_Handler,
+		},
+		{
+			MethodName: "RunSavedSearch",
+			Handler:    _SavedSearches_RunSavedSearch_Handler,
+		},
+		{
+			MethodName: "DeleteSavedSearch",
+			Handler:    _SavedSearches_DeleteSavedSearch_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "wordsearcher/searcher.proto",
+}
@@ -0,0 +1,7 @@
+package wordsearcherv2
+
+// Name returns the name of the lexicon's database: its family and version
+// run together, like NWL23.
+func (l *Lexicon) Name() string {
+	return l.GetFamily() + l.GetVersion()
+}
@@ -0,0 +1,2468 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.21.4
+// source: wordsearcher/v2/searcher.proto
+
+// Version 2 of the QuestionSearcher API. It names lexica with a Lexicon
+// message instead of a LEXICON search condition, and its conditions are
+// typed instead of sharing a bag of parameters. Version 1, in the
+// wordsearcher package, is still served, and the two search the same
+// databases. This package's services are served under the /v2 path prefix.
+
+package wordsearcherv2
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The types of search condition. They have the numbers and meanings of
+// version 1's SearchRequest.Condition, which documents them; those that
+// aren't searched for by the server, and LEXICON, have none.
+type ConditionType int32
+
+const (
+	ConditionType_CONDITION_TYPE_UNSPECIFIED                  ConditionType = 0
+	ConditionType_CONDITION_TYPE_LENGTH                       ConditionType = 1  // range
+	ConditionType_CONDITION_TYPE_PROBABILITY_RANGE            ConditionType = 2  // range
+	ConditionType_CONDITION_TYPE_PROBABILITY_LIST             ConditionType = 3  // numbers
+	ConditionType_CONDITION_TYPE_PROBABILITY_LIMIT            ConditionType = 4  // range
+	ConditionType_CONDITION_TYPE_NUMBER_OF_ANAGRAMS           ConditionType = 5  // range
+	ConditionType_CONDITION_TYPE_NUMBER_OF_VOWELS             ConditionType = 6  // range
+	ConditionType_CONDITION_TYPE_POINT_VALUE                  ConditionType = 8  // range
+	ConditionType_CONDITION_TYPE_MATCHING_ANAGRAM             ConditionType = 9  // text
+	ConditionType_CONDITION_TYPE_ALPHAGRAM_LIST               ConditionType = 10 // strings
+	ConditionType_CONDITION_TYPE_NOT_IN_LEXICON               ConditionType = 11 // not_in_lexicon
+	ConditionType_CONDITION_TYPE_DIFFICULTY_RANGE             ConditionType = 17 // range
+	ConditionType_CONDITION_TYPE_PLAYABILITY_RANGE            ConditionType = 18 // range
+	ConditionType_CONDITION_TYPE_DELETED_WORD                 ConditionType = 19 // none
+	ConditionType_CONDITION_TYPE_NUMBER_OF_FRONT_HOOKS        ConditionType = 20 // range
+	ConditionType_CONDITION_TYPE_NUMBER_OF_BACK_HOOKS         ConditionType = 21 // range
+	ConditionType_CONDITION_TYPE_FRONT_HOOKS_INCLUDE          ConditionType = 22 // text
+	ConditionType_CONDITION_TYPE_BACK_HOOKS_INCLUDE           ConditionType = 23 // text
+	ConditionType_CONDITION_TYPE_DEFINITION_CONTAINS          ConditionType = 24 // text
+	ConditionType_CONDITION_TYPE_VOWEL_PROBABILITY_RANGE      ConditionType = 25 // range
+	ConditionType_CONDITION_TYPE_RANDOM_SAMPLE                ConditionType = 26 // random_sample
+	ConditionType_CONDITION_TYPE_LEXICON_DIFF                 ConditionType = 27 // lexicon_diff
+	ConditionType_CONDITION_TYPE_WORD_SOURCE                  ConditionType = 28 // text
+	ConditionType_CONDITION_TYPE_COMBINATOR                   ConditionType = 29 // combinator
+	ConditionType_CONDITION_TYPE_CONTAINS_LETTERS             ConditionType = 30 // text
+	ConditionType_CONDITION_TYPE_EXCLUDES_LETTERS             ConditionType = 31 // text
+	ConditionType_CONDITION_TYPE_VOWEL_RATIO                  ConditionType = 32 // range
+	ConditionType_CONDITION_TYPE_NUMBER_OF_BLANK_ANAGRAMS     ConditionType = 33 // range
+	ConditionType_CONDITION_TYPE_COMBINATIONS_RANGE           ConditionType = 34 // range
+	ConditionType_CONDITION_TYPE_HAS_TAG                      ConditionType = 35 // text
+	ConditionType_CONDITION_TYPE_ORDERED_ALPHAGRAM_LIST       ConditionType = 36 // strings
+	ConditionType_CONDITION_TYPE_ORDERED_PROBABILITY_LIST     ConditionType = 37 // length_probabilities
+	ConditionType_CONDITION_TYPE_BUILD                        ConditionType = 38 // build
+	ConditionType_CONDITION_TYPE_HAS_INNER_HOOKS              ConditionType = 39 // none
+	ConditionType_CONDITION_TYPE_NO_INNER_HOOKS               ConditionType = 40 // none
+	ConditionType_CONDITION_TYPE_ADDED_IN_LAST_UPDATES        ConditionType = 41 // number
+	ConditionType_CONDITION_TYPE_STEM_PLUS_ONE                ConditionType = 42 // text
+	ConditionType_CONDITION_TYPE_PROBABILITY_LIMIT_PER_LENGTH ConditionType = 43 // range
+	ConditionType_CONDITION_TYPE_MOST_PROBABLE                ConditionType = 44 // range
+	ConditionType_CONDITION_TYPE_LEAST_PROBABLE               ConditionType = 45 // range
+)
+
+// Enum value maps for ConditionType.
+var (
+	ConditionType_name = map[int32]string{
+		0:  "CONDITION_TYPE_UNSPECIFIED",
+		1:  "CONDITION_TYPE_LENGTH",
+		2:  "CONDITION_TYPE_PROBABILITY_RANGE",
+		3:  "CONDITION_TYPE_PROBABILITY_LIST",
+		4:  "CONDITION_TYPE_PROBABILITY_LIMIT",
+		5:  "CONDITION_TYPE_NUMBER_OF_ANAGRAMS",
+		6:  "CONDITION_TYPE_NUMBER_OF_VOWELS",
+		8:  "CONDITION_TYPE_POINT_VALUE",
+		9:  "CONDITION_TYPE_MATCHING_ANAGRAM",
+		10: "CONDITION_TYPE_ALPHAGRAM_LIST",
+		11: "CONDITION_TYPE_NOT_IN_LEXICON",
+		17: "CONDITION_TYPE_DIFFICULTY_RANGE",
+		18: "CONDITION_TYPE_PLAYABILITY_RANGE",
+		19: "CONDITION_TYPE_DELETED_WORD",
+		20: "CONDITION_TYPE_NUMBER_OF_FRONT_HOOKS",
+		21: "CONDITION_TYPE_NUMBER_OF_BACK_HOOKS",
+		22: "CONDITION_TYPE_FRONT_HOOKS_INCLUDE",
+		23: "CONDITION_TYPE_BACK_HOOKS_INCLUDE",
+		24: "CONDITION_TYPE_DEFINITION_CONTAINS",
+		25: "CONDITION_TYPE_VOWEL_PROBABILITY_RANGE",
+		26: "CONDITION_TYPE_RANDOM_SAMPLE",
+		27: "CONDITION_TYPE_LEXICON_DIFF",
+		28: "CONDITION_TYPE_WORD_SOURCE",
+		29: "CONDITION_TYPE_COMBINATOR",
+		30: "CONDITION_TYPE_CONTAINS_LETTERS",
+		31: "CONDITION_TYPE_EXCLUDES_LETTERS",
+		32: "CONDITION_TYPE_VOWEL_RATIO",
+		33: "CONDITION_TYPE_NUMBER_OF_BLANK_ANAGRAMS",
+		34: "CONDITION_TYPE_COMBINATIONS_RANGE",
+		35: "CONDITION_TYPE_HAS_TAG",
+		36: "CONDITION_TYPE_ORDERED_ALPHAGRAM_LIST",
+		37: "CONDITION_TYPE_ORDERED_PROBABILITY_LIST",
+		38: "CONDITION_TYPE_BUILD",
+		39: "CONDITION_TYPE_HAS_INNER_HOOKS",
+		40: "CONDITION_TYPE_NO_INNER_HOOKS",
+		41: "CONDITION_TYPE_ADDED_IN_LAST_UPDATES",
+		42: "CONDITION_TYPE_STEM_PLUS_ONE",
+		43: "CONDITION_TYPE_PROBABILITY_LIMIT_PER_LENGTH",
+		44: "CONDITION_TYPE_MOST_PROBABLE",
+		45: "CONDITION_TYPE_LEAST_PROBABLE",
+	}
+	ConditionType_value = map[string]int32{
+		"CONDITION_TYPE_UNSPECIFIED":                  0,
+		"CONDITION_TYPE_LENGTH":                       1,
+		"CONDITION_TYPE_PROBABILITY_RANGE":            2,
+		"CONDITION_TYPE_PROBABILITY_LIST":             3,
+		"CONDITION_TYPE_PROBABILITY_LIMIT":            4,
+		"CONDITION_TYPE_NUMBER_OF_ANAGRAMS":           5,
+		"CONDITION_TYPE_NUMBER_OF_VOWELS":             6,
+		"CONDITION_TYPE_POINT_VALUE":                  8,
+		"CONDITION_TYPE_MATCHING_ANAGRAM":             9,
+		"CONDITION_TYPE_ALPHAGRAM_LIST":               10,
+		"CONDITION_TYPE_NOT_IN_LEXICON":               11,
+		"CONDITION_TYPE_DIFFICULTY_RANGE":             17,
+		"CONDITION_TYPE_PLAYABILITY_RANGE":            18,
+		"CONDITION_TYPE_DELETED_WORD":                 19,
+		"CONDITION_TYPE_NUMBER_OF_FRONT_HOOKS":        20,
+		"CONDITION_TYPE_NUMBER_OF_BACK_HOOKS":         21,
+		"CONDITION_TYPE_FRONT_HOOKS_INCLUDE":          22,
+		"CONDITION_TYPE_BACK_HOOKS_INCLUDE":           23,
+		"CONDITION_TYPE_DEFINITION_CONTAINS":          24,
+		"CONDITION_TYPE_VOWEL_PROBABILITY_RANGE":      25,
+		"CONDITION_TYPE_RANDOM_SAMPLE":                26,
+		"CONDITION_TYPE_LEXICON_DIFF":                 27,
+		"CONDITION_TYPE_WORD_SOURCE":                  28,
+		"CONDITION_TYPE_COMBINATOR":                   29,
+		"CONDITION_TYPE_CONTAINS_LETTERS":             30,
+		"CONDITION_TYPE_EXCLUDES_LETTERS":             31,
+		"CONDITION_TYPE_VOWEL_RATIO":                  32,
+		"CONDITION_TYPE_NUMBER_OF_BLANK_ANAGRAMS":     33,
+		"CONDITION_TYPE_COMBINATIONS_RANGE":           34,
+		"CONDITION_TYPE_HAS_TAG":                      35,
+		"CONDITION_TYPE_ORDERED_ALPHAGRAM_LIST":       36,
+		"CONDITION_TYPE_ORDERED_PROBABILITY_LIST":     37,
+		"CONDITION_TYPE_BUILD":                        38,
+		"CONDITION_TYPE_HAS_INNER_HOOKS":              39,
+		"CONDITION_TYPE_NO_INNER_HOOKS":               40,
+		"CONDITION_TYPE_ADDED_IN_LAST_UPDATES":        41,
+		"CONDITION_TYPE_STEM_PLUS_ONE":                42,
+		"CONDITION_TYPE_PROBABILITY_LIMIT_PER_LENGTH": 43,
+		"CONDITION_TYPE_MOST_PROBABLE":                44,
+		"CONDITION_TYPE_LEAST_PROBABLE":               45,
+	}
+)
+
+func (x ConditionType) Enum() *ConditionType {
+	p := new(ConditionType)
+	*p = x
+	return p
+}
+
+func (x ConditionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConditionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_wordsearcher_v2_searcher_proto_enumTypes[0].Descriptor()
+}
+
+func (ConditionType) Type() protoreflect.EnumType {
+	return &file_wordsearcher_v2_searcher_proto_enumTypes[0]
+}
+
+func (x ConditionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConditionType.Descriptor instead.
+func (ConditionType) EnumDescriptor() ([]byte, []int) {
+	return file_wordsearcher_v2_searcher_proto_rawDescGZIP(), []int{0}
+}
+
+type NotInLexicon int32
+
+const (
+	NotInLexicon_NOT_IN_LEXICON_OTHER_ENGLISH    NotInLexicon = 0
+	NotInLexicon_NOT_IN_LEXICON_PREVIOUS_VERSION NotInLexicon = 1
+)
+
+// Enum value maps for NotInLexicon.
+var (
+	NotInLexicon_name = map[int32]string{
+		0: "NOT_IN_LEXICON_OTHER_ENGLISH",
+		1: "NOT_IN_LEXICON_PREVIOUS_VERSION",
+	}
+	NotInLexicon_value = map[string]int32{
+		"NOT_IN_LEXICON_OTHER_ENGLISH":    0,
+		"NOT_IN_LEXICON_PREVIOUS_VERSION": 1,
+	}
+)
+
+func (x NotInLexicon) Enum() *NotInLexicon {
+	p := new(NotInLexicon)
+	*p = x
+	return p
+}
+
+func (x NotInLexicon) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotInLexicon) Descriptor() protoreflect.EnumDescriptor {
+	return file_wordsearcher_v2_searcher_proto_enumTypes[1].Descriptor()
+}
+
+func (NotInLexicon) Type() protoreflect.EnumType {
+	return &file_wordsearcher_v2_searcher_proto_enumTypes[1]
+}
+
+func (x NotInLexicon) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotInLexicon.Descriptor instead.
+func (NotInLexicon) EnumDescriptor() ([]byte, []int) {
+	return file_wordsearcher_v2_searcher_proto_rawDescGZIP(), []int{1}
+}
+
+type SortOrder int32
+
+const (
+	SortOrder_SORT_ORDER_PROBABILITY       SortOrder = 0
+	SortOrder_SORT_ORDER_VOWEL_PROBABILITY SortOrder = 1
+)
+
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "SORT_ORDER_PROBABILITY",
+		1: "SORT_ORDER_VOWEL_PROBABILITY",
+	}
+	SortOrder_value = map[string]int32{
+		"SORT_ORDER_PROBABILITY":       0,
+		"SORT_ORDER_VOWEL_PROBABILITY": 1,
+	}
+)
+
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
+}
+
+func (x SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_wordsearcher_v2_searcher_proto_enumTypes[2].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_wordsearcher_v2_searcher_proto_enumTypes[2]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrder.Descriptor instead.
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_wordsearcher_v2_searcher_proto_rawDescGZIP(), []int{2}
+}
+
+type WordField int32
+
+const (
+	WordField_WORD_FIELD_DEFINITION      WordField = 0
+	WordField_WORD_FIELD_FRONT_HOOKS     WordField = 1
+	WordField_WORD_FIELD_BACK_HOOKS      WordField = 2
+	WordField_WORD_FIELD_LEXICON_SYMBOLS WordField = 3
+	WordField_WORD_FIELD_INNER_HOOKS     WordField = 4
+	WordField_WORD_FIELD_SOURCES         WordField = 5
+)
+
+// Enum value maps for WordField.
+var (
+	WordField_name = map[int32]string{
+		0: "WORD_FIELD_DEFINITION",
+		1: "WORD_FIELD_FRONT_HOOKS",
+		2: "WORD_FIELD_BACK_HOOKS",
+		3: "WORD_FIELD_LEXICON_SYMBOLS",
+		4: "WORD_FIELD_INNER_HOOKS",
+		5: "WORD_FIELD_SOURCES",
+	}
+	WordField_value = map[string]int32{
+		"WORD_FIELD_DEFINITION":      0,
+		"WORD_FIELD_FRONT_HOOKS":     1,
+		"WORD_FIELD_BACK_HOOKS":      2,
+		"WORD_FIELD_LEXICON_SYMBOLS": 3,
+		"WORD_FIELD_INNER_HOOKS":     4,
+		"WORD_FIELD_SOURCES":         5,
+	}
+)
+
+func (x WordField) Enum() *WordField {
+	p := new(WordField)
+	*p = x
+	return p
+}
+
+func (x WordField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WordField) Descriptor() protoreflect.EnumDescriptor {
+	return file_wordsearcher_v2_searcher_proto_enumTypes[3].Descriptor()
+}
+
+func (WordField) Type() protoreflect.EnumType {
+	return &file_wordsearcher_v2_searcher_proto_enumTypes[3]
+}
+
+func (x WordField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WordField.Descriptor instead.
+func (WordField) EnumDescriptor() ([]byte, []int) {
+	return file_wordsearcher_v2_searcher_proto_rawDescGZIP(), []int{3}
+}
+
+type LexiconDiff_Mode int32
+
+const (
+	LexiconDiff_MODE_NOT_IN_OTHER LexiconDiff_Mode = 0
+	LexiconDiff_MODE_WORDS_DIFFER LexiconDiff_Mode = 1
+)
+
+// Enum value maps for LexiconDiff_Mode.
+var (
+	LexiconDiff_Mode_name = map[int32]string{
+		0: "MODE_NOT_IN_OTHER",
+		1: "MODE_WORDS_DIFFER",
+	}
+	LexiconDiff_Mode_value = map[string]int32{
+		"MODE_NOT_IN_OTHER": 0,
+		"MODE_WORDS_DIFFER": 1,
+	}
+)
+
+func (x LexiconDiff_Mode) Enum() *LexiconDiff_Mode {
+	p := new(LexiconDiff_Mode)
+	*p = x
+	return p
+}
+
+func (x LexiconDiff_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LexiconDiff_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_wordsearcher_v2_searcher_proto_enumTypes[4].Descriptor()
+}
+
+func (LexiconDiff_Mode) Type() protoreflect.EnumType {
+	return &file_wordsearcher_v2_searcher_proto_enumTypes[4]
+}
+
+func (x LexiconDiff_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LexiconDiff_Mode.Descriptor instead.
+func (LexiconDiff_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_wordsearcher_v2_searcher_proto_rawDescGZIP(), []int{9, 0}
+}
+
+type Combinator_Op int32
+
+const (
+	Combinator_OP_AND Combinator_Op = 0
+	Combinator_OP_OR  Combinator_Op = 1
+	Combinator_OP_NOT Combinator_Op = 2
+)
+
+// Enum value maps for Combinator_Op.
+var (
+	Combinator_Op_name = map[int32]string{
+		0: "OP_AND",
+		1: "OP_OR",
+		2: "OP_NOT",
+	}
+	Combinator_Op_value = map[string]int32{
+		"OP_AND": 0,
+		"OP_OR":  1,
+		"OP_NOT": 2,
+	}
+)
+
+func (x Combinator_Op) Enum() *Combinator_Op {
+	p := new(Combinator_Op)
+	*p = x
+	return p
+}
+
+func (x Combinator_Op) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Combinator_Op) Descriptor() protoreflect.EnumDescriptor {
+	return file_wordsearcher_v2_searcher_proto_enumTypes[5].Descriptor()
+}
+
+func (Combinator_Op) Type() protoreflect.EnumType {
+	return &file_wordsearcher_v2_searcher_proto_enumTypes[5]
+}
+
+func (x Combinator_Op) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Combinator_Op.Descriptor instead.
+func (Combinator_Op) EnumDescriptor() ([]byte, []int) {
+	return file_wordsearcher_v2_searcher_proto_rawDescGZIP(), []int{10, 0}
+}
+
+// A Lexicon names a lexicon by its family and version, like NWL and 23.
+// The server's database for it is named by the two run together, NWL23; a
+// custom lexicon has just a family, its name.
+type Lexicon struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Family  string `protobuf:"bytes,1,opt,name=family,proto3" json:"family,omitempty"`
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// The name of the lexicon's letter distribution, like english. In a
+	// request it's optional, and if given the search fails unless the
+	// lexicon uses it. Responses always have it.
+	LetterDistribution string `protobuf:"bytes,3,opt,name=letter_distribution,json=letterDistribution,proto3" json:"letter_distribution,omitempty"`
+}
+
+func (x *Lexicon) Reset() {
+	*x = Lexicon{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_v2_searcher_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Lexicon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lexicon) ProtoMessage() {}
+
+func (x *Lexicon) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_v2_searcher_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lexicon.ProtoReflect.Descriptor instead.
+func (*Lexicon) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_v2_searcher_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Lexicon) GetFamily() string {
+	if x != nil {
+		return x.Family
+	}
+	return ""
+}
+
+func (x *Lexicon) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Lexicon) GetLetterDistribution() string {
+	if x != nil {
+		return x.LetterDistribution
+	}
+	return ""
+}
+
+// An Alphagram is an alphagram and its words. See Alphagram in version 1.
+type Alphagram struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alphagram string  `protobuf:"bytes,1,opt,name=alphagram,proto3" json:"alphagram,omitempty"`
+	Words     []*Word `protobuf:"bytes,2,rep,name=words,proto3" json:"words,omitempty"`
+	// Whether the details (length and probability excepted) of the alphagram
+	// and its words are filled in.
+	Expanded         bool   `protobuf:"varint,3,opt,name=expanded,proto3" json:"expanded,omitempty"`
+	Length           int32  `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`
+	Probability      int32  `protobuf:"varint,5,opt,name=probability,proto3" json:"probability,omitempty"`
+	Combinations     int64  `protobuf:"varint,6,opt,name=combinations,proto3" json:"combinations,omitempty"`
+	Difficulty       int32  `protobuf:"varint,7,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	DisplayAlphagram string `protobuf:"bytes,8,opt,name=display_alphagram,json=displayAlphagram,proto3" json:"display_alphagram,omitempty"`
+	Playability      int32  `protobuf:"varint,9,opt,name=playability,proto3" json:"playability,omitempty"`
+	VowelProbability int32  `protobuf:"varint,10,opt,name=vowel_probability,json=vowelProbability,proto3" json:"vowel_probability,omitempty"`
+	Deleted          bool   `protobuf:"varint,11,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// For Expand, the lexicon of this alphagram if it isn't the request's.
+	Lexicon *Lexicon `protobuf:"bytes,12,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+}
+
+func (x *Alphagram) Reset() {
+	*x = Alphagram{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_v2_searcher_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Alphagram) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alphagram) ProtoMessage() {}
+
+func (x *Alphagram) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_v2_searcher_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alphagram.ProtoReflect.Descriptor instead.
+func (*Alphagram) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_v2_searcher_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Alphagram) GetAlphagram() string {
+	if x != nil {
+		return x.Alphagram
+	}
+	return ""
+}
+
+func (x *Alphagram) GetWords() []*Word {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *Alphagram) GetExpanded() bool {
+	if x != nil {
+		return x.Expanded
+	}
+	return false
+}
+
+func (x *Alphagram) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *Alphagram) GetProbability() int32 {
+	if x != nil {
+		return x.Probability
+	}
+	return 0
+}
+
+func (x *Alphagram) GetCombinations() int64 {
+	if x != nil {
+		return x.Combinations
+	}
+	return 0
+}
+
+func (x *Alphagram) GetDifficulty() int32 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
+}
+
+func (x *Alphagram) GetDisplayAlphagram() string {
+	if x != nil {
+		return x.DisplayAlphagram
+	}
+	return ""
+}
+
+func (x *Alphagram) GetPlayability() int32 {
+	if x != nil {
+		return x.Playability
+	}
+	return 0
+}
+
+func (x *Alphagram) GetVowelProbability() int32 {
+	if x != nil {
+		return x.VowelProbability
+	}
+	return 0
+}
+
+func (x *Alphagram) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *Alphagram) GetLexicon() *Lexicon {
+	if x != nil {
+		return x.Lexicon
+	}
+	return nil
+}
+
+type Word struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Word string `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	// The word's alphagram; only set if it's expanded.
+	Alphagram      string   `protobuf:"bytes,2,opt,name=alphagram,proto3" json:"alphagram,omitempty"`
+	Definition     string   `protobuf:"bytes,3,opt,name=definition,proto3" json:"definition,omitempty"`
+	FrontHooks     string   `protobuf:"bytes,4,opt,name=front_hooks,json=frontHooks,proto3" json:"front_hooks,omitempty"`
+	BackHooks      string   `protobuf:"bytes,5,opt,name=back_hooks,json=backHooks,proto3" json:"back_hooks,omitempty"`
+	LexiconSymbols string   `protobuf:"bytes,6,opt,name=lexicon_symbols,json=lexiconSymbols,proto3" json:"lexicon_symbols,omitempty"`
+	InnerFrontHook bool     `protobuf:"varint,7,opt,name=inner_front_hook,json=innerFrontHook,proto3" json:"inner_front_hook,omitempty"`
+	InnerBackHook  bool     `protobuf:"varint,8,opt,name=inner_back_hook,json=innerBackHook,proto3" json:"inner_back_hook,omitempty"`
+	Deleted        bool     `protobuf:"varint,9,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Sources        []string `protobuf:"bytes,10,rep,name=sources,proto3" json:"sources,omitempty"`
+}
+
+func (x *Word) Reset() {
+	*x = Word{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_v2_searcher_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Word) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Word) ProtoMessage() {}
+
+func (x *Word) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_v2_searcher_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Word.ProtoReflect.Descriptor instead.
+func (*Word) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_v2_searcher_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Word) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *Word) GetAlphagram() string {
+	if x != nil {
+		return x.Alphagram
+	}
+	return ""
+}
+
+func (x *Word) GetDefinition() string {
+	if x != nil {
+		return x.Definition
+	}
+	return ""
+}
+
+func (x *Word) GetFrontHooks() string {
+	if x != nil {
+		return x.FrontHooks
+	}
+	return ""
+}
+
+func (x *Word) GetBackHooks() string {
+	if x != nil {
+		return x.BackHooks
+	}
+	return ""
+}
+
+func (x *Word) GetLexiconSymbols() string {
+	if x != nil {
+		return x.LexiconSymbols
+	}
+	return ""
+}
+
+func (x *Word) GetInnerFrontHook() bool {
+	if x != nil {
+		return x.InnerFrontHook
+	}
+	return false
+}
+
+func (x *Word) GetInnerBackHook() bool {
+	if x != nil {
+		return x.InnerBackHook
+	}
+	return false
+}
+
+func (x *Word) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *Word) GetSources() []string {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type Range struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min int64 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max int64 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *Range) Reset() {
+	*x = Range{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_v2_searcher_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Range) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Range) ProtoMessage() {}
+
+func (x *Range) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_v2_searcher_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Range.ProtoReflect.Descriptor instead.
+func (*Range) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_v2_searcher_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Range) GetMin() int64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *Range) GetMax() int64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type StringList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *StringList) Reset() {
+	*x = StringList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_v2_searcher_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StringList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringList) ProtoMessage() {}
+
+func (x *StringList) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_v2_searcher_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringList.ProtoReflect.Descriptor instead.
+func (*StringList) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_v2_searcher_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StringList) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type NumberList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []int32 `protobuf:"varint,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *NumberList) Reset() {
+	*x = NumberList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_v2_searcher_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NumberList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumberList) ProtoMessage() {}
+
+func (x *NumberList) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_v2_searcher_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumberList.ProtoReflect.Descriptor instead.
+func (*NumberList) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_v2_searcher_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NumberList) GetValues() []int32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type LengthProbability struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Length      int32 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	Probability int32 `protobuf:"varint,2,opt,name=probability,proto3" json:"probability,omitempty"`
+}
+
+func (x *LengthProbability) Reset() {
+	*x = LengthProbability{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_v2_searcher_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LengthProbability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LengthProbability) ProtoMessage() {}
+
+func (x *LengthProbability) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_v2_searcher_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LengthProbability.ProtoReflect.Descriptor instead.
+func (*LengthProbability) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_v2_searcher_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LengthProbability) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *LengthProbability) GetProbability() int32 {
+	if x != nil {
+		return x.Probability
+	}
+	return 0
+}
+
+type LengthProbabilityList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []*LengthProbability `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *LengthProbabilityList) Reset() {
+	*x = LengthProbabilityList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_v2_searcher_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LengthProbabilityList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LengthProbabilityList) ProtoMessage() {}
+
+func (x *LengthProbabilityList) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_v2_searcher_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LengthProbabilityList.ProtoReflect.Descriptor instead.
+func (*LengthProbabilityList) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_v2_searcher_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LengthProbabilityList) GetValues() []*LengthProbability {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type RandomSample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Seed  int64 `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"`
+}
+
+func (x *RandomSample) Reset() {
+	*x = RandomSample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_v2_searcher_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RandomSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomSample) ProtoMessage() {}
+
+func (x *RandomSample) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_v2_searcher_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandomSample.ProtoReflect.Descriptor instead.
+func (*RandomSample) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_v2_searcher_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RandomSample) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *RandomSample) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+type LexiconDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OtherLexicon *Lexicon         `protobuf:"bytes,1,opt,name=other_lexicon,json=otherLexicon,proto3" json:"other_lexicon,omitempty"`
+	Mode         LexiconDiff_Mode `protobuf:"varint,2,opt,name=mode,proto3,enum=wordsearcher.v2.LexiconDiff_Mode" json:"mode,omitempty"`
+}
+
+func (x *LexiconDiff) Reset() {
+	*x = LexiconDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_v2_searcher_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LexiconDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LexiconDiff) ProtoMessage() {}
+
+func (x *LexiconDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_v2_searcher_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LexiconDiff.ProtoReflect.Descriptor instead.
+func (*LexiconDiff) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_v2_searcher_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *LexiconDiff) GetOtherLexicon() *Lexicon {
+	if x != nil {
+		return x.OtherLexicon
+	}
+	return nil
+}
+
+func (x *LexiconDiff) GetMode() LexiconDiff_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return LexiconDiff_MODE_NOT_IN_OTHER
+}
+
+type Combinator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Op         Combinator_Op `protobuf:"varint,1,opt,name=op,proto3,enum=wordsearcher.v2.Combinator_Op" json:"op,omitempty"`
+	Conditions []*Condition  `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty"`
+}
+
+func (x *Combinator) Reset() {
+	*x = Combinator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_v2_searcher_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Combinator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Combinator) ProtoMessage() {}
+
+func (x *Combinator) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_v2_searcher_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Combinator.ProtoReflect.Descriptor instead.
+func (*Combinator) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_v2_searcher_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Combinator) GetOp() Combinator_Op {
+	if x != nil {
+		return x.Op
+	}
+	return Combinator_OP_AND
+}
+
+func (x *Combinator) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+type Build struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Letters   string `protobuf:"bytes,1,opt,name=letters,proto3" json:"letters,omitempty"`
+	MinLength int32  `protobuf:"varint,2,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
+	MaxLength int32  `protobuf:"varint,3,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`
+}
+
+func (x *Build) Reset() {
+	*x = Build{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_v2_searcher_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Build) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Build) ProtoMessage() {}
+
+func (x *Build) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_v2_searcher_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Build.ProtoReflect.Descriptor instead.
+func (*Build) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_v2_searcher_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Build) GetLetters() string {
+	if x != nil {
+		return x.Letters
+	}
+	return ""
+}
+
+func (x *Build) GetMinLength() int32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *Build) GetMaxLength() int32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+// A Condition is a search condition. Its value is the one its type takes,
+// as commented on the type; types marked none take no value.
+type Condition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type ConditionType `protobuf:"varint,1,opt,name=type,proto3,enum=wordsearcher.v2.ConditionType" json:"type,omitempty"`
+	// Types that are assignable to Value:
+	//	*Condition_Range
+	//	*Condition_Text
+	//	*Condition_Strings
+	//	*Condition_Numbers
+	//	*Condition_Number
+	//	*Condition_NotInLexicon
+	//	*Condition_RandomSample
+	//	*Condition_LexiconDiff
+	//	*Condition_Combinator
+	//	*Condition_LengthProbabilities
+	//	*Condition_Build
+	Value isCondition_Value `protobuf_oneof:"value"`
+}
+
+func (x *Condition) Reset() {
+	*x = Condition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_v2_searcher_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Condition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Condition) ProtoMessage() {}
+
+func (x *Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_v2_searcher_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Condition.ProtoReflect.Descriptor instead.
+func (*Condition) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_v2_searcher_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Condition) GetType() ConditionType {
+	if x != nil {
+		return x.Type
+	}
+	return ConditionType_CONDITION_TYPE_UNSPECIFIED
+}
+
+func (m *Condition) GetValue() isCondition_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *Condition) GetRange() *Range {
+	if x, ok := x.GetValue().(*Condition_Range); ok {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *Condition) GetText() string {
+	if x, ok := x.GetValue().(*Condition_Text); ok {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Condition) GetStrings() *StringList {
+	if x, ok := x.GetValue().(*Condition_Strings); ok {
+		return x.Strings
+	}
+	return nil
+}
+
+func (x *Condition) GetNumbers() *NumberList {
+	if x, ok := x.GetValue().(*Condition_Numbers); ok {
+		return x.Numbers
+	}
+	return nil
+}
+
+func (x *Condition) GetNumber() int32 {
+	if x, ok := x.GetValue().(*Condition_Number); ok {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Condition) GetNotInLexicon() NotInLexicon {
+	if x, ok := x.GetValue().(*Condition_NotInLexicon); ok {
+		return x.NotInLexicon
+	}
+	return NotInLexicon_NOT_IN_LEXICON_OTHER_ENGLISH
+}
+
+func (x *Condition) GetRandomSample() *RandomSample {
+	if x, ok := x.GetValue().(*Condition_RandomSample); ok {
+		return x.RandomSample
+	}
+	return nil
+}
+
+func (x *Condition) GetLexiconDiff() *LexiconDiff {
+	if x, ok := x.GetValue().(*Condition_LexiconDiff); ok {
+		return x.LexiconDiff
+	}
+	return nil
+}
+
+func (x *Condition) GetCombinator() *Combinator {
+	if x, ok := x.GetValue().(*Condition_Combinator); ok {
+		return x.Combinator
+	}
+	return nil
+}
+
+func (x *Condition) GetLengthProbabilities() *LengthProbabilityList {
+	if x, ok := x.GetValue().(*Condition_LengthProbabilities); ok {
+		return x.LengthProbabilities
+	}
+	return nil
+}
+
+func (x *Condition) GetBuild() *Build {
+	if x, ok := x.GetValue().(*Condition_Build); ok {
+		return x.Build
+	}
+	return nil
+}
+
+type isCondition_Value interface {
+	isCondition_Value()
+}
+
+type Condition_Range struct {
+	Range *Range `protobuf:"bytes,2,opt,name=range,proto3,oneof"`
+}
+
+type Condition_Text struct {
+	Text string `protobuf:"bytes,3,opt,name=text,proto3,oneof"`
+}
+
+type Condition_Strings struct {
+	Strings *StringList `protobuf:"bytes,4,opt,name=strings,proto3,oneof"`
+}
+
+type Condition_Numbers struct {
+	Numbers *NumberList `protobuf:"bytes,5,opt,name=numbers,proto3,oneof"`
+}
+
+type Condition_Number struct {
+	Number int32 `protobuf:"varint,6,opt,name=number,proto3,oneof"`
+}
+
+type Condition_NotInLexicon struct {
+	NotInLexicon NotInLexicon `protobuf:"varint,7,opt,name=not_in_lexicon,json=notInLexicon,proto3,enum=wordsearcher.v2.NotInLexicon,oneof"`
+}
+
+type Condition_RandomSample struct {
+	RandomSample *RandomSample `protobuf:"bytes,8,opt,name=random_sample,json=randomSample,proto3,oneof"`
+}
+
+type Condition_LexiconDiff struct {
+	LexiconDiff *LexiconDiff `protobuf:"bytes,9,opt,name=lexicon_diff,json=lexiconDiff,proto3,oneof"`
+}
+
+type Condition_Combinator struct {
+	Combinator *Combinator `protobuf:"bytes,10,opt,name=combinator,proto3,oneof"`
+}
+
+type Condition_LengthProbabilities struct {
+	LengthProbabilities *LengthProbabilityList `protobuf:"bytes,11,opt,name=length_probabilities,json=lengthProbabilities,proto3,oneof"`
+}
+
+type Condition_Build struct {
+	Build *Build `protobuf:"bytes,12,opt,name=build,proto3,oneof"`
+}
+
+func (*Condition_Range) isCondition_Value() {}
+
+func (*Condition_Text) isCondition_Value() {}
+
+func (*Condition_Strings) isCondition_Value() {}
+
+func (*Condition_Numbers) isCondition_Value() {}
+
+func (*Condition_Number) isCondition_Value() {}
+
+func (*Condition_NotInLexicon) isCondition_Value() {}
+
+func (*Condition_RandomSample) isCondition_Value() {}
+
+func (*Condition_LexiconDiff) isCondition_Value() {}
+
+func (*Condition_Combinator) isCondition_Value() {}
+
+func (*Condition_LengthProbabilities) isCondition_Value() {}
+
+func (*Condition_Build) isCondition_Value() {}
+
+type SingleAnagram struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seed    int64 `protobuf:"varint,1,opt,name=seed,proto3" json:"seed,omitempty"`
+	Shuffle bool  `protobuf:"varint,2,opt,name=shuffle,proto3" json:"shuffle,omitempty"`
+}
+
+func (x *SingleAnagram) Reset() {
+	*x = SingleAnagram{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_v2_searcher_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SingleAnagram) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SingleAnagram) ProtoMessage() {}
+
+func (x *SingleAnagram) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_v2_searcher_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SingleAnagram.ProtoReflect.Descriptor instead.
+func (*SingleAnagram) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_v2_searcher_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SingleAnagram) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *SingleAnagram) GetShuffle() bool {
+	if x != nil {
+		return x.Shuffle
+	}
+	return false
+}
+
+// A SearchRequest searches the lexicon for the alphagrams that meet all of
+// the conditions. The other fields are as in version 1.
+type SearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lexicon       *Lexicon       `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	Conditions    []*Condition   `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Expand        bool           `protobuf:"varint,3,opt,name=expand,proto3" json:"expand,omitempty"`
+	SortOrder     SortOrder      `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3,enum=wordsearcher.v2.SortOrder" json:"sort_order,omitempty"`
+	PinSnapshot   bool           `protobuf:"varint,5,opt,name=pin_snapshot,json=pinSnapshot,proto3" json:"pin_snapshot,omitempty"`
+	SnapshotId    string         `protobuf:"bytes,6,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	PageSize      int32          `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        string         `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PartialExpand []WordField    `protobuf:"varint,9,rep,packed,name=partial_expand,json=partialExpand,proto3,enum=wordsearcher.v2.WordField" json:"partial_expand,omitempty"`
+	SingleAnagram *SingleAnagram `protobuf:"bytes,10,opt,name=single_anagram,json=singleAnagram,proto3" json:"single_anagram,omitempty"`
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_v2_searcher_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_v2_searcher_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_v2_searcher_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SearchRequest) GetLexicon() *Lexicon {
+	if x != nil {
+		return x.Lexicon
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetExpand() bool {
+	if x != nil {
+		return x.Expand
+	}
+	return false
+}
+
+func (x *SearchRequest) GetSortOrder() SortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return SortOrder_SORT_ORDER_PROBABILITY
+}
+
+func (x *SearchRequest) GetPinSnapshot() bool {
+	if x != nil {
+		return x.PinSnapshot
+	}
+	return false
+}
+
+func (x *SearchRequest) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetPartialExpand() []WordField {
+	if x != nil {
+		return x.PartialExpand
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetSingleAnagram() *SingleAnagram {
+	if x != nil {
+		return x.SingleAnagram
+	}
+	return nil
+}
+
+type SearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alphagrams []*Alphagram `protobuf:"bytes,1,rep,name=alphagrams,proto3" json:"alphagrams,omitempty"`
+	Lexicon    *Lexicon     `protobuf:"bytes,2,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	SnapshotId string       `protobuf:"bytes,3,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	NextCursor string       `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	Truncated  bool         `protobuf:"varint,5,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_v2_searcher_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_v2_searcher_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_v2_searcher_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SearchResponse) GetAlphagrams() []*Alphagram {
+	if x != nil {
+		return x.Alphagrams
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetLexicon() *Lexicon {
+	if x != nil {
+		return x.Lexicon
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+func (x *SearchResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *SearchResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// An ExpandRequest fills in the details of the alphagrams, which are
+// usually the unexpanded results of a search. If either expand_indexes or
+// expand_alphagrams is set, only those alphagrams are expanded.
+type ExpandRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lexicon          *Lexicon     `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	Alphagrams       []*Alphagram `protobuf:"bytes,2,rep,name=alphagrams,proto3" json:"alphagrams,omitempty"`
+	SnapshotId       string       `protobuf:"bytes,3,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	ExpandIndexes    []int32      `protobuf:"varint,4,rep,packed,name=expand_indexes,json=expandIndexes,proto3" json:"expand_indexes,omitempty"`
+	ExpandAlphagrams []string     `protobuf:"bytes,5,rep,name=expand_alphagrams,json=expandAlphagrams,proto3" json:"expand_alphagrams,omitempty"`
+}
+
+func (x *ExpandRequest) Reset() {
+	*x = ExpandRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wordsearcher_v2_searcher_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpandRequest) ProtoMessage() {}
+
+func (x *ExpandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wordsearcher_v2_searcher_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpandRequest.ProtoReflect.Descriptor instead.
+func (*ExpandRequest) Descriptor() ([]byte, []int) {
+	return file_wordsearcher_v2_searcher_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ExpandRequest) GetLexicon() *Lexicon {
+	if x != nil {
+		return x.Lexicon
+	}
+	return nil
+}
+
+func (x *ExpandRequest) GetAlphagrams() []*Alphagram {
+	if x != nil {
+		return x.Alphagrams
+	}
+	return nil
+}
+
+func (x *ExpandRequest) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+func (x *ExpandRequest) GetExpandIndexes() []int32 {
+	if x != nil {
+		return x.ExpandIndexes
+	}
+	return nil
+}
+
+func (x *ExpandRequest) GetExpandAlphagrams() []string {
+	if x != nil {
+		return x.ExpandAlphagrams
+	}
+	return nil
+}
+
+var File_wordsearcher_v2_searcher_proto protoreflect.FileDescriptor
+
+var file_wordsearcher_v2_searcher_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2f, 0x76,
+	0x32, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76,
+	0x32, 0x22, 0x6c, 0x0a, 0x07, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x61,
+	0x6d, 0x69, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f,
+	0x0a, 0x13, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0xba, 0x03, 0x0a, 0x09, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x2b, 0x0a, 0x05, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x57, 0x6f, 0x72,
+	0x64, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x61,
+	0x6e, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x70, 0x61,
+	0x6e, 0x64, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b,
+	0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x22,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c,
+	0x74, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x12,
+	0x20, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x6f, 0x77, 0x65, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x76, 0x6f,
+	0x77, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x6c, 0x65, 0x78, 0x69,
+	0x63, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x65, 0x78, 0x69,
+	0x63, 0x6f, 0x6e, 0x52, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x22, 0xc7, 0x02, 0x0a,
+	0x04, 0x57, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6e, 0x74,
+	0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72,
+	0x6f, 0x6e, 0x74, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b,
+	0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61,
+	0x63, 0x6b, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x65, 0x78, 0x69, 0x63,
+	0x6f, 0x6e, 0x5f, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73,
+	0x12, 0x28, 0x0a, 0x10, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f,
+	0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x6e, 0x65,
+	0x72, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x6e,
+	0x6e, 0x65, 0x72, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x42, 0x61, 0x63, 0x6b, 0x48, 0x6f,
+	0x6f, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x2b, 0x0a, 0x05, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x69,
+	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x6d, 0x61, 0x78, 0x22, 0x24, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x24, 0x0a, 0x0a, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22,
+	0x4d, 0x0a, 0x11, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b,
+	0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x53,
+	0x0a, 0x15, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x22, 0x38, 0x0a, 0x0c, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x53, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x22, 0xb9, 0x01,
+	0x0a, 0x0b, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x12, 0x3d, 0x0a,
+	0x0d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x52, 0x0c,
+	0x6f, 0x74, 0x68, 0x65, 0x72, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x65, 0x78,
+	0x69, 0x63, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x22, 0x34, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52,
+	0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x57, 0x4f, 0x52, 0x44, 0x53,
+	0x5f, 0x44, 0x49, 0x46, 0x46, 0x45, 0x52, 0x10, 0x01, 0x22, 0xa1, 0x01, 0x0a, 0x0a, 0x43, 0x6f,
+	0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x4f, 0x70, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x27, 0x0a, 0x02, 0x4f, 0x70, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x50,
+	0x5f, 0x41, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x50, 0x5f, 0x4f, 0x52, 0x10,
+	0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x50, 0x5f, 0x4e, 0x4f, 0x54, 0x10, 0x02, 0x22, 0x5f, 0x0a,
+	0x05, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0xb6,
+	0x05, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x2e, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76,
+	0x32, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x14, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x37, 0x0a, 0x07, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x76, 0x32, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x07, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x45, 0x0a, 0x0e, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x78,
+	0x69, 0x63, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x4e, 0x6f, 0x74,
+	0x49, 0x6e, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x6e, 0x6f, 0x74,
+	0x49, 0x6e, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x0d, 0x72, 0x61, 0x6e,
+	0x64, 0x6f, 0x6d, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x76, 0x32, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x48,
+	0x00, 0x52, 0x0c, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12,
+	0x41, 0x0a, 0x0c, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x44,
+	0x69, 0x66, 0x66, 0x48, 0x00, 0x52, 0x0b, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x44, 0x69,
+	0x66, 0x66, 0x12, 0x3d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61,
+	0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x5b, 0x0a, 0x14, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x62,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76,
+	0x32, 0x2e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x13, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2e,
+	0x0a, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x42, 0x07,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3d, 0x0a, 0x0d, 0x53, 0x69, 0x6e, 0x67, 0x6c,
+	0x65, 0x41, 0x6e, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x22, 0xd5, 0x03, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x6c, 0x65, 0x78, 0x69,
+	0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x65, 0x78, 0x69,
+	0x63, 0x6f, 0x6e, 0x52, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x61,
+	0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64,
+	0x12, 0x39, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x70,
+	0x69, 0x6e, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x70, 0x69, 0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x12, 0x41, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f,
+	0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x57,
+	0x6f, 0x72, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61,
+	0x6c, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x12, 0x45, 0x0a, 0x0e, 0x73, 0x69, 0x6e, 0x67, 0x6c,
+	0x65, 0x5f, 0x61, 0x6e, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76,
+	0x32, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x41, 0x6e, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x52,
+	0x0d, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x41, 0x6e, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x22, 0xe0,
+	0x01, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61,
+	0x6d, 0x52, 0x0a, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x32, 0x0a,
+	0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x32,
+	0x2e, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x52, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f,
+	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x22, 0xf4, 0x01, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x52, 0x07,
+	0x6c, 0x65, 0x78, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0a, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x6c,
+	0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x0a, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0d, 0x65, 0x78,
+	0x70, 0x61, 0x6e, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x65,
+	0x78, 0x70, 0x61, 0x6e, 0x64, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x41, 0x6c,
+	0x70, 0x68, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x2a, 0xd7, 0x0b, 0x0a, 0x0d, 0x43, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f,
+	0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f,
+	0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x45, 0x4e,
+	0x47, 0x54, 0x48, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x42, 0x41, 0x42, 0x49, 0x4c,
+	0x49, 0x54, 0x59, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x43,
+	0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x52,
+	0x4f, 0x42, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x03,
+	0x12, 0x24, 0x0a, 0x20, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x42, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x4c,
+	0x49, 0x4d, 0x49, 0x54, 0x10, 0x04, 0x12, 0x25, 0x0a, 0x21, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x5f,
+	0x4f, 0x46, 0x5f, 0x41, 0x4e, 0x41, 0x47, 0x52, 0x41, 0x4d, 0x53, 0x10, 0x05, 0x12, 0x23, 0x0a,
+	0x1f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x4f, 0x46, 0x5f, 0x56, 0x4f, 0x57, 0x45, 0x4c, 0x53,
+	0x10, 0x06, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45,
+	0x10, 0x08, 0x12, 0x23, 0x0a, 0x1f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x4e,
+	0x41, 0x47, 0x52, 0x41, 0x4d, 0x10, 0x09, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x4f, 0x4e, 0x44, 0x49,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x4c, 0x50, 0x48, 0x41, 0x47,
+	0x52, 0x41, 0x4d, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x0a, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x4f,
+	0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x49, 0x4e, 0x5f, 0x4c, 0x45, 0x58, 0x49, 0x43, 0x4f, 0x4e, 0x10, 0x0b, 0x12, 0x23, 0x0a,
+	0x1f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45,
+	0x10, 0x11, 0x12, 0x24, 0x0a, 0x20, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59,
+	0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x12, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x4e, 0x44,
+	0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54,
+	0x45, 0x44, 0x5f, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x13, 0x12, 0x28, 0x0a, 0x24, 0x43, 0x4f, 0x4e,
+	0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x42,
+	0x45, 0x52, 0x5f, 0x4f, 0x46, 0x5f, 0x46, 0x52, 0x4f, 0x4e, 0x54, 0x5f, 0x48, 0x4f, 0x4f, 0x4b,
+	0x53, 0x10, 0x14, 0x12, 0x27, 0x0a, 0x23, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x4f, 0x46, 0x5f,
+	0x42, 0x41, 0x43, 0x4b, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x53, 0x10, 0x15, 0x12, 0x26, 0x0a, 0x22,
+	0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46,
+	0x52, 0x4f, 0x4e, 0x54, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x53, 0x5f, 0x49, 0x4e, 0x43, 0x4c, 0x55,
+	0x44, 0x45, 0x10, 0x16, 0x12, 0x25, 0x0a, 0x21, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x48, 0x4f, 0x4f, 0x4b,
+	0x53, 0x5f, 0x49, 0x4e, 0x43, 0x4c, 0x55, 0x44, 0x45, 0x10, 0x17, 0x12, 0x26, 0x0a, 0x22, 0x43,
+	0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45,
+	0x46, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e,
+	0x53, 0x10, 0x18, 0x12, 0x2a, 0x0a, 0x26, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x4f, 0x57, 0x45, 0x4c, 0x5f, 0x50, 0x52, 0x4f, 0x42,
+	0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x19, 0x12,
+	0x20, 0x0a, 0x1c, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x5f, 0x53, 0x41, 0x4d, 0x50, 0x4c, 0x45, 0x10,
+	0x1a, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x4c, 0x45, 0x58, 0x49, 0x43, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x46, 0x46,
+	0x10, 0x1b, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x10, 0x1c, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x42, 0x49, 0x4e, 0x41, 0x54, 0x4f, 0x52, 0x10,
+	0x1d, 0x12, 0x23, 0x0a, 0x1f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x53, 0x5f, 0x4c, 0x45, 0x54,
+	0x54, 0x45, 0x52, 0x53, 0x10, 0x1e, 0x12, 0x23, 0x0a, 0x1f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x58, 0x43, 0x4c, 0x55, 0x44, 0x45,
+	0x53, 0x5f, 0x4c, 0x45, 0x54, 0x54, 0x45, 0x52, 0x53, 0x10, 0x1f, 0x12, 0x1e, 0x0a, 0x1a, 0x43,
+	0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x4f,
+	0x57, 0x45, 0x4c, 0x5f, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x10, 0x20, 0x12, 0x2b, 0x0a, 0x27, 0x43,
+	0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x55,
+	0x4d, 0x42, 0x45, 0x52, 0x5f, 0x4f, 0x46, 0x5f, 0x42, 0x4c, 0x41, 0x4e, 0x4b, 0x5f, 0x41, 0x4e,
+	0x41, 0x47, 0x52, 0x41, 0x4d, 0x53, 0x10, 0x21, 0x12, 0x25, 0x0a, 0x21, 0x43, 0x4f, 0x4e, 0x44,
+	0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x42, 0x49,
+	0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x22, 0x12,
+	0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x48, 0x41, 0x53, 0x5f, 0x54, 0x41, 0x47, 0x10, 0x23, 0x12, 0x29, 0x0a, 0x25, 0x43,
+	0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x45, 0x44, 0x5f, 0x41, 0x4c, 0x50, 0x48, 0x41, 0x47, 0x52, 0x41, 0x4d, 0x5f,
+	0x4c, 0x49, 0x53, 0x54, 0x10, 0x24, 0x12, 0x2b, 0x0a, 0x27, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x45, 0x44,
+	0x5f, 0x50, 0x52, 0x4f, 0x42, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x49, 0x53,
+	0x54, 0x10, 0x25, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x10, 0x26, 0x12, 0x22, 0x0a,
+	0x1e, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x48, 0x41, 0x53, 0x5f, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x53, 0x10,
+	0x27, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x5f, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x5f, 0x48, 0x4f, 0x4f,
+	0x4b, 0x53, 0x10, 0x28, 0x12, 0x28, 0x0a, 0x24, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x5f, 0x49, 0x4e, 0x5f,
+	0x4c, 0x41, 0x53, 0x54, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x53, 0x10, 0x29, 0x12, 0x20,
+	0x0a, 0x1c, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x50, 0x4c, 0x55, 0x53, 0x5f, 0x4f, 0x4e, 0x45, 0x10, 0x2a,
+	0x12, 0x2f, 0x0a, 0x2b, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x42, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x4c,
+	0x49, 0x4d, 0x49, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x4c, 0x45, 0x4e, 0x47, 0x54, 0x48, 0x10,
+	0x2b, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x53, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x42, 0x41, 0x42, 0x4c,
+	0x45, 0x10, 0x2c, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x45, 0x41, 0x53, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x42,
+	0x41, 0x42, 0x4c, 0x45, 0x10, 0x2d, 0x22, 0x04, 0x08, 0x07, 0x10, 0x07, 0x22, 0x04, 0x08, 0x0c,
+	0x10, 0x10, 0x2a, 0x55, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x4c, 0x65, 0x78, 0x69, 0x63,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x1c, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x4c, 0x45, 0x58,
+	0x49, 0x43, 0x4f, 0x4e, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x45, 0x4e, 0x47, 0x4c, 0x49,
+	0x53, 0x48, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x4c,
+	0x45, 0x58, 0x49, 0x43, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x45, 0x56, 0x49, 0x4f, 0x55, 0x53, 0x5f,
+	0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x2a, 0x49, 0x0a, 0x09, 0x53, 0x6f, 0x72,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x52, 0x4f, 0x42, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59,
+	0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x56, 0x4f, 0x57, 0x45, 0x4c, 0x5f, 0x50, 0x52, 0x4f, 0x42, 0x41, 0x42, 0x49, 0x4c, 0x49,
+	0x54, 0x59, 0x10, 0x01, 0x2a, 0xb1, 0x01, 0x0a, 0x09, 0x57, 0x6f, 0x72, 0x64, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x19, 0x0a, 0x15, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44,
+	0x5f, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x1a, 0x0a,
+	0x16, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x46, 0x52, 0x4f, 0x4e,
+	0x54, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x53, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x57, 0x4f, 0x52,
+	0x44, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x48, 0x4f, 0x4f,
+	0x4b, 0x53, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x46, 0x49, 0x45,
+	0x4c, 0x44, 0x5f, 0x4c, 0x45, 0x58, 0x49, 0x43, 0x4f, 0x4e, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f,
+	0x4c, 0x53, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x46, 0x49, 0x45,
+	0x4c, 0x44, 0x5f, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x53, 0x10, 0x04,
+	0x12, 0x16, 0x0a, 0x12, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x53,
+	0x4f, 0x55, 0x52, 0x43, 0x45, 0x53, 0x10, 0x05, 0x32, 0xa8, 0x01, 0x0a, 0x10, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x12, 0x49, 0x0a,
+	0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x61,
+	0x6e, 0x64, 0x12, 0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
+	0x72, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
+	0x72, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x47, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x64, 0x6f, 0x6d, 0x69, 0x6e, 0x6f, 0x31, 0x34, 0x2f, 0x77, 0x6f, 0x72, 0x64, 0x5f,
+	0x64, 0x62, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2f, 0x76, 0x32, 0x3b, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x76, 0x32, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_wordsearcher_v2_searcher_proto_rawDescOnce sync.Once
+	file_wordsearcher_v2_searcher_proto_rawDescData = file_wordsearcher_v2_searcher_proto_rawDesc
+)
+
+func file_wordsearcher_v2_searcher_proto_rawDescGZIP() []byte {
+	file_wordsearcher_v2_searcher_proto_rawDescOnce.Do(func() {
+		file_wordsearcher_v2_searcher_proto_rawDescData = protoimpl.X.CompressGZIP(file_wordsearcher_v2_searcher_proto_rawDescData)
+	})
+	return file_wordsearcher_v2_searcher_proto_rawDescData
+}
+
+var file_wordsearcher_v2_searcher_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_wordsearcher_v2_searcher_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_wordsearcher_v2_searcher_proto_goTypes = []interface{}{
+	(ConditionType)(0),            // 0: wordsearcher.v2.ConditionType
+	(NotInLexicon)(0),             // 1: wordsearcher.v2.NotInLexicon
+	(SortOrder)(0),                // 2: wordsearcher.v2.SortOrder
+	(WordField)(0),                // 3: wordsearcher.v2.WordField
+	(LexiconDiff_Mode)(0),         // 4: wordsearcher.v2.LexiconDiff.Mode
+	(Combinator_Op)(0),            // 5: wordsearcher.v2.Combinator.Op
+	(*Lexicon)(nil),               // 6: wordsearcher.v2.Lexicon
+	(*Alphagram)(nil),             // 7: wordsearcher.v2.Alphagram
+	(*Word)(nil),                  // 8: wordsearcher.v2.Word
+	(*Range)(nil),                 // 9: wordsearcher.v2.Range
+	(*StringList)(nil),            // 10: wordsearcher.v2.StringList
+	(*NumberList)(nil),            // 11: wordsearcher.v2.NumberList
+	(*LengthProbability)(nil),     // 12: wordsearcher.v2.LengthProbability
+	(*LengthProbabilityList)(nil), // 13: wordsearcher.v2.LengthProbabilityList
+	(*RandomSample)(nil),          // 14: wordsearcher.v2.RandomSample
+	(*LexiconDiff)(nil),           // 15: wordsearcher.v2.LexiconDiff
+	(*Combinator)(nil),            // 16: wordsearcher.v2.Combinator
+	(*Build)(nil),                 // 17: wordsearcher.v2.Build
+	(*Condition)(nil),             // 18: wordsearcher.v2.Condition
+	(*SingleAnagram)(nil),         // 19: wordsearcher.v2.SingleAnagram
+	(*SearchRequest)(nil),         // 20: wordsearcher.v2.SearchRequest
+	(*SearchResponse)(nil),        // 21: wordsearcher.v2.SearchResponse
+	(*ExpandRequest)(nil),         // 22: wordsearcher.v2.ExpandRequest
+}
+var file_wordsearcher_v2_searcher_proto_depIdxs = []int32{
+	8,  // 0: wordsearcher.v2.Alphagram.words:type_name -> wordsearcher.v2.Word
+	6,  // 1: wordsearcher.v2.Alphagram.lexicon:type_name -> wordsearcher.v2.Lexicon
+	12, // 2: wordsearcher.v2.LengthProbabilityList.values:type_name -> wordsearcher.v2.LengthProbability
+	6,  // 3: wordsearcher.v2.LexiconDiff.other_lexicon:type_name -> wordsearcher.v2.Lexicon
+	4,  // 4: wordsearcher.v2.LexiconDiff.mode:type_name -> wordsearcher.v2.LexiconDiff.Mode
+	5,  // 5: wordsearcher.v2.Combinator.op:type_name -> wordsearcher.v2.Combinator.Op
+	18, // 6: wordsearcher.v2.Combinator.conditions:type_name -> wordsearcher.v2.Condition
+	0,  // 7: wordsearcher.v2.Condition.type:type_name -> wordsearcher.v2.ConditionType
+	9,  // 8: wordsearcher.v2.Condition.range:type_name -> wordsearcher.v2.Range
+	10, // 9: wordsearcher.v2.Condition.strings:type_name -> wordsearcher.v2.StringList
+	11, // 10: wordsearcher.v2.Condition.numbers:type_name -> wordsearcher.v2.NumberList
+	1,  // 11: wordsearcher.v2.Condition.not_in_lexicon:type_name -> wordsearcher.v2.NotInLexicon
+	14, // 12: wordsearcher.v2.Condition.random_sample:type_name -> wordsearcher.v2.RandomSample
+	15, // 13: wordsearcher.v2.Condition.lexicon_diff:type_name -> wordsearcher.v2.LexiconDiff
+	16, // 14: wordsearcher.v2.Condition.combinator:type_name -> wordsearcher.v2.Combinator
+	13, // 15: wordsearcher.v2.Condition.length_probabilities:type_name -> wordsearcher.v2.LengthProbabilityList
+	17, // 16: wordsearcher.v2.Condition.build:type_name -> wordsearcher.v2.Build
+	6,  // 17: wordsearcher.v2.SearchRequest.lexicon:type_name -> wordsearcher.v2.Lexicon
+	18, // 18: wordsearcher.v2.SearchRequest.conditions:type_name -> wordsearcher.v2.Condition
+	2,  // 19: wordsearcher.v2.SearchRequest.sort_order:type_name -> wordsearcher.v2.SortOrder
+	3,  // 20: wordsearcher.v2.SearchRequest.partial_expand:type_name -> wordsearcher.v2.WordField
+	19, // 21: wordsearcher.v2.SearchRequest.single_anagram:type_name -> wordsearcher.v2.SingleAnagram
+	7,  // 22: wordsearcher.v2.SearchResponse.alphagrams:type_name -> wordsearcher.v2.Alphagram
+	6,  // 23: wordsearcher.v2.SearchResponse.lexicon:type_name -> wordsearcher.v2.Lexicon
+	6,  // 24: wordsearcher.v2.ExpandRequest.lexicon:type_name -> wordsearcher.v2.Lexicon
+	7,  // 25: wordsearcher.v2.ExpandRequest.alphagrams:type_name -> wordsearcher.v2.Alphagram
+	20, // 26: wordsearcher.v2.QuestionSearcher.Search:input_type -> wordsearcher.v2.SearchRequest
+	22, // 27: wordsearcher.v2.QuestionSearcher.Expand:input_type -> wordsearcher.v2.ExpandRequest
+	21, // 28: wordsearcher.v2.QuestionSearcher.Search:output_type -> wordsearcher.v2.SearchResponse
+	21, // 29: wordsearcher.v2.QuestionSearcher.Expand:output_type -> wordsearcher.v2.SearchResponse
+	28, // [28:30] is the sub-list for method output_type
+	26, // [26:28] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
+}
+
+func init() { file_wordsearcher_v2_searcher_proto_init() }
+func file_wordsearcher_v2_searcher_proto_init() {
+	if File_wordsearcher_v2_searcher_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_wordsearcher_v2_searcher_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Lexicon); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_v2_searcher_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Alphagram); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_v2_searcher_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Word); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_v2_searcher_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Range); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_v2_searcher_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StringList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_v2_searcher_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NumberList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_v2_searcher_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LengthProbability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_v2_searcher_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LengthProbabilityList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_v2_searcher_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandomSample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_v2_searcher_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LexiconDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_v2_searcher_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Combinator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_v2_searcher_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Build); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_v2_searcher_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Condition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_v2_searcher_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SingleAnagram); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_v2_searcher_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_v2_searcher_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wordsearcher_v2_searcher_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpandRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_wordsearcher_v2_searcher_proto_msgTypes[12].OneofWrappers = []interface{}{
+		(*Condition_Range)(nil),
+		(*Condition_Text)(nil),
+		(*Condition_Strings)(nil),
+		(*Condition_Numbers)(nil),
+		(*Condition_Number)(nil),
+		(*Condition_NotInLexicon)(nil),
+		(*Condition_RandomSample)(nil),
+		(*Condition_LexiconDiff)(nil),
+		(*Condition_Combinator)(nil),
+		(*Condition_LengthProbabilities)(nil),
+		(*Condition_Build)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_wordsearcher_v2_searcher_proto_rawDesc,
+			NumEnums:      6,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_wordsearcher_v2_searcher_proto_goTypes,
+		DependencyIndexes: file_wordsearcher_v2_searcher_proto_depIdxs,
+		EnumInfos:         file_wordsearcher_v2_searcher_proto_enumTypes,
+		MessageInfos:      file_wordsearcher_v2_searcher_proto_msgTypes,
+	}.Build()
+	File_wordsearcher_v2_searcher_proto = out.File
+	file_wordsearcher_v2_searcher_proto_rawDesc = nil
+	file_wordsearcher_v2_searcher_proto_goTypes = nil
+	file_wordsearcher_v2_searcher_proto_depIdxs = nil
+}
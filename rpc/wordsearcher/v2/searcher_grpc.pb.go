@@ -0,0 +1,150 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.4
+// source: wordsearcher/v2/searcher.proto
+
+// Version 2 of the QuestionSearcher API. It names lexica with a Lexicon
+// message instead of a LEXICON search condition, and its conditions are
+// typed instead of sharing a bag of parameters. Version 1, in the
+// wordsearcher package, is still served, and the two search the same
+// databases. This package's services are served under the /v2 path prefix.
+
+package wordsearcherv2
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	QuestionSearcher_Search_FullMethodName = "/wordsearcher.v2.QuestionSearcher/Search"
+	QuestionSearcher_Expand_FullMethodName = "/wordsearcher.v2.QuestionSearcher/Expand"
+)
+
+// QuestionSearcherClient is the client API for QuestionSearcher service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QuestionSearcherClient interface {
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	Expand(ctx context.Context, in *ExpandRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+}
+
+type questionSearcherClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQuestionSearcherClient(cc grpc.ClientConnInterface) QuestionSearcherClient {
+	return &questionSearcherClient{cc}
+}
+
+func (c *questionSearcherClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, QuestionSearcher_Search_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionSearcherClient) Expand(ctx context.Context, in *ExpandRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, QuestionSearcher_Expand_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuestionSearcherServer is the server API for QuestionSearcher service.
+// All implementations should embed UnimplementedQuestionSearcherServer
+// for forward compatibility
+type QuestionSearcherServer interface {
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	Expand(context.Context, *ExpandRequest) (*SearchResponse, error)
+}
+
+// UnimplementedQuestionSearcherServer should be embedded to have forward compatible implementations.
+type UnimplementedQuestionSearcherServer struct {
+}
+
+func (UnimplementedQuestionSearcherServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedQuestionSearcherServer) Expand(context.Context, *ExpandRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Expand not implemented")
+}
+
+// UnsafeQuestionSearcherServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QuestionSearcherServer will
+// result in compilation errors.
+type UnsafeQuestionSearcherServer interface {
+	mustEmbedUnimplementedQuestionSearcherServer()
+}
+
+func RegisterQuestionSearcherServer(s grpc.ServiceRegistrar, srv QuestionSearcherServer) {
+	s.RegisterService(&QuestionSearcher_ServiceDesc, srv)
+}
+
+func _QuestionSearcher_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionSearcherServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionSearcher_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionSearcherServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionSearcher_Expand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionSearcherServer).Expand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionSearcher_Expand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionSearcherServer).Expand(ctx, req.(*ExpandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QuestionSearcher_ServiceDesc is the grpc.ServiceDesc for QuestionSearcher service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QuestionSearcher_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wordsearcher.v2.QuestionSearcher",
+	HandlerType: (*QuestionSearcherServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Search",
+			Handler:    _QuestionSearcher_Search_Handler,
+		},
+		{
+			MethodName: "Expand",
+			Handler:    _QuestionSearcher_Expand_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "wordsearcher/v2/searcher.proto",
+}